@@ -0,0 +1,220 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bulkwriter
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"strconv"
+
+	"github.com/apache/arrow/go/v17/arrow"
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus/client/v3/entity"
+)
+
+// buffer holds encoded rows of one output file.
+type buffer struct {
+	fileType BulkFileType
+	fields   []*entity.Field
+	columns  []string
+	sep      rune
+	nullKey  string
+
+	// arrowSchema is the schema of ParquetFile output
+	arrowSchema *arrow.Schema
+
+	rows []bufferedRow
+	size int64
+}
+
+// bufferedRow is one encoded row, JSONFile and CSVFile rows are kept as encoded bytes,
+// ParquetFile rows are kept as arrow values until the whole file is built.
+type bufferedRow struct {
+	data   []byte
+	values map[string]any
+	size   int64
+}
+
+func newBuffer(fileType BulkFileType, validator *rowValidator, sep rune, nullKey string) (*buffer, error) {
+	b := &buffer{
+		fileType: fileType,
+		fields:   validator.columnFields(),
+		columns:  validator.columnNames(),
+		sep:      sep,
+		nullKey:  nullKey,
+	}
+	if fileType == ParquetFile {
+		var err error
+		if b.arrowSchema, err = newArrowSchema(b.fields, validator.dynamicEnable); err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+// encode encodes a normalized row, the buffer is not changed.
+func (b *buffer) encode(row map[string]any) (bufferedRow, error) {
+	var (
+		bs  []byte
+		err error
+	)
+	switch b.fileType {
+	case JSONFile:
+		bs, err = json.Marshal(row)
+	case CSVFile:
+		bs, err = b.encodeCSVRecord(row)
+	case ParquetFile:
+		return b.encodeParquetRow(row)
+	default:
+		err = errors.Newf("unsupported bulk file type %s", b.fileType)
+	}
+	if err != nil {
+		return bufferedRow{}, err
+	}
+	return bufferedRow{data: bs, size: int64(len(bs))}, nil
+}
+
+// add keeps the encoded rows in buffer.
+func (b *buffer) add(rows ...bufferedRow) {
+	for _, row := range rows {
+		b.rows = append(b.rows, row)
+		b.size += row.size
+	}
+}
+
+// truncate drops the rows added after the first n rows.
+func (b *buffer) truncate(n int) {
+	for _, row := range b.rows[n:] {
+		b.size -= row.size
+	}
+	b.rows = b.rows[:n]
+}
+
+func (b *buffer) rowCount() int {
+	return len(b.rows)
+}
+
+func (b *buffer) bufferSize() int64 {
+	return b.size
+}
+
+// bytes returns the whole file content of buffered rows.
+func (b *buffer) bytes() ([]byte, error) {
+	var out bytes.Buffer
+	switch b.fileType {
+	case JSONFile:
+		out.Grow(int(b.size) + len(b.rows) + 2)
+		out.WriteByte('[')
+		for i, row := range b.rows {
+			if i > 0 {
+				out.WriteByte(',')
+			}
+			out.Write(row.data)
+		}
+		out.WriteByte(']')
+	case CSVFile:
+		out.Grow(int(b.size) + len(b.rows) + 2)
+		w := csv.NewWriter(&out)
+		w.Comma = b.sep
+		if err := w.Write(b.columns); err != nil {
+			return nil, err
+		}
+		w.Flush()
+		for _, row := range b.rows {
+			out.Write(row.data)
+		}
+	case ParquetFile:
+		values := make([]map[string]any, 0, len(b.rows))
+		for _, row := range b.rows {
+			values = append(values, row.values)
+		}
+		return writeParquet(b.arrowSchema, values)
+	default:
+		return nil, errors.Newf("unsupported bulk file type %s", b.fileType)
+	}
+	return out.Bytes(), nil
+}
+
+func (b *buffer) reset() {
+	b.rows = nil
+	b.size = 0
+}
+
+// encodeCSVRecord renders one csv line, scalar values are written as plain text,
+// vectors, arrays, JSON and dynamic values are written as JSON strings.
+func (b *buffer) encodeCSVRecord(row map[string]any) ([]byte, error) {
+	record := make([]string, 0, len(b.columns))
+	for _, name := range b.columns {
+		value := row[name]
+		switch v := value.(type) {
+		case nil:
+			record = append(record, b.nullKey)
+		case bool:
+			record = append(record, strconv.FormatBool(v))
+		case int64:
+			record = append(record, strconv.FormatInt(v, 10))
+		case float32:
+			record = append(record, strconv.FormatFloat(float64(v), 'g', -1, 32))
+		case float64:
+			record = append(record, strconv.FormatFloat(v, 'g', -1, 64))
+		case string:
+			record = append(record, v)
+		default:
+			bs, err := json.Marshal(v)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to encode value of field '%s'", name)
+			}
+			record = append(record, string(bs))
+		}
+	}
+
+	var out bytes.Buffer
+	w := csv.NewWriter(&out)
+	w.Comma = b.sep
+	if err := w.Write(record); err != nil {
+		return nil, err
+	}
+	w.Flush()
+	return out.Bytes(), w.Error()
+}
+
+// encodeParquetRow converts the values into arrow values, the dynamic values are
+// encoded as a JSON string.
+func (b *buffer) encodeParquetRow(row map[string]any) (bufferedRow, error) {
+	values := make(map[string]any, len(b.columns))
+	for _, field := range b.fields {
+		value, err := toArrowValue(field, field.DataType, row[field.Name])
+		if err != nil {
+			return bufferedRow{}, err
+		}
+		values[field.Name] = value
+	}
+	if dynamicValues, ok := row[dynamicFieldName]; ok {
+		value, err := toJSONString(dynamicFieldName, dynamicValues)
+		if err != nil {
+			return bufferedRow{}, err
+		}
+		values[dynamicFieldName] = value
+	}
+	var size int64
+	for _, value := range values {
+		size += estimateSize(value)
+	}
+	return bufferedRow{values: values, size: size}, nil
+}
@@ -0,0 +1,310 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bulkwriter
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+
+	"github.com/milvus-io/milvus/client/v3/column"
+)
+
+// flushFunc persists one file and returns the path to be passed to import API.
+type flushFunc func(ctx context.Context, name string, content []byte) (string, error)
+
+// bulkWriter is the shared implementation of LocalBulkWriter and RemoteBulkWriter.
+// Rows are validated against the schema, encoded into buffer and flushed into
+// a new file once the buffered size reaches the chunk size.
+type bulkWriter struct {
+	mut sync.Mutex
+
+	option    *BulkWriterOption
+	validator *rowValidator
+	buffer    *buffer
+	flush     flushFunc
+
+	uuid       string
+	fileIdx    int
+	totalRows  int64
+	batchFiles [][]string
+	closed     bool
+}
+
+func newBulkWriter(option *BulkWriterOption, flush flushFunc) (*bulkWriter, error) {
+	if option == nil {
+		return nil, errors.New("bulk writer option is nil")
+	}
+	if option.FileType.Suffix() == "" {
+		return nil, errors.Newf("unsupported bulk file type %d", option.FileType)
+	}
+	if option.ChunkSize <= 0 {
+		return nil, errors.Newf("invalid chunk size %d", option.ChunkSize)
+	}
+	if option.FileType == CSVFile {
+		switch option.CSVSeparator {
+		case 0, '\n', '\r', '"', 0xFFFD:
+			return nil, errors.Newf("unsupported csv separator: %q", option.CSVSeparator)
+		}
+	}
+	validator, err := newRowValidator(option.Schema)
+	if err != nil {
+		return nil, err
+	}
+	buffer, err := newBuffer(option.FileType, validator, option.CSVSeparator, option.CSVNullKey)
+	if err != nil {
+		return nil, err
+	}
+	return &bulkWriter{
+		option:    option,
+		validator: validator,
+		buffer:    buffer,
+		flush:     flush,
+		uuid:      uuid.NewString(),
+	}, nil
+}
+
+// AppendRow validates a row, `{field name: value}`, and appends it into buffer.
+// Keys not defined in schema are stored into dynamic field if it is enabled.
+func (w *bulkWriter) AppendRow(ctx context.Context, row map[string]any) error {
+	w.mut.Lock()
+	defer w.mut.Unlock()
+
+	if w.closed {
+		return errors.New("bulk writer is closed")
+	}
+	encoded, err := w.encodeRow(row)
+	if err != nil {
+		return err
+	}
+	return w.appendRows(ctx, encoded)
+}
+
+// AppendColumns appends column based data, all columns shall have the same length.
+// The rows are appended atomically, nothing is appended if any row is invalid.
+func (w *bulkWriter) AppendColumns(ctx context.Context, columns ...column.Column) error {
+	if len(columns) == 0 {
+		return nil
+	}
+	rowNum := columns[0].Len()
+	for _, col := range columns {
+		if col.Len() != rowNum {
+			return errors.Newf("column %s has %d rows, expected %d", col.Name(), col.Len(), rowNum)
+		}
+	}
+
+	w.mut.Lock()
+	defer w.mut.Unlock()
+
+	if w.closed {
+		return errors.New("bulk writer is closed")
+	}
+	rows := make([]bufferedRow, 0, rowNum)
+	for i := 0; i < rowNum; i++ {
+		row := make(map[string]any, len(columns))
+		for _, col := range columns {
+			isNull, err := col.IsNull(i)
+			if err != nil {
+				return err
+			}
+			if isNull {
+				row[col.Name()] = nil
+				continue
+			}
+			value, err := col.Get(i)
+			if err != nil {
+				return err
+			}
+			row[col.Name()] = value
+		}
+		encoded, err := w.encodeRow(row)
+		if err != nil {
+			return errors.Wrapf(err, "failed to append row %d", i)
+		}
+		rows = append(rows, encoded)
+	}
+	return w.appendRows(ctx, rows...)
+}
+
+// Commit flushes all buffered rows into a file.
+func (w *bulkWriter) Commit(ctx context.Context) error {
+	w.mut.Lock()
+	defer w.mut.Unlock()
+	return w.tryFlush(ctx, true)
+}
+
+// Close commits the buffered rows, the writer could not be used after closed.
+func (w *bulkWriter) Close(ctx context.Context) error {
+	w.mut.Lock()
+	defer w.mut.Unlock()
+	if w.closed {
+		return nil
+	}
+	err := w.tryFlush(ctx, true)
+	w.closed = true
+	return err
+}
+
+// BatchFiles returns the generated files, each element could be used as
+// one entry of BulkImportOption.Files.
+func (w *bulkWriter) BatchFiles() [][]string {
+	w.mut.Lock()
+	defer w.mut.Unlock()
+	result := make([][]string, 0, len(w.batchFiles))
+	for _, files := range w.batchFiles {
+		result = append(result, append([]string{}, files...))
+	}
+	return result
+}
+
+// TotalRowCount returns the number of rows appended.
+func (w *bulkWriter) TotalRowCount() int64 {
+	w.mut.Lock()
+	defer w.mut.Unlock()
+	return w.totalRows
+}
+
+// BufferRowCount returns the number of rows not flushed yet.
+func (w *bulkWriter) BufferRowCount() int {
+	w.mut.Lock()
+	defer w.mut.Unlock()
+	return w.buffer.rowCount()
+}
+
+// UUID returns the unique id of this writer, which is the parent folder of all the generated files.
+func (w *bulkWriter) UUID() string {
+	return w.uuid
+}
+
+func (w *bulkWriter) encodeRow(row map[string]any) (bufferedRow, error) {
+	normalized, err := w.validator.normalize(row)
+	if err != nil {
+		return bufferedRow{}, err
+	}
+	return w.buffer.encode(normalized)
+}
+
+// appendRows adds the encoded rows into buffer and flushes them once the chunk size is
+// reached. The rows are dropped from buffer if they could not be flushed, so either
+// all the rows are appended or none of them.
+func (w *bulkWriter) appendRows(ctx context.Context, rows ...bufferedRow) error {
+	n := w.buffer.rowCount()
+	w.buffer.add(rows...)
+	if err := w.tryFlush(ctx, false); err != nil {
+		w.buffer.truncate(n)
+		return err
+	}
+	w.totalRows += int64(len(rows))
+	return nil
+}
+
+func (w *bulkWriter) tryFlush(ctx context.Context, force bool) error {
+	if w.buffer.rowCount() == 0 {
+		return nil
+	}
+	if !force && w.buffer.bufferSize() < w.option.ChunkSize {
+		return nil
+	}
+
+	content, err := w.buffer.bytes()
+	if err != nil {
+		return err
+	}
+	name := fmt.Sprintf("%d%s", w.fileIdx+1, w.option.FileType.Suffix())
+	filePath, err := w.flush(ctx, name, content)
+	if err != nil {
+		return errors.Wrapf(err, "failed to flush bulk file %s", name)
+	}
+	w.fileIdx++
+	w.batchFiles = append(w.batchFiles, []string{filePath})
+	w.buffer.reset()
+	return nil
+}
+
+// LocalBulkWriter generates import files into a local directory,
+// the files are placed in `{Path}/{UUID}/`.
+type LocalBulkWriter struct {
+	*bulkWriter
+}
+
+// NewLocalBulkWriter creates a LocalBulkWriter with provided option.
+func NewLocalBulkWriter(option *BulkWriterOption) (*LocalBulkWriter, error) {
+	writer := &LocalBulkWriter{}
+	bw, err := newBulkWriter(option, writer.writeFile)
+	if err != nil {
+		return nil, err
+	}
+	writer.bulkWriter = bw
+	return writer, nil
+}
+
+// DataPath returns the local directory holding all the generated files.
+func (w *LocalBulkWriter) DataPath() string {
+	return filepath.Join(w.option.Path, w.uuid)
+}
+
+func (w *LocalBulkWriter) writeFile(_ context.Context, name string, content []byte) (string, error) {
+	dir := w.DataPath()
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", err
+	}
+	filePath := filepath.Join(dir, name)
+	if err := os.WriteFile(filePath, content, 0o644); err != nil {
+		return "", err
+	}
+	return filePath, nil
+}
+
+// RemoteBulkWriter generates import files and uploads them through an ObjectWriter,
+// the object keys are `{Path}/{UUID}/{n}.{suffix}`.
+type RemoteBulkWriter struct {
+	*bulkWriter
+	storage ObjectWriter
+}
+
+// NewRemoteBulkWriter creates a RemoteBulkWriter with provided option and storage backend.
+func NewRemoteBulkWriter(option *BulkWriterOption, storage ObjectWriter) (*RemoteBulkWriter, error) {
+	if storage == nil {
+		return nil, errors.New("remote bulk writer requires a storage backend")
+	}
+	writer := &RemoteBulkWriter{storage: storage}
+	bw, err := newBulkWriter(option, writer.uploadFile)
+	if err != nil {
+		return nil, err
+	}
+	writer.bulkWriter = bw
+	return writer, nil
+}
+
+// DataPath returns the object key prefix holding all the generated files.
+func (w *RemoteBulkWriter) DataPath() string {
+	return path.Join(w.option.Path, w.uuid)
+}
+
+func (w *RemoteBulkWriter) uploadFile(ctx context.Context, name string, content []byte) (string, error) {
+	key := path.Join(w.DataPath(), name)
+	if err := w.storage.Write(ctx, key, content); err != nil {
+		return "", err
+	}
+	return key, nil
+}
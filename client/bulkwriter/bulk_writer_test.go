@@ -0,0 +1,422 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bulkwriter
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/apache/arrow/go/v17/arrow"
+	"github.com/apache/arrow/go/v17/arrow/array"
+	"github.com/apache/arrow/go/v17/arrow/memory"
+	"github.com/apache/arrow/go/v17/parquet/file"
+	"github.com/apache/arrow/go/v17/parquet/pqarrow"
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus/client/v3/column"
+	"github.com/milvus-io/milvus/client/v3/entity"
+)
+
+type mockObjectWriter struct {
+	mut     sync.Mutex
+	objects map[string][]byte
+	err     error
+}
+
+func (m *mockObjectWriter) Write(_ context.Context, filePath string, content []byte) error {
+	m.mut.Lock()
+	defer m.mut.Unlock()
+	if m.err != nil {
+		return m.err
+	}
+	m.objects[filePath] = content
+	return nil
+}
+
+type BulkWriterSuite struct {
+	suite.Suite
+
+	schema *entity.Schema
+}
+
+func (s *BulkWriterSuite) SetupTest() {
+	s.schema = entity.NewSchema().WithName("bulk_writer").WithDynamicFieldEnabled(true).
+		WithField(entity.NewField().WithName("id").WithDataType(entity.FieldTypeInt64).WithIsPrimaryKey(true)).
+		WithField(entity.NewField().WithName("vector").WithDataType(entity.FieldTypeFloatVector).WithDim(4)).
+		WithField(entity.NewField().WithName("name").WithDataType(entity.FieldTypeVarChar).WithMaxLength(8).WithNullable(true)).
+		WithField(entity.NewField().WithName("tags").WithDataType(entity.FieldTypeArray).WithElementType(entity.FieldTypeInt32).WithMaxCapacity(3)).
+		WithField(entity.NewField().WithName("sparse").WithDataType(entity.FieldTypeSparseVector))
+}
+
+func (s *BulkWriterSuite) row(id int64) map[string]any {
+	sparse, err := entity.NewSliceSparseEmbedding([]uint32{1, 10}, []float32{0.5, 0.2})
+	s.Require().NoError(err)
+	return map[string]any{
+		"id":     id,
+		"vector": []float32{0.1, 0.2, 0.3, 0.4},
+		"name":   "foo",
+		"tags":   []int32{1, 2},
+		"sparse": sparse,
+		"extra":  "dynamic",
+	}
+}
+
+func (s *BulkWriterSuite) TestLocalJSON() {
+	ctx := context.Background()
+	dir := s.T().TempDir()
+	writer, err := NewLocalBulkWriter(NewBulkWriterOption(s.schema, dir))
+	s.Require().NoError(err)
+
+	for i := 0; i < 3; i++ {
+		s.NoError(writer.AppendRow(ctx, s.row(int64(i))))
+	}
+	s.EqualValues(3, writer.BufferRowCount())
+	s.NoError(writer.Commit(ctx))
+	s.EqualValues(0, writer.BufferRowCount())
+	s.EqualValues(3, writer.TotalRowCount())
+
+	files := writer.BatchFiles()
+	s.Require().Len(files, 1)
+	s.True(strings.HasPrefix(files[0][0], writer.DataPath()))
+	s.True(strings.HasSuffix(files[0][0], ".json"))
+
+	bs, err := os.ReadFile(files[0][0])
+	s.Require().NoError(err)
+	var rows []map[string]any
+	s.Require().NoError(json.Unmarshal(bs, &rows))
+	s.Len(rows, 3)
+	s.EqualValues(0, rows[0]["id"])
+	s.Equal(map[string]any{"extra": "dynamic"}, rows[0][dynamicFieldName])
+	s.Equal(map[string]any{"indices": []any{float64(1), float64(10)}, "values": []any{0.5, 0.2}}, rows[0]["sparse"])
+
+	s.NoError(writer.Close(ctx))
+	s.Error(writer.AppendRow(ctx, s.row(4)))
+}
+
+func (s *BulkWriterSuite) TestLocalCSV() {
+	ctx := context.Background()
+	writer, err := NewLocalBulkWriter(NewBulkWriterOption(s.schema, s.T().TempDir()).
+		WithFileType(CSVFile).
+		WithCSVSeparator('\t').
+		WithCSVNullKey("NULL"))
+	s.Require().NoError(err)
+
+	row := s.row(1)
+	row["name"] = nil
+	s.NoError(writer.AppendRow(ctx, row))
+	s.NoError(writer.Commit(ctx))
+
+	files := writer.BatchFiles()
+	s.Require().Len(files, 1)
+	f, err := os.Open(files[0][0])
+	s.Require().NoError(err)
+	defer f.Close()
+	reader := csv.NewReader(f)
+	reader.Comma = '\t'
+	records, err := reader.ReadAll()
+	s.Require().NoError(err)
+	s.Require().Len(records, 2)
+	s.Equal([]string{"id", "vector", "name", "tags", "sparse", dynamicFieldName}, records[0])
+	s.Equal("1", records[1][0])
+	s.Equal("[0.1,0.2,0.3,0.4]", records[1][1])
+	s.Equal("NULL", records[1][2])
+	s.Equal("[1,2]", records[1][3])
+	s.Equal(`{"extra":"dynamic"}`, records[1][5])
+}
+
+func (s *BulkWriterSuite) TestRemoteRolling() {
+	ctx := context.Background()
+	storage := &mockObjectWriter{objects: make(map[string][]byte)}
+	writer, err := NewRemoteBulkWriter(NewBulkWriterOption(s.schema, "bulk/data").WithChunkSize(1), storage)
+	s.Require().NoError(err)
+
+	ids := column.NewColumnInt64("id", []int64{1, 2})
+	vectors := column.NewColumnFloatVector("vector", 4, [][]float32{{1, 2, 3, 4}, {5, 6, 7, 8}})
+	names := column.NewColumnVarChar("name", []string{"a", "b"})
+	tags := column.NewColumnInt32Array("tags", [][]int32{{1}, {2, 3}})
+	sparse1, _ := entity.NewSliceSparseEmbedding([]uint32{1}, []float32{1})
+	sparse2, _ := entity.NewSliceSparseEmbedding([]uint32{2}, []float32{2})
+	sparses := column.NewColumnSparseVectors("sparse", []entity.SparseEmbedding{sparse1, sparse2})
+	s.NoError(writer.AppendColumns(ctx, ids, vectors, names, tags, sparses))
+	s.NoError(writer.AppendRow(ctx, s.row(3)))
+
+	// chunk size 1 makes every append a separate file
+	files := writer.BatchFiles()
+	s.Len(files, 2)
+	for _, file := range files {
+		s.True(strings.HasPrefix(file[0], "bulk/data/"+writer.UUID()))
+		s.Contains(storage.objects, file[0])
+	}
+	s.NoError(writer.Commit(ctx))
+	s.Len(writer.BatchFiles(), 2)
+	s.EqualValues(3, writer.TotalRowCount())
+
+	s.Error(writer.AppendColumns(ctx, ids, column.NewColumnInt64("x", []int64{1})))
+
+	// the row is dropped if it could not be flushed
+	storage.err = errors.New("mocked")
+	s.Error(writer.AppendRow(ctx, s.row(4)))
+	s.EqualValues(0, writer.BufferRowCount())
+	s.EqualValues(3, writer.TotalRowCount())
+
+	storage.err = nil
+	s.NoError(writer.AppendRow(ctx, s.row(4)))
+	s.Len(writer.BatchFiles(), 3)
+	s.Contains(storage.objects, "bulk/data/"+writer.UUID()+"/3.json")
+}
+
+func (s *BulkWriterSuite) TestAppendColumnsAtomic() {
+	ctx := context.Background()
+	writer, err := NewLocalBulkWriter(NewBulkWriterOption(s.schema, s.T().TempDir()))
+	s.Require().NoError(err)
+
+	ids := column.NewColumnInt64("id", []int64{1, 2})
+	vectors := column.NewColumnFloatVector("vector", 4, [][]float32{{1, 2, 3, 4}, {5, 6, 7, 8}})
+	names := column.NewColumnVarChar("name", []string{"a", "too_long_value"})
+	tags := column.NewColumnInt32Array("tags", [][]int32{{1}, {2, 3}})
+	sparse1, _ := entity.NewSliceSparseEmbedding([]uint32{1}, []float32{1})
+	sparse2, _ := entity.NewSliceSparseEmbedding([]uint32{2}, []float32{2})
+	sparses := column.NewColumnSparseVectors("sparse", []entity.SparseEmbedding{sparse1, sparse2})
+
+	// the second row is invalid, the first one shall not be appended either
+	s.Error(writer.AppendColumns(ctx, ids, vectors, names, tags, sparses))
+	s.EqualValues(0, writer.BufferRowCount())
+	s.EqualValues(0, writer.TotalRowCount())
+
+	names = column.NewColumnVarChar("name", []string{"a", "b"})
+	s.NoError(writer.AppendColumns(ctx, ids, vectors, names, tags, sparses))
+	s.EqualValues(2, writer.BufferRowCount())
+	s.EqualValues(2, writer.TotalRowCount())
+}
+
+func (s *BulkWriterSuite) TestLocalParquet() {
+	ctx := context.Background()
+	schema := entity.NewSchema().WithName("parquet").WithDynamicFieldEnabled(true).
+		WithField(entity.NewField().WithName("id").WithDataType(entity.FieldTypeInt64).WithIsPrimaryKey(true).WithIsAutoID(true)).
+		WithField(entity.NewField().WithName("vector").WithDataType(entity.FieldTypeFloatVector).WithDim(2)).
+		WithField(entity.NewField().WithName("fp16").WithDataType(entity.FieldTypeFloat16Vector).WithDim(2)).
+		WithField(entity.NewField().WithName("binary").WithDataType(entity.FieldTypeBinaryVector).WithDim(16)).
+		WithField(entity.NewField().WithName("sparse").WithDataType(entity.FieldTypeSparseVector)).
+		WithField(entity.NewField().WithName("name").WithDataType(entity.FieldTypeVarChar).WithMaxLength(8).WithNullable(true)).
+		WithField(entity.NewField().WithName("age").WithDataType(entity.FieldTypeInt16)).
+		WithField(entity.NewField().WithName("tags").WithDataType(entity.FieldTypeArray).WithElementType(entity.FieldTypeInt32).WithMaxCapacity(3)).
+		WithField(entity.NewField().WithName("json").WithDataType(entity.FieldTypeJSON)).
+		WithField(entity.NewField().WithName("clips").WithDataType(entity.FieldTypeArray).WithElementType(entity.FieldTypeStruct).WithMaxCapacity(4).
+			WithStructSchema(entity.NewStructSchema().
+				WithField(entity.NewField().WithName("frame").WithDataType(entity.FieldTypeInt32)).
+				WithField(entity.NewField().WithName("embedding").WithDataType(entity.FieldTypeFloatVector).WithDim(2))))
+	writer, err := NewLocalBulkWriter(NewBulkWriterOption(schema, s.T().TempDir()).WithFileType(ParquetFile))
+	s.Require().NoError(err)
+
+	sparse, err := entity.NewSliceSparseEmbedding([]uint32{1, 10}, []float32{0.5, 0.2})
+	s.Require().NoError(err)
+	for i := 0; i < 2; i++ {
+		row := map[string]any{
+			"vector": []float32{1, 2},
+			"fp16":   []float32{0.5, 1},
+			"binary": []byte{1, 2},
+			"sparse": sparse,
+			"name":   "foo",
+			"age":    int16(i),
+			"tags":   []int32{1, 2},
+			"json":   `{"a": 1}`,
+			"clips": []map[string]any{
+				{"frame": int32(1), "embedding": []float32{0.1, 0.2}},
+			},
+			"extra": "dynamic",
+		}
+		if i == 1 {
+			row["name"] = nil
+		}
+		s.NoError(writer.AppendRow(ctx, row))
+	}
+	s.NoError(writer.Commit(ctx))
+
+	files := writer.BatchFiles()
+	s.Require().Len(files, 1)
+	s.True(strings.HasSuffix(files[0][0], ".parquet"))
+
+	f, err := os.Open(files[0][0])
+	s.Require().NoError(err)
+	defer f.Close()
+	pqReader, err := file.NewParquetReader(f)
+	s.Require().NoError(err)
+	defer pqReader.Close()
+	reader, err := pqarrow.NewFileReader(pqReader, pqarrow.ArrowReadProperties{}, memory.DefaultAllocator)
+	s.Require().NoError(err)
+	table, err := reader.ReadTable(ctx)
+	s.Require().NoError(err)
+	defer table.Release()
+
+	s.EqualValues(2, table.NumRows())
+	names := lo.Map(table.Schema().Fields(), func(field arrow.Field, _ int) string { return field.Name })
+	s.Equal([]string{"vector", "fp16", "binary", "sparse", "name", "age", "tags", "json", "clips", dynamicFieldName}, names)
+
+	columnOf := func(name string) arrow.Array {
+		indices := table.Schema().FieldIndices(name)
+		s.Require().Len(indices, 1)
+		return table.Column(indices[0]).Data().Chunk(0)
+	}
+	s.Equal(arrow.LIST, columnOf("vector").DataType().ID())
+	s.Equal(arrow.PrimitiveTypes.Uint8, columnOf("fp16").DataType().(*arrow.ListType).Elem())
+	fp16 := []byte(entity.FloatVector([]float32{0.5, 1}).ToFloat16Vector())
+	s.Equal(append(append([]byte{}, fp16...), fp16...), columnOf("fp16").(*array.List).ListValues().(*array.Uint8).Uint8Values())
+	s.Equal([]uint8{1, 2, 1, 2}, columnOf("binary").(*array.List).ListValues().(*array.Uint8).Uint8Values())
+	s.Equal(`{"indices":[1,10],"values":[0.5,0.2]}`, columnOf("sparse").(*array.String).Value(0))
+	s.Equal("foo", columnOf("name").(*array.String).Value(0))
+	s.True(columnOf("name").IsNull(1))
+	s.Equal([]int16{0, 1}, columnOf("age").(*array.Int16).Int16Values())
+	s.Equal([]int32{1, 2, 1, 2}, columnOf("tags").(*array.List).ListValues().(*array.Int32).Int32Values())
+	s.Equal(`{"a": 1}`, columnOf("json").(*array.String).Value(0))
+	s.Equal(arrow.STRUCT, columnOf("clips").DataType().(*arrow.ListType).Elem().ID())
+	s.Equal(`{"extra":"dynamic"}`, columnOf(dynamicFieldName).(*array.String).Value(0))
+}
+
+func (s *BulkWriterSuite) TestValidation() {
+	ctx := context.Background()
+	writer, err := NewLocalBulkWriter(NewBulkWriterOption(s.schema, s.T().TempDir()))
+	s.Require().NoError(err)
+
+	s.Run("wrong_dim", func() {
+		row := s.row(1)
+		row["vector"] = []float32{0.1}
+		s.Error(writer.AppendRow(ctx, row))
+	})
+
+	s.Run("wrong_type", func() {
+		row := s.row(1)
+		row["id"] = "1"
+		s.Error(writer.AppendRow(ctx, row))
+	})
+
+	s.Run("integral_numbers", func() {
+		for _, id := range []any{float64(2), float32(3), uint(4), uint64(5)} {
+			row := s.row(1)
+			row["id"] = id
+			row["tags"] = []any{float64(1), uint64(2)}
+			s.NoError(writer.AppendRow(ctx, row), "%T", id)
+		}
+		for _, id := range []any{1.5, 1e19, uint64(1 << 63)} {
+			row := s.row(1)
+			row["id"] = id
+			s.Error(writer.AppendRow(ctx, row), "%v", id)
+		}
+		row := s.row(1)
+		row["tags"] = []any{float64(1 << 31)}
+		s.Error(writer.AppendRow(ctx, row))
+	})
+
+	s.Run("exceed_max_length", func() {
+		row := s.row(1)
+		row["name"] = "too_long_value"
+		s.Error(writer.AppendRow(ctx, row))
+	})
+
+	s.Run("exceed_max_capacity", func() {
+		row := s.row(1)
+		row["tags"] = []int32{1, 2, 3, 4}
+		s.Error(writer.AppendRow(ctx, row))
+	})
+
+	s.Run("missing_field", func() {
+		row := s.row(1)
+		delete(row, "vector")
+		s.Error(writer.AppendRow(ctx, row))
+	})
+
+	s.Run("nullable_missing", func() {
+		row := s.row(1)
+		delete(row, "name")
+		s.NoError(writer.AppendRow(ctx, row))
+	})
+
+	s.Run("duplicated_dynamic_key", func() {
+		row := s.row(1)
+		row[dynamicFieldName] = map[string]any{"extra": 1}
+		s.Error(writer.AppendRow(ctx, row))
+	})
+
+	s.Run("dynamic_disabled", func() {
+		schema := entity.NewSchema().WithName("no_dynamic").
+			WithField(entity.NewField().WithName("id").WithDataType(entity.FieldTypeInt64).WithIsPrimaryKey(true).WithIsAutoID(true)).
+			WithField(entity.NewField().WithName("vector").WithDataType(entity.FieldTypeBinaryVector).WithDim(16))
+		w, err := NewLocalBulkWriter(NewBulkWriterOption(schema, s.T().TempDir()))
+		s.Require().NoError(err)
+		s.NoError(w.AppendRow(ctx, map[string]any{"vector": []byte{1, 2}}))
+		s.Error(w.AppendRow(ctx, map[string]any{"vector": []byte{1, 2}, "extra": 1}))
+		// auto id primary key shall not be provided
+		s.Error(w.AppendRow(ctx, map[string]any{"id": int64(1), "vector": []byte{1, 2}}))
+	})
+}
+
+func (s *BulkWriterSuite) TestStructArray() {
+	ctx := context.Background()
+	schema := entity.NewSchema().WithName("struct_array").
+		WithField(entity.NewField().WithName("id").WithDataType(entity.FieldTypeInt64).WithIsPrimaryKey(true)).
+		WithField(entity.NewField().WithName("vector").WithDataType(entity.FieldTypeFloatVector).WithDim(2)).
+		WithField(entity.NewField().WithName("clips").WithDataType(entity.FieldTypeArray).WithElementType(entity.FieldTypeStruct).WithMaxCapacity(4).
+			WithStructSchema(entity.NewStructSchema().
+				WithField(entity.NewField().WithName("frame").WithDataType(entity.FieldTypeInt32)).
+				WithField(entity.NewField().WithName("embedding").WithDataType(entity.FieldTypeFloatVector).WithDim(2))))
+	writer, err := NewLocalBulkWriter(NewBulkWriterOption(schema, s.T().TempDir()))
+	s.Require().NoError(err)
+
+	s.NoError(writer.AppendRow(ctx, map[string]any{
+		"id":     int64(1),
+		"vector": []float32{1, 2},
+		"clips": []map[string]any{
+			{"frame": int32(1), "embedding": []float32{0.1, 0.2}},
+			{"frame": int32(2), "embedding": []float32{0.3, 0.4}},
+		},
+	}))
+	s.Error(writer.AppendRow(ctx, map[string]any{
+		"id":     int64(2),
+		"vector": []float32{1, 2},
+		"clips":  []map[string]any{{"frame": int32(1)}},
+	}))
+}
+
+func (s *BulkWriterSuite) TestInvalidOption() {
+	_, err := NewLocalBulkWriter(nil)
+	s.Error(err)
+
+	_, err = NewLocalBulkWriter(NewBulkWriterOption(nil, s.T().TempDir()))
+	s.Error(err)
+
+	_, err = NewLocalBulkWriter(NewBulkWriterOption(s.schema, s.T().TempDir()).WithFileType(BulkFileType(100)))
+	s.Error(err)
+
+	_, err = NewLocalBulkWriter(NewBulkWriterOption(s.schema, s.T().TempDir()).WithChunkSize(0))
+	s.Error(err)
+
+	_, err = NewLocalBulkWriter(NewBulkWriterOption(s.schema, s.T().TempDir()).WithFileType(CSVFile).WithCSVSeparator('"'))
+	s.Error(err)
+
+	_, err = NewRemoteBulkWriter(NewBulkWriterOption(s.schema, "bulk"), nil)
+	s.Error(err)
+}
+
+func TestBulkWriter(t *testing.T) {
+	suite.Run(t, new(BulkWriterSuite))
+}
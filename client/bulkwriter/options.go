@@ -0,0 +1,129 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bulkwriter
+
+import (
+	"context"
+
+	"github.com/milvus-io/milvus/client/v3/entity"
+)
+
+// BulkFileType is the file format produced by bulk writers.
+type BulkFileType int
+
+const (
+	// JSONFile produces row-based JSON files, `[{...}, {...}]`.
+	JSONFile BulkFileType = iota + 1
+	// CSVFile produces CSV files with a header line of field names.
+	CSVFile
+	// ParquetFile produces parquet files, one column per field.
+	ParquetFile
+)
+
+// Suffix returns the file extension recognized by the import service.
+func (t BulkFileType) Suffix() string {
+	switch t {
+	case JSONFile:
+		return ".json"
+	case CSVFile:
+		return ".csv"
+	case ParquetFile:
+		return ".parquet"
+	default:
+		return ""
+	}
+}
+
+func (t BulkFileType) String() string {
+	switch t {
+	case JSONFile:
+		return "JSON"
+	case CSVFile:
+		return "CSV"
+	case ParquetFile:
+		return "PARQUET"
+	default:
+		return "Unknown"
+	}
+}
+
+const (
+	// DefaultChunkSize is the buffered data size which triggers a new file, 128MB.
+	DefaultChunkSize = 128 * 1024 * 1024
+	// DefaultCSVSeparator is the default csv separator, same as the import service default.
+	DefaultCSVSeparator = ','
+	// DefaultCSVNullKey is the default string representing null values in csv files.
+	DefaultCSVNullKey = ""
+)
+
+// ObjectWriter is the minimal storage backend used by RemoteBulkWriter.
+// Its method set is a subset of the server side storage.ChunkManager,
+// so a ChunkManager or any object storage adapter could be used directly.
+type ObjectWriter interface {
+	Write(ctx context.Context, filePath string, content []byte) error
+}
+
+// BulkWriterOption is the option for LocalBulkWriter and RemoteBulkWriter.
+type BulkWriterOption struct {
+	Schema    *entity.Schema
+	Path      string
+	FileType  BulkFileType
+	ChunkSize int64
+
+	CSVSeparator rune
+	CSVNullKey   string
+}
+
+// WithFileType sets the output file format, JSONFile by default.
+func (opt *BulkWriterOption) WithFileType(fileType BulkFileType) *BulkWriterOption {
+	opt.FileType = fileType
+	return opt
+}
+
+// WithChunkSize sets the buffered data size in bytes which triggers a new file.
+func (opt *BulkWriterOption) WithChunkSize(size int64) *BulkWriterOption {
+	opt.ChunkSize = size
+	return opt
+}
+
+// WithCSVSeparator sets the separator used by CSVFile output.
+// The import request shall carry the same value in the `sep` option.
+func (opt *BulkWriterOption) WithCSVSeparator(sep rune) *BulkWriterOption {
+	opt.CSVSeparator = sep
+	return opt
+}
+
+// WithCSVNullKey sets the string representing null values in CSVFile output.
+// The import request shall carry the same value in the `nullkey` option.
+func (opt *BulkWriterOption) WithCSVNullKey(nullKey string) *BulkWriterOption {
+	opt.CSVNullKey = nullKey
+	return opt
+}
+
+// NewBulkWriterOption returns BulkWriterOption with default values.
+// For LocalBulkWriter path is a local directory, for RemoteBulkWriter
+// path is the object key prefix in the target bucket.
+func NewBulkWriterOption(schema *entity.Schema, path string) *BulkWriterOption {
+	return &BulkWriterOption{
+		Schema:       schema,
+		Path:         path,
+		FileType:     JSONFile,
+		ChunkSize:    DefaultChunkSize,
+		CSVSeparator: DefaultCSVSeparator,
+		CSVNullKey:   DefaultCSVNullKey,
+	}
+}
@@ -0,0 +1,368 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bulkwriter
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/apache/arrow/go/v17/arrow"
+	"github.com/apache/arrow/go/v17/arrow/array"
+	"github.com/apache/arrow/go/v17/arrow/memory"
+	"github.com/apache/arrow/go/v17/parquet"
+	"github.com/apache/arrow/go/v17/parquet/pqarrow"
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus/client/v3/entity"
+)
+
+// newArrowSchema returns the schema of parquet files, the arrow types are the same
+// as the ones the parquet reader of the import service expects:
+//   - JSON, sparse vector and dynamic field values are JSON strings
+//   - binary, float16 and bfloat16 vectors are lists of bytes
+//   - struct array is a list of structs, each sub-field is a column of the struct
+func newArrowSchema(fields []*entity.Field, dynamicEnable bool) (*arrow.Schema, error) {
+	arrFields := make([]arrow.Field, 0, len(fields)+1)
+	for _, field := range fields {
+		dataType, err := arrowDataType(field, field.DataType)
+		if err != nil {
+			return nil, err
+		}
+		arrFields = append(arrFields, arrow.Field{
+			Name:     field.Name,
+			Type:     dataType,
+			Nullable: field.Nullable || field.DefaultValue != nil,
+		})
+	}
+	if dynamicEnable {
+		arrFields = append(arrFields, arrow.Field{
+			Name:     dynamicFieldName,
+			Type:     arrow.BinaryTypes.String,
+			Nullable: true,
+		})
+	}
+	return arrow.NewSchema(arrFields, nil), nil
+}
+
+func arrowDataType(field *entity.Field, dataType entity.FieldType) (arrow.DataType, error) {
+	switch dataType {
+	case entity.FieldTypeBool:
+		return arrow.FixedWidthTypes.Boolean, nil
+	case entity.FieldTypeInt8:
+		return arrow.PrimitiveTypes.Int8, nil
+	case entity.FieldTypeInt16:
+		return arrow.PrimitiveTypes.Int16, nil
+	case entity.FieldTypeInt32:
+		return arrow.PrimitiveTypes.Int32, nil
+	case entity.FieldTypeInt64:
+		return arrow.PrimitiveTypes.Int64, nil
+	case entity.FieldTypeFloat:
+		return arrow.PrimitiveTypes.Float32, nil
+	case entity.FieldTypeDouble:
+		return arrow.PrimitiveTypes.Float64, nil
+	case entity.FieldTypeVarChar, entity.FieldTypeString, entity.FieldTypeText, entity.FieldTypeTimestamptz,
+		entity.FieldTypeJSON, entity.FieldTypeGeometry, entity.FieldTypeSparseVector:
+		return arrow.BinaryTypes.String, nil
+	case entity.FieldTypeBinaryVector, entity.FieldTypeFloat16Vector, entity.FieldTypeBFloat16Vector:
+		return arrow.ListOf(arrow.PrimitiveTypes.Uint8), nil
+	case entity.FieldTypeFloatVector:
+		return arrow.ListOf(arrow.PrimitiveTypes.Float32), nil
+	case entity.FieldTypeInt8Vector:
+		return arrow.ListOf(arrow.PrimitiveTypes.Int8), nil
+	case entity.FieldTypeArray:
+		if field.ElementType == entity.FieldTypeStruct {
+			return structArrayDataType(field)
+		}
+		elemType, err := arrowDataType(field, field.ElementType)
+		if err != nil {
+			return nil, err
+		}
+		return arrow.ListOf(elemType), nil
+	default:
+		return nil, errors.Newf("field '%s' with data type %s is not supported by parquet bulk writer", field.Name, dataType.Name())
+	}
+}
+
+func structArrayDataType(field *entity.Field) (arrow.DataType, error) {
+	if field.StructSchema == nil {
+		return nil, errors.Newf("struct array field '%s' has no struct schema", field.Name)
+	}
+	subFields := make([]arrow.Field, 0, len(field.StructSchema.Fields))
+	for _, sub := range field.StructSchema.Fields {
+		dataType, err := arrowDataType(sub, sub.DataType)
+		if err != nil {
+			return nil, err
+		}
+		subFields = append(subFields, arrow.Field{
+			Name:     sub.Name,
+			Type:     dataType,
+			Nullable: sub.Nullable,
+		})
+	}
+	return arrow.ListOf(arrow.StructOf(subFields...)), nil
+}
+
+// toArrowValue converts a normalized value into the value appended into arrow builders.
+func toArrowValue(field *entity.Field, dataType entity.FieldType, value any) (any, error) {
+	if value == nil {
+		return nil, nil
+	}
+	switch dataType {
+	case entity.FieldTypeBinaryVector:
+		vec := value.([]int)
+		bs := make([]byte, len(vec))
+		for i, b := range vec {
+			bs[i] = byte(b)
+		}
+		return bs, nil
+	case entity.FieldTypeFloat16Vector:
+		return []byte(entity.FloatVector(value.([]float32)).ToFloat16Vector()), nil
+	case entity.FieldTypeBFloat16Vector:
+		return []byte(entity.FloatVector(value.([]float32)).ToBFloat16Vector()), nil
+	case entity.FieldTypeSparseVector:
+		return toJSONString(field.Name, value)
+	case entity.FieldTypeArray:
+		if field.ElementType != entity.FieldTypeStruct {
+			return value, nil
+		}
+		elements := value.([]map[string]any)
+		result := make([]map[string]any, 0, len(elements))
+		for _, element := range elements {
+			converted := make(map[string]any, len(element))
+			for _, sub := range field.StructSchema.Fields {
+				data, err := toArrowValue(sub, sub.DataType, element[sub.Name])
+				if err != nil {
+					return nil, err
+				}
+				converted[sub.Name] = data
+			}
+			result = append(result, converted)
+		}
+		return result, nil
+	default:
+		return value, nil
+	}
+}
+
+func toJSONString(name string, value any) (string, error) {
+	bs, err := json.Marshal(value)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to encode value of field '%s'", name)
+	}
+	return string(bs), nil
+}
+
+// appendArrowValue appends one value converted by toArrowValue into the builder.
+func appendArrowValue(builder array.Builder, value any) error {
+	if value == nil {
+		builder.AppendNull()
+		return nil
+	}
+	switch b := builder.(type) {
+	case *array.BooleanBuilder:
+		v, ok := value.(bool)
+		if !ok {
+			return arrowTypeError(builder, value)
+		}
+		b.Append(v)
+	case *array.Int8Builder:
+		v, ok := toInt64(value)
+		if !ok {
+			return arrowTypeError(builder, value)
+		}
+		b.Append(int8(v))
+	case *array.Int16Builder:
+		v, ok := toInt64(value)
+		if !ok {
+			return arrowTypeError(builder, value)
+		}
+		b.Append(int16(v))
+	case *array.Int32Builder:
+		v, ok := toInt64(value)
+		if !ok {
+			return arrowTypeError(builder, value)
+		}
+		b.Append(int32(v))
+	case *array.Int64Builder:
+		v, ok := toInt64(value)
+		if !ok {
+			return arrowTypeError(builder, value)
+		}
+		b.Append(v)
+	case *array.Uint8Builder:
+		v, ok := toInt64(value)
+		if !ok {
+			return arrowTypeError(builder, value)
+		}
+		b.Append(uint8(v))
+	case *array.Float32Builder:
+		v, ok := value.(float32)
+		if !ok {
+			return arrowTypeError(builder, value)
+		}
+		b.Append(v)
+	case *array.Float64Builder:
+		v, ok := value.(float64)
+		if !ok {
+			return arrowTypeError(builder, value)
+		}
+		b.Append(v)
+	case *array.StringBuilder:
+		v, ok := value.(string)
+		if !ok {
+			return arrowTypeError(builder, value)
+		}
+		b.Append(v)
+	case *array.ListBuilder:
+		return appendArrowList(b, value)
+	case *array.StructBuilder:
+		m, ok := value.(map[string]any)
+		if !ok {
+			return arrowTypeError(builder, value)
+		}
+		b.Append(true)
+		structType := b.Type().(*arrow.StructType)
+		for i, field := range structType.Fields() {
+			if err := appendArrowValue(b.FieldBuilder(i), m[field.Name]); err != nil {
+				return err
+			}
+		}
+	default:
+		return arrowTypeError(builder, value)
+	}
+	return nil
+}
+
+func appendArrowList(b *array.ListBuilder, value any) error {
+	// fast path for vectors
+	switch v := value.(type) {
+	case []float32:
+		if vb, ok := b.ValueBuilder().(*array.Float32Builder); ok {
+			b.Append(true)
+			vb.AppendValues(v, nil)
+			return nil
+		}
+	case []byte:
+		if vb, ok := b.ValueBuilder().(*array.Uint8Builder); ok {
+			b.Append(true)
+			vb.AppendValues(v, nil)
+			return nil
+		}
+	case []int8:
+		if vb, ok := b.ValueBuilder().(*array.Int8Builder); ok {
+			b.Append(true)
+			vb.AppendValues(v, nil)
+			return nil
+		}
+	}
+
+	elements, ok := toAnySlice(value)
+	if !ok {
+		return arrowTypeError(b, value)
+	}
+	b.Append(true)
+	for _, element := range elements {
+		if err := appendArrowValue(b.ValueBuilder(), element); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeParquet writes the rows into one parquet file.
+func writeParquet(schema *arrow.Schema, rows []map[string]any) ([]byte, error) {
+	builder := array.NewRecordBuilder(memory.DefaultAllocator, schema)
+	defer builder.Release()
+	for i, field := range schema.Fields() {
+		for _, row := range rows {
+			if err := appendArrowValue(builder.Field(i), row[field.Name]); err != nil {
+				return nil, errors.Wrapf(err, "failed to encode value of field '%s'", field.Name)
+			}
+		}
+	}
+	record := builder.NewRecord()
+	defer record.Release()
+
+	var out bytes.Buffer
+	w, err := pqarrow.NewFileWriter(schema, &out, parquet.NewWriterProperties(), pqarrow.DefaultWriterProps())
+	if err != nil {
+		return nil, err
+	}
+	if err := w.Write(record); err != nil {
+		w.Close()
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
+
+// estimateSize returns the approximate encoded size of a value, used to roll files.
+func estimateSize(value any) int64 {
+	switch v := value.(type) {
+	case nil:
+		return 0
+	case bool, int8, uint8:
+		return 1
+	case string:
+		return int64(len(v))
+	case []byte:
+		return int64(len(v))
+	case []int8:
+		return int64(len(v))
+	case []float32:
+		return int64(len(v)) * 4
+	case []any:
+		var size int64
+		for _, element := range v {
+			size += estimateSize(element)
+		}
+		return size
+	case []map[string]any:
+		var size int64
+		for _, element := range v {
+			size += estimateSize(element)
+		}
+		return size
+	case map[string]any:
+		var size int64
+		for _, element := range v {
+			size += estimateSize(element)
+		}
+		return size
+	default:
+		return 8
+	}
+}
+
+func toInt64(value any) (int64, bool) {
+	switch v := value.(type) {
+	case int8:
+		return int64(v), true
+	case uint8:
+		return int64(v), true
+	case int64:
+		return v, true
+	default:
+		return 0, false
+	}
+}
+
+func arrowTypeError(builder array.Builder, value any) error {
+	return errors.Newf("unexpected value type %T for arrow type %s", value, builder.Type())
+}
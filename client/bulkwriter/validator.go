@@ -0,0 +1,582 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bulkwriter
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
+
+	"github.com/milvus-io/milvus/client/v3/entity"
+)
+
+// dynamicFieldName is the reserved key for dynamic field values, same as the import service.
+const dynamicFieldName = "$meta"
+
+// sparseRow is the sparse vector layout accepted by the import service,
+// `{"indices": [...], "values": [...]}`.
+type sparseRow struct {
+	Indices []uint32  `json:"indices"`
+	Values  []float32 `json:"values"`
+}
+
+// rowValidator checks input rows against the collection schema and
+// normalizes values into the layout importutilv2 readers expect.
+type rowValidator struct {
+	fields        []*entity.Field
+	name2Field    map[string]*entity.Field
+	outputFields  map[string]struct{}
+	dynamicEnable bool
+}
+
+func newRowValidator(schema *entity.Schema) (*rowValidator, error) {
+	if schema == nil {
+		return nil, errors.New("bulk writer requires a collection schema")
+	}
+	if err := schema.Validate(); err != nil {
+		return nil, err
+	}
+	if !lo.ContainsBy(schema.Fields, func(field *entity.Field) bool { return field.PrimaryKey }) {
+		return nil, errors.New("primary key field not found in schema")
+	}
+
+	outputFields := make(map[string]struct{})
+	for _, function := range schema.Functions {
+		for _, name := range function.OutputFieldNames {
+			outputFields[name] = struct{}{}
+		}
+	}
+
+	v := &rowValidator{
+		name2Field:    make(map[string]*entity.Field),
+		outputFields:  outputFields,
+		dynamicEnable: schema.EnableDynamicField,
+	}
+	for _, field := range schema.Fields {
+		// dynamic field is filled by validator from redundant keys
+		if field.IsDynamic {
+			continue
+		}
+		v.fields = append(v.fields, field)
+		v.name2Field[field.Name] = field
+	}
+	return v, nil
+}
+
+// columnFields returns the ordered fields written into files, the dynamic field excluded.
+func (v *rowValidator) columnFields() []*entity.Field {
+	return lo.Filter(v.fields, func(field *entity.Field, _ int) bool {
+		return !v.skipField(field)
+	})
+}
+
+// columnNames returns the ordered field names written into files, used as csv header.
+func (v *rowValidator) columnNames() []string {
+	names := make([]string, 0, len(v.fields)+1)
+	for _, field := range v.columnFields() {
+		names = append(names, field.Name)
+	}
+	if v.dynamicEnable {
+		names = append(names, dynamicFieldName)
+	}
+	return names
+}
+
+// skipField returns true for fields whose values are generated by the server.
+func (v *rowValidator) skipField(field *entity.Field) bool {
+	if field.PrimaryKey && field.AutoID {
+		return true
+	}
+	_, ok := v.outputFields[field.Name]
+	return ok
+}
+
+// normalize validates one row and returns a new row that could be encoded into import files.
+func (v *rowValidator) normalize(row map[string]any) (map[string]any, error) {
+	out := make(map[string]any, len(row))
+	dynamicValues := make(map[string]any)
+	for key, value := range row {
+		field, ok := v.name2Field[key]
+		switch {
+		case ok && field.PrimaryKey && field.AutoID:
+			return nil, errors.Newf("the primary key '%s' is auto-generated, no need to provide", key)
+		case ok && v.skipField(field):
+			return nil, errors.Newf("not allowed to provide data for function output field '%s'", key)
+		case ok:
+			data, err := normalizeValue(field, value)
+			if err != nil {
+				return nil, err
+			}
+			out[key] = data
+		case key == dynamicFieldName && v.dynamicEnable:
+			meta, err := toJSONObject(value)
+			if err != nil {
+				return nil, errors.Wrap(err, "illegal value for dynamic field")
+			}
+			for k, mv := range meta {
+				if _, dup := row[k]; dup {
+					return nil, errors.Newf("duplicated key is not allowed, key=%s", k)
+				}
+				dynamicValues[k] = mv
+			}
+		case v.dynamicEnable:
+			dynamicValues[key] = value
+		default:
+			return nil, errors.Newf("field '%s' is not defined in schema and dynamic field is disabled", key)
+		}
+	}
+
+	for _, field := range v.fields {
+		if _, ok := out[field.Name]; ok || v.skipField(field) {
+			continue
+		}
+		if !field.Nullable && field.DefaultValue == nil {
+			return nil, errors.Newf("value of field '%s' is missed", field.Name)
+		}
+		out[field.Name] = nil
+	}
+
+	if v.dynamicEnable {
+		out[dynamicFieldName] = dynamicValues
+	}
+	return out, nil
+}
+
+func normalizeValue(field *entity.Field, value any) (any, error) {
+	if value == nil || isNilPointer(value) {
+		if field.Nullable || field.DefaultValue != nil {
+			return nil, nil
+		}
+		return nil, errors.Newf("field '%s' is not nullable but got null value", field.Name)
+	}
+	value = derefPointer(value)
+
+	switch field.DataType {
+	case entity.FieldTypeArray:
+		if field.ElementType == entity.FieldTypeStruct {
+			return normalizeStructArray(field, value)
+		}
+		return normalizeArray(field, value)
+	default:
+		return normalizeElement(field, field.DataType, value)
+	}
+}
+
+// normalizeElement converts a single (non-array) value of data type dataType.
+func normalizeElement(field *entity.Field, dataType entity.FieldType, value any) (any, error) {
+	switch dataType {
+	case entity.FieldTypeBool:
+		b, ok := value.(bool)
+		if !ok {
+			return nil, typeError(field, dataType, value)
+		}
+		return b, nil
+	case entity.FieldTypeInt8:
+		return toInt(field, dataType, value, math.MinInt8, math.MaxInt8)
+	case entity.FieldTypeInt16:
+		return toInt(field, dataType, value, math.MinInt16, math.MaxInt16)
+	case entity.FieldTypeInt32:
+		return toInt(field, dataType, value, math.MinInt32, math.MaxInt32)
+	case entity.FieldTypeInt64:
+		return toInt(field, dataType, value, math.MinInt64, math.MaxInt64)
+	case entity.FieldTypeFloat:
+		f, err := toFloat(field, dataType, value)
+		if err != nil {
+			return nil, err
+		}
+		if math.Abs(f) > math.MaxFloat32 {
+			return nil, errors.Newf("value %v of field '%s' overflows float32", f, field.Name)
+		}
+		return float32(f), nil
+	case entity.FieldTypeDouble:
+		return toFloat(field, dataType, value)
+	case entity.FieldTypeVarChar, entity.FieldTypeString:
+		s, ok := value.(string)
+		if !ok {
+			return nil, typeError(field, dataType, value)
+		}
+		if maxLength, ok := intTypeParam(field, entity.TypeParamMaxLength); ok && int64(len(s)) > maxLength {
+			return nil, errors.Newf("value length(%d) for field %s exceeds max_length(%d)", len(s), field.Name, maxLength)
+		}
+		return s, nil
+	case entity.FieldTypeText, entity.FieldTypeGeometry:
+		s, ok := value.(string)
+		if !ok {
+			return nil, typeError(field, dataType, value)
+		}
+		return s, nil
+	case entity.FieldTypeTimestamptz:
+		switch t := value.(type) {
+		case string:
+			return t, nil
+		case time.Time:
+			return t.Format(time.RFC3339Nano), nil
+		default:
+			return nil, typeError(field, dataType, value)
+		}
+	case entity.FieldTypeJSON:
+		return toJSONValue(field, value)
+	case entity.FieldTypeFloatVector:
+		vec, err := toFloat32Slice(field, dataType, value)
+		if err != nil {
+			return nil, err
+		}
+		return vec, checkDim(field, len(vec))
+	case entity.FieldTypeFloat16Vector, entity.FieldTypeBFloat16Vector:
+		var vec []float32
+		switch t := value.(type) {
+		case entity.Float16Vector:
+			vec = t.ToFloat32Vector()
+		case entity.BFloat16Vector:
+			vec = t.ToFloat32Vector()
+		default:
+			var err error
+			if vec, err = toFloat32Slice(field, dataType, value); err != nil {
+				return nil, err
+			}
+		}
+		return vec, checkDim(field, len(vec))
+	case entity.FieldTypeBinaryVector:
+		var bs []byte
+		switch t := value.(type) {
+		case []byte:
+			bs = t
+		case entity.BinaryVector:
+			bs = t
+		default:
+			return nil, typeError(field, dataType, value)
+		}
+		if err := checkDim(field, len(bs)*8); err != nil {
+			return nil, err
+		}
+		// encode as number list, json.Marshal encodes []byte as base64 string
+		vec := make([]int, len(bs))
+		for i, b := range bs {
+			vec[i] = int(b)
+		}
+		return vec, nil
+	case entity.FieldTypeInt8Vector:
+		var vec []int8
+		switch t := value.(type) {
+		case []int8:
+			vec = t
+		case entity.Int8Vector:
+			vec = t
+		default:
+			return nil, typeError(field, dataType, value)
+		}
+		return vec, checkDim(field, len(vec))
+	case entity.FieldTypeSparseVector:
+		return toSparseRow(field, value)
+	default:
+		return nil, errors.Newf("field '%s' with data type %s is not supported by bulk writer", field.Name, dataType.Name())
+	}
+}
+
+func normalizeArray(field *entity.Field, value any) (any, error) {
+	elements, ok := toAnySlice(value)
+	if !ok {
+		return nil, typeError(field, field.DataType, value)
+	}
+	if err := checkCapacity(field, len(elements)); err != nil {
+		return nil, err
+	}
+	result := make([]any, 0, len(elements))
+	for _, element := range elements {
+		data, err := normalizeElement(field, field.ElementType, derefPointer(element))
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, data)
+	}
+	return result, nil
+}
+
+// normalizeStructArray converts struct array value, a list of `{sub-field: value}` maps.
+func normalizeStructArray(field *entity.Field, value any) (any, error) {
+	if field.StructSchema == nil {
+		return nil, errors.Newf("struct array field '%s' has no struct schema", field.Name)
+	}
+	elements, ok := toAnySlice(value)
+	if !ok {
+		return nil, typeError(field, field.DataType, value)
+	}
+	if err := checkCapacity(field, len(elements)); err != nil {
+		return nil, err
+	}
+	result := make([]map[string]any, 0, len(elements))
+	for i, element := range elements {
+		m, ok := element.(map[string]any)
+		if !ok {
+			return nil, errors.Newf("invalid element in struct array field '%s', expect map[string]any but got type %T", field.Name, element)
+		}
+		if len(m) != len(field.StructSchema.Fields) {
+			return nil, errors.Newf("inconsistent field count in struct array field '%s' element: position=%d, actual=%d, expected=%d",
+				field.Name, i, len(m), len(field.StructSchema.Fields))
+		}
+		converted := make(map[string]any, len(m))
+		for _, sub := range field.StructSchema.Fields {
+			subValue, ok := m[sub.Name]
+			if !ok || subValue == nil {
+				return nil, errors.Newf("sub-field '%s' of struct array field '%s' is missed at position %d", sub.Name, field.Name, i)
+			}
+			data, err := normalizeElement(sub, sub.DataType, derefPointer(subValue))
+			if err != nil {
+				return nil, err
+			}
+			converted[sub.Name] = data
+		}
+		result = append(result, converted)
+	}
+	return result, nil
+}
+
+func toInt(field *entity.Field, dataType entity.FieldType, value any, minValue, maxValue int64) (int64, error) {
+	var n int64
+	switch t := value.(type) {
+	case int:
+		n = int64(t)
+	case int8:
+		n = int64(t)
+	case int16:
+		n = int64(t)
+	case int32:
+		n = int64(t)
+	case int64:
+		n = t
+	case uint8:
+		n = int64(t)
+	case uint16:
+		n = int64(t)
+	case uint32:
+		n = int64(t)
+	case uint:
+		if uint64(t) > math.MaxInt64 {
+			return 0, errors.Newf("value %d of field '%s' is out of range for %s", t, field.Name, dataType.Name())
+		}
+		n = int64(t)
+	case uint64:
+		if t > math.MaxInt64 {
+			return 0, errors.Newf("value %d of field '%s' is out of range for %s", t, field.Name, dataType.Name())
+		}
+		n = int64(t)
+	case float32:
+		return toInt(field, dataType, float64(t), minValue, maxValue)
+	case float64:
+		// the numbers decoded from JSON are float64, accept them if they are integral.
+		if t != math.Trunc(t) {
+			return 0, errors.Newf("value %v of field '%s' is not an integer for %s", t, field.Name, dataType.Name())
+		}
+		if t < math.MinInt64 || t >= math.MaxInt64 {
+			return 0, errors.Newf("value %v of field '%s' is out of range for %s", t, field.Name, dataType.Name())
+		}
+		n = int64(t)
+	case json.Number:
+		var err error
+		if n, err = strconv.ParseInt(t.String(), 0, 64); err != nil {
+			return 0, typeError(field, dataType, value)
+		}
+	default:
+		return 0, typeError(field, dataType, value)
+	}
+	if n < minValue || n > maxValue {
+		return 0, errors.Newf("value %d of field '%s' is out of range for %s", n, field.Name, dataType.Name())
+	}
+	return n, nil
+}
+
+func toFloat(field *entity.Field, dataType entity.FieldType, value any) (float64, error) {
+	var f float64
+	switch t := value.(type) {
+	case float32:
+		f = float64(t)
+	case float64:
+		f = t
+	case int:
+		f = float64(t)
+	case int32:
+		f = float64(t)
+	case int64:
+		f = float64(t)
+	case json.Number:
+		var err error
+		if f, err = t.Float64(); err != nil {
+			return 0, typeError(field, dataType, value)
+		}
+	default:
+		return 0, typeError(field, dataType, value)
+	}
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return 0, errors.Newf("value of field '%s' is NaN or Inf", field.Name)
+	}
+	return f, nil
+}
+
+func toFloat32Slice(field *entity.Field, dataType entity.FieldType, value any) ([]float32, error) {
+	var vec []float32
+	switch t := value.(type) {
+	case []float32:
+		vec = t
+	case entity.FloatVector:
+		vec = t
+	case []float64:
+		vec = make([]float32, len(t))
+		for i, f := range t {
+			vec[i] = float32(f)
+		}
+	default:
+		return nil, typeError(field, dataType, value)
+	}
+	for _, f := range vec {
+		if math.IsNaN(float64(f)) || math.IsInf(float64(f), 0) {
+			return nil, errors.Newf("vector of field '%s' contains NaN or Inf", field.Name)
+		}
+	}
+	return vec, nil
+}
+
+func toSparseRow(field *entity.Field, value any) (*sparseRow, error) {
+	row := &sparseRow{}
+	switch t := value.(type) {
+	case entity.SparseEmbedding:
+		for i := 0; i < t.Len(); i++ {
+			pos, v, _ := t.Get(i)
+			row.Indices = append(row.Indices, pos)
+			row.Values = append(row.Values, v)
+		}
+	case map[uint32]float32:
+		for pos := range t {
+			row.Indices = append(row.Indices, pos)
+		}
+		sort.Slice(row.Indices, func(i, j int) bool { return row.Indices[i] < row.Indices[j] })
+		for _, pos := range row.Indices {
+			row.Values = append(row.Values, t[pos])
+		}
+	default:
+		return nil, typeError(field, entity.FieldTypeSparseVector, value)
+	}
+	for _, v := range row.Values {
+		if math.IsNaN(float64(v)) || math.IsInf(float64(v), 0) {
+			return nil, errors.Newf("sparse vector of field '%s' contains NaN or Inf", field.Name)
+		}
+	}
+	return row, nil
+}
+
+// toJSONValue converts JSON field value into encoded JSON string, which is accepted
+// by both json and csv readers whatever the JSON value is an object or not.
+// []byte and string are treated as encoded JSON, other values are marshaled.
+func toJSONValue(field *entity.Field, value any) (string, error) {
+	var bs []byte
+	switch t := value.(type) {
+	case []byte:
+		bs = t
+	case string:
+		bs = []byte(t)
+	case json.RawMessage:
+		bs = t
+	default:
+		var err error
+		if bs, err = json.Marshal(value); err != nil {
+			return "", errors.Wrapf(err, "failed to marshal value of JSON field '%s'", field.Name)
+		}
+	}
+	if !json.Valid(bs) {
+		return "", errors.Newf("value of JSON field '%s' is not a valid JSON", field.Name)
+	}
+	return string(bs), nil
+}
+
+func toJSONObject(value any) (map[string]any, error) {
+	switch t := value.(type) {
+	case map[string]any:
+		return t, nil
+	case []byte:
+		m := make(map[string]any)
+		return m, json.Unmarshal(t, &m)
+	case string:
+		m := make(map[string]any)
+		return m, json.Unmarshal([]byte(t), &m)
+	default:
+		return nil, errors.Newf("expect JSON object, got type %T", value)
+	}
+}
+
+func toAnySlice(value any) ([]any, bool) {
+	if s, ok := value.([]any); ok {
+		return s, true
+	}
+	rv := reflect.ValueOf(value)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return nil, false
+	}
+	result := make([]any, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		result[i] = rv.Index(i).Interface()
+	}
+	return result, true
+}
+
+func isNilPointer(value any) bool {
+	rv := reflect.ValueOf(value)
+	return rv.Kind() == reflect.Ptr && rv.IsNil()
+}
+
+func derefPointer(value any) any {
+	rv := reflect.ValueOf(value)
+	if rv.Kind() == reflect.Ptr && !rv.IsNil() {
+		return rv.Elem().Interface()
+	}
+	return value
+}
+
+func intTypeParam(field *entity.Field, key string) (int64, bool) {
+	str, ok := field.TypeParams[key]
+	if !ok {
+		return 0, false
+	}
+	v, err := strconv.ParseInt(str, 10, 64)
+	return v, err == nil
+}
+
+func checkDim(field *entity.Field, dim int) error {
+	expect, err := field.GetDim()
+	if err != nil {
+		return err
+	}
+	if int64(dim) != expect {
+		return errors.Newf("expected dim '%d' for field '%s' with type '%s', got dim '%d'", expect, field.Name, field.DataType.Name(), dim)
+	}
+	return nil
+}
+
+func checkCapacity(field *entity.Field, length int) error {
+	if maxCapacity, ok := intTypeParam(field, entity.TypeParamMaxCapacity); ok && int64(length) > maxCapacity {
+		return errors.Newf("array capacity(%d) for field %s exceeds max_capacity(%d)", length, field.Name, maxCapacity)
+	}
+	return nil
+}
+
+func typeError(field *entity.Field, dataType entity.FieldType, value any) error {
+	return errors.Newf("expected type '%s' for field '%s', got type '%T' with value '%v'", dataType.Name(), field.Name, value, value)
+}
@@ -98,6 +98,8 @@ var (
 	ErrIndexNotFound            = newRPCError("index not found", 700, commonpb.ErrorCode_IndexNotExist, false, false)
 	ErrParameterInvalid         = newRPCError("invalid parameter", 1100, commonpb.ErrorCode_IllegalArgument, false, true)
 	ErrParameterMissing         = newRPCError("missing required parameters", 1101, commonpb.ErrorCode_IllegalArgument, false, true)
+	ErrTxnNotFound              = newRPCError("transaction not found", 2700, commonpb.ErrorCode_UnexpectedError, false, false)
+	ErrTxnExpired               = newRPCError("transaction expired", 2701, commonpb.ErrorCode_UnexpectedError, false, false)
 
 	errUnexpected = newRPCError("unexpected error", unexpectedCode, commonpb.ErrorCode_UnexpectedError, false, false)
 )
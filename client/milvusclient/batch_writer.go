@@ -0,0 +1,333 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package milvusclient
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus/client/v3/internal/merr"
+)
+
+// BatchWriteFailure describes the rows of a batch which failed to be written.
+type BatchWriteFailure struct {
+	CollectionName string
+	PartitionName  string
+	Rows           []any
+	Err            error
+}
+
+// BatchWriter accumulates the rows written per collection and partition, and
+// inserts or upserts them in batches.
+//
+// A batch is sent once it reaches the row count or the byte size limit, or when
+// the flush interval elapses. At most `Concurrency` batches are in flight, the
+// writes block until a slot is released. The rate limited batches are retried
+// with the backoff of RetryOnRateLimitInterceptor, the other failures are
+// reported to the failure handler.
+type BatchWriter struct {
+	client *Client
+	option BatchWriterOption
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	// sem bounds the in-flight batches
+	sem  chan struct{}
+	done chan struct{}
+	wg   sync.WaitGroup
+
+	mu      sync.Mutex
+	buffers map[batchKey]*batchBuffer
+	err     error // the first failure not reported to a handler
+	closed  bool
+}
+
+type batchKey struct {
+	collectionName string
+	partitionName  string
+}
+
+type batchBuffer struct {
+	rows []any
+	size int64
+}
+
+type writeBatch struct {
+	key  batchKey
+	rows []any
+}
+
+// NewBatchWriter creates a BatchWriter, the writer shall be closed to flush the buffered rows.
+// The context is used by all the requests sent by the writer.
+func (c *Client) NewBatchWriter(ctx context.Context, option BatchWriterOption) (*BatchWriter, error) {
+	if option.MaxRows() <= 0 || option.MaxBytes() <= 0 || option.Concurrency() <= 0 {
+		return nil, errors.New("max rows, max bytes and concurrency of batch writer shall be positive")
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	w := &BatchWriter{
+		client:  c,
+		option:  option,
+		ctx:     ctx,
+		cancel:  cancel,
+		sem:     make(chan struct{}, option.Concurrency()),
+		done:    make(chan struct{}),
+		buffers: make(map[batchKey]*batchBuffer),
+	}
+	if option.FlushInterval() > 0 {
+		w.wg.Add(1)
+		go w.flushLoop()
+	}
+	return w, nil
+}
+
+// Write buffers the rows to write into the partition of the collection, the default
+// partition is used if the partition name is empty.
+// The rows shall be the structs or the maps accepted by NewRowBasedInsertOption.
+// Write blocks when the concurrency limit is reached, returns error if the context
+// is done before the full batches are sent.
+func (w *BatchWriter) Write(ctx context.Context, collectionName, partitionName string, rows ...any) error {
+	key := batchKey{collectionName: collectionName, partitionName: partitionName}
+	var batches []*writeBatch
+
+	w.mu.Lock()
+	if w.closed {
+		w.mu.Unlock()
+		return errors.New("batch writer is closed")
+	}
+	buffer, ok := w.buffers[key]
+	if !ok {
+		buffer = &batchBuffer{}
+		w.buffers[key] = buffer
+	}
+	for _, row := range rows {
+		buffer.rows = append(buffer.rows, row)
+		buffer.size += estimateRowSize(reflect.ValueOf(row))
+		if len(buffer.rows) >= w.option.MaxRows() || buffer.size >= w.option.MaxBytes() {
+			batches = append(batches, &writeBatch{key: key, rows: buffer.rows})
+			buffer.rows, buffer.size = nil, 0
+		}
+	}
+	w.mu.Unlock()
+
+	return w.sendAll(ctx, batches)
+}
+
+// Flush sends all the buffered rows and waits for the in-flight batches.
+// The first failure is returned if no failure handler is set.
+func (w *BatchWriter) Flush(ctx context.Context) error {
+	w.mu.Lock()
+	batches := w.drain()
+	w.mu.Unlock()
+
+	if err := w.sendAll(ctx, batches); err != nil {
+		return err
+	}
+	if err := w.wait(ctx); err != nil {
+		return err
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	err := w.err
+	w.err = nil
+	return err
+}
+
+// Close flushes the buffered rows and stops the writer, the writes after Close fail.
+func (w *BatchWriter) Close(ctx context.Context) error {
+	w.mu.Lock()
+	if w.closed {
+		w.mu.Unlock()
+		return nil
+	}
+	w.closed = true
+	w.mu.Unlock()
+
+	close(w.done)
+	w.wg.Wait()
+	defer w.cancel()
+	return w.Flush(ctx)
+}
+
+// drain takes all the non-empty buffers as batches, the caller shall hold the lock.
+func (w *BatchWriter) drain() []*writeBatch {
+	batches := make([]*writeBatch, 0, len(w.buffers))
+	for key, buffer := range w.buffers {
+		if len(buffer.rows) > 0 {
+			batches = append(batches, &writeBatch{key: key, rows: buffer.rows})
+		}
+		delete(w.buffers, key)
+	}
+	return batches
+}
+
+func (w *BatchWriter) flushLoop() {
+	defer w.wg.Done()
+	ticker := time.NewTicker(w.option.FlushInterval())
+	defer ticker.Stop()
+	for {
+		select {
+		case <-w.done:
+			return
+		case <-w.ctx.Done():
+			return
+		case <-ticker.C:
+			w.mu.Lock()
+			batches := w.drain()
+			w.mu.Unlock()
+			w.sendAll(w.ctx, batches)
+		}
+	}
+}
+
+// sendAll sends the batches in background, the batches not sent before the context is done are failed.
+func (w *BatchWriter) sendAll(ctx context.Context, batches []*writeBatch) error {
+	for i, batch := range batches {
+		select {
+		case w.sem <- struct{}{}:
+		case <-ctx.Done():
+			for _, batch := range batches[i:] {
+				w.fail(batch, ctx.Err())
+			}
+			return ctx.Err()
+		}
+		go func(batch *writeBatch) {
+			defer func() { <-w.sem }()
+			if err := w.write(batch); err != nil {
+				w.fail(batch, err)
+			}
+		}(batch)
+	}
+	return nil
+}
+
+// wait waits until all the in-flight batches are done.
+func (w *BatchWriter) wait(ctx context.Context) error {
+	for i := 0; i < cap(w.sem); i++ {
+		select {
+		case w.sem <- struct{}{}:
+		case <-ctx.Done():
+			for ; i > 0; i-- {
+				<-w.sem
+			}
+			return ctx.Err()
+		}
+	}
+	for i := 0; i < cap(w.sem); i++ {
+		<-w.sem
+	}
+	return nil
+}
+
+// write writes the batch, retries with backoff while the batch is rate limited.
+func (w *BatchWriter) write(batch *writeBatch) error {
+	retry := w.client.config.RetryRateLimit
+	if retry == nil {
+		retry = w.client.config.defaultRetryRateLimitOption()
+	}
+	for attempt := uint(0); ; attempt++ {
+		if _, err := waitRetryBackoff(w.ctx, attempt, retry.MaxBackoff, rateLimitBackoff); err != nil {
+			return err
+		}
+		err := w.writeOnce(batch)
+		if err == nil || !isRateLimitErr(err) || !retryOnRateLimit(w.ctx) || attempt+1 >= retry.MaxRetry {
+			return err
+		}
+	}
+}
+
+func (w *BatchWriter) writeOnce(batch *writeBatch) error {
+	option := NewRowBasedInsertOption(batch.key.collectionName, batch.rows...).WithPartition(batch.key.partitionName)
+	if w.option.Upsert() {
+		_, err := w.client.Upsert(w.ctx, option)
+		return err
+	}
+	_, err := w.client.Insert(w.ctx, option)
+	return err
+}
+
+func (w *BatchWriter) fail(batch *writeBatch, err error) {
+	if handler := w.option.FailureHandler(); handler != nil {
+		handler(BatchWriteFailure{
+			CollectionName: batch.key.collectionName,
+			PartitionName:  batch.key.partitionName,
+			Rows:           batch.rows,
+			Err:            err,
+		})
+		return
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.err == nil {
+		w.err = err
+	}
+}
+
+func isRateLimitErr(err error) bool {
+	var rpcErr *merr.RPCError
+	return errors.As(err, &rpcErr) && rpcErr.LegacyCode() == commonpb.ErrorCode_RateLimit
+}
+
+// estimateRowSize estimates the bytes of the row, used to bound the size of the batches.
+func estimateRowSize(v reflect.Value) int64 {
+	switch v.Kind() {
+	case reflect.Invalid:
+		return 0
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return 0
+		}
+		return estimateRowSize(v.Elem())
+	case reflect.String:
+		return int64(v.Len())
+	case reflect.Slice, reflect.Array:
+		if v.Len() == 0 {
+			return 0
+		}
+		switch v.Type().Elem().Kind() {
+		case reflect.Bool, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Int,
+			reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uint,
+			reflect.Float32, reflect.Float64:
+			return int64(v.Len()) * int64(v.Type().Elem().Size())
+		}
+		var size int64
+		for i := 0; i < v.Len(); i++ {
+			size += estimateRowSize(v.Index(i))
+		}
+		return size
+	case reflect.Map:
+		var size int64
+		iter := v.MapRange()
+		for iter.Next() {
+			size += estimateRowSize(iter.Key()) + estimateRowSize(iter.Value())
+		}
+		return size
+	case reflect.Struct:
+		var size int64
+		for i := 0; i < v.NumField(); i++ {
+			size += estimateRowSize(v.Field(i))
+		}
+		return size
+	default:
+		return int64(v.Type().Size())
+	}
+}
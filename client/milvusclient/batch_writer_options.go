@@ -0,0 +1,120 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package milvusclient
+
+import "time"
+
+// BatchWriterOption is the interface for batch writer options.
+type BatchWriterOption interface {
+	// MaxRows returns the row count to send a batch
+	MaxRows() int
+	// MaxBytes returns the estimated byte size to send a batch
+	MaxBytes() int64
+	// FlushInterval returns the interval to send the buffered rows, zero disables the periodic flush
+	FlushInterval() time.Duration
+	// Concurrency returns the max count of in-flight batches
+	Concurrency() int
+	// Upsert returns whether the batches are upserted instead of inserted
+	Upsert() bool
+	// FailureHandler returns the handler called with the rows failed to be written
+	FailureHandler() func(BatchWriteFailure)
+}
+
+var _ BatchWriterOption = (*batchWriterOption)(nil)
+
+type batchWriterOption struct {
+	maxRows        int
+	maxBytes       int64
+	flushInterval  time.Duration
+	concurrency    int
+	upsert         bool
+	failureHandler func(BatchWriteFailure)
+}
+
+func (opt *batchWriterOption) MaxRows() int {
+	return opt.maxRows
+}
+
+func (opt *batchWriterOption) MaxBytes() int64 {
+	return opt.maxBytes
+}
+
+func (opt *batchWriterOption) FlushInterval() time.Duration {
+	return opt.flushInterval
+}
+
+func (opt *batchWriterOption) Concurrency() int {
+	return opt.concurrency
+}
+
+func (opt *batchWriterOption) Upsert() bool {
+	return opt.upsert
+}
+
+func (opt *batchWriterOption) FailureHandler() func(BatchWriteFailure) {
+	return opt.failureHandler
+}
+
+// WithMaxRows sets the row count to send a batch.
+func (opt *batchWriterOption) WithMaxRows(maxRows int) *batchWriterOption {
+	opt.maxRows = maxRows
+	return opt
+}
+
+// WithMaxBytes sets the estimated byte size to send a batch,
+// it shall be kept below the max gRPC message size of the server.
+func (opt *batchWriterOption) WithMaxBytes(maxBytes int64) *batchWriterOption {
+	opt.maxBytes = maxBytes
+	return opt
+}
+
+// WithFlushInterval sets the interval to send the buffered rows, zero disables the periodic flush.
+func (opt *batchWriterOption) WithFlushInterval(interval time.Duration) *batchWriterOption {
+	opt.flushInterval = interval
+	return opt
+}
+
+// WithConcurrency sets the max count of in-flight batches.
+func (opt *batchWriterOption) WithConcurrency(concurrency int) *batchWriterOption {
+	opt.concurrency = concurrency
+	return opt
+}
+
+// WithUpsert sets whether the batches are upserted instead of inserted.
+func (opt *batchWriterOption) WithUpsert(upsert bool) *batchWriterOption {
+	opt.upsert = upsert
+	return opt
+}
+
+// WithFailureHandler sets the handler called with the rows failed to be written.
+// The handler may be called concurrently. Without handler, the first failure is
+// returned by the next Flush or Close.
+func (opt *batchWriterOption) WithFailureHandler(handler func(BatchWriteFailure)) *batchWriterOption {
+	opt.failureHandler = handler
+	return opt
+}
+
+// NewBatchWriterOption creates the batch writer option with the default values:
+// 1000 rows or 16MB per batch, flushed every second with 4 in-flight batches.
+func NewBatchWriterOption() *batchWriterOption {
+	return &batchWriterOption{
+		maxRows:       1000,
+		maxBytes:      16 * 1024 * 1024,
+		flushInterval: time.Second,
+		concurrency:   4,
+	}
+}
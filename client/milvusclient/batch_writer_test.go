@@ -0,0 +1,218 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package milvusclient
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v3/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v3/schemapb"
+	"github.com/milvus-io/milvus/client/v3/entity"
+	"github.com/milvus-io/milvus/client/v3/internal/merr"
+)
+
+type batchWriterRow struct {
+	ID     int64     `milvus:"name:id"`
+	Vector []float32 `milvus:"name:vector"`
+}
+
+type BatchWriterSuite struct {
+	MockSuiteBase
+
+	schema *entity.Schema
+}
+
+func (s *BatchWriterSuite) SetupSuite() {
+	s.MockSuiteBase.SetupSuite()
+	s.schema = entity.NewSchema().
+		WithField(entity.NewField().WithName("id").WithDataType(entity.FieldTypeInt64).WithIsPrimaryKey(true)).
+		WithField(entity.NewField().WithName("vector").WithDataType(entity.FieldTypeFloatVector).WithDim(2))
+}
+
+func (s *BatchWriterSuite) rows(start, count int) []any {
+	rows := make([]any, 0, count)
+	for i := start; i < start+count; i++ {
+		rows = append(rows, &batchWriterRow{ID: int64(i), Vector: []float32{float32(i), 1}})
+	}
+	return rows
+}
+
+func (s *BatchWriterSuite) insertResult(ir *milvuspb.InsertRequest) *milvuspb.MutationResult {
+	var ids []int64
+	for _, fieldData := range ir.GetFieldsData() {
+		if fieldData.GetFieldName() == "id" {
+			ids = fieldData.GetScalars().GetLongData().GetData()
+		}
+	}
+	return &milvuspb.MutationResult{
+		Status:    merr.Success(),
+		InsertCnt: int64(ir.GetNumRows()),
+		IDs: &schemapb.IDs{
+			IdField: &schemapb.IDs_IntId{
+				IntId: &schemapb.LongArray{Data: ids},
+			},
+		},
+	}
+}
+
+func (s *BatchWriterSuite) TestBatching() {
+	ctx := context.Background()
+	collName := fmt.Sprintf("coll_%s", s.randString(6))
+	s.setupCache(collName, s.schema)
+
+	var mu sync.Mutex
+	batches := make(map[string][]uint32)
+	s.mock.EXPECT().Insert(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, ir *milvuspb.InsertRequest) (*milvuspb.MutationResult, error) {
+		s.Equal(collName, ir.GetCollectionName())
+		mu.Lock()
+		batches[ir.GetPartitionName()] = append(batches[ir.GetPartitionName()], ir.GetNumRows())
+		mu.Unlock()
+		return s.insertResult(ir), nil
+	})
+
+	writer, err := s.client.NewBatchWriter(ctx, NewBatchWriterOption().WithMaxRows(2).WithFlushInterval(0).WithConcurrency(2))
+	s.Require().NoError(err)
+	s.NoError(writer.Write(ctx, collName, "p1", s.rows(0, 5)...))
+	s.NoError(writer.Write(ctx, collName, "p2", s.rows(5, 1)...))
+	s.NoError(writer.Flush(ctx))
+
+	mu.Lock()
+	s.ElementsMatch([]uint32{2, 2, 1}, batches["p1"])
+	s.Equal([]uint32{1}, batches["p2"])
+	mu.Unlock()
+
+	s.NoError(writer.Close(ctx))
+	s.Error(writer.Write(ctx, collName, "p1", s.rows(0, 1)...))
+
+	s.Run("invalid_option", func() {
+		_, err := s.client.NewBatchWriter(ctx, NewBatchWriterOption().WithConcurrency(0))
+		s.Error(err)
+	})
+}
+
+func (s *BatchWriterSuite) TestFlushInterval() {
+	ctx := context.Background()
+	collName := fmt.Sprintf("coll_%s", s.randString(6))
+	s.setupCache(collName, s.schema)
+
+	inserted := make(chan uint32, 1)
+	s.mock.EXPECT().Insert(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, ir *milvuspb.InsertRequest) (*milvuspb.MutationResult, error) {
+		inserted <- ir.GetNumRows()
+		return s.insertResult(ir), nil
+	}).Once()
+
+	writer, err := s.client.NewBatchWriter(ctx, NewBatchWriterOption().WithFlushInterval(10*time.Millisecond))
+	s.Require().NoError(err)
+	defer writer.Close(ctx)
+	s.NoError(writer.Write(ctx, collName, "", s.rows(0, 3)...))
+
+	select {
+	case rows := <-inserted:
+		s.EqualValues(3, rows)
+	case <-time.After(5 * time.Second):
+		s.Fail("rows not flushed")
+	}
+}
+
+func (s *BatchWriterSuite) TestRetryAndFailure() {
+	ctx := context.Background()
+
+	s.Run("retry_on_rate_limit", func() {
+		defer s.resetMock()
+		collName := fmt.Sprintf("coll_%s", s.randString(6))
+		s.setupCache(collName, s.schema)
+
+		s.mock.EXPECT().Insert(mock.Anything, mock.Anything).Return(&milvuspb.MutationResult{
+			Status: s.getStatus(commonpb.ErrorCode_RateLimit, "rate limited"),
+		}, nil).Twice()
+		s.mock.EXPECT().Insert(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, ir *milvuspb.InsertRequest) (*milvuspb.MutationResult, error) {
+			return s.insertResult(ir), nil
+		}).Once()
+
+		writer, err := s.client.NewBatchWriter(ctx, NewBatchWriterOption().WithFlushInterval(0))
+		s.Require().NoError(err)
+		s.NoError(writer.Write(ctx, collName, "", s.rows(0, 3)...))
+		s.NoError(writer.Close(ctx))
+	})
+
+	s.Run("failure_handler", func() {
+		defer s.resetMock()
+		collName := fmt.Sprintf("coll_%s", s.randString(6))
+		s.setupCache(collName, s.schema)
+
+		s.mock.EXPECT().Insert(mock.Anything, mock.Anything).Return(&milvuspb.MutationResult{
+			Status: s.getStatus(commonpb.ErrorCode_UnexpectedError, "mocked"),
+		}, nil)
+
+		var failures []BatchWriteFailure
+		writer, err := s.client.NewBatchWriter(ctx, NewBatchWriterOption().WithFlushInterval(0).WithConcurrency(1).
+			WithFailureHandler(func(failure BatchWriteFailure) {
+				failures = append(failures, failure)
+			}))
+		s.Require().NoError(err)
+		rows := s.rows(0, 3)
+		s.NoError(writer.Write(ctx, collName, "p1", rows...))
+		s.NoError(writer.Close(ctx))
+
+		s.Require().Len(failures, 1)
+		s.Equal(collName, failures[0].CollectionName)
+		s.Equal("p1", failures[0].PartitionName)
+		s.Equal(rows, failures[0].Rows)
+		s.Error(failures[0].Err)
+	})
+
+	s.Run("failure_returned", func() {
+		defer s.resetMock()
+		collName := fmt.Sprintf("coll_%s", s.randString(6))
+		s.setupCache(collName, s.schema)
+
+		s.mock.EXPECT().Insert(mock.Anything, mock.Anything).Return(&milvuspb.MutationResult{
+			Status: s.getStatus(commonpb.ErrorCode_UnexpectedError, "mocked"),
+		}, nil)
+
+		writer, err := s.client.NewBatchWriter(ctx, NewBatchWriterOption().WithFlushInterval(0))
+		s.Require().NoError(err)
+		s.NoError(writer.Write(ctx, collName, "", s.rows(0, 3)...))
+		s.Error(writer.Flush(ctx))
+		// the failure is reported once
+		s.NoError(writer.Close(ctx))
+	})
+}
+
+func TestBatchWriter(t *testing.T) {
+	suite.Run(t, new(BatchWriterSuite))
+}
+
+func TestEstimateRowSize(t *testing.T) {
+	assert.EqualValues(t, 0, estimateRowSize(reflect.ValueOf(nil)))
+	assert.EqualValues(t, 8+3*4+5, estimateRowSize(reflect.ValueOf(&struct {
+		ID     int64
+		Vector []float32
+		Name   string
+	}{ID: 1, Vector: []float32{1, 2, 3}, Name: "hello"})))
+	assert.EqualValues(t, 2+8+1+3, estimateRowSize(reflect.ValueOf(map[string]any{"id": int64(1), "a": "abc"})))
+}
@@ -0,0 +1,143 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package milvusclient
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus/client/v3/column"
+	"github.com/milvus-io/milvus/client/v3/entity"
+	"github.com/milvus-io/milvus/client/v3/internal/merr"
+	"github.com/milvus-io/milvus/client/v3/proto/changestreampb"
+)
+
+// ChangeEventType is the type of a change event.
+type ChangeEventType string
+
+const (
+	ChangeEventInsert             ChangeEventType = "insert"
+	ChangeEventDelete             ChangeEventType = "delete"
+	ChangeEventDropCollection     ChangeEventType = "drop_collection"
+	ChangeEventTruncateCollection ChangeEventType = "truncate_collection"
+	ChangeEventCreatePartition    ChangeEventType = "create_partition"
+	ChangeEventDropPartition      ChangeEventType = "drop_partition"
+	ChangeEventSchemaChange       ChangeEventType = "schema_change"
+	ChangeEventAlterCollection    ChangeEventType = "alter_collection"
+	ChangeEventImport             ChangeEventType = "import"
+)
+
+var changeEventTypes = map[changestreampb.ChangeEventType]ChangeEventType{
+	changestreampb.ChangeEventType_Insert:             ChangeEventInsert,
+	changestreampb.ChangeEventType_Delete:             ChangeEventDelete,
+	changestreampb.ChangeEventType_DropCollection:     ChangeEventDropCollection,
+	changestreampb.ChangeEventType_TruncateCollection: ChangeEventTruncateCollection,
+	changestreampb.ChangeEventType_CreatePartition:    ChangeEventCreatePartition,
+	changestreampb.ChangeEventType_DropPartition:      ChangeEventDropPartition,
+	changestreampb.ChangeEventType_SchemaChange:       ChangeEventSchemaChange,
+	changestreampb.ChangeEventType_AlterCollection:    ChangeEventAlterCollection,
+	changestreampb.ChangeEventType_Import:             ChangeEventImport,
+}
+
+// ChangeEvent is a mutation of the subscribed collection.
+//
+// The events of a shard are delivered in order, there's no order between shards.
+// An upsert is delivered as a delete event of the old rows followed by an insert event
+// of the new rows with the same timestamp. An import event only notifies that the rows
+// of an import job are coming, the rows are not delivered by the stream.
+type ChangeEvent struct {
+	Type          ChangeEventType
+	Timestamp     uint64
+	VChannel      string
+	PartitionName string
+	// Columns is the inserted rows of insert event.
+	Columns []column.Column
+	// PrimaryKeys is the deleted primary keys of delete event.
+	PrimaryKeys column.Column
+	// Checkpoint is the position to resume the stream after the event, see WithCheckpoint.
+	Checkpoint *changestreampb.Checkpoint
+}
+
+// ChangeStream is the stream of the change events of a collection.
+type ChangeStream struct {
+	stream changestreampb.ChangeStreamService_SubscribeClient
+	cancel context.CancelFunc
+	schema *entity.Schema
+}
+
+// SubscribeChanges subscribes the inserts, deletes, upserts and ddl of the collection.
+//
+// The stream can be resumed from the checkpoint of any received event, the events after it
+// are delivered at least once.
+func (c *Client) SubscribeChanges(ctx context.Context, option SubscribeChangesOption, callOptions ...grpc.CallOption) (*ChangeStream, error) {
+	if c.conn == nil {
+		return nil, merr.WrapErrServiceNotReady("SDK", 0, "not connected")
+	}
+	coll, err := c.getCollection(ctx, option.CollectionName())
+	if err != nil {
+		return nil, err
+	}
+
+	// the metadata interceptor doesn't apply on streams.
+	ctx, cancel := context.WithCancel(c.extraInfo(c.state(c.metadata(ctx))))
+	stream, err := changestreampb.NewChangeStreamServiceClient(c.conn).Subscribe(ctx, option.Request(), callOptions...)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	return &ChangeStream{stream: stream, cancel: cancel, schema: coll.Schema}, nil
+}
+
+// Recv returns the next change event, io.EOF is returned after the collection is dropped.
+func (s *ChangeStream) Recv() (*ChangeEvent, error) {
+	msg, err := s.stream.Recv()
+	if err != nil {
+		return nil, err
+	}
+	if err := merr.Error(msg.GetStatus()); err != nil {
+		return nil, err
+	}
+
+	event := &ChangeEvent{
+		Type:          changeEventTypes[msg.GetType()],
+		Timestamp:     msg.GetTimestamp(),
+		VChannel:      msg.GetVchannel(),
+		PartitionName: msg.GetPartitionName(),
+		Checkpoint:    msg.GetCheckpoint(),
+	}
+	switch msg.GetType() {
+	case changestreampb.ChangeEventType_Insert:
+		for _, fd := range msg.GetFieldsData() {
+			col, err := column.FieldDataColumn(fd, 0, -1)
+			if err != nil {
+				return nil, err
+			}
+			event.Columns = append(event.Columns, col)
+		}
+	case changestreampb.ChangeEventType_Delete:
+		if event.PrimaryKeys, err = column.IDColumns(s.schema, msg.GetPrimaryKeys(), 0, -1); err != nil {
+			return nil, err
+		}
+	}
+	return event, nil
+}
+
+// Close closes the stream.
+func (s *ChangeStream) Close() {
+	s.cancel()
+}
@@ -0,0 +1,72 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package milvusclient
+
+import (
+	"github.com/milvus-io/milvus/client/v3/proto/changestreampb"
+)
+
+// SubscribeChangesOption is the interface builds the change stream subscription request.
+type SubscribeChangesOption interface {
+	Request() *changestreampb.SubscribeRequest
+	CollectionName() string
+}
+
+type subscribeChangesOption struct {
+	dbName         string
+	collectionName string
+	checkpoint     *changestreampb.Checkpoint
+	startTimestamp uint64
+}
+
+func (opt *subscribeChangesOption) Request() *changestreampb.SubscribeRequest {
+	return &changestreampb.SubscribeRequest{
+		DbName:         opt.dbName,
+		CollectionName: opt.collectionName,
+		Checkpoint:     opt.checkpoint,
+		StartTimestamp: opt.startTimestamp,
+	}
+}
+
+func (opt *subscribeChangesOption) CollectionName() string {
+	return opt.collectionName
+}
+
+// WithDbName sets the database of the collection. If not set, the current database is used.
+func (opt *subscribeChangesOption) WithDbName(dbName string) *subscribeChangesOption {
+	opt.dbName = dbName
+	return opt
+}
+
+// WithCheckpoint resumes the stream after the event carrying the checkpoint.
+func (opt *subscribeChangesOption) WithCheckpoint(checkpoint *changestreampb.Checkpoint) *subscribeChangesOption {
+	opt.checkpoint = checkpoint
+	return opt
+}
+
+// WithStartTimestamp delivers the changes after the timestamp, it's ignored if the checkpoint is set.
+// If neither is set, the stream starts from the latest change.
+func (opt *subscribeChangesOption) WithStartTimestamp(ts uint64) *subscribeChangesOption {
+	opt.startTimestamp = ts
+	return opt
+}
+
+func NewSubscribeChangesOption(collectionName string) *subscribeChangesOption {
+	return &subscribeChangesOption{
+		collectionName: collectionName,
+	}
+}
@@ -0,0 +1,171 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package milvusclient
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v3/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v3/schemapb"
+	"github.com/milvus-io/milvus/client/v3/entity"
+	"github.com/milvus-io/milvus/client/v3/internal/merr"
+	"github.com/milvus-io/milvus/client/v3/proto/changestreampb"
+)
+
+// mockChangeStreamServer serves the subscriptions with the function set by the cases.
+type mockChangeStreamServer struct {
+	subscribe func(req *changestreampb.SubscribeRequest, stream changestreampb.ChangeStreamService_SubscribeServer) error
+}
+
+func (m *mockChangeStreamServer) Subscribe(req *changestreampb.SubscribeRequest, stream changestreampb.ChangeStreamService_SubscribeServer) error {
+	return m.subscribe(req, stream)
+}
+
+type ChangeStreamSuite struct {
+	MockSuiteBase
+
+	streamServer *mockChangeStreamServer
+}
+
+func (s *ChangeStreamSuite) SetupSuite() {
+	s.lis = bufconn.Listen(bufSize)
+	s.svr = grpc.NewServer()
+
+	s.mock = &MilvusServiceServer{}
+	milvuspb.RegisterMilvusServiceServer(s.svr, s.mock)
+	s.streamServer = &mockChangeStreamServer{}
+	changestreampb.RegisterChangeStreamServiceServer(s.svr, s.streamServer)
+
+	go func() {
+		if err := s.svr.Serve(s.lis); err != nil {
+			s.Fail("failed to start mock server", err.Error())
+		}
+	}()
+	s.setupConnect()
+}
+
+func (s *ChangeStreamSuite) event(eventType changestreampb.ChangeEventType, ts uint64) *changestreampb.ChangeEvent {
+	return &changestreampb.ChangeEvent{
+		Status:        merr.Success(),
+		Type:          eventType,
+		Timestamp:     449873129226862593,
+		Vchannel:      "v0",
+		PartitionName: "_default",
+		Checkpoint:    &changestreampb.Checkpoint{CollectionId: 1, StartTimestamp: ts},
+	}
+}
+
+func (s *ChangeStreamSuite) TestSubscribe() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	collName := "coll"
+	s.setupCache(collName, entity.NewSchema().WithName(collName).
+		WithField(entity.NewField().WithName("id").WithDataType(entity.FieldTypeInt64).WithIsPrimaryKey(true)))
+
+	checkpoint := &changestreampb.Checkpoint{
+		CollectionId: 1,
+		Positions:    map[string]*commonpb.MessageID{"v0": {Id: "1"}},
+	}
+	s.streamServer.subscribe = func(req *changestreampb.SubscribeRequest, stream changestreampb.ChangeStreamService_SubscribeServer) error {
+		s.Equal(collName, req.GetCollectionName())
+		s.Equal("1", req.GetCheckpoint().GetPositions()["v0"].GetId())
+		s.EqualValues(100, req.GetStartTimestamp())
+
+		del := s.event(changestreampb.ChangeEventType_Delete, 1)
+		del.PrimaryKeys = &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1, 2}}}}
+		insert := s.event(changestreampb.ChangeEventType_Insert, 2)
+		insert.NumRows = 2
+		insert.FieldsData = []*schemapb.FieldData{{
+			Type:      schemapb.DataType_Int64,
+			FieldName: "id",
+			Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{1, 2}}},
+			}},
+		}}
+		for _, event := range []*changestreampb.ChangeEvent{
+			del,
+			insert,
+			s.event(changestreampb.ChangeEventType_DropCollection, 3),
+		} {
+			if err := stream.Send(event); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	stream, err := s.client.SubscribeChanges(ctx, NewSubscribeChangesOption(collName).WithCheckpoint(checkpoint).WithStartTimestamp(100))
+	s.Require().NoError(err)
+	defer stream.Close()
+
+	event, err := stream.Recv()
+	s.Require().NoError(err)
+	s.Equal(ChangeEventDelete, event.Type)
+	s.EqualValues(449873129226862593, event.Timestamp)
+	s.EqualValues(1, event.Checkpoint.GetStartTimestamp())
+	s.Equal("id", event.PrimaryKeys.Name())
+	s.Equal(2, event.PrimaryKeys.Len())
+
+	event, err = stream.Recv()
+	s.Require().NoError(err)
+	s.Equal(ChangeEventInsert, event.Type)
+	s.Equal("_default", event.PartitionName)
+	s.Require().Len(event.Columns, 1)
+	s.Equal(2, event.Columns[0].Len())
+
+	event, err = stream.Recv()
+	s.Require().NoError(err)
+	s.Equal(ChangeEventDropCollection, event.Type)
+
+	_, err = stream.Recv()
+	s.ErrorIs(err, io.EOF)
+}
+
+func (s *ChangeStreamSuite) TestFailure() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	collName := "coll"
+	s.setupCache(collName, entity.NewSchema().WithName(collName).
+		WithField(entity.NewField().WithName("id").WithDataType(entity.FieldTypeInt64).WithIsPrimaryKey(true)))
+
+	s.streamServer.subscribe = func(req *changestreampb.SubscribeRequest, stream changestreampb.ChangeStreamService_SubscribeServer) error {
+		return stream.Send(&changestreampb.ChangeEvent{
+			Status: &commonpb.Status{Code: 1100, Reason: "invalid checkpoint"},
+		})
+	}
+
+	stream, err := s.client.SubscribeChanges(ctx, NewSubscribeChangesOption(collName).WithCheckpoint(&changestreampb.Checkpoint{CollectionId: 2}))
+	s.Require().NoError(err)
+	defer stream.Close()
+
+	_, err = stream.Recv()
+	s.Error(err)
+	s.Equal(int32(1100), ErrorCode(err))
+}
+
+func TestChangeStream(t *testing.T) {
+	suite.Run(t, new(ChangeStreamSuite))
+}
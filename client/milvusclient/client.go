@@ -42,8 +42,14 @@ import (
 	"github.com/milvus-io/milvus/client/v3/internal/merr"
 )
 
+// clientConn is the connection of the client, a grpc connection to one proxy or the pool of multiple proxies.
+type clientConn interface {
+	grpc.ClientConnInterface
+	Close() error
+}
+
 type Client struct {
-	conn             *grpc.ClientConn
+	conn             clientConn
 	service          milvuspb.MilvusServiceClient
 	telemetryService milvuspb.ClientTelemetryServiceClient
 	config           *ClientConfig
@@ -71,17 +77,22 @@ func New(ctx context.Context, config *ClientConfig) (*Client, error) {
 		currentDB: config.DBName,
 	}
 
-	// Parse remote address.
-	addr := c.config.getParsedAddress()
-
 	// parse authentication parameters
 	c.parseAuthentication()
 	// Parse grpc options
 	options := c.dialOptions()
 
 	// Connect the grpc server.
-	if err := c.connect(ctx, addr, options...); err != nil {
-		return nil, err
+	if c.config.useEndpointPool() {
+		if err := c.connectEndpoints(ctx, options...); err != nil {
+			return nil, err
+		}
+	} else {
+		// Parse remote address.
+		addr := c.config.getParsedAddress()
+		if err := c.connect(ctx, addr, options...); err != nil {
+			return nil, err
+		}
 	}
 
 	c.collCache = NewCollectionCache(func(ctx context.Context, collName string) (*entity.Collection, error) {
@@ -112,13 +123,18 @@ func (c *Client) dialOptions() []grpc.DialOption {
 	options = append(options, DefaultGrpcOpts...)
 	options = append(options, c.config.DialOptions...)
 
+	retryCodes := []codes.Code{codes.Unavailable, codes.ResourceExhausted}
+	if c.config.useEndpointPool() {
+		// the endpoint pool fails over the unavailable proxies instead of retrying on them
+		retryCodes = []codes.Code{codes.ResourceExhausted}
+	}
 	options = append(options,
 		grpc.WithChainUnaryInterceptor(grpc_retry.UnaryClientInterceptor(
 			grpc_retry.WithMax(6),
 			grpc_retry.WithBackoff(func(attempt uint) time.Duration {
 				return 60 * time.Millisecond * time.Duration(math.Pow(3, float64(attempt)))
 			}),
-			grpc_retry.WithCodes(codes.Unavailable, codes.ResourceExhausted)),
+			grpc_retry.WithCodes(retryCodes...)),
 
 		// c.getRetryOnRateLimitInterceptor(),
 		))
@@ -204,10 +220,47 @@ func (c *Client) connect(ctx context.Context, addr string, options ...grpc.DialO
 	return nil
 }
 
+// connectEndpoints connects all the proxies of the config, the requests are balanced over them.
+func (c *Client) connectEndpoints(ctx context.Context, options ...grpc.DialOption) error {
+	pool := newEndpointPool(c.config, options, c.connectService)
+	if err := pool.start(ctx); err != nil {
+		return err
+	}
+
+	c.conn = pool
+	c.service = milvuspb.NewMilvusServiceClient(pool)
+	c.telemetryService = milvuspb.NewClientTelemetryServiceClient(pool)
+	return nil
+}
+
 func (c *Client) connectInternal(ctx context.Context) error {
+	if pool, ok := c.conn.(*endpointPool); ok {
+		// every proxy keeps its own connection info, the current database is sent along
+		if err := pool.connectAll(ctx); err != nil {
+			return err
+		}
+	} else {
+		identifier, err := c.connectService(ctx, c.service)
+		if err != nil {
+			return err
+		}
+		if identifier != "" {
+			c.setIdentifier(identifier)
+		}
+	}
+	if c.collCache != nil {
+		c.collCache.Reset()
+	}
+
+	return nil
+}
+
+// connectService registers the client on the proxy of the service and returns the identifier assigned,
+// empty if the proxy doesn't support connect.
+func (c *Client) connectService(ctx context.Context, service milvuspb.MilvusServiceClient) (string, error) {
 	hostName, err := os.Hostname()
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	req := &milvuspb.ConnectRequest{
@@ -220,7 +273,7 @@ func (c *Client) connectInternal(ctx context.Context) error {
 		},
 	}
 
-	resp, err := c.service.Connect(ctx, req)
+	resp, err := service.Connect(ctx, req)
 	if err != nil {
 		status, ok := status.FromError(err)
 		if ok {
@@ -231,23 +284,18 @@ func (c *Client) connectInternal(ctx context.Context) error {
 						disableJSON |
 						disableParitionKey |
 						disableDynamicSchema)
-				return nil
+				return "", nil
 			}
 		}
-		return err
+		return "", err
 	}
 
 	if !merr.Ok(resp.GetStatus()) {
-		return merr.Error(resp.GetStatus())
+		return "", merr.Error(resp.GetStatus())
 	}
 
 	c.config.setServerInfo(resp.GetServerInfo().GetBuildTags())
-	c.setIdentifier(strconv.FormatInt(resp.GetIdentifier(), 10))
-	if c.collCache != nil {
-		c.collCache.Reset()
-	}
-
-	return nil
+	return strconv.FormatInt(resp.GetIdentifier(), 10), nil
 }
 
 func (c *Client) callService(fn func(milvusService milvuspb.MilvusServiceClient) error) error {
@@ -54,6 +54,18 @@ type ClientConfig struct {
 	Password string // Password for auth.
 	DBName   string // DBName for this client.
 
+	// Addresses are the remote addresses of multiple proxies, e.g. ["proxy-1:19530", "proxy-2:19530"].
+	// The requests are balanced over the healthy proxies, and the read requests failed by an unavailable
+	// proxy are retried on another. Address is ignored if Addresses is set.
+	Addresses []string
+	// ResolveAddresses resolves the host of every address by DNS and balances over all the resolved ips,
+	// the addresses are resolved again on every health check.
+	ResolveAddresses bool
+	// LoadBalancePolicy picks the proxy of a request when there are multiple, round robin by default.
+	LoadBalancePolicy LoadBalancePolicy
+	// HealthCheckInterval is the interval to check the health of the proxies when there are multiple, 5s by default.
+	HealthCheckInterval time.Duration
+
 	EnableTLSAuth bool   // Enable TLS Auth for transport security.
 	APIKey        string // API key
 
@@ -68,9 +80,10 @@ type ClientConfig struct {
 	// TelemetryConfig for client telemetry settings
 	TelemetryConfig *TelemetryConfig
 
-	ServerVersion string // ServerVersion
-	parsedAddress *url.URL
-	flags         uint64 // internal flags
+	ServerVersion   string // ServerVersion
+	parsedAddress   *url.URL
+	parsedAddresses []*url.URL
+	flags           uint64 // internal flags
 }
 
 type RetryRateLimitOption struct {
@@ -79,32 +92,48 @@ type RetryRateLimitOption struct {
 }
 
 func (cfg *ClientConfig) parse() error {
-	// Prepend default fake tcp:// scheme for remote address.
-	address := cfg.Address
-	if !regexValidScheme.MatchString(address) {
-		address = fmt.Sprintf("tcp://%s", address)
+	addresses := cfg.Addresses
+	if len(addresses) == 0 {
+		addresses = []string{cfg.Address}
 	}
 
-	remoteURL, err := url.Parse(address)
-	if err != nil {
-		return errors.Wrap(err, "milvus address parse fail")
-	}
-	// Remote Host should never be empty.
-	if remoteURL.Host == "" {
-		return errors.New("empty remote host of milvus address")
-	}
-	// Use DBName in remote url path.
-	if cfg.DBName == "" {
-		cfg.DBName = strings.TrimLeft(remoteURL.Path, "/")
+	cfg.parsedAddresses = make([]*url.URL, 0, len(addresses))
+	for _, address := range addresses {
+		// Prepend default fake tcp:// scheme for remote address.
+		if !regexValidScheme.MatchString(address) {
+			address = fmt.Sprintf("tcp://%s", address)
+		}
+
+		remoteURL, err := url.Parse(address)
+		if err != nil {
+			return errors.Wrap(err, "milvus address parse fail")
+		}
+		// Remote Host should never be empty.
+		if remoteURL.Host == "" {
+			return errors.New("empty remote host of milvus address")
+		}
+		// Use DBName in remote url path.
+		if cfg.DBName == "" {
+			cfg.DBName = strings.TrimLeft(remoteURL.Path, "/")
+		}
+		// Always enable tls auth for https remote url.
+		if remoteURL.Scheme == "https" {
+			cfg.EnableTLSAuth = true
+		}
+		cfg.parsedAddresses = append(cfg.parsedAddresses, remoteURL)
 	}
-	// Always enable tls auth for https remote url.
-	if remoteURL.Scheme == "https" {
-		cfg.EnableTLSAuth = true
+	for _, remoteURL := range cfg.parsedAddresses {
+		if remoteURL.Port() == "" && cfg.EnableTLSAuth {
+			remoteURL.Host += ":443"
+		}
 	}
-	if remoteURL.Port() == "" && cfg.EnableTLSAuth {
-		remoteURL.Host += ":443"
+	cfg.parsedAddress = cfg.parsedAddresses[0]
+
+	switch cfg.LoadBalancePolicy {
+	case "", LoadBalanceRoundRobin, LoadBalanceLeastInflight:
+	default:
+		return errors.Newf("unknown load balance policy %s", cfg.LoadBalancePolicy)
 	}
-	cfg.parsedAddress = remoteURL
 	return nil
 }
 
@@ -113,6 +142,21 @@ func (c *ClientConfig) getParsedAddress() string {
 	return c.parsedAddress.Host
 }
 
+// Get parsed remote milvus addresses, should be called after parse was called.
+func (c *ClientConfig) getParsedAddresses() []string {
+	addresses := make([]string, 0, len(c.parsedAddresses))
+	for _, remoteURL := range c.parsedAddresses {
+		addresses = append(addresses, remoteURL.Host)
+	}
+	return addresses
+}
+
+// useEndpointPool returns whether the requests are balanced over multiple endpoints,
+// should be called after parse was called.
+func (c *ClientConfig) useEndpointPool() bool {
+	return len(c.parsedAddresses) > 1 || c.ResolveAddresses
+}
+
 // useDatabase change the inner db name.
 func (c *ClientConfig) useDatabase(dbName string) {
 	c.DBName = dbName
@@ -127,9 +171,12 @@ func (c *ClientConfig) getRetryOnRateLimitInterceptor() grpc.UnaryClientIntercep
 		c.RetryRateLimit = c.defaultRetryRateLimitOption()
 	}
 
-	return RetryOnRateLimitInterceptor(c.RetryRateLimit.MaxRetry, c.RetryRateLimit.MaxBackoff, func(ctx context.Context, attempt uint) time.Duration {
-		return 10 * time.Millisecond * time.Duration(math.Pow(3, float64(attempt)))
-	})
+	return RetryOnRateLimitInterceptor(c.RetryRateLimit.MaxRetry, c.RetryRateLimit.MaxBackoff, rateLimitBackoff)
+}
+
+// rateLimitBackoff is the backoff between the retries of the rate limited requests.
+func rateLimitBackoff(_ context.Context, attempt uint) time.Duration {
+	return 10 * time.Millisecond * time.Duration(math.Pow(3, float64(attempt)))
 }
 
 func (c *ClientConfig) defaultRetryRateLimitOption() *RetryRateLimitOption {
@@ -0,0 +1,620 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package milvusclient
+
+import (
+	"context"
+	"net"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v3/milvuspb"
+	"github.com/milvus-io/milvus/client/v3/internal/merr"
+)
+
+// LoadBalancePolicy picks the proxy of a request when the client has multiple proxies.
+type LoadBalancePolicy string
+
+const (
+	// LoadBalanceRoundRobin sends the requests to the healthy proxies in turn.
+	LoadBalanceRoundRobin LoadBalancePolicy = "round_robin"
+	// LoadBalanceLeastInflight sends a request to the healthy proxy with the fewest requests in flight.
+	LoadBalanceLeastInflight LoadBalancePolicy = "least_inflight"
+)
+
+const (
+	defaultHealthCheckInterval = 5 * time.Second
+	endpointDialTimeout        = 5 * time.Second
+	endpointCheckTimeout       = 3 * time.Second
+
+	milvusServicePrefix = "/milvus.proto.milvus.MilvusService/"
+)
+
+// idempotentMethods are the read only methods of MilvusService, they are retried on
+// another proxy when the proxy serving them is unavailable.
+var idempotentMethods = map[string]struct{}{
+	"Search":                      {},
+	"HybridSearch":                {},
+	"Query":                       {},
+	"DescribeCollection":          {},
+	"HasCollection":               {},
+	"ShowCollections":             {},
+	"GetCollectionStatistics":     {},
+	"ShowPartitions":              {},
+	"HasPartition":                {},
+	"GetPartitionStatistics":      {},
+	"DescribeIndex":               {},
+	"GetIndexStatistics":          {},
+	"GetIndexState":               {},
+	"GetIndexBuildProgress":       {},
+	"GetLoadingProgress":          {},
+	"GetLoadState":                {},
+	"DescribeAlias":               {},
+	"ListAliases":                 {},
+	"ListDatabases":               {},
+	"DescribeDatabase":            {},
+	"GetVersion":                  {},
+	"CheckHealth":                 {},
+	"GetComponentStates":          {},
+	"GetFlushState":               {},
+	"GetFlushAllState":            {},
+	"GetCompactionState":          {},
+	"GetCompactionStateWithPlans": {},
+	"GetPersistentSegmentInfo":    {},
+	"GetQuerySegmentInfo":         {},
+	"GetReplicas":                 {},
+	"ListResourceGroups":          {},
+	"DescribeResourceGroup":       {},
+	"SelectRole":                  {},
+	"SelectUser":                  {},
+	"SelectGrant":                 {},
+	"ListCredUsers":               {},
+	"ListPrivilegeGroups":         {},
+	"GetImportState":              {},
+	"ListImportTasks":             {},
+	"ListSnapshots":               {},
+	"DescribeSnapshot":            {},
+	"ListRowPolicies":             {},
+	"RunAnalyzer":                 {},
+}
+
+func isIdempotentMethod(method string) bool {
+	name, ok := strings.CutPrefix(method, milvusServicePrefix)
+	if !ok {
+		return false
+	}
+	_, ok = idempotentMethods[name]
+	return ok
+}
+
+// isEndpointUnavailable returns whether the request failed because the proxy is unavailable,
+// i.e. the proxy is down, or it's stopping or not ready yet.
+func isEndpointUnavailable(reply any, err error) bool {
+	if err != nil {
+		return status.Code(err) == codes.Unavailable
+	}
+	if resp, ok := reply.(interface{ GetStatus() *commonpb.Status }); ok {
+		return errors.Is(merr.Error(resp.GetStatus()), merr.ErrServiceNotReady)
+	}
+	return false
+}
+
+type endpointKeyType struct{}
+
+// withEndpoint returns a context binding the requests issued with it to a proxy.
+// If *address is empty, it's set to the address of the proxy serving the first request.
+func withEndpoint(ctx context.Context, address *string) context.Context {
+	return context.WithValue(ctx, endpointKeyType{}, address)
+}
+
+// endpoint is a proxy of the pool.
+type endpoint struct {
+	// address is the dialed host:port, authority is the configured one if it's resolved by dns.
+	address   string
+	authority string
+	inflight  atomic.Int64
+
+	mu         sync.RWMutex
+	conn       *grpc.ClientConn
+	service    milvuspb.MilvusServiceClient
+	healthy    bool
+	identifier string
+}
+
+func (ep *endpoint) getConn() (*grpc.ClientConn, milvuspb.MilvusServiceClient) {
+	ep.mu.RLock()
+	defer ep.mu.RUnlock()
+	return ep.conn, ep.service
+}
+
+func (ep *endpoint) isHealthy() bool {
+	ep.mu.RLock()
+	defer ep.mu.RUnlock()
+	return ep.healthy && ep.conn != nil
+}
+
+func (ep *endpoint) setHealthy(healthy bool) {
+	ep.mu.Lock()
+	defer ep.mu.Unlock()
+	ep.healthy = healthy
+}
+
+func (ep *endpoint) setIdentifier(identifier string) {
+	ep.mu.Lock()
+	defer ep.mu.Unlock()
+	ep.identifier = identifier
+}
+
+// outgoingContext appends the identifier assigned by the proxy.
+func (ep *endpoint) outgoingContext(ctx context.Context) context.Context {
+	ep.mu.RLock()
+	defer ep.mu.RUnlock()
+	if ep.identifier != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, identifierHeader, ep.identifier)
+	}
+	return ctx
+}
+
+func (ep *endpoint) close() {
+	ep.mu.Lock()
+	defer ep.mu.Unlock()
+	if ep.conn != nil {
+		ep.conn.Close()
+	}
+	ep.conn = nil
+	ep.service = nil
+	ep.healthy = false
+}
+
+// endpointPool balances the requests over multiple proxies, it implements grpc.ClientConnInterface.
+//
+// The proxies are checked by CheckHealth, or GetVersion for the old ones, every health check interval.
+// A proxy is marked unhealthy once a request fails because it's unavailable, and it's registered again
+// by Connect when it's back, with the current database of the client.
+type endpointPool struct {
+	policy    LoadBalancePolicy
+	interval  time.Duration
+	hosts     []string
+	resolve   bool
+	skipConn  bool
+	options   []grpc.DialOption
+	connect   func(ctx context.Context, service milvuspb.MilvusServiceClient) (string, error)
+	connectMu sync.Mutex
+	// lookupHost resolves the host to the ip addresses, replaced in tests.
+	lookupHost func(ctx context.Context, host string) ([]string, error)
+
+	mu        sync.RWMutex
+	endpoints []*endpoint
+	next      atomic.Uint64
+
+	closeOnce sync.Once
+	closeCh   chan struct{}
+	wg        sync.WaitGroup
+}
+
+func newEndpointPool(config *ClientConfig, options []grpc.DialOption,
+	connect func(ctx context.Context, service milvuspb.MilvusServiceClient) (string, error),
+) *endpointPool {
+	interval := config.HealthCheckInterval
+	if interval <= 0 {
+		interval = defaultHealthCheckInterval
+	}
+	policy := config.LoadBalancePolicy
+	if policy == "" {
+		policy = LoadBalanceRoundRobin
+	}
+	return &endpointPool{
+		policy:     policy,
+		interval:   interval,
+		hosts:      config.getParsedAddresses(),
+		resolve:    config.ResolveAddresses,
+		skipConn:   config.DisableConn,
+		options:    options,
+		connect:    connect,
+		lookupHost: net.DefaultResolver.LookupHost,
+		closeCh:    make(chan struct{}),
+	}
+}
+
+// start connects all the proxies and starts the health check, it fails only if no proxy is connected.
+func (p *endpointPool) start(ctx context.Context) error {
+	if err := p.updateEndpoints(ctx); err != nil {
+		return err
+	}
+
+	endpoints := p.getEndpoints()
+	errs := make([]error, len(endpoints))
+	wg := sync.WaitGroup{}
+	for i, ep := range endpoints {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = p.dial(ctx, ep)
+		}()
+	}
+	wg.Wait()
+
+	var firstErr error
+	for i, ep := range endpoints {
+		err := errs[i]
+		if err == nil && !p.skipConn {
+			err = p.connectEndpoint(ctx, ep)
+		}
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		ep.setHealthy(true)
+	}
+	if !p.hasHealthy() {
+		p.Close()
+		return firstErr
+	}
+
+	p.wg.Add(1)
+	go p.healthCheckLoop()
+	return nil
+}
+
+func (p *endpointPool) getEndpoints() []*endpoint {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.endpoints
+}
+
+func (p *endpointPool) hasHealthy() bool {
+	for _, ep := range p.getEndpoints() {
+		if ep.isHealthy() {
+			return true
+		}
+	}
+	return false
+}
+
+// updateEndpoints resolves the configured hosts, adds the new proxies and removes the vanished ones.
+func (p *endpointPool) updateEndpoints(ctx context.Context) error {
+	type target struct{ address, authority string }
+	var targets []target
+	for _, host := range p.hosts {
+		if !p.resolve {
+			targets = append(targets, target{address: host})
+			continue
+		}
+		hostname, port, err := net.SplitHostPort(host)
+		if err != nil {
+			return errors.Wrapf(err, "invalid address %s", host)
+		}
+		if net.ParseIP(hostname) != nil {
+			targets = append(targets, target{address: host})
+			continue
+		}
+		ips, err := p.lookupHost(ctx, hostname)
+		if err != nil {
+			return errors.Wrapf(err, "failed to resolve %s", hostname)
+		}
+		for _, ip := range ips {
+			targets = append(targets, target{address: net.JoinHostPort(ip, port), authority: host})
+		}
+	}
+	if len(targets) == 0 {
+		return errors.New("no address resolved")
+	}
+
+	p.mu.Lock()
+	existing := make(map[string]*endpoint, len(p.endpoints))
+	for _, ep := range p.endpoints {
+		existing[ep.address] = ep
+	}
+	endpoints := make([]*endpoint, 0, len(targets))
+	for _, t := range targets {
+		if ep, ok := existing[t.address]; ok {
+			endpoints = append(endpoints, ep)
+			delete(existing, t.address)
+			continue
+		}
+		endpoints = append(endpoints, &endpoint{address: t.address, authority: t.authority})
+	}
+	p.endpoints = endpoints
+	p.mu.Unlock()
+
+	for _, ep := range existing {
+		ep.close()
+	}
+	return nil
+}
+
+func (p *endpointPool) dial(ctx context.Context, ep *endpoint) error {
+	options := p.options
+	if ep.authority != "" {
+		// keep the configured host for tls and routing, the options of the config take precedence
+		options = append([]grpc.DialOption{grpc.WithAuthority(ep.authority)}, options...)
+	}
+	ctx, cancel := context.WithTimeout(ctx, endpointDialTimeout)
+	defer cancel()
+	conn, err := grpc.DialContext(ctx, ep.address, options...)
+	if err != nil {
+		return err
+	}
+
+	ep.mu.Lock()
+	defer ep.mu.Unlock()
+	if ep.conn != nil {
+		conn.Close()
+		return nil
+	}
+	ep.conn = conn
+	ep.service = milvuspb.NewMilvusServiceClient(conn)
+	return nil
+}
+
+// connectEndpoint registers the client on the proxy, the connect calls are serialized
+// since they update the server info of the client.
+func (p *endpointPool) connectEndpoint(ctx context.Context, ep *endpoint) error {
+	_, service := ep.getConn()
+	if service == nil {
+		return merr.WrapErrServiceNotReady("SDK", 0, "not connected", ep.address)
+	}
+	p.connectMu.Lock()
+	defer p.connectMu.Unlock()
+	identifier, err := p.connect(ctx, service)
+	if err != nil {
+		return err
+	}
+	ep.setIdentifier(identifier)
+	return nil
+}
+
+// connectAll registers the client on all the connected proxies again, it fails only if none succeeds.
+func (p *endpointPool) connectAll(ctx context.Context) error {
+	var firstErr error
+	succeeded := false
+	for _, ep := range p.getEndpoints() {
+		if conn, _ := ep.getConn(); conn == nil {
+			continue
+		}
+		if err := p.connectEndpoint(ctx, ep); err != nil {
+			ep.setHealthy(false)
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		ep.setHealthy(true)
+		succeeded = true
+	}
+	if !succeeded {
+		if firstErr == nil {
+			firstErr = merr.WrapErrServiceNotReady("SDK", 0, "not connected")
+		}
+		return firstErr
+	}
+	return nil
+}
+
+func (p *endpointPool) healthCheckLoop() {
+	defer p.wg.Done()
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-p.closeCh:
+			return
+		case <-ticker.C:
+			p.checkEndpoints(context.Background())
+		}
+	}
+}
+
+// checkEndpoints checks the health of all the proxies, and dials and connects the ones back.
+func (p *endpointPool) checkEndpoints(ctx context.Context) {
+	if p.resolve {
+		// keep the known proxies if the dns is unavailable
+		_ = p.updateEndpoints(ctx)
+	}
+	wg := sync.WaitGroup{}
+	for _, ep := range p.getEndpoints() {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ep.setHealthy(p.checkEndpoint(ctx, ep) == nil)
+		}()
+	}
+	wg.Wait()
+}
+
+func (p *endpointPool) checkEndpoint(ctx context.Context, ep *endpoint) error {
+	if conn, _ := ep.getConn(); conn == nil {
+		if err := p.dial(ctx, ep); err != nil {
+			return err
+		}
+	}
+	_, service := ep.getConn()
+	checkCtx, cancel := context.WithTimeout(ctx, endpointCheckTimeout)
+	defer cancel()
+	if err := checkHealth(checkCtx, service); err != nil {
+		return err
+	}
+	if ep.isHealthy() || p.skipConn {
+		return nil
+	}
+	// the proxy may have restarted and lost the connection info
+	return p.connectEndpoint(checkCtx, ep)
+}
+
+func checkHealth(ctx context.Context, service milvuspb.MilvusServiceClient) error {
+	resp, err := service.CheckHealth(ctx, &milvuspb.CheckHealthRequest{})
+	if status.Code(err) == codes.Unimplemented {
+		versionResp, err := service.GetVersion(ctx, &milvuspb.GetVersionRequest{})
+		return merr.CheckRPCCall(versionResp, err)
+	}
+	if err := merr.CheckRPCCall(resp, err); err != nil {
+		return err
+	}
+	if !resp.GetIsHealthy() {
+		return merr.WrapErrServiceNotReady("SDK", 0, "unhealthy", resp.GetReasons()...)
+	}
+	return nil
+}
+
+// pick returns the proxy serving the next request, the unhealthy proxies are
+// picked only if all the others are excluded or unhealthy.
+func (p *endpointPool) pick(excluded map[*endpoint]struct{}) (*endpoint, error) {
+	var healthy, connected []*endpoint
+	for _, ep := range p.getEndpoints() {
+		if _, ok := excluded[ep]; ok {
+			continue
+		}
+		if conn, _ := ep.getConn(); conn == nil {
+			continue
+		}
+		connected = append(connected, ep)
+		if ep.isHealthy() {
+			healthy = append(healthy, ep)
+		}
+	}
+	candidates := healthy
+	if len(candidates) == 0 {
+		candidates = connected
+	}
+	if len(candidates) == 0 {
+		return nil, merr.WrapErrServiceNotReady("SDK", 0, "no available proxy")
+	}
+
+	start := int(p.next.Add(1) % uint64(len(candidates)))
+	if p.policy != LoadBalanceLeastInflight {
+		return candidates[start], nil
+	}
+	// starting from a rotating offset to spread the ties
+	picked := candidates[start]
+	for i := 1; i < len(candidates); i++ {
+		ep := candidates[(start+i)%len(candidates)]
+		if ep.inflight.Load() < picked.inflight.Load() {
+			picked = ep
+		}
+	}
+	return picked, nil
+}
+
+// pinned returns the proxy the requests of the context are bound to, nil if they are not bound.
+func (p *endpointPool) pinned(ctx context.Context) (*endpoint, *string, error) {
+	var address *string
+	if txn := transactionFromContext(ctx); txn != nil && txn.endpoint != "" {
+		address = &txn.endpoint
+	} else if addr, ok := ctx.Value(endpointKeyType{}).(*string); ok {
+		address = addr
+	}
+	if address == nil || *address == "" {
+		return nil, address, nil
+	}
+	for _, ep := range p.getEndpoints() {
+		if ep.address == *address {
+			return ep, address, nil
+		}
+	}
+	return nil, address, merr.WrapErrServiceNotReady("SDK", 0, "proxy not found", *address)
+}
+
+// Invoke sends the request to a proxy, the idempotent requests are retried on another proxy
+// if the proxy is unavailable.
+func (p *endpointPool) Invoke(ctx context.Context, method string, args, reply any, opts ...grpc.CallOption) error {
+	ep, address, err := p.pinned(ctx)
+	if err != nil {
+		return err
+	}
+	failover := ep == nil && isIdempotentMethod(method)
+	if ep == nil {
+		if ep, err = p.pick(nil); err != nil {
+			return err
+		}
+	}
+	if address != nil && *address == "" {
+		*address = ep.address
+	}
+
+	excluded := make(map[*endpoint]struct{})
+	for {
+		err = p.invoke(ctx, ep, method, args, reply, opts...)
+		if !isEndpointUnavailable(reply, err) {
+			return err
+		}
+		ep.setHealthy(false)
+		if !failover || ctx.Err() != nil {
+			return err
+		}
+		excluded[ep] = struct{}{}
+		next, pickErr := p.pick(excluded)
+		if pickErr != nil {
+			return err
+		}
+		ep = next
+		if msg, ok := reply.(proto.Message); ok {
+			proto.Reset(msg)
+		}
+	}
+}
+
+func (p *endpointPool) invoke(ctx context.Context, ep *endpoint, method string, args, reply any, opts ...grpc.CallOption) error {
+	conn, _ := ep.getConn()
+	if conn == nil {
+		return status.Error(codes.Unavailable, "proxy "+ep.address+" is not connected")
+	}
+	ep.inflight.Add(1)
+	defer ep.inflight.Add(-1)
+	return conn.Invoke(ep.outgoingContext(ctx), method, args, reply, opts...)
+}
+
+// NewStream opens the stream on a proxy, the streams are not failed over.
+func (p *endpointPool) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	ep, _, err := p.pinned(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ep == nil {
+		if ep, err = p.pick(nil); err != nil {
+			return nil, err
+		}
+	}
+	conn, _ := ep.getConn()
+	if conn == nil {
+		return nil, status.Error(codes.Unavailable, "proxy "+ep.address+" is not connected")
+	}
+	return conn.NewStream(ep.outgoingContext(ctx), desc, method, opts...)
+}
+
+// Close stops the health check and closes the connections to all the proxies.
+func (p *endpointPool) Close() error {
+	p.closeOnce.Do(func() {
+		close(p.closeCh)
+	})
+	p.wg.Wait()
+	for _, ep := range p.getEndpoints() {
+		ep.close()
+	}
+	return nil
+}
@@ -0,0 +1,271 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package milvusclient
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/milvuspb"
+	"github.com/milvus-io/milvus/client/v3/internal/merr"
+	"github.com/milvus-io/milvus/client/v3/proto/txnpb"
+)
+
+type testProxy struct {
+	lis  *bufconn.Listener
+	svr  *grpc.Server
+	mock *MilvusServiceServer
+}
+
+func newTestProxy(t *testing.T, identifier int64) *testProxy {
+	p := &testProxy{
+		lis:  bufconn.Listen(bufSize),
+		svr:  grpc.NewServer(),
+		mock: &MilvusServiceServer{},
+	}
+	milvuspb.RegisterMilvusServiceServer(p.svr, p.mock)
+	go p.svr.Serve(p.lis)
+	t.Cleanup(p.svr.Stop)
+
+	p.mock.EXPECT().Connect(mock.Anything, mock.Anything).Return(&milvuspb.ConnectResponse{
+		Status:     merr.Success(),
+		Identifier: identifier,
+	}, nil).Maybe()
+	p.mock.EXPECT().CheckHealth(mock.Anything, mock.Anything).Return(&milvuspb.CheckHealthResponse{
+		Status:    merr.Success(),
+		IsHealthy: true,
+	}, nil).Maybe()
+	return p
+}
+
+func newTestPoolClient(t *testing.T, proxies map[string]*testProxy, policy LoadBalancePolicy) *Client {
+	addresses := make([]string, 0, len(proxies))
+	for address := range proxies {
+		addresses = append(addresses, address)
+	}
+	c, err := New(context.Background(), &ClientConfig{
+		Addresses:           addresses,
+		LoadBalancePolicy:   policy,
+		HealthCheckInterval: time.Hour,
+		DialOptions: []grpc.DialOption{
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+			grpc.WithContextDialer(func(ctx context.Context, address string) (net.Conn, error) {
+				return proxies[address].lis.DialContext(ctx)
+			}),
+		},
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { c.Close(context.Background()) })
+	return c
+}
+
+func incomingHeader(ctx context.Context, key string) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[len(values)-1]
+}
+
+func TestIsIdempotentMethod(t *testing.T) {
+	assert.True(t, isIdempotentMethod(milvusServicePrefix+"Search"))
+	assert.True(t, isIdempotentMethod(milvusServicePrefix+"DescribeCollection"))
+	assert.False(t, isIdempotentMethod(milvusServicePrefix+"Insert"))
+	assert.False(t, isIdempotentMethod(txnpb.TransactionService_Begin_FullMethodName))
+}
+
+func TestIsEndpointUnavailable(t *testing.T) {
+	assert.True(t, isEndpointUnavailable(nil, status.Error(codes.Unavailable, "down")))
+	assert.False(t, isEndpointUnavailable(nil, status.Error(codes.DeadlineExceeded, "slow")))
+	assert.True(t, isEndpointUnavailable(&milvuspb.BoolResponse{
+		Status: merr.Status(merr.WrapErrServiceNotReady("proxy", 1, "Stopping")),
+	}, nil))
+	assert.False(t, isEndpointUnavailable(&milvuspb.BoolResponse{Status: merr.Success()}, nil))
+	assert.False(t, isEndpointUnavailable(&milvuspb.BoolResponse{
+		Status: merr.Status(errors.New("collection not found")),
+	}, nil))
+}
+
+func TestClientConfigAddresses(t *testing.T) {
+	cfg := &ClientConfig{Addresses: []string{"https://proxy-1/db1", "proxy-2"}}
+	require.NoError(t, cfg.parse())
+	assert.Equal(t, []string{"proxy-1:443", "proxy-2:443"}, cfg.getParsedAddresses())
+	assert.Equal(t, "db1", cfg.DBName)
+	assert.True(t, cfg.EnableTLSAuth)
+	assert.True(t, cfg.useEndpointPool())
+
+	cfg = &ClientConfig{Address: "localhost:19530"}
+	require.NoError(t, cfg.parse())
+	assert.False(t, cfg.useEndpointPool())
+	cfg.ResolveAddresses = true
+	assert.True(t, cfg.useEndpointPool())
+
+	cfg = &ClientConfig{Address: "localhost:19530", LoadBalancePolicy: "random"}
+	assert.Error(t, cfg.parse())
+}
+
+func TestEndpointPoolFailover(t *testing.T) {
+	proxy1, proxy2 := newTestProxy(t, 1), newTestProxy(t, 2)
+	c := newTestPoolClient(t, map[string]*testProxy{"proxy-1": proxy1, "proxy-2": proxy2}, LoadBalanceRoundRobin)
+	pool := c.conn.(*endpointPool)
+	ctx := context.Background()
+
+	proxy1.mock.EXPECT().HasCollection(mock.Anything, mock.Anything).
+		Return(nil, status.Error(codes.Unavailable, "proxy is down")).Maybe()
+	proxy2.mock.EXPECT().HasCollection(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, req *milvuspb.HasCollectionRequest) (*milvuspb.BoolResponse, error) {
+			// the identifier is the one assigned by the proxy serving the request
+			assert.Equal(t, "2", incomingHeader(ctx, identifierHeader))
+			assert.Equal(t, "db1", incomingHeader(ctx, databaseHeader))
+			return &milvuspb.BoolResponse{Status: merr.Success(), Value: true}, nil
+		})
+
+	err := c.UseDatabase(ctx, NewUseDatabaseOption("db1"))
+	require.NoError(t, err)
+
+	for i := 0; i < 4; i++ {
+		has, err := c.HasCollection(ctx, NewHasCollectionOption("coll"))
+		require.NoError(t, err)
+		assert.True(t, has)
+	}
+	for _, ep := range pool.getEndpoints() {
+		assert.Equal(t, ep.address == "proxy-2", ep.isHealthy(), ep.address)
+	}
+
+	// the writes are not failed over
+	proxy1.mock.EXPECT().CreateDatabase(mock.Anything, mock.Anything).
+		Return(nil, status.Error(codes.Unavailable, "proxy is down")).Maybe()
+	for _, ep := range pool.getEndpoints() {
+		ep.setHealthy(ep.address == "proxy-1")
+	}
+	err = c.CreateDatabase(ctx, NewCreateDatabaseOption("db2"))
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+
+	// both proxies pass the health check
+	pool.checkEndpoints(ctx)
+	for _, ep := range pool.getEndpoints() {
+		assert.True(t, ep.isHealthy(), ep.address)
+	}
+}
+
+func TestEndpointPoolPick(t *testing.T) {
+	newEndpoint := func(address string, healthy bool, inflight int64) *endpoint {
+		conn, err := grpc.NewClient("passthrough:///"+address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		require.NoError(t, err)
+		t.Cleanup(func() { conn.Close() })
+		ep := &endpoint{address: address, conn: conn, healthy: healthy}
+		ep.inflight.Store(inflight)
+		return ep
+	}
+	ep1, ep2, ep3 := newEndpoint("ep-1", true, 3), newEndpoint("ep-2", true, 1), newEndpoint("ep-3", false, 0)
+	pool := &endpointPool{policy: LoadBalanceRoundRobin, endpoints: []*endpoint{ep1, ep2, ep3}}
+
+	t.Run("round robin", func(t *testing.T) {
+		picked := make(map[string]int)
+		for i := 0; i < 4; i++ {
+			ep, err := pool.pick(nil)
+			require.NoError(t, err)
+			picked[ep.address]++
+		}
+		assert.Equal(t, map[string]int{"ep-1": 2, "ep-2": 2}, picked)
+	})
+
+	t.Run("least inflight", func(t *testing.T) {
+		pool.policy = LoadBalanceLeastInflight
+		defer func() { pool.policy = LoadBalanceRoundRobin }()
+		for i := 0; i < 4; i++ {
+			ep, err := pool.pick(nil)
+			require.NoError(t, err)
+			assert.Equal(t, "ep-2", ep.address)
+		}
+	})
+
+	t.Run("unhealthy as the last resort", func(t *testing.T) {
+		ep, err := pool.pick(map[*endpoint]struct{}{ep1: {}, ep2: {}})
+		require.NoError(t, err)
+		assert.Equal(t, "ep-3", ep.address)
+
+		_, err = pool.pick(map[*endpoint]struct{}{ep1: {}, ep2: {}, ep3: {}})
+		assert.Error(t, err)
+	})
+
+	t.Run("pinned", func(t *testing.T) {
+		ep, _, err := pool.pinned(context.Background())
+		assert.NoError(t, err)
+		assert.Nil(t, ep)
+
+		ctx := WithTransaction(context.Background(), &Transaction{ID: "1", endpoint: "ep-3"})
+		ep, _, err = pool.pinned(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, ep3, ep)
+
+		address := "ep-4"
+		_, _, err = pool.pinned(withEndpoint(context.Background(), &address))
+		assert.Error(t, err)
+	})
+}
+
+func TestEndpointPoolResolve(t *testing.T) {
+	ips := []string{"10.0.0.1", "10.0.0.2"}
+	pool := &endpointPool{
+		hosts:   []string{"milvus:19530", "10.0.0.9:19530"},
+		resolve: true,
+		lookupHost: func(ctx context.Context, host string) ([]string, error) {
+			assert.Equal(t, "milvus", host)
+			return ips, nil
+		},
+	}
+	addresses := func() []string {
+		var result []string
+		for _, ep := range pool.getEndpoints() {
+			result = append(result, ep.address)
+		}
+		return result
+	}
+
+	require.NoError(t, pool.updateEndpoints(context.Background()))
+	assert.Equal(t, []string{"10.0.0.1:19530", "10.0.0.2:19530", "10.0.0.9:19530"}, addresses())
+	assert.Equal(t, "milvus:19530", pool.getEndpoints()[0].authority)
+	assert.Empty(t, pool.getEndpoints()[2].authority)
+	first := pool.getEndpoints()[1]
+
+	ips = []string{"10.0.0.2", "10.0.0.3"}
+	require.NoError(t, pool.updateEndpoints(context.Background()))
+	assert.Equal(t, []string{"10.0.0.2:19530", "10.0.0.3:19530", "10.0.0.9:19530"}, addresses())
+	// the known proxy is kept
+	assert.Same(t, first, pool.getEndpoints()[0])
+
+	pool.lookupHost = func(ctx context.Context, host string) ([]string, error) {
+		return nil, errors.New("dns is down")
+	}
+	assert.Error(t, pool.updateEndpoints(context.Background()))
+	assert.Len(t, pool.getEndpoints(), 3)
+}
@@ -28,6 +28,8 @@ var (
 	ErrCollectionNotFound       = merr.ErrCollectionNotFound
 	ErrCollectionSchemaMismatch = merr.ErrCollectionSchemaMismatch
 	ErrIndexNotFound            = merr.ErrIndexNotFound
+	ErrTxnNotFound              = merr.ErrTxnNotFound
+	ErrTxnExpired               = merr.ErrTxnExpired
 )
 
 // ErrorCode returns the Milvus numeric error code carried by err.
@@ -163,6 +163,9 @@ func (c *Client) extraInfo(ctx context.Context) context.Context {
 	if requestID := clientRequestIDFromContext(ctx); requestID != "" {
 		ctx = metadata.AppendToOutgoingContext(ctx, ClientRequestIDKey, requestID)
 	}
+	if txn := transactionFromContext(ctx); txn != nil {
+		ctx = metadata.AppendToOutgoingContext(ctx, transactionIDHeader, txn.ID)
+	}
 	return ctx
 }
 
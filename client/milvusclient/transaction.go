@@ -0,0 +1,109 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package milvusclient
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus/client/v3/internal/merr"
+	"github.com/milvus-io/milvus/client/v3/proto/txnpb"
+)
+
+const transactionIDHeader = `transaction-id`
+
+// Transaction is a transaction opened on a collection.
+//
+// The insert, upsert and delete requests issued with the context returned by
+// WithTransaction are invisible until the transaction is committed, and discarded
+// if it's rolled back or expired. A transaction is bound to the proxy which begins
+// it, the requests of the transaction are always sent to that proxy if the client
+// has multiple proxies.
+// Only collections with one shard are supported for now.
+type Transaction struct {
+	ID             string
+	CollectionName string
+	Keepalive      time.Duration
+
+	// endpoint is the address of the proxy beginning the transaction when the client has multiple proxies.
+	endpoint string
+}
+
+type transactionKeyType struct{}
+
+// WithTransaction returns a context that makes the write requests issued with it
+// belong to the transaction.
+func WithTransaction(ctx context.Context, txn *Transaction) context.Context {
+	return context.WithValue(ctx, transactionKeyType{}, txn)
+}
+
+func transactionFromContext(ctx context.Context) *Transaction {
+	txn, _ := ctx.Value(transactionKeyType{}).(*Transaction)
+	return txn
+}
+
+// BeginTransaction opens a transaction on the collection of the option.
+func (c *Client) BeginTransaction(ctx context.Context, option BeginTransactionOption, callOptions ...grpc.CallOption) (*Transaction, error) {
+	req := option.Request()
+	txn := &Transaction{CollectionName: req.GetCollectionName()}
+	err := c.callTransactionService(func(service txnpb.TransactionServiceClient) error {
+		resp, err := service.Begin(withEndpoint(ctx, &txn.endpoint), req, callOptions...)
+		if err = merr.CheckRPCCall(resp, err); err != nil {
+			return err
+		}
+		txn.ID = resp.GetTransactionId()
+		txn.Keepalive = time.Duration(resp.GetKeepaliveMs()) * time.Millisecond
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return txn, nil
+}
+
+// CommitTransaction commits the transaction and returns the commit timestamp,
+// the writes of the transaction are visible to the reads guaranteed after it.
+// ErrTxnExpired is returned if the transaction has expired.
+func (c *Client) CommitTransaction(ctx context.Context, txn *Transaction, callOptions ...grpc.CallOption) (uint64, error) {
+	var ts uint64
+	err := c.callTransactionService(func(service txnpb.TransactionServiceClient) error {
+		resp, err := service.Commit(withEndpoint(ctx, &txn.endpoint), &txnpb.CommitTransactionRequest{TransactionId: txn.ID}, callOptions...)
+		if err = merr.CheckRPCCall(resp, err); err != nil {
+			return err
+		}
+		ts = resp.GetCommitTimestamp()
+		return nil
+	})
+	return ts, err
+}
+
+// RollbackTransaction discards all the writes of the transaction.
+func (c *Client) RollbackTransaction(ctx context.Context, txn *Transaction, callOptions ...grpc.CallOption) error {
+	return c.callTransactionService(func(service txnpb.TransactionServiceClient) error {
+		resp, err := service.Rollback(withEndpoint(ctx, &txn.endpoint), &txnpb.RollbackTransactionRequest{TransactionId: txn.ID}, callOptions...)
+		return merr.CheckRPCCall(resp, err)
+	})
+}
+
+func (c *Client) callTransactionService(fn func(service txnpb.TransactionServiceClient) error) error {
+	if c.conn == nil {
+		return merr.WrapErrServiceNotReady("SDK", 0, "not connected")
+	}
+	return fn(txnpb.NewTransactionServiceClient(c.conn))
+}
@@ -0,0 +1,61 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package milvusclient
+
+import (
+	"time"
+
+	"github.com/milvus-io/milvus/client/v3/proto/txnpb"
+)
+
+// BeginTransactionOption is the interface builds the begin transaction request.
+type BeginTransactionOption interface {
+	Request() *txnpb.BeginTransactionRequest
+}
+
+type beginTransactionOption struct {
+	dbName         string
+	collectionName string
+	keepalive      time.Duration
+}
+
+func (opt *beginTransactionOption) Request() *txnpb.BeginTransactionRequest {
+	return &txnpb.BeginTransactionRequest{
+		DbName:         opt.dbName,
+		CollectionName: opt.collectionName,
+		KeepaliveMs:    opt.keepalive.Milliseconds(),
+	}
+}
+
+// WithDbName sets the database of the collection. If not set, the current database is used.
+func (opt *beginTransactionOption) WithDbName(dbName string) *beginTransactionOption {
+	opt.dbName = dbName
+	return opt
+}
+
+// WithKeepalive sets the keepalive of the transaction, the transaction expires if there's
+// no write or commit within the keepalive. If not set, the default keepalive of server is used.
+func (opt *beginTransactionOption) WithKeepalive(keepalive time.Duration) *beginTransactionOption {
+	opt.keepalive = keepalive
+	return opt
+}
+
+func NewBeginTransactionOption(collectionName string) *beginTransactionOption {
+	return &beginTransactionOption{
+		collectionName: collectionName,
+	}
+}
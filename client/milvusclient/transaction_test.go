@@ -0,0 +1,145 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package milvusclient
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v3/milvuspb"
+	"github.com/milvus-io/milvus/client/v3/internal/merr"
+	"github.com/milvus-io/milvus/client/v3/proto/txnpb"
+)
+
+// mockTransactionServer serves the transaction requests with the functions set by the cases.
+type mockTransactionServer struct {
+	begin    func(req *txnpb.BeginTransactionRequest) *txnpb.BeginTransactionResponse
+	commit   func(req *txnpb.CommitTransactionRequest) *txnpb.CommitTransactionResponse
+	rollback func(req *txnpb.RollbackTransactionRequest) *commonpb.Status
+}
+
+func (m *mockTransactionServer) Begin(_ context.Context, req *txnpb.BeginTransactionRequest) (*txnpb.BeginTransactionResponse, error) {
+	return m.begin(req), nil
+}
+
+func (m *mockTransactionServer) Commit(_ context.Context, req *txnpb.CommitTransactionRequest) (*txnpb.CommitTransactionResponse, error) {
+	return m.commit(req), nil
+}
+
+func (m *mockTransactionServer) Rollback(_ context.Context, req *txnpb.RollbackTransactionRequest) (*commonpb.Status, error) {
+	return m.rollback(req), nil
+}
+
+type TransactionSuite struct {
+	MockSuiteBase
+
+	txnServer *mockTransactionServer
+}
+
+func (s *TransactionSuite) SetupSuite() {
+	s.lis = bufconn.Listen(bufSize)
+	s.svr = grpc.NewServer()
+
+	s.mock = &MilvusServiceServer{}
+	milvuspb.RegisterMilvusServiceServer(s.svr, s.mock)
+	s.txnServer = &mockTransactionServer{}
+	txnpb.RegisterTransactionServiceServer(s.svr, s.txnServer)
+
+	go func() {
+		if err := s.svr.Serve(s.lis); err != nil {
+			s.Fail("failed to start mock server", err.Error())
+		}
+	}()
+	s.setupConnect()
+}
+
+func (s *TransactionSuite) TestBeginCommit() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s.txnServer.begin = func(req *txnpb.BeginTransactionRequest) *txnpb.BeginTransactionResponse {
+		s.Equal("db1", req.GetDbName())
+		s.Equal("coll", req.GetCollectionName())
+		s.EqualValues(5000, req.GetKeepaliveMs())
+		return &txnpb.BeginTransactionResponse{Status: merr.Success(), TransactionId: "100", KeepaliveMs: 5000}
+	}
+	s.txnServer.commit = func(req *txnpb.CommitTransactionRequest) *txnpb.CommitTransactionResponse {
+		s.Equal("100", req.GetTransactionId())
+		return &txnpb.CommitTransactionResponse{Status: merr.Success(), CommitTimestamp: 449873129226862593}
+	}
+	s.txnServer.rollback = func(req *txnpb.RollbackTransactionRequest) *commonpb.Status {
+		s.Equal("100", req.GetTransactionId())
+		return merr.Success()
+	}
+
+	txn, err := s.client.BeginTransaction(ctx, NewBeginTransactionOption("coll").WithDbName("db1").WithKeepalive(5*time.Second))
+	s.Require().NoError(err)
+	s.Equal("100", txn.ID)
+	s.Equal("coll", txn.CollectionName)
+	s.Equal(5*time.Second, txn.Keepalive)
+
+	ts, err := s.client.CommitTransaction(ctx, txn)
+	s.NoError(err)
+	s.EqualValues(449873129226862593, ts)
+
+	s.NoError(s.client.RollbackTransaction(ctx, txn))
+}
+
+func (s *TransactionSuite) TestExpired() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	expired := &commonpb.Status{Code: 2701, Reason: "transaction expired[transaction=100]"}
+	s.txnServer.commit = func(req *txnpb.CommitTransactionRequest) *txnpb.CommitTransactionResponse {
+		return &txnpb.CommitTransactionResponse{Status: expired}
+	}
+	s.txnServer.rollback = func(req *txnpb.RollbackTransactionRequest) *commonpb.Status {
+		return expired
+	}
+
+	_, err := s.client.CommitTransaction(ctx, &Transaction{ID: "100"})
+	s.ErrorIs(err, ErrTxnExpired)
+	s.Equal(int32(2701), ErrorCode(err))
+
+	err = s.client.RollbackTransaction(ctx, &Transaction{ID: "100"})
+	s.ErrorIs(err, ErrTxnExpired)
+}
+
+func TestTransaction(t *testing.T) {
+	suite.Run(t, new(TransactionSuite))
+}
+
+func TestExtraInfoInjectsTransaction(t *testing.T) {
+	c := &Client{}
+
+	md, ok := metadata.FromOutgoingContext(c.extraInfo(context.Background()))
+	assert.True(t, ok)
+	assert.Empty(t, md.Get(transactionIDHeader))
+
+	ctx := WithTransaction(context.Background(), &Transaction{ID: "100"})
+	md, ok = metadata.FromOutgoingContext(c.extraInfo(ctx))
+	assert.True(t, ok)
+	assert.Equal(t, []string{"100"}, md.Get(transactionIDHeader))
+}
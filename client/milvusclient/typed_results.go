@@ -0,0 +1,250 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package milvusclient
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"runtime/debug"
+
+	"github.com/cockroachdb/errors"
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus/client/v3/column"
+	"github.com/milvus-io/milvus/client/v3/entity"
+	"github.com/milvus-io/milvus/client/v3/row"
+)
+
+// Hit is one entity of a search or query result decoded into the model type T.
+// T shall be a struct, or a pointer to struct, tagged the same way as the
+// receivers of `ResultSet.Unmarshal`.
+type Hit[T any] struct {
+	ID     any     // primary key of the entity
+	Score  float32 // distance to the target vector, always zero for query results
+	Entity T
+}
+
+// SearchTyped runs the search and decodes the hits of each query vector into T.
+// The returned slice has one element per query vector, in the order of the vectors.
+func SearchTyped[T any](ctx context.Context, c *Client, option SearchOption, callOptions ...grpc.CallOption) ([][]Hit[T], error) {
+	resultSets, err := c.Search(ctx, option, callOptions...)
+	if err != nil {
+		return nil, err
+	}
+	hits := make([][]Hit[T], 0, len(resultSets))
+	for _, rs := range resultSets {
+		if rs.Err != nil {
+			return nil, rs.Err
+		}
+		nqHits, err := UnmarshalHits[T](rs)
+		if err != nil {
+			return nil, err
+		}
+		hits = append(hits, nqHits)
+	}
+	return hits, nil
+}
+
+// QueryTyped runs the query and decodes the returned entities into T.
+func QueryTyped[T any](ctx context.Context, c *Client, option QueryOption, callOptions ...grpc.CallOption) ([]Hit[T], error) {
+	rs, err := c.Query(ctx, option, callOptions...)
+	if err != nil {
+		return nil, err
+	}
+	return UnmarshalHits[T](rs)
+}
+
+// TypedQueryIterator is a QueryIterator decoding each batch into T.
+type TypedQueryIterator[T any] struct {
+	it QueryIterator
+}
+
+// Next returns the next batch of entities, io.EOF is returned when the iteration is done.
+func (it *TypedQueryIterator[T]) Next(ctx context.Context) ([]Hit[T], error) {
+	rs, err := it.it.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return UnmarshalHits[T](rs)
+}
+
+// QueryIteratorTyped creates a query iterator decoding the entities into T.
+func QueryIteratorTyped[T any](ctx context.Context, c *Client, option QueryIteratorOption, callOptions ...grpc.CallOption) (*TypedQueryIterator[T], error) {
+	it, err := c.QueryIterator(ctx, option, callOptions...)
+	if err != nil {
+		return nil, err
+	}
+	return &TypedQueryIterator[T]{it: it}, nil
+}
+
+// TypedSearchIterator is a SearchIterator decoding each batch into T.
+type TypedSearchIterator[T any] struct {
+	it SearchIterator
+}
+
+// Next returns the next batch of hits, io.EOF is returned when the iteration is done.
+func (it *TypedSearchIterator[T]) Next(ctx context.Context) ([]Hit[T], error) {
+	rs, err := it.it.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return UnmarshalHits[T](rs)
+}
+
+// SearchIteratorTyped creates a search iterator decoding the hits into T.
+func SearchIteratorTyped[T any](ctx context.Context, c *Client, option SearchIteratorOption, callOptions ...grpc.CallOption) (*TypedSearchIterator[T], error) {
+	it, err := c.SearchIterator(ctx, option, callOptions...)
+	if err != nil {
+		return nil, err
+	}
+	return &TypedSearchIterator[T]{it: it}, nil
+}
+
+// UnmarshalHits decodes the entities of the result set into T.
+//
+// Besides the assignments done by `ResultSet.Unmarshal`, the decoding
+//   - sets the pointer fields to nil for the null values of nullable fields;
+//   - unmarshals the JSON fields into map, struct, slice or interface fields;
+//   - converts the float16 and bfloat16 vectors into []float32 fields.
+func UnmarshalHits[T any](rs ResultSet) (hits []Hit[T], err error) {
+	defer func() {
+		if x := recover(); x != nil {
+			err = errors.Newf("failed to unmarshal result set: %v, stack: %v", x, string(debug.Stack()))
+		}
+	}()
+
+	typ := reflect.TypeOf((*T)(nil)).Elem()
+	et := typ
+	if et.Kind() == reflect.Ptr {
+		et = et.Elem()
+	}
+	if et.Kind() != reflect.Struct {
+		return nil, errors.Newf("hit entity must be struct or pointer to struct but get: %v", typ)
+	}
+	rc := row.GetReceiverCandidate(et)
+
+	var pkName string
+	pkColumn := rs.IDs
+	if rs.sch != nil && rs.sch.PKField() != nil {
+		pkName = rs.sch.PKField().Name
+		if pkColumn == nil {
+			pkColumn = rs.GetColumn(pkName)
+		}
+	}
+	// the primary keys are filled from the ids unless returned as an output field
+	pkIdx, fillPK := rc.Name2FieldIndex(pkName)
+	if rs.IDs == nil || rs.GetColumn(pkName) != nil {
+		fillPK = false
+	}
+
+	count := rs.ResultCount
+	if count == 0 {
+		count = rs.Fields.Len()
+	}
+	hits = make([]Hit[T], 0, count)
+	for i := 0; i < count; i++ {
+		data := reflect.New(et)
+		for _, col := range rs.Fields {
+			fidx, ok := rc.Name2FieldIndex(col.Name())
+			if !ok {
+				continue
+			}
+			if err := setTypedField(data.Elem().Field(fidx), col, i); err != nil {
+				return nil, errors.Wrapf(err, "failed to unmarshal field %s", col.Name())
+			}
+		}
+
+		hit := Hit[T]{}
+		if pkColumn != nil {
+			hit.ID, err = pkColumn.Get(i)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if fillPK {
+			if err := setTypedValue(data.Elem().Field(pkIdx), rs.IDs.Type(), hit.ID); err != nil {
+				return nil, errors.Wrapf(err, "failed to unmarshal field %s", pkName)
+			}
+		}
+		if i < len(rs.Scores) {
+			hit.Score = rs.Scores[i]
+		}
+		if typ.Kind() == reflect.Ptr {
+			hit.Entity = data.Interface().(T)
+		} else {
+			hit.Entity = data.Elem().Interface().(T)
+		}
+		hits = append(hits, hit)
+	}
+	return hits, nil
+}
+
+func setTypedField(field reflect.Value, col column.Column, idx int) error {
+	isNull, err := col.IsNull(idx)
+	if err != nil {
+		return err
+	}
+	if isNull {
+		field.Set(reflect.Zero(field.Type()))
+		return nil
+	}
+	val, err := col.Get(idx)
+	if err != nil {
+		return err
+	}
+	return setTypedValue(field, col.Type(), val)
+}
+
+func setTypedValue(target reflect.Value, fieldType entity.FieldType, val any) error {
+	if target.Kind() == reflect.Ptr {
+		ptr := reflect.New(target.Type().Elem())
+		if err := setTypedValue(ptr.Elem(), fieldType, val); err != nil {
+			return err
+		}
+		target.Set(ptr)
+		return nil
+	}
+
+	if fieldType == entity.FieldTypeJSON {
+		if bs, ok := val.([]byte); ok && (target.Kind() == reflect.Interface || !reflect.TypeOf(val).AssignableTo(target.Type())) {
+			return json.Unmarshal(bs, target.Addr().Interface())
+		}
+	}
+
+	switch vector := val.(type) {
+	case entity.Float16Vector:
+		if target.Type() == reflect.TypeOf([]float32{}) {
+			val = []float32(vector.ToFloat32Vector())
+		}
+	case entity.BFloat16Vector:
+		if target.Type() == reflect.TypeOf([]float32{}) {
+			val = []float32(vector.ToFloat32Vector())
+		}
+	}
+
+	rv := reflect.ValueOf(val)
+	switch {
+	case rv.Type().AssignableTo(target.Type()):
+		target.Set(rv)
+	case rv.Kind() == target.Kind() && rv.Type().ConvertibleTo(target.Type()):
+		target.Set(rv.Convert(target.Type()))
+	default:
+		return errors.Newf("cannot assign %v to %v", rv.Type(), target.Type())
+	}
+	return nil
+}
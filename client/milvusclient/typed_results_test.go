@@ -0,0 +1,120 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package milvusclient
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/client/v3/column"
+	"github.com/milvus-io/milvus/client/v3/entity"
+)
+
+type typedMeta struct {
+	Color string `json:"color"`
+}
+
+type typedRecord struct {
+	ID     int64     `milvus:"name:id"`
+	Name   *string   `milvus:"name:name"`
+	Meta   typedMeta `milvus:"name:meta"`
+	Vector []float32 `milvus:"name:vector"`
+	Fp16V  []float32 `milvus:"name:fp16_vector"`
+}
+
+func newTypedResultSet(t *testing.T) ResultSet {
+	vectors := [][]float32{{0.5, 1}, {1, 2}, {2, 4}}
+	name, err := column.NewNullableColumnVarChar("name", []string{"a", "c"}, []bool{true, false, true})
+	require.NoError(t, err)
+	return ResultSet{
+		sch: entity.NewSchema().
+			WithField(entity.NewField().WithName("id").WithDataType(entity.FieldTypeInt64).WithIsPrimaryKey(true)),
+		ResultCount: 3,
+		IDs:         column.NewColumnInt64("id", []int64{1, 2, 3}),
+		Scores:      []float32{0.9, 0.8, 0.7},
+		Fields: DataSet{
+			name,
+			column.NewColumnJSONBytes("meta", [][]byte{
+				[]byte(`{"color":"red"}`), []byte(`{"color":"green"}`), []byte(`{"color":"blue"}`),
+			}),
+			column.NewColumnFloatVector("vector", 2, vectors),
+			column.NewColumnFloat16VectorFromFp32Vector("fp16_vector", 2, vectors),
+		},
+	}
+}
+
+func TestUnmarshalHits(t *testing.T) {
+	rs := newTypedResultSet(t)
+
+	hits, err := UnmarshalHits[typedRecord](rs)
+	require.NoError(t, err)
+	require.Len(t, hits, 3)
+
+	assert.Equal(t, int64(1), hits[0].ID)
+	assert.Equal(t, float32(0.9), hits[0].Score)
+	assert.Equal(t, int64(1), hits[0].Entity.ID)
+	require.NotNil(t, hits[0].Entity.Name)
+	assert.Equal(t, "a", *hits[0].Entity.Name)
+	assert.Nil(t, hits[1].Entity.Name)
+	assert.Equal(t, "c", *hits[2].Entity.Name)
+	assert.Equal(t, typedMeta{Color: "green"}, hits[1].Entity.Meta)
+	assert.Equal(t, []float32{1, 2}, hits[1].Entity.Vector)
+	assert.Equal(t, []float32{2, 4}, hits[2].Entity.Fp16V)
+
+	type mapRecord struct {
+		Meta map[string]any `milvus:"name:meta"`
+	}
+	mapHits, err := UnmarshalHits[mapRecord](rs)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"color": "blue"}, mapHits[2].Entity.Meta)
+
+	// pointer entity
+	ptrHits, err := UnmarshalHits[*typedRecord](rs)
+	require.NoError(t, err)
+	require.Len(t, ptrHits, 3)
+	assert.Equal(t, hits[2].Entity, *ptrHits[2].Entity)
+
+	// query results without ids and scores
+	rs.IDs, rs.Scores = nil, nil
+	rs.Fields = append(rs.Fields, column.NewColumnInt64("id", []int64{4, 5, 6}))
+	hits, err = UnmarshalHits[typedRecord](rs)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), hits[1].ID)
+	assert.Equal(t, int64(5), hits[1].Entity.ID)
+	assert.Zero(t, hits[1].Score)
+}
+
+func TestUnmarshalHitsError(t *testing.T) {
+	rs := newTypedResultSet(t)
+
+	_, err := UnmarshalHits[int64](rs)
+	assert.Error(t, err)
+
+	type wrongType struct {
+		Name *int64 `milvus:"name:name"`
+	}
+	_, err = UnmarshalHits[wrongType](rs)
+	assert.Error(t, err)
+
+	type badJSON struct {
+		Meta []int64 `milvus:"name:meta"`
+	}
+	_, err = UnmarshalHits[badJSON](rs)
+	assert.Error(t, err)
+}
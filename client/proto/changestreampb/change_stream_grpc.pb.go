@@ -0,0 +1,138 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v5.27.0
+// source: change_stream.proto
+
+package changestreampb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	ChangeStreamService_Subscribe_FullMethodName = "/milvus.proto.cdc.ChangeStreamService/Subscribe"
+)
+
+// ChangeStreamServiceClient is the client API for ChangeStreamService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ChangeStreamServiceClient interface {
+	// Subscribe streams the change events of the collection until the collection is dropped,
+	// a failure is sent as the last event with the status before the stream is closed.
+	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (ChangeStreamService_SubscribeClient, error)
+}
+
+type changeStreamServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewChangeStreamServiceClient(cc grpc.ClientConnInterface) ChangeStreamServiceClient {
+	return &changeStreamServiceClient{cc}
+}
+
+func (c *changeStreamServiceClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (ChangeStreamService_SubscribeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ChangeStreamService_ServiceDesc.Streams[0], ChangeStreamService_Subscribe_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &changeStreamServiceSubscribeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ChangeStreamService_SubscribeClient interface {
+	Recv() (*ChangeEvent, error)
+	grpc.ClientStream
+}
+
+type changeStreamServiceSubscribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *changeStreamServiceSubscribeClient) Recv() (*ChangeEvent, error) {
+	m := new(ChangeEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ChangeStreamServiceServer is the server API for ChangeStreamService service.
+// All implementations should embed UnimplementedChangeStreamServiceServer
+// for forward compatibility
+type ChangeStreamServiceServer interface {
+	// Subscribe streams the change events of the collection until the collection is dropped,
+	// a failure is sent as the last event with the status before the stream is closed.
+	Subscribe(*SubscribeRequest, ChangeStreamService_SubscribeServer) error
+}
+
+// UnimplementedChangeStreamServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedChangeStreamServiceServer struct {
+}
+
+func (UnimplementedChangeStreamServiceServer) Subscribe(*SubscribeRequest, ChangeStreamService_SubscribeServer) error {
+	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+
+// UnsafeChangeStreamServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ChangeStreamServiceServer will
+// result in compilation errors.
+type UnsafeChangeStreamServiceServer interface {
+	mustEmbedUnimplementedChangeStreamServiceServer()
+}
+
+func RegisterChangeStreamServiceServer(s grpc.ServiceRegistrar, srv ChangeStreamServiceServer) {
+	s.RegisterService(&ChangeStreamService_ServiceDesc, srv)
+}
+
+func _ChangeStreamService_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ChangeStreamServiceServer).Subscribe(m, &changeStreamServiceSubscribeServer{stream})
+}
+
+type ChangeStreamService_SubscribeServer interface {
+	Send(*ChangeEvent) error
+	grpc.ServerStream
+}
+
+type changeStreamServiceSubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *changeStreamServiceSubscribeServer) Send(m *ChangeEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// ChangeStreamService_ServiceDesc is the grpc.ServiceDesc for ChangeStreamService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ChangeStreamService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "milvus.proto.cdc.ChangeStreamService",
+	HandlerType: (*ChangeStreamServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Subscribe",
+			Handler:       _ChangeStreamService_Subscribe_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "change_stream.proto",
+}
@@ -0,0 +1,1013 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        v5.27.0
+// source: explain.proto
+
+package explainpb
+
+import (
+	commonpb "github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	milvuspb "github.com/milvus-io/milvus-proto/go-api/v3/milvuspb"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ExplainSearchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// hybrid searches and search iterators are not supported.
+	Request *milvuspb.SearchRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (x *ExplainSearchRequest) Reset() {
+	*x = ExplainSearchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_explain_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExplainSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExplainSearchRequest) ProtoMessage() {}
+
+func (x *ExplainSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_explain_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExplainSearchRequest.ProtoReflect.Descriptor instead.
+func (*ExplainSearchRequest) Descriptor() ([]byte, []int) {
+	return file_explain_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ExplainSearchRequest) GetRequest() *milvuspb.SearchRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type ExplainQueryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// query iterators are not supported.
+	Request *milvuspb.QueryRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (x *ExplainQueryRequest) Reset() {
+	*x = ExplainQueryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_explain_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExplainQueryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExplainQueryRequest) ProtoMessage() {}
+
+func (x *ExplainQueryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_explain_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExplainQueryRequest.ProtoReflect.Descriptor instead.
+func (*ExplainQueryRequest) Descriptor() ([]byte, []int) {
+	return file_explain_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ExplainQueryRequest) GetRequest() *milvuspb.QueryRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type ExplainResults struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// the rendering of the plan node sent to the query nodes.
+	Plan *structpb.Struct `protobuf:"bytes,2,opt,name=plan,proto3" json:"plan,omitempty"`
+	// the filter expression, unset if the request has no filter.
+	Expr *ExprExplain `protobuf:"bytes,3,opt,name=expr,proto3" json:"expr,omitempty"`
+	// the profiles of the shards ordered by channel.
+	Shards  []*ShardProfile `protobuf:"bytes,4,rep,name=shards,proto3" json:"shards,omitempty"`
+	Summary *Summary        `protobuf:"bytes,5,opt,name=summary,proto3" json:"summary,omitempty"`
+}
+
+func (x *ExplainResults) Reset() {
+	*x = ExplainResults{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_explain_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExplainResults) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExplainResults) ProtoMessage() {}
+
+func (x *ExplainResults) ProtoReflect() protoreflect.Message {
+	mi := &file_explain_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExplainResults.ProtoReflect.Descriptor instead.
+func (*ExplainResults) Descriptor() ([]byte, []int) {
+	return file_explain_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ExplainResults) GetStatus() *commonpb.Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *ExplainResults) GetPlan() *structpb.Struct {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
+}
+
+func (x *ExplainResults) GetExpr() *ExprExplain {
+	if x != nil {
+		return x.Expr
+	}
+	return nil
+}
+
+func (x *ExplainResults) GetShards() []*ShardProfile {
+	if x != nil {
+		return x.Shards
+	}
+	return nil
+}
+
+func (x *ExplainResults) GetSummary() *Summary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+// ExprExplain describes how the filter expression is parsed and rewritten.
+type ExprExplain struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Expr string `protobuf:"bytes,1,opt,name=expr,proto3" json:"expr,omitempty"`
+	// the renderings of the expression before and after the rewriter.
+	Parsed      *structpb.Value `protobuf:"bytes,2,opt,name=parsed,proto3" json:"parsed,omitempty"`
+	Rewritten   *structpb.Value `protobuf:"bytes,3,opt,name=rewritten,proto3" json:"rewritten,omitempty"`
+	IsRewritten bool            `protobuf:"varint,4,opt,name=is_rewritten,json=isRewritten,proto3" json:"is_rewritten,omitempty"`
+	// the text format of the rewritten expression, it covers the expressions without a rendering.
+	Text string `protobuf:"bytes,5,opt,name=text,proto3" json:"text,omitempty"`
+}
+
+func (x *ExprExplain) Reset() {
+	*x = ExprExplain{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_explain_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExprExplain) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExprExplain) ProtoMessage() {}
+
+func (x *ExprExplain) ProtoReflect() protoreflect.Message {
+	mi := &file_explain_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExprExplain.ProtoReflect.Descriptor instead.
+func (*ExprExplain) Descriptor() ([]byte, []int) {
+	return file_explain_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ExprExplain) GetExpr() string {
+	if x != nil {
+		return x.Expr
+	}
+	return ""
+}
+
+func (x *ExprExplain) GetParsed() *structpb.Value {
+	if x != nil {
+		return x.Parsed
+	}
+	return nil
+}
+
+func (x *ExprExplain) GetRewritten() *structpb.Value {
+	if x != nil {
+		return x.Rewritten
+	}
+	return nil
+}
+
+func (x *ExprExplain) GetIsRewritten() bool {
+	if x != nil {
+		return x.IsRewritten
+	}
+	return false
+}
+
+func (x *ExprExplain) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+// SegmentProfile is the execution profile of a segment.
+type SegmentProfile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SegmentId int64 `protobuf:"varint,1,opt,name=segment_id,json=segmentId,proto3" json:"segment_id,omitempty"`
+	NodeId    int64 `protobuf:"varint,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Growing   bool  `protobuf:"varint,3,opt,name=growing,proto3" json:"growing,omitempty"`
+	NumRows   int64 `protobuf:"varint,4,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
+	// the rows passing the filter, -1 if the execution doesn't count them.
+	SurvivedRows int64 `protobuf:"varint,5,opt,name=survived_rows,json=survivedRows,proto3" json:"survived_rows,omitempty"`
+	// the index type loaded for each field id.
+	Indexes map[int64]string `protobuf:"bytes,6,rep,name=indexes,proto3" json:"indexes,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	CostUs  int64            `protobuf:"varint,7,opt,name=cost_us,json=costUs,proto3" json:"cost_us,omitempty"`
+}
+
+func (x *SegmentProfile) Reset() {
+	*x = SegmentProfile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_explain_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SegmentProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SegmentProfile) ProtoMessage() {}
+
+func (x *SegmentProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_explain_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SegmentProfile.ProtoReflect.Descriptor instead.
+func (*SegmentProfile) Descriptor() ([]byte, []int) {
+	return file_explain_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SegmentProfile) GetSegmentId() int64 {
+	if x != nil {
+		return x.SegmentId
+	}
+	return 0
+}
+
+func (x *SegmentProfile) GetNodeId() int64 {
+	if x != nil {
+		return x.NodeId
+	}
+	return 0
+}
+
+func (x *SegmentProfile) GetGrowing() bool {
+	if x != nil {
+		return x.Growing
+	}
+	return false
+}
+
+func (x *SegmentProfile) GetNumRows() int64 {
+	if x != nil {
+		return x.NumRows
+	}
+	return 0
+}
+
+func (x *SegmentProfile) GetSurvivedRows() int64 {
+	if x != nil {
+		return x.SurvivedRows
+	}
+	return 0
+}
+
+func (x *SegmentProfile) GetIndexes() map[int64]string {
+	if x != nil {
+		return x.Indexes
+	}
+	return nil
+}
+
+func (x *SegmentProfile) GetCostUs() int64 {
+	if x != nil {
+		return x.CostUs
+	}
+	return 0
+}
+
+// PruneProfile records the sealed segments removed by a pruner.
+type PruneProfile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pruner           string  `protobuf:"bytes,1,opt,name=pruner,proto3" json:"pruner,omitempty"`
+	SegmentsBefore   int64   `protobuf:"varint,2,opt,name=segments_before,json=segmentsBefore,proto3" json:"segments_before,omitempty"`
+	PrunedSegmentIds []int64 `protobuf:"varint,3,rep,packed,name=pruned_segment_ids,json=prunedSegmentIds,proto3" json:"pruned_segment_ids,omitempty"`
+	CostUs           int64   `protobuf:"varint,4,opt,name=cost_us,json=costUs,proto3" json:"cost_us,omitempty"`
+}
+
+func (x *PruneProfile) Reset() {
+	*x = PruneProfile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_explain_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PruneProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PruneProfile) ProtoMessage() {}
+
+func (x *PruneProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_explain_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PruneProfile.ProtoReflect.Descriptor instead.
+func (*PruneProfile) Descriptor() ([]byte, []int) {
+	return file_explain_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PruneProfile) GetPruner() string {
+	if x != nil {
+		return x.Pruner
+	}
+	return ""
+}
+
+func (x *PruneProfile) GetSegmentsBefore() int64 {
+	if x != nil {
+		return x.SegmentsBefore
+	}
+	return 0
+}
+
+func (x *PruneProfile) GetPrunedSegmentIds() []int64 {
+	if x != nil {
+		return x.PrunedSegmentIds
+	}
+	return nil
+}
+
+func (x *PruneProfile) GetCostUs() int64 {
+	if x != nil {
+		return x.CostUs
+	}
+	return 0
+}
+
+// ShardProfile is the profile collected by the delegator of a channel.
+type ShardProfile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Channel  string            `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	NodeId   int64             `protobuf:"varint,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Pruning  []*PruneProfile   `protobuf:"bytes,3,rep,name=pruning,proto3" json:"pruning,omitempty"`
+	Segments []*SegmentProfile `protobuf:"bytes,4,rep,name=segments,proto3" json:"segments,omitempty"`
+	// the time of the delegator to prune, execute and reduce the shard.
+	CostUs int64 `protobuf:"varint,5,opt,name=cost_us,json=costUs,proto3" json:"cost_us,omitempty"`
+}
+
+func (x *ShardProfile) Reset() {
+	*x = ShardProfile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_explain_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShardProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShardProfile) ProtoMessage() {}
+
+func (x *ShardProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_explain_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShardProfile.ProtoReflect.Descriptor instead.
+func (*ShardProfile) Descriptor() ([]byte, []int) {
+	return file_explain_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ShardProfile) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *ShardProfile) GetNodeId() int64 {
+	if x != nil {
+		return x.NodeId
+	}
+	return 0
+}
+
+func (x *ShardProfile) GetPruning() []*PruneProfile {
+	if x != nil {
+		return x.Pruning
+	}
+	return nil
+}
+
+func (x *ShardProfile) GetSegments() []*SegmentProfile {
+	if x != nil {
+		return x.Segments
+	}
+	return nil
+}
+
+func (x *ShardProfile) GetCostUs() int64 {
+	if x != nil {
+		return x.CostUs
+	}
+	return 0
+}
+
+// IndexUsage counts the executed segments per index type of a field,
+// the segments without an index on the field are counted as NONE.
+type IndexUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Segments map[string]int64 `protobuf:"bytes,1,rep,name=segments,proto3" json:"segments,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *IndexUsage) Reset() {
+	*x = IndexUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_explain_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IndexUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IndexUsage) ProtoMessage() {}
+
+func (x *IndexUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_explain_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IndexUsage.ProtoReflect.Descriptor instead.
+func (*IndexUsage) Descriptor() ([]byte, []int) {
+	return file_explain_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *IndexUsage) GetSegments() map[string]int64 {
+	if x != nil {
+		return x.Segments
+	}
+	return nil
+}
+
+type Summary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Shards           int64 `protobuf:"varint,1,opt,name=shards,proto3" json:"shards,omitempty"`
+	PrunedSegments   int64 `protobuf:"varint,2,opt,name=pruned_segments,json=prunedSegments,proto3" json:"pruned_segments,omitempty"`
+	ExecutedSegments int64 `protobuf:"varint,3,opt,name=executed_segments,json=executedSegments,proto3" json:"executed_segments,omitempty"`
+	TotalRows        int64 `protobuf:"varint,4,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"`
+	// the sum of the segments counting the rows passing the filter.
+	SurvivedRows int64 `protobuf:"varint,5,opt,name=survived_rows,json=survivedRows,proto3" json:"survived_rows,omitempty"`
+	// the index usage of each field name.
+	IndexUsage map[string]*IndexUsage `protobuf:"bytes,6,rep,name=index_usage,json=indexUsage,proto3" json:"index_usage,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Summary) Reset() {
+	*x = Summary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_explain_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Summary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Summary) ProtoMessage() {}
+
+func (x *Summary) ProtoReflect() protoreflect.Message {
+	mi := &file_explain_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Summary.ProtoReflect.Descriptor instead.
+func (*Summary) Descriptor() ([]byte, []int) {
+	return file_explain_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Summary) GetShards() int64 {
+	if x != nil {
+		return x.Shards
+	}
+	return 0
+}
+
+func (x *Summary) GetPrunedSegments() int64 {
+	if x != nil {
+		return x.PrunedSegments
+	}
+	return 0
+}
+
+func (x *Summary) GetExecutedSegments() int64 {
+	if x != nil {
+		return x.ExecutedSegments
+	}
+	return 0
+}
+
+func (x *Summary) GetTotalRows() int64 {
+	if x != nil {
+		return x.TotalRows
+	}
+	return 0
+}
+
+func (x *Summary) GetSurvivedRows() int64 {
+	if x != nil {
+		return x.SurvivedRows
+	}
+	return 0
+}
+
+func (x *Summary) GetIndexUsage() map[string]*IndexUsage {
+	if x != nil {
+		return x.IndexUsage
+	}
+	return nil
+}
+
+var File_explain_proto protoreflect.FileDescriptor
+
+var file_explain_proto_rawDesc = []byte{
+	0x0a, 0x0d, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x14, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x65, 0x78,
+	0x70, 0x6c, 0x61, 0x69, 0x6e, 0x1a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0x54, 0x0a, 0x14, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x52, 0x0a, 0x13, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x07,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x6d, 0x69, 0x6c,
+	0x76, 0x75, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x9e, 0x02, 0x0a, 0x0e, 0x45, 0x78,
+	0x70, 0x6c, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x33, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d,
+	0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x2b, 0x0a, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x12, 0x35,
+	0x0a, 0x04, 0x65, 0x78, 0x70, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d,
+	0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x65, 0x78, 0x70, 0x6c,
+	0x61, 0x69, 0x6e, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x52,
+	0x04, 0x65, 0x78, 0x70, 0x72, 0x12, 0x3a, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x72, 0x64, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x2e, 0x53, 0x68, 0x61,
+	0x72, 0x64, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x06, 0x73, 0x68, 0x61, 0x72, 0x64,
+	0x73, 0x12, 0x37, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x2e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x79, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x22, 0xbe, 0x01, 0x0a, 0x0b, 0x45,
+	0x78, 0x70, 0x72, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x78,
+	0x70, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x65, 0x78, 0x70, 0x72, 0x12, 0x2e,
+	0x0a, 0x06, 0x70, 0x61, 0x72, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x70, 0x61, 0x72, 0x73, 0x65, 0x64, 0x12, 0x34,
+	0x0a, 0x09, 0x72, 0x65, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x72, 0x65, 0x77, 0x72, 0x69,
+	0x74, 0x74, 0x65, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x72, 0x65, 0x77, 0x72, 0x69,
+	0x74, 0x74, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x52, 0x65,
+	0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0xc4, 0x02, 0x0a, 0x0e,
+	0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a,
+	0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x77, 0x69, 0x6e,
+	0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x77, 0x69, 0x6e, 0x67,
+	0x12, 0x19, 0x0a, 0x08, 0x6e, 0x75, 0x6d, 0x5f, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x6e, 0x75, 0x6d, 0x52, 0x6f, 0x77, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x73,
+	0x75, 0x72, 0x76, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0c, 0x73, 0x75, 0x72, 0x76, 0x69, 0x76, 0x65, 0x64, 0x52, 0x6f, 0x77, 0x73,
+	0x12, 0x4b, 0x0a, 0x07, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x31, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x12, 0x17, 0x0a,
+	0x07, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x63, 0x6f, 0x73, 0x74, 0x55, 0x73, 0x1a, 0x3a, 0x0a, 0x0c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0x96, 0x01, 0x0a, 0x0c, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x73,
+	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x65,
+	0x66, 0x6f, 0x72, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x64, 0x5f, 0x73,
+	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x03,
+	0x52, 0x10, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x64, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x75, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x6f, 0x73, 0x74, 0x55, 0x73, 0x22, 0xda, 0x01, 0x0a, 0x0c,
+	0x53, 0x68, 0x61, 0x72, 0x64, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12,
+	0x3c, 0x0a, 0x07, 0x70, 0x72, 0x75, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x65, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x2e, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x52, 0x07, 0x70, 0x72, 0x75, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x40, 0x0a,
+	0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x65,
+	0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x17, 0x0a, 0x07, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x63, 0x6f, 0x73, 0x74, 0x55, 0x73, 0x22, 0x95, 0x01, 0x0a, 0x0a, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x4a, 0x0a, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6d, 0x69, 0x6c, 0x76,
+	0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e,
+	0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x55, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x65, 0x67, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x1a, 0x3b, 0x0a, 0x0d, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0xec, 0x02, 0x0a, 0x07, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x68, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x68,
+	0x61, 0x72, 0x64, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x64, 0x5f, 0x73,
+	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x70,
+	0x72, 0x75, 0x6e, 0x65, 0x64, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2b, 0x0a,
+	0x11, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x65, 0x64, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x5f, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x6f, 0x77, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x75, 0x72,
+	0x76, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0c, 0x73, 0x75, 0x72, 0x76, 0x69, 0x76, 0x65, 0x64, 0x52, 0x6f, 0x77, 0x73, 0x12, 0x4e,
+	0x0a, 0x0b, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x2e, 0x53, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x55, 0x73, 0x61, 0x67, 0x65, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x0a, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x55, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x5f,
+	0x0a, 0x0f, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x55, 0x73, 0x61, 0x67, 0x65, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x36, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x55,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x32,
+	0xd8, 0x01, 0x0a, 0x0e, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x63, 0x0a, 0x0d, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x12, 0x2a, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x2e, 0x45, 0x78, 0x70, 0x6c, 0x61,
+	0x69, 0x6e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x24, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x65,
+	0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x2e, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x0c, 0x45, 0x78, 0x70, 0x6c, 0x61,
+	0x69, 0x6e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x29, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x2e, 0x45,
+	0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x2e, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69,
+	0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x00, 0x42, 0x37, 0x5a, 0x35, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2d,
+	0x69, 0x6f, 0x2f, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x2f, 0x76, 0x33, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x69,
+	0x6e, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_explain_proto_rawDescOnce sync.Once
+	file_explain_proto_rawDescData = file_explain_proto_rawDesc
+)
+
+func file_explain_proto_rawDescGZIP() []byte {
+	file_explain_proto_rawDescOnce.Do(func() {
+		file_explain_proto_rawDescData = protoimpl.X.CompressGZIP(file_explain_proto_rawDescData)
+	})
+	return file_explain_proto_rawDescData
+}
+
+var file_explain_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_explain_proto_goTypes = []interface{}{
+	(*ExplainSearchRequest)(nil),   // 0: milvus.proto.explain.ExplainSearchRequest
+	(*ExplainQueryRequest)(nil),    // 1: milvus.proto.explain.ExplainQueryRequest
+	(*ExplainResults)(nil),         // 2: milvus.proto.explain.ExplainResults
+	(*ExprExplain)(nil),            // 3: milvus.proto.explain.ExprExplain
+	(*SegmentProfile)(nil),         // 4: milvus.proto.explain.SegmentProfile
+	(*PruneProfile)(nil),           // 5: milvus.proto.explain.PruneProfile
+	(*ShardProfile)(nil),           // 6: milvus.proto.explain.ShardProfile
+	(*IndexUsage)(nil),             // 7: milvus.proto.explain.IndexUsage
+	(*Summary)(nil),                // 8: milvus.proto.explain.Summary
+	nil,                            // 9: milvus.proto.explain.SegmentProfile.IndexesEntry
+	nil,                            // 10: milvus.proto.explain.IndexUsage.SegmentsEntry
+	nil,                            // 11: milvus.proto.explain.Summary.IndexUsageEntry
+	(*milvuspb.SearchRequest)(nil), // 12: milvus.proto.milvus.SearchRequest
+	(*milvuspb.QueryRequest)(nil),  // 13: milvus.proto.milvus.QueryRequest
+	(*commonpb.Status)(nil),        // 14: milvus.proto.common.Status
+	(*structpb.Struct)(nil),        // 15: google.protobuf.Struct
+	(*structpb.Value)(nil),         // 16: google.protobuf.Value
+}
+var file_explain_proto_depIdxs = []int32{
+	12, // 0: milvus.proto.explain.ExplainSearchRequest.request:type_name -> milvus.proto.milvus.SearchRequest
+	13, // 1: milvus.proto.explain.ExplainQueryRequest.request:type_name -> milvus.proto.milvus.QueryRequest
+	14, // 2: milvus.proto.explain.ExplainResults.status:type_name -> milvus.proto.common.Status
+	15, // 3: milvus.proto.explain.ExplainResults.plan:type_name -> google.protobuf.Struct
+	3,  // 4: milvus.proto.explain.ExplainResults.expr:type_name -> milvus.proto.explain.ExprExplain
+	6,  // 5: milvus.proto.explain.ExplainResults.shards:type_name -> milvus.proto.explain.ShardProfile
+	8,  // 6: milvus.proto.explain.ExplainResults.summary:type_name -> milvus.proto.explain.Summary
+	16, // 7: milvus.proto.explain.ExprExplain.parsed:type_name -> google.protobuf.Value
+	16, // 8: milvus.proto.explain.ExprExplain.rewritten:type_name -> google.protobuf.Value
+	9,  // 9: milvus.proto.explain.SegmentProfile.indexes:type_name -> milvus.proto.explain.SegmentProfile.IndexesEntry
+	5,  // 10: milvus.proto.explain.ShardProfile.pruning:type_name -> milvus.proto.explain.PruneProfile
+	4,  // 11: milvus.proto.explain.ShardProfile.segments:type_name -> milvus.proto.explain.SegmentProfile
+	10, // 12: milvus.proto.explain.IndexUsage.segments:type_name -> milvus.proto.explain.IndexUsage.SegmentsEntry
+	11, // 13: milvus.proto.explain.Summary.index_usage:type_name -> milvus.proto.explain.Summary.IndexUsageEntry
+	7,  // 14: milvus.proto.explain.Summary.IndexUsageEntry.value:type_name -> milvus.proto.explain.IndexUsage
+	0,  // 15: milvus.proto.explain.ExplainService.ExplainSearch:input_type -> milvus.proto.explain.ExplainSearchRequest
+	1,  // 16: milvus.proto.explain.ExplainService.ExplainQuery:input_type -> milvus.proto.explain.ExplainQueryRequest
+	2,  // 17: milvus.proto.explain.ExplainService.ExplainSearch:output_type -> milvus.proto.explain.ExplainResults
+	2,  // 18: milvus.proto.explain.ExplainService.ExplainQuery:output_type -> milvus.proto.explain.ExplainResults
+	17, // [17:19] is the sub-list for method output_type
+	15, // [15:17] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
+}
+
+func init() { file_explain_proto_init() }
+func file_explain_proto_init() {
+	if File_explain_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_explain_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExplainSearchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_explain_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExplainQueryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_explain_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExplainResults); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_explain_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExprExplain); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_explain_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SegmentProfile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_explain_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PruneProfile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_explain_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShardProfile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_explain_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IndexUsage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_explain_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Summary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_explain_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_explain_proto_goTypes,
+		DependencyIndexes: file_explain_proto_depIdxs,
+		MessageInfos:      file_explain_proto_msgTypes,
+	}.Build()
+	File_explain_proto = out.File
+	file_explain_proto_rawDesc = nil
+	file_explain_proto_goTypes = nil
+	file_explain_proto_depIdxs = nil
+}
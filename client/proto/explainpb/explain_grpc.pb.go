@@ -0,0 +1,144 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v5.27.0
+// source: explain.proto
+
+package explainpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	ExplainService_ExplainSearch_FullMethodName = "/milvus.proto.explain.ExplainService/ExplainSearch"
+	ExplainService_ExplainQuery_FullMethodName  = "/milvus.proto.explain.ExplainService/ExplainQuery"
+)
+
+// ExplainServiceClient is the client API for ExplainService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ExplainServiceClient interface {
+	ExplainSearch(ctx context.Context, in *ExplainSearchRequest, opts ...grpc.CallOption) (*ExplainResults, error)
+	ExplainQuery(ctx context.Context, in *ExplainQueryRequest, opts ...grpc.CallOption) (*ExplainResults, error)
+}
+
+type explainServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewExplainServiceClient(cc grpc.ClientConnInterface) ExplainServiceClient {
+	return &explainServiceClient{cc}
+}
+
+func (c *explainServiceClient) ExplainSearch(ctx context.Context, in *ExplainSearchRequest, opts ...grpc.CallOption) (*ExplainResults, error) {
+	out := new(ExplainResults)
+	err := c.cc.Invoke(ctx, ExplainService_ExplainSearch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *explainServiceClient) ExplainQuery(ctx context.Context, in *ExplainQueryRequest, opts ...grpc.CallOption) (*ExplainResults, error) {
+	out := new(ExplainResults)
+	err := c.cc.Invoke(ctx, ExplainService_ExplainQuery_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ExplainServiceServer is the server API for ExplainService service.
+// All implementations should embed UnimplementedExplainServiceServer
+// for forward compatibility
+type ExplainServiceServer interface {
+	ExplainSearch(context.Context, *ExplainSearchRequest) (*ExplainResults, error)
+	ExplainQuery(context.Context, *ExplainQueryRequest) (*ExplainResults, error)
+}
+
+// UnimplementedExplainServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedExplainServiceServer struct {
+}
+
+func (UnimplementedExplainServiceServer) ExplainSearch(context.Context, *ExplainSearchRequest) (*ExplainResults, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExplainSearch not implemented")
+}
+func (UnimplementedExplainServiceServer) ExplainQuery(context.Context, *ExplainQueryRequest) (*ExplainResults, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExplainQuery not implemented")
+}
+
+// UnsafeExplainServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ExplainServiceServer will
+// result in compilation errors.
+type UnsafeExplainServiceServer interface {
+	mustEmbedUnimplementedExplainServiceServer()
+}
+
+func RegisterExplainServiceServer(s grpc.ServiceRegistrar, srv ExplainServiceServer) {
+	s.RegisterService(&ExplainService_ServiceDesc, srv)
+}
+
+func _ExplainService_ExplainSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExplainSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExplainServiceServer).ExplainSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExplainService_ExplainSearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExplainServiceServer).ExplainSearch(ctx, req.(*ExplainSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExplainService_ExplainQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExplainQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExplainServiceServer).ExplainQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExplainService_ExplainQuery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExplainServiceServer).ExplainQuery(ctx, req.(*ExplainQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ExplainService_ServiceDesc is the grpc.ServiceDesc for ExplainService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ExplainService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "milvus.proto.explain.ExplainService",
+	HandlerType: (*ExplainServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ExplainSearch",
+			Handler:    _ExplainService_ExplainSearch_Handler,
+		},
+		{
+			MethodName: "ExplainQuery",
+			Handler:    _ExplainService_ExplainQuery_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "explain.proto",
+}
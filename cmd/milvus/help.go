@@ -13,7 +13,7 @@ const (
 
 var (
 	usageLine = fmt.Sprintf("Usage:\n"+
-		"%s\n%s\n%s\n%s\n", runLine, stopLine, mckLine, serverTypeLine)
+		"%s\n%s\n%s\n%s\n%s\n%s\n", runLine, stopLine, mckLine, inspectLine, metaCheckLine, serverTypeLine)
 
 	serverTypeLine = `
 [server type]
@@ -64,5 +64,41 @@ milvus mck run [flags]
 milvus mck cleanTrash [flags]
 	Clean the back inconsistent data
 	Tips: The flags is the same as its of the 'milvus mck [flags]'
+`
+	inspectLine = `
+milvus inspect [flags]
+	Inspect the segments of a collection offline, the meta and the object storage are read only.
+	Tips: The meta store and the object storage are configured by the milvus config.
+[flags]
+	-collection ''
+		Id of the collection to inspect, required.
+	-segment ''
+		Comma separated ids of the segments to inspect, all the segments if empty.
+	-config ''
+		Path of the milvus config file, the default config is used if empty.
+	-detail 'false'
+		Print the paths of the insert, delta, stats and index files.
+	-json 'false'
+		Print the reports as json.
+	-dump ''
+		Path of the jsonl file to dump the rows of the segments into, the deletions are not applied.
+`
+	metaCheckLine = `
+milvus metacheck [flags]
+	Check the consistency of the meta of rootcoord, datacoord and querycoord and the object storage offline.
+	Reports the orphaned segments, the missing binlogs, the indexes of the dropped fields,
+	the partitions without collection, the aliases of the dropped collections and the load info of the dropped collections.
+	Tips: The check is a dry run, the meta is only modified with '-repair'. Stop milvus before repairing.
+[flags]
+	-config ''
+		Path of the milvus config file, the default config is used if empty.
+	-skipStorage 'false'
+		Skip checking the binlogs in the object storage.
+	-json 'false'
+		Print the report as json.
+	-repair 'false'
+		Repair the repairable issues after printing the report and confirming.
+	-yes 'false'
+		Repair without confirmation.
 `
 )
@@ -0,0 +1,252 @@
+package milvus
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus/internal/compaction"
+	"github.com/milvus-io/milvus/internal/json"
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	sqlkv "github.com/milvus-io/milvus/internal/kv/sql"
+	kv_tikv "github.com/milvus-io/milvus/internal/kv/tikv"
+	"github.com/milvus-io/milvus/internal/metastore/kv/datacoord"
+	kvmetestore "github.com/milvus-io/milvus/internal/metastore/kv/rootcoord"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/segmentinspect"
+	"github.com/milvus-io/milvus/pkg/v3/kv"
+	"github.com/milvus-io/milvus/pkg/v3/util"
+	"github.com/milvus-io/milvus/pkg/v3/util/etcd"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v3/util/tikv"
+)
+
+const (
+	InspectCmd = "inspect"
+)
+
+type inspect struct {
+	configPath   string
+	collectionID int64
+	segmentIDs   []int64
+	detail       bool
+	jsonOutput   bool
+	dumpPath     string
+}
+
+func (c *inspect) execute(args []string, flags *flag.FlagSet) {
+	flags.Usage = func() {
+		fmt.Fprintln(os.Stderr, inspectLine)
+	}
+	var segments string
+	flags.StringVar(&c.configPath, "config", "", "path of the milvus config file")
+	flags.Int64Var(&c.collectionID, "collection", 0, "id of the collection to inspect")
+	flags.StringVar(&segments, "segment", "", "comma separated ids of the segments to inspect")
+	flags.BoolVar(&c.detail, "detail", false, "print the paths of the files")
+	flags.BoolVar(&c.jsonOutput, "json", false, "print the reports as json")
+	flags.StringVar(&c.dumpPath, "dump", "", "path of the jsonl file to dump the rows into")
+	if err := flags.Parse(args[2:]); err != nil {
+		os.Exit(-1)
+	}
+	if c.collectionID == 0 {
+		fmt.Fprintln(os.Stderr, inspectLine)
+		os.Exit(-1)
+	}
+	for _, s := range strings.Split(segments, ",") {
+		if s = strings.TrimSpace(s); s == "" {
+			continue
+		}
+		segmentID, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "invalid segment id %s\n", s)
+			os.Exit(-1)
+		}
+		c.segmentIDs = append(c.segmentIDs, segmentID)
+	}
+
+	if err := c.run(context.Background()); err != nil {
+		fmt.Fprintf(os.Stderr, "inspect failed: %s\n", err.Error())
+		os.Exit(1)
+	}
+}
+
+func (c *inspect) run(ctx context.Context) error {
+	params := prepareToolParams(c.configPath)
+	metaKV, err := newToolMetaKV(params)
+	if err != nil {
+		return errors.Wrap(err, "failed to connect the meta store")
+	}
+	defer metaKV.Close()
+	metaKV = segmentinspect.NewReadOnlyKV(metaKV)
+
+	chunkManager, err := storage.NewChunkManagerFactoryWithParam(params).NewPersistentStorageChunkManager(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to connect the object storage")
+	}
+	inspector := segmentinspect.NewInspector(
+		kvmetestore.NewCatalog(metaKV),
+		datacoord.NewCatalog(metaKV, chunkManager.RootPath(), metaRootPath(params)),
+		chunkManager,
+		compaction.CreateStorageConfig(),
+	)
+
+	coll, reports, err := inspector.Inspect(ctx, c.collectionID, c.segmentIDs...)
+	if err != nil {
+		return err
+	}
+	if c.jsonOutput {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(reports); err != nil {
+			return err
+		}
+	} else {
+		fmt.Fprintf(os.Stdout, "collection %d (%s), schema version %d, %d segments\n",
+			coll.CollectionID, coll.Name, coll.SchemaVersion, len(reports))
+		for _, report := range reports {
+			printSegmentReport(os.Stdout, report, c.detail)
+		}
+	}
+
+	if c.dumpPath == "" {
+		return nil
+	}
+	f, err := os.Create(c.dumpPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	w := bufio.NewWriter(f)
+	schema := coll.ToCollectionSchemaPB()
+	var total int64
+	for _, report := range reports {
+		count, err := inspector.DumpRows(ctx, w, schema, report)
+		if err != nil {
+			return errors.Wrapf(err, "failed to dump segment %d", report.SegmentID)
+		}
+		total += count
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "dumped %d rows into %s\n", total, c.dumpPath)
+	return nil
+}
+
+func printSegmentReport(w io.Writer, report *segmentinspect.SegmentReport, detail bool) {
+	fmt.Fprintf(w, "\nsegment %d\n", report.SegmentID)
+	fmt.Fprintf(w, "  partition: %d, channel: %s\n", report.PartitionID, report.Channel)
+	fmt.Fprintf(w, "  state: %s, level: %s, storage version: %d\n", report.State, report.Level, report.StorageVersion)
+	if report.ManifestPath != "" {
+		fmt.Fprintf(w, "  manifest: %s\n", report.ManifestPath)
+	}
+	fmt.Fprintf(w, "  rows: %d, binlog rows: %d, deleted rows: %d\n", report.NumRows, report.BinlogRows, report.DeletedRows)
+	fmt.Fprintf(w, "  schema version: %d (collection %d)\n", report.SchemaVersion, report.CollectionSchemaVersion)
+	if report.PKRangeError != "" {
+		fmt.Fprintf(w, "  pk range: unavailable, %s\n", report.PKRangeError)
+	} else {
+		fmt.Fprintf(w, "  pk range: [%v, %v]\n", report.MinPK, report.MaxPK)
+	}
+	printFieldLogs(w, "insert logs", report.InsertLogs, detail)
+	fmt.Fprintf(w, "  delta logs: %d\n", len(report.DeltaLogs))
+	if detail {
+		for _, p := range report.DeltaLogs {
+			fmt.Fprintf(w, "    %s\n", p)
+		}
+	}
+	printFieldLogs(w, "stats logs", report.StatsLogs, detail)
+	printFieldLogs(w, "bm25 logs", report.BM25Logs, detail)
+	fmt.Fprintf(w, "  indexes: %d\n", len(report.Indexes))
+	for _, index := range report.Indexes {
+		fmt.Fprintf(w, "    %s (index %d, field %d, build %d, version %d): %s, %d files\n",
+			index.IndexName, index.IndexID, index.FieldID, index.BuildID, index.IndexVersion, index.State, len(index.Files))
+		if detail {
+			for _, p := range index.Files {
+				fmt.Fprintf(w, "      %s\n", p)
+			}
+		}
+	}
+}
+
+func printFieldLogs(w io.Writer, name string, logs map[int64][]string, detail bool) {
+	fieldIDs := make([]int64, 0, len(logs))
+	count := 0
+	for fieldID, paths := range logs {
+		fieldIDs = append(fieldIDs, fieldID)
+		count += len(paths)
+	}
+	sort.Slice(fieldIDs, func(i, j int) bool { return fieldIDs[i] < fieldIDs[j] })
+	fmt.Fprintf(w, "  %s: %d\n", name, count)
+	if !detail {
+		return
+	}
+	for _, fieldID := range fieldIDs {
+		fmt.Fprintf(w, "    field %d:\n", fieldID)
+		for _, p := range logs[fieldID] {
+			fmt.Fprintf(w, "      %s\n", p)
+		}
+	}
+}
+
+// prepareToolParams initializes the params for the offline tools, from the config file if given.
+func prepareToolParams(configPath string) *paramtable.ComponentParam {
+	if configPath != "" {
+		paramtable.Get().Init(paramtable.NewBaseTableFromYamlOnly(configPath))
+	} else {
+		paramtable.Init()
+	}
+	return paramtable.Get()
+}
+
+func metaRootPath(params *paramtable.ComponentParam) string {
+	switch params.MetaStoreCfg.MetaStoreType.GetValue() {
+	case util.MetaStoreTypeTiKV:
+		return params.TiKVCfg.MetaRootPath.GetValue()
+	case util.MetaStoreTypeSQL:
+		return params.SQLCfg.MetaRootPath.GetValue()
+	default:
+		return params.EtcdCfg.MetaRootPath.GetValue()
+	}
+}
+
+// newToolMetaKV connects the meta store configured for the offline tools.
+func newToolMetaKV(params *paramtable.ComponentParam) (kv.MetaKv, error) {
+	switch params.MetaStoreCfg.MetaStoreType.GetValue() {
+	case util.MetaStoreTypeTiKV:
+		tikvCli, err := tikv.GetTiKVClient(&params.TiKVCfg)
+		if err != nil {
+			return nil, err
+		}
+		return kv_tikv.NewTiKV(tikvCli, params.TiKVCfg.MetaRootPath.GetValue(),
+			kv_tikv.WithRequestTimeout(params.TiKVCfg.RequestTimeout.GetAsDuration(time.Millisecond))), nil
+	case util.MetaStoreTypeSQL:
+		return sqlkv.NewMetaKvFactory(params.SQLCfg.MetaRootPath.GetValue(), &params.SQLCfg)
+	default:
+		etcdCli, err := etcd.CreateEtcdClient(
+			params.EtcdCfg.UseEmbedEtcd.GetAsBool(),
+			params.EtcdCfg.EtcdEnableAuth.GetAsBool(),
+			params.EtcdCfg.EtcdAuthUserName.GetValue(),
+			params.EtcdCfg.EtcdAuthPassword.GetValue(),
+			params.EtcdCfg.EtcdUseSSL.GetAsBool(),
+			params.EtcdCfg.Endpoints.GetAsStrings(),
+			params.EtcdCfg.EtcdTLSCert.GetValue(),
+			params.EtcdCfg.EtcdTLSKey.GetValue(),
+			params.EtcdCfg.EtcdTLSCACert.GetValue(),
+			params.EtcdCfg.EtcdTLSMinVersion.GetValue(),
+			params.EtcdCfg.ClientOptions()...)
+		if err != nil {
+			return nil, err
+		}
+		return etcdkv.NewEtcdKV(etcdCli, params.EtcdCfg.MetaRootPath.GetValue(),
+			etcdkv.WithRequestTimeout(params.EtcdCfg.RequestTimeout.GetAsDuration(time.Millisecond))), nil
+	}
+}
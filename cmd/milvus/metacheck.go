@@ -0,0 +1,135 @@
+package milvus
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus/internal/json"
+	"github.com/milvus-io/milvus/internal/metastore/kv/binlog"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/metacheck"
+	"github.com/milvus-io/milvus/internal/util/segmentinspect"
+)
+
+const (
+	MetaCheckCmd = "metacheck"
+)
+
+type metaCheck struct {
+	configPath  string
+	skipStorage bool
+	jsonOutput  bool
+	repair      bool
+	yes         bool
+}
+
+func (c *metaCheck) execute(args []string, flags *flag.FlagSet) {
+	flags.Usage = func() {
+		fmt.Fprintln(os.Stderr, metaCheckLine)
+	}
+	flags.StringVar(&c.configPath, "config", "", "path of the milvus config file")
+	flags.BoolVar(&c.skipStorage, "skipStorage", false, "skip checking the binlogs in the object storage")
+	flags.BoolVar(&c.jsonOutput, "json", false, "print the report as json")
+	flags.BoolVar(&c.repair, "repair", false, "repair the issues after the check")
+	flags.BoolVar(&c.yes, "yes", false, "repair without confirmation")
+	if err := flags.Parse(args[2:]); err != nil {
+		os.Exit(-1)
+	}
+
+	if err := c.run(context.Background()); err != nil {
+		fmt.Fprintf(os.Stderr, "metacheck failed: %s\n", err.Error())
+		os.Exit(1)
+	}
+}
+
+func (c *metaCheck) run(ctx context.Context) error {
+	params := prepareToolParams(c.configPath)
+	metaKV, err := newToolMetaKV(params)
+	if err != nil {
+		return errors.Wrap(err, "failed to connect the meta store")
+	}
+	defer metaKV.Close()
+	if !c.repair {
+		// the dry run never modifies the meta
+		metaKV = segmentinspect.NewReadOnlyKV(metaKV)
+	}
+
+	var chunkManager storage.ChunkManager
+	chunkManagerRootPath := binlog.GetRootPath()
+	if !c.skipStorage {
+		chunkManager, err = storage.NewChunkManagerFactoryWithParam(params).NewPersistentStorageChunkManager(ctx)
+		if err != nil {
+			return errors.Wrap(err, "failed to connect the object storage")
+		}
+		chunkManagerRootPath = chunkManager.RootPath()
+	}
+	checker := metacheck.NewChecker(metaKV, metaRootPath(params), chunkManagerRootPath, chunkManager)
+
+	report, err := checker.Check(ctx)
+	if err != nil {
+		return err
+	}
+	if c.jsonOutput {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(report); err != nil {
+			return err
+		}
+	} else {
+		printMetaCheckReport(os.Stdout, report)
+	}
+
+	repairable := 0
+	for _, issue := range report.Issues {
+		if issue.Repairable() {
+			repairable++
+		}
+	}
+	if !c.repair || repairable == 0 {
+		return nil
+	}
+	if !c.yes && !confirm(os.Stdin, os.Stderr, fmt.Sprintf("%d issues will be repaired, make sure milvus is stopped, type 'yes' to continue: ", repairable)) {
+		fmt.Fprintln(os.Stderr, "repair aborted")
+		return nil
+	}
+	repaired, err := checker.Repair(ctx, report.Issues)
+	fmt.Fprintf(os.Stderr, "%d of %d issues repaired\n", repaired, repairable)
+	return err
+}
+
+func printMetaCheckReport(w io.Writer, report *metacheck.Report) {
+	fmt.Fprintf(w, "checked %d databases, %d collections, %d segments, %d indexes, %d aliases, %d files\n",
+		report.Databases, report.Collections, report.Segments, report.Indexes, report.Aliases, report.CheckedFiles)
+	if len(report.Issues) == 0 {
+		fmt.Fprintln(w, "no issue found")
+		return
+	}
+	fmt.Fprintf(w, "%d issues found\n", len(report.Issues))
+	for _, issue := range report.Issues {
+		fmt.Fprintf(w, "\n[%s] %s\n", issue.Type, issue.Detail)
+		for _, p := range issue.Paths {
+			fmt.Fprintf(w, "  missing: %s\n", p)
+		}
+		if issue.Repairable() {
+			fmt.Fprintf(w, "  repair: %s\n", issue.Repair)
+		} else {
+			fmt.Fprintln(w, "  repair: not repairable")
+		}
+	}
+}
+
+func confirm(r io.Reader, w io.Writer, prompt string) bool {
+	fmt.Fprint(w, prompt)
+	answer, err := bufio.NewReader(r).ReadString('\n')
+	if err != nil && answer == "" {
+		return false
+	}
+	return strings.TrimSpace(answer) == "yes"
+}
@@ -54,6 +54,10 @@ func RunMilvus(args []string) {
 		c = &dryRun{}
 	case MckCmd:
 		c = &mck{}
+	case InspectCmd:
+		c = &inspect{}
+	case MetaCheckCmd:
+		c = &metaCheck{}
 	default:
 		c = &defaultCommand{}
 	}
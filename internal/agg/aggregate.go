@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"reflect"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/milvus-io/milvus-proto/go-api/v3/schemapb"
@@ -17,12 +18,21 @@ const (
 	kAvg   = "avg"
 	kMin   = "min"
 	kMax   = "max"
+
+	// approximate aggregates, computed with mergeable sketches
+	kCountDistinct = "count_distinct"
+	kPercentile    = "percentile"
+	kMedian        = "median"
+	kStddev        = "stddev"
+	kVariance      = "variance"
 )
 
 var (
 	// Define the regular expression pattern once to avoid repeated concatenation.
-	aggregationTypes   = kSum + `|` + kCount + `|` + kAvg + `|` + kMin + `|` + kMax
-	aggregationPattern = regexp.MustCompile(`(?i)^(` + aggregationTypes + `)\s*\(\s*([\w\*]*)\s*\)$`)
+	aggregationTypes = kSum + `|` + kCount + `|` + kAvg + `|` + kMin + `|` + kMax + `|` +
+		kCountDistinct + `|` + kPercentile + `|` + kMedian + `|` + kStddev + `|` + kVariance
+	// the optional argument is only accepted by percentile, e.g. percentile(price, 0.9)
+	aggregationPattern = regexp.MustCompile(`(?i)^(` + aggregationTypes + `)\s*\(\s*([\w\*]*)\s*(?:,\s*([^\)]*?)\s*)?\)$`)
 )
 
 // MatchAggregationExpression return isAgg, operator name, operator parameter
@@ -51,11 +61,37 @@ func isSupportedAggregateName(aggregateName string) bool {
 	switch aggregateName {
 	case kCount, kSum, kAvg, kMin, kMax:
 		return true
+	case kCountDistinct, kPercentile, kMedian, kStddev, kVariance:
+		return true
 	default:
 		return false
 	}
 }
 
+// parsePercentileArgument returns the quantile of percentile(field, quantile) expression.
+func parsePercentileArgument(expression string) (float64, error) {
+	matches := aggregationPattern.FindStringSubmatch(expression)
+	if len(matches) < 4 || matches[3] == "" {
+		return 0, merr.WrapErrParameterInvalidMsg("percentile requires a quantile argument, such as percentile(field, 0.9)")
+	}
+	return ParsePercentileQuantile(matches[3])
+}
+
+// NewPercentileAggregate returns a percentile aggregate whose quantile is given
+// explicitly instead of being parsed from originalName.
+func NewPercentileAggregate(aggFieldID int64, originalName string, quantile float64) AggregateBase {
+	return &PercentileAggregate{fieldID: aggFieldID, originalName: originalName, name: kPercentile, quantile: quantile}
+}
+
+// ParsePercentileQuantile parses the quantile of a percentile argument, it should be in [0, 1].
+func ParsePercentileQuantile(argument string) (float64, error) {
+	quantile, err := strconv.ParseFloat(strings.TrimSpace(argument), 64)
+	if err != nil || quantile < 0 || quantile > 1 {
+		return 0, merr.WrapErrParameterInvalidMsg("invalid percentile quantile %s, it should be in [0, 1]", argument)
+	}
+	return quantile, nil
+}
+
 func NewAggregate(aggregateName string, aggFieldID int64, originalName string, fieldType schemapb.DataType) ([]AggregateBase, error) {
 	if !isSupportedAggregateName(aggregateName) {
 		return nil, merr.WrapErrParameterInvalidMsg("invalid Aggregation operator %s", aggregateName)
@@ -64,6 +100,9 @@ func NewAggregate(aggregateName string, aggFieldID int64, originalName string, f
 	if err := ValidateAggFieldType(aggregateName, fieldType); err != nil {
 		return nil, err
 	}
+	if matches := aggregationPattern.FindStringSubmatch(originalName); aggregateName != kPercentile && len(matches) > 3 && matches[3] != "" {
+		return nil, merr.WrapErrParameterInvalidMsg("aggregation operator %s does not accept arguments", aggregateName)
+	}
 
 	switch aggregateName {
 	case kCount:
@@ -77,6 +116,20 @@ func NewAggregate(aggregateName string, aggFieldID int64, originalName string, f
 		return []AggregateBase{&MinAggregate{fieldID: aggFieldID, originalName: originalName}}, nil
 	case kMax:
 		return []AggregateBase{&MaxAggregate{fieldID: aggFieldID, originalName: originalName}}, nil
+	case kCountDistinct:
+		return []AggregateBase{&CountDistinctAggregate{fieldID: aggFieldID, originalName: originalName}}, nil
+	case kPercentile:
+		quantile, err := parsePercentileArgument(originalName)
+		if err != nil {
+			return nil, err
+		}
+		return []AggregateBase{NewPercentileAggregate(aggFieldID, originalName, quantile)}, nil
+	case kMedian:
+		return []AggregateBase{&PercentileAggregate{fieldID: aggFieldID, originalName: originalName, name: kMedian, quantile: 0.5}}, nil
+	case kStddev:
+		return []AggregateBase{&VarianceAggregate{fieldID: aggFieldID, originalName: originalName, stddev: true}}, nil
+	case kVariance:
+		return []AggregateBase{&VarianceAggregate{fieldID: aggFieldID, originalName: originalName}}, nil
 	default:
 		// should never happen due to isSupportedAggregateName check
 		return nil, merr.WrapErrParameterInvalidMsg("invalid Aggregation operator %s", aggregateName)
@@ -93,6 +146,8 @@ func FromPB(pb *planpb.Aggregate) (AggregateBase, error) {
 		return &MinAggregate{fieldID: pb.GetFieldId()}, nil
 	case planpb.AggregateOp_max:
 		return &MaxAggregate{fieldID: pb.GetFieldId()}, nil
+	case planpb.AggregateOp_hyperloglog, planpb.AggregateOp_tdigest, planpb.AggregateOp_moments:
+		return &SketchStateAggregate{op: pb.GetOp(), fieldID: pb.GetFieldId()}, nil
 	default:
 		return nil, merr.WrapErrParameterInvalidMsg("invalid Aggregation operator %d", pb.Op)
 	}
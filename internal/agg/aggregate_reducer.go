@@ -97,6 +97,11 @@ func (reducer *GroupAggReducer) EmptyAggResult() (*AggregationResult, error) {
 			if err != nil {
 				return nil, merr.Wrapf(err, "failed to generate empty field data for result type %s", resultType.String())
 			}
+			if isSketchOp(agg.GetOp()) {
+				// nothing is sketched, a null state
+				emptyFieldData.GetScalars().GetStringData().Data = []string{""}
+				emptyFieldData.ValidData = []bool{false}
+			}
 			ret.fieldDatas = append(ret.fieldDatas, emptyFieldData)
 		}
 	}
@@ -176,6 +181,9 @@ func getAggregateResultType(op planpb.AggregateOp, inputType schemapb.DataType)
 	case planpb.AggregateOp_min, planpb.AggregateOp_max:
 		// min/max keep the original field type
 		return inputType, nil
+	case planpb.AggregateOp_hyperloglog, planpb.AggregateOp_tdigest, planpb.AggregateOp_moments:
+		// the sketches are shipped encoded, see encodeSketch
+		return schemapb.DataType_VarChar, nil
 	case planpb.AggregateOp_sum:
 		// sum returns Int64 for integer types, Double for float types
 		switch inputType {
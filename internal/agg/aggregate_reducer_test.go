@@ -297,3 +297,105 @@ func TestGroupAggReducer_MaxGroupByGroupsJustOverLimit(t *testing.T) {
 	require.Error(t, err)
 	assert.True(t, strings.Contains(err.Error(), "too many groups"))
 }
+
+func TestGroupAggReducer_SketchStates(t *testing.T) {
+	encode := func(values ...int64) string {
+		m := &moments{}
+		for _, v := range values {
+			m.add(float64(v))
+		}
+		encoded, err := encodeSketch(m)
+		require.NoError(t, err)
+		return encoded
+	}
+	stateData := func(states []string, valid []bool) *schemapb.FieldData {
+		return &schemapb.FieldData{
+			Type:      schemapb.DataType_VarChar,
+			ValidData: valid,
+			Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: states}},
+			}},
+		}
+	}
+	variance, err := NewAggregate(kVariance, 2, "variance(value)", schemapb.DataType_Int64)
+	require.NoError(t, err)
+	aggregates := []*planpb.Aggregate{variance[0].ToPB()}
+	require.Equal(t, planpb.AggregateOp_moments, aggregates[0].GetOp())
+
+	t.Run("group by", func(t *testing.T) {
+		reducer := NewGroupAggReducer([]int64{1}, aggregates, -1, makeTestSchema())
+		categories := func(data ...string) *schemapb.FieldData {
+			return &schemapb.FieldData{
+				Type: schemapb.DataType_VarChar,
+				Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: data}},
+				}},
+			}
+		}
+		results := []*AggregationResult{
+			NewAggregationResult([]*schemapb.FieldData{
+				categories("a", "b"),
+				stateData([]string{encode(1, 2), ""}, []bool{true, false}),
+			}, 2),
+			NewAggregationResult([]*schemapb.FieldData{
+				categories("a", "b", "c"),
+				stateData([]string{encode(3), encode(5, 5), ""}, []bool{true, true, false}),
+			}, 3),
+		}
+		reduced, err := reducer.Reduce(context.Background(), results)
+		require.NoError(t, err)
+		fieldDatas := reduced.GetFieldDatas()
+		require.Len(t, fieldDatas, 2)
+
+		computed, err := ComputeSketchResult(variance[0], fieldDatas[1])
+		require.NoError(t, err)
+		require.Equal(t, schemapb.DataType_Double, computed.GetType())
+		values := computed.GetScalars().GetDoubleData().GetData()
+		valid := computed.GetValidData()
+		for i, category := range fieldDatas[0].GetScalars().GetStringData().GetData() {
+			switch category {
+			case "a":
+				// the sample variance of 1, 2 and 3
+				assert.True(t, valid[i])
+				assert.InDelta(t, 1.0, values[i], 1e-9)
+			case "b":
+				assert.True(t, valid[i])
+				assert.InDelta(t, 0.0, values[i], 1e-9)
+			case "c":
+				assert.False(t, valid[i])
+			default:
+				t.Fatalf("unexpected category %s", category)
+			}
+		}
+	})
+
+	t.Run("global", func(t *testing.T) {
+		reducer := NewGroupAggReducer(nil, aggregates, -1, makeTestSchema())
+		results := []*AggregationResult{
+			NewAggregationResult([]*schemapb.FieldData{stateData([]string{encode(1, 2)}, []bool{true})}, 2),
+			NewAggregationResult([]*schemapb.FieldData{stateData([]string{""}, []bool{false})}, 0),
+			NewAggregationResult([]*schemapb.FieldData{stateData([]string{encode(3, 4, 5)}, []bool{true})}, 3),
+		}
+		reduced, err := reducer.Reduce(context.Background(), results)
+		require.NoError(t, err)
+		require.EqualValues(t, 5, reduced.GetAllRetrieveCount())
+
+		computed, err := ComputeSketchResult(variance[0], reduced.GetFieldDatas()[0])
+		require.NoError(t, err)
+		assert.InDeltaSlice(t, []float64{2.5}, computed.GetScalars().GetDoubleData().GetData(), 1e-9)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		reducer := NewGroupAggReducer(nil, aggregates, -1, makeTestSchema())
+		empty, err := reducer.Reduce(context.Background(), nil)
+		require.NoError(t, err)
+		computed, err := ComputeSketchResult(variance[0], empty.GetFieldDatas()[0])
+		require.NoError(t, err)
+		assert.Equal(t, []bool{false}, computed.GetValidData())
+	})
+
+	t.Run("invalid state", func(t *testing.T) {
+		_, err := ComputeSketchResult(variance[0], stateData([]string{"not a sketch"}, nil))
+		assert.Error(t, err)
+	})
+}
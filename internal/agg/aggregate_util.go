@@ -457,6 +457,14 @@ func AssembleSingleValue(fv *FieldValue, fieldData *schemapb.FieldData) error {
 		}
 		fieldData.GetScalars().GetDoubleData().Data = append(fieldData.GetScalars().GetDoubleData().GetData(), doubleVal)
 	case schemapb.DataType_VarChar, schemapb.DataType_String:
+		// the partial states of the approximate aggregates are merged decoded
+		if state, ok := val.(sketch); ok {
+			encoded, err := encodeSketch(state)
+			if err != nil {
+				return err
+			}
+			val = encoded
+		}
 		stringVal, ok := val.(string)
 		if !ok {
 			return merr.WrapErrServiceInternalMsg("type assertion failed: expected string, got %T", val)
@@ -471,6 +479,9 @@ func AssembleSingleValue(fv *FieldValue, fieldData *schemapb.FieldData) error {
 type AggregationFieldMap struct {
 	userOriginalOutputFields     []string
 	userOriginalOutputFieldIdxes [][]int // Each user output field can map to multiple field indices (e.g., avg maps to sum and count)
+	// userOriginalOutputSketches holds the approximate aggregates of the user output fields,
+	// their fields hold the partial states until terminated by ComputeSketchResult
+	userOriginalOutputSketches []AggregateBase
 }
 
 func (aggMap *AggregationFieldMap) Count() int {
@@ -494,10 +505,24 @@ func (aggMap *AggregationFieldMap) IndexesAt(idx int) []int {
 	return aggMap.userOriginalOutputFieldIdxes[idx]
 }
 
+// SketchAt returns the approximate aggregate of the given user output field index,
+// nil if the field isn't computed from a sketch.
+func (aggMap *AggregationFieldMap) SketchAt(idx int) AggregateBase {
+	if idx >= len(aggMap.userOriginalOutputSketches) {
+		return nil
+	}
+	return aggMap.userOriginalOutputSketches[idx]
+}
+
 func (aggMap *AggregationFieldMap) NameAt(idx int) string {
 	return aggMap.userOriginalOutputFields[idx]
 }
 
+// Names returns the user output field names in order.
+func (aggMap *AggregationFieldMap) Names() []string {
+	return aggMap.userOriginalOutputFields
+}
+
 func NewAggregationFieldMap(originalUserOutputFields []string, groupByFields []string, aggs []AggregateBase) (*AggregationFieldMap, error) {
 	numGroupingKeys := len(groupByFields)
 
@@ -508,7 +533,11 @@ func NewAggregationFieldMap(originalUserOutputFields []string, groupByFields []s
 
 	// Build a map from originalName to all indices (for avg, this will include both sum and count indices)
 	aggFieldMap := make(map[string][]int, len(aggs))
+	sketchMap := make(map[string]AggregateBase)
 	for i, agg := range aggs {
+		if _, ok := agg.(sketchAggregate); ok {
+			sketchMap[agg.OriginalName()] = agg
+		}
 		originalName := agg.OriginalName()
 		idx := i + numGroupingKeys
 
@@ -532,7 +561,9 @@ func NewAggregationFieldMap(originalUserOutputFields []string, groupByFields []s
 	}
 
 	userOriginalOutputFieldIdxes := make([][]int, len(originalUserOutputFields))
+	userOriginalOutputSketches := make([]AggregateBase, len(originalUserOutputFields))
 	for i, outputField := range originalUserOutputFields {
+		userOriginalOutputSketches[i] = sketchMap[outputField]
 		if idx, exist := groupByFieldMap[outputField]; exist {
 			// Group by field maps to a single index
 			userOriginalOutputFieldIdxes[i] = []int{idx}
@@ -560,7 +591,7 @@ func NewAggregationFieldMap(originalUserOutputFields []string, groupByFields []s
 		}
 	}
 
-	return &AggregationFieldMap{originalUserOutputFields, userOriginalOutputFieldIdxes}, nil
+	return &AggregationFieldMap{originalUserOutputFields, userOriginalOutputFieldIdxes, userOriginalOutputSketches}, nil
 }
 
 // ComputeAvgFromSumAndCount computes average from sum and count field data.
@@ -0,0 +1,378 @@
+package agg
+
+import (
+	"strings"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/schemapb"
+	"github.com/milvus-io/milvus/internal/parser/planparserv2"
+	"github.com/milvus-io/milvus/pkg/v3/proto/planpb"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+)
+
+// HavingExpr is a predicate over the grouped rows of an aggregation query, for example
+// "count(*) >= 10 and avg(price) > 9.9". The operands are group by columns, aggregate
+// expressions or literals, and the predicate is evaluated on the final aggregated values.
+// It's parsed by planparserv2 with the grammar of the filter expressions.
+//
+// Comparisons involving a null value are unknown, and a group is kept only if the
+// predicate is true, following the SQL semantics.
+type HavingExpr struct {
+	expr string
+	root havingNode
+	// refs are the normalized names of the columns referenced by the predicate
+	refs []string
+}
+
+// NormalizeOutputName returns the canonical form of an output name, so that
+// "COUNT( * )" and "count(*)" refer to the same aggregate column.
+func NormalizeOutputName(name string) string {
+	name = strings.TrimSpace(name)
+	matches := aggregationPattern.FindStringSubmatch(name)
+	if len(matches) == 0 {
+		return name
+	}
+	normalized := strings.ToLower(matches[1]) + "(" + strings.TrimSpace(matches[2])
+	if matches[3] != "" {
+		normalized += "," + matches[3]
+	}
+	return normalized + ")"
+}
+
+// ParseHavingExpr parses the HAVING predicate, see planparserv2.ParseHavingExpr.
+func ParseHavingExpr(expr string) (*HavingExpr, error) {
+	parsed, err := planparserv2.ParseHavingExpr(expr)
+	if err != nil {
+		return nil, err
+	}
+	root, err := newHavingNode(parsed)
+	if err != nil {
+		return nil, err
+	}
+	refs := make(map[string]struct{})
+	root.collectRefs(refs)
+	names := make([]string, 0, len(refs))
+	for ref := range refs {
+		names = append(names, ref)
+	}
+	return &HavingExpr{expr: expr, root: root, refs: names}, nil
+}
+
+func (h *HavingExpr) String() string {
+	return h.expr
+}
+
+// References returns the normalized names of the columns referenced by the predicate.
+func (h *HavingExpr) References() []string {
+	return h.refs
+}
+
+// Validate checks that all the referenced columns are in names.
+func (h *HavingExpr) Validate(names []string) error {
+	_, err := h.resolve(names)
+	return err
+}
+
+func (h *HavingExpr) resolve(names []string) (map[string]int, error) {
+	positions := make(map[string]int, len(names))
+	for i, name := range names {
+		if _, ok := positions[NormalizeOutputName(name)]; !ok {
+			positions[NormalizeOutputName(name)] = i
+		}
+	}
+	resolved := make(map[string]int, len(h.refs))
+	for _, ref := range h.refs {
+		pos, ok := positions[ref]
+		if !ok {
+			return nil, merr.WrapErrParameterInvalidMsg(
+				"HAVING references '%s' which is not in output_fields, the output fields are: %v", ref, names)
+		}
+		resolved[ref] = pos
+	}
+	return resolved, nil
+}
+
+// Filter returns the indices of the rows satisfying the predicate, names[i] is the
+// output name of fieldDatas[i].
+func (h *HavingExpr) Filter(names []string, fieldDatas []*schemapb.FieldData) ([]int, error) {
+	if len(names) != len(fieldDatas) {
+		return nil, merr.WrapErrServiceInternalMsg("HAVING got %d names for %d columns", len(names), len(fieldDatas))
+	}
+	if len(fieldDatas) == 0 {
+		return nil, nil
+	}
+	resolved, err := h.resolve(names)
+	if err != nil {
+		return nil, err
+	}
+
+	accessors := make(map[string]FieldAccessor, len(resolved))
+	for ref, pos := range resolved {
+		accessor, err := NewFieldAccessor(fieldDatas[pos].GetType())
+		if err != nil {
+			return nil, merr.WrapErrParameterInvalidMsg("HAVING does not support column '%s' of type %s", ref, fieldDatas[pos].GetType().String())
+		}
+		accessor.SetVals(fieldDatas[pos])
+		accessors[ref] = accessor
+	}
+	rowCount := 0
+	if len(accessors) > 0 {
+		for _, accessor := range accessors {
+			rowCount = accessor.RowCount()
+			break
+		}
+	} else {
+		accessor, err := NewFieldAccessor(fieldDatas[0].GetType())
+		if err != nil {
+			return nil, err
+		}
+		accessor.SetVals(fieldDatas[0])
+		rowCount = accessor.RowCount()
+	}
+
+	indices := make([]int, 0, rowCount)
+	for row := 0; row < rowCount; row++ {
+		result, err := h.root.eval(row, accessors)
+		if err != nil {
+			return nil, err
+		}
+		if result == havingTrue {
+			indices = append(indices, row)
+		}
+	}
+	return indices, nil
+}
+
+// havingBool is a three-valued logic value, comparisons involving null are unknown.
+type havingBool int8
+
+const (
+	havingFalse havingBool = iota
+	havingTrue
+	havingUnknown
+)
+
+func toHavingBool(b bool) havingBool {
+	if b {
+		return havingTrue
+	}
+	return havingFalse
+}
+
+type havingNode interface {
+	eval(row int, columns map[string]FieldAccessor) (havingBool, error)
+	collectRefs(refs map[string]struct{})
+}
+
+func newHavingNode(node *planparserv2.HavingNode) (havingNode, error) {
+	switch node.Kind {
+	case planparserv2.HavingAnd, planparserv2.HavingOr:
+		left, err := newHavingNode(node.Children[0])
+		if err != nil {
+			return nil, err
+		}
+		right, err := newHavingNode(node.Children[1])
+		if err != nil {
+			return nil, err
+		}
+		return &havingLogicalNode{and: node.Kind == planparserv2.HavingAnd, left: left, right: right}, nil
+	case planparserv2.HavingNot:
+		child, err := newHavingNode(node.Children[0])
+		if err != nil {
+			return nil, err
+		}
+		return &havingNotNode{child: child}, nil
+	case planparserv2.HavingCompare:
+		left, err := newHavingOperand(node.Left)
+		if err != nil {
+			return nil, err
+		}
+		right, err := newHavingOperand(node.Right)
+		if err != nil {
+			return nil, err
+		}
+		return &havingCompareNode{op: node.CompareOp, left: left, right: right}, nil
+	}
+	return nil, merr.WrapErrServiceInternalMsg("unknown HAVING node kind %d", node.Kind)
+}
+
+type havingLogicalNode struct {
+	and         bool
+	left, right havingNode
+}
+
+func (n *havingLogicalNode) eval(row int, columns map[string]FieldAccessor) (havingBool, error) {
+	left, err := n.left.eval(row, columns)
+	if err != nil {
+		return havingUnknown, err
+	}
+	// short-circuit only when the result is decided
+	if n.and && left == havingFalse {
+		return havingFalse, nil
+	}
+	if !n.and && left == havingTrue {
+		return havingTrue, nil
+	}
+	right, err := n.right.eval(row, columns)
+	if err != nil {
+		return havingUnknown, err
+	}
+	if n.and && right == havingFalse {
+		return havingFalse, nil
+	}
+	if !n.and && right == havingTrue {
+		return havingTrue, nil
+	}
+	if left == havingUnknown || right == havingUnknown {
+		return havingUnknown, nil
+	}
+	return right, nil
+}
+
+func (n *havingLogicalNode) collectRefs(refs map[string]struct{}) {
+	n.left.collectRefs(refs)
+	n.right.collectRefs(refs)
+}
+
+type havingNotNode struct {
+	child havingNode
+}
+
+func (n *havingNotNode) eval(row int, columns map[string]FieldAccessor) (havingBool, error) {
+	v, err := n.child.eval(row, columns)
+	if err != nil {
+		return havingUnknown, err
+	}
+	switch v {
+	case havingTrue:
+		return havingFalse, nil
+	case havingFalse:
+		return havingTrue, nil
+	}
+	return havingUnknown, nil
+}
+
+func (n *havingNotNode) collectRefs(refs map[string]struct{}) {
+	n.child.collectRefs(refs)
+}
+
+type havingOperand struct {
+	// ref is the normalized column name, empty for a literal
+	ref   string
+	value any
+}
+
+func newHavingOperand(operand *planparserv2.HavingOperand) (*havingOperand, error) {
+	if operand.Column == "" {
+		return &havingOperand{value: operand.Value}, nil
+	}
+	if strings.Contains(operand.Column, "(") {
+		if isAgg, _, _ := MatchAggregationExpression(operand.Column); !isAgg {
+			return nil, merr.WrapErrParameterInvalidMsg("HAVING references '%s' which is not an aggregate expression", operand.Column)
+		}
+	}
+	return &havingOperand{ref: NormalizeOutputName(operand.Column)}, nil
+}
+
+func (o *havingOperand) valueAt(row int, columns map[string]FieldAccessor) (any, bool) {
+	if o.ref == "" {
+		return o.value, false
+	}
+	accessor := columns[o.ref]
+	if accessor.IsNullAt(row) {
+		return nil, true
+	}
+	return accessor.ValAt(row), false
+}
+
+type havingCompareNode struct {
+	op          planpb.OpType
+	left, right *havingOperand
+}
+
+func (n *havingCompareNode) eval(row int, columns map[string]FieldAccessor) (havingBool, error) {
+	left, leftNull := n.left.valueAt(row, columns)
+	right, rightNull := n.right.valueAt(row, columns)
+	if leftNull || rightNull {
+		return havingUnknown, nil
+	}
+	cmp, err := compareHavingValues(left, right)
+	if err != nil {
+		return havingUnknown, err
+	}
+	switch n.op {
+	case planpb.OpType_Equal:
+		return toHavingBool(cmp == 0), nil
+	case planpb.OpType_NotEqual:
+		return toHavingBool(cmp != 0), nil
+	case planpb.OpType_LessThan:
+		return toHavingBool(cmp < 0), nil
+	case planpb.OpType_LessEqual:
+		return toHavingBool(cmp <= 0), nil
+	case planpb.OpType_GreaterThan:
+		return toHavingBool(cmp > 0), nil
+	case planpb.OpType_GreaterEqual:
+		return toHavingBool(cmp >= 0), nil
+	}
+	return havingUnknown, merr.WrapErrServiceInternalMsg("unknown HAVING operator %s", n.op.String())
+}
+
+func (n *havingCompareNode) collectRefs(refs map[string]struct{}) {
+	for _, operand := range []*havingOperand{n.left, n.right} {
+		if operand.ref != "" {
+			refs[operand.ref] = struct{}{}
+		}
+	}
+}
+
+func havingNumber(v any) (int64, float64, bool, bool) {
+	switch n := v.(type) {
+	case int32:
+		return int64(n), float64(n), true, true
+	case int64:
+		return n, float64(n), true, true
+	case float32:
+		return 0, float64(n), false, true
+	case float64:
+		return 0, n, false, true
+	}
+	return 0, 0, false, false
+}
+
+func compareOrdered[T int64 | float64 | string](a, b T) int {
+	if a < b {
+		return -1
+	}
+	if a > b {
+		return 1
+	}
+	return 0
+}
+
+func compareHavingValues(a, b any) (int, error) {
+	ai, af, aIsInt, aIsNum := havingNumber(a)
+	bi, bf, bIsInt, bIsNum := havingNumber(b)
+	if aIsNum && bIsNum {
+		// compare integers exactly, int64 doesn't fit into float64
+		if aIsInt && bIsInt {
+			return compareOrdered(ai, bi), nil
+		}
+		return compareOrdered(af, bf), nil
+	}
+	switch va := a.(type) {
+	case string:
+		if vb, ok := b.(string); ok {
+			return compareOrdered(va, vb), nil
+		}
+	case bool:
+		if vb, ok := b.(bool); ok {
+			if va == vb {
+				return 0, nil
+			}
+			if !va {
+				return -1, nil
+			}
+			return 1, nil
+		}
+	}
+	return 0, merr.WrapErrParameterInvalidMsg("HAVING cannot compare %T with %T", a, b)
+}
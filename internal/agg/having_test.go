@@ -0,0 +1,118 @@
+package agg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/schemapb"
+)
+
+func havingTestColumns() ([]string, []*schemapb.FieldData) {
+	names := []string{"category", "count(*)", "avg(price)"}
+	fieldDatas := []*schemapb.FieldData{
+		{
+			Type: schemapb.DataType_VarChar,
+			Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: []string{"a", "b", "c", "d"}}},
+			}},
+		},
+		{
+			Type: schemapb.DataType_Int64,
+			Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{30, 5, 12, 40}}},
+			}},
+		},
+		{
+			Type: schemapb.DataType_Double,
+			Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_DoubleData{DoubleData: &schemapb.DoubleArray{Data: []float64{12.5, 20, 9.9, 0}}},
+			}},
+			// avg of "d" is null
+			ValidData: []bool{true, true, true, false},
+		},
+	}
+	return names, fieldDatas
+}
+
+func TestHavingExprFilter(t *testing.T) {
+	names, fieldDatas := havingTestColumns()
+
+	tests := []struct {
+		expr     string
+		expected []int
+	}{
+		{expr: "count(*) > 10", expected: []int{0, 2, 3}},
+		{expr: "COUNT( * ) >= 12 and avg(price) > 10", expected: []int{0}},
+		{expr: "count(*) < 10 || avg(price) >= 12.5", expected: []int{0, 1}},
+		{expr: "category == 'c' or category == \"d\"", expected: []int{2, 3}},
+		{expr: "not (category != 'b')", expected: []int{1}},
+		{expr: "avg(price) > 1e1", expected: []int{0, 1}},
+		{expr: "40 <= count(*)", expected: []int{3}},
+		// null comparisons are unknown, the group is dropped unless the result is decided
+		{expr: "not (avg(price) < 10)", expected: []int{0, 1}},
+		{expr: "avg(price) < 10 or count(*) > 35", expected: []int{2, 3}},
+		{expr: "avg(price) < 10 and count(*) > 35", expected: []int{}},
+	}
+	for _, test := range tests {
+		t.Run(test.expr, func(t *testing.T) {
+			having, err := ParseHavingExpr(test.expr)
+			require.NoError(t, err)
+			require.NoError(t, having.Validate(names))
+			indices, err := having.Filter(names, fieldDatas)
+			require.NoError(t, err)
+			require.Equal(t, test.expected, indices)
+		})
+	}
+}
+
+func TestHavingExprReferences(t *testing.T) {
+	having, err := ParseHavingExpr("Count(*) > 1 and avg( price ) < 2 and count(*) < 10")
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"count(*)", "avg(price)"}, having.References())
+
+	names, _ := havingTestColumns()
+	require.NoError(t, having.Validate(names))
+	require.Error(t, having.Validate([]string{"category", "count(*)"}))
+
+	having, err = ParseHavingExpr("max(price) > 1")
+	require.NoError(t, err)
+	require.Error(t, having.Validate(names))
+}
+
+func TestHavingExprParseError(t *testing.T) {
+	for _, expr := range []string{
+		"",
+		"count(*)",
+		"count(*) > ",
+		"count(*) > 1 and",
+		"(count(*) > 1",
+		"count(*) > 1)",
+		"count(*) >> 1",
+		"upper(category) == 'a'",
+		"category == 'a",
+		"count(* > 1",
+		"count(*) > 1 # 2",
+	} {
+		_, err := ParseHavingExpr(expr)
+		require.Error(t, err, expr)
+	}
+}
+
+func TestHavingExprFilterTypeMismatch(t *testing.T) {
+	names, fieldDatas := havingTestColumns()
+	having, err := ParseHavingExpr("category > 1")
+	require.NoError(t, err)
+	_, err = having.Filter(names, fieldDatas)
+	require.Error(t, err)
+
+	_, err = having.Filter(names[:1], fieldDatas)
+	require.Error(t, err)
+}
+
+func TestNormalizeOutputName(t *testing.T) {
+	require.Equal(t, "count(*)", NormalizeOutputName(" COUNT( * ) "))
+	require.Equal(t, "avg(price)", NormalizeOutputName("Avg(price)"))
+	require.Equal(t, "category", NormalizeOutputName("category"))
+	require.Equal(t, "percentile(price,0.9)", NormalizeOutputName("Percentile( price , 0.9 )"))
+}
@@ -42,6 +42,10 @@ func fieldValueToFloat64(v any) (float64, error) {
 	switch value := v.(type) {
 	case int:
 		return float64(value), nil
+	case int8:
+		return float64(value), nil
+	case int16:
+		return float64(value), nil
 	case int32:
 		return float64(value), nil
 	case int64:
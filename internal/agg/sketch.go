@@ -0,0 +1,312 @@
+package agg
+
+import (
+	"encoding/binary"
+	"math"
+	"math/bits"
+	"sort"
+
+	"github.com/spaolacci/murmur3"
+
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+)
+
+// The sketches below are the partial states of approximate aggregates. All of them
+// are mergeable, so the states built on different segments or nodes can be combined
+// in any order, and serializable, so that they can be shipped between nodes.
+
+const (
+	// hllPrecision uses 2^12 registers, the standard error is about 1.04/sqrt(4096) = 1.6%
+	hllPrecision = 12
+	hllRegisters = 1 << hllPrecision
+
+	// tDigestCompression bounds the number of centroids to roughly 2 * compression
+	tDigestCompression = 100
+	// tDigestBufferSize is the number of unmerged points buffered before compressing
+	tDigestBufferSize = 500
+
+	sketchVersion = byte(1)
+)
+
+// hyperLogLog estimates the number of distinct values.
+type hyperLogLog struct {
+	registers []uint8
+}
+
+func newHyperLogLog() *hyperLogLog {
+	return &hyperLogLog{registers: make([]uint8, hllRegisters)}
+}
+
+func (h *hyperLogLog) addHash(hash uint64) {
+	idx := hash >> (64 - hllPrecision)
+	// the sentinel bit bounds the rank when all remaining bits are zero
+	rank := uint8(bits.LeadingZeros64(hash<<hllPrecision|1<<(hllPrecision-1))) + 1
+	if rank > h.registers[idx] {
+		h.registers[idx] = rank
+	}
+}
+
+func (h *hyperLogLog) add(value any) error {
+	buf := make([]byte, 8)
+	switch v := value.(type) {
+	case bool:
+		if v {
+			buf[0] = 1
+		}
+		buf = buf[:1]
+	case int:
+		binary.LittleEndian.PutUint64(buf, uint64(v))
+	case int8:
+		binary.LittleEndian.PutUint64(buf, uint64(v))
+	case int16:
+		binary.LittleEndian.PutUint64(buf, uint64(v))
+	case int32:
+		binary.LittleEndian.PutUint64(buf, uint64(v))
+	case int64:
+		binary.LittleEndian.PutUint64(buf, uint64(v))
+	case float32:
+		binary.LittleEndian.PutUint64(buf, math.Float64bits(float64(v)))
+	case float64:
+		binary.LittleEndian.PutUint64(buf, math.Float64bits(v))
+	case string:
+		buf = []byte(v)
+	default:
+		return merr.WrapErrParameterInvalidMsg("unsupported type for %s aggregation: %T", kCountDistinct, value)
+	}
+	h.addHash(murmur3.Sum64(buf))
+	return nil
+}
+
+func (h *hyperLogLog) merge(other *hyperLogLog) {
+	for i, r := range other.registers {
+		if r > h.registers[i] {
+			h.registers[i] = r
+		}
+	}
+}
+
+func (h *hyperLogLog) estimate() int64 {
+	m := float64(hllRegisters)
+	sum := 0.0
+	zeros := 0
+	for _, r := range h.registers {
+		sum += math.Ldexp(1, -int(r))
+		if r == 0 {
+			zeros++
+		}
+	}
+	alpha := 0.7213 / (1 + 1.079/m)
+	estimate := alpha * m * m / sum
+	// linear counting is more accurate for small cardinalities
+	if estimate <= 2.5*m && zeros > 0 {
+		estimate = m * math.Log(m/float64(zeros))
+	}
+	return int64(math.Round(estimate))
+}
+
+func (h *hyperLogLog) MarshalBinary() ([]byte, error) {
+	return append([]byte{sketchVersion}, h.registers...), nil
+}
+
+func (h *hyperLogLog) UnmarshalBinary(data []byte) error {
+	if len(data) != hllRegisters+1 || data[0] != sketchVersion {
+		return merr.WrapErrParameterInvalidMsg("invalid hyperloglog state")
+	}
+	h.registers = append([]uint8{}, data[1:]...)
+	return nil
+}
+
+type centroid struct {
+	mean   float64
+	weight float64
+}
+
+// tDigest estimates quantiles with a bounded number of centroids, the relative error
+// is smaller at the tails than at the median.
+type tDigest struct {
+	centroids []centroid
+	buffer    []centroid
+	count     float64
+	min       float64
+	max       float64
+}
+
+func newTDigest() *tDigest {
+	return &tDigest{min: math.Inf(1), max: math.Inf(-1)}
+}
+
+func (t *tDigest) add(value float64, weight float64) {
+	if math.IsNaN(value) || weight <= 0 {
+		return
+	}
+	t.buffer = append(t.buffer, centroid{mean: value, weight: weight})
+	t.count += weight
+	t.min = math.Min(t.min, value)
+	t.max = math.Max(t.max, value)
+	if len(t.buffer) >= tDigestBufferSize {
+		t.compress()
+	}
+}
+
+func (t *tDigest) merge(other *tDigest) {
+	other.compress()
+	for _, c := range other.centroids {
+		t.buffer = append(t.buffer, c)
+		t.count += c.weight
+	}
+	t.min = math.Min(t.min, other.min)
+	t.max = math.Max(t.max, other.max)
+	t.compress()
+}
+
+func (t *tDigest) compress() {
+	if len(t.buffer) == 0 {
+		return
+	}
+	all := append(t.centroids, t.buffer...)
+	t.buffer = t.buffer[:0]
+	sort.Slice(all, func(i, j int) bool { return all[i].mean < all[j].mean })
+
+	merged := make([]centroid, 0, 2*tDigestCompression)
+	cur := all[0]
+	weightSoFar := 0.0
+	for _, c := range all[1:] {
+		proposed := cur.weight + c.weight
+		q0 := weightSoFar / t.count
+		q2 := (weightSoFar + proposed) / t.count
+		limit := 4 * t.count * math.Min(q0*(1-q0), q2*(1-q2)) / tDigestCompression
+		if proposed <= limit {
+			cur.mean += (c.mean - cur.mean) * c.weight / proposed
+			cur.weight = proposed
+			continue
+		}
+		weightSoFar += cur.weight
+		merged = append(merged, cur)
+		cur = c
+	}
+	t.centroids = append(merged, cur)
+}
+
+// quantile returns the estimated value at quantile q, q is in [0, 1].
+func (t *tDigest) quantile(q float64) float64 {
+	t.compress()
+	if len(t.centroids) == 0 {
+		return math.NaN()
+	}
+	if q <= 0 {
+		return t.min
+	}
+	if q >= 1 {
+		return t.max
+	}
+
+	target := q * t.count
+	// interpolate between the centers of adjacent centroids
+	cumulative := 0.0
+	prevCenter := 0.0
+	prevMean := t.min
+	for _, c := range t.centroids {
+		center := cumulative + c.weight/2
+		if target < center {
+			if center == prevCenter {
+				return c.mean
+			}
+			return prevMean + (c.mean-prevMean)*(target-prevCenter)/(center-prevCenter)
+		}
+		cumulative += c.weight
+		prevCenter = center
+		prevMean = c.mean
+	}
+	if t.count == prevCenter {
+		return t.max
+	}
+	return prevMean + (t.max-prevMean)*(target-prevCenter)/(t.count-prevCenter)
+}
+
+func (t *tDigest) MarshalBinary() ([]byte, error) {
+	t.compress()
+	buf := make([]byte, 1, 1+8*3+16*len(t.centroids))
+	buf[0] = sketchVersion
+	buf = binary.LittleEndian.AppendUint64(buf, math.Float64bits(t.count))
+	buf = binary.LittleEndian.AppendUint64(buf, math.Float64bits(t.min))
+	buf = binary.LittleEndian.AppendUint64(buf, math.Float64bits(t.max))
+	for _, c := range t.centroids {
+		buf = binary.LittleEndian.AppendUint64(buf, math.Float64bits(c.mean))
+		buf = binary.LittleEndian.AppendUint64(buf, math.Float64bits(c.weight))
+	}
+	return buf, nil
+}
+
+func (t *tDigest) UnmarshalBinary(data []byte) error {
+	if len(data) < 1+8*3 || (len(data)-1-8*3)%16 != 0 || data[0] != sketchVersion {
+		return merr.WrapErrParameterInvalidMsg("invalid t-digest state")
+	}
+	readFloat := func(offset int) float64 {
+		return math.Float64frombits(binary.LittleEndian.Uint64(data[offset:]))
+	}
+	t.count = readFloat(1)
+	t.min = readFloat(9)
+	t.max = readFloat(17)
+	t.buffer = nil
+	t.centroids = make([]centroid, 0, (len(data)-25)/16)
+	for offset := 25; offset < len(data); offset += 16 {
+		t.centroids = append(t.centroids, centroid{mean: readFloat(offset), weight: readFloat(offset + 8)})
+	}
+	return nil
+}
+
+// moments keeps count, mean and the sum of squared deviations (Welford), which
+// can be merged exactly with the parallel algorithm of Chan et al.
+type moments struct {
+	count int64
+	mean  float64
+	m2    float64
+}
+
+func (m *moments) add(value float64) {
+	m.count++
+	delta := value - m.mean
+	m.mean += delta / float64(m.count)
+	m.m2 += delta * (value - m.mean)
+}
+
+func (m *moments) merge(other *moments) {
+	if other.count == 0 {
+		return
+	}
+	if m.count == 0 {
+		*m = *other
+		return
+	}
+	n := float64(m.count + other.count)
+	delta := other.mean - m.mean
+	m.mean += delta * float64(other.count) / n
+	m.m2 += other.m2 + delta*delta*float64(m.count)*float64(other.count)/n
+	m.count += other.count
+}
+
+// variance returns the sample variance, false if there are less than two values.
+func (m *moments) variance() (float64, bool) {
+	if m.count < 2 {
+		return 0, false
+	}
+	return m.m2 / float64(m.count-1), true
+}
+
+func (m *moments) MarshalBinary() ([]byte, error) {
+	buf := []byte{sketchVersion}
+	buf = binary.LittleEndian.AppendUint64(buf, uint64(m.count))
+	buf = binary.LittleEndian.AppendUint64(buf, math.Float64bits(m.mean))
+	buf = binary.LittleEndian.AppendUint64(buf, math.Float64bits(m.m2))
+	return buf, nil
+}
+
+func (m *moments) UnmarshalBinary(data []byte) error {
+	if len(data) != 1+8*3 || data[0] != sketchVersion {
+		return merr.WrapErrParameterInvalidMsg("invalid moments state")
+	}
+	m.count = int64(binary.LittleEndian.Uint64(data[1:]))
+	m.mean = math.Float64frombits(binary.LittleEndian.Uint64(data[9:]))
+	m.m2 = math.Float64frombits(binary.LittleEndian.Uint64(data[17:]))
+	return nil
+}
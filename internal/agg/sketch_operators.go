@@ -0,0 +1,454 @@
+package agg
+
+import (
+	"encoding"
+	"encoding/base64"
+	"math"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/schemapb"
+	"github.com/milvus-io/milvus/pkg/v3/proto/planpb"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+)
+
+// sketchAggregate is an approximate aggregate built from a mergeable sketch. It's
+// pushed down as the partial state of its sketch, like avg is pushed down as sum and
+// count, and the merged state is terminated at proxy, see ComputeSketchResult.
+type sketchAggregate interface {
+	AggregateBase
+	resultType() schemapb.DataType
+}
+
+// updateSketchState accumulates a raw value into the sketch held by target, or merges
+// new into it if new is a partial state of the same kind, e.g. the state of another
+// segment or node.
+func updateSketchState[S any](target *FieldValue, new *FieldValue, newSketch func() *S,
+	add func(*S, any) error, merge func(*S, *S),
+) error {
+	if target == nil || new == nil {
+		return merr.WrapErrServiceInternalMsg("target or new field value is nil")
+	}
+	if new.IsNull() {
+		return nil
+	}
+	if target.IsNull() || target.val == nil {
+		target.val = newSketch()
+		target.isNull = false
+	}
+	sketch, ok := target.val.(*S)
+	if !ok {
+		return merr.WrapErrServiceInternalMsg("unexpected aggregate state %T", target.val)
+	}
+	if other, ok := new.val.(*S); ok {
+		merge(sketch, other)
+		return nil
+	}
+	return add(sketch, new.val)
+}
+
+func updateSingleSketchSlot(agg AggregateBase, slots []*FieldValue, new *FieldValue) error {
+	if len(slots) != 1 {
+		return merr.WrapErrParameterInvalidMsg("aggregate expects 1 accumulator slot, got %d", len(slots))
+	}
+	return agg.Update(slots[0], new)
+}
+
+func sketchSlot[S any](slots []*FieldValue) (*S, error) {
+	if len(slots) != 1 {
+		return nil, merr.WrapErrParameterInvalidMsg("aggregate expects 1 accumulator slot, got %d", len(slots))
+	}
+	if slots[0] == nil || slots[0].IsNull() || slots[0].val == nil {
+		return nil, nil
+	}
+	sketch, ok := slots[0].val.(*S)
+	if !ok {
+		return nil, merr.WrapErrServiceInternalMsg("unexpected aggregate state %T", slots[0].val)
+	}
+	return sketch, nil
+}
+
+// CountDistinctAggregate approximately counts the distinct values with HyperLogLog.
+type CountDistinctAggregate struct {
+	fieldID      int64
+	originalName string
+}
+
+func (cd *CountDistinctAggregate) Name() string {
+	return kCountDistinct
+}
+
+func (cd *CountDistinctAggregate) Update(target *FieldValue, new *FieldValue) error {
+	return updateSketchState(target, new, newHyperLogLog,
+		func(h *hyperLogLog, v any) error { return h.add(v) },
+		func(h *hyperLogLog, other *hyperLogLog) { h.merge(other) })
+}
+
+func (cd *CountDistinctAggregate) NewState() []*FieldValue {
+	return newSingleSlotState()
+}
+
+func (cd *CountDistinctAggregate) UpdateState(slots []*FieldValue, new *FieldValue) error {
+	return updateSingleSketchSlot(cd, slots, new)
+}
+
+func (cd *CountDistinctAggregate) Terminate(slots []*FieldValue) (any, error) {
+	h, err := sketchSlot[hyperLogLog](slots)
+	if err != nil || h == nil {
+		return nil, err
+	}
+	return h.estimate(), nil
+}
+
+func (cd *CountDistinctAggregate) resultType() schemapb.DataType {
+	return schemapb.DataType_Int64
+}
+
+// ToPB returns the hyperloglog of the field.
+func (cd *CountDistinctAggregate) ToPB() *planpb.Aggregate {
+	return &planpb.Aggregate{Op: planpb.AggregateOp_hyperloglog, FieldId: cd.fieldID}
+}
+
+func (cd *CountDistinctAggregate) FieldID() int64 {
+	return cd.fieldID
+}
+
+func (cd *CountDistinctAggregate) OriginalName() string {
+	return cd.originalName
+}
+
+// PercentileAggregate approximately computes the value at a quantile with t-digest,
+// median is the percentile at 0.5.
+type PercentileAggregate struct {
+	fieldID      int64
+	originalName string
+	name         string
+	quantile     float64
+}
+
+func (p *PercentileAggregate) Name() string {
+	return p.name
+}
+
+func (p *PercentileAggregate) Update(target *FieldValue, new *FieldValue) error {
+	return updateSketchState(target, new, newTDigest,
+		func(t *tDigest, v any) error {
+			f, err := fieldValueToFloat64(v)
+			if err != nil {
+				return err
+			}
+			t.add(f, 1)
+			return nil
+		},
+		func(t *tDigest, other *tDigest) { t.merge(other) })
+}
+
+func (p *PercentileAggregate) NewState() []*FieldValue {
+	return newSingleSlotState()
+}
+
+func (p *PercentileAggregate) UpdateState(slots []*FieldValue, new *FieldValue) error {
+	return updateSingleSketchSlot(p, slots, new)
+}
+
+func (p *PercentileAggregate) Terminate(slots []*FieldValue) (any, error) {
+	t, err := sketchSlot[tDigest](slots)
+	if err != nil || t == nil {
+		return nil, err
+	}
+	value := t.quantile(p.quantile)
+	if math.IsNaN(value) {
+		return nil, nil
+	}
+	return value, nil
+}
+
+func (p *PercentileAggregate) resultType() schemapb.DataType {
+	return schemapb.DataType_Double
+}
+
+// ToPB returns the t-digest of the field.
+func (p *PercentileAggregate) ToPB() *planpb.Aggregate {
+	return &planpb.Aggregate{Op: planpb.AggregateOp_tdigest, FieldId: p.fieldID}
+}
+
+func (p *PercentileAggregate) FieldID() int64 {
+	return p.fieldID
+}
+
+func (p *PercentileAggregate) OriginalName() string {
+	return p.originalName
+}
+
+// VarianceAggregate computes the sample variance, or the sample standard deviation
+// if stddev is set.
+type VarianceAggregate struct {
+	fieldID      int64
+	originalName string
+	stddev       bool
+}
+
+func (v *VarianceAggregate) Name() string {
+	if v.stddev {
+		return kStddev
+	}
+	return kVariance
+}
+
+func (v *VarianceAggregate) Update(target *FieldValue, new *FieldValue) error {
+	return updateSketchState(target, new, func() *moments { return &moments{} },
+		func(m *moments, value any) error {
+			f, err := fieldValueToFloat64(value)
+			if err != nil {
+				return err
+			}
+			m.add(f)
+			return nil
+		},
+		func(m *moments, other *moments) { m.merge(other) })
+}
+
+func (v *VarianceAggregate) NewState() []*FieldValue {
+	return newSingleSlotState()
+}
+
+func (v *VarianceAggregate) UpdateState(slots []*FieldValue, new *FieldValue) error {
+	return updateSingleSketchSlot(v, slots, new)
+}
+
+func (v *VarianceAggregate) Terminate(slots []*FieldValue) (any, error) {
+	m, err := sketchSlot[moments](slots)
+	if err != nil || m == nil {
+		return nil, err
+	}
+	variance, ok := m.variance()
+	if !ok {
+		return nil, nil
+	}
+	if v.stddev {
+		return math.Sqrt(variance), nil
+	}
+	return variance, nil
+}
+
+func (v *VarianceAggregate) resultType() schemapb.DataType {
+	return schemapb.DataType_Double
+}
+
+// ToPB returns the moments of the field.
+func (v *VarianceAggregate) ToPB() *planpb.Aggregate {
+	return &planpb.Aggregate{Op: planpb.AggregateOp_moments, FieldId: v.fieldID}
+}
+
+func (v *VarianceAggregate) FieldID() int64 {
+	return v.fieldID
+}
+
+func (v *VarianceAggregate) OriginalName() string {
+	return v.originalName
+}
+
+// sketch is the mergeable partial state of an approximate aggregate.
+type sketch interface {
+	encoding.BinaryMarshaler
+	encoding.BinaryUnmarshaler
+}
+
+func isSketchOp(op planpb.AggregateOp) bool {
+	switch op {
+	case planpb.AggregateOp_hyperloglog, planpb.AggregateOp_tdigest, planpb.AggregateOp_moments:
+		return true
+	default:
+		return false
+	}
+}
+
+// newSketch returns an empty sketch of the partial state op.
+func newSketch(op planpb.AggregateOp) (sketch, error) {
+	switch op {
+	case planpb.AggregateOp_hyperloglog:
+		return newHyperLogLog(), nil
+	case planpb.AggregateOp_tdigest:
+		return newTDigest(), nil
+	case planpb.AggregateOp_moments:
+		return &moments{}, nil
+	default:
+		return nil, merr.WrapErrParameterInvalidMsg("aggregation operator %s is not a sketch", op.String())
+	}
+}
+
+// encodeSketch encodes the sketch as the value of a VarChar column, since the strings
+// of protobuf must be valid UTF-8 the serialized sketch is encoded in base64.
+func encodeSketch(s sketch) (string, error) {
+	bs, err := s.MarshalBinary()
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(bs), nil
+}
+
+// decodeSketch decodes the sketch encoded by encodeSketch or segcore.
+func decodeSketch(op planpb.AggregateOp, encoded string) (sketch, error) {
+	s, err := newSketch(op)
+	if err != nil {
+		return nil, err
+	}
+	bs, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, merr.WrapErrServiceInternalMsg("invalid %s state: %s", op.String(), err.Error())
+	}
+	if err := s.UnmarshalBinary(bs); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func mergeSketch(target sketch, other sketch) error {
+	switch t := target.(type) {
+	case *hyperLogLog:
+		if o, ok := other.(*hyperLogLog); ok {
+			t.merge(o)
+			return nil
+		}
+	case *tDigest:
+		if o, ok := other.(*tDigest); ok {
+			t.merge(o)
+			return nil
+		}
+	case *moments:
+		if o, ok := other.(*moments); ok {
+			t.merge(o)
+			return nil
+		}
+	}
+	return merr.WrapErrServiceInternalMsg("can't merge sketch %T into %T", other, target)
+}
+
+// SketchStateAggregate merges the partial states pushed down for the approximate
+// aggregates, like SumAggregate merges the partial sums of avg. The states are read
+// encoded and kept decoded while merging, they are encoded again when assembled.
+type SketchStateAggregate struct {
+	op      planpb.AggregateOp
+	fieldID int64
+}
+
+func (s *SketchStateAggregate) Name() string {
+	return s.op.String()
+}
+
+func (s *SketchStateAggregate) toSketch(val any) (sketch, error) {
+	switch v := val.(type) {
+	case string:
+		return decodeSketch(s.op, v)
+	case sketch:
+		return v, nil
+	default:
+		return nil, merr.WrapErrServiceInternalMsg("unexpected %s state %T", s.op.String(), val)
+	}
+}
+
+func (s *SketchStateAggregate) Update(target *FieldValue, new *FieldValue) error {
+	if target == nil || new == nil {
+		return merr.WrapErrServiceInternalMsg("target or new field value is nil")
+	}
+	if new.IsNull() {
+		return nil
+	}
+	other, err := s.toSketch(new.val)
+	if err != nil {
+		return err
+	}
+	if target.IsNull() || target.val == nil {
+		target.val = other
+		target.isNull = false
+		return nil
+	}
+	current, err := s.toSketch(target.val)
+	if err != nil {
+		return err
+	}
+	if err := mergeSketch(current, other); err != nil {
+		return err
+	}
+	target.val = current
+	return nil
+}
+
+func (s *SketchStateAggregate) NewState() []*FieldValue {
+	return newSingleSlotState()
+}
+
+func (s *SketchStateAggregate) UpdateState(slots []*FieldValue, new *FieldValue) error {
+	return updateSingleSketchSlot(s, slots, new)
+}
+
+// Terminate returns the encoded state, it's terminated by the approximate aggregate.
+func (s *SketchStateAggregate) Terminate(slots []*FieldValue) (any, error) {
+	if len(slots) != 1 {
+		return nil, merr.WrapErrParameterInvalidMsg("aggregate expects 1 accumulator slot, got %d", len(slots))
+	}
+	if slots[0] == nil || slots[0].IsNull() || slots[0].val == nil {
+		return nil, nil
+	}
+	state, err := s.toSketch(slots[0].val)
+	if err != nil {
+		return nil, err
+	}
+	return encodeSketch(state)
+}
+
+func (s *SketchStateAggregate) ToPB() *planpb.Aggregate {
+	return &planpb.Aggregate{Op: s.op, FieldId: s.fieldID}
+}
+
+func (s *SketchStateAggregate) FieldID() int64 {
+	return s.fieldID
+}
+
+func (s *SketchStateAggregate) OriginalName() string {
+	return ""
+}
+
+// ComputeSketchResult terminates the reduced partial states of the approximate aggregate,
+// e.g. estimates count_distinct from the hyperloglogs, a null state gives a null value.
+func ComputeSketchResult(aggregate AggregateBase, stateFieldData *schemapb.FieldData) (*schemapb.FieldData, error) {
+	sketchAgg, ok := aggregate.(sketchAggregate)
+	if !ok {
+		return nil, merr.WrapErrServiceInternalMsg("aggregate %s is not built from a sketch", aggregate.OriginalName())
+	}
+	if stateFieldData.GetType() != schemapb.DataType_VarChar {
+		return nil, merr.WrapErrServiceInternalMsg("unexpected state type %s of aggregate %s", stateFieldData.GetType().String(), aggregate.OriginalName())
+	}
+	op := aggregate.ToPB().GetOp()
+	states := stateFieldData.GetScalars().GetStringData().GetData()
+	validData := stateFieldData.GetValidData()
+
+	result, err := genEmptyFieldDataByType(sketchAgg.resultType())
+	if err != nil {
+		return nil, err
+	}
+	switch sketchAgg.resultType() {
+	case schemapb.DataType_Int64:
+		result.GetScalars().GetLongData().Data = make([]int64, 0, len(states))
+	case schemapb.DataType_Double:
+		result.GetScalars().GetDoubleData().Data = make([]float64, 0, len(states))
+	}
+	for i, encoded := range states {
+		value := NewNullFieldValue()
+		if len(validData) == 0 || validData[i] {
+			state, err := decodeSketch(op, encoded)
+			if err != nil {
+				return nil, err
+			}
+			v, err := aggregate.Terminate([]*FieldValue{NewFieldValue(state)})
+			if err != nil {
+				return nil, err
+			}
+			if v != nil {
+				value = NewFieldValue(v)
+			}
+		}
+		if err := AssembleSingleValue(value, result); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
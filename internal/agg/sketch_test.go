@@ -0,0 +1,198 @@
+package agg
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/schemapb"
+	"github.com/milvus-io/milvus/pkg/v3/proto/planpb"
+)
+
+func TestHyperLogLogEstimateAndMerge(t *testing.T) {
+	left, right, all := newHyperLogLog(), newHyperLogLog(), newHyperLogLog()
+	for i := 0; i < 50000; i++ {
+		value := fmt.Sprintf("value-%d", i)
+		require.NoError(t, all.add(value))
+		// the two halves overlap on [20000, 30000)
+		if i < 30000 {
+			require.NoError(t, left.add(value))
+		}
+		if i >= 20000 {
+			require.NoError(t, right.add(value))
+		}
+	}
+	require.InEpsilon(t, 50000, float64(all.estimate()), 0.05)
+
+	left.merge(right)
+	require.Equal(t, all.estimate(), left.estimate())
+
+	small := newHyperLogLog()
+	for i := 0; i < 100; i++ {
+		require.NoError(t, small.add(int64(i%10)))
+	}
+	require.Equal(t, int64(10), small.estimate())
+
+	require.Error(t, small.add([]int{1}))
+}
+
+func TestTDigestQuantileAndMerge(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	values := make([]float64, 100000)
+	left, right := newTDigest(), newTDigest()
+	for i := range values {
+		values[i] = r.NormFloat64()*10 + 100
+		if i%2 == 0 {
+			left.add(values[i], 1)
+		} else {
+			right.add(values[i], 1)
+		}
+	}
+	sort.Float64s(values)
+	left.merge(right)
+
+	for _, q := range []float64{0.01, 0.1, 0.5, 0.9, 0.99} {
+		expected := values[int(q*float64(len(values)))]
+		require.InDelta(t, expected, left.quantile(q), 0.5, "quantile %v", q)
+	}
+	require.Equal(t, values[0], left.quantile(0))
+	require.Equal(t, values[len(values)-1], left.quantile(1))
+
+	require.True(t, math.IsNaN(newTDigest().quantile(0.5)))
+}
+
+func TestMomentsMerge(t *testing.T) {
+	values := []float64{2, 4, 4, 4, 5, 5, 7, 9}
+	direct := &moments{}
+	left, right := &moments{}, &moments{}
+	for i, v := range values {
+		direct.add(v)
+		if i < 3 {
+			left.add(v)
+		} else {
+			right.add(v)
+		}
+	}
+	left.merge(right)
+	require.Equal(t, direct.count, left.count)
+	require.InDelta(t, direct.mean, left.mean, 1e-9)
+
+	variance, ok := left.variance()
+	require.True(t, ok)
+	require.InDelta(t, 32.0/7, variance, 1e-9)
+
+	single := &moments{}
+	single.add(1)
+	_, ok = single.variance()
+	require.False(t, ok)
+}
+
+func TestSketchMarshalRoundTrip(t *testing.T) {
+	h := newHyperLogLog()
+	td := newTDigest()
+	m := &moments{}
+	for i := 0; i < 1000; i++ {
+		require.NoError(t, h.add(int64(i)))
+		td.add(float64(i), 1)
+		m.add(float64(i))
+	}
+
+	data, err := h.MarshalBinary()
+	require.NoError(t, err)
+	h2 := &hyperLogLog{}
+	require.NoError(t, h2.UnmarshalBinary(data))
+	require.Equal(t, h.estimate(), h2.estimate())
+
+	data, err = td.MarshalBinary()
+	require.NoError(t, err)
+	td2 := &tDigest{}
+	require.NoError(t, td2.UnmarshalBinary(data))
+	require.Equal(t, td.quantile(0.9), td2.quantile(0.9))
+
+	data, err = m.MarshalBinary()
+	require.NoError(t, err)
+	m2 := &moments{}
+	require.NoError(t, m2.UnmarshalBinary(data))
+	require.Equal(t, *m, *m2)
+
+	require.Error(t, h2.UnmarshalBinary([]byte{sketchVersion}))
+	require.Error(t, td2.UnmarshalBinary([]byte{0}))
+	require.Error(t, m2.UnmarshalBinary(nil))
+}
+
+func TestMatchAggregationExpressionWithArgument(t *testing.T) {
+	isAgg, name, param := MatchAggregationExpression("percentile(price, 0.9)")
+	require.True(t, isAgg)
+	require.Equal(t, kPercentile, name)
+	require.Equal(t, "price", param)
+
+	isAgg, name, param = MatchAggregationExpression("COUNT_DISTINCT( brand )")
+	require.True(t, isAgg)
+	require.Equal(t, kCountDistinct, name)
+	require.Equal(t, "brand", param)
+}
+
+func TestNewSketchAggregates(t *testing.T) {
+	aggs, err := NewAggregate(kPercentile, 101, "percentile(price, 0.9)", schemapb.DataType_Double)
+	require.NoError(t, err)
+	require.Len(t, aggs, 1)
+	require.Equal(t, 0.9, aggs[0].(*PercentileAggregate).quantile)
+	require.Equal(t, planpb.AggregateOp_tdigest, aggs[0].ToPB().GetOp())
+	require.Equal(t, int64(101), aggs[0].ToPB().GetFieldId())
+
+	_, err = NewAggregate(kPercentile, 101, "percentile(price)", schemapb.DataType_Double)
+	require.Error(t, err)
+	_, err = NewAggregate(kPercentile, 101, "percentile(price, 1.5)", schemapb.DataType_Double)
+	require.Error(t, err)
+	_, err = NewAggregate(kSum, 101, "sum(price, 0.9)", schemapb.DataType_Double)
+	require.Error(t, err)
+	_, err = NewAggregate(kStddev, 101, "stddev(brand)", schemapb.DataType_VarChar)
+	require.Error(t, err)
+
+	aggs, err = NewAggregate(kMedian, 101, "median(price)", schemapb.DataType_Int32)
+	require.NoError(t, err)
+	require.Equal(t, kMedian, aggs[0].Name())
+}
+
+func TestSketchAggregatesUpdateAndMergeState(t *testing.T) {
+	tests := []struct {
+		name      string
+		aggregate AggregateBase
+		expected  float64
+	}{
+		{name: "count_distinct", aggregate: &CountDistinctAggregate{}, expected: 5},
+		{name: "median", aggregate: &PercentileAggregate{name: kMedian, quantile: 0.5}, expected: 3},
+		{name: "variance", aggregate: &VarianceAggregate{}, expected: 2.5},
+		{name: "stddev", aggregate: &VarianceAggregate{stddev: true}, expected: math.Sqrt(2.5)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// the states of two partitions are merged like the states of two nodes
+			left, right := test.aggregate.NewState(), test.aggregate.NewState()
+			for _, v := range []int32{1, 2} {
+				require.NoError(t, test.aggregate.UpdateState(left, NewFieldValue(v)))
+			}
+			for _, v := range []int32{3, 4, 5} {
+				require.NoError(t, test.aggregate.UpdateState(right, NewFieldValue(v)))
+			}
+			require.NoError(t, test.aggregate.UpdateState(right, NewNullFieldValue()))
+			require.NoError(t, test.aggregate.UpdateState(left, right[0]))
+
+			result, err := test.aggregate.Terminate(left)
+			require.NoError(t, err)
+			switch v := result.(type) {
+			case int64:
+				require.Equal(t, int64(test.expected), v)
+			case float64:
+				require.InDelta(t, test.expected, v, 1e-9)
+			default:
+				t.Fatalf("unexpected result type %T", result)
+			}
+		})
+	}
+}
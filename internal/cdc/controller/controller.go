@@ -26,8 +26,11 @@ import (
 	clientv3 "go.etcd.io/etcd/client/v3"
 
 	"github.com/milvus-io/milvus/internal/cdc/meta"
+	"github.com/milvus-io/milvus/internal/cdc/replication/replicatemanager"
 	"github.com/milvus-io/milvus/internal/cdc/resource"
+	"github.com/milvus-io/milvus/internal/cdc/sink"
 	"github.com/milvus-io/milvus/internal/metastore/kv/streamingcoord"
+	"github.com/milvus-io/milvus/internal/util/streamingutil/util"
 	"github.com/milvus-io/milvus/pkg/v3/mlog"
 	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
 )
@@ -45,6 +48,9 @@ type controller struct {
 	wg     sync.WaitGroup
 
 	prefix string
+
+	sink            sink.Sink
+	sinkReplicators []replicatemanager.Replicator
 }
 
 func NewController() *controller {
@@ -60,10 +66,32 @@ func NewController() *controller {
 }
 
 func (c *controller) Start() error {
+	if err := c.startSinkReplication(); err != nil {
+		return err
+	}
 	c.startWatchLoop()
 	return nil
 }
 
+// startSinkReplication starts to replicate all pchannels into the sink if it's configured.
+func (c *controller) startSinkReplication() error {
+	s, err := sink.NewSinkFromConfig(c.ctx)
+	if err != nil {
+		return err
+	}
+	if s == nil {
+		return nil
+	}
+	c.sink = s
+	for _, pchannel := range util.GetAllTopicsFromConfiguration().Collect() {
+		replicator := replicatemanager.NewSinkReplicator(pchannel, s)
+		replicator.StartReplication()
+		c.sinkReplicators = append(c.sinkReplicators, replicator)
+	}
+	mlog.Info(c.ctx, "sink replication started", mlog.String("sink", s.Name()), mlog.Int("pchannels", len(c.sinkReplicators)))
+	return nil
+}
+
 func (c *controller) recoverReplicatePChannelMeta(channels []*meta.ReplicateChannel) {
 	currentClusterID := paramtable.Get().CommonCfg.ClusterPrefix.GetValue()
 	for _, channelMeta := range channels {
@@ -168,6 +196,12 @@ func (c *controller) Stop() {
 	mlog.Info(c.ctx, "stop CDC controller...")
 	c.cancel()
 	c.wg.Wait()
+	for _, replicator := range c.sinkReplicators {
+		replicator.StopReplication()
+	}
+	if c.sink != nil {
+		c.sink.Close()
+	}
 	resource.Resource().ReplicateManagerClient().Close()
 	mlog.Info(c.ctx, "CDC controller stopped")
 }
@@ -0,0 +1,53 @@
+package meta
+
+import (
+	"context"
+	"path"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+// SinkCheckpointPrefix is the prefix of the checkpoints of the replication sinks.
+const SinkCheckpointPrefix = "cdc-sink-checkpoint"
+
+// BuildSinkCheckpointKey returns the key of the checkpoint of the pchannel replicated into the sink.
+func BuildSinkCheckpointKey(sinkName string, pchannel string) string {
+	return path.Join(paramtable.Get().EtcdCfg.MetaRootPath.GetValue(), SinkCheckpointPrefix, sinkName, pchannel)
+}
+
+// GetSinkCheckpoint gets the checkpoint of the sink from metastore, nil is returned if there's no checkpoint.
+func GetSinkCheckpoint(ctx context.Context, etcdCli *clientv3.Client, key string) (*commonpb.ReplicateCheckpoint, error) {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	resp, err := etcdCli.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Kvs) == 0 {
+		return nil, nil
+	}
+	checkpoint := &commonpb.ReplicateCheckpoint{}
+	if err := proto.Unmarshal(resp.Kvs[0].Value, checkpoint); err != nil {
+		return nil, merr.Wrapf(err, "unmarshal sink checkpoint %s failed", key)
+	}
+	return checkpoint, nil
+}
+
+// SaveSinkCheckpoint saves the checkpoint of the sink into metastore.
+func SaveSinkCheckpoint(ctx context.Context, etcdCli *clientv3.Client, key string, checkpoint *commonpb.ReplicateCheckpoint) error {
+	value, err := proto.Marshal(checkpoint)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	_, err = etcdCli.Put(ctx, key, string(value))
+	return err
+}
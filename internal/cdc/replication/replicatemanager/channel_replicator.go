@@ -21,195 +21,130 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/cockroachdb/errors"
-
 	"github.com/milvus-io/milvus-proto/go-api/v3/milvuspb"
 	"github.com/milvus-io/milvus/internal/cdc/cluster"
 	"github.com/milvus-io/milvus/internal/cdc/meta"
 	"github.com/milvus-io/milvus/internal/cdc/replication/replicatestream"
 	"github.com/milvus-io/milvus/internal/cdc/util"
-	"github.com/milvus-io/milvus/internal/distributed/streaming"
 	"github.com/milvus-io/milvus/internal/streamingnode/server/wal/utility"
 	"github.com/milvus-io/milvus/pkg/v3/mlog"
 	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
-	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message/adaptor"
-	"github.com/milvus-io/milvus/pkg/v3/streaming/util/options"
 	"github.com/milvus-io/milvus/pkg/v3/util/merr"
 	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
-	"github.com/milvus-io/milvus/pkg/v3/util/syncutil"
 )
 
-// Replicator is the client that replicates the message to the channel in the target cluster.
-type Replicator interface {
-	// StartReplication starts the replicate for the channel.
-	StartReplication()
-
-	// StopReplication stops the replicate loop
-	// and wait for the loop to exit.
-	StopReplication()
-}
-
-var _ Replicator = (*channelReplicator)(nil)
+var _ ReplicateTarget = (*milvusTarget)(nil)
 
-// channelReplicator is the implementation of ChannelReplicator.
-type channelReplicator struct {
+// milvusTarget replicates the messages into the channel of the target milvus cluster,
+// the checkpoint is kept by the target cluster.
+type milvusTarget struct {
 	channel       *meta.ReplicateChannel
 	createRscFunc replicatestream.CreateReplicateStreamClientFunc
 	createMcFunc  cluster.CreateMilvusClientFunc
 	targetClient  cluster.MilvusClient
 	streamClient  replicatestream.ReplicateStreamClient
-	msgScanner    streaming.Scanner
-	msgChan       adaptor.ChanMessageHandler
-
-	asyncNotifier *syncutil.AsyncTaskNotifier[struct{}]
 }
 
-// NewChannelReplicator creates a new ChannelReplicator.
+// NewChannelReplicator creates a new replicator replicating the channel into the target cluster.
 func NewChannelReplicator(channel *meta.ReplicateChannel) Replicator {
-	createRscFunc := replicatestream.NewReplicateStreamClient
-	return &channelReplicator{
+	target := &milvusTarget{
 		channel:       channel,
-		createRscFunc: createRscFunc,
+		createRscFunc: replicatestream.NewReplicateStreamClient,
 		createMcFunc:  cluster.NewMilvusClient,
-		asyncNotifier: syncutil.NewAsyncTaskNotifier[struct{}](),
 	}
+	target.initLastReplicatedTimeTickMetric()
+	return NewTargetReplicator(channel.Value.GetSourceChannelName(), target)
 }
 
-func (r *channelReplicator) StartReplication() {
-	r.initLastReplicatedTimeTickMetric()
-	logger := mlog.With(mlog.String("key", r.channel.Key), mlog.Int64("modRevision", r.channel.ModRevision))
-	logger.Info(context.TODO(), "start replicate channel")
-	go func() {
-		defer func() {
-			if r.streamClient != nil {
-				r.streamClient.Close()
-			}
-			if r.msgScanner != nil {
-				r.msgScanner.Close()
-			}
-			if r.targetClient != nil {
-				r.targetClient.Close(r.asyncNotifier.Context())
-			}
-			r.asyncNotifier.Finish(struct{}{})
-		}()
-	INIT_LOOP:
-		for {
-			select {
-			case <-r.asyncNotifier.Context().Done():
-				return
-			default:
-				err := r.init()
-				if err != nil {
-					logger.Warn(context.TODO(), "initialize replicator failed", mlog.Err(err))
-					continue
-				}
-				break INIT_LOOP
-			}
-		}
-		r.startConsumeLoop()
-	}()
+func (t *milvusTarget) Name() string {
+	return fmt.Sprintf("%s/%s", t.channel.Value.GetTargetCluster().GetClusterId(), t.channel.Value.GetTargetChannelName())
 }
 
 // initLastReplicatedTimeTickMetric seeds the lag series synchronously, before
-// any network dependency, so that the series exists even while init() keeps
+// any network dependency, so that the series exists even while Init() keeps
 // failing against an unreachable target — an absent series reads as zero lag
 // on the dashboard. The initialized (creation-time) checkpoint is a
 // conservative bound: real progress is at or after it, so the seed can only
-// overstate the lag. init() overwrites it with the target-confirmed
+// overstate the lag. Init() overwrites it with the target-confirmed
 // checkpoint once the target is reachable.
-func (r *channelReplicator) initLastReplicatedTimeTickMetric() {
-	checkpoint := r.channel.Value.GetInitializedCheckpoint()
+func (t *milvusTarget) initLastReplicatedTimeTickMetric() {
+	checkpoint := t.channel.Value.GetInitializedCheckpoint()
 	if checkpoint == nil {
 		return
 	}
-	replicatestream.InitLastReplicatedTimeTick(r.channel.Value, checkpoint.GetTimeTick())
+	replicatestream.InitLastReplicatedTimeTick(t.channel.Value, checkpoint.GetTimeTick())
 }
 
-func (r *channelReplicator) init() error {
-	logger := mlog.With(mlog.String("key", r.channel.Key), mlog.Int64("modRevision", r.channel.ModRevision))
+func (t *milvusTarget) Init(ctx context.Context) (*utility.ReplicateCheckpoint, error) {
+	logger := mlog.With(mlog.String("key", t.channel.Key), mlog.Int64("modRevision", t.channel.ModRevision))
 	// init target client
-	if r.targetClient == nil {
-		dialCtx, dialCancel := context.WithTimeout(r.asyncNotifier.Context(), 30*time.Second)
+	if t.targetClient == nil {
+		dialCtx, dialCancel := context.WithTimeout(ctx, 30*time.Second)
 		defer dialCancel()
-		milvusClient, err := r.createMcFunc(dialCtx, r.channel.Value.GetTargetCluster())
+		milvusClient, err := t.createMcFunc(dialCtx, t.channel.Value.GetTargetCluster())
 		if err != nil {
-			return err
+			return nil, err
 		}
-		r.targetClient = milvusClient
+		t.targetClient = milvusClient
 		logger.Info(context.TODO(), "target client initialized")
 	}
-	// init msg scanner
-	if r.msgScanner == nil {
-		cp, err := r.getReplicateCheckpoint()
-		if err != nil {
-			return err
-		}
-		ch := make(adaptor.ChanMessageHandler)
-		scanner := streaming.WAL().Read(r.asyncNotifier.Context(), streaming.ReadOption{
-			PChannel:               r.channel.Value.GetSourceChannelName(),
-			DeliverPolicy:          options.DeliverPolicyStartFrom(cp.MessageID),
-			DeliverFilters:         []options.DeliverFilter{options.DeliverFilterTimeTickGT(cp.TimeTick)},
-			MessageHandler:         ch,
-			IgnorePauseConsumption: true,
-		})
-		r.msgScanner = scanner
-		r.msgChan = ch
-		// Seed the last replicated time tick from the resume checkpoint so that
-		// after a CDC pod restart the lag metric reports the real backlog
-		// immediately, instead of the series being absent (which reads as 0)
-		// until the first message is replicated.
-		replicatestream.InitLastReplicatedTimeTick(r.channel.Value, cp.TimeTick)
-		logger.Info(context.TODO(), "scanner initialized", mlog.Any("checkpoint", cp))
+	cp, err := t.getReplicateCheckpoint(ctx)
+	if err != nil {
+		return nil, err
 	}
+	// Seed the last replicated time tick from the resume checkpoint so that
+	// after a CDC pod restart the lag metric reports the real backlog
+	// immediately, instead of the series being absent (which reads as 0)
+	// until the first message is replicated.
+	replicatestream.InitLastReplicatedTimeTick(t.channel.Value, cp.TimeTick)
 	// init replicate stream client
-	if r.streamClient == nil {
-		r.streamClient = r.createRscFunc(r.asyncNotifier.Context(), r.targetClient, r.channel)
+	if t.streamClient == nil {
+		t.streamClient = t.createRscFunc(ctx, t.targetClient, t.channel)
 		logger.Info(context.TODO(), "stream client initialized")
 	}
+	return cp, nil
+}
+
+func (t *milvusTarget) Replicate(ctx context.Context, msg message.ImmutableMessage) error {
+	if err := t.streamClient.Replicate(msg); err != nil {
+		return err
+	}
+	if msg.MessageType() == message.MessageTypeAlterReplicateConfig &&
+		util.IsReplicationRemovedByAlterReplicateConfigMessage(msg, t.channel.Value) {
+		mlog.Info(context.TODO(), "replication removed",
+			mlog.String("key", t.channel.Key), mlog.Int64("modRevision", t.channel.ModRevision))
+		t.streamClient.BlockUntilFinish()
+		// The replication is genuinely removed, delete its lag
+		// series. Deleting after BlockUntilFinish ensures no
+		// in-flight confirm re-creates the series afterwards.
+		replicatestream.DeleteLastReplicatedTimeTick(t.channel.Value)
+		return ErrReplicateFinished
+	}
 	return nil
 }
 
-// startConsumeLoop starts the replicate loop.
-func (r *channelReplicator) startConsumeLoop() {
-	logger := mlog.With(mlog.String("key", r.channel.Key), mlog.Int64("modRevision", r.channel.ModRevision))
-	logger.Info(context.TODO(), "start consume loop")
-
-	for {
-		select {
-		case <-r.asyncNotifier.Context().Done():
-			logger.Info(context.TODO(), "consume loop stopped")
-			return
-		case msg := <-r.msgChan:
-			err := r.streamClient.Replicate(msg)
-			if err != nil {
-				if !errors.Is(err, replicatestream.ErrReplicateIgnored) {
-					panic(fmt.Sprintf("replicate message failed due to unrecoverable error: %v", err))
-				}
-				continue
-			}
-			logger.Debug(context.TODO(), "replicate message success", mlog.FieldMessage(msg))
-			if msg.MessageType() == message.MessageTypeAlterReplicateConfig {
-				if util.IsReplicationRemovedByAlterReplicateConfigMessage(msg, r.channel.Value) {
-					logger.Info(context.TODO(), "replication removed, stop consume loop")
-					r.streamClient.BlockUntilFinish()
-					// The replication is genuinely removed, delete its lag
-					// series. Deleting after BlockUntilFinish ensures no
-					// in-flight confirm re-creates the series afterwards.
-					replicatestream.DeleteLastReplicatedTimeTick(r.channel.Value)
-					return
-				}
-			}
-		}
+// SaveCheckpoint does nothing, the target cluster keeps the checkpoint of the
+// messages confirmed by the replicate stream, see getReplicateCheckpoint.
+func (t *milvusTarget) SaveCheckpoint(ctx context.Context, cp *utility.ReplicateCheckpoint) error {
+	return nil
+}
+
+func (t *milvusTarget) Close() {
+	if t.streamClient != nil {
+		t.streamClient.Close()
+	}
+	if t.targetClient != nil {
+		t.targetClient.Close(context.Background())
 	}
 }
 
-func (r *channelReplicator) getReplicateCheckpoint() (*utility.ReplicateCheckpoint, error) {
-	logger := mlog.With(mlog.String("key", r.channel.Key), mlog.Int64("modRevision", r.channel.ModRevision))
+func (t *milvusTarget) getReplicateCheckpoint(ctx context.Context) (*utility.ReplicateCheckpoint, error) {
+	logger := mlog.With(mlog.String("key", t.channel.Key), mlog.Int64("modRevision", t.channel.ModRevision))
 
 	// For pchannel-increasing tasks, the secondary WAL for new pchannels hasn't received the
 	// AlterReplicateConfig yet, so GetReplicateInfo would fail. Use InitializedCheckpoint directly.
-	if r.channel.Value.GetSkipGetReplicateCheckpoint() {
-		initializedCheckpoint := utility.NewReplicateCheckpointFromProto(r.channel.Value.InitializedCheckpoint)
+	if t.channel.Value.GetSkipGetReplicateCheckpoint() {
+		initializedCheckpoint := utility.NewReplicateCheckpointFromProto(t.channel.Value.InitializedCheckpoint)
 		logger.Info(context.TODO(), "skip get replicate checkpoint for pchannel-increasing task, use initialized checkpoint",
 			mlog.Stringer("messageID", initializedCheckpoint.MessageID),
 			mlog.Uint64("timeTick", initializedCheckpoint.TimeTick),
@@ -217,22 +152,22 @@ func (r *channelReplicator) getReplicateCheckpoint() (*utility.ReplicateCheckpoi
 		return initializedCheckpoint, nil
 	}
 
-	ctx, cancel := context.WithTimeout(r.asyncNotifier.Context(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
 	sourceClusterID := paramtable.Get().CommonCfg.ClusterPrefix.GetValue()
 	req := &milvuspb.GetReplicateInfoRequest{
 		SourceClusterId: sourceClusterID,
-		TargetPchannel:  r.channel.Value.GetTargetChannelName(),
+		TargetPchannel:  t.channel.Value.GetTargetChannelName(),
 	}
-	replicateInfo, err := r.targetClient.GetReplicateInfo(ctx, req)
+	replicateInfo, err := t.targetClient.GetReplicateInfo(ctx, req)
 	if err != nil {
 		return nil, merr.Wrap(err, "failed to get replicate info")
 	}
 
 	checkpoint := replicateInfo.GetCheckpoint()
 	if checkpoint == nil || checkpoint.MessageId == nil {
-		initializedCheckpoint := utility.NewReplicateCheckpointFromProto(r.channel.Value.InitializedCheckpoint)
+		initializedCheckpoint := utility.NewReplicateCheckpointFromProto(t.channel.Value.InitializedCheckpoint)
 		logger.Info(context.TODO(), "channel not found in replicate info, will start from the beginning",
 			mlog.Stringer("messageID", initializedCheckpoint.MessageID),
 			mlog.Uint64("timeTick", initializedCheckpoint.TimeTick),
@@ -247,8 +182,3 @@ func (r *channelReplicator) getReplicateCheckpoint() (*utility.ReplicateCheckpoi
 	)
 	return cp, nil
 }
-
-func (r *channelReplicator) StopReplication() {
-	r.asyncNotifier.Cancel()
-	r.asyncNotifier.BlockUntilFinish()
-}
@@ -84,13 +84,14 @@ func TestChannelReplicator_StartReplicateChannel(t *testing.T) {
 	})
 	assert.NotNil(t, replicator)
 
-	replicator.(*channelReplicator).createRscFunc = func(ctx context.Context,
+	target := replicator.(*targetReplicator).target.(*milvusTarget)
+	target.createRscFunc = func(ctx context.Context,
 		c cluster.MilvusClient,
 		rm *meta.ReplicateChannel,
 	) replicatestream.ReplicateStreamClient {
 		return rs
 	}
-	replicator.(*channelReplicator).createMcFunc = func(ctx context.Context,
+	target.createMcFunc = func(ctx context.Context,
 		cluster *commonpb.MilvusCluster,
 	) (cluster.MilvusClient, error) {
 		return mockMilvusClient, nil
@@ -141,13 +142,16 @@ func TestChannelReplicatorConsumeLoopDeletesLagSeriesOnRemoval(t *testing.T) {
 	rs.EXPECT().Replicate(mock.Anything).Return(nil)
 	rs.EXPECT().BlockUntilFinish().Return()
 
-	replicator := &channelReplicator{
-		channel: &meta.ReplicateChannel{
-			Key:         "removal-key",
-			ModRevision: 1,
-			Value:       replicateInfo,
+	replicator := &targetReplicator{
+		pchannel: source,
+		target: &milvusTarget{
+			channel: &meta.ReplicateChannel{
+				Key:         "removal-key",
+				ModRevision: 1,
+				Value:       replicateInfo,
+			},
+			streamClient: rs,
 		},
-		streamClient:  rs,
 		msgChan:       make(adaptor.ChanMessageHandler),
 		asyncNotifier: syncutil.NewAsyncTaskNotifier[struct{}](),
 	}
@@ -173,11 +177,9 @@ func TestChannelReplicatorInitMetricFromInitializedCheckpoint(t *testing.T) {
 			},
 		},
 	})
-	channelReplicator, ok := replicator.(*channelReplicator)
+	_, ok := replicator.(*targetReplicator).target.(*milvusTarget)
 	assert.True(t, ok)
 
-	channelReplicator.initLastReplicatedTimeTickMetric()
-
 	got := testutil.ToFloat64(metrics.CDCLastReplicatedTimeTick.WithLabelValues(source, target))
 	assert.InDelta(t, tsoutil.PhysicalTimeSeconds(checkpoint), got, 1)
 }
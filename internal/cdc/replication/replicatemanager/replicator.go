@@ -0,0 +1,221 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replicatemanager
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus/internal/cdc/replication/replicatestream"
+	"github.com/milvus-io/milvus/internal/distributed/streaming"
+	"github.com/milvus-io/milvus/internal/streamingnode/server/wal/utility"
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message/adaptor"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/options"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v3/util/syncutil"
+)
+
+// ErrReplicateFinished is returned by ReplicateTarget.Replicate if the replication
+// is finished after the message, the replicator stops replicating then.
+var ErrReplicateFinished = errors.New("replication finished")
+
+// Replicator is the client that replicates the message to the channel in the target cluster.
+type Replicator interface {
+	// StartReplication starts the replicate for the channel.
+	StartReplication()
+
+	// StopReplication stops the replicate loop
+	// and wait for the loop to exit.
+	StopReplication()
+}
+
+// ReplicateTarget is where the messages of a pchannel are replicated into, e.g. the channel
+// of the target milvus cluster or a sink. The scanning of the wal and the checkpointing
+// are shared by all the targets, see NewTargetReplicator.
+type ReplicateTarget interface {
+	// Name returns the name of the target for logging.
+	Name() string
+
+	// Init initializes the target and returns the checkpoint to resume from, the messages
+	// after the checkpoint are replicated, or the ones after the latest message if it's nil.
+	// Init is retried until it succeeds or the replicator is stopped.
+	Init(ctx context.Context) (*utility.ReplicateCheckpoint, error)
+
+	// Replicate replicates the message into the target.
+	// return replicatestream.ErrReplicateIgnored if the message is not replicated,
+	// return ErrReplicateFinished if the replication is finished after the message,
+	// any other error is unrecoverable unless the replicator is stopped.
+	Replicate(ctx context.Context, msg message.ImmutableMessage) error
+
+	// SaveCheckpoint persists the checkpoint of the last replicated message, it's called
+	// periodically and when the replicator stops. The targets which keep the checkpoint
+	// by themselves may do nothing here.
+	SaveCheckpoint(ctx context.Context, cp *utility.ReplicateCheckpoint) error
+
+	// Close closes the target.
+	Close()
+}
+
+var _ Replicator = (*targetReplicator)(nil)
+
+// targetReplicator scans the messages of a pchannel from the checkpoint of the target
+// and replicates them into the target.
+type targetReplicator struct {
+	pchannel string
+	target   ReplicateTarget
+
+	// checkpoint is the position of the last replicated message, nil if nothing is replicated.
+	checkpoint *utility.ReplicateCheckpoint
+	saved      bool
+	msgScanner streaming.Scanner
+	msgChan    adaptor.ChanMessageHandler
+
+	asyncNotifier *syncutil.AsyncTaskNotifier[struct{}]
+}
+
+// NewTargetReplicator creates a new replicator replicating the pchannel into the target.
+func NewTargetReplicator(pchannel string, target ReplicateTarget) Replicator {
+	return &targetReplicator{
+		pchannel:      pchannel,
+		target:        target,
+		saved:         true,
+		asyncNotifier: syncutil.NewAsyncTaskNotifier[struct{}](),
+	}
+}
+
+func (r *targetReplicator) StartReplication() {
+	logger := mlog.With(mlog.String("pchannel", r.pchannel), mlog.String("target", r.target.Name()))
+	logger.Info(context.TODO(), "start replicate channel")
+	go func() {
+		defer func() {
+			if r.msgScanner != nil {
+				r.msgScanner.Close()
+			}
+			r.flushCheckpoint(context.Background())
+			r.target.Close()
+			r.asyncNotifier.Finish(struct{}{})
+		}()
+		for {
+			cp, err := r.target.Init(r.asyncNotifier.Context())
+			if err == nil {
+				r.checkpoint = cp
+				break
+			}
+			logger.Warn(context.TODO(), "initialize replicate target failed", mlog.Err(err))
+			select {
+			case <-r.asyncNotifier.Context().Done():
+				return
+			case <-time.After(time.Second):
+			}
+		}
+		r.startScanner()
+		r.startConsumeLoop()
+	}()
+}
+
+// startScanner starts the scanner after the last replicated message.
+func (r *targetReplicator) startScanner() {
+	opts := streaming.ReadOption{
+		PChannel:               r.pchannel,
+		DeliverPolicy:          options.DeliverPolicyLatest(),
+		IgnorePauseConsumption: true,
+	}
+	if r.checkpoint != nil {
+		opts.DeliverPolicy = options.DeliverPolicyStartFrom(r.checkpoint.MessageID)
+		opts.DeliverFilters = []options.DeliverFilter{options.DeliverFilterTimeTickGT(r.checkpoint.TimeTick)}
+	}
+	ch := make(adaptor.ChanMessageHandler)
+	opts.MessageHandler = ch
+	r.msgScanner = streaming.WAL().Read(r.asyncNotifier.Context(), opts)
+	r.msgChan = ch
+	mlog.Info(context.TODO(), "scanner initialized",
+		mlog.String("pchannel", r.pchannel), mlog.String("target", r.target.Name()), mlog.Any("checkpoint", r.checkpoint))
+}
+
+// startConsumeLoop starts the replicate loop.
+func (r *targetReplicator) startConsumeLoop() {
+	logger := mlog.With(mlog.String("pchannel", r.pchannel), mlog.String("target", r.target.Name()))
+	logger.Info(context.TODO(), "start consume loop")
+
+	ticker := time.NewTicker(paramtable.Get().StreamingCfg.ReplicationSinkCheckpointInterval.GetAsDurationByParse())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.asyncNotifier.Context().Done():
+			logger.Info(context.TODO(), "consume loop stopped")
+			return
+		case <-ticker.C:
+			r.flushCheckpoint(r.asyncNotifier.Context())
+		case msg, ok := <-r.msgChan:
+			if !ok {
+				if r.asyncNotifier.Context().Err() != nil {
+					return
+				}
+				// the scanner is closed unexpectedly, restart it from the last replicated message.
+				logger.Warn(context.TODO(), "scanner closed, restart it", mlog.Err(r.msgScanner.Error()))
+				r.msgScanner.Close()
+				r.startScanner()
+				continue
+			}
+			err := r.target.Replicate(r.asyncNotifier.Context(), msg)
+			if err != nil && !errors.Is(err, ErrReplicateFinished) {
+				if errors.Is(err, replicatestream.ErrReplicateIgnored) {
+					continue
+				}
+				if r.asyncNotifier.Context().Err() != nil {
+					return
+				}
+				panic(fmt.Sprintf("replicate message failed due to unrecoverable error: %v", err))
+			}
+			logger.Debug(context.TODO(), "replicate message success", mlog.FieldMessage(msg))
+			r.checkpoint = &utility.ReplicateCheckpoint{
+				PChannel:  r.pchannel,
+				MessageID: msg.MessageID(),
+				TimeTick:  msg.TimeTick(),
+			}
+			r.saved = false
+			if err != nil {
+				logger.Info(context.TODO(), "replication finished, stop consume loop")
+				return
+			}
+		}
+	}
+}
+
+// flushCheckpoint saves the position of the last replicated message if it's not saved.
+func (r *targetReplicator) flushCheckpoint(ctx context.Context) {
+	if r.saved || r.checkpoint == nil {
+		return
+	}
+	if err := r.target.SaveCheckpoint(ctx, r.checkpoint); err != nil {
+		mlog.Warn(context.TODO(), "save replicate checkpoint failed",
+			mlog.String("pchannel", r.pchannel), mlog.String("target", r.target.Name()), mlog.Err(err))
+		return
+	}
+	r.saved = true
+}
+
+func (r *targetReplicator) StopReplication() {
+	r.asyncNotifier.Cancel()
+	r.asyncNotifier.BlockUntilFinish()
+}
@@ -0,0 +1,111 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replicatemanager
+
+import (
+	"context"
+	"time"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus/internal/cdc/meta"
+	"github.com/milvus-io/milvus/internal/cdc/resource"
+	"github.com/milvus-io/milvus/internal/cdc/sink"
+	"github.com/milvus-io/milvus/internal/streamingnode/server/wal/utility"
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
+)
+
+var _ ReplicateTarget = (*sinkTarget)(nil)
+
+// sinkTarget replicates the collection level changes of a pchannel into a sink,
+// the replicated position is checkpointed into the cdc meta periodically,
+// so the sink receives the changes at least once.
+type sinkTarget struct {
+	pchannel       string
+	sink           sink.Sink
+	loadCheckpoint func(ctx context.Context) (*commonpb.ReplicateCheckpoint, error)
+	saveCheckpoint func(ctx context.Context, cp *commonpb.ReplicateCheckpoint) error
+}
+
+// NewSinkReplicator creates a new replicator replicating the pchannel into the sink.
+func NewSinkReplicator(pchannel string, s sink.Sink) Replicator {
+	return NewTargetReplicator(pchannel, newSinkTarget(pchannel, s))
+}
+
+func newSinkTarget(pchannel string, s sink.Sink) *sinkTarget {
+	key := meta.BuildSinkCheckpointKey(s.Name(), pchannel)
+	return &sinkTarget{
+		pchannel: pchannel,
+		sink:     s,
+		loadCheckpoint: func(ctx context.Context) (*commonpb.ReplicateCheckpoint, error) {
+			return meta.GetSinkCheckpoint(ctx, resource.Resource().ETCD(), key)
+		},
+		saveCheckpoint: func(ctx context.Context, cp *commonpb.ReplicateCheckpoint) error {
+			return meta.SaveSinkCheckpoint(ctx, resource.Resource().ETCD(), key, cp)
+		},
+	}
+}
+
+func (t *sinkTarget) Name() string {
+	return "sink/" + t.sink.Name()
+}
+
+// Init loads the checkpoint saved in the cdc meta, the replication starts from the latest
+// message if there's no checkpoint.
+func (t *sinkTarget) Init(ctx context.Context) (*utility.ReplicateCheckpoint, error) {
+	cp, err := t.loadCheckpoint(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if cp == nil || cp.GetMessageId() == nil {
+		return nil, nil
+	}
+	return utility.NewReplicateCheckpointFromProto(cp), nil
+}
+
+// Replicate emits the message into the sink, it keeps retrying until success or ctx is done.
+func (t *sinkTarget) Replicate(ctx context.Context, msg message.ImmutableMessage) error {
+	events, err := sink.NewEventsFromMessage(t.pchannel, msg)
+	if err != nil {
+		// the message is broken, there's nothing can be done but skip it.
+		mlog.Warn(context.TODO(), "convert message into sink events failed, skip it", mlog.FieldMessage(msg), mlog.Err(err))
+		return nil
+	}
+	if len(events) == 0 {
+		return nil
+	}
+	for {
+		err := t.sink.Emit(ctx, events...)
+		if err == nil {
+			return nil
+		}
+		mlog.Warn(context.TODO(), "emit events into sink failed, retry later",
+			mlog.String("pchannel", t.pchannel), mlog.String("sink", t.sink.Name()), mlog.Err(err))
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+func (t *sinkTarget) SaveCheckpoint(ctx context.Context, cp *utility.ReplicateCheckpoint) error {
+	return t.saveCheckpoint(ctx, cp.IntoProto())
+}
+
+// Close does nothing, the sink is shared by the replicators of all pchannels and closed by the controller.
+func (t *sinkTarget) Close() {}
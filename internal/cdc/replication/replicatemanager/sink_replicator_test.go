@@ -0,0 +1,133 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replicatemanager
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v3/msgpb"
+	"github.com/milvus-io/milvus/internal/cdc/sink"
+	"github.com/milvus-io/milvus/internal/distributed/streaming"
+	"github.com/milvus-io/milvus/internal/mocks/distributed/mock_streaming"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message/adaptor"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/walimpls/impls/walimplstest"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+type testSink struct {
+	mu     sync.Mutex
+	fails  int
+	events []*sink.Event
+}
+
+func (s *testSink) Name() string {
+	return "test"
+}
+
+func (s *testSink) Emit(ctx context.Context, events ...*sink.Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.fails > 0 {
+		s.fails--
+		return errors.New("mock emit failure")
+	}
+	s.events = append(s.events, events...)
+	return nil
+}
+
+func (s *testSink) Close() {}
+
+func (s *testSink) count() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.events)
+}
+
+func TestSinkReplicator(t *testing.T) {
+	paramtable.Init()
+
+	msgs := make([]message.ImmutableMessage, 0, 2)
+	for i := int64(1); i <= 2; i++ {
+		msgID := walimplstest.NewTestMessageID(i)
+		msgs = append(msgs, message.NewInsertMessageBuilderV1().
+			WithHeader(&message.InsertMessageHeader{CollectionId: 1}).
+			WithBody(&msgpb.InsertRequest{CollectionID: 1, NumRows: 1}).
+			WithVChannel("test-pchannel_1v0").
+			MustBuildMutable().
+			WithTimeTick(uint64(i)).
+			WithLastConfirmed(msgID).
+			IntoImmutableMessage(msgID))
+	}
+
+	scanner := mock_streaming.NewMockScanner(t)
+	scanner.EXPECT().Close().Return()
+	wal := mock_streaming.NewMockWALAccesser(t)
+	wal.EXPECT().Read(mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, opts streaming.ReadOption) streaming.Scanner {
+			assert.Equal(t, "test-pchannel", opts.PChannel)
+			// resume after the saved checkpoint.
+			policy, ok := opts.DeliverPolicy.GetPolicy().(*streamingpb.DeliverPolicy_StartFrom)
+			assert.True(t, ok)
+			assert.Equal(t, walimplstest.NewTestMessageID(0).Marshal(), policy.StartFrom.GetId())
+			assert.Len(t, opts.DeliverFilters, 1)
+			ch := opts.MessageHandler.(adaptor.ChanMessageHandler)
+			go func() {
+				for _, msg := range msgs {
+					ch <- msg
+				}
+			}()
+			return scanner
+		})
+	streaming.SetWALForTest(wal)
+
+	s := &testSink{fails: 1}
+	var saved *commonpb.ReplicateCheckpoint
+	target := newSinkTarget("test-pchannel", s)
+	target.loadCheckpoint = func(ctx context.Context) (*commonpb.ReplicateCheckpoint, error) {
+		return &commonpb.ReplicateCheckpoint{
+			Pchannel:  "test-pchannel",
+			MessageId: walimplstest.NewTestMessageID(0).IntoProto(),
+		}, nil
+	}
+	target.saveCheckpoint = func(ctx context.Context, cp *commonpb.ReplicateCheckpoint) error {
+		saved = cp
+		return nil
+	}
+
+	r := NewTargetReplicator("test-pchannel", target)
+	r.StartReplication()
+	require.Eventually(t, func() bool {
+		return s.count() == 2
+	}, 10*time.Second, 10*time.Millisecond)
+	r.StopReplication()
+
+	// the checkpoint of the last emitted message is saved when stopping.
+	require.NotNil(t, saved)
+	assert.Equal(t, uint64(2), saved.GetTimeTick())
+	assert.Equal(t, walimplstest.NewTestMessageID(2).Marshal(), saved.GetMessageId().GetId())
+}
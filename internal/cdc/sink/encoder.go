@@ -0,0 +1,156 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sink
+
+import (
+	"encoding/json"
+
+	"github.com/hamba/avro/v2"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+)
+
+const (
+	FormatJSON = "json"
+	FormatAvro = "avro"
+)
+
+// Encoder encodes the change events into the payload of the sink messages.
+type Encoder interface {
+	// ContentType returns the content type of the encoded payload.
+	ContentType() string
+
+	// Encode encodes the event.
+	Encode(event *Event) ([]byte, error)
+}
+
+// NewEncoder returns the encoder of the format.
+func NewEncoder(format string) (Encoder, error) {
+	switch format {
+	case FormatJSON:
+		return jsonEncoder{}, nil
+	case FormatAvro:
+		return newAvroEncoder(), nil
+	default:
+		return nil, merr.WrapErrParameterInvalidMsg("unsupported sink format %s", format)
+	}
+}
+
+// jsonEvent is the json form of the event, the data is the protojson of Event.Data.
+type jsonEvent struct {
+	Type           EventType       `json:"type"`
+	Timestamp      uint64          `json:"timestamp"`
+	PChannel       string          `json:"pchannel"`
+	VChannel       string          `json:"vchannel"`
+	CollectionID   int64           `json:"collection_id"`
+	DbName         string          `json:"db_name"`
+	CollectionName string          `json:"collection_name"`
+	PartitionName  string          `json:"partition_name,omitempty"`
+	Data           json.RawMessage `json:"data,omitempty"`
+}
+
+type jsonEncoder struct{}
+
+func (jsonEncoder) ContentType() string {
+	return "application/json"
+}
+
+func (jsonEncoder) Encode(event *Event) ([]byte, error) {
+	e := jsonEvent{
+		Type:           event.Type,
+		Timestamp:      event.Timestamp,
+		PChannel:       event.PChannel,
+		VChannel:       event.VChannel,
+		CollectionID:   event.CollectionID,
+		DbName:         event.DbName,
+		CollectionName: event.CollectionName,
+		PartitionName:  event.PartitionName,
+	}
+	if event.Data != nil {
+		data, err := protojson.Marshal(event.Data)
+		if err != nil {
+			return nil, err
+		}
+		e.Data = data
+	}
+	return json.Marshal(e)
+}
+
+// avroEventSchema is the avro schema of the event, the data is the marshaled protobuf of Event.Data.
+const avroEventSchema = `{
+	"type": "record",
+	"name": "ChangeEvent",
+	"namespace": "io.milvus.cdc",
+	"fields": [
+		{"name": "type", "type": "string"},
+		{"name": "timestamp", "type": "long"},
+		{"name": "pchannel", "type": "string"},
+		{"name": "vchannel", "type": "string"},
+		{"name": "collection_id", "type": "long"},
+		{"name": "db_name", "type": "string"},
+		{"name": "collection_name", "type": "string"},
+		{"name": "partition_name", "type": "string"},
+		{"name": "data", "type": "bytes"}
+	]
+}`
+
+type avroEvent struct {
+	Type           string `avro:"type"`
+	Timestamp      int64  `avro:"timestamp"`
+	PChannel       string `avro:"pchannel"`
+	VChannel       string `avro:"vchannel"`
+	CollectionID   int64  `avro:"collection_id"`
+	DbName         string `avro:"db_name"`
+	CollectionName string `avro:"collection_name"`
+	PartitionName  string `avro:"partition_name"`
+	Data           []byte `avro:"data"`
+}
+
+type avroEncoder struct {
+	schema avro.Schema
+}
+
+func newAvroEncoder() *avroEncoder {
+	return &avroEncoder{schema: avro.MustParse(avroEventSchema)}
+}
+
+func (e *avroEncoder) ContentType() string {
+	return "avro/binary"
+}
+
+func (e *avroEncoder) Encode(event *Event) ([]byte, error) {
+	a := avroEvent{
+		Type:           string(event.Type),
+		Timestamp:      int64(event.Timestamp),
+		PChannel:       event.PChannel,
+		VChannel:       event.VChannel,
+		CollectionID:   event.CollectionID,
+		DbName:         event.DbName,
+		CollectionName: event.CollectionName,
+		PartitionName:  event.PartitionName,
+	}
+	if event.Data != nil {
+		data, err := proto.Marshal(event.Data)
+		if err != nil {
+			return nil, err
+		}
+		a.Data = data
+	}
+	return avro.Marshal(e.schema, a)
+}
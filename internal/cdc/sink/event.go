@@ -0,0 +1,144 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sink
+
+import (
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v3/schemapb"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
+)
+
+// collectionBody is the common part of the message bodies of the collection level changes.
+type collectionBody interface {
+	GetDbName() string
+	GetCollectionName() string
+	GetCollectionID() int64
+}
+
+// NewEventsFromMessage converts the wal message of the pchannel into the change events,
+// the transaction is expanded, nil is returned if the message is not a collection level change.
+func NewEventsFromMessage(pchannel string, msg message.ImmutableMessage) ([]*Event, error) {
+	if txnMsg, ok := msg.(message.ImmutableTxnMessage); ok {
+		events := make([]*Event, 0, txnMsg.Size())
+		err := txnMsg.RangeOver(func(im message.ImmutableMessage) error {
+			converted, err := NewEventsFromMessage(pchannel, im)
+			if err != nil {
+				return err
+			}
+			events = append(events, converted...)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return events, nil
+	}
+
+	var (
+		eventType EventType
+		body      collectionBody
+		partition string
+		data      proto.Message
+	)
+	switch msg.MessageType() {
+	case message.MessageTypeInsert:
+		insertMsg, err := message.AsImmutableInsertMessageV1(msg)
+		if err != nil {
+			return nil, err
+		}
+		insert, err := insertMsg.Body()
+		if err != nil {
+			return nil, err
+		}
+		eventType, body, partition = EventInsert, insert, insert.GetPartitionName()
+		data = &milvuspb.InsertRequest{
+			PartitionName: insert.GetPartitionName(),
+			FieldsData:    insert.GetFieldsData(),
+			NumRows:       uint32(insert.GetNumRows()),
+		}
+	case message.MessageTypeDelete:
+		deleteMsg, err := message.AsImmutableDeleteMessageV1(msg)
+		if err != nil {
+			return nil, err
+		}
+		del, err := deleteMsg.Body()
+		if err != nil {
+			return nil, err
+		}
+		eventType, body, partition, data = EventDelete, del, del.GetPartitionName(), del.GetPrimaryKeys()
+	case message.MessageTypeCreateCollection:
+		createMsg, err := message.AsImmutableCreateCollectionMessageV1(msg)
+		if err != nil {
+			return nil, err
+		}
+		create, err := createMsg.Body()
+		if err != nil {
+			return nil, err
+		}
+		schema := &schemapb.CollectionSchema{}
+		if err := proto.Unmarshal(create.GetSchema(), schema); err != nil {
+			return nil, err
+		}
+		eventType, body, data = EventCreateCollection, create, schema
+	case message.MessageTypeDropCollection:
+		dropMsg, err := message.AsImmutableDropCollectionMessageV1(msg)
+		if err != nil {
+			return nil, err
+		}
+		drop, err := dropMsg.Body()
+		if err != nil {
+			return nil, err
+		}
+		eventType, body = EventDropCollection, drop
+	case message.MessageTypeCreatePartition:
+		createMsg, err := message.AsImmutableCreatePartitionMessageV1(msg)
+		if err != nil {
+			return nil, err
+		}
+		create, err := createMsg.Body()
+		if err != nil {
+			return nil, err
+		}
+		eventType, body, partition = EventCreatePartition, create, create.GetPartitionName()
+	case message.MessageTypeDropPartition:
+		dropMsg, err := message.AsImmutableDropPartitionMessageV1(msg)
+		if err != nil {
+			return nil, err
+		}
+		drop, err := dropMsg.Body()
+		if err != nil {
+			return nil, err
+		}
+		eventType, body, partition = EventDropPartition, drop, drop.GetPartitionName()
+	default:
+		return nil, nil
+	}
+
+	return []*Event{{
+		Type:           eventType,
+		Timestamp:      msg.TimeTick(),
+		PChannel:       pchannel,
+		VChannel:       msg.VChannel(),
+		CollectionID:   body.GetCollectionID(),
+		DbName:         body.GetDbName(),
+		CollectionName: body.GetCollectionName(),
+		PartitionName:  partition,
+		Data:           data,
+	}}, nil
+}
@@ -0,0 +1,116 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sink
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/mq/common"
+	"github.com/milvus-io/milvus/pkg/v3/mq/msgstream/mqwrapper"
+)
+
+// The properties attached to the sink messages, they're carried as the headers of kafka.
+const (
+	PropertyContentType  = "content-type"
+	PropertyEventType    = "event-type"
+	PropertyCollectionID = "collection-id"
+	PropertyVChannel     = "vchannel"
+)
+
+// mqSink emits the change events into a message queue,
+// the events of a collection are emitted into the topic {topicPrefix}-{collectionID}.
+type mqSink struct {
+	name        string
+	client      mqwrapper.Client
+	encoder     Encoder
+	topicPrefix string
+
+	mu        sync.Mutex
+	producers map[int64]mqwrapper.Producer
+}
+
+// NewMQSink creates a sink emitting the events into the message queue of the client.
+func NewMQSink(name string, client mqwrapper.Client, encoder Encoder, topicPrefix string) Sink {
+	return &mqSink{
+		name:        name,
+		client:      client,
+		encoder:     encoder,
+		topicPrefix: topicPrefix,
+		producers:   make(map[int64]mqwrapper.Producer),
+	}
+}
+
+func (s *mqSink) Name() string {
+	return s.name
+}
+
+func (s *mqSink) Emit(ctx context.Context, events ...*Event) error {
+	for _, event := range events {
+		producer, err := s.getProducer(ctx, event.CollectionID)
+		if err != nil {
+			return err
+		}
+		payload, err := s.encoder.Encode(event)
+		if err != nil {
+			return err
+		}
+		if _, err := producer.Send(ctx, &common.ProducerMessage{
+			Payload: payload,
+			Properties: map[string]string{
+				PropertyContentType:  s.encoder.ContentType(),
+				PropertyEventType:    string(event.Type),
+				PropertyCollectionID: strconv.FormatInt(event.CollectionID, 10),
+				PropertyVChannel:     event.VChannel,
+			},
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *mqSink) getProducer(ctx context.Context, collectionID int64) (mqwrapper.Producer, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if producer, ok := s.producers[collectionID]; ok {
+		return producer, nil
+	}
+	topic := fmt.Sprintf("%s-%d", s.topicPrefix, collectionID)
+	producer, err := s.client.CreateProducer(ctx, common.ProducerOptions{Topic: topic})
+	if err != nil {
+		return nil, err
+	}
+	s.producers[collectionID] = producer
+	mlog.Info(ctx, "sink producer created", mlog.String("sink", s.name), mlog.String("topic", topic))
+	return producer, nil
+}
+
+func (s *mqSink) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, producer := range s.producers {
+		producer.Close()
+	}
+	s.producers = make(map[int64]mqwrapper.Producer)
+	s.client.Close()
+}
@@ -0,0 +1,99 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sink
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus/pkg/v3/mq/msgstream/mqwrapper/kafka"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+// EventType is the type of the change event emitted to the sink.
+type EventType string
+
+const (
+	EventInsert           EventType = "insert"
+	EventDelete           EventType = "delete"
+	EventCreateCollection EventType = "create_collection"
+	EventDropCollection   EventType = "drop_collection"
+	EventCreatePartition  EventType = "create_partition"
+	EventDropPartition    EventType = "drop_partition"
+)
+
+// Event is a collection level change event emitted to the sink.
+type Event struct {
+	Type           EventType
+	Timestamp      uint64
+	PChannel       string
+	VChannel       string
+	CollectionID   int64
+	DbName         string
+	CollectionName string
+	PartitionName  string
+	// Data is the inserted rows of insert event as *milvuspb.InsertRequest,
+	// the deleted primary keys of delete event as *schemapb.IDs,
+	// or the schema of create collection event as *schemapb.CollectionSchema.
+	Data proto.Message
+}
+
+// Sink is a replication target other than milvus, the change events of the collections
+// are emitted to it, so that the non-milvus consumers can receive the change stream.
+type Sink interface {
+	// Name returns the unique name of the sink, the checkpoints of the sink are kept by it.
+	Name() string
+
+	// Emit emits the events in order, it returns after all events are persisted by the sink.
+	Emit(ctx context.Context, events ...*Event) error
+
+	// Close closes the sink.
+	Close()
+}
+
+// NewSinkFromConfig creates the sink by the replication sink configuration,
+// nil is returned if the sink is not configured.
+func NewSinkFromConfig(ctx context.Context) (Sink, error) {
+	params := &paramtable.Get().StreamingCfg
+	sinkType := params.ReplicationSinkType.GetValue()
+	if sinkType == "" {
+		return nil, nil
+	}
+	encoder, err := NewEncoder(params.ReplicationSinkFormat.GetValue())
+	if err != nil {
+		return nil, err
+	}
+	topicPrefix := params.ReplicationSinkTopicPrefix.GetValue()
+	name := fmt.Sprintf("%s/%s", sinkType, topicPrefix)
+
+	switch sinkType {
+	case "kafka":
+		if brokerList := params.ReplicationSinkKafkaBrokerList.GetValue(); brokerList != "" {
+			return NewMQSink(name, kafka.NewKafkaClientInstance(brokerList), encoder, topicPrefix), nil
+		}
+		client, err := kafka.NewKafkaClientInstanceWithConfig(ctx, &paramtable.Get().KafkaCfg)
+		if err != nil {
+			return nil, err
+		}
+		return NewMQSink(name, client, encoder, topicPrefix), nil
+	default:
+		return nil, merr.WrapErrParameterInvalidMsg("unsupported replication sink type %s", sinkType)
+	}
+}
@@ -0,0 +1,210 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sink
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hamba/avro/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v3/msgpb"
+	"github.com/milvus-io/milvus-proto/go-api/v3/schemapb"
+	"github.com/milvus-io/milvus/pkg/v3/mq/common"
+	"github.com/milvus-io/milvus/pkg/v3/mq/mqimpl/rocksmq/server"
+	"github.com/milvus-io/milvus/pkg/v3/mq/msgstream/mqwrapper"
+	"github.com/milvus-io/milvus/pkg/v3/mq/msgstream/mqwrapper/rmq"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/walimpls/impls/walimplstest"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+func TestMain(m *testing.M) {
+	exitCode := func() int {
+		paramtable.Init()
+		dir, _ := os.MkdirTemp("", "milvus_cdc_sink_test_*")
+		defer os.RemoveAll(dir)
+		paramtable.Get().Save("rocksmq.compressionTypes", "0,0,0,0,0")
+		_ = server.InitRocksMQ(dir + "/rdb_data")
+		defer server.CloseRocksMQ()
+		return m.Run()
+	}()
+	os.Exit(exitCode)
+}
+
+func newTestInsertMessage(id int64) message.ImmutableMessage {
+	msgID := walimplstest.NewTestMessageID(id)
+	return message.NewInsertMessageBuilderV1().
+		WithHeader(&message.InsertMessageHeader{CollectionId: 1}).
+		WithBody(&msgpb.InsertRequest{
+			DbName:         "db",
+			CollectionName: "coll",
+			CollectionID:   1,
+			PartitionName:  "p1",
+			NumRows:        2,
+			FieldsData: []*schemapb.FieldData{{
+				FieldName: "pk",
+				Type:      schemapb.DataType_Int64,
+				Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{1, 2}}},
+				}},
+			}},
+		}).
+		WithVChannel("by-dev-rootcoord-dml_0_1v0").
+		MustBuildMutable().
+		WithTimeTick(uint64(id)).
+		WithLastConfirmed(msgID).
+		IntoImmutableMessage(msgID)
+}
+
+func TestNewEventsFromMessage(t *testing.T) {
+	events, err := NewEventsFromMessage("by-dev-rootcoord-dml_0", newTestInsertMessage(10))
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, EventInsert, events[0].Type)
+	assert.Equal(t, uint64(10), events[0].Timestamp)
+	assert.Equal(t, "by-dev-rootcoord-dml_0", events[0].PChannel)
+	assert.Equal(t, "by-dev-rootcoord-dml_0_1v0", events[0].VChannel)
+	assert.Equal(t, int64(1), events[0].CollectionID)
+	assert.Equal(t, "db", events[0].DbName)
+	assert.Equal(t, "coll", events[0].CollectionName)
+	assert.Equal(t, "p1", events[0].PartitionName)
+	assert.Equal(t, uint32(2), events[0].Data.(*milvuspb.InsertRequest).GetNumRows())
+
+	msgID := walimplstest.NewTestMessageID(11)
+	pks := &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1}}}}
+	del := message.NewDeleteMessageBuilderV1().
+		WithHeader(&message.DeleteMessageHeader{CollectionId: 1, Rows: 1}).
+		WithBody(&msgpb.DeleteRequest{CollectionID: 1, PrimaryKeys: pks}).
+		WithVChannel("by-dev-rootcoord-dml_0_1v0").
+		MustBuildMutable().
+		WithTimeTick(11).
+		WithLastConfirmed(msgID).
+		IntoImmutableMessage(msgID)
+	events, err = NewEventsFromMessage("by-dev-rootcoord-dml_0", del)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, EventDelete, events[0].Type)
+	assert.True(t, proto.Equal(pks, events[0].Data))
+
+	// the messages other than collection level changes are ignored.
+	msgID = walimplstest.NewTestMessageID(12)
+	timeTick := message.NewTimeTickMessageBuilderV1().
+		WithHeader(&message.TimeTickMessageHeader{}).
+		WithBody(&msgpb.TimeTickMsg{}).
+		WithAllVChannel().
+		MustBuildMutable().
+		WithTimeTick(12).
+		WithLastConfirmed(msgID).
+		IntoImmutableMessage(msgID)
+	events, err = NewEventsFromMessage("by-dev-rootcoord-dml_0", timeTick)
+	require.NoError(t, err)
+	assert.Empty(t, events)
+}
+
+func newTestEvent() *Event {
+	return &Event{
+		Type:           EventDelete,
+		Timestamp:      100,
+		PChannel:       "pchannel",
+		VChannel:       "vchannel",
+		CollectionID:   1,
+		DbName:         "db",
+		CollectionName: "coll",
+		Data:           &schemapb.IDs{IdField: &schemapb.IDs_StrId{StrId: &schemapb.StringArray{Data: []string{"a"}}}},
+	}
+}
+
+func TestEncoder(t *testing.T) {
+	_, err := NewEncoder("xml")
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+
+	event := newTestEvent()
+
+	encoder, err := NewEncoder(FormatJSON)
+	require.NoError(t, err)
+	payload, err := encoder.Encode(event)
+	require.NoError(t, err)
+	decoded := jsonEvent{}
+	require.NoError(t, json.Unmarshal(payload, &decoded))
+	assert.Equal(t, EventDelete, decoded.Type)
+	assert.Equal(t, uint64(100), decoded.Timestamp)
+	assert.Equal(t, "coll", decoded.CollectionName)
+	ids := &schemapb.IDs{}
+	require.NoError(t, protojson.Unmarshal(decoded.Data, ids))
+	assert.True(t, proto.Equal(event.Data, ids))
+
+	encoder, err = NewEncoder(FormatAvro)
+	require.NoError(t, err)
+	payload, err = encoder.Encode(event)
+	require.NoError(t, err)
+	decodedAvro := avroEvent{}
+	require.NoError(t, avro.Unmarshal(avro.MustParse(avroEventSchema), payload, &decodedAvro))
+	assert.Equal(t, string(EventDelete), decodedAvro.Type)
+	assert.Equal(t, int64(100), decodedAvro.Timestamp)
+	assert.Equal(t, int64(1), decodedAvro.CollectionID)
+	ids = &schemapb.IDs{}
+	require.NoError(t, proto.Unmarshal(decodedAvro.Data, ids))
+	assert.True(t, proto.Equal(event.Data, ids))
+}
+
+func TestMQSink(t *testing.T) {
+	client, err := rmq.NewClientWithDefaultOptions(context.Background())
+	require.NoError(t, err)
+	encoder, err := NewEncoder(FormatJSON)
+	require.NoError(t, err)
+	s := NewMQSink("rmq/test", client, encoder, "test-cdc")
+	defer s.Close()
+	assert.Equal(t, "rmq/test", s.Name())
+
+	consumer, err := client.Subscribe(context.Background(), mqwrapper.ConsumerOptions{
+		Topic:                       "test-cdc-1",
+		SubscriptionName:            "test-sub",
+		SubscriptionInitialPosition: common.SubscriptionPositionEarliest,
+		BufSize:                     16,
+	})
+	require.NoError(t, err)
+	defer consumer.Close()
+
+	first, second := newTestEvent(), newTestEvent()
+	second.Timestamp = 101
+	require.NoError(t, s.Emit(context.Background(), first, second))
+
+	for _, expected := range []uint64{100, 101} {
+		select {
+		case msg := <-consumer.Chan():
+			consumer.Ack(msg)
+			assert.Equal(t, "application/json", msg.Properties()[PropertyContentType])
+			assert.Equal(t, string(EventDelete), msg.Properties()[PropertyEventType])
+			assert.Equal(t, "1", msg.Properties()[PropertyCollectionID])
+			decoded := jsonEvent{}
+			require.NoError(t, json.Unmarshal(msg.Payload(), &decoded))
+			assert.Equal(t, expected, decoded.Timestamp)
+		case <-time.After(5 * time.Second):
+			t.Fatal("sink message not received")
+		}
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v3/milvuspb"
 	"github.com/milvus-io/milvus/internal/datacoord"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	sqlkv "github.com/milvus-io/milvus/internal/kv/sql"
 	"github.com/milvus-io/milvus/internal/kv/tikv"
 	"github.com/milvus-io/milvus/internal/querycoordv2"
 	"github.com/milvus-io/milvus/internal/rootcoord"
@@ -75,7 +76,7 @@ type mixCoordImpl struct {
 
 	metricsRequest *metricsinfo.MetricsRequest
 
-	metaKVCreator  func() kv.MetaKv
+	metaKVCreator  func() (kv.MetaKv, error)
 	mixCoordClient types.MixCoordClient
 
 	// POSIX directory cleanup task
@@ -132,7 +133,9 @@ func (s *mixCoordImpl) Init() error {
 	}
 	s.factory.Init(Params)
 	s.initKVCreator()
-	s.initStreamingCoord()
+	if initErr = s.initStreamingCoord(); initErr != nil {
+		return initErr
+	}
 	s.UpdateStateCode(commonpb.StateCode_StandBy)
 	mlog.Info(s.ctx, "MixCoord enter standby mode successfully")
 	return nil
@@ -218,14 +221,18 @@ func (s *mixCoordImpl) initInternal() error {
 func (s *mixCoordImpl) initKVCreator() {
 	if s.metaKVCreator == nil {
 		if Params.MetaStoreCfg.MetaStoreType.GetValue() == util.MetaStoreTypeTiKV {
-			s.metaKVCreator = func() kv.MetaKv {
+			s.metaKVCreator = func() (kv.MetaKv, error) {
 				return tikv.NewTiKV(s.tikvCli, Params.TiKVCfg.MetaRootPath.GetValue(),
-					tikv.WithRequestTimeout(paramtable.Get().TiKVCfg.RequestTimeout.GetAsDuration(time.Millisecond)))
+					tikv.WithRequestTimeout(paramtable.Get().TiKVCfg.RequestTimeout.GetAsDuration(time.Millisecond))), nil
+			}
+		} else if Params.MetaStoreCfg.MetaStoreType.GetValue() == util.MetaStoreTypeSQL {
+			s.metaKVCreator = func() (kv.MetaKv, error) {
+				return sqlkv.NewMetaKvFactory(Params.SQLCfg.MetaRootPath.GetValue(), &Params.SQLCfg)
 			}
 		} else {
-			s.metaKVCreator = func() kv.MetaKv {
+			s.metaKVCreator = func() (kv.MetaKv, error) {
 				return etcdkv.NewEtcdKV(s.etcdCli, Params.EtcdCfg.MetaRootPath.GetValue(),
-					etcdkv.WithRequestTimeout(paramtable.Get().EtcdCfg.RequestTimeout.GetAsDuration(time.Millisecond)))
+					etcdkv.WithRequestTimeout(paramtable.Get().EtcdCfg.RequestTimeout.GetAsDuration(time.Millisecond))), nil
 			}
 		}
 	}
@@ -366,16 +373,21 @@ func (s *mixCoordImpl) Stop() error {
 	return nil
 }
 
-func (s *mixCoordImpl) initStreamingCoord() {
+func (s *mixCoordImpl) initStreamingCoord() error {
+	metaKV, err := s.metaKVCreator()
+	if err != nil {
+		return err
+	}
 	fMixcoord := syncutil.NewFuture[types.MixCoordClient]()
 	fMixcoord.Set(s.mixCoordClient)
 
 	s.streamingCoord = streamingcoord.NewServerBuilder().
 		WithETCD(s.etcdCli).
-		WithMetaKV(s.metaKVCreator()).
+		WithMetaKV(metaKV).
 		WithSession(s.session).
 		WithMixCoordClient(fMixcoord).
 		Build()
+	return nil
 }
 
 func (s *mixCoordImpl) initSession() error {
@@ -1213,6 +1225,30 @@ func (s *mixCoordImpl) ListImports(ctx context.Context, req *internalpb.ListImpo
 	return s.datacoordServer.ListImports(ctx, req)
 }
 
+func (s *mixCoordImpl) CreateExportJob(ctx context.Context, req *datapb.CreateExportJobRequest) (*datapb.CreateExportJobResponse, error) {
+	return s.datacoordServer.CreateExportJob(ctx, req)
+}
+
+func (s *mixCoordImpl) GetExportJob(ctx context.Context, req *datapb.GetExportJobRequest) (*datapb.GetExportJobResponse, error) {
+	return s.datacoordServer.GetExportJob(ctx, req)
+}
+
+func (s *mixCoordImpl) ListExportJobs(ctx context.Context, req *datapb.ListExportJobsRequest) (*datapb.ListExportJobsResponse, error) {
+	return s.datacoordServer.ListExportJobs(ctx, req)
+}
+
+func (s *mixCoordImpl) CancelExportJob(ctx context.Context, req *datapb.CancelExportJobRequest) (*commonpb.Status, error) {
+	return s.datacoordServer.CancelExportJob(ctx, req)
+}
+
+func (s *mixCoordImpl) AcquireExportJob(ctx context.Context, req *datapb.AcquireExportJobRequest) (*datapb.AcquireExportJobResponse, error) {
+	return s.datacoordServer.AcquireExportJob(ctx, req)
+}
+
+func (s *mixCoordImpl) ReportExportJob(ctx context.Context, req *datapb.ReportExportJobRequest) (*commonpb.Status, error) {
+	return s.datacoordServer.ReportExportJob(ctx, req)
+}
+
 func (s *mixCoordImpl) CommitImport(ctx context.Context, req *datapb.CommitImportRequest) (*commonpb.Status, error) {
 	return s.datacoordServer.CommitImport(ctx, req)
 }
@@ -45,6 +45,8 @@ set_option_category("Milvus Build Option")
 
 define_option(MILVUS_GPU_VERSION "Build GPU version" OFF)
 
+define_option(MILVUS_WITH_ONNXRUNTIME "Build the local inference of the rerank models with onnxruntime" OFF)
+
 #----------------------------------------------------------------------
 set_option_category("Thirdparty")
 
@@ -8,6 +8,9 @@ import os
 
 class MilvusConan(ConanFile):
     settings = "os", "compiler", "build_type", "arch"
+    options = {
+        "with_onnxruntime": [True, False],
+    }
     requires = (
         "rocksdb/6.29.5@milvus/dev#67b8ae76ad7be5f779082f67416f89bf",
         "onetbb/2021.9.0#f9d7a3aa294ac4a594a93f9b4c7f272d",
@@ -43,6 +46,7 @@ class MilvusConan(ConanFile):
     )
 
     default_options = {
+        "with_onnxruntime": False,
         "openssl/*:shared": True,
         "openssl/*:no_apps": True,
         "libevent/*:shared": True,
@@ -146,6 +150,9 @@ class MilvusConan(ConanFile):
         # Override s2n 1.4.1 (from aws-c-io) to 1.6.0 for OpenSSL 3.x FIPS detection
         if self.settings.os in ["Linux", "FreeBSD"]:
             self.requires("s2n/1.6.0#4fa3b751b92e126a55e45dce723f0384", force=True)
+        # onnxruntime is only needed by the local rerank models, see MILVUS_WITH_ONNXRUNTIME
+        if self.options.with_onnxruntime:
+            self.requires("onnxruntime/1.18.1")
 
     def generate(self):
         deps = CMakeDeps(self)
@@ -436,6 +436,10 @@ inline const char* const KMin = "min";
 inline const char* const KMax = "max";
 inline const char* const KCount = "count";
 inline const char* const KAvg = "avg";
+// the partial states of the approximate aggregates, serialized sketches
+inline const char* const KHyperLogLog = "hyperloglog";
+inline const char* const KTDigest = "tdigest";
+inline const char* const KMoments = "moments";
 
 inline DataType
 GetAggResultType(std::string func_name, DataType input_type) {
@@ -507,6 +511,10 @@ GetAggResultType(std::string func_name, DataType input_type) {
     if (func_name == KCount) {
         return DataType::INT64;
     }
+    if (func_name == KHyperLogLog || func_name == KTDigest ||
+        func_name == KMoments) {
+        return DataType::VARCHAR;
+    }
     ThrowInfo(OpTypeInvalid, "Unsupported func type:{}", func_name);
 }
 
@@ -24,6 +24,7 @@
 #include "exec/operator/query-agg/CountAggregateBase.h"
 #include "exec/operator/query-agg/MaxAggregateBase.h"
 #include "exec/operator/query-agg/MinAggregateBase.h"
+#include "exec/operator/query-agg/SketchAggregateBase.h"
 #include "exec/operator/query-agg/SumAggregateBase.h"
 #include "glog/logging.h"
 #include "log/Log.h"
@@ -84,6 +85,7 @@ FunctionFactory::RegisterAggregateFunction() {
     milvus::exec::registerMinAggregate();
     milvus::exec::registerMaxAggregate();
     milvus::exec::registerSumAggregate();
+    milvus::exec::registerSketchAggregate();
 }
 
 const FilterFunctionPtr
@@ -0,0 +1,101 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+#include <stdint.h>
+#include <memory>
+#include <string>
+#include <vector>
+
+#include "SketchAggregateBase.h"
+#include "common/EasyAssert.h"
+#include "common/Types.h"
+#include "common/Utils.h"
+#include "exec/QueryContext.h"
+#include "exec/operator/query-agg/Aggregate.h"
+#include "log/Log.h"
+
+namespace milvus {
+namespace exec {
+
+template <typename TSketch>
+void
+registerSketch(const std::string& name, bool acceptNonNumeric) {
+    exec::registerAggregateFunction(
+        name,
+        [name, acceptNonNumeric](
+            const std::vector<DataType>& argumentTypes,
+            const QueryConfig& /*config*/) -> std::unique_ptr<Aggregate> {
+            AssertInfo(argumentTypes.size() == 1,
+                       "function:{} only accept one argument",
+                       name);
+            auto inputType = argumentTypes[0];
+            switch (inputType) {
+                case DataType::INT8:
+                    return std::make_unique<SketchAggregate<TSketch, int8_t>>(
+                        DataType::VARCHAR);
+                case DataType::INT16:
+                    return std::make_unique<SketchAggregate<TSketch, int16_t>>(
+                        DataType::VARCHAR);
+                case DataType::INT32:
+                    return std::make_unique<SketchAggregate<TSketch, int32_t>>(
+                        DataType::VARCHAR);
+                case DataType::INT64:
+                    return std::make_unique<SketchAggregate<TSketch, int64_t>>(
+                        DataType::VARCHAR);
+                case DataType::FLOAT:
+                    return std::make_unique<SketchAggregate<TSketch, float>>(
+                        DataType::VARCHAR);
+                case DataType::DOUBLE:
+                    return std::make_unique<SketchAggregate<TSketch, double>>(
+                        DataType::VARCHAR);
+                default:
+                    break;
+            }
+            if (acceptNonNumeric) {
+                switch (inputType) {
+                    case DataType::BOOL:
+                        return std::make_unique<SketchAggregate<TSketch, bool>>(
+                            DataType::VARCHAR);
+                    case DataType::TIMESTAMPTZ:
+                        return std::make_unique<
+                            SketchAggregate<TSketch, int64_t>>(
+                            DataType::VARCHAR);
+                    case DataType::VARCHAR:
+                    case DataType::STRING:
+                        return std::make_unique<
+                            SketchAggregate<TSketch, std::string>>(
+                            DataType::VARCHAR);
+                    default:
+                        break;
+                }
+            }
+            ThrowInfo(UnexpectedError,
+                      "Unknown input type for {} aggregation {}",
+                      name,
+                      GetDataTypeName(inputType));
+        });
+}
+
+void
+registerSketchAggregate() {
+    registerSketch<sketch::HyperLogLog>(milvus::KHyperLogLog, true);
+    registerSketch<sketch::TDigest>(milvus::KTDigest, false);
+    registerSketch<sketch::Moments>(milvus::KMoments, false);
+    LOG_INFO("Registered Sketch Aggregate Functions");
+}
+
+}  // namespace exec
+}  // namespace milvus
@@ -0,0 +1,369 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+#pragma once
+
+#include <stdint.h>
+#include <algorithm>
+#include <array>
+#include <cmath>
+#include <cstring>
+#include <limits>
+#include <string>
+#include <type_traits>
+#include <vector>
+
+#include "common/EasyAssert.h"
+#include "common/Vector.h"
+#include "exec/operator/query-agg/Aggregate.h"
+#include "rescores/Murmur3.h"
+
+namespace milvus {
+namespace exec {
+
+// The sketches below are the partial states of the approximate aggregates
+// (count_distinct, percentile, median, stddev and variance). Each segment
+// outputs its sketch serialized as a base64 encoded string, the states are
+// merged and terminated by the reducers of querynode and proxy, so the
+// serialization must be kept the same as internal/agg/sketch.go.
+
+namespace sketch {
+
+constexpr uint8_t kVersion = 1;
+
+// kHllPrecision uses 2^12 registers
+constexpr int kHllPrecision = 12;
+constexpr int kHllRegisters = 1 << kHllPrecision;
+
+// kTDigestCompression bounds the number of centroids
+constexpr double kTDigestCompression = 100;
+// kTDigestBufferSize is the number of unmerged points buffered before
+// compressing
+constexpr size_t kTDigestBufferSize = 500;
+
+inline void
+AppendUint64(std::string& buf, uint64_t value) {
+    for (int i = 0; i < 8; i++) {
+        buf.push_back(static_cast<char>((value >> (8 * i)) & 0xff));
+    }
+}
+
+inline void
+AppendDouble(std::string& buf, double value) {
+    uint64_t bits;
+    std::memcpy(&bits, &value, sizeof(bits));
+    AppendUint64(buf, bits);
+}
+
+inline std::string
+EncodeBase64(const std::string& data) {
+    static const char* const kAlphabet =
+        "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789+/";
+    std::string out;
+    out.reserve((data.size() + 2) / 3 * 4);
+    size_t i = 0;
+    for (; i + 2 < data.size(); i += 3) {
+        uint32_t n = (static_cast<uint8_t>(data[i]) << 16) |
+                     (static_cast<uint8_t>(data[i + 1]) << 8) |
+                     static_cast<uint8_t>(data[i + 2]);
+        out.push_back(kAlphabet[(n >> 18) & 0x3f]);
+        out.push_back(kAlphabet[(n >> 12) & 0x3f]);
+        out.push_back(kAlphabet[(n >> 6) & 0x3f]);
+        out.push_back(kAlphabet[n & 0x3f]);
+    }
+    if (i < data.size()) {
+        uint32_t n = static_cast<uint8_t>(data[i]) << 16;
+        if (i + 1 < data.size()) {
+            n |= static_cast<uint8_t>(data[i + 1]) << 8;
+        }
+        out.push_back(kAlphabet[(n >> 18) & 0x3f]);
+        out.push_back(kAlphabet[(n >> 12) & 0x3f]);
+        out.push_back(i + 1 < data.size() ? kAlphabet[(n >> 6) & 0x3f] : '=');
+        out.push_back('=');
+    }
+    return out;
+}
+
+// HyperLogLog estimates the number of distinct values.
+class HyperLogLog {
+ public:
+    template <typename T>
+    void
+    Add(const T& value) {
+        if constexpr (std::is_same_v<T, std::string>) {
+            AddBytes(value.data(), value.size());
+        } else if constexpr (std::is_same_v<T, bool>) {
+            uint8_t byte = value ? 1 : 0;
+            AddBytes(&byte, 1);
+        } else if constexpr (std::is_integral_v<T>) {
+            // integers are hashed as little endian int64
+            std::string buf;
+            AppendUint64(buf,
+                         static_cast<uint64_t>(static_cast<int64_t>(value)));
+            AddBytes(buf.data(), buf.size());
+        } else {
+            // floats are hashed as the bits of little endian float64
+            std::string buf;
+            AppendDouble(buf, static_cast<double>(value));
+            AddBytes(buf.data(), buf.size());
+        }
+    }
+
+    std::string
+    Serialize() const {
+        std::string buf;
+        buf.reserve(1 + kHllRegisters);
+        buf.push_back(static_cast<char>(kVersion));
+        buf.append(reinterpret_cast<const char*>(registers_.data()),
+                   registers_.size());
+        return buf;
+    }
+
+ private:
+    void
+    AddBytes(const void* data, size_t len) {
+        uint64_t hash[2];
+        MurmurHash3_x64_128(data, static_cast<int>(len), 0, hash);
+        auto idx = hash[0] >> (64 - kHllPrecision);
+        // the sentinel bit bounds the rank when all remaining bits are zero
+        auto rank = static_cast<uint8_t>(
+            __builtin_clzll(hash[0] << kHllPrecision |
+                            uint64_t(1) << (kHllPrecision - 1)) +
+            1);
+        registers_[idx] = std::max(registers_[idx], rank);
+    }
+
+    std::array<uint8_t, kHllRegisters> registers_{};
+};
+
+// TDigest estimates quantiles with a bounded number of centroids.
+class TDigest {
+ public:
+    template <typename T>
+    void
+    Add(const T& value) {
+        auto v = static_cast<double>(value);
+        if (std::isnan(v)) {
+            return;
+        }
+        buffer_.push_back({v, 1});
+        count_ += 1;
+        min_ = std::min(min_, v);
+        max_ = std::max(max_, v);
+        if (buffer_.size() >= kTDigestBufferSize) {
+            Compress();
+        }
+    }
+
+    std::string
+    Serialize() {
+        Compress();
+        std::string buf;
+        buf.reserve(1 + 8 * 3 + 16 * centroids_.size());
+        buf.push_back(static_cast<char>(kVersion));
+        AppendDouble(buf, count_);
+        AppendDouble(buf, min_);
+        AppendDouble(buf, max_);
+        for (const auto& c : centroids_) {
+            AppendDouble(buf, c.mean);
+            AppendDouble(buf, c.weight);
+        }
+        return buf;
+    }
+
+ private:
+    struct Centroid {
+        double mean;
+        double weight;
+    };
+
+    void
+    Compress() {
+        if (buffer_.empty()) {
+            return;
+        }
+        std::vector<Centroid> all = std::move(centroids_);
+        all.insert(all.end(), buffer_.begin(), buffer_.end());
+        buffer_.clear();
+        std::stable_sort(
+            all.begin(), all.end(), [](const Centroid& a, const Centroid& b) {
+                return a.mean < b.mean;
+            });
+
+        centroids_.clear();
+        auto cur = all[0];
+        double weight_so_far = 0;
+        for (size_t i = 1; i < all.size(); i++) {
+            const auto& c = all[i];
+            auto proposed = cur.weight + c.weight;
+            auto q0 = weight_so_far / count_;
+            auto q2 = (weight_so_far + proposed) / count_;
+            auto limit = 4 * count_ * std::min(q0 * (1 - q0), q2 * (1 - q2)) /
+                         kTDigestCompression;
+            if (proposed <= limit) {
+                cur.mean += (c.mean - cur.mean) * c.weight / proposed;
+                cur.weight = proposed;
+                continue;
+            }
+            weight_so_far += cur.weight;
+            centroids_.push_back(cur);
+            cur = c;
+        }
+        centroids_.push_back(cur);
+    }
+
+    std::vector<Centroid> centroids_;
+    std::vector<Centroid> buffer_;
+    double count_ = 0;
+    double min_ = std::numeric_limits<double>::infinity();
+    double max_ = -std::numeric_limits<double>::infinity();
+};
+
+// Moments keeps count, mean and the sum of squared deviations (Welford).
+class Moments {
+ public:
+    template <typename T>
+    void
+    Add(const T& value) {
+        auto v = static_cast<double>(value);
+        count_++;
+        auto delta = v - mean_;
+        mean_ += delta / static_cast<double>(count_);
+        m2_ += delta * (v - mean_);
+    }
+
+    std::string
+    Serialize() const {
+        std::string buf;
+        buf.reserve(1 + 8 * 3);
+        buf.push_back(static_cast<char>(kVersion));
+        AppendUint64(buf, static_cast<uint64_t>(count_));
+        AppendDouble(buf, mean_);
+        AppendDouble(buf, m2_);
+        return buf;
+    }
+
+ private:
+    int64_t count_ = 0;
+    double mean_ = 0;
+    double m2_ = 0;
+};
+
+}  // namespace sketch
+
+// SketchAggregate builds a sketch per group and outputs it serialized in a
+// VARCHAR column. Like MaxStringAggregate, the group row only keeps a pointer
+// to the owned state, which is released once the value is extracted.
+template <typename TSketch, typename TInput>
+class SketchAggregate final : public Aggregate {
+ public:
+    explicit SketchAggregate(DataType resultType) : Aggregate(resultType) {
+    }
+
+    int32_t
+    accumulatorFixedWidthSize() const override {
+        return sizeof(TSketch*);
+    }
+
+    void
+    extractValues(char** groups,
+                  int32_t numGroups,
+                  VectorPtr* result) override {
+        auto result_column = std::dynamic_pointer_cast<ColumnVector>(*result);
+        AssertInfo(result_column != nullptr,
+                   "input vector for extracting aggregation must be of Type "
+                   "ColumnVector");
+        result_column->resize(numGroups);
+        for (auto i = 0; i < numGroups; i++) {
+            char* group = groups[i];
+            if (isNull(group)) {
+                result_column->nullAt(i);
+            } else {
+                result_column->clearNullAt(i);
+                auto& ptr = *value<TSketch*>(group);
+                AssertInfo(ptr != nullptr,
+                           "sketch aggregate should not have null pointer "
+                           "when group is not null");
+                result_column->SetValueAt<std::string>(
+                    i, sketch::EncodeBase64(ptr->Serialize()));
+                delete ptr;
+                ptr = nullptr;
+            }
+        }
+    }
+
+    void
+    addRawInput(char** groups,
+                int numGroups,
+                const std::vector<VectorPtr>& input) override {
+        AssertInfo(input.size() == 1,
+                   "sketch aggregate expects exactly one input column");
+        auto column = std::dynamic_pointer_cast<ColumnVector>(input[0]);
+        AssertInfo(column != nullptr,
+                   "sketch aggregate input must be of type ColumnVector");
+        auto raw = column->RawAsValues<TInput>();
+        for (auto i = 0; i < column->size(); i++) {
+            if (!column->ValidAt(i)) {
+                continue;
+            }
+            sketchOf(groups[i])->Add(raw[i]);
+        }
+    }
+
+    void
+    addSingleGroupRawInput(char* group,
+                           int64_t numRows,
+                           const std::vector<VectorPtr>& input) override {
+        AssertInfo(input.size() == 1,
+                   "sketch aggregate expects exactly one input column");
+        auto column = std::dynamic_pointer_cast<ColumnVector>(input[0]);
+        AssertInfo(column != nullptr,
+                   "sketch aggregate input must be of type ColumnVector");
+        auto raw = column->RawAsValues<TInput>();
+        for (auto i = 0; i < column->size(); i++) {
+            if (!column->ValidAt(i)) {
+                continue;
+            }
+            sketchOf(group)->Add(raw[i]);
+        }
+    }
+
+    void
+    initializeNewGroupsInternal(
+        char** groups, folly::Range<const vector_size_t*> indices) override {
+        setAllNulls(groups, indices);
+        for (auto i : indices) {
+            *value<TSketch*>(groups[i]) = nullptr;
+        }
+    }
+
+ private:
+    inline TSketch*
+    sketchOf(char* group) {
+        auto& ptr = *value<TSketch*>(group);
+        if (ptr == nullptr) {
+            clearNull(group);
+            ptr = new TSketch();
+        }
+        return ptr;
+    }
+};
+
+void
+registerSketchAggregate();
+
+}  // namespace exec
+}  // namespace milvus
@@ -0,0 +1,221 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+#include "inference/onnx_model_c.h"
+
+#include <onnxruntime_cxx_api.h>
+
+#include <array>
+#include <cstdint>
+#include <cstdlib>
+#include <cstring>
+#include <memory>
+#include <string>
+#include <vector>
+
+#include "common/EasyAssert.h"
+
+namespace {
+
+constexpr const char* kInputIDs = "input_ids";
+constexpr const char* kAttentionMask = "attention_mask";
+constexpr const char* kTokenTypeIDs = "token_type_ids";
+
+Ort::Env&
+GetOrtEnv() {
+    // the environment owns the logging and must outlive all the sessions
+    static Ort::Env env(ORT_LOGGING_LEVEL_WARNING, "milvus");
+    return env;
+}
+
+// OnnxModel runs a transformer model exported to ONNX on CPU, the inputs are
+// the usual tokenizer outputs. Ort::Session::Run is thread safe, so a model
+// is shared by all the concurrent requests.
+class OnnxModel {
+ public:
+    OnnxModel(const char* path, int32_t intra_op_threads) {
+        Ort::SessionOptions options;
+        if (intra_op_threads > 0) {
+            options.SetIntraOpNumThreads(intra_op_threads);
+        }
+        options.SetGraphOptimizationLevel(
+            GraphOptimizationLevel::ORT_ENABLE_ALL);
+        session_ = std::make_unique<Ort::Session>(GetOrtEnv(), path, options);
+
+        Ort::AllocatorWithDefaultOptions allocator;
+        for (size_t i = 0; i < session_->GetInputCount(); ++i) {
+            auto name = session_->GetInputNameAllocated(i, allocator);
+            std::string input(name.get());
+            if (input != kInputIDs && input != kAttentionMask &&
+                input != kTokenTypeIDs) {
+                ThrowInfo(milvus::InvalidParameter,
+                          "onnx: unsupported model input '{}', expected {}, "
+                          "{} or {}",
+                          input,
+                          kInputIDs,
+                          kAttentionMask,
+                          kTokenTypeIDs);
+            }
+            input_names_.push_back(std::move(input));
+        }
+        if (session_->GetOutputCount() == 0) {
+            ThrowInfo(milvus::InvalidParameter, "onnx: model has no output");
+        }
+        auto output = session_->GetOutputNameAllocated(0, allocator);
+        output_name_ = output.get();
+    }
+
+    void
+    Run(const COnnxRunRequest& request, COnnxRunResult* result) const {
+        if (request.batch_size <= 0 || request.seq_len <= 0) {
+            ThrowInfo(milvus::InvalidParameter,
+                      "onnx: invalid input shape [{}, {}]",
+                      request.batch_size,
+                      request.seq_len);
+        }
+        auto memory_info =
+            Ort::MemoryInfo::CreateCpu(OrtArenaAllocator, OrtMemTypeDefault);
+        std::array<int64_t, 2> shape{request.batch_size, request.seq_len};
+        auto count = static_cast<size_t>(request.batch_size * request.seq_len);
+
+        std::vector<int64_t> zero_type_ids;
+        std::vector<Ort::Value> inputs;
+        std::vector<const char*> names;
+        for (const auto& name : input_names_) {
+            const int64_t* data = nullptr;
+            if (name == kInputIDs) {
+                data = request.input_ids;
+            } else if (name == kAttentionMask) {
+                data = request.attention_mask;
+            } else {
+                data = request.token_type_ids;
+                if (data == nullptr) {
+                    zero_type_ids.assign(count, 0);
+                    data = zero_type_ids.data();
+                }
+            }
+            if (data == nullptr) {
+                ThrowInfo(milvus::InvalidParameter,
+                          "onnx: missing model input '{}'",
+                          name);
+            }
+            inputs.push_back(Ort::Value::CreateTensor<int64_t>(
+                memory_info,
+                const_cast<int64_t*>(data),
+                count,
+                shape.data(),
+                shape.size()));
+            names.push_back(name.c_str());
+        }
+
+        const char* output_names[] = {output_name_.c_str()};
+        auto outputs = session_->Run(Ort::RunOptions{nullptr},
+                                     names.data(),
+                                     inputs.data(),
+                                     inputs.size(),
+                                     output_names,
+                                     1);
+        auto info = outputs[0].GetTensorTypeAndShapeInfo();
+        if (info.GetElementType() != ONNX_TENSOR_ELEMENT_DATA_TYPE_FLOAT) {
+            ThrowInfo(milvus::InvalidParameter,
+                      "onnx: output '{}' must be a float tensor",
+                      output_name_);
+        }
+        auto dims = info.GetShape();
+        auto elements = info.GetElementCount();
+
+        result->num_dims = static_cast<int32_t>(dims.size());
+        result->shape =
+            static_cast<int64_t*>(std::malloc(sizeof(int64_t) * dims.size()));
+        result->data =
+            static_cast<float*>(std::malloc(sizeof(float) * elements));
+        if ((result->shape == nullptr && !dims.empty()) ||
+            (result->data == nullptr && elements > 0)) {
+            ThrowInfo(milvus::UnexpectedError,
+                      "onnx: failed to allocate output of {} elements",
+                      elements);
+        }
+        std::memcpy(result->shape, dims.data(), sizeof(int64_t) * dims.size());
+        std::memcpy(result->data,
+                    outputs[0].GetTensorData<float>(),
+                    sizeof(float) * elements);
+    }
+
+ private:
+    std::unique_ptr<Ort::Session> session_;
+    std::vector<std::string> input_names_;
+    std::string output_name_;
+};
+
+}  // namespace
+
+COnnxLoadModelResult
+LoadOnnxModel(const char* path, int32_t intra_op_threads) {
+    COnnxLoadModelResult result{};
+    try {
+        auto model = std::make_unique<OnnxModel>(path, intra_op_threads);
+        result.model = static_cast<COnnxModel>(model.release());
+        result.status = milvus::SuccessCStatus();
+        return result;
+    } catch (std::exception& e) {
+        result.status = milvus::FailureCStatus(&e);
+        result.model = nullptr;
+        return result;
+    }
+}
+
+COnnxRunResult
+RunOnnxModel(COnnxRunRequest request) {
+    COnnxRunResult result{};
+    try {
+        auto model = static_cast<const OnnxModel*>(request.model);
+        if (model == nullptr) {
+            ThrowInfo(milvus::InvalidParameter, "onnx: model is nil");
+        }
+        model->Run(request, &result);
+        result.status = milvus::SuccessCStatus();
+        return result;
+    } catch (std::exception& e) {
+        FreeOnnxRunResult(&result);
+        result.status = milvus::FailureCStatus(&e);
+        return result;
+    }
+}
+
+void
+FreeOnnxRunResult(COnnxRunResult* result) {
+    if (result == nullptr) {
+        return;
+    }
+    std::free(result->data);
+    std::free(result->shape);
+    result->data = nullptr;
+    result->shape = nullptr;
+    result->num_dims = 0;
+}
+
+CStatus
+DeleteOnnxModel(COnnxModel model) {
+    try {
+        if (model == nullptr) {
+            return milvus::SuccessCStatus();
+        }
+        delete static_cast<OnnxModel*>(model);
+        return milvus::SuccessCStatus();
+    } catch (std::exception& e) {
+        return milvus::FailureCStatus(&e);
+    }
+}
@@ -0,0 +1,69 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+#pragma once
+
+#include <stdint.h>
+
+#include "common/type_c.h"
+
+#ifdef __cplusplus
+extern "C" {
+#endif
+
+typedef void* COnnxModel;
+
+typedef struct COnnxLoadModelResult {
+    CStatus status;
+    COnnxModel model;
+} COnnxLoadModelResult;
+
+// COnnxRunRequest holds a batch of tokenized texts in row-major
+// [batch_size, seq_len] layout. token_type_ids may be null, it is only fed to
+// the models which declare the input.
+typedef struct COnnxRunRequest {
+    COnnxModel model;
+    const int64_t* input_ids;
+    const int64_t* attention_mask;
+    const int64_t* token_type_ids;
+    int64_t batch_size;
+    int64_t seq_len;
+} COnnxRunRequest;
+
+// COnnxRunResult holds the first output of the model, data and shape are
+// allocated by the callee and must be released by FreeOnnxRunResult.
+typedef struct COnnxRunResult {
+    CStatus status;
+    float* data;
+    int64_t* shape;
+    int32_t num_dims;
+} COnnxRunResult;
+
+COnnxLoadModelResult
+LoadOnnxModel(const char* path, int32_t intra_op_threads);
+
+COnnxRunResult
+RunOnnxModel(COnnxRunRequest request);
+
+void
+FreeOnnxRunResult(COnnxRunResult* result);
+
+CStatus
+DeleteOnnxModel(COnnxModel model);
+
+#ifdef __cplusplus
+}
+#endif
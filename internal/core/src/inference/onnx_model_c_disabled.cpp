@@ -0,0 +1,50 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// The stubs of the onnx models for the builds without MILVUS_WITH_ONNXRUNTIME,
+// loading a model always fails so nothing else is ever called with a model.
+
+#include "inference/onnx_model_c.h"
+
+#include "common/EasyAssert.h"
+
+COnnxLoadModelResult
+LoadOnnxModel(const char* path, int32_t intra_op_threads) {
+    COnnxLoadModelResult result{};
+    result.status = milvus::FailureCStatus(
+        milvus::NotImplemented,
+        "onnx: milvus is built without onnxruntime, rebuild with "
+        "WITH_ONNXRUNTIME=ON to use the local models");
+    result.model = nullptr;
+    return result;
+}
+
+COnnxRunResult
+RunOnnxModel(COnnxRunRequest request) {
+    COnnxRunResult result{};
+    result.status = milvus::FailureCStatus(
+        milvus::NotImplemented, "onnx: milvus is built without onnxruntime");
+    return result;
+}
+
+void
+FreeOnnxRunResult(COnnxRunResult* result) {
+}
+
+CStatus
+DeleteOnnxModel(COnnxModel model) {
+    return milvus::SuccessCStatus();
+}
@@ -236,6 +236,12 @@ getAggregateOpName(planpb::AggregateOp op) {
             return "min";
         case planpb::max:
             return "max";
+        case planpb::hyperloglog:
+            return "hyperloglog";
+        case planpb::tdigest:
+            return "tdigest";
+        case planpb::moments:
+            return "moments";
         default:
             ThrowInfo(OpTypeInvalid, "Unknown op type for aggregation");
     }
@@ -23,6 +23,7 @@
 #include "test_utils/storage_test_utils.h"
 #include "exec/expression/function/FunctionFactory.h"
 #include "exec/operator/query-agg/CountAggregateBase.h"
+#include "exec/operator/query-agg/SketchAggregateBase.h"
 #include "exec/HashTable.h"
 #include "exec/VectorHasher.h"
 #include "pb/plan.pb.h"
@@ -1220,6 +1221,100 @@ TEST(CountAggregateTest, NullCountMatchesValidAt) {
     }
 }
 
+// ============================================================
+// Direct unit tests for SketchAggregate
+// ============================================================
+
+// The sketch is built per group and extracted as its base64 encoded state,
+// groups without any valid input stay null.
+TEST(SketchAggregateTest, GroupByMomentsState) {
+    milvus::exec::SketchAggregate<milvus::exec::sketch::Moments, int64_t> agg(
+        milvus::DataType::VARCHAR);
+    agg.setOffsets(CountAggTestHelper::kAccOffset,
+                   CountAggTestHelper::kNullByte,
+                   CountAggTestHelper::kNullMask,
+                   CountAggTestHelper::kRowSizeOffset);
+
+    auto g0 = CountAggTestHelper::makeGroupRow();
+    auto g1 = CountAggTestHelper::makeGroupRow();
+    std::vector<char*> groupPtrs = {g0.data(), g1.data()};
+    const std::vector<milvus::vector_size_t> indices = {0, 1};
+    agg.initializeNewGroups(groupPtrs.data(), indices);
+
+    // rows alternate between the groups, the rows of g1 are all null
+    const int numRows = 8;
+    std::vector<char*> groups(numRows);
+    auto col = std::make_shared<milvus::ColumnVector>(milvus::DataType::INT64,
+                                                      numRows);
+    milvus::exec::sketch::Moments expected;
+    for (int i = 0; i < numRows; i++) {
+        groups[i] = groupPtrs[i % 2];
+        col->SetValueAt<int64_t>(i, i);
+        if (i % 2 == 0) {
+            expected.Add(int64_t(i));
+        } else {
+            col->nullAt(i);
+        }
+    }
+    std::vector<milvus::VectorPtr> input = {col};
+    agg.addRawInput(groups.data(), numRows, input);
+
+    milvus::VectorPtr result =
+        std::make_shared<milvus::ColumnVector>(milvus::DataType::VARCHAR, 0);
+    agg.extractValues(groupPtrs.data(), 2, &result);
+    auto column = std::dynamic_pointer_cast<milvus::ColumnVector>(result);
+    ASSERT_EQ(column->size(), 2);
+    ASSERT_TRUE(column->ValidAt(0));
+    EXPECT_EQ(column->RawAsValues<std::string>()[0],
+              milvus::exec::sketch::EncodeBase64(expected.Serialize()));
+    EXPECT_FALSE(column->ValidAt(1));
+}
+
+TEST(SketchAggregateTest, SingleGroupHyperLogLogState) {
+    milvus::exec::SketchAggregate<milvus::exec::sketch::HyperLogLog,
+                                  std::string>
+        agg(milvus::DataType::VARCHAR);
+    agg.setOffsets(CountAggTestHelper::kAccOffset,
+                   CountAggTestHelper::kNullByte,
+                   CountAggTestHelper::kNullMask,
+                   CountAggTestHelper::kRowSizeOffset);
+
+    auto row = CountAggTestHelper::makeGroupRow();
+    char* group = row.data();
+    const std::vector<milvus::vector_size_t> indices = {0};
+    agg.initializeNewGroups(&group, indices);
+
+    const int numRows = 100;
+    auto col = std::make_shared<milvus::ColumnVector>(milvus::DataType::VARCHAR,
+                                                      numRows);
+    milvus::exec::sketch::HyperLogLog expected;
+    for (int i = 0; i < numRows; i++) {
+        auto value = "str_" + std::to_string(i % 10);
+        col->SetValueAt<std::string>(i, value);
+        expected.Add(value);
+    }
+    std::vector<milvus::VectorPtr> input = {col};
+    agg.addSingleGroupRawInput(group, numRows, input);
+
+    milvus::VectorPtr result =
+        std::make_shared<milvus::ColumnVector>(milvus::DataType::VARCHAR, 0);
+    agg.extractValues(&group, 1, &result);
+    auto column = std::dynamic_pointer_cast<milvus::ColumnVector>(result);
+    ASSERT_EQ(column->size(), 1);
+    ASSERT_TRUE(column->ValidAt(0));
+    EXPECT_EQ(column->RawAsValues<std::string>()[0],
+              milvus::exec::sketch::EncodeBase64(expected.Serialize()));
+}
+
+TEST(SketchAggregateTest, EncodeBase64) {
+    using milvus::exec::sketch::EncodeBase64;
+    EXPECT_EQ(EncodeBase64(""), "");
+    EXPECT_EQ(EncodeBase64("f"), "Zg==");
+    EXPECT_EQ(EncodeBase64("fo"), "Zm8=");
+    EXPECT_EQ(EncodeBase64("foo"), "Zm9v");
+    EXPECT_EQ(EncodeBase64("foobar"), "Zm9vYmFy");
+}
+
 // Regression test for #47316: GROUP BY aggregation with empty result set
 // When filter matches zero rows, outputRowCount() must handle null lookup_
 // and setupRetrieveResult must return empty field_data arrays with correct schema.
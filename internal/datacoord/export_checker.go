@@ -0,0 +1,150 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/samber/lo"
+
+	"github.com/milvus-io/milvus/internal/datacoord/broker"
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v3/util/tsoutil"
+)
+
+type ExportChecker interface {
+	Start()
+	Close()
+}
+
+type exportChecker struct {
+	ctx        context.Context
+	broker     broker.Broker
+	exportMeta *exportMeta
+
+	closeOnce sync.Once
+	closeChan chan struct{}
+}
+
+func NewExportChecker(ctx context.Context, broker broker.Broker, exportMeta *exportMeta) ExportChecker {
+	return &exportChecker{
+		ctx:        ctx,
+		broker:     broker,
+		exportMeta: exportMeta,
+		closeChan:  make(chan struct{}),
+	}
+}
+
+func (c *exportChecker) Start() {
+	mlog.Info(c.ctx, "start export checker")
+	ticker := time.NewTicker(Params.DataCoordCfg.ExportScheduleInterval.GetAsDuration(time.Second))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.closeChan:
+			mlog.Info(c.ctx, "export checker exited")
+			return
+		case <-ticker.C:
+			c.check()
+		}
+	}
+}
+
+func (c *exportChecker) Close() {
+	c.closeOnce.Do(func() {
+		close(c.closeChan)
+	})
+}
+
+func (c *exportChecker) check() {
+	jobs := c.exportMeta.GetJobBy(c.ctx)
+	for _, job := range jobs {
+		c.checkGC(job)
+	}
+	unfinished := lo.Filter(jobs, func(job *exportJob, _ int) bool {
+		return !isExportJobFinished(job.GetState())
+	})
+	jobsByColl := lo.GroupBy(unfinished, func(job *exportJob) int64 {
+		return job.GetCollectionID()
+	})
+	for collID, collJobs := range jobsByColl {
+		c.checkCollection(collID, collJobs)
+	}
+	c.LogJobStats(jobs)
+}
+
+func (c *exportChecker) LogJobStats(jobs []*exportJob) {
+	byState := lo.GroupBy(jobs, func(job *exportJob) datapb.ExportJobState {
+		return job.GetState()
+	})
+	stateNum := make(map[string]int)
+	for state, name := range datapb.ExportJobState_name {
+		if datapb.ExportJobState(state) == datapb.ExportJobState_ExportJobNone {
+			continue
+		}
+		stateNum[name] = len(byState[datapb.ExportJobState(state)])
+	}
+	mlog.Debug(c.ctx, "export job stats", mlog.Any("stateNum", stateNum))
+}
+
+// checkCollection fails the unfinished jobs of the dropped collection, the running
+// proxy stops the job once its progress report is rejected.
+func (c *exportChecker) checkCollection(collectionID int64, jobs []*exportJob) {
+	if len(jobs) == 0 {
+		return
+	}
+	ctx, cancel := context.WithTimeout(c.ctx, 10*time.Second)
+	defer cancel()
+	has, err := c.broker.HasCollection(ctx, collectionID)
+	if err != nil {
+		mlog.Warn(c.ctx, "verify existence of collection failed", mlog.Int64("collection", collectionID), mlog.Err(err))
+		return
+	}
+	if has {
+		return
+	}
+	for _, job := range jobs {
+		err = c.exportMeta.UpdateJob(c.ctx, job.GetJobID(), UpdateExportJobState(datapb.ExportJobState_ExportJobFailed),
+			UpdateExportJobReason(fmt.Sprintf("collection %d dropped", collectionID)))
+		if err != nil {
+			mlog.Warn(c.ctx, "failed to update export job state to Failed", mlog.FieldJobID(job.GetJobID()), mlog.Err(err))
+		}
+	}
+}
+
+// checkGC removes the finished job after the retention, the exported files are
+// owned by the user and are never removed by Milvus.
+func (c *exportChecker) checkGC(job *exportJob) {
+	if !isExportJobFinished(job.GetState()) {
+		return
+	}
+	cleanupTime := tsoutil.PhysicalTime(job.GetCleanupTs())
+	if time.Now().Before(cleanupTime) {
+		return
+	}
+	err := c.exportMeta.RemoveJob(c.ctx, job.GetJobID())
+	if err != nil {
+		mlog.Warn(c.ctx, "remove export job failed during GC", mlog.FieldJobID(job.GetJobID()), mlog.Err(err))
+		return
+	}
+	mlog.Info(c.ctx, "export job reached GC retention, job removed",
+		mlog.FieldJobID(job.GetJobID()), mlog.Time("cleanupTime", cleanupTime))
+}
@@ -0,0 +1,75 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	broker2 "github.com/milvus-io/milvus/internal/datacoord/broker"
+	"github.com/milvus-io/milvus/pkg/v3/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v3/util/tsoutil"
+)
+
+func TestExportChecker_CheckCollection(t *testing.T) {
+	ctx := context.Background()
+	m := newTestExportMeta(t,
+		newTestExportJob(1, datapb.ExportJobState_ExportJobPending, "export/a"),
+		newTestExportJob(2, datapb.ExportJobState_ExportJobInProgress, "export/b"))
+	broker := broker2.NewMockBroker(t)
+	checker := NewExportChecker(ctx, broker, m).(*exportChecker)
+
+	broker.EXPECT().HasCollection(mock.Anything, int64(100)).Return(false, errors.New("mock err")).Once()
+	checker.check()
+	assert.Equal(t, 2, m.CountJobBy(ctx, WithExportJobStates(datapb.ExportJobState_ExportJobPending,
+		datapb.ExportJobState_ExportJobInProgress)))
+
+	broker.EXPECT().HasCollection(mock.Anything, int64(100)).Return(true, nil).Once()
+	checker.check()
+	assert.Equal(t, 0, m.CountJobBy(ctx, WithExportJobStates(datapb.ExportJobState_ExportJobFailed)))
+
+	broker.EXPECT().HasCollection(mock.Anything, int64(100)).Return(false, nil).Once()
+	checker.check()
+	jobs := m.GetJobBy(ctx, WithExportJobStates(datapb.ExportJobState_ExportJobFailed))
+	assert.Len(t, jobs, 2)
+	assert.Equal(t, "collection 100 dropped", jobs[0].GetReason())
+
+	// no unfinished job, the collection is not checked
+	checker.check()
+}
+
+func TestExportChecker_GC(t *testing.T) {
+	ctx := context.Background()
+	paramtable.Get().Save(Params.DataCoordCfg.ExportJobRetention.Key, "100")
+	defer paramtable.Get().Reset(Params.DataCoordCfg.ExportJobRetention.Key)
+
+	m := newTestExportMeta(t,
+		newTestExportJob(1, datapb.ExportJobState_ExportJobCanceled, "export/a"),
+		newTestExportJob(2, datapb.ExportJobState_ExportJobCompleted, "export/b"))
+	m.jobs[1].CleanupTs = tsoutil.ComposeTSByTime(time.Now().Add(-time.Second))
+	m.jobs[2].CleanupTs = tsoutil.ComposeTSByTime(time.Now().Add(time.Hour))
+	checker := NewExportChecker(ctx, broker2.NewMockBroker(t), m).(*exportChecker)
+	checker.check()
+	assert.Nil(t, m.GetJob(ctx, 1))
+	assert.NotNil(t, m.GetJob(ctx, 2))
+}
@@ -0,0 +1,137 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/proto/datapb"
+)
+
+// ExportInspector schedules the export jobs. Export jobs are executed by proxies
+// which acquire pending jobs from datacoord and renew the lease by reporting the
+// progress, the inspector reschedules the jobs whose executing proxy is lost.
+type ExportInspector interface {
+	Start()
+	Close()
+	// Acquire assigns the oldest pending job to the proxy, nil is returned if
+	// there is no pending job or the running jobs reach the limit.
+	Acquire(ctx context.Context, nodeID int64) (*datapb.ExportJob, error)
+}
+
+type exportInspector struct {
+	ctx        context.Context
+	exportMeta *exportMeta
+
+	// mu serializes the scheduling decisions of Acquire and inspect.
+	mu sync.Mutex
+
+	closeOnce sync.Once
+	closeChan chan struct{}
+}
+
+func NewExportInspector(ctx context.Context, exportMeta *exportMeta) ExportInspector {
+	return &exportInspector{
+		ctx:        ctx,
+		exportMeta: exportMeta,
+		closeChan:  make(chan struct{}),
+	}
+}
+
+func (s *exportInspector) Start() {
+	mlog.Info(s.ctx, "start export inspector")
+	ticker := time.NewTicker(Params.DataCoordCfg.ExportScheduleInterval.GetAsDuration(time.Second))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.closeChan:
+			mlog.Info(s.ctx, "export inspector exited")
+			return
+		case <-ticker.C:
+			s.inspect()
+		}
+	}
+}
+
+func (s *exportInspector) Close() {
+	s.closeOnce.Do(func() {
+		close(s.closeChan)
+	})
+}
+
+func (s *exportInspector) Acquire(ctx context.Context, nodeID int64) (*datapb.ExportJob, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	running := s.exportMeta.CountJobBy(ctx, WithExportJobStates(datapb.ExportJobState_ExportJobInProgress))
+	if running >= Params.DataCoordCfg.ExportMaxRunningJobs.GetAsInt() {
+		return nil, nil
+	}
+	pending := s.exportMeta.GetJobBy(ctx, WithExportJobStates(datapb.ExportJobState_ExportJobPending))
+	if len(pending) == 0 {
+		return nil, nil
+	}
+	job := pending[0]
+	err := s.exportMeta.UpdateJob(ctx, job.GetJobID(),
+		UpdateExportJobState(datapb.ExportJobState_ExportJobInProgress), UpdateExportJobNodeID(nodeID))
+	if err != nil {
+		return nil, err
+	}
+	mlog.Info(ctx, "export job assigned", mlog.FieldJobID(job.GetJobID()),
+		mlog.Int64("nodeID", nodeID), mlog.Int64("retryTimes", job.GetRetryTimes()))
+	return proto.Clone(s.exportMeta.GetJob(ctx, job.GetJobID()).ExportJob).(*datapb.ExportJob), nil
+}
+
+// inspect reschedules the running jobs whose lease is expired, the job fails once
+// it has been rescheduled for dataCoord.export.maxRetryTimes.
+func (s *exportInspector) inspect() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	lease := Params.DataCoordCfg.ExportLeaseTimeout.GetAsDuration(time.Second)
+	maxRetryTimes := Params.DataCoordCfg.ExportMaxRetryTimes.GetAsInt64()
+	jobs := s.exportMeta.GetJobBy(s.ctx, WithExportJobStates(datapb.ExportJobState_ExportJobInProgress))
+	for _, job := range jobs {
+		if time.Since(job.heartbeat) <= lease {
+			continue
+		}
+		log := mlog.With(mlog.FieldJobID(job.GetJobID()), mlog.Int64("nodeID", job.GetNodeID()),
+			mlog.Int64("retryTimes", job.GetRetryTimes()))
+		var err error
+		if job.GetRetryTimes() >= maxRetryTimes {
+			reason := fmt.Sprintf("the executing proxy %d is lost and the job has been retried for %d times",
+				job.GetNodeID(), job.GetRetryTimes())
+			err = s.exportMeta.UpdateJob(s.ctx, job.GetJobID(),
+				UpdateExportJobState(datapb.ExportJobState_ExportJobFailed), UpdateExportJobReason(reason))
+		} else {
+			err = s.exportMeta.UpdateJob(s.ctx, job.GetJobID(),
+				UpdateExportJobState(datapb.ExportJobState_ExportJobPending),
+				UpdateExportJobNodeID(0),
+				UpdateExportJobProgress(0, nil),
+				UpdateExportJobRetryTimes(job.GetRetryTimes()+1))
+		}
+		if err != nil {
+			log.Warn(s.ctx, "failed to reschedule export job", mlog.Err(err))
+			continue
+		}
+		log.Warn(s.ctx, "export job lease expired", mlog.Duration("lease", lease))
+	}
+}
@@ -0,0 +1,131 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus/internal/metastore/mocks"
+	"github.com/milvus-io/milvus/pkg/v3/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+func newTestExportMeta(t *testing.T, jobs ...*exportJob) *exportMeta {
+	catalog := mocks.NewDataCoordCatalog(t)
+	catalog.EXPECT().ListExportJobs(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().SaveExportJob(mock.Anything, mock.Anything).Return(nil).Maybe()
+	catalog.EXPECT().DropExportJob(mock.Anything, mock.Anything).Return(nil).Maybe()
+	m, err := newExportMeta(context.Background(), catalog)
+	assert.NoError(t, err)
+	for _, job := range jobs {
+		assert.NoError(t, m.AddJob(context.Background(), job))
+	}
+	return m
+}
+
+func TestExportInspector_Acquire(t *testing.T) {
+	ctx := context.Background()
+	paramtable.Get().Save(Params.DataCoordCfg.ExportMaxRunningJobs.Key, "1")
+	defer paramtable.Get().Reset(Params.DataCoordCfg.ExportMaxRunningJobs.Key)
+
+	m := newTestExportMeta(t,
+		newTestExportJob(2, datapb.ExportJobState_ExportJobPending, "export/b"),
+		newTestExportJob(1, datapb.ExportJobState_ExportJobPending, "export/a"))
+	inspector := NewExportInspector(ctx, m)
+
+	job, err := inspector.Acquire(ctx, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), job.GetJobID())
+	assert.Equal(t, int64(10), job.GetNodeID())
+	assert.Equal(t, datapb.ExportJobState_ExportJobInProgress, job.GetState())
+
+	// reach the max running jobs
+	job, err = inspector.Acquire(ctx, 11)
+	assert.NoError(t, err)
+	assert.Nil(t, job)
+
+	assert.NoError(t, m.UpdateJob(ctx, 1, UpdateExportJobState(datapb.ExportJobState_ExportJobCompleted)))
+	job, err = inspector.Acquire(ctx, 11)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), job.GetJobID())
+
+	// no pending job
+	paramtable.Get().Save(Params.DataCoordCfg.ExportMaxRunningJobs.Key, "4")
+	job, err = inspector.Acquire(ctx, 11)
+	assert.NoError(t, err)
+	assert.Nil(t, job)
+}
+
+func TestExportInspector_Lease(t *testing.T) {
+	ctx := context.Background()
+	paramtable.Get().Save(Params.DataCoordCfg.ExportMaxRetryTimes.Key, "1")
+	defer paramtable.Get().Reset(Params.DataCoordCfg.ExportMaxRetryTimes.Key)
+
+	m := newTestExportMeta(t, newTestExportJob(1, datapb.ExportJobState_ExportJobPending, "export/a"))
+	inspector := NewExportInspector(ctx, m).(*exportInspector)
+	job, err := inspector.Acquire(ctx, 10)
+	assert.NoError(t, err)
+	assert.NotNil(t, job)
+
+	// the lease is not expired
+	inspector.inspect()
+	assert.Equal(t, datapb.ExportJobState_ExportJobInProgress, m.GetJob(ctx, 1).GetState())
+
+	expire := func() {
+		m.mu.Lock()
+		m.jobs[1].heartbeat = time.Now().Add(-2 * Params.DataCoordCfg.ExportLeaseTimeout.GetAsDuration(time.Second))
+		m.mu.Unlock()
+	}
+
+	// rescheduled
+	expire()
+	inspector.inspect()
+	got := m.GetJob(ctx, 1)
+	assert.Equal(t, datapb.ExportJobState_ExportJobPending, got.GetState())
+	assert.Equal(t, int64(0), got.GetNodeID())
+	assert.Equal(t, int64(1), got.GetRetryTimes())
+
+	// failed after retrying for max times
+	job, err = inspector.Acquire(ctx, 11)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(11), job.GetNodeID())
+	expire()
+	inspector.inspect()
+	got = m.GetJob(ctx, 1)
+	assert.Equal(t, datapb.ExportJobState_ExportJobFailed, got.GetState())
+	assert.NotEmpty(t, got.GetReason())
+}
+
+func TestExportInspector_StartClose(t *testing.T) {
+	paramtable.Get().Save(Params.DataCoordCfg.ExportScheduleInterval.Key, "0.01")
+	defer paramtable.Get().Reset(Params.DataCoordCfg.ExportScheduleInterval.Key)
+	inspector := NewExportInspector(context.Background(), newTestExportMeta(t))
+	done := make(chan struct{})
+	go func() {
+		inspector.Start()
+		close(done)
+	}()
+	time.Sleep(50 * time.Millisecond)
+	inspector.Close()
+	inspector.Close()
+	<-done
+}
@@ -0,0 +1,124 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus/pkg/v3/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v3/util/tsoutil"
+)
+
+const exportJobReasonCanceledByUser = "canceled by user"
+
+type ExportJobFilter func(job *exportJob) bool
+
+func WithExportDbID(dbID int64) ExportJobFilter {
+	return func(job *exportJob) bool {
+		return job.GetDbID() == dbID
+	}
+}
+
+func WithExportCollectionID(collectionID int64) ExportJobFilter {
+	return func(job *exportJob) bool {
+		return job.GetCollectionID() == collectionID
+	}
+}
+
+func WithExportJobStates(states ...datapb.ExportJobState) ExportJobFilter {
+	return func(job *exportJob) bool {
+		for _, state := range states {
+			if job.GetState() == state {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+type UpdateExportJobAction func(job *exportJob)
+
+// UpdateExportJobState sets the state of job, the finished job is kept for
+// dataCoord.export.jobRetention before it is removed by the export checker.
+func UpdateExportJobState(state datapb.ExportJobState) UpdateExportJobAction {
+	return func(job *exportJob) {
+		job.State = state
+		if isExportJobFinished(state) {
+			now := time.Now()
+			job.CompleteTime = now.Format("2006-01-02T15:04:05Z07:00")
+			dur := Params.DataCoordCfg.ExportJobRetention.GetAsDuration(time.Second)
+			job.CleanupTs = tsoutil.ComposeTSByTime(now.Add(dur))
+		}
+	}
+}
+
+func UpdateExportJobReason(reason string) UpdateExportJobAction {
+	return func(job *exportJob) {
+		job.Reason = reason
+	}
+}
+
+// UpdateExportJobNodeID assigns the job to the proxy, the lease of the job starts from now.
+func UpdateExportJobNodeID(nodeID int64) UpdateExportJobAction {
+	return func(job *exportJob) {
+		job.NodeID = nodeID
+		job.heartbeat = time.Now()
+	}
+}
+
+func UpdateExportJobRetryTimes(retryTimes int64) UpdateExportJobAction {
+	return func(job *exportJob) {
+		job.RetryTimes = retryTimes
+	}
+}
+
+func UpdateExportJobProgress(exportedRows int64, files []string) UpdateExportJobAction {
+	return func(job *exportJob) {
+		job.ExportedRows = exportedRows
+		job.Files = files
+	}
+}
+
+// UpdateExportJobHeartbeat renews the lease of the job, it is kept in memory only.
+func UpdateExportJobHeartbeat() UpdateExportJobAction {
+	return func(job *exportJob) {
+		job.heartbeat = time.Now()
+	}
+}
+
+func isExportJobFinished(state datapb.ExportJobState) bool {
+	return state == datapb.ExportJobState_ExportJobCompleted ||
+		state == datapb.ExportJobState_ExportJobFailed ||
+		state == datapb.ExportJobState_ExportJobCanceled
+}
+
+type exportJob struct {
+	*datapb.ExportJob
+
+	// heartbeat is the last time the executing proxy reported, it is reset to the
+	// load time after datacoord restarts.
+	heartbeat time.Time
+}
+
+func (j *exportJob) Clone() *exportJob {
+	return &exportJob{
+		ExportJob: proto.Clone(j.ExportJob).(*datapb.ExportJob),
+		heartbeat: j.heartbeat,
+	}
+}
@@ -0,0 +1,148 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus/internal/metastore"
+	"github.com/milvus-io/milvus/internal/util/exportutil"
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+)
+
+// exportMeta keeps the export jobs in memory, all the changes are persisted
+// into the catalog before they are applied to memory.
+type exportMeta struct {
+	mu      sync.RWMutex
+	jobs    map[int64]*exportJob
+	catalog metastore.DataCoordCatalog
+}
+
+func newExportMeta(ctx context.Context, catalog metastore.DataCoordCatalog) (*exportMeta, error) {
+	jobs, err := catalog.ListExportJobs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	m := &exportMeta{
+		jobs:    make(map[int64]*exportJob, len(jobs)),
+		catalog: catalog,
+	}
+	for _, job := range jobs {
+		// the running jobs get a whole lease to report to the new datacoord
+		m.jobs[job.GetJobID()] = &exportJob{ExportJob: job, heartbeat: now}
+	}
+	mlog.Info(ctx, "export jobs loaded", mlog.Int("jobCount", len(jobs)))
+	return m, nil
+}
+
+// AddJob adds the job, it is rejected if its path overlaps with an unfinished job
+// in the same bucket so that two jobs never write into the same directory.
+func (m *exportMeta) AddJob(ctx context.Context, job *exportJob) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, other := range m.getJobBy(WithExportJobStates(datapb.ExportJobState_ExportJobPending,
+		datapb.ExportJobState_ExportJobInProgress)) {
+		if other.GetBucket() == job.GetBucket() && exportutil.Overlaps(other.GetPath(), job.GetPath()) {
+			return merr.WrapErrParameterInvalidMsg("export path '%s' overlaps with the path '%s' of the unfinished export job %d",
+				job.GetPath(), other.GetPath(), other.GetJobID())
+		}
+	}
+	if err := m.catalog.SaveExportJob(ctx, job.ExportJob); err != nil {
+		return err
+	}
+	m.jobs[job.GetJobID()] = job
+	return nil
+}
+
+// UpdateJob applies the actions to the job, the finished job is not updated anymore.
+// The catalog is written only if the persisted fields are changed, so renewing the
+// lease of a job costs nothing.
+func (m *exportMeta) UpdateJob(ctx context.Context, jobID int64, actions ...UpdateExportJobAction) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	job, ok := m.jobs[jobID]
+	if !ok || isExportJobFinished(job.GetState()) {
+		return nil
+	}
+	updatedJob := job.Clone()
+	for _, action := range actions {
+		action(updatedJob)
+	}
+	if !proto.Equal(job.ExportJob, updatedJob.ExportJob) {
+		if err := m.catalog.SaveExportJob(ctx, updatedJob.ExportJob); err != nil {
+			return err
+		}
+	}
+	m.jobs[jobID] = updatedJob
+	return nil
+}
+
+func (m *exportMeta) GetJob(ctx context.Context, jobID int64) *exportJob {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.jobs[jobID]
+}
+
+// GetJobBy returns the jobs matching all the filters, ordered by job id.
+func (m *exportMeta) GetJobBy(ctx context.Context, filters ...ExportJobFilter) []*exportJob {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.getJobBy(filters...)
+}
+
+func (m *exportMeta) getJobBy(filters ...ExportJobFilter) []*exportJob {
+	ret := make([]*exportJob, 0)
+OUTER:
+	for _, job := range m.jobs {
+		for _, f := range filters {
+			if !f(job) {
+				continue OUTER
+			}
+		}
+		ret = append(ret, job)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].GetJobID() < ret[j].GetJobID()
+	})
+	return ret
+}
+
+func (m *exportMeta) CountJobBy(ctx context.Context, filters ...ExportJobFilter) int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return len(m.getJobBy(filters...))
+}
+
+func (m *exportMeta) RemoveJob(ctx context.Context, jobID int64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.jobs[jobID]; ok {
+		if err := m.catalog.DropExportJob(ctx, jobID); err != nil {
+			return err
+		}
+		delete(m.jobs, jobID)
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus/internal/metastore/mocks"
+	"github.com/milvus-io/milvus/pkg/v3/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+	"github.com/milvus-io/milvus/pkg/v3/util/tsoutil"
+)
+
+func newTestExportJob(jobID int64, state datapb.ExportJobState, path string) *exportJob {
+	return &exportJob{
+		ExportJob: &datapb.ExportJob{
+			JobID:        jobID,
+			DbID:         1,
+			CollectionID: 100,
+			Format:       "parquet",
+			Path:         path,
+			State:        state,
+		},
+		heartbeat: time.Now(),
+	}
+}
+
+func TestExportMeta_Load(t *testing.T) {
+	ctx := context.Background()
+	catalog := mocks.NewDataCoordCatalog(t)
+	catalog.EXPECT().ListExportJobs(mock.Anything).Return(nil, errors.New("mock err")).Once()
+	_, err := newExportMeta(ctx, catalog)
+	assert.Error(t, err)
+
+	catalog.EXPECT().ListExportJobs(mock.Anything).Return([]*datapb.ExportJob{
+		{JobID: 2, State: datapb.ExportJobState_ExportJobInProgress},
+		{JobID: 1, State: datapb.ExportJobState_ExportJobPending},
+	}, nil)
+	m, err := newExportMeta(ctx, catalog)
+	assert.NoError(t, err)
+	jobs := m.GetJobBy(ctx)
+	assert.Len(t, jobs, 2)
+	assert.Equal(t, int64(1), jobs[0].GetJobID())
+	assert.False(t, jobs[1].heartbeat.IsZero())
+}
+
+func TestExportMeta_Job(t *testing.T) {
+	ctx := context.Background()
+	catalog := mocks.NewDataCoordCatalog(t)
+	catalog.EXPECT().ListExportJobs(mock.Anything).Return(nil, nil)
+	m, err := newExportMeta(ctx, catalog)
+	assert.NoError(t, err)
+
+	catalog.EXPECT().SaveExportJob(mock.Anything, mock.Anything).Return(errors.New("mock err")).Once()
+	assert.Error(t, m.AddJob(ctx, newTestExportJob(1, datapb.ExportJobState_ExportJobPending, "export/a")))
+	assert.Nil(t, m.GetJob(ctx, 1))
+
+	catalog.EXPECT().SaveExportJob(mock.Anything, mock.Anything).Return(nil)
+	assert.NoError(t, m.AddJob(ctx, newTestExportJob(1, datapb.ExportJobState_ExportJobPending, "export/a")))
+	assert.NoError(t, m.AddJob(ctx, newTestExportJob(2, datapb.ExportJobState_ExportJobPending, "export/b")))
+
+	// overlapped with an unfinished job
+	err = m.AddJob(ctx, newTestExportJob(3, datapb.ExportJobState_ExportJobPending, "export/a/c"))
+	assert.True(t, errors.Is(err, merr.ErrParameterInvalid))
+	assert.Equal(t, 2, m.CountJobBy(ctx))
+
+	err = m.UpdateJob(ctx, 1, UpdateExportJobState(datapb.ExportJobState_ExportJobInProgress), UpdateExportJobNodeID(10))
+	assert.NoError(t, err)
+	job := m.GetJob(ctx, 1)
+	assert.Equal(t, datapb.ExportJobState_ExportJobInProgress, job.GetState())
+	assert.Equal(t, int64(10), job.GetNodeID())
+	assert.Equal(t, 1, m.CountJobBy(ctx, WithExportJobStates(datapb.ExportJobState_ExportJobPending)))
+	assert.Equal(t, 2, m.CountJobBy(ctx, WithExportDbID(1), WithExportCollectionID(100)))
+	assert.Equal(t, 0, m.CountJobBy(ctx, WithExportCollectionID(101)))
+
+	// renewing the lease does not write the catalog
+	catalog.ExpectedCalls = nil
+	heartbeat := job.heartbeat
+	time.Sleep(time.Millisecond)
+	assert.NoError(t, m.UpdateJob(ctx, 1, UpdateExportJobHeartbeat()))
+	assert.True(t, m.GetJob(ctx, 1).heartbeat.After(heartbeat))
+
+	catalog.EXPECT().SaveExportJob(mock.Anything, mock.Anything).Return(nil)
+	err = m.UpdateJob(ctx, 1, UpdateExportJobProgress(100, []string{"f1"}),
+		UpdateExportJobState(datapb.ExportJobState_ExportJobCompleted))
+	assert.NoError(t, err)
+	job = m.GetJob(ctx, 1)
+	assert.Equal(t, int64(100), job.GetExportedRows())
+	assert.NotEmpty(t, job.GetCompleteTime())
+	assert.True(t, tsoutil.PhysicalTime(job.GetCleanupTs()).After(time.Now()))
+
+	// the finished job is not updated anymore
+	assert.NoError(t, m.UpdateJob(ctx, 1, UpdateExportJobState(datapb.ExportJobState_ExportJobFailed)))
+	assert.Equal(t, datapb.ExportJobState_ExportJobCompleted, m.GetJob(ctx, 1).GetState())
+
+	// the path of the finished job can be reused
+	assert.NoError(t, m.AddJob(ctx, newTestExportJob(3, datapb.ExportJobState_ExportJobPending, "export/a")))
+
+	catalog.EXPECT().DropExportJob(mock.Anything, int64(1)).Return(errors.New("mock err")).Once()
+	assert.Error(t, m.RemoveJob(ctx, 1))
+	catalog.EXPECT().DropExportJob(mock.Anything, int64(1)).Return(nil)
+	assert.NoError(t, m.RemoveJob(ctx, 1))
+	assert.NoError(t, m.RemoveJob(ctx, 1))
+	assert.Nil(t, m.GetJob(ctx, 1))
+}
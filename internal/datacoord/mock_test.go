@@ -987,6 +987,30 @@ func (s *mockMixCoord) ListImports(ctx context.Context, req *internalpb.ListImpo
 	panic("implement me")
 }
 
+func (s *mockMixCoord) CreateExportJob(ctx context.Context, req *datapb.CreateExportJobRequest) (*datapb.CreateExportJobResponse, error) {
+	panic("implement me")
+}
+
+func (s *mockMixCoord) GetExportJob(ctx context.Context, req *datapb.GetExportJobRequest) (*datapb.GetExportJobResponse, error) {
+	panic("implement me")
+}
+
+func (s *mockMixCoord) ListExportJobs(ctx context.Context, req *datapb.ListExportJobsRequest) (*datapb.ListExportJobsResponse, error) {
+	panic("implement me")
+}
+
+func (s *mockMixCoord) CancelExportJob(ctx context.Context, req *datapb.CancelExportJobRequest) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (s *mockMixCoord) AcquireExportJob(ctx context.Context, req *datapb.AcquireExportJobRequest) (*datapb.AcquireExportJobResponse, error) {
+	panic("implement me")
+}
+
+func (s *mockMixCoord) ReportExportJob(ctx context.Context, req *datapb.ReportExportJobRequest) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
 func (s *mockMixCoord) ListIndexes(ctx context.Context, req *indexpb.ListIndexesRequest) (*indexpb.ListIndexesResponse, error) {
 	panic("implement me")
 }
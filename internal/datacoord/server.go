@@ -41,6 +41,7 @@ import (
 	"github.com/milvus-io/milvus/internal/datacoord/task"
 	datanodeclient "github.com/milvus-io/milvus/internal/distributed/datanode/client"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	sqlkv "github.com/milvus-io/milvus/internal/kv/sql"
 	"github.com/milvus-io/milvus/internal/kv/tikv"
 	"github.com/milvus-io/milvus/internal/metastore/kv/datacoord"
 	"github.com/milvus-io/milvus/internal/storage"
@@ -127,6 +128,10 @@ type Server struct {
 	copySegmentInspector CopySegmentInspector
 	copySegmentChecker   CopySegmentChecker
 
+	exportMeta      *exportMeta
+	exportInspector ExportInspector
+	exportChecker   ExportChecker
+
 	snapshotManager       SnapshotManager
 	snapshotExportManager *snapshotExportManager
 
@@ -320,6 +325,10 @@ func (s *Server) initDataCoord() error {
 	if err != nil {
 		return err
 	}
+	s.exportMeta, err = newExportMeta(s.ctx, s.meta.catalog)
+	if err != nil {
+		return err
+	}
 	s.initCompaction()
 	mlog.Info(s.ctx, "init compaction done")
 
@@ -350,6 +359,9 @@ func (s *Server) initDataCoord() error {
 		isReplicatingCluster: s.isReplicatingClusterNow,
 	})
 
+	s.exportInspector = NewExportInspector(s.ctx, s.exportMeta)
+	s.exportChecker = NewExportChecker(s.ctx, s.broker, s.exportMeta)
+
 	// init file resource observer
 	if s.fileResourceObserver != nil {
 		s.fileResourceObserver.InitDataCoord(s.nodeManager)
@@ -620,6 +632,13 @@ func (s *Server) initKV() error {
 		s.metaRootPath = Params.TiKVCfg.MetaRootPath.GetValue()
 		s.kv = tikv.NewTiKV(s.tikvCli, s.metaRootPath,
 			tikv.WithRequestTimeout(paramtable.Get().TiKVCfg.RequestTimeout.GetAsDuration(time.Millisecond)))
+	case util.MetaStoreTypeSQL:
+		s.metaRootPath = Params.SQLCfg.MetaRootPath.GetValue()
+		metaKV, err := sqlkv.NewMetaKvFactory(s.metaRootPath, &Params.SQLCfg)
+		if err != nil {
+			return err
+		}
+		s.kv = metaKV
 	case util.MetaStoreTypeEtcd:
 		s.metaRootPath = Params.EtcdCfg.MetaRootPath.GetValue()
 		s.kv = etcdkv.NewEtcdKV(s.etcdCli, s.metaRootPath,
@@ -728,6 +747,8 @@ func (s *Server) startServerLoop() {
 	s.globalScheduler.Start()
 	go s.importInspector.Start()
 	go s.importChecker.Start()
+	go s.exportInspector.Start()
+	go s.exportChecker.Start()
 
 	// Start copy segment inspector and checker
 	go s.copySegmentInspector.Start()
@@ -1072,6 +1093,8 @@ func (s *Server) Stop() error {
 	s.globalScheduler.Stop()
 	s.importInspector.Close()
 	s.importChecker.Close()
+	s.exportInspector.Close()
+	s.exportChecker.Close()
 
 	// Stop copy segment components
 	s.copySegmentInspector.Close()
@@ -0,0 +1,210 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/samber/lo"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus/internal/util/exportutil"
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+)
+
+// CreateExportJob validates the export path and persists a pending export job,
+// the job is executed later by a proxy acquiring it through AcquireExportJob.
+func (s *Server) CreateExportJob(ctx context.Context, req *datapb.CreateExportJobRequest) (*datapb.CreateExportJobResponse, error) {
+	log := mlog.With(mlog.FieldCollectionID(req.GetCollectionID()), mlog.String("path", req.GetPath()))
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return &datapb.CreateExportJobResponse{Status: merr.Status(err)}, nil
+	}
+
+	if _, err := exportutil.ParseFormat(req.GetFormat()); err != nil {
+		return &datapb.CreateExportJobResponse{Status: merr.Status(err)}, nil
+	}
+	rootPath := Params.DataCoordCfg.ExportRootPath.GetValue()
+	if err := exportutil.ValidateRootPath(rootPath, getMilvusRootPath(), isMilvusBucket(Params.DataCoordCfg.ExportBucketName.GetValue())); err != nil {
+		log.Warn(ctx, "invalid export root path", mlog.Err(err))
+		return &datapb.CreateExportJobResponse{Status: merr.Status(err)}, nil
+	}
+
+	jobID, err := s.allocator.AllocID(ctx)
+	if err != nil {
+		log.Warn(ctx, "failed to alloc export job id", mlog.Err(err))
+		return &datapb.CreateExportJobResponse{Status: merr.Status(err)}, nil
+	}
+	relPath := req.GetPath()
+	if relPath == "" {
+		relPath = strconv.FormatInt(jobID, 10)
+	}
+	dir, err := exportutil.JoinPath(rootPath, relPath)
+	if err != nil {
+		return &datapb.CreateExportJobResponse{Status: merr.Status(err)}, nil
+	}
+
+	job := &exportJob{
+		ExportJob: &datapb.ExportJob{
+			JobID:          jobID,
+			DbID:           req.GetDbID(),
+			DbName:         req.GetDbName(),
+			CollectionID:   req.GetCollectionID(),
+			CollectionName: req.GetCollectionName(),
+			PartitionNames: req.GetPartitionNames(),
+			Filter:         req.GetFilter(),
+			OutputFields:   req.GetOutputFields(),
+			Format:         req.GetFormat(),
+			Bucket:         Params.DataCoordCfg.ExportBucketName.GetValue(),
+			Path:           dir,
+			State:          datapb.ExportJobState_ExportJobPending,
+			CreateTime:     time.Now().Format("2006-01-02T15:04:05Z07:00"),
+		},
+		heartbeat: time.Now(),
+	}
+	if err = s.exportMeta.AddJob(ctx, job); err != nil {
+		log.Warn(ctx, "failed to add export job", mlog.Err(err))
+		return &datapb.CreateExportJobResponse{Status: merr.Status(err)}, nil
+	}
+	log.Info(ctx, "export job created", mlog.FieldJobID(jobID), mlog.String("dir", dir))
+	return &datapb.CreateExportJobResponse{Status: merr.Success(), JobID: jobID}, nil
+}
+
+func (s *Server) GetExportJob(ctx context.Context, req *datapb.GetExportJobRequest) (*datapb.GetExportJobResponse, error) {
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return &datapb.GetExportJobResponse{Status: merr.Status(err)}, nil
+	}
+	job := s.exportMeta.GetJob(ctx, req.GetJobID())
+	if job == nil {
+		err := merr.WrapErrParameterInvalidMsg("export job %d does not exist", req.GetJobID())
+		return &datapb.GetExportJobResponse{Status: merr.Status(err)}, nil
+	}
+	return &datapb.GetExportJobResponse{Status: merr.Success(), Job: job.ExportJob}, nil
+}
+
+func (s *Server) ListExportJobs(ctx context.Context, req *datapb.ListExportJobsRequest) (*datapb.ListExportJobsResponse, error) {
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return &datapb.ListExportJobsResponse{Status: merr.Status(err)}, nil
+	}
+	filters := make([]ExportJobFilter, 0, 2)
+	if req.GetDbID() != 0 {
+		filters = append(filters, WithExportDbID(req.GetDbID()))
+	}
+	if req.GetCollectionID() != 0 {
+		filters = append(filters, WithExportCollectionID(req.GetCollectionID()))
+	}
+	jobs := s.exportMeta.GetJobBy(ctx, filters...)
+	return &datapb.ListExportJobsResponse{
+		Status: merr.Success(),
+		Jobs: lo.Map(jobs, func(job *exportJob, _ int) *datapb.ExportJob {
+			return job.ExportJob
+		}),
+	}, nil
+}
+
+// CancelExportJob cancels the unfinished export job, the executing proxy stops the
+// job once its progress report is rejected. The exported files are left as is.
+func (s *Server) CancelExportJob(ctx context.Context, req *datapb.CancelExportJobRequest) (*commonpb.Status, error) {
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+	job := s.exportMeta.GetJob(ctx, req.GetJobID())
+	if job == nil {
+		return merr.Status(merr.WrapErrParameterInvalidMsg("export job %d does not exist", req.GetJobID())), nil
+	}
+	if isExportJobFinished(job.GetState()) {
+		return merr.Status(merr.WrapErrParameterInvalidMsg("export job %d is already %s",
+			req.GetJobID(), job.GetState().String())), nil
+	}
+	err := s.exportMeta.UpdateJob(ctx, req.GetJobID(), UpdateExportJobState(datapb.ExportJobState_ExportJobCanceled),
+		UpdateExportJobReason(exportJobReasonCanceledByUser))
+	if err != nil {
+		mlog.Warn(ctx, "failed to cancel export job", mlog.FieldJobID(req.GetJobID()), mlog.Err(err))
+		return merr.Status(err), nil
+	}
+	mlog.Info(ctx, "export job canceled", mlog.FieldJobID(req.GetJobID()))
+	return merr.Success(), nil
+}
+
+func (s *Server) AcquireExportJob(ctx context.Context, req *datapb.AcquireExportJobRequest) (*datapb.AcquireExportJobResponse, error) {
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return &datapb.AcquireExportJobResponse{Status: merr.Status(err)}, nil
+	}
+	job, err := s.exportInspector.Acquire(ctx, req.GetNodeID())
+	if err != nil {
+		return &datapb.AcquireExportJobResponse{Status: merr.Status(err)}, nil
+	}
+	return &datapb.AcquireExportJobResponse{Status: merr.Success(), Job: job}, nil
+}
+
+// ReportExportJob renews the lease of the running job and records its result. The
+// report is rejected if the job is not running on the proxy anymore, e.g. it is
+// canceled or rescheduled to another proxy, and the proxy must stop the job then.
+func (s *Server) ReportExportJob(ctx context.Context, req *datapb.ReportExportJobRequest) (*commonpb.Status, error) {
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+	job := s.exportMeta.GetJob(ctx, req.GetJobID())
+	if job == nil {
+		return merr.Status(merr.WrapErrParameterInvalidMsg("export job %d does not exist", req.GetJobID())), nil
+	}
+	if job.GetState() != datapb.ExportJobState_ExportJobInProgress || job.GetNodeID() != req.GetNodeID() {
+		return merr.Status(merr.WrapErrParameterInvalidMsg("export job %d is %s on node %d, not running on node %d",
+			req.GetJobID(), job.GetState().String(), job.GetNodeID(), req.GetNodeID())), nil
+	}
+
+	var actions []UpdateExportJobAction
+	switch req.GetState() {
+	case datapb.ExportJobState_ExportJobInProgress:
+		actions = append(actions, UpdateExportJobProgress(req.GetExportedRows(), req.GetFiles()), UpdateExportJobHeartbeat())
+	case datapb.ExportJobState_ExportJobCompleted:
+		actions = append(actions, UpdateExportJobProgress(req.GetExportedRows(), req.GetFiles()),
+			UpdateExportJobState(datapb.ExportJobState_ExportJobCompleted))
+	case datapb.ExportJobState_ExportJobFailed:
+		actions = append(actions, UpdateExportJobProgress(req.GetExportedRows(), req.GetFiles()),
+			UpdateExportJobState(datapb.ExportJobState_ExportJobFailed), UpdateExportJobReason(req.GetReason()))
+	default:
+		return merr.Status(merr.WrapErrParameterInvalidMsg("invalid export job state %s", req.GetState().String())), nil
+	}
+	if err := s.exportMeta.UpdateJob(ctx, req.GetJobID(), actions...); err != nil {
+		mlog.Warn(ctx, "failed to update export job", mlog.FieldJobID(req.GetJobID()), mlog.Err(err))
+		return merr.Status(err), nil
+	}
+	if req.GetState() != datapb.ExportJobState_ExportJobInProgress {
+		mlog.Info(ctx, "export job finished", mlog.FieldJobID(req.GetJobID()), mlog.String("state", req.GetState().String()),
+			mlog.Int64("exportedRows", req.GetExportedRows()), mlog.String("reason", req.GetReason()))
+	}
+	return merr.Success(), nil
+}
+
+// getMilvusRootPath returns the root path of Milvus data in the storage.
+func getMilvusRootPath() string {
+	if Params.CommonCfg.StorageType.GetValue() == "local" {
+		return Params.LocalStorageCfg.Path.GetValue()
+	}
+	return Params.MinioCfg.RootPath.GetValue()
+}
+
+// isMilvusBucket returns true if the exported files are written into the bucket of
+// Milvus data, an empty bucket name falls back to the bucket of Milvus.
+func isMilvusBucket(bucket string) bool {
+	return Params.CommonCfg.StorageType.GetValue() == "local" ||
+		bucket == "" || bucket == Params.MinioCfg.BucketName.GetValue()
+}
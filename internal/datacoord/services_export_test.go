@@ -0,0 +1,197 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus/internal/datacoord/allocator"
+	"github.com/milvus-io/milvus/pkg/v3/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+func newTestExportServer(t *testing.T) *Server {
+	s := &Server{}
+	s.stateCode.Store(commonpb.StateCode_Healthy)
+	s.exportMeta = newTestExportMeta(t)
+	s.exportInspector = NewExportInspector(context.Background(), s.exportMeta)
+	alloc := allocator.NewMockAllocator(t)
+	nextID := int64(1000)
+	alloc.EXPECT().AllocID(mock.Anything).RunAndReturn(func(ctx context.Context) (int64, error) {
+		nextID++
+		return nextID, nil
+	}).Maybe()
+	s.allocator = alloc
+	return s
+}
+
+func TestServer_CreateExportJob(t *testing.T) {
+	ctx := context.Background()
+	s := newTestExportServer(t)
+	req := &datapb.CreateExportJobRequest{CollectionID: 100, Format: "parquet", Path: "spark/daily"}
+
+	// export is disabled
+	resp, err := s.CreateExportJob(ctx, req)
+	assert.NoError(t, err)
+	assert.True(t, errors.Is(merr.Error(resp.GetStatus()), merr.ErrOperationNotSupported))
+
+	paramtable.Get().Save(Params.DataCoordCfg.ExportRootPath.Key, Params.MinioCfg.RootPath.GetValue()+"/export")
+	defer paramtable.Get().Reset(Params.DataCoordCfg.ExportRootPath.Key)
+	resp, err = s.CreateExportJob(ctx, req)
+	assert.NoError(t, err)
+	assert.True(t, errors.Is(merr.Error(resp.GetStatus()), merr.ErrParameterInvalid))
+
+	// a dedicated bucket may share the root path with Milvus
+	paramtable.Get().Save(Params.DataCoordCfg.ExportBucketName.Key, "export-bucket")
+	defer paramtable.Get().Reset(Params.DataCoordCfg.ExportBucketName.Key)
+	resp, err = s.CreateExportJob(ctx, req)
+	assert.NoError(t, err)
+	assert.NoError(t, merr.Error(resp.GetStatus()))
+
+	paramtable.Get().Reset(Params.DataCoordCfg.ExportBucketName.Key)
+	paramtable.Get().Save(Params.DataCoordCfg.ExportRootPath.Key, "export")
+	resp, err = s.CreateExportJob(ctx, req)
+	assert.NoError(t, err)
+	assert.NoError(t, merr.Error(resp.GetStatus()))
+	job := s.exportMeta.GetJob(ctx, resp.GetJobID())
+	assert.Equal(t, "export/spark/daily", job.GetPath())
+	assert.Equal(t, datapb.ExportJobState_ExportJobPending, job.GetState())
+
+	// overlapped with the pending job
+	resp, err = s.CreateExportJob(ctx, &datapb.CreateExportJobRequest{CollectionID: 100, Format: "parquet", Path: "spark"})
+	assert.NoError(t, err)
+	assert.True(t, errors.Is(merr.Error(resp.GetStatus()), merr.ErrParameterInvalid))
+
+	// the path defaults to the job id
+	resp, err = s.CreateExportJob(ctx, &datapb.CreateExportJobRequest{CollectionID: 100, Format: "jsonl"})
+	assert.NoError(t, err)
+	assert.NoError(t, merr.Error(resp.GetStatus()))
+	assert.Equal(t, "export/1004", s.exportMeta.GetJob(ctx, resp.GetJobID()).GetPath())
+
+	for _, r := range []*datapb.CreateExportJobRequest{
+		{CollectionID: 100, Format: "csv"},
+		{CollectionID: 100, Format: "parquet", Path: "/files"},
+		{CollectionID: 100, Format: "parquet", Path: "../files"},
+	} {
+		resp, err = s.CreateExportJob(ctx, r)
+		assert.NoError(t, err)
+		assert.Error(t, merr.Error(resp.GetStatus()), r.String())
+	}
+
+	s.stateCode.Store(commonpb.StateCode_Abnormal)
+	resp, err = s.CreateExportJob(ctx, req)
+	assert.NoError(t, err)
+	assert.True(t, errors.Is(merr.Error(resp.GetStatus()), merr.ErrServiceNotReady))
+}
+
+func TestServer_ExportJobLifetime(t *testing.T) {
+	ctx := context.Background()
+	s := newTestExportServer(t)
+	assert.NoError(t, s.exportMeta.AddJob(ctx, newTestExportJob(1, datapb.ExportJobState_ExportJobPending, "export/a")))
+	assert.NoError(t, s.exportMeta.AddJob(ctx, newTestExportJob(2, datapb.ExportJobState_ExportJobPending, "export/b")))
+
+	getResp, err := s.GetExportJob(ctx, &datapb.GetExportJobRequest{JobID: 3})
+	assert.NoError(t, err)
+	assert.True(t, errors.Is(merr.Error(getResp.GetStatus()), merr.ErrParameterInvalid))
+
+	listResp, err := s.ListExportJobs(ctx, &datapb.ListExportJobsRequest{CollectionID: 100})
+	assert.NoError(t, err)
+	assert.Len(t, listResp.GetJobs(), 2)
+	listResp, err = s.ListExportJobs(ctx, &datapb.ListExportJobsRequest{DbID: 2})
+	assert.NoError(t, err)
+	assert.Len(t, listResp.GetJobs(), 0)
+
+	acquireResp, err := s.AcquireExportJob(ctx, &datapb.AcquireExportJobRequest{NodeID: 10})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), acquireResp.GetJob().GetJobID())
+
+	// the job is not running on the node
+	status, err := s.ReportExportJob(ctx, &datapb.ReportExportJobRequest{JobID: 1, NodeID: 11, State: datapb.ExportJobState_ExportJobInProgress})
+	assert.NoError(t, err)
+	assert.Error(t, merr.Error(status))
+	status, err = s.ReportExportJob(ctx, &datapb.ReportExportJobRequest{JobID: 2, NodeID: 10, State: datapb.ExportJobState_ExportJobInProgress})
+	assert.NoError(t, err)
+	assert.Error(t, merr.Error(status))
+	status, err = s.ReportExportJob(ctx, &datapb.ReportExportJobRequest{JobID: 1, NodeID: 10, State: datapb.ExportJobState_ExportJobPending})
+	assert.NoError(t, err)
+	assert.Error(t, merr.Error(status))
+
+	status, err = s.ReportExportJob(ctx, &datapb.ReportExportJobRequest{
+		JobID: 1, NodeID: 10, State: datapb.ExportJobState_ExportJobInProgress, ExportedRows: 10, Files: []string{"f1"},
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, merr.Error(status))
+	getResp, err = s.GetExportJob(ctx, &datapb.GetExportJobRequest{JobID: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10), getResp.GetJob().GetExportedRows())
+
+	status, err = s.ReportExportJob(ctx, &datapb.ReportExportJobRequest{
+		JobID: 1, NodeID: 10, State: datapb.ExportJobState_ExportJobCompleted, ExportedRows: 20, Files: []string{"f1", "f2"},
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, merr.Error(status))
+	getResp, err = s.GetExportJob(ctx, &datapb.GetExportJobRequest{JobID: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, datapb.ExportJobState_ExportJobCompleted, getResp.GetJob().GetState())
+	assert.Equal(t, []string{"f1", "f2"}, getResp.GetJob().GetFiles())
+
+	// cancel
+	status, err = s.CancelExportJob(ctx, &datapb.CancelExportJobRequest{JobID: 1})
+	assert.NoError(t, err)
+	assert.Error(t, merr.Error(status))
+	status, err = s.CancelExportJob(ctx, &datapb.CancelExportJobRequest{JobID: 3})
+	assert.NoError(t, err)
+	assert.Error(t, merr.Error(status))
+	acquireResp, err = s.AcquireExportJob(ctx, &datapb.AcquireExportJobRequest{NodeID: 11})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), acquireResp.GetJob().GetJobID())
+	status, err = s.CancelExportJob(ctx, &datapb.CancelExportJobRequest{JobID: 2})
+	assert.NoError(t, err)
+	assert.NoError(t, merr.Error(status))
+	getResp, err = s.GetExportJob(ctx, &datapb.GetExportJobRequest{JobID: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, datapb.ExportJobState_ExportJobCanceled, getResp.GetJob().GetState())
+	assert.Equal(t, exportJobReasonCanceledByUser, getResp.GetJob().GetReason())
+
+	// the executing proxy stops the canceled job once its report is rejected
+	status, err = s.ReportExportJob(ctx, &datapb.ReportExportJobRequest{JobID: 2, NodeID: 11, State: datapb.ExportJobState_ExportJobInProgress})
+	assert.NoError(t, err)
+	assert.Error(t, merr.Error(status))
+
+	acquireResp, err = s.AcquireExportJob(ctx, &datapb.AcquireExportJobRequest{NodeID: 11})
+	assert.NoError(t, err)
+	assert.Nil(t, acquireResp.GetJob())
+
+	s.stateCode.Store(commonpb.StateCode_Abnormal)
+	getResp, _ = s.GetExportJob(ctx, &datapb.GetExportJobRequest{JobID: 1})
+	assert.Error(t, merr.Error(getResp.GetStatus()))
+	listResp, _ = s.ListExportJobs(ctx, &datapb.ListExportJobsRequest{})
+	assert.Error(t, merr.Error(listResp.GetStatus()))
+	status, _ = s.CancelExportJob(ctx, &datapb.CancelExportJobRequest{JobID: 1})
+	assert.Error(t, merr.Error(status))
+	acquireResp, _ = s.AcquireExportJob(ctx, &datapb.AcquireExportJobRequest{NodeID: 11})
+	assert.Error(t, merr.Error(acquireResp.GetStatus()))
+	status, _ = s.ReportExportJob(ctx, &datapb.ReportExportJobRequest{JobID: 1})
+	assert.Error(t, merr.Error(status))
+}
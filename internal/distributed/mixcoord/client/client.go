@@ -1555,6 +1555,42 @@ func (c *Client) ListImports(ctx context.Context, in *internalpb.ListImportsRequ
 	})
 }
 
+func (c *Client) CreateExportJob(ctx context.Context, req *datapb.CreateExportJobRequest, opts ...grpc.CallOption) (*datapb.CreateExportJobResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client MixCoordClient) (*datapb.CreateExportJobResponse, error) {
+		return client.CreateExportJob(ctx, req)
+	})
+}
+
+func (c *Client) GetExportJob(ctx context.Context, req *datapb.GetExportJobRequest, opts ...grpc.CallOption) (*datapb.GetExportJobResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client MixCoordClient) (*datapb.GetExportJobResponse, error) {
+		return client.GetExportJob(ctx, req)
+	})
+}
+
+func (c *Client) ListExportJobs(ctx context.Context, req *datapb.ListExportJobsRequest, opts ...grpc.CallOption) (*datapb.ListExportJobsResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client MixCoordClient) (*datapb.ListExportJobsResponse, error) {
+		return client.ListExportJobs(ctx, req)
+	})
+}
+
+func (c *Client) CancelExportJob(ctx context.Context, req *datapb.CancelExportJobRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return wrapGrpcCall(ctx, c, func(client MixCoordClient) (*commonpb.Status, error) {
+		return client.CancelExportJob(ctx, req)
+	})
+}
+
+func (c *Client) AcquireExportJob(ctx context.Context, req *datapb.AcquireExportJobRequest, opts ...grpc.CallOption) (*datapb.AcquireExportJobResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client MixCoordClient) (*datapb.AcquireExportJobResponse, error) {
+		return client.AcquireExportJob(ctx, req)
+	})
+}
+
+func (c *Client) ReportExportJob(ctx context.Context, req *datapb.ReportExportJobRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return wrapGrpcCall(ctx, c, func(client MixCoordClient) (*commonpb.Status, error) {
+		return client.ReportExportJob(ctx, req)
+	})
+}
+
 func (c *Client) CommitImport(ctx context.Context, req *datapb.CommitImportRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	return wrapGrpcCall(ctx, c, func(client MixCoordClient) (*commonpb.Status, error) {
 		return client.CommitImport(ctx, req)
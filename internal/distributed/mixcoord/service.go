@@ -33,6 +33,7 @@ import (
 	mixcoord "github.com/milvus-io/milvus/internal/coordinator"
 	mix "github.com/milvus-io/milvus/internal/distributed/mixcoord/client"
 	"github.com/milvus-io/milvus/internal/distributed/utils"
+	sqlkv "github.com/milvus-io/milvus/internal/kv/sql"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/dependency"
 	_ "github.com/milvus-io/milvus/internal/util/grpcclient"
@@ -162,6 +163,15 @@ func (s *Server) init() error {
 		mlog.Info(s.ctx, "Connected to tikv. Using tikv as metadata storage.")
 	}
 
+	if params.MetaStoreCfg.MetaStoreType.GetValue() == util.MetaStoreTypeSQL {
+		mlog.Info(s.ctx, "Connecting to sql metadata storage.")
+		if _, err := sqlkv.GetSQLClient(&paramtable.Get().SQLCfg); err != nil {
+			mlog.Warn(s.ctx, "MixCoord failed to connect to sql database", mlog.Err(err))
+			return err
+		}
+		mlog.Info(s.ctx, "Connected to sql database. Using sql as metadata storage.")
+	}
+
 	if err := s.mixCoord.Init(); err != nil {
 		return err
 	}
@@ -954,6 +964,30 @@ func (s *Server) ListImports(ctx context.Context, in *internalpb.ListImportsRequ
 	return s.mixCoord.ListImports(ctx, in)
 }
 
+func (s *Server) CreateExportJob(ctx context.Context, req *datapb.CreateExportJobRequest) (*datapb.CreateExportJobResponse, error) {
+	return s.mixCoord.CreateExportJob(ctx, req)
+}
+
+func (s *Server) GetExportJob(ctx context.Context, req *datapb.GetExportJobRequest) (*datapb.GetExportJobResponse, error) {
+	return s.mixCoord.GetExportJob(ctx, req)
+}
+
+func (s *Server) ListExportJobs(ctx context.Context, req *datapb.ListExportJobsRequest) (*datapb.ListExportJobsResponse, error) {
+	return s.mixCoord.ListExportJobs(ctx, req)
+}
+
+func (s *Server) CancelExportJob(ctx context.Context, req *datapb.CancelExportJobRequest) (*commonpb.Status, error) {
+	return s.mixCoord.CancelExportJob(ctx, req)
+}
+
+func (s *Server) AcquireExportJob(ctx context.Context, req *datapb.AcquireExportJobRequest) (*datapb.AcquireExportJobResponse, error) {
+	return s.mixCoord.AcquireExportJob(ctx, req)
+}
+
+func (s *Server) ReportExportJob(ctx context.Context, req *datapb.ReportExportJobRequest) (*commonpb.Status, error) {
+	return s.mixCoord.ReportExportJob(ctx, req)
+}
+
 func (s *Server) CommitImport(ctx context.Context, req *datapb.CommitImportRequest) (*commonpb.Status, error) {
 	return s.mixCoord.CommitImport(ctx, req)
 }
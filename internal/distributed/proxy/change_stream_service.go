@@ -0,0 +1,84 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpcproxy
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/milvuspb"
+	"github.com/milvus-io/milvus/client/v3/proto/changestreampb"
+	"github.com/milvus-io/milvus/internal/proxy"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+)
+
+// changeStreamAuthorizer authenticates the subscriber and checks its privilege on the collection,
+// the stream interceptors are not applied on the external grpc server.
+type changeStreamAuthorizer func(ctx context.Context, dbName, collectionName string) (context.Context, error)
+
+// newChangeStreamAuthorizer returns the authorizer requiring the query privilege of the collection.
+func newChangeStreamAuthorizer(getMetaCache func() proxy.Cache) changeStreamAuthorizer {
+	authenticate := proxy.AuthenticationInterceptorWithMetaCache(getMetaCache)
+	authorize := proxy.PrivilegeInterceptorWithMetaCache(getMetaCache)
+	return func(ctx context.Context, dbName, collectionName string) (context.Context, error) {
+		ctx, err := authenticate(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return authorize(ctx, &milvuspb.QueryRequest{DbName: dbName, CollectionName: collectionName})
+	}
+}
+
+// changeStreamService serves the change streams with proxy.
+type changeStreamService struct {
+	proxy     types.ProxyComponent
+	authorize changeStreamAuthorizer
+}
+
+func registerChangeStreamService(s *grpc.Server, proxyComponent types.ProxyComponent, authorize changeStreamAuthorizer) {
+	changestreampb.RegisterChangeStreamServiceServer(s, &changeStreamService{proxy: proxyComponent, authorize: authorize})
+}
+
+// Subscribe streams the change events of the collection, a failure is sent as the last event
+// with the status before the stream is closed.
+func (s *changeStreamService) Subscribe(req *changestreampb.SubscribeRequest, stream changestreampb.ChangeStreamService_SubscribeServer) error {
+	provider, ok := s.proxy.(interface {
+		SubscribeChanges(ctx context.Context, req *changestreampb.SubscribeRequest, send func(*changestreampb.ChangeEvent) error) error
+	})
+	if !ok {
+		return stream.Send(&changestreampb.ChangeEvent{Status: merr.Status(merr.WrapErrServiceNotReadyMsg("change stream is not supported"))})
+	}
+
+	ctx := stream.Context()
+	if s.authorize != nil {
+		var err error
+		if ctx, err = s.authorize(ctx, req.GetDbName(), req.GetCollectionName()); err != nil {
+			return stream.Send(&changestreampb.ChangeEvent{Status: merr.Status(err)})
+		}
+	}
+
+	err := provider.SubscribeChanges(ctx, req, func(event *changestreampb.ChangeEvent) error {
+		event.Status = merr.Success()
+		return stream.Send(event)
+	})
+	if err != nil && ctx.Err() == nil {
+		return stream.Send(&changestreampb.ChangeEvent{Status: merr.Status(err)})
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpcproxy
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/milvuspb"
+	"github.com/milvus-io/milvus/client/v3/proto/explainpb"
+	"github.com/milvus-io/milvus/internal/proxy"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+)
+
+// explainAuthorizer authenticates the caller, checks its privilege and applies the rate limits
+// of the explained search or query. The interceptors of the external grpc server only know the
+// milvuspb requests, so they skip the requests of the explain service.
+type explainAuthorizer func(ctx context.Context, req proto.Message) (context.Context, error)
+
+// newExplainAuthorizer returns the authorizer treating the explained request as a normal one.
+func newExplainAuthorizer(getMetaCache func() proxy.Cache, limiter types.Limiter) explainAuthorizer {
+	authenticate := proxy.AuthenticationInterceptorWithMetaCache(getMetaCache)
+	authorize := proxy.PrivilegeInterceptorWithMetaCache(getMetaCache)
+	return func(ctx context.Context, req proto.Message) (context.Context, error) {
+		ctx, err := authenticate(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if ctx, err = authorize(ctx, req); err != nil {
+			return nil, err
+		}
+		if limiter == nil {
+			return ctx, nil
+		}
+		dbID, collectionIDToPartIDs, rt, n, err := proxy.GetRequestInfo(ctx, getMetaCache(), req)
+		if err != nil {
+			return nil, err
+		}
+		if err := proxy.CheckRateLimit(ctx, limiter, dbID, collectionIDToPartIDs, rt, n); err != nil {
+			return nil, err
+		}
+		return ctx, nil
+	}
+}
+
+// explainProvider is implemented by the proxy to explain the searches and queries.
+type explainProvider interface {
+	ExplainSearch(ctx context.Context, req *milvuspb.SearchRequest) (*explainpb.ExplainResults, error)
+	ExplainQuery(ctx context.Context, req *milvuspb.QueryRequest) (*explainpb.ExplainResults, error)
+}
+
+// explainService serves the explain requests with proxy.
+type explainService struct {
+	proxy     types.ProxyComponent
+	authorize explainAuthorizer
+}
+
+func registerExplainService(s *grpc.Server, proxyComponent types.ProxyComponent, authorize explainAuthorizer) {
+	explainpb.RegisterExplainServiceServer(s, &explainService{proxy: proxyComponent, authorize: authorize})
+}
+
+func (s *explainService) provider() (explainProvider, error) {
+	provider, ok := s.proxy.(explainProvider)
+	if !ok {
+		return nil, merr.WrapErrServiceNotReadyMsg("explain is not supported")
+	}
+	return provider, nil
+}
+
+func (s *explainService) ExplainSearch(ctx context.Context, req *explainpb.ExplainSearchRequest) (*explainpb.ExplainResults, error) {
+	provider, err := s.provider()
+	if err != nil {
+		return &explainpb.ExplainResults{Status: merr.Status(err)}, nil
+	}
+	request := req.GetRequest()
+	if request == nil {
+		return &explainpb.ExplainResults{Status: merr.Status(merr.WrapErrParameterMissingMsg("search request is required"))}, nil
+	}
+	if request.GetDbName() == "" {
+		request.DbName = proxy.GetCurDBNameFromContextOrDefault(ctx)
+	}
+	if s.authorize != nil {
+		if ctx, err = s.authorize(ctx, request); err != nil {
+			return &explainpb.ExplainResults{Status: merr.Status(err)}, nil
+		}
+	}
+	return provider.ExplainSearch(ctx, request)
+}
+
+func (s *explainService) ExplainQuery(ctx context.Context, req *explainpb.ExplainQueryRequest) (*explainpb.ExplainResults, error) {
+	provider, err := s.provider()
+	if err != nil {
+		return &explainpb.ExplainResults{Status: merr.Status(err)}, nil
+	}
+	request := req.GetRequest()
+	if request == nil {
+		return &explainpb.ExplainResults{Status: merr.Status(merr.WrapErrParameterMissingMsg("query request is required"))}, nil
+	}
+	if request.GetDbName() == "" {
+		request.DbName = proxy.GetCurDBNameFromContextOrDefault(ctx)
+	}
+	if s.authorize != nil {
+		if ctx, err = s.authorize(ctx, request); err != nil {
+			return &explainpb.ExplainResults{Status: merr.Status(err)}, nil
+		}
+	}
+	return provider.ExplainQuery(ctx, request)
+}
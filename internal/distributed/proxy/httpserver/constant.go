@@ -34,6 +34,8 @@ const (
 	IndexCategory                 = "/indexes/"
 	AliasCategory                 = "/aliases/"
 	ImportJobCategory             = "/jobs/import/"
+	ExportJobCategory             = "/jobs/export/"
+	TransactionCategory           = "/transactions/"
 	SnapshotJobCategory           = "/jobs/snapshot/"
 	SnapshotCategory              = "/snapshots/"
 	ExternalCollectionJobCategory = "/jobs/external_collection/"
@@ -101,8 +103,10 @@ const (
 
 	RunAnalyzerAction = "run_analyzer"
 
-	CommitAction = "commit"
-	AbortAction  = "abort"
+	CommitAction   = "commit"
+	AbortAction    = "abort"
+	BeginAction    = "begin"
+	RollbackAction = "rollback"
 )
 
 const (
@@ -149,6 +153,7 @@ const (
 	HTTPHeaderDBName         = "DB-Name"
 	HTTPHeaderRequestTimeout = "Request-Timeout"
 	HTTPHeaderMilvusTraceID  = "X-Milvus-Trace-Id"
+	HTTPHeaderTransactionID  = "Transaction-Id"
 	HTTPReturnCode           = "code"
 	HTTPReturnMessage        = "message"
 	HTTPReturnData           = "data"
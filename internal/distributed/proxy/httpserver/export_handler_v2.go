@@ -0,0 +1,149 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/milvuspb"
+	"github.com/milvus-io/milvus/internal/proxy"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+)
+
+// getProxyExportManager resolves the export manager of the local proxy, export jobs
+// are submitted to datacoord through it.
+func getProxyExportManager(proxyComponent types.ProxyComponent) func() *proxy.ExportManager {
+	if provider, ok := proxyComponent.(interface{ GetExportManager() *proxy.ExportManager }); ok {
+		return func() *proxy.ExportManager { return provider.GetExportManager() }
+	}
+	return func() *proxy.ExportManager { return nil }
+}
+
+func (h *HandlersV2) abortExportRequest(ctx context.Context, c *gin.Context, err error) (interface{}, error) {
+	c.Set("error_type", merr.GetErrorType(err).String())
+	mlog.Warn(ctx, "high level restful api, export request failed", mlog.Err(err))
+	HTTPAbortReturn(c, http.StatusOK, gin.H{HTTPReturnCode: merr.Code(err), HTTPReturnMessage: err.Error()})
+	return nil, err
+}
+
+// checkExportPrivilege requires the query privilege of the exported collection.
+func (h *HandlersV2) checkExportPrivilege(ctx context.Context, c *gin.Context, dbName string, collectionName string) error {
+	if !h.checkAuth {
+		return nil
+	}
+	return h.checkAuthorizationHelper(ctx, c, &milvuspb.QueryRequest{
+		DbName:         dbName,
+		CollectionName: collectionName,
+	})
+}
+
+func (h *HandlersV2) createExportJob(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	httpReq := anyReq.(*ExportReq)
+	mgr := h.exportManager()
+	if mgr == nil {
+		return h.abortExportRequest(ctx, c, merr.WrapErrServiceNotReadyMsg("export manager is not initialized"))
+	}
+	if err := h.checkExportPrivilege(ctx, c, dbName, httpReq.CollectionName); err != nil {
+		return h.abortExportRequest(ctx, c, err)
+	}
+
+	jobID, err := mgr.Create(ctx, &proxy.ExportRequest{
+		DbName:         dbName,
+		CollectionName: httpReq.CollectionName,
+		PartitionNames: httpReq.PartitionNames,
+		Filter:         httpReq.Filter,
+		OutputFields:   httpReq.OutputFields,
+		Format:         httpReq.Format,
+		Path:           httpReq.Path,
+	})
+	if err != nil {
+		return h.abortExportRequest(ctx, c, err)
+	}
+	HTTPReturn(c, http.StatusOK, gin.H{HTTPReturnCode: merr.Code(nil), HTTPReturnData: gin.H{"jobId": jobID}})
+	return nil, nil
+}
+
+// getExportJob gets the job of the database and checks the privilege of its collection.
+func (h *HandlersV2) getExportJob(ctx context.Context, c *gin.Context, mgr *proxy.ExportManager, jobID string, dbName string) (*proxy.ExportJob, error) {
+	job, err := mgr.Get(ctx, jobID)
+	if err != nil {
+		return nil, err
+	}
+	if job.DbName != dbName {
+		return nil, merr.WrapErrParameterInvalidMsg("export job %s not found", jobID)
+	}
+	if err := h.checkExportPrivilege(ctx, c, dbName, job.CollectionName); err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+func (h *HandlersV2) describeExportJob(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	jobID := anyReq.(JobIDGetter).GetJobID()
+	mgr := h.exportManager()
+	if mgr == nil {
+		return h.abortExportRequest(ctx, c, merr.WrapErrServiceNotReadyMsg("export manager is not initialized"))
+	}
+	job, err := h.getExportJob(ctx, c, mgr, jobID, dbName)
+	if err != nil {
+		return h.abortExportRequest(ctx, c, err)
+	}
+	HTTPReturn(c, http.StatusOK, gin.H{HTTPReturnCode: merr.Code(nil), HTTPReturnData: job})
+	return nil, nil
+}
+
+func (h *HandlersV2) abortExportJob(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	jobID := anyReq.(JobIDGetter).GetJobID()
+	mgr := h.exportManager()
+	if mgr == nil {
+		return h.abortExportRequest(ctx, c, merr.WrapErrServiceNotReadyMsg("export manager is not initialized"))
+	}
+	if _, err := h.getExportJob(ctx, c, mgr, jobID, dbName); err != nil {
+		return h.abortExportRequest(ctx, c, err)
+	}
+	if err := mgr.Cancel(ctx, jobID); err != nil {
+		return h.abortExportRequest(ctx, c, err)
+	}
+	HTTPReturn(c, http.StatusOK, gin.H{HTTPReturnCode: merr.Code(nil), HTTPReturnData: gin.H{}})
+	return nil, nil
+}
+
+func (h *HandlersV2) listExportJob(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	httpReq := anyReq.(*OptionalCollectionNameReq)
+	mgr := h.exportManager()
+	if mgr == nil {
+		return h.abortExportRequest(ctx, c, merr.WrapErrServiceNotReadyMsg("export manager is not initialized"))
+	}
+	jobs, err := mgr.List(ctx, dbName, httpReq.GetCollectionName())
+	if err != nil {
+		return h.abortExportRequest(ctx, c, err)
+	}
+	records := make([]*proxy.ExportJob, 0, len(jobs))
+	for _, job := range jobs {
+		if err := h.checkExportPrivilege(ctx, c, dbName, job.CollectionName); err != nil {
+			continue
+		}
+		records = append(records, job)
+	}
+	HTTPReturn(c, http.StatusOK, gin.H{HTTPReturnCode: merr.Code(nil), HTTPReturnData: gin.H{"records": records}})
+	return nil, nil
+}
@@ -51,6 +51,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/v3/proto/datapb"
 	"github.com/milvus-io/milvus/pkg/v3/proto/internalpb"
 	"github.com/milvus-io/milvus/pkg/v3/util/commonpbutil"
+	"github.com/milvus-io/milvus/pkg/v3/util/contextutil"
 	"github.com/milvus-io/milvus/pkg/v3/util/crypto"
 	"github.com/milvus-io/milvus/pkg/v3/util/externalspec"
 	"github.com/milvus-io/milvus/pkg/v3/util/funcutil"
@@ -62,16 +63,20 @@ import (
 )
 
 type HandlersV2 struct {
-	proxy     types.ProxyComponent
-	metaCache func() proxy.Cache
-	checkAuth bool
+	proxy         types.ProxyComponent
+	metaCache     func() proxy.Cache
+	exportManager func() *proxy.ExportManager
+	txnManager    func() *proxy.TxnManager
+	checkAuth     bool
 }
 
 func NewHandlersV2(proxyClient types.ProxyComponent) *HandlersV2 {
 	return &HandlersV2{
-		proxy:     proxyClient,
-		metaCache: getProxyMetaCache(proxyClient),
-		checkAuth: proxy.Params.CommonCfg.AuthorizationEnabled.GetAsBool(),
+		proxy:         proxyClient,
+		metaCache:     getProxyMetaCache(proxyClient),
+		exportManager: getProxyExportManager(proxyClient),
+		txnManager:    getProxyTxnManager(proxyClient),
+		checkAuth:     proxy.Params.CommonCfg.AuthorizationEnabled.GetAsBool(),
 	}
 }
 
@@ -346,6 +351,14 @@ func (h *HandlersV2) RegisterRoutesToV2(router gin.IRouter) {
 	router.POST(ImportJobCategory+DescribeAction, timeoutMiddleware(wrapperPost(func() any { return &JobIDReq{} }, wrapperTraceLog(h.getImportJobProcess))))
 	router.POST(ImportJobCategory+CommitAction, timeoutMiddleware(wrapperPost(func() any { return &JobIDReq{} }, wrapperTraceLog(h.commitImportJob))))
 	router.POST(ImportJobCategory+AbortAction, timeoutMiddleware(wrapperPost(func() any { return &JobIDReq{} }, wrapperTraceLog(h.abortImportJob))))
+	router.POST(ExportJobCategory+ListAction, timeoutMiddleware(wrapperPost(func() any { return &OptionalCollectionNameReq{} }, wrapperTraceLog(h.listExportJob))))
+	router.POST(ExportJobCategory+CreateAction, timeoutMiddleware(wrapperPost(func() any { return &ExportReq{} }, wrapperTraceLog(h.createExportJob))))
+	router.POST(ExportJobCategory+DescribeAction, timeoutMiddleware(wrapperPost(func() any { return &JobIDReq{} }, wrapperTraceLog(h.describeExportJob))))
+	router.POST(ExportJobCategory+AbortAction, timeoutMiddleware(wrapperPost(func() any { return &JobIDReq{} }, wrapperTraceLog(h.abortExportJob))))
+
+	router.POST(TransactionCategory+BeginAction, timeoutMiddleware(wrapperPost(func() any { return &BeginTransactionReq{} }, wrapperTraceLog(h.beginTransaction))))
+	router.POST(TransactionCategory+CommitAction, timeoutMiddleware(wrapperPost(func() any { return &TransactionIDReq{} }, wrapperTraceLog(h.commitTransaction))))
+	router.POST(TransactionCategory+RollbackAction, timeoutMiddleware(wrapperPost(func() any { return &TransactionIDReq{} }, wrapperTraceLog(h.rollbackTransaction))))
 	router.POST(SnapshotJobCategory+RestoreExternalAction, timeoutMiddleware(wrapperPost(func() any { return &RestoreExternalSnapshotReq{} }, wrapperTraceLog(h.restoreExternalSnapshot))))
 	router.POST(SnapshotJobCategory+ExportAction, timeoutMiddleware(wrapperPost(func() any { return &ExportSnapshotReq{} }, wrapperTraceLog(h.exportSnapshot))))
 	router.POST(SnapshotJobCategory+DescribeExportAction, timeoutMiddleware(wrapperPost(func() any { return &JobIDReq{} }, wrapperTraceLog(h.getExportSnapshotState))))
@@ -431,6 +444,9 @@ func wrapperPost(newReq newReqFunc, v2 handlerFuncV2) gin.HandlerFunc {
 		ctx := gCtx.Request.Context()
 		username, _ := gCtx.Get(ContextUsername)
 		ctx = proxy.NewContextWithMetadata(ctx, username.(string), dbName)
+		if txnID := gCtx.Request.Header.Get(HTTPHeaderTransactionID); txnID != "" {
+			ctx = contextutil.AppendToIncomingContext(ctx, proxy.TransactionIDHeader, txnID)
+		}
 		mlog.Debug(ctx, "high level restful api, read parameters from request body, then start to handle.",
 			mlog.Any("url", gCtx.Request.URL.Path))
 
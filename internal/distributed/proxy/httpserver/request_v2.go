@@ -373,12 +373,43 @@ func (req *ImportReq) GetOptions() map[string]string {
 	return req.Options
 }
 
+type ExportReq struct {
+	DbName         string   `json:"dbName"`
+	CollectionName string   `json:"collectionName" binding:"required"`
+	PartitionNames []string `json:"partitionNames"`
+	Filter         string   `json:"filter"`
+	OutputFields   []string `json:"outputFields"`
+	Format         string   `json:"format"`
+	Path           string   `json:"path"`
+}
+
+func (req *ExportReq) GetDbName() string {
+	return req.DbName
+}
+
+func (req *ExportReq) GetCollectionName() string {
+	return req.CollectionName
+}
+
 type JobIDReq struct {
 	JobID string `json:"jobId" binding:"required"`
 }
 
 func (req *JobIDReq) GetJobID() string { return req.JobID }
 
+type BeginTransactionReq struct {
+	DbName         string `json:"dbName"`
+	CollectionName string `json:"collectionName" binding:"required"`
+	KeepaliveMs    int64  `json:"keepaliveMs" binding:"gte=0"`
+}
+
+func (req *BeginTransactionReq) GetDbName() string         { return req.DbName }
+func (req *BeginTransactionReq) GetCollectionName() string { return req.CollectionName }
+
+type TransactionIDReq struct {
+	TransactionID string `json:"transactionId" binding:"required"`
+}
+
 type CreateSnapshotReq struct {
 	DbName                      string `json:"dbName"`
 	CollectionName              string `json:"collectionName" binding:"required"`
@@ -0,0 +1,144 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/milvuspb"
+	"github.com/milvus-io/milvus/internal/proxy"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+)
+
+// getProxyTxnManager resolves the transaction manager of the local proxy, the writes of
+// a transaction must be sent to the proxy which begins it, with the Transaction-Id header.
+func getProxyTxnManager(proxyComponent types.ProxyComponent) func() *proxy.TxnManager {
+	if provider, ok := proxyComponent.(interface{ GetTxnManager() *proxy.TxnManager }); ok {
+		return func() *proxy.TxnManager { return provider.GetTxnManager() }
+	}
+	return func() *proxy.TxnManager { return nil }
+}
+
+func (h *HandlersV2) abortTransactionRequest(ctx context.Context, c *gin.Context, err error) (interface{}, error) {
+	c.Set("error_type", merr.GetErrorType(err).String())
+	mlog.Warn(ctx, "high level restful api, transaction request failed", mlog.Err(err))
+	HTTPAbortReturn(c, http.StatusOK, gin.H{HTTPReturnCode: merr.Code(err), HTTPReturnMessage: err.Error()})
+	return nil, err
+}
+
+func (h *HandlersV2) getTxnManager() (*proxy.TxnManager, error) {
+	mgr := h.txnManager()
+	if mgr == nil {
+		return nil, merr.WrapErrServiceNotReadyMsg("transaction manager is not initialized")
+	}
+	return mgr, nil
+}
+
+// checkTransactionPrivilege requires the insert and delete privileges of the collection of a transaction,
+// begin and commit are also limited as small inserts, so they are denied once the writes are denied.
+func (h *HandlersV2) checkTransactionPrivilege(ctx context.Context, c *gin.Context, dbName string, collectionName string, checkLimit bool) (context.Context, error) {
+	insertReq := &milvuspb.InsertRequest{DbName: dbName, CollectionName: collectionName}
+	if h.checkAuth {
+		for _, req := range []any{insertReq, &milvuspb.DeleteRequest{DbName: dbName, CollectionName: collectionName}} {
+			if err := h.checkAuthorizationHelper(ctx, c, req); err != nil {
+				return nil, err
+			}
+			ctx = c.Request.Context()
+		}
+	}
+	if checkLimit {
+		if _, err := CheckLimiter(ctx, insertReq, h.proxy); err != nil {
+			return nil, err
+		}
+	}
+	return ctx, nil
+}
+
+// checkTxnPrivilege checks the privileges on the collection of the transaction owned by the caller.
+func (h *HandlersV2) checkTxnPrivilege(ctx context.Context, c *gin.Context, mgr *proxy.TxnManager, txnID string, checkLimit bool) (context.Context, error) {
+	info, err := mgr.Describe(ctx, txnID)
+	if err != nil {
+		return nil, err
+	}
+	return h.checkTransactionPrivilege(ctx, c, info.DbName, info.CollectionName, checkLimit)
+}
+
+func (h *HandlersV2) beginTransaction(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	httpReq := anyReq.(*BeginTransactionReq)
+	mgr, err := h.getTxnManager()
+	if err != nil {
+		return h.abortTransactionRequest(ctx, c, err)
+	}
+	authCtx, err := h.checkTransactionPrivilege(ctx, c, dbName, httpReq.CollectionName, true)
+	if err != nil {
+		return h.abortTransactionRequest(ctx, c, err)
+	}
+	info, err := mgr.Begin(authCtx, dbName, httpReq.CollectionName, time.Duration(httpReq.KeepaliveMs)*time.Millisecond)
+	if err != nil {
+		return h.abortTransactionRequest(ctx, c, err)
+	}
+	HTTPReturn(c, http.StatusOK, gin.H{HTTPReturnCode: merr.Code(nil), HTTPReturnData: gin.H{
+		"transactionId": info.TxnID,
+		"keepaliveMs":   info.Keepalive.Milliseconds(),
+	}})
+	return nil, nil
+}
+
+func (h *HandlersV2) commitTransaction(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	httpReq := anyReq.(*TransactionIDReq)
+	mgr, err := h.getTxnManager()
+	if err != nil {
+		return h.abortTransactionRequest(ctx, c, err)
+	}
+	authCtx, err := h.checkTxnPrivilege(ctx, c, mgr, httpReq.TransactionID, true)
+	if err != nil {
+		return h.abortTransactionRequest(ctx, c, err)
+	}
+	ts, err := mgr.Commit(authCtx, httpReq.TransactionID)
+	if err != nil {
+		return h.abortTransactionRequest(ctx, c, err)
+	}
+	// the timestamp is returned as string to keep the precision for json clients.
+	HTTPReturn(c, http.StatusOK, gin.H{HTTPReturnCode: merr.Code(nil), HTTPReturnData: gin.H{
+		"commitTimestamp": strconv.FormatUint(ts, 10),
+	}})
+	return nil, nil
+}
+
+func (h *HandlersV2) rollbackTransaction(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	httpReq := anyReq.(*TransactionIDReq)
+	mgr, err := h.getTxnManager()
+	if err != nil {
+		return h.abortTransactionRequest(ctx, c, err)
+	}
+	authCtx, err := h.checkTxnPrivilege(ctx, c, mgr, httpReq.TransactionID, false)
+	if err != nil {
+		return h.abortTransactionRequest(ctx, c, err)
+	}
+	if err := mgr.Rollback(authCtx, httpReq.TransactionID); err != nil {
+		return h.abortTransactionRequest(ctx, c, err)
+	}
+	HTTPReturn(c, http.StatusOK, gin.H{HTTPReturnCode: merr.Code(nil), HTTPReturnData: gin.H{}})
+	return nil, nil
+}
@@ -56,6 +56,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proxy"
 	"github.com/milvus-io/milvus/internal/proxy/accesslog"
 	"github.com/milvus-io/milvus/internal/proxy/connection"
+	"github.com/milvus-io/milvus/internal/proxy/jwtauth"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/componentutil"
@@ -137,7 +138,15 @@ func authenticate(c *gin.Context) {
 		}
 	}
 	rawToken := httpserver.GetAuthorization(c)
-	if rawToken != "" && !strings.Contains(rawToken, util.CredentialSeparator) {
+	if identity, ok, err := proxy.VerifyJWT(c, rawToken); ok {
+		if err == nil {
+			c.Set(httpserver.ContextUsername, identity.Username)
+			c.Set(httpserver.ContextToken, rawToken)
+			c.Set(jwtauth.GinContextKey, identity)
+			c.Request = c.Request.WithContext(jwtauth.WithIdentity(c.Request.Context(), identity))
+			return
+		}
+	} else if rawToken != "" && !strings.Contains(rawToken, util.CredentialSeparator) {
 		user, err := proxy.VerifyAPIKey(rawToken)
 		if err == nil {
 			c.Set(httpserver.ContextUsername, user)
@@ -389,6 +398,17 @@ func (s *Server) startExternalGrpc(errChan chan error) {
 
 	milvuspb.RegisterMilvusServiceServer(s.grpcExternalServer, s)
 	milvuspb.RegisterClientTelemetryServiceServer(s.grpcExternalServer, s)
+	var authorizeTxn *transactionAuthorizer
+	var authorizeChangeStream changeStreamAuthorizer
+	var authorizeExplain explainAuthorizer
+	if enableCustomInterceptor {
+		authorizeTxn = newTransactionAuthorizer(getMetaCache, limiter)
+		authorizeChangeStream = newChangeStreamAuthorizer(getMetaCache)
+		authorizeExplain = newExplainAuthorizer(getMetaCache, limiter)
+	}
+	registerTransactionService(s.grpcExternalServer, s.proxy, authorizeTxn)
+	registerChangeStreamService(s.grpcExternalServer, s.proxy, authorizeChangeStream)
+	registerExplainService(s.grpcExternalServer, s.proxy, authorizeExplain)
 	grpc_health_v1.RegisterHealthServer(s.grpcExternalServer, s)
 	errChan <- nil
 
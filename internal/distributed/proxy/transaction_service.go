@@ -0,0 +1,172 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpcproxy
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v3/milvuspb"
+	"github.com/milvus-io/milvus/client/v3/proto/txnpb"
+	"github.com/milvus-io/milvus/internal/proxy"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+)
+
+// transactionAuthorizer authenticates the caller, checks its privileges on the collection and
+// applies the dml rate limits. The interceptors of the external grpc server only know the
+// milvuspb requests, so they skip the requests of the transactions.
+type transactionAuthorizer struct {
+	authenticate func(ctx context.Context) (context.Context, error)
+	authorize    func(ctx context.Context, dbName, collectionName string, rateLimited bool) (context.Context, error)
+}
+
+// newTransactionAuthorizer returns the authorizer requiring the insert and delete privileges of the collection.
+func newTransactionAuthorizer(getMetaCache func() proxy.Cache, limiter types.Limiter) *transactionAuthorizer {
+	authorize := proxy.PrivilegeInterceptorWithMetaCache(getMetaCache)
+	return &transactionAuthorizer{
+		authenticate: proxy.AuthenticationInterceptorWithMetaCache(getMetaCache),
+		authorize: func(ctx context.Context, dbName, collectionName string, rateLimited bool) (context.Context, error) {
+			if dbName == "" {
+				dbName = proxy.GetCurDBNameFromContextOrDefault(ctx)
+			}
+			insertReq := &milvuspb.InsertRequest{DbName: dbName, CollectionName: collectionName}
+			ctx, err := authorize(ctx, insertReq)
+			if err != nil {
+				return nil, err
+			}
+			if ctx, err = authorize(ctx, &milvuspb.DeleteRequest{DbName: dbName, CollectionName: collectionName}); err != nil {
+				return nil, err
+			}
+			if !rateLimited || limiter == nil {
+				return ctx, nil
+			}
+			// the writes of a transaction are limited by themselves, begin and commit are limited as
+			// small inserts, so they are denied once the writes of the collection are denied.
+			dbID, collectionIDToPartIDs, rt, n, err := proxy.GetRequestInfo(ctx, getMetaCache(), insertReq)
+			if err != nil {
+				return nil, err
+			}
+			if err := proxy.CheckRateLimit(ctx, limiter, dbID, collectionIDToPartIDs, rt, n); err != nil {
+				return nil, err
+			}
+			return ctx, nil
+		},
+	}
+}
+
+// transactionService serves the client transactions with the transaction manager of proxy.
+type transactionService struct {
+	proxy      types.ProxyComponent
+	authorizer *transactionAuthorizer
+}
+
+func registerTransactionService(s *grpc.Server, proxyComponent types.ProxyComponent, authorizer *transactionAuthorizer) {
+	txnpb.RegisterTransactionServiceServer(s, &transactionService{proxy: proxyComponent, authorizer: authorizer})
+}
+
+func (s *transactionService) txnManager() (*proxy.TxnManager, error) {
+	provider, ok := s.proxy.(interface{ GetTxnManager() *proxy.TxnManager })
+	if !ok || provider.GetTxnManager() == nil {
+		return nil, merr.WrapErrServiceNotReadyMsg("transaction manager is not initialized")
+	}
+	return provider.GetTxnManager(), nil
+}
+
+// authorize authorizes the request on the collection.
+func (s *transactionService) authorize(ctx context.Context, dbName, collectionName string, rateLimited bool) (context.Context, error) {
+	if s.authorizer == nil {
+		return ctx, nil
+	}
+	ctx, err := s.authorizer.authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.authorizer.authorize(ctx, dbName, collectionName, rateLimited)
+}
+
+// authorizeTxn authorizes the request on the collection of the transaction,
+// the transactions are only visible to their owners.
+func (s *transactionService) authorizeTxn(ctx context.Context, mgr *proxy.TxnManager, txnID string, rateLimited bool) (context.Context, error) {
+	if s.authorizer == nil {
+		return ctx, nil
+	}
+	ctx, err := s.authorizer.authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	info, err := mgr.Describe(ctx, txnID)
+	if err != nil {
+		return nil, err
+	}
+	return s.authorizer.authorize(ctx, info.DbName, info.CollectionName, rateLimited)
+}
+
+func (s *transactionService) Begin(ctx context.Context, req *txnpb.BeginTransactionRequest) (*txnpb.BeginTransactionResponse, error) {
+	mgr, err := s.txnManager()
+	if err != nil {
+		return &txnpb.BeginTransactionResponse{Status: merr.Status(err)}, nil
+	}
+	if ctx, err = s.authorize(ctx, req.GetDbName(), req.GetCollectionName(), true); err != nil {
+		return &txnpb.BeginTransactionResponse{Status: merr.Status(err)}, nil
+	}
+	keepalive := time.Duration(req.GetKeepaliveMs()) * time.Millisecond
+	info, err := mgr.Begin(ctx, req.GetDbName(), req.GetCollectionName(), keepalive)
+	if err != nil {
+		return &txnpb.BeginTransactionResponse{Status: merr.Status(err)}, nil
+	}
+	return &txnpb.BeginTransactionResponse{
+		Status:        merr.Success(),
+		TransactionId: info.TxnID,
+		KeepaliveMs:   info.Keepalive.Milliseconds(),
+	}, nil
+}
+
+func (s *transactionService) Commit(ctx context.Context, req *txnpb.CommitTransactionRequest) (*txnpb.CommitTransactionResponse, error) {
+	mgr, err := s.txnManager()
+	if err != nil {
+		return &txnpb.CommitTransactionResponse{Status: merr.Status(err)}, nil
+	}
+	if ctx, err = s.authorizeTxn(ctx, mgr, req.GetTransactionId(), true); err != nil {
+		return &txnpb.CommitTransactionResponse{Status: merr.Status(err)}, nil
+	}
+	ts, err := mgr.Commit(ctx, req.GetTransactionId())
+	if err != nil {
+		return &txnpb.CommitTransactionResponse{Status: merr.Status(err)}, nil
+	}
+	return &txnpb.CommitTransactionResponse{
+		Status:          merr.Success(),
+		CommitTimestamp: ts,
+	}, nil
+}
+
+func (s *transactionService) Rollback(ctx context.Context, req *txnpb.RollbackTransactionRequest) (*commonpb.Status, error) {
+	mgr, err := s.txnManager()
+	if err != nil {
+		return merr.Status(err), nil
+	}
+	if ctx, err = s.authorizeTxn(ctx, mgr, req.GetTransactionId(), false); err != nil {
+		return merr.Status(err), nil
+	}
+	if err := mgr.Rollback(ctx, req.GetTransactionId()); err != nil {
+		return merr.Status(err), nil
+	}
+	return merr.Success(), nil
+}
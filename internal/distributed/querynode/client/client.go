@@ -436,3 +436,14 @@ func (c *Client) ComputePhraseMatchSlop(ctx context.Context, req *querypb.Comput
 		return client.ComputePhraseMatchSlop(ctx, req)
 	})
 }
+
+func (c *Client) GetShardAppliedVersion(ctx context.Context, req *querypb.GetShardAppliedVersionRequest, _ ...grpc.CallOption) (*querypb.GetShardAppliedVersionResponse, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(c.nodeID),
+	)
+	return wrapGrpcCall(ctx, c, func(client querypb.QueryNodeClient) (*querypb.GetShardAppliedVersionResponse, error) {
+		return client.GetShardAppliedVersion(ctx, req)
+	})
+}
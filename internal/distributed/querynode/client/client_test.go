@@ -120,6 +120,9 @@ func Test_NewClient(t *testing.T) {
 		r24, err := client.GetHighlight(ctx, nil)
 		retCheck(retNotNil, r24, err)
 
+		r25, err := client.GetShardAppliedVersion(ctx, nil)
+		retCheck(retNotNil, r25, err)
+
 		// stream rpc
 		client, err := client.QueryStream(ctx, nil)
 		retCheck(retNotNil, client, err)
@@ -483,3 +483,7 @@ func (s *Server) ClearReadTaskQueue(ctx context.Context, req *internalpb.ClearRe
 func (s *Server) ComputePhraseMatchSlop(ctx context.Context, req *querypb.ComputePhraseMatchSlopRequest) (*querypb.ComputePhraseMatchSlopResponse, error) {
 	return s.querynode.ComputePhraseMatchSlop(ctx, req)
 }
+
+func (s *Server) GetShardAppliedVersion(ctx context.Context, req *querypb.GetShardAppliedVersionRequest) (*querypb.GetShardAppliedVersionResponse, error) {
+	return s.querynode.GetShardAppliedVersion(ctx, req)
+}
@@ -302,6 +302,18 @@ func Test_NewServer(t *testing.T) {
 		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
 	})
 
+	t.Run("GetShardAppliedVersion", func(t *testing.T) {
+		mockQN.EXPECT().GetShardAppliedVersion(mock.Anything, mock.Anything).Return(&querypb.GetShardAppliedVersionResponse{
+			Status: merr.Success(),
+		}, nil)
+
+		resp, err := server.GetShardAppliedVersion(ctx, &querypb.GetShardAppliedVersionRequest{
+			Channel: "test-channel",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+
 	t.Run("ValidateAnalyzer", func(t *testing.T) {
 		mockQN.EXPECT().ValidateAnalyzer(mock.Anything, mock.Anything).Return(&querypb.ValidateAnalyzerResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}}, nil)
 		req := &querypb.ValidateAnalyzerRequest{}
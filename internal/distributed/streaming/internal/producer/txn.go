@@ -0,0 +1,101 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package producer
+
+import (
+	"context"
+	"time"
+
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
+)
+
+// Txn is a transaction on a vchannel, which is kept open across the appends of the caller.
+// The messages appended into the transaction are invisible to the consumers until the
+// transaction is committed.
+type Txn struct {
+	producer *ResumableProducer
+	vchannel string
+	txnCtx   message.TxnContext
+}
+
+// BeginTxn begins a new transaction on the vchannel.
+// If keepalive is 0, the default keepalive of the streaming node is used.
+func (p *ResumableProducer) BeginTxn(ctx context.Context, vchannel string, keepalive time.Duration) (*Txn, error) {
+	beginTxn := message.NewBeginTxnMessageBuilderV2().
+		WithVChannel(vchannel).
+		WithHeader(&message.BeginTxnMessageHeader{
+			KeepaliveMilliseconds: keepalive.Milliseconds(),
+		}).
+		WithBody(&message.BeginTxnMessageBody{}).
+		MustBuildMutable()
+	message.InjectTraceContext(ctx, beginTxn)
+
+	result, err := p.produceInternal(ctx, beginTxn)
+	if err != nil {
+		return nil, err
+	}
+	return &Txn{
+		producer: p,
+		vchannel: vchannel,
+		txnCtx:   *result.TxnCtx,
+	}, nil
+}
+
+// TxnContext returns the context of the transaction.
+func (t *Txn) TxnContext() message.TxnContext {
+	return t.txnCtx
+}
+
+// Append appends a message into the transaction, the keepalive of the transaction is refreshed.
+func (t *Txn) Append(ctx context.Context, msg message.MutableMessage) (*types.AppendResult, error) {
+	if msg.MessageType().IsDMLMessageType() {
+		r, err := t.producer.rateLimiter.RequestReservation(ctx, msg)
+		if err != nil {
+			return nil, err
+		}
+		result, err := t.producer.produceInternal(ctx, msg.WithTxnContext(t.txnCtx))
+		if err != nil && r != nil {
+			r.Cancel()
+		}
+		return result, err
+	}
+	return t.producer.produceInternal(ctx, msg.WithTxnContext(t.txnCtx))
+}
+
+// Commit commits the transaction, all the appended messages become visible atomically.
+func (t *Txn) Commit(ctx context.Context) (*types.AppendResult, error) {
+	commitTxn := message.NewCommitTxnMessageBuilderV2().
+		WithVChannel(t.vchannel).
+		WithHeader(&message.CommitTxnMessageHeader{}).
+		WithBody(&message.CommitTxnMessageBody{}).
+		MustBuildMutable()
+	message.InjectTraceContext(ctx, commitTxn)
+	return t.producer.produceInternal(ctx, commitTxn.WithTxnContext(t.txnCtx))
+}
+
+// Rollback rolls back the transaction, all the appended messages are discarded.
+func (t *Txn) Rollback(ctx context.Context) error {
+	rollbackTxn := message.NewRollbackTxnMessageBuilderV2().
+		WithVChannel(t.vchannel).
+		WithHeader(&message.RollbackTxnMessageHeader{}).
+		WithBody(&message.RollbackTxnMessageBody{}).
+		MustBuildMutable()
+	message.InjectTraceContext(ctx, rollbackTxn)
+	_, err := t.producer.produceInternal(ctx, rollbackTxn.WithTxnContext(t.txnCtx))
+	return err
+}
@@ -198,6 +198,11 @@ type WALAccesser interface {
 	// Broadcast also support the resource-key to achieve a resource-exclusive acquirsion.
 	Broadcast() Broadcast
 
+	// Txn returns a transaction for writing records to one vchannel with atomic commit.
+	// The transaction is kept alive by its appends, it's expired if no append, commit or
+	// rollback arrives within the keepalive duration.
+	Txn(ctx context.Context, opts TxnOption) (Txn, error)
+
 	// Read returns a scanner for reading records from the wal.
 	Read(ctx context.Context, opts ReadOption) Scanner
 
@@ -210,6 +210,13 @@ func (n *noopWALAccesser) Broadcast() Broadcast {
 	return &noopBroadcast{}
 }
 
+func (n *noopWALAccesser) Txn(ctx context.Context, opts TxnOption) (Txn, error) {
+	if err := getExpectErr(); err != nil {
+		return nil, err
+	}
+	return &noopTxn{}, nil
+}
+
 func (n *noopWALAccesser) Read(ctx context.Context, opts ReadOption) Scanner {
 	return &noopScanner{}
 }
@@ -0,0 +1,159 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package streaming
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus/internal/distributed/streaming/internal/producer"
+	"github.com/milvus-io/milvus/internal/util/streamingutil/status"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
+	"github.com/milvus-io/milvus/pkg/v3/util/typeutil"
+)
+
+var (
+	ErrTxnCommitted  = errors.New("transaction is committed")
+	ErrTxnRolledBack = errors.New("transaction is rolled back")
+)
+
+// TxnOption is the option for starting a transaction.
+type TxnOption struct {
+	// VChannel is the target vchannel to write.
+	// TODO: support cross-wal in future.
+	VChannel string
+
+	// Keepalive is the keepalive duration of the transaction.
+	// If the transaction is not appended or committed within the keepalive duration,
+	// the transaction will be expired and rolled back by the streaming node.
+	// If Keepalive is 0, the default keepalive of the streaming node is used.
+	Keepalive time.Duration
+}
+
+// Txn is the interface for writing transaction into the wal.
+type Txn interface {
+	// Append writes a record to the transaction.
+	// All the messages must belong to the vchannel of the transaction.
+	Append(ctx context.Context, msg message.MutableMessage, opts ...AppendOption) error
+
+	// Commit commits the transaction, the appended messages become visible atomically.
+	// Commit and Rollback can be only called once, the caller should wait for the
+	// in-flight appends to finish before calling them.
+	Commit(ctx context.Context) (*types.AppendResult, error)
+
+	// Rollback rollbacks the transaction, the appended messages are discarded.
+	Rollback(ctx context.Context) error
+}
+
+// Txn starts a transaction on the vchannel of the option.
+func (w *walAccesserImpl) Txn(ctx context.Context, opts TxnOption) (Txn, error) {
+	if !w.lifetime.Add(typeutil.LifetimeStateWorking) {
+		return nil, ErrWALAccesserClosed
+	}
+	defer w.lifetime.Done()
+
+	if opts.VChannel == "" {
+		return nil, status.NewInvalidArgument("vchannel is required")
+	}
+	if opts.Keepalive != 0 && opts.Keepalive < time.Millisecond {
+		return nil, status.NewInvalidArgument("keepalive must be greater than 1ms")
+	}
+	txn, err := w.getProducer(opts.VChannel).BeginTxn(ctx, opts.VChannel, opts.Keepalive)
+	if err != nil {
+		return nil, err
+	}
+	return &txnImpl{
+		vchannel: opts.VChannel,
+		txn:      txn,
+	}, nil
+}
+
+type txnState int
+
+const (
+	txnStateInFlight txnState = iota
+	txnStateCommitted
+	txnStateRolledBack
+)
+
+// txnImpl is the implementation of Txn.
+type txnImpl struct {
+	mu       sync.Mutex
+	state    txnState
+	vchannel string
+	txn      *producer.Txn
+}
+
+// Append writes records to the transaction.
+func (t *txnImpl) Append(ctx context.Context, msg message.MutableMessage, opts ...AppendOption) error {
+	assertValidMessage(msg)
+	if msg.VChannel() != t.vchannel {
+		return status.NewInvalidArgument("the vchannel %s of message is not matched with the transaction vchannel %s", msg.VChannel(), t.vchannel)
+	}
+	if err := t.checkInFlight(); err != nil {
+		return err
+	}
+	_, err := t.txn.Append(ctx, applyOpt(msg, opts...))
+	return err
+}
+
+// Commit commits the transaction.
+func (t *txnImpl) Commit(ctx context.Context) (*types.AppendResult, error) {
+	if err := t.transitTo(txnStateCommitted); err != nil {
+		return nil, err
+	}
+	return t.txn.Commit(ctx)
+}
+
+// Rollback rollbacks the transaction.
+func (t *txnImpl) Rollback(ctx context.Context) error {
+	if err := t.transitTo(txnStateRolledBack); err != nil {
+		return err
+	}
+	return t.txn.Rollback(ctx)
+}
+
+func (t *txnImpl) checkInFlight() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.stateError()
+}
+
+func (t *txnImpl) transitTo(state txnState) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if err := t.stateError(); err != nil {
+		return err
+	}
+	t.state = state
+	return nil
+}
+
+func (t *txnImpl) stateError() error {
+	switch t.state {
+	case txnStateCommitted:
+		return ErrTxnCommitted
+	case txnStateRolledBack:
+		return ErrTxnRolledBack
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,106 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package streaming
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus/internal/mocks/streamingnode/client/handler/mock_producer"
+	"github.com/milvus-io/milvus/internal/util/streamingutil/status"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/walimpls/impls/walimplstest"
+)
+
+func TestWALTxn(t *testing.T) {
+	ctx := context.Background()
+	w, _, _, handler := createMockWAL(t)
+
+	p := mock_producer.NewMockProducer(t)
+	p.EXPECT().IsAvailable().Return(true).Maybe()
+	p.EXPECT().Available().Return(make(chan struct{})).Maybe()
+	p.EXPECT().Close().Return().Maybe()
+	handler.EXPECT().CreateProducer(mock.Anything, mock.Anything).Return(p, nil)
+
+	appended := make([]message.MutableMessage, 0)
+	p.EXPECT().Append(mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, mm message.MutableMessage) (*types.AppendResult, error) {
+			appended = append(appended, mm)
+			if mm.MessageType() == message.MessageTypeBeginTxn {
+				assert.Equal(t, int64(5000), message.MustAsMutableBeginTxnMessageV2(mm).Header().KeepaliveMilliseconds)
+			}
+			return &types.AppendResult{
+				MessageID: walimplstest.NewTestMessageID(int64(len(appended))),
+				TimeTick:  uint64(len(appended)),
+				TxnCtx: &message.TxnContext{
+					TxnID:     1,
+					Keepalive: 5 * time.Second,
+				},
+			}, nil
+		})
+
+	_, err := w.Txn(ctx, TxnOption{})
+	assert.Error(t, err)
+	_, err = w.Txn(ctx, TxnOption{VChannel: vChannel1, Keepalive: time.Microsecond})
+	assert.Error(t, err)
+
+	txn, err := w.Txn(ctx, TxnOption{VChannel: vChannel1, Keepalive: 5 * time.Second})
+	assert.NoError(t, err)
+	assert.NoError(t, txn.Append(ctx, newInsertMessage(vChannel1)))
+	assert.NoError(t, txn.Append(ctx, newInsertMessage(vChannel1)))
+	err = txn.Append(ctx, newInsertMessage(vChannel2))
+	assert.True(t, status.AsStreamingError(err).IsInvalidArgument())
+	result, err := txn.Commit(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(4), result.TimeTick)
+
+	assert.Len(t, appended, 4)
+	assert.Equal(t, message.MessageTypeBeginTxn, appended[0].MessageType())
+	for _, msg := range appended[1:] {
+		assert.Equal(t, message.TxnID(1), msg.TxnContext().TxnID)
+	}
+	assert.Equal(t, message.MessageTypeCommitTxn, appended[3].MessageType())
+
+	// the transaction can't be used after committed.
+	assert.ErrorIs(t, txn.Append(ctx, newInsertMessage(vChannel1)), ErrTxnCommitted)
+	_, err = txn.Commit(ctx)
+	assert.ErrorIs(t, err, ErrTxnCommitted)
+	assert.ErrorIs(t, txn.Rollback(ctx), ErrTxnCommitted)
+
+	txn, err = w.Txn(ctx, TxnOption{VChannel: vChannel1, Keepalive: 5 * time.Second})
+	assert.NoError(t, err)
+	assert.NoError(t, txn.Rollback(ctx))
+	assert.Equal(t, message.MessageTypeRollbackTxn, appended[len(appended)-1].MessageType())
+	assert.ErrorIs(t, txn.Append(ctx, newInsertMessage(vChannel1)), ErrTxnRolledBack)
+
+	// the expired transaction is reported to the caller without retrying.
+	txn, err = w.Txn(ctx, TxnOption{VChannel: vChannel1, Keepalive: 5 * time.Second})
+	assert.NoError(t, err)
+	p.EXPECT().Append(mock.Anything, mock.Anything).Unset()
+	p.EXPECT().Append(mock.Anything, mock.Anything).Return(nil, status.NewTransactionExpired("expired"))
+	_, err = txn.Commit(ctx)
+	assert.True(t, status.AsStreamingError(err).IsTxnExpired())
+
+	w.Close()
+	_, err = w.Txn(ctx, TxnOption{VChannel: vChannel1})
+	assert.ErrorIs(t, err, ErrWALAccesserClosed)
+}
@@ -34,6 +34,7 @@ import (
 	mix "github.com/milvus-io/milvus/internal/distributed/mixcoord/client"
 	"github.com/milvus-io/milvus/internal/distributed/utils"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	sqlkv "github.com/milvus-io/milvus/internal/kv/sql"
 	tikvkv "github.com/milvus-io/milvus/internal/kv/tikv"
 	"github.com/milvus-io/milvus/internal/storage"
 	streamingnodeserver "github.com/milvus-io/milvus/internal/streamingnode/server"
@@ -279,6 +280,14 @@ func (s *Server) initMeta() error {
 		metaRootPath = params.TiKVCfg.MetaRootPath.GetValue()
 		s.metaKV = tikvkv.NewTiKV(s.tikvCli, metaRootPath,
 			tikvkv.WithRequestTimeout(paramtable.Get().TiKVCfg.RequestTimeout.GetAsDuration(time.Millisecond)))
+	case util.MetaStoreTypeSQL:
+		metaRootPath = params.SQLCfg.MetaRootPath.GetValue()
+		metaKV, err := sqlkv.NewMetaKvFactory(metaRootPath, &params.SQLCfg)
+		if err != nil {
+			mlog.Warn(context.TODO(), "Streamingnode init sql client failed", mlog.Err(err))
+			return err
+		}
+		s.metaKV = metaKV
 	case util.MetaStoreTypeEtcd:
 		metaRootPath = params.EtcdCfg.MetaRootPath.GetValue()
 		s.metaKV = etcdkv.NewEtcdKV(s.etcdCli, metaRootPath,
@@ -70,6 +70,8 @@ const (
 	RouteGetQueryNodeDistribution   = "/management/querycoord/distribution/get"
 	RouteCheckQueryNodeDistribution = "/management/querycoord/distribution/check"
 	RouteClearReadTaskQueue         = "/management/query/task_queue/clear"
+
+	RouteSlowLog = "/management/proxy/slow_log"
 )
 
 const (
@@ -0,0 +1,124 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlkv
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+	"github.com/milvus-io/milvus/pkg/v3/util/sqlutil"
+)
+
+// querier is implemented by both *sql.DB and *sql.Tx.
+type querier interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+// Client is the database shared by all the sql kvs of a process,
+// like the etcd client shared by the etcd kvs.
+type Client struct {
+	db      *sql.DB
+	dialect sqlutil.Dialect
+	tables  sqlutil.Tables
+}
+
+// NewClient creates the schema of the kv tables if not exist and returns the client.
+func NewClient(ctx context.Context, db *sql.DB, dialect sqlutil.Dialect, tablePrefix string) (*Client, error) {
+	tables, err := sqlutil.NewTables(tablePrefix)
+	if err != nil {
+		return nil, err
+	}
+	if err := sqlutil.InitSchema(ctx, db, dialect, tables); err != nil {
+		return nil, err
+	}
+	return &Client{
+		db:      db,
+		dialect: dialect,
+		tables:  tables,
+	}, nil
+}
+
+// Close closes the underlying database.
+func (c *Client) Close() error {
+	return c.db.Close()
+}
+
+// query formats the table names into the query and rebinds the placeholders for the dialect.
+// The %[1]s, %[2]s and %[3]s verbs are the kv, event and meta tables.
+func (c *Client) query(format string) string {
+	return c.dialect.Rebind(fmt.Sprintf(format, c.tables.KV, c.tables.Event, c.tables.Meta))
+}
+
+// revisions returns the current and the compacted revision.
+func (c *Client) revisions(ctx context.Context, q querier) (int64, int64, error) {
+	var revision, compactRevision int64
+	if err := q.QueryRowContext(ctx, c.query(`SELECT revision, compact_revision FROM %[3]s WHERE id = ?`), sqlutil.MetaRowID).
+		Scan(&revision, &compactRevision); err != nil {
+		return 0, 0, err
+	}
+	return revision, compactRevision, nil
+}
+
+// nextRevision increases the revision and returns the new one.
+// The update locks the meta row until the transaction ends, so all the writers are serialized
+// and the revisions become visible in order, which the watchers rely on.
+func (c *Client) nextRevision(ctx context.Context, tx *sql.Tx) (int64, error) {
+	if _, err := tx.ExecContext(ctx, c.query(`UPDATE %[3]s SET revision = revision + 1 WHERE id = ?`), sqlutil.MetaRowID); err != nil {
+		return 0, err
+	}
+	revision, _, err := c.revisions(ctx, tx)
+	return revision, err
+}
+
+// Compact removes the change history older than the latest retainRevisions revisions.
+// The watchers that have not consumed the removed history receive ErrCompacted as etcd does.
+func (c *Client) Compact(ctx context.Context, retainRevisions int64) error {
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		return merr.WrapErrIoFailedReason("failed to begin txn for compaction", err.Error())
+	}
+	defer tx.Rollback()
+
+	// lock the meta row first to keep the compacted revision consistent with the writers.
+	if _, err := tx.ExecContext(ctx, c.query(`UPDATE %[3]s SET compact_revision = compact_revision WHERE id = ?`), sqlutil.MetaRowID); err != nil {
+		return merr.WrapErrIoFailedReason("failed to lock meta for compaction", err.Error())
+	}
+	revision, compactRevision, err := c.revisions(ctx, tx)
+	if err != nil {
+		return merr.WrapErrIoFailedReason("failed to get revision for compaction", err.Error())
+	}
+	target := revision - retainRevisions
+	if target <= compactRevision {
+		return nil
+	}
+	if _, err := tx.ExecContext(ctx, c.query(`DELETE FROM %[2]s WHERE revision <= ?`), target); err != nil {
+		return merr.WrapErrIoFailedReason("failed to remove compacted events", err.Error())
+	}
+	if _, err := tx.ExecContext(ctx, c.query(`UPDATE %[3]s SET compact_revision = ? WHERE id = ?`), target, sqlutil.MetaRowID); err != nil {
+		return merr.WrapErrIoFailedReason("failed to update compact revision", err.Error())
+	}
+	if err := tx.Commit(); err != nil {
+		return merr.WrapErrIoFailedReason("failed to commit compaction", err.Error())
+	}
+	mlog.Info(ctx, "sql kv history compacted", mlog.Int64("compactRevision", target), mlog.Int64("revision", revision))
+	return nil
+}
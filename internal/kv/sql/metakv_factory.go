@@ -0,0 +1,96 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlkv
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	// register the database drivers of the supported dialects.
+	_ "github.com/jackc/pgx/v5/stdlib"
+	_ "modernc.org/sqlite"
+
+	"github.com/milvus-io/milvus/pkg/v3/kv"
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v3/util/sqlutil"
+)
+
+var (
+	clientMu     sync.Mutex
+	sharedClient *Client
+)
+
+// GetSQLClient returns the client shared by the process, the client is created at the first call
+// with the history compaction started in background.
+func GetSQLClient(cfg *paramtable.SQLConfig) (*Client, error) {
+	clientMu.Lock()
+	defer clientMu.Unlock()
+	if sharedClient != nil {
+		return sharedClient, nil
+	}
+
+	dialect, err := sqlutil.ParseDialect(cfg.Dialect.GetValue())
+	if err != nil {
+		return nil, err
+	}
+	db, err := sqlutil.CreateSQLClient(dialect, cfg.DSN.GetValue())
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.RequestTimeout.GetAsDuration(time.Millisecond))
+	defer cancel()
+	client, err := NewClient(ctx, db, dialect, cfg.TablePrefix.GetValue())
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	mlog.Info(ctx, "sql metastore connected", mlog.String("dialect", string(dialect)), mlog.String("tablePrefix", cfg.TablePrefix.GetValue()))
+	go compactLoop(client, cfg)
+	sharedClient = client
+	return sharedClient, nil
+}
+
+// compactLoop compacts the change history periodically for the lifetime of the process.
+func compactLoop(client *Client, cfg *paramtable.SQLConfig) {
+	ticker := time.NewTicker(cfg.CompactionInterval.GetAsDuration(time.Second))
+	defer ticker.Stop()
+	for range ticker.C {
+		ctx, cancel := context.WithTimeout(context.Background(), cfg.RequestTimeout.GetAsDuration(time.Millisecond))
+		if err := client.Compact(ctx, cfg.CompactionRetainRevisions.GetAsInt64()); err != nil {
+			mlog.Warn(ctx, "failed to compact sql kv history", mlog.Err(err))
+		}
+		cancel()
+	}
+}
+
+// NewWatchKVFactory returns an object that implements the kv.WatchKV interface using the sql database.
+func NewWatchKVFactory(rootPath string, cfg *paramtable.SQLConfig) (kv.WatchKV, error) {
+	client, err := GetSQLClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return NewSQLKV(client, rootPath,
+		WithRequestTimeout(cfg.RequestTimeout.GetAsDuration(time.Millisecond)),
+		WithWatchPollInterval(cfg.WatchPollInterval.GetAsDuration(time.Millisecond))), nil
+}
+
+// NewMetaKvFactory returns an object that implements the kv.MetaKv interface using the sql database.
+func NewMetaKvFactory(rootPath string, cfg *paramtable.SQLConfig) (kv.MetaKv, error) {
+	return NewWatchKVFactory(rootPath, cfg)
+}
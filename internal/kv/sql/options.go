@@ -0,0 +1,46 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlkv
+
+import "time"
+
+type sqlOpt struct {
+	requestTimeout    time.Duration
+	watchPollInterval time.Duration
+}
+
+type Option func(*sqlOpt)
+
+func WithRequestTimeout(timeout time.Duration) Option {
+	return func(opt *sqlOpt) {
+		opt.requestTimeout = timeout
+	}
+}
+
+// WithWatchPollInterval sets the interval the watchers poll the event table for new changes.
+func WithWatchPollInterval(interval time.Duration) Option {
+	return func(opt *sqlOpt) {
+		opt.watchPollInterval = interval
+	}
+}
+
+func defaultOption() *sqlOpt {
+	return &sqlOpt{
+		requestTimeout:    defaultRequestTimeout,
+		watchPollInterval: defaultWatchPollInterval,
+	}
+}
@@ -0,0 +1,603 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlkv
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+
+	"github.com/milvus-io/milvus/pkg/v3/kv"
+	"github.com/milvus-io/milvus/pkg/v3/kv/predicates"
+	"github.com/milvus-io/milvus/pkg/v3/metrics"
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/util"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+	"github.com/milvus-io/milvus/pkg/v3/util/sqlutil"
+	"github.com/milvus-io/milvus/pkg/v3/util/typeutil"
+)
+
+const (
+	// defaultRequestTimeout is the default timeout for sql request.
+	defaultRequestTimeout = 10 * time.Second
+	// defaultWatchPollInterval is the default interval the watchers poll the event table.
+	defaultWatchPollInterval = 100 * time.Millisecond
+	// maxSQLTxnOps bounds how many key ops we treat as one atomic sql transaction.
+	// A database transaction has no practical op limit, the bound only keeps
+	// a single transaction from holding the revision lock for too long.
+	maxSQLTxnOps = 1 << 16
+	// maxKeysPerQuery bounds the keys in one IN clause, sqlite limits the number of host parameters.
+	maxKeysPerQuery = 512
+)
+
+// implementation assertion
+var _ kv.WatchKV = (*sqlKV)(nil)
+
+// sqlKV implements WatchKV over a relational database.
+// Every write transaction takes a new revision and records its changes into the event table,
+// the watchers poll the event table to provide etcd-compatible watch responses.
+type sqlKV struct {
+	client   *Client
+	rootPath string
+
+	requestTimeout    time.Duration
+	watchPollInterval time.Duration
+
+	closeOnce sync.Once
+	closed    chan struct{}
+	wg        sync.WaitGroup
+}
+
+// NewSQLKV creates a new sql kv.
+func NewSQLKV(client *Client, rootPath string, options ...Option) *sqlKV {
+	opt := defaultOption()
+	for _, option := range options {
+		option(opt)
+	}
+	return &sqlKV{
+		client:            client,
+		rootPath:          rootPath,
+		requestTimeout:    opt.requestTimeout,
+		watchPollInterval: opt.watchPollInterval,
+		closed:            make(chan struct{}),
+	}
+}
+
+// MaxTxnOps returns the per-transaction op count the sql kv applies atomically.
+func (kv *sqlKV) MaxTxnOps() int {
+	return maxSQLTxnOps
+}
+
+// Close stops the watchers of the kv, the client is shared with the other kvs so it's not closed.
+func (kv *sqlKV) Close() {
+	kv.closeOnce.Do(func() {
+		close(kv.closed)
+	})
+	kv.wg.Wait()
+	mlog.Debug(context.TODO(), "sql kv closed", mlog.String("path", kv.rootPath))
+}
+
+// GetPath returns the path of the key/prefix.
+func (kv *sqlKV) GetPath(key string) string {
+	return util.GetPath(kv.rootPath, key)
+}
+
+// kvState is a row of the kv table.
+type kvState struct {
+	value          []byte
+	createRevision int64
+	modRevision    int64
+	version        int64
+}
+
+// Has returns if a key exists.
+func (kv *sqlKV) Has(ctx context.Context, key string) (bool, error) {
+	start := time.Now()
+	key = kv.GetPath(key)
+	ctx, cancel := context.WithTimeout(ctx, kv.requestTimeout)
+	defer cancel()
+
+	exist, err := kv.exist(ctx, `SELECT 1 FROM %[1]s WHERE k = ?`, []byte(key))
+	if err != nil {
+		mlog.Warn(ctx, "sqlKV Has() error", mlog.String("key", key), mlog.Err(err))
+		return false, merr.WrapErrIoFailedReason(fmt.Sprintf("Failed to read key: %s", key), err.Error())
+	}
+	CheckElapseAndWarn(ctx, start, "Slow sql operation has", mlog.String("key", key))
+	return exist, nil
+}
+
+// HasPrefix returns if a key prefix exists.
+func (kv *sqlKV) HasPrefix(ctx context.Context, prefix string) (bool, error) {
+	start := time.Now()
+	prefix = kv.GetPath(prefix)
+	ctx, cancel := context.WithTimeout(ctx, kv.requestTimeout)
+	defer cancel()
+
+	cond, args := sqlutil.PrefixCondition(prefix)
+	exist, err := kv.exist(ctx, `SELECT 1 FROM %[1]s WHERE `+cond+` LIMIT 1`, args...)
+	if err != nil {
+		mlog.Warn(ctx, "sqlKV HasPrefix() error", mlog.String("prefix", prefix), mlog.Err(err))
+		return false, merr.WrapErrIoFailedReason(fmt.Sprintf("Failed to read prefix: %s", prefix), err.Error())
+	}
+	CheckElapseAndWarn(ctx, start, "Slow sql operation has prefix", mlog.String("prefix", prefix))
+	return exist, nil
+}
+
+func (kv *sqlKV) exist(ctx context.Context, query string, args ...any) (bool, error) {
+	rows, err := kv.client.db.QueryContext(ctx, kv.client.query(query), args...)
+	if err != nil {
+		return false, err
+	}
+	defer rows.Close()
+	exist := rows.Next()
+	return exist, rows.Err()
+}
+
+// Load returns value of the key.
+func (kv *sqlKV) Load(ctx context.Context, key string) (string, error) {
+	start := time.Now()
+	key = kv.GetPath(key)
+	ctx, cancel := context.WithTimeout(ctx, kv.requestTimeout)
+	defer cancel()
+
+	states, err := kv.client.loadStates(ctx, kv.client.db, []string{key})
+	kv.observe(metrics.MetaGetLabel, start, err)
+	if err != nil {
+		mlog.Warn(ctx, "sqlKV Load() error", mlog.String("key", key), mlog.Err(err))
+		return "", merr.WrapErrIoFailedReason(fmt.Sprintf("Failed to read key %s", key), err.Error())
+	}
+	state, ok := states[key]
+	if !ok {
+		return "", merr.WrapErrIoKeyNotFound(key)
+	}
+	CheckElapseAndWarn(ctx, start, "Slow sql operation load", mlog.String("key", key))
+	return string(state.value), nil
+}
+
+// MultiLoad gets the values of the keys, the missing keys are reported in the error
+// with an empty value at their position.
+func (kv *sqlKV) MultiLoad(ctx context.Context, keys []string) ([]string, error) {
+	start := time.Now()
+	ctx, cancel := context.WithTimeout(ctx, kv.requestTimeout)
+	defer cancel()
+
+	fullKeys := lo.Map(keys, func(key string, _ int) string { return kv.GetPath(key) })
+	states, err := kv.client.loadStates(ctx, kv.client.db, fullKeys)
+	kv.observe(metrics.MetaGetLabel, start, err)
+	if err != nil {
+		mlog.Warn(ctx, "sqlKV MultiLoad() error", mlog.Strings("keys", keys), mlog.Err(err))
+		return nil, merr.WrapErrIoFailedReason("Failed to read keys for MultiLoad", err.Error())
+	}
+	result := make([]string, 0, len(keys))
+	invalid := make([]string, 0)
+	for i, key := range fullKeys {
+		state, ok := states[key]
+		if !ok {
+			invalid = append(invalid, keys[i])
+			result = append(result, "")
+			continue
+		}
+		result = append(result, string(state.value))
+	}
+	if len(invalid) != 0 {
+		mlog.Warn(ctx, "MultiLoad: there are invalid keys", mlog.Strings("keys", invalid))
+		return result, merr.WrapErrIoKeyNotFound(fmt.Sprintf("%v", invalid))
+	}
+	CheckElapseAndWarn(ctx, start, "Slow sql operation multi load", mlog.Strings("keys", keys))
+	return result, nil
+}
+
+// LoadWithPrefix returns all the keys and values with the given key prefix.
+func (kv *sqlKV) LoadWithPrefix(ctx context.Context, prefix string) ([]string, []string, error) {
+	start := time.Now()
+	prefix = kv.GetPath(prefix)
+	ctx, cancel := context.WithTimeout(ctx, kv.requestTimeout)
+	defer cancel()
+
+	cond, args := sqlutil.PrefixCondition(prefix)
+	keys, values, err := kv.scan(ctx, `SELECT k, v FROM %[1]s WHERE `+cond+` ORDER BY k`, args...)
+	kv.observe(metrics.MetaGetLabel, start, err)
+	if err != nil {
+		mlog.Warn(ctx, "sqlKV LoadWithPrefix() error", mlog.String("prefix", prefix), mlog.Err(err))
+		return nil, nil, merr.WrapErrIoFailedReason(fmt.Sprintf("Failed to read prefix %s", prefix), err.Error())
+	}
+	CheckElapseAndWarn(ctx, start, "Slow sql operation load with prefix", mlog.String("prefix", prefix))
+	return keys, lo.Map(values, func(v []byte, _ int) string { return string(v) }), nil
+}
+
+// WalkWithPrefix visits each kv with input prefix and apply given fn to it.
+// Every page is read out before fn is applied, so fn may access the kv again.
+func (kv *sqlKV) WalkWithPrefix(ctx context.Context, prefix string, paginationSize int, fn func([]byte, []byte) error) error {
+	start := time.Now()
+	prefix = kv.GetPath(prefix)
+	if paginationSize <= 0 {
+		return merr.WrapErrParameterInvalidMsg("invalid pagination size %d", paginationSize)
+	}
+
+	cond, args := sqlutil.PrefixCondition(prefix)
+	next := []byte(prefix)
+	for {
+		ctx1, cancel := context.WithTimeout(ctx, kv.requestTimeout)
+		pageArgs := append([]any{next}, args...)
+		pageArgs = append(pageArgs, paginationSize)
+		keys, values, err := kv.scan(ctx1, `SELECT k, v FROM %[1]s WHERE k >= ? AND `+cond+` ORDER BY k LIMIT ?`, pageArgs...)
+		cancel()
+		if err != nil {
+			mlog.Warn(ctx, "sqlKV WalkWithPrefix() error", mlog.String("prefix", prefix), mlog.Err(err))
+			return merr.WrapErrIoFailedReason(fmt.Sprintf("Failed to walk prefix %s", prefix), err.Error())
+		}
+		for i := range keys {
+			if err := fn([]byte(keys[i]), values[i]); err != nil {
+				return err
+			}
+		}
+		if len(keys) < paginationSize {
+			break
+		}
+		// move to next key
+		next = append([]byte(keys[len(keys)-1]), 0)
+	}
+	CheckElapseAndWarn(ctx, start, "Slow sql operation(WalkWithPagination)", mlog.String("prefix", prefix))
+	return nil
+}
+
+func (kv *sqlKV) scan(ctx context.Context, query string, args ...any) ([]string, [][]byte, error) {
+	rows, err := kv.client.db.QueryContext(ctx, kv.client.query(query), args...)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+	keys := make([]string, 0)
+	values := make([][]byte, 0)
+	for rows.Next() {
+		var k, v []byte
+		if err := rows.Scan(&k, &v); err != nil {
+			return nil, nil, err
+		}
+		keys = append(keys, string(k))
+		values = append(values, v)
+	}
+	return keys, values, rows.Err()
+}
+
+// Save saves the input key-value pair.
+func (kv *sqlKV) Save(ctx context.Context, key, value string) error {
+	return kv.apply(ctx, "Save()", map[string]string{key: value}, nil, nil, nil)
+}
+
+// MultiSave saves the input key-value pairs in transaction manner.
+func (kv *sqlKV) MultiSave(ctx context.Context, kvs map[string]string) error {
+	return kv.apply(ctx, "MultiSave()", kvs, nil, nil, nil)
+}
+
+// Remove removes the input key.
+func (kv *sqlKV) Remove(ctx context.Context, key string) error {
+	return kv.apply(ctx, "Remove()", nil, []string{key}, nil, nil)
+}
+
+// MultiRemove removes the input keys in transaction manner.
+func (kv *sqlKV) MultiRemove(ctx context.Context, keys []string) error {
+	return kv.apply(ctx, "MultiRemove()", nil, keys, nil, nil)
+}
+
+// RemoveWithPrefix removes the keys for the given prefix.
+func (kv *sqlKV) RemoveWithPrefix(ctx context.Context, prefix string) error {
+	return kv.apply(ctx, "RemoveWithPrefix()", nil, nil, []string{prefix}, nil)
+}
+
+// MultiSaveAndRemove saves the key-value pairs and removes the keys in a transaction.
+func (kv *sqlKV) MultiSaveAndRemove(ctx context.Context, saves map[string]string, removals []string, preds ...predicates.Predicate) error {
+	return kv.apply(ctx, "MultiSaveAndRemove()", saves, removals, nil, preds)
+}
+
+// MultiSaveAndRemoveWithPrefix saves kv in @saves and removes the keys with given prefix in @removals.
+func (kv *sqlKV) MultiSaveAndRemoveWithPrefix(ctx context.Context, saves map[string]string, removals []string, preds ...predicates.Predicate) error {
+	return kv.apply(ctx, "MultiSaveAndRemoveWithPrefix()", saves, nil, removals, preds)
+}
+
+// CompareVersionAndSwap compares the existing key-value's version with version, and if
+// they are equal, the target is stored. A missing key has version 0.
+func (kv *sqlKV) CompareVersionAndSwap(ctx context.Context, key string, version int64, target string) (bool, error) {
+	start := time.Now()
+	key = kv.GetPath(key)
+	ctx, cancel := context.WithTimeout(ctx, kv.requestTimeout)
+	defer cancel()
+
+	swapped := false
+	err := kv.runWriteTxn(ctx, "CompareVersionAndSwap()", func(txn *writeTxn) error {
+		states, err := kv.client.loadStates(ctx, txn.tx, []string{key})
+		if err != nil {
+			return err
+		}
+		prev := states[key]
+		current := int64(0)
+		if prev != nil {
+			current = prev.version
+		}
+		if current != version {
+			return nil
+		}
+		swapped = true
+		return txn.put(ctx, key, target, prev)
+	})
+	if err != nil {
+		mlog.Warn(ctx, "sqlKV CompareVersionAndSwap() error", mlog.String("key", key), mlog.Err(err))
+		return false, err
+	}
+	CheckElapseAndWarn(ctx, start, "Slow sql operation compare version and swap", mlog.String("key", key))
+	return swapped, nil
+}
+
+// apply applies the mutations in one transaction if all the predicates are met.
+// The keys in saves win over the removals like the complement taken by etcd and tikv kv.
+func (kv *sqlKV) apply(ctx context.Context, op string, saves map[string]string, removals []string, prefixRemovals []string, preds []predicates.Predicate) error {
+	if err := checkPredicates(preds); err != nil {
+		return err
+	}
+	start := time.Now()
+	ctx, cancel := context.WithTimeout(ctx, kv.requestTimeout)
+	defer cancel()
+
+	fullSaves := make(map[string]string, len(saves))
+	for key, value := range saves {
+		fullSaves[kv.GetPath(key)] = value
+	}
+	fullRemovals := lo.Map(removals, func(key string, _ int) string { return kv.GetPath(key) })
+	fullPrefixes := lo.Map(prefixRemovals, func(prefix string, _ int) string { return kv.GetPath(prefix) })
+
+	err := kv.runWriteTxn(ctx, op, func(txn *writeTxn) error {
+		if err := kv.checkPredicatesInTxn(ctx, txn, preds); err != nil {
+			return err
+		}
+		states, err := kv.client.loadStates(ctx, txn.tx, append(lo.Keys(fullSaves), fullRemovals...))
+		if err != nil {
+			return err
+		}
+		// collect the keys to remove, the removal of a missing key is a no-op as etcd does.
+		deletes := typeutil.NewSet[string]()
+		for _, key := range fullRemovals {
+			if _, ok := states[key]; ok {
+				deletes.Insert(key)
+			}
+		}
+		for _, prefix := range fullPrefixes {
+			prefixStates, err := kv.client.loadPrefixStates(ctx, txn.tx, prefix)
+			if err != nil {
+				return err
+			}
+			for key, state := range prefixStates {
+				states[key] = state
+				deletes.Insert(key)
+			}
+		}
+		for _, key := range sortedKeys(deletes.Collect()) {
+			if _, ok := fullSaves[key]; ok {
+				continue
+			}
+			if err := txn.delete(ctx, key, states[key]); err != nil {
+				return err
+			}
+		}
+		for _, key := range sortedKeys(lo.Keys(fullSaves)) {
+			if err := txn.put(ctx, key, fullSaves[key], states[key]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		mlog.Warn(ctx, fmt.Sprintf("sqlKV %s error", op),
+			mlog.Strings("saveKeys", lo.Keys(saves)),
+			mlog.Strings("removes", removals),
+			mlog.Strings("prefixRemoves", prefixRemovals),
+			mlog.Err(err))
+		return err
+	}
+	CheckElapseAndWarn(ctx, start, fmt.Sprintf("Slow sql operation %s", op), mlog.Strings("keys", lo.Keys(saves)))
+	return nil
+}
+
+// checkPredicates checks the predicates are supported before starting the transaction.
+func checkPredicates(preds []predicates.Predicate) error {
+	for _, pred := range preds {
+		if pred.Target() != predicates.PredTargetValue {
+			return merr.WrapErrParameterInvalid("valid predicate target", fmt.Sprintf("%d", pred.Target()))
+		}
+		if pred.Type() != predicates.PredTypeEqual {
+			return merr.WrapErrParameterInvalid("valid predicate type", fmt.Sprintf("%d", pred.Type()))
+		}
+	}
+	return nil
+}
+
+func (kv *sqlKV) checkPredicatesInTxn(ctx context.Context, txn *writeTxn, preds []predicates.Predicate) error {
+	if len(preds) == 0 {
+		return nil
+	}
+	keys := lo.Map(preds, func(pred predicates.Predicate, _ int) string { return kv.GetPath(pred.Key()) })
+	states, err := kv.client.loadStates(ctx, txn.tx, keys)
+	if err != nil {
+		return err
+	}
+	for i, pred := range preds {
+		state, ok := states[keys[i]]
+		if !ok || !pred.IsTrue(state.value) {
+			return errors.Mark(merr.WrapErrIoFailedReason("failed to meet predicate", fmt.Sprintf("key=%s, value=%v", pred.Key(), pred.TargetValue())), errPredicateNotMet)
+		}
+	}
+	return nil
+}
+
+var errPredicateNotMet = errors.New("predicate not met")
+
+// writeTxn is a write transaction holding a new revision.
+type writeTxn struct {
+	client   *Client
+	tx       *sql.Tx
+	revision int64
+	mutated  bool
+}
+
+// runWriteTxn runs fn in a transaction with a new revision.
+// The transaction is rolled back if fn fails or mutates nothing, so the revision only increases on changes.
+func (kv *sqlKV) runWriteTxn(ctx context.Context, op string, fn func(txn *writeTxn) error) error {
+	start := time.Now()
+	err := func() error {
+		tx, err := kv.client.db.BeginTx(ctx, nil)
+		if err != nil {
+			return merr.WrapErrIoFailedReason(fmt.Sprintf("Failed to begin txn for %s", op), err.Error())
+		}
+		defer tx.Rollback()
+
+		revision, err := kv.client.nextRevision(ctx, tx)
+		if err != nil {
+			return merr.WrapErrIoFailedReason(fmt.Sprintf("Failed to get revision for %s", op), err.Error())
+		}
+		txn := &writeTxn{client: kv.client, tx: tx, revision: revision}
+		if err := fn(txn); err != nil {
+			if errors.Is(err, errPredicateNotMet) {
+				return err
+			}
+			return merr.WrapErrIoFailedReason(fmt.Sprintf("Failed to execute txn for %s", op), err.Error())
+		}
+		if !txn.mutated {
+			return nil
+		}
+		if err := tx.Commit(); err != nil {
+			return merr.WrapErrIoFailedReason(fmt.Sprintf("Failed to commit for %s", op), err.Error())
+		}
+		return nil
+	}()
+	kv.observe(metrics.MetaTxnLabel, start, err)
+	return err
+}
+
+// put saves the value of the key and records the put event.
+func (txn *writeTxn) put(ctx context.Context, key, value string, prev *kvState) error {
+	createRevision, version := txn.revision, int64(1)
+	if prev != nil {
+		createRevision, version = prev.createRevision, prev.version+1
+	}
+	if _, err := txn.tx.ExecContext(ctx, txn.client.query(`INSERT INTO %[1]s (k, v, create_revision, mod_revision, version) VALUES (?, ?, ?, ?, ?)
+ON CONFLICT (k) DO UPDATE SET v = excluded.v, create_revision = excluded.create_revision, mod_revision = excluded.mod_revision, version = excluded.version`),
+		[]byte(key), []byte(value), createRevision, txn.revision, version); err != nil {
+		return err
+	}
+	txn.mutated = true
+	return txn.recordEvent(ctx, mvccpb.PUT, key, []byte(value), createRevision, version, prev)
+}
+
+// delete removes the key and records the delete event.
+func (txn *writeTxn) delete(ctx context.Context, key string, prev *kvState) error {
+	if _, err := txn.tx.ExecContext(ctx, txn.client.query(`DELETE FROM %[1]s WHERE k = ?`), []byte(key)); err != nil {
+		return err
+	}
+	txn.mutated = true
+	return txn.recordEvent(ctx, mvccpb.DELETE, key, nil, 0, 0, prev)
+}
+
+func (txn *writeTxn) recordEvent(ctx context.Context, eventType mvccpb.Event_EventType, key string, value []byte, createRevision int64, version int64, prev *kvState) error {
+	var prevValue []byte
+	var prevCreateRevision, prevModRevision, prevVersion sql.NullInt64
+	if prev != nil {
+		prevValue = prev.value
+		prevCreateRevision = sql.NullInt64{Int64: prev.createRevision, Valid: true}
+		prevModRevision = sql.NullInt64{Int64: prev.modRevision, Valid: true}
+		prevVersion = sql.NullInt64{Int64: prev.version, Valid: true}
+	}
+	_, err := txn.tx.ExecContext(ctx, txn.client.query(`INSERT INTO %[2]s
+(revision, k, v, event_type, create_revision, version, prev_v, prev_create_revision, prev_mod_revision, prev_version)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`),
+		txn.revision, []byte(key), value, int32(eventType), createRevision, version,
+		prevValue, prevCreateRevision, prevModRevision, prevVersion)
+	return err
+}
+
+// loadStates loads the rows of the keys, the missing keys are absent in the result.
+func (c *Client) loadStates(ctx context.Context, q querier, keys []string) (map[string]*kvState, error) {
+	states := make(map[string]*kvState, len(keys))
+	for _, batch := range lo.Chunk(lo.Uniq(keys), maxKeysPerQuery) {
+		placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(batch)), ", ")
+		args := lo.Map(batch, func(key string, _ int) any { return []byte(key) })
+		if err := c.scanStates(ctx, q, states,
+			`SELECT k, v, create_revision, mod_revision, version FROM %[1]s WHERE k IN (`+placeholders+`)`, args...); err != nil {
+			return nil, err
+		}
+	}
+	return states, nil
+}
+
+// loadPrefixStates loads the rows of the keys with the prefix.
+func (c *Client) loadPrefixStates(ctx context.Context, q querier, prefix string) (map[string]*kvState, error) {
+	states := make(map[string]*kvState)
+	cond, args := sqlutil.PrefixCondition(prefix)
+	if err := c.scanStates(ctx, q, states,
+		`SELECT k, v, create_revision, mod_revision, version FROM %[1]s WHERE `+cond, args...); err != nil {
+		return nil, err
+	}
+	return states, nil
+}
+
+func (c *Client) scanStates(ctx context.Context, q querier, states map[string]*kvState, query string, args ...any) error {
+	rows, err := q.QueryContext(ctx, c.query(query), args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var k []byte
+		state := &kvState{}
+		if err := rows.Scan(&k, &state.value, &state.createRevision, &state.modRevision, &state.version); err != nil {
+			return err
+		}
+		states[string(k)] = state
+	}
+	return rows.Err()
+}
+
+func (kv *sqlKV) observe(label string, start time.Time, err error) {
+	metrics.MetaOpCounter.WithLabelValues(label, metrics.TotalLabel).Inc()
+	if err != nil {
+		metrics.MetaOpCounter.WithLabelValues(label, metrics.FailLabel).Inc()
+		return
+	}
+	metrics.MetaRequestLatency.WithLabelValues(label).Observe(float64(time.Since(start).Milliseconds()))
+	metrics.MetaOpCounter.WithLabelValues(label, metrics.SuccessLabel).Inc()
+}
+
+func sortedKeys(keys []string) []string {
+	sort.Strings(keys)
+	return keys
+}
+
+// CheckElapseAndWarn checks the elapsed time and warns if it is too long.
+func CheckElapseAndWarn(ctx context.Context, start time.Time, message string, fields ...mlog.Field) bool {
+	elapsed := time.Since(start)
+	if elapsed.Milliseconds() > 2000 {
+		mlog.Warn(ctx, message, append([]mlog.Field{mlog.String("time spent", elapsed.String())}, fields...)...)
+		return true
+	}
+	return false
+}
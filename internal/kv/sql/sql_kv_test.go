@@ -0,0 +1,348 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlkv
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"github.com/milvus-io/milvus/pkg/v3/kv/predicates"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v3/util/sqlutil"
+)
+
+func TestMain(m *testing.M) {
+	paramtable.Init()
+	os.Exit(m.Run())
+}
+
+// newTestClient creates a client over a sqlite database in a temporary directory.
+func newTestClient(t *testing.T) *Client {
+	db, err := sqlutil.CreateSQLClient(sqlutil.DialectSQLite, filepath.Join(t.TempDir(), "meta.db"))
+	require.NoError(t, err)
+	client, err := NewClient(context.TODO(), db, sqlutil.DialectSQLite, "milvus")
+	require.NoError(t, err)
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+func newTestKV(t *testing.T, client *Client, rootPath string) *sqlKV {
+	kv := NewSQLKV(client, rootPath, WithWatchPollInterval(10*time.Millisecond))
+	t.Cleanup(kv.Close)
+	return kv
+}
+
+func TestSQLKVLoad(t *testing.T) {
+	ctx := context.TODO()
+	kv := newTestKV(t, newTestClient(t), "/sql/test/root/load")
+
+	for _, test := range []struct {
+		key   string
+		value string
+	}{
+		{"test1", "value1"},
+		{"test2", "value2"},
+		{"test1/a", "value_a"},
+		{"test1/b", "value_b"},
+	} {
+		require.NoError(t, kv.Save(ctx, test.key, test.value))
+		val, err := kv.Load(ctx, test.key)
+		assert.NoError(t, err)
+		assert.Equal(t, test.value, val)
+	}
+
+	for _, key := range []string{"t", "a", "test1a"} {
+		val, err := kv.Load(ctx, key)
+		assert.ErrorIs(t, err, merr.ErrIoKeyNotFound)
+		assert.Zero(t, val)
+	}
+
+	for _, test := range []struct {
+		prefix         string
+		expectedKeys   []string
+		expectedValues []string
+	}{
+		{"test", []string{kv.GetPath("test1"), kv.GetPath("test1/a"), kv.GetPath("test1/b"), kv.GetPath("test2")}, []string{"value1", "value_a", "value_b", "value2"}},
+		{"test1", []string{kv.GetPath("test1"), kv.GetPath("test1/a"), kv.GetPath("test1/b")}, []string{"value1", "value_a", "value_b"}},
+		{"test1/", []string{kv.GetPath("test1/a"), kv.GetPath("test1/b")}, []string{"value_a", "value_b"}},
+		{"a", []string{}, []string{}},
+	} {
+		keys, values, err := kv.LoadWithPrefix(ctx, test.prefix)
+		assert.NoError(t, err)
+		assert.Equal(t, test.expectedKeys, keys, test.prefix)
+		assert.Equal(t, test.expectedValues, values, test.prefix)
+	}
+
+	values, err := kv.MultiLoad(ctx, []string{"test1", "test2"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"value1", "value2"}, values)
+	values, err = kv.MultiLoad(ctx, []string{"test1", "missing"})
+	assert.ErrorIs(t, err, merr.ErrIoKeyNotFound)
+	assert.Equal(t, []string{"value1", ""}, values)
+
+	has, err := kv.Has(ctx, "test1")
+	assert.NoError(t, err)
+	assert.True(t, has)
+	has, err = kv.Has(ctx, "test3")
+	assert.NoError(t, err)
+	assert.False(t, has)
+	has, err = kv.HasPrefix(ctx, "test1/")
+	assert.NoError(t, err)
+	assert.True(t, has)
+	has, err = kv.HasPrefix(ctx, "test3")
+	assert.NoError(t, err)
+	assert.False(t, has)
+
+	// another root path shares the tables but not the keys.
+	other := newTestKV(t, kv.client, "/sql/test/root/other")
+	keys, _, err := other.LoadWithPrefix(ctx, "")
+	assert.NoError(t, err)
+	assert.Empty(t, keys)
+}
+
+func TestSQLKVRemove(t *testing.T) {
+	ctx := context.TODO()
+	kv := newTestKV(t, newTestClient(t), "/sql/test/root/remove")
+
+	require.NoError(t, kv.MultiSave(ctx, map[string]string{
+		"a": "1", "a/b": "2", "a/c": "3", "ab": "4", "d": "5",
+	}))
+
+	require.NoError(t, kv.Remove(ctx, "d"))
+	// removing a missing key is a no-op.
+	require.NoError(t, kv.Remove(ctx, "d"))
+	_, err := kv.Load(ctx, "d")
+	assert.ErrorIs(t, err, merr.ErrIoKeyNotFound)
+
+	require.NoError(t, kv.RemoveWithPrefix(ctx, "a/"))
+	keys, _, err := kv.LoadWithPrefix(ctx, "")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{kv.GetPath("a"), kv.GetPath("ab")}, keys)
+
+	require.NoError(t, kv.MultiRemove(ctx, []string{"a", "ab", "missing"}))
+	keys, _, err = kv.LoadWithPrefix(ctx, "")
+	assert.NoError(t, err)
+	assert.Empty(t, keys)
+}
+
+func TestSQLKVMultiSaveAndRemove(t *testing.T) {
+	ctx := context.TODO()
+	kv := newTestKV(t, newTestClient(t), "/sql/test/root/multi")
+
+	require.NoError(t, kv.MultiSave(ctx, map[string]string{"x/1": "1", "x/2": "2", "y": "3"}))
+
+	// the saves win over the removals.
+	require.NoError(t, kv.MultiSaveAndRemove(ctx, map[string]string{"y": "4", "z": "5"}, []string{"y", "x/1"}))
+	_, values, err := kv.LoadWithPrefix(ctx, "")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"2", "4", "5"}, values)
+
+	require.NoError(t, kv.MultiSaveAndRemoveWithPrefix(ctx, map[string]string{"x/3": "6"}, []string{"x/"}))
+	keys, values, err := kv.LoadWithPrefix(ctx, "x/")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{kv.GetPath("x/3")}, keys)
+	assert.Equal(t, []string{"6"}, values)
+}
+
+func TestSQLKVPredicates(t *testing.T) {
+	ctx := context.TODO()
+	kv := newTestKV(t, newTestClient(t), "/sql/test/root/predicates")
+
+	require.NoError(t, kv.Save(ctx, "lease", "1"))
+
+	for _, test := range []struct {
+		name   string
+		preds  []predicates.Predicate
+		expect bool
+	}{
+		{"predicate_ok", []predicates.Predicate{predicates.ValueEqual("lease", "1")}, true},
+		{"predicate_fail", []predicates.Predicate{predicates.ValueEqual("lease", "2")}, false},
+		{"predicate_missing", []predicates.Predicate{predicates.ValueEqual("missing", "1")}, false},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			key := fmt.Sprintf("save_%s", test.name)
+			err := kv.MultiSaveAndRemove(ctx, map[string]string{key: "v"}, nil, test.preds...)
+			prefixErr := kv.MultiSaveAndRemoveWithPrefix(ctx, map[string]string{key + "_prefix": "v"}, nil, test.preds...)
+			if test.expect {
+				assert.NoError(t, err)
+				assert.NoError(t, prefixErr)
+			} else {
+				assert.ErrorIs(t, err, merr.ErrIoFailed)
+				assert.ErrorIs(t, prefixErr, merr.ErrIoFailed)
+			}
+			has, err := kv.Has(ctx, key)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expect, has)
+		})
+	}
+
+	// the failed predicates take no revision.
+	revision, _, err := kv.client.revisions(ctx, kv.client.db)
+	require.NoError(t, err)
+	err = kv.MultiSaveAndRemove(ctx, map[string]string{"k": "v"}, nil, predicates.ValueEqual("lease", "2"))
+	assert.Error(t, err)
+	current, _, err := kv.client.revisions(ctx, kv.client.db)
+	require.NoError(t, err)
+	assert.Equal(t, revision, current)
+}
+
+func TestSQLKVCompareVersionAndSwap(t *testing.T) {
+	ctx := context.TODO()
+	kv := newTestKV(t, newTestClient(t), "/sql/test/root/cas")
+
+	swapped, err := kv.CompareVersionAndSwap(ctx, "key", 0, "v1")
+	assert.NoError(t, err)
+	assert.True(t, swapped)
+
+	swapped, err = kv.CompareVersionAndSwap(ctx, "key", 0, "v2")
+	assert.NoError(t, err)
+	assert.False(t, swapped)
+
+	swapped, err = kv.CompareVersionAndSwap(ctx, "key", 1, "v2")
+	assert.NoError(t, err)
+	assert.True(t, swapped)
+
+	val, err := kv.Load(ctx, "key")
+	assert.NoError(t, err)
+	assert.Equal(t, "v2", val)
+}
+
+func TestSQLKVWalkWithPrefix(t *testing.T) {
+	ctx := context.TODO()
+	kv := newTestKV(t, newTestClient(t), "/sql/test/root/walk")
+
+	saves := make(map[string]string)
+	for i := 0; i < 25; i++ {
+		saves[fmt.Sprintf("walk/%02d", i)] = fmt.Sprintf("%d", i)
+	}
+	require.NoError(t, kv.MultiSave(ctx, saves))
+
+	var keys []string
+	err := kv.WalkWithPrefix(ctx, "walk/", 7, func(k []byte, v []byte) error {
+		keys = append(keys, string(k))
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Len(t, keys, 25)
+	assert.Equal(t, kv.GetPath("walk/00"), keys[0])
+	assert.Equal(t, kv.GetPath("walk/24"), keys[24])
+
+	stop := errors.New("stop")
+	err = kv.WalkWithPrefix(ctx, "walk/", 7, func(k []byte, v []byte) error {
+		return stop
+	})
+	assert.ErrorIs(t, err, stop)
+}
+
+func TestSQLKVWatch(t *testing.T) {
+	ctx := context.TODO()
+	kv := newTestKV(t, newTestClient(t), "/sql/test/root/watch")
+
+	ch := kv.WatchWithPrefix(ctx, "watch/")
+	resp := receive(t, ch)
+	assert.True(t, resp.Created)
+
+	require.NoError(t, kv.Save(ctx, "watch/a", "1"))
+	require.NoError(t, kv.Save(ctx, "other", "1"))
+	require.NoError(t, kv.Remove(ctx, "watch/a"))
+
+	var events []*clientv3.Event
+	for len(events) < 2 {
+		resp := receive(t, ch)
+		require.NoError(t, resp.Err())
+		events = append(events, resp.Events...)
+	}
+	require.Len(t, events, 2)
+	assert.Equal(t, mvccpb.PUT, events[0].Type)
+	assert.Equal(t, kv.GetPath("watch/a"), string(events[0].Kv.Key))
+	assert.Equal(t, "1", string(events[0].Kv.Value))
+	assert.Equal(t, mvccpb.DELETE, events[1].Type)
+
+	// watch with revision replays the history with the previous values.
+	revCh := kv.WatchWithRevision(ctx, "watch/", events[0].Kv.ModRevision)
+	resp = receive(t, revCh)
+	require.Len(t, resp.Events, 2)
+	assert.Nil(t, resp.Events[0].PrevKv)
+	require.NotNil(t, resp.Events[1].PrevKv)
+	assert.Equal(t, "1", string(resp.Events[1].PrevKv.Value))
+
+	// the watcher of a single key ignores the keys under it.
+	keyCh := kv.Watch(ctx, "watch/b")
+	assert.True(t, receive(t, keyCh).Created)
+	require.NoError(t, kv.Save(ctx, "watch/b/c", "1"))
+	require.NoError(t, kv.Save(ctx, "watch/b", "2"))
+	resp = receive(t, keyCh)
+	require.Len(t, resp.Events, 1)
+	assert.Equal(t, kv.GetPath("watch/b"), string(resp.Events[0].Kv.Key))
+	assert.EqualValues(t, 1, resp.Events[0].Kv.Version)
+
+	// the watch channels are closed with the kv.
+	kv.Close()
+	for range ch {
+	}
+	for range keyCh {
+	}
+}
+
+func TestSQLKVWatchCompacted(t *testing.T) {
+	ctx := context.TODO()
+	kv := newTestKV(t, newTestClient(t), "/sql/test/root/compact")
+
+	for i := 0; i < 10; i++ {
+		require.NoError(t, kv.Save(ctx, "key", fmt.Sprintf("%d", i)))
+	}
+	revision, _, err := kv.client.revisions(ctx, kv.client.db)
+	require.NoError(t, err)
+	require.NoError(t, kv.client.Compact(ctx, 3))
+
+	resp := receive(t, kv.WatchWithRevision(ctx, "key", 1))
+	assert.True(t, resp.Canceled)
+	assert.ErrorIs(t, resp.Err(), rpctypes.ErrCompacted)
+	assert.Equal(t, revision-2, resp.CompactRevision)
+
+	// the watch from the compact revision replays the retained history.
+	resp = receive(t, kv.WatchWithRevision(ctx, "key", resp.CompactRevision))
+	require.NoError(t, resp.Err())
+	assert.Len(t, resp.Events, 3)
+
+	val, err := kv.Load(ctx, "key")
+	assert.NoError(t, err)
+	assert.Equal(t, "9", val)
+}
+
+func receive(t *testing.T, ch clientv3.WatchChan) clientv3.WatchResponse {
+	select {
+	case resp, ok := <-ch:
+		require.True(t, ok)
+		return resp
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for the watch response")
+	}
+	return clientv3.WatchResponse{}
+}
@@ -0,0 +1,229 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlkv
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"golang.org/x/time/rate"
+
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/util/sqlutil"
+)
+
+// maxWatchRevisions bounds the revisions fetched by one poll of a watcher.
+const maxWatchRevisions = 1024
+
+// Watch starts watching a key, returns a watch channel.
+// Like the etcd kv, the watch lives until the kv is closed, the ctx is not used to cancel it.
+func (kv *sqlKV) Watch(ctx context.Context, key string) clientv3.WatchChan {
+	return kv.watch(ctx, &watcher{key: kv.GetPath(key), createdNotify: true})
+}
+
+// WatchWithPrefix starts watching a key with prefix, returns a watch channel.
+func (kv *sqlKV) WatchWithPrefix(ctx context.Context, key string) clientv3.WatchChan {
+	return kv.watch(ctx, &watcher{key: kv.GetPath(key), prefix: true, createdNotify: true})
+}
+
+// WatchWithRevision starts watching a key with prefix from the revision, returns a watch channel.
+// The previous key-values are attached to the events.
+func (kv *sqlKV) WatchWithRevision(ctx context.Context, key string, revision int64) clientv3.WatchChan {
+	return kv.watch(ctx, &watcher{key: kv.GetPath(key), prefix: true, prevKV: true, next: revision})
+}
+
+// watcher polls the event table for the changes of a key or a prefix.
+type watcher struct {
+	kv            *sqlKV
+	key           string
+	prefix        bool
+	prevKV        bool
+	createdNotify bool
+	// next is the next revision to deliver.
+	next int64
+	ch   chan clientv3.WatchResponse
+}
+
+func (kv *sqlKV) watch(ctx context.Context, w *watcher) clientv3.WatchChan {
+	w.kv = kv
+	w.ch = make(chan clientv3.WatchResponse)
+	if w.next <= 0 {
+		// watch the changes after now.
+		ctx1, cancel := context.WithTimeout(ctx, kv.requestTimeout)
+		revision, _, err := kv.client.revisions(ctx1, kv.client.db)
+		cancel()
+		if err != nil {
+			mlog.Warn(ctx, "sql kv failed to get revision for watch", mlog.String("key", w.key), mlog.Err(err))
+			ch := make(chan clientv3.WatchResponse, 1)
+			ch <- clientv3.WatchResponse{Canceled: true}
+			close(ch)
+			return ch
+		}
+		w.next = revision + 1
+	}
+	kv.wg.Add(1)
+	go w.run()
+	return w.ch
+}
+
+func (w *watcher) run() {
+	defer w.kv.wg.Done()
+	defer close(w.ch)
+
+	if w.createdNotify {
+		if !w.send(clientv3.WatchResponse{Header: etcdserverpb.ResponseHeader{Revision: w.next - 1}, Created: true}) {
+			return
+		}
+	}
+	ticker := time.NewTicker(w.kv.watchPollInterval)
+	defer ticker.Stop()
+	for {
+		resp, more, err := w.poll()
+		if err != nil {
+			mlog.RatedWarn(context.TODO(), rate.Limit(0.1), "sql kv watcher failed to poll events", mlog.String("key", w.key), mlog.Err(err))
+		} else if resp != nil {
+			if !w.send(*resp) || resp.CompactRevision != 0 {
+				return
+			}
+		}
+		if more {
+			continue
+		}
+		select {
+		case <-w.kv.closed:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *watcher) send(resp clientv3.WatchResponse) bool {
+	select {
+	case <-w.kv.closed:
+		return false
+	case w.ch <- resp:
+		return true
+	}
+}
+
+// poll fetches the events from the next revision, more is true if there are more revisions to fetch.
+func (w *watcher) poll() (resp *clientv3.WatchResponse, more bool, err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), w.kv.requestTimeout)
+	defer cancel()
+
+	client := w.kv.client
+	revision, compactRevision, err := client.revisions(ctx, client.db)
+	if err != nil {
+		return nil, false, err
+	}
+	if w.next <= compactRevision {
+		return w.compacted(revision, compactRevision), false, nil
+	}
+	if w.next > revision {
+		return nil, false, nil
+	}
+	upper := revision
+	if upper-w.next >= maxWatchRevisions {
+		upper = w.next + maxWatchRevisions - 1
+		more = true
+	}
+
+	cond, args := `k = ?`, []any{[]byte(w.key)}
+	if w.prefix {
+		cond, args = sqlutil.PrefixCondition(w.key)
+	}
+	args = append([]any{w.next, upper}, args...)
+	rows, err := client.db.QueryContext(ctx, client.query(`SELECT revision, k, v, event_type, create_revision, version,
+prev_v, prev_create_revision, prev_mod_revision, prev_version
+FROM %[2]s WHERE revision >= ? AND revision <= ? AND `+cond+` ORDER BY revision, k`), args...)
+	if err != nil {
+		return nil, false, err
+	}
+	events, err := w.scanEvents(rows)
+	if err != nil {
+		return nil, false, err
+	}
+
+	// the history may be compacted while reading, the events read may be incomplete then.
+	if _, compactRevision, err = client.revisions(ctx, client.db); err != nil {
+		return nil, false, err
+	}
+	if w.next <= compactRevision {
+		return w.compacted(revision, compactRevision), false, nil
+	}
+	w.next = upper + 1
+	if len(events) == 0 {
+		return nil, more, nil
+	}
+	return &clientv3.WatchResponse{
+		Header: etcdserverpb.ResponseHeader{Revision: upper},
+		Events: events,
+	}, more, nil
+}
+
+func (w *watcher) scanEvents(rows *sql.Rows) ([]*clientv3.Event, error) {
+	defer rows.Close()
+	var events []*clientv3.Event
+	for rows.Next() {
+		var (
+			revision, createRevision, version                int64
+			eventType                                        int32
+			key, value, prevValue                            []byte
+			prevCreateRevision, prevModRevision, prevVersion sql.NullInt64
+		)
+		if err := rows.Scan(&revision, &key, &value, &eventType, &createRevision, &version,
+			&prevValue, &prevCreateRevision, &prevModRevision, &prevVersion); err != nil {
+			return nil, err
+		}
+		event := &clientv3.Event{
+			Type: mvccpb.Event_EventType(eventType),
+			Kv: &mvccpb.KeyValue{
+				Key:            key,
+				Value:          value,
+				CreateRevision: createRevision,
+				ModRevision:    revision,
+				Version:        version,
+			},
+		}
+		if w.prevKV && prevModRevision.Valid {
+			event.PrevKv = &mvccpb.KeyValue{
+				Key:            key,
+				Value:          prevValue,
+				CreateRevision: prevCreateRevision.Int64,
+				ModRevision:    prevModRevision.Int64,
+				Version:        prevVersion.Int64,
+			}
+		}
+		events = append(events, event)
+	}
+	return events, rows.Err()
+}
+
+// compacted returns the response telling the watcher its revision is compacted,
+// the watcher is closed after it as etcd does.
+// The history up to compactRevision is removed, so the minimum revision to watch from is the next one.
+func (w *watcher) compacted(revision, compactRevision int64) *clientv3.WatchResponse {
+	return &clientv3.WatchResponse{
+		Header:          etcdserverpb.ResponseHeader{Revision: revision},
+		CompactRevision: compactRevision + 1,
+		Canceled:        true,
+	}
+}
@@ -104,6 +104,9 @@ type DataCoordCatalog interface {
 	SaveImportJob(ctx context.Context, job *datapb.ImportJob) error
 	ListImportJobs(ctx context.Context) ([]*datapb.ImportJob, error)
 	DropImportJob(ctx context.Context, jobID int64) error
+	SaveExportJob(ctx context.Context, job *datapb.ExportJob) error
+	ListExportJobs(ctx context.Context) ([]*datapb.ExportJob, error)
+	DropExportJob(ctx context.Context, jobID int64) error
 	SavePreImportTask(ctx context.Context, task *datapb.PreImportTask) error
 	ListPreImportTasks(ctx context.Context) ([]*datapb.PreImportTask, error)
 	DropPreImportTask(ctx context.Context, taskID int64) error
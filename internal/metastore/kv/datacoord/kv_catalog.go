@@ -775,6 +775,39 @@ func (kc *Catalog) DropImportJob(ctx context.Context, jobID int64) error {
 	return kc.MetaKv.Remove(ctx, key)
 }
 
+func (kc *Catalog) SaveExportJob(ctx context.Context, job *datapb.ExportJob) error {
+	key := buildExportJobKey(job.GetJobID())
+	value, err := proto.Marshal(job)
+	if err != nil {
+		return err
+	}
+	return kc.MetaKv.Save(ctx, key, string(value))
+}
+
+func (kc *Catalog) ListExportJobs(ctx context.Context) ([]*datapb.ExportJob, error) {
+	jobs := make([]*datapb.ExportJob, 0)
+	applyFn := func(key []byte, value []byte) error {
+		job := &datapb.ExportJob{}
+		err := proto.Unmarshal(value, job)
+		if err != nil {
+			return err
+		}
+		jobs = append(jobs, job)
+		return nil
+	}
+
+	err := kc.MetaKv.WalkWithPrefix(ctx, ExportJobPrefix+"/", kc.paginationSize, applyFn)
+	if err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
+
+func (kc *Catalog) DropExportJob(ctx context.Context, jobID int64) error {
+	key := buildExportJobKey(jobID)
+	return kc.MetaKv.Remove(ctx, key)
+}
+
 func (kc *Catalog) SavePreImportTask(ctx context.Context, task *datapb.PreImportTask) error {
 	key := buildPreImportTaskKey(task.GetTaskID())
 	value, err := proto.Marshal(task)
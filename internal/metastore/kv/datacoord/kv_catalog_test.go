@@ -1637,6 +1637,72 @@ func TestCatalog_Import(t *testing.T) {
 	})
 }
 
+func TestCatalog_ExportJob(t *testing.T) {
+	kc := &Catalog{}
+	mockErr := errors.New("mock error")
+
+	job := &datapb.ExportJob{
+		JobID: 1,
+		State: datapb.ExportJobState_ExportJobPending,
+	}
+
+	t.Run("SaveExportJob", func(t *testing.T) {
+		txn := mocks.NewMetaKv(t)
+		txn.EXPECT().Save(mock.Anything, buildExportJobKey(job.GetJobID()), mock.Anything).Return(nil)
+		kc.MetaKv = txn
+		err := kc.SaveExportJob(context.TODO(), job)
+		assert.NoError(t, err)
+
+		txn = mocks.NewMetaKv(t)
+		txn.EXPECT().Save(mock.Anything, mock.Anything, mock.Anything).Return(mockErr)
+		kc.MetaKv = txn
+		err = kc.SaveExportJob(context.TODO(), job)
+		assert.Error(t, err)
+	})
+
+	t.Run("ListExportJobs", func(t *testing.T) {
+		txn := mocks.NewMetaKv(t)
+		value, err := proto.Marshal(job)
+		assert.NoError(t, err)
+		txn.EXPECT().WalkWithPrefix(mock.Anything, ExportJobPrefix+"/", mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, _ string, _ int, f func([]byte, []byte) error) error {
+			return f(nil, value)
+		})
+		kc.MetaKv = txn
+		jobs, err := kc.ListExportJobs(context.TODO())
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(jobs))
+		assert.True(t, proto.Equal(job, jobs[0]))
+
+		txn = mocks.NewMetaKv(t)
+		txn.EXPECT().WalkWithPrefix(mock.Anything, mock.Anything, mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, _ string, _ int, f func([]byte, []byte) error) error {
+			return f(nil, []byte("@#%#^#"))
+		})
+		kc.MetaKv = txn
+		_, err = kc.ListExportJobs(context.TODO())
+		assert.Error(t, err)
+
+		txn = mocks.NewMetaKv(t)
+		txn.EXPECT().WalkWithPrefix(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(mockErr)
+		kc.MetaKv = txn
+		_, err = kc.ListExportJobs(context.TODO())
+		assert.Error(t, err)
+	})
+
+	t.Run("DropExportJob", func(t *testing.T) {
+		txn := mocks.NewMetaKv(t)
+		txn.EXPECT().Remove(mock.Anything, buildExportJobKey(job.GetJobID())).Return(nil)
+		kc.MetaKv = txn
+		err := kc.DropExportJob(context.TODO(), job.GetJobID())
+		assert.NoError(t, err)
+
+		txn = mocks.NewMetaKv(t)
+		txn.EXPECT().Remove(mock.Anything, mock.Anything).Return(mockErr)
+		kc.MetaKv = txn
+		err = kc.DropExportJob(context.TODO(), job.GetJobID())
+		assert.Error(t, err)
+	})
+}
+
 func TestCatalog_AnalyzeTask(t *testing.T) {
 	kc := &Catalog{}
 	mockErr := errors.New("mock error")
@@ -415,3 +415,7 @@ func buildSnapshotKey(collectionID int64, snapshotID int64) string {
 func buildExportSnapshotJobKey(jobID int64) string {
 	return fmt.Sprintf("%s/%d", ExportSnapshotJobPrefix, jobID)
 }
+
+func buildExportJobKey(jobID int64) string {
+	return fmt.Sprintf("%s/%d", ExportJobPrefix, jobID)
+}
@@ -701,6 +701,53 @@ func (_c *DataCoordCatalog_DropCurrentPartitionStatsVersion_Call) RunAndReturn(r
 	return _c
 }
 
+// DropExportJob provides a mock function with given fields: ctx, jobID
+func (_m *DataCoordCatalog) DropExportJob(ctx context.Context, jobID int64) error {
+	ret := _m.Called(ctx, jobID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DropExportJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, jobID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DataCoordCatalog_DropExportJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropExportJob'
+type DataCoordCatalog_DropExportJob_Call struct {
+	*mock.Call
+}
+
+// DropExportJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobID int64
+func (_e *DataCoordCatalog_Expecter) DropExportJob(ctx interface{}, jobID interface{}) *DataCoordCatalog_DropExportJob_Call {
+	return &DataCoordCatalog_DropExportJob_Call{Call: _e.mock.On("DropExportJob", ctx, jobID)}
+}
+
+func (_c *DataCoordCatalog_DropExportJob_Call) Run(run func(ctx context.Context, jobID int64)) *DataCoordCatalog_DropExportJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *DataCoordCatalog_DropExportJob_Call) Return(_a0 error) *DataCoordCatalog_DropExportJob_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DataCoordCatalog_DropExportJob_Call) RunAndReturn(run func(context.Context, int64) error) *DataCoordCatalog_DropExportJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DropExportSnapshotJob provides a mock function with given fields: ctx, jobID
 func (_m *DataCoordCatalog) DropExportSnapshotJob(ctx context.Context, jobID int64) error {
 	ret := _m.Called(ctx, jobID)
@@ -1584,6 +1631,64 @@ func (_c *DataCoordCatalog_ListCopySegmentTasks_Call) RunAndReturn(run func(cont
 	return _c
 }
 
+// ListExportJobs provides a mock function with given fields: ctx
+func (_m *DataCoordCatalog) ListExportJobs(ctx context.Context) ([]*datapb.ExportJob, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListExportJobs")
+	}
+
+	var r0 []*datapb.ExportJob
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*datapb.ExportJob, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*datapb.ExportJob); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*datapb.ExportJob)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DataCoordCatalog_ListExportJobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListExportJobs'
+type DataCoordCatalog_ListExportJobs_Call struct {
+	*mock.Call
+}
+
+// ListExportJobs is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *DataCoordCatalog_Expecter) ListExportJobs(ctx interface{}) *DataCoordCatalog_ListExportJobs_Call {
+	return &DataCoordCatalog_ListExportJobs_Call{Call: _e.mock.On("ListExportJobs", ctx)}
+}
+
+func (_c *DataCoordCatalog_ListExportJobs_Call) Run(run func(ctx context.Context)) *DataCoordCatalog_ListExportJobs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *DataCoordCatalog_ListExportJobs_Call) Return(_a0 []*datapb.ExportJob, _a1 error) *DataCoordCatalog_ListExportJobs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DataCoordCatalog_ListExportJobs_Call) RunAndReturn(run func(context.Context) ([]*datapb.ExportJob, error)) *DataCoordCatalog_ListExportJobs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListExportSnapshotJobs provides a mock function with given fields: ctx
 func (_m *DataCoordCatalog) ListExportSnapshotJobs(ctx context.Context) ([]*datapb.ExportSnapshotJob, error) {
 	ret := _m.Called(ctx)
@@ -2753,6 +2858,53 @@ func (_c *DataCoordCatalog_SaveDroppedSegmentsInBatch_Call) RunAndReturn(run fun
 	return _c
 }
 
+// SaveExportJob provides a mock function with given fields: ctx, job
+func (_m *DataCoordCatalog) SaveExportJob(ctx context.Context, job *datapb.ExportJob) error {
+	ret := _m.Called(ctx, job)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveExportJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ExportJob) error); ok {
+		r0 = rf(ctx, job)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DataCoordCatalog_SaveExportJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveExportJob'
+type DataCoordCatalog_SaveExportJob_Call struct {
+	*mock.Call
+}
+
+// SaveExportJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - job *datapb.ExportJob
+func (_e *DataCoordCatalog_Expecter) SaveExportJob(ctx interface{}, job interface{}) *DataCoordCatalog_SaveExportJob_Call {
+	return &DataCoordCatalog_SaveExportJob_Call{Call: _e.mock.On("SaveExportJob", ctx, job)}
+}
+
+func (_c *DataCoordCatalog_SaveExportJob_Call) Run(run func(ctx context.Context, job *datapb.ExportJob)) *DataCoordCatalog_SaveExportJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.ExportJob))
+	})
+	return _c
+}
+
+func (_c *DataCoordCatalog_SaveExportJob_Call) Return(_a0 error) *DataCoordCatalog_SaveExportJob_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DataCoordCatalog_SaveExportJob_Call) RunAndReturn(run func(context.Context, *datapb.ExportJob) error) *DataCoordCatalog_SaveExportJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SaveExportSnapshotJob provides a mock function with given fields: ctx, job
 func (_m *DataCoordCatalog) SaveExportSnapshotJob(ctx context.Context, job *datapb.ExportSnapshotJob) error {
 	ret := _m.Called(ctx, job)
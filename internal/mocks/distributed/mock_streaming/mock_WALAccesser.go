@@ -490,6 +490,65 @@ func (_c *MockWALAccesser_Replicate_Call) RunAndReturn(run func() streaming.Repl
 	return _c
 }
 
+// Txn provides a mock function with given fields: ctx, opts
+func (_m *MockWALAccesser) Txn(ctx context.Context, opts streaming.TxnOption) (streaming.Txn, error) {
+	ret := _m.Called(ctx, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Txn")
+	}
+
+	var r0 streaming.Txn
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, streaming.TxnOption) (streaming.Txn, error)); ok {
+		return rf(ctx, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, streaming.TxnOption) streaming.Txn); ok {
+		r0 = rf(ctx, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(streaming.Txn)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, streaming.TxnOption) error); ok {
+		r1 = rf(ctx, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWALAccesser_Txn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Txn'
+type MockWALAccesser_Txn_Call struct {
+	*mock.Call
+}
+
+// Txn is a helper method to define mock.On call
+//   - ctx context.Context
+//   - opts streaming.TxnOption
+func (_e *MockWALAccesser_Expecter) Txn(ctx interface{}, opts interface{}) *MockWALAccesser_Txn_Call {
+	return &MockWALAccesser_Txn_Call{Call: _e.mock.On("Txn", ctx, opts)}
+}
+
+func (_c *MockWALAccesser_Txn_Call) Run(run func(ctx context.Context, opts streaming.TxnOption)) *MockWALAccesser_Txn_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(streaming.TxnOption))
+	})
+	return _c
+}
+
+func (_c *MockWALAccesser_Txn_Call) Return(_a0 streaming.Txn, _a1 error) *MockWALAccesser_Txn_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWALAccesser_Txn_Call) RunAndReturn(run func(context.Context, streaming.TxnOption) (streaming.Txn, error)) *MockWALAccesser_Txn_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockWALAccesser creates a new instance of MockWALAccesser. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockWALAccesser(t interface {
@@ -88,6 +88,65 @@ func (_c *MockDataCoord_AbortImport_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// AcquireExportJob provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) AcquireExportJob(_a0 context.Context, _a1 *datapb.AcquireExportJobRequest) (*datapb.AcquireExportJobResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AcquireExportJob")
+	}
+
+	var r0 *datapb.AcquireExportJobResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.AcquireExportJobRequest) (*datapb.AcquireExportJobResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.AcquireExportJobRequest) *datapb.AcquireExportJobResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.AcquireExportJobResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.AcquireExportJobRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_AcquireExportJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AcquireExportJob'
+type MockDataCoord_AcquireExportJob_Call struct {
+	*mock.Call
+}
+
+// AcquireExportJob is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.AcquireExportJobRequest
+func (_e *MockDataCoord_Expecter) AcquireExportJob(_a0 interface{}, _a1 interface{}) *MockDataCoord_AcquireExportJob_Call {
+	return &MockDataCoord_AcquireExportJob_Call{Call: _e.mock.On("AcquireExportJob", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_AcquireExportJob_Call) Run(run func(_a0 context.Context, _a1 *datapb.AcquireExportJobRequest)) *MockDataCoord_AcquireExportJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.AcquireExportJobRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_AcquireExportJob_Call) Return(_a0 *datapb.AcquireExportJobResponse, _a1 error) *MockDataCoord_AcquireExportJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_AcquireExportJob_Call) RunAndReturn(run func(context.Context, *datapb.AcquireExportJobRequest) (*datapb.AcquireExportJobResponse, error)) *MockDataCoord_AcquireExportJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AllocSegment provides a mock function with given fields: _a0, _a1
 func (_m *MockDataCoord) AllocSegment(_a0 context.Context, _a1 *datapb.AllocSegmentRequest) (*datapb.AllocSegmentResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -383,6 +442,65 @@ func (_c *MockDataCoord_BroadcastAlteredCollection_Call) RunAndReturn(run func(c
 	return _c
 }
 
+// CancelExportJob provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) CancelExportJob(_a0 context.Context, _a1 *datapb.CancelExportJobRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelExportJob")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CancelExportJobRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CancelExportJobRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.CancelExportJobRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_CancelExportJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelExportJob'
+type MockDataCoord_CancelExportJob_Call struct {
+	*mock.Call
+}
+
+// CancelExportJob is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.CancelExportJobRequest
+func (_e *MockDataCoord_Expecter) CancelExportJob(_a0 interface{}, _a1 interface{}) *MockDataCoord_CancelExportJob_Call {
+	return &MockDataCoord_CancelExportJob_Call{Call: _e.mock.On("CancelExportJob", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_CancelExportJob_Call) Run(run func(_a0 context.Context, _a1 *datapb.CancelExportJobRequest)) *MockDataCoord_CancelExportJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.CancelExportJobRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_CancelExportJob_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoord_CancelExportJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_CancelExportJob_Call) RunAndReturn(run func(context.Context, *datapb.CancelExportJobRequest) (*commonpb.Status, error)) *MockDataCoord_CancelExportJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CheckHealth provides a mock function with given fields: _a0, _a1
 func (_m *MockDataCoord) CheckHealth(_a0 context.Context, _a1 *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -560,6 +678,65 @@ func (_c *MockDataCoord_CommitImport_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// CreateExportJob provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) CreateExportJob(_a0 context.Context, _a1 *datapb.CreateExportJobRequest) (*datapb.CreateExportJobResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateExportJob")
+	}
+
+	var r0 *datapb.CreateExportJobResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CreateExportJobRequest) (*datapb.CreateExportJobResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CreateExportJobRequest) *datapb.CreateExportJobResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.CreateExportJobResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.CreateExportJobRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_CreateExportJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateExportJob'
+type MockDataCoord_CreateExportJob_Call struct {
+	*mock.Call
+}
+
+// CreateExportJob is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.CreateExportJobRequest
+func (_e *MockDataCoord_Expecter) CreateExportJob(_a0 interface{}, _a1 interface{}) *MockDataCoord_CreateExportJob_Call {
+	return &MockDataCoord_CreateExportJob_Call{Call: _e.mock.On("CreateExportJob", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_CreateExportJob_Call) Run(run func(_a0 context.Context, _a1 *datapb.CreateExportJobRequest)) *MockDataCoord_CreateExportJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.CreateExportJobRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_CreateExportJob_Call) Return(_a0 *datapb.CreateExportJobResponse, _a1 error) *MockDataCoord_CreateExportJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_CreateExportJob_Call) RunAndReturn(run func(context.Context, *datapb.CreateExportJobRequest) (*datapb.CreateExportJobResponse, error)) *MockDataCoord_CreateExportJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateIndex provides a mock function with given fields: _a0, _a1
 func (_m *MockDataCoord) CreateIndex(_a0 context.Context, _a1 *indexpb.CreateIndexRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -1504,6 +1681,65 @@ func (_c *MockDataCoord_GetCompactionStateWithPlans_Call) RunAndReturn(run func(
 	return _c
 }
 
+// GetExportJob provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) GetExportJob(_a0 context.Context, _a1 *datapb.GetExportJobRequest) (*datapb.GetExportJobResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetExportJob")
+	}
+
+	var r0 *datapb.GetExportJobResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetExportJobRequest) (*datapb.GetExportJobResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetExportJobRequest) *datapb.GetExportJobResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.GetExportJobResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetExportJobRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_GetExportJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExportJob'
+type MockDataCoord_GetExportJob_Call struct {
+	*mock.Call
+}
+
+// GetExportJob is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.GetExportJobRequest
+func (_e *MockDataCoord_Expecter) GetExportJob(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetExportJob_Call {
+	return &MockDataCoord_GetExportJob_Call{Call: _e.mock.On("GetExportJob", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_GetExportJob_Call) Run(run func(_a0 context.Context, _a1 *datapb.GetExportJobRequest)) *MockDataCoord_GetExportJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.GetExportJobRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_GetExportJob_Call) Return(_a0 *datapb.GetExportJobResponse, _a1 error) *MockDataCoord_GetExportJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_GetExportJob_Call) RunAndReturn(run func(context.Context, *datapb.GetExportJobRequest) (*datapb.GetExportJobResponse, error)) *MockDataCoord_GetExportJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetExportSnapshotState provides a mock function with given fields: _a0, _a1
 func (_m *MockDataCoord) GetExportSnapshotState(_a0 context.Context, _a1 *datapb.GetExportSnapshotStateRequest) (*datapb.GetExportSnapshotStateResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -2906,6 +3142,65 @@ func (_c *MockDataCoord_Init_Call) RunAndReturn(run func() error) *MockDataCoord
 	return _c
 }
 
+// ListExportJobs provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) ListExportJobs(_a0 context.Context, _a1 *datapb.ListExportJobsRequest) (*datapb.ListExportJobsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListExportJobs")
+	}
+
+	var r0 *datapb.ListExportJobsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ListExportJobsRequest) (*datapb.ListExportJobsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ListExportJobsRequest) *datapb.ListExportJobsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.ListExportJobsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.ListExportJobsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_ListExportJobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListExportJobs'
+type MockDataCoord_ListExportJobs_Call struct {
+	*mock.Call
+}
+
+// ListExportJobs is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.ListExportJobsRequest
+func (_e *MockDataCoord_Expecter) ListExportJobs(_a0 interface{}, _a1 interface{}) *MockDataCoord_ListExportJobs_Call {
+	return &MockDataCoord_ListExportJobs_Call{Call: _e.mock.On("ListExportJobs", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_ListExportJobs_Call) Run(run func(_a0 context.Context, _a1 *datapb.ListExportJobsRequest)) *MockDataCoord_ListExportJobs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.ListExportJobsRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_ListExportJobs_Call) Return(_a0 *datapb.ListExportJobsResponse, _a1 error) *MockDataCoord_ListExportJobs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_ListExportJobs_Call) RunAndReturn(run func(context.Context, *datapb.ListExportJobsRequest) (*datapb.ListExportJobsResponse, error)) *MockDataCoord_ListExportJobs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListImports provides a mock function with given fields: _a0, _a1
 func (_m *MockDataCoord) ListImports(_a0 context.Context, _a1 *internalpb.ListImportsRequestInternal) (*internalpb.ListImportsResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -3541,6 +3836,65 @@ func (_c *MockDataCoord_ReportDataNodeTtMsgs_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// ReportExportJob provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) ReportExportJob(_a0 context.Context, _a1 *datapb.ReportExportJobRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReportExportJob")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ReportExportJobRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ReportExportJobRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.ReportExportJobRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_ReportExportJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReportExportJob'
+type MockDataCoord_ReportExportJob_Call struct {
+	*mock.Call
+}
+
+// ReportExportJob is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.ReportExportJobRequest
+func (_e *MockDataCoord_Expecter) ReportExportJob(_a0 interface{}, _a1 interface{}) *MockDataCoord_ReportExportJob_Call {
+	return &MockDataCoord_ReportExportJob_Call{Call: _e.mock.On("ReportExportJob", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_ReportExportJob_Call) Run(run func(_a0 context.Context, _a1 *datapb.ReportExportJobRequest)) *MockDataCoord_ReportExportJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.ReportExportJobRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_ReportExportJob_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoord_ReportExportJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_ReportExportJob_Call) RunAndReturn(run func(context.Context, *datapb.ReportExportJobRequest) (*commonpb.Status, error)) *MockDataCoord_ReportExportJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RestoreSnapshot provides a mock function with given fields: _a0, _a1
 func (_m *MockDataCoord) RestoreSnapshot(_a0 context.Context, _a1 *datapb.RestoreSnapshotRequest) (*datapb.RestoreSnapshotResponse, error) {
 	ret := _m.Called(_a0, _a1)
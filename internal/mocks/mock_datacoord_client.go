@@ -101,6 +101,80 @@ func (_c *MockDataCoordClient_AbortImport_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// AcquireExportJob provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) AcquireExportJob(ctx context.Context, in *datapb.AcquireExportJobRequest, opts ...grpc.CallOption) (*datapb.AcquireExportJobResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AcquireExportJob")
+	}
+
+	var r0 *datapb.AcquireExportJobResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.AcquireExportJobRequest, ...grpc.CallOption) (*datapb.AcquireExportJobResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.AcquireExportJobRequest, ...grpc.CallOption) *datapb.AcquireExportJobResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.AcquireExportJobResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.AcquireExportJobRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoordClient_AcquireExportJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AcquireExportJob'
+type MockDataCoordClient_AcquireExportJob_Call struct {
+	*mock.Call
+}
+
+// AcquireExportJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *datapb.AcquireExportJobRequest
+//   - opts ...grpc.CallOption
+func (_e *MockDataCoordClient_Expecter) AcquireExportJob(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_AcquireExportJob_Call {
+	return &MockDataCoordClient_AcquireExportJob_Call{Call: _e.mock.On("AcquireExportJob",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockDataCoordClient_AcquireExportJob_Call) Run(run func(ctx context.Context, in *datapb.AcquireExportJobRequest, opts ...grpc.CallOption)) *MockDataCoordClient_AcquireExportJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*datapb.AcquireExportJobRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDataCoordClient_AcquireExportJob_Call) Return(_a0 *datapb.AcquireExportJobResponse, _a1 error) *MockDataCoordClient_AcquireExportJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoordClient_AcquireExportJob_Call) RunAndReturn(run func(context.Context, *datapb.AcquireExportJobRequest, ...grpc.CallOption) (*datapb.AcquireExportJobResponse, error)) *MockDataCoordClient_AcquireExportJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AllocSegment provides a mock function with given fields: ctx, in, opts
 func (_m *MockDataCoordClient) AllocSegment(ctx context.Context, in *datapb.AllocSegmentRequest, opts ...grpc.CallOption) (*datapb.AllocSegmentResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -471,6 +545,80 @@ func (_c *MockDataCoordClient_BroadcastAlteredCollection_Call) RunAndReturn(run
 	return _c
 }
 
+// CancelExportJob provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) CancelExportJob(ctx context.Context, in *datapb.CancelExportJobRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelExportJob")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CancelExportJobRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CancelExportJobRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.CancelExportJobRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoordClient_CancelExportJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelExportJob'
+type MockDataCoordClient_CancelExportJob_Call struct {
+	*mock.Call
+}
+
+// CancelExportJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *datapb.CancelExportJobRequest
+//   - opts ...grpc.CallOption
+func (_e *MockDataCoordClient_Expecter) CancelExportJob(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_CancelExportJob_Call {
+	return &MockDataCoordClient_CancelExportJob_Call{Call: _e.mock.On("CancelExportJob",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockDataCoordClient_CancelExportJob_Call) Run(run func(ctx context.Context, in *datapb.CancelExportJobRequest, opts ...grpc.CallOption)) *MockDataCoordClient_CancelExportJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*datapb.CancelExportJobRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDataCoordClient_CancelExportJob_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoordClient_CancelExportJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoordClient_CancelExportJob_Call) RunAndReturn(run func(context.Context, *datapb.CancelExportJobRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockDataCoordClient_CancelExportJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CheckHealth provides a mock function with given fields: ctx, in, opts
 func (_m *MockDataCoordClient) CheckHealth(ctx context.Context, in *milvuspb.CheckHealthRequest, opts ...grpc.CallOption) (*milvuspb.CheckHealthResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -738,6 +886,80 @@ func (_c *MockDataCoordClient_CommitImport_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// CreateExportJob provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) CreateExportJob(ctx context.Context, in *datapb.CreateExportJobRequest, opts ...grpc.CallOption) (*datapb.CreateExportJobResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateExportJob")
+	}
+
+	var r0 *datapb.CreateExportJobResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CreateExportJobRequest, ...grpc.CallOption) (*datapb.CreateExportJobResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CreateExportJobRequest, ...grpc.CallOption) *datapb.CreateExportJobResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.CreateExportJobResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.CreateExportJobRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoordClient_CreateExportJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateExportJob'
+type MockDataCoordClient_CreateExportJob_Call struct {
+	*mock.Call
+}
+
+// CreateExportJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *datapb.CreateExportJobRequest
+//   - opts ...grpc.CallOption
+func (_e *MockDataCoordClient_Expecter) CreateExportJob(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_CreateExportJob_Call {
+	return &MockDataCoordClient_CreateExportJob_Call{Call: _e.mock.On("CreateExportJob",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockDataCoordClient_CreateExportJob_Call) Run(run func(ctx context.Context, in *datapb.CreateExportJobRequest, opts ...grpc.CallOption)) *MockDataCoordClient_CreateExportJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*datapb.CreateExportJobRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDataCoordClient_CreateExportJob_Call) Return(_a0 *datapb.CreateExportJobResponse, _a1 error) *MockDataCoordClient_CreateExportJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoordClient_CreateExportJob_Call) RunAndReturn(run func(context.Context, *datapb.CreateExportJobRequest, ...grpc.CallOption) (*datapb.CreateExportJobResponse, error)) *MockDataCoordClient_CreateExportJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateIndex provides a mock function with given fields: ctx, in, opts
 func (_m *MockDataCoordClient) CreateIndex(ctx context.Context, in *indexpb.CreateIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
@@ -1922,6 +2144,80 @@ func (_c *MockDataCoordClient_GetCompactionStateWithPlans_Call) RunAndReturn(run
 	return _c
 }
 
+// GetExportJob provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) GetExportJob(ctx context.Context, in *datapb.GetExportJobRequest, opts ...grpc.CallOption) (*datapb.GetExportJobResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetExportJob")
+	}
+
+	var r0 *datapb.GetExportJobResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetExportJobRequest, ...grpc.CallOption) (*datapb.GetExportJobResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetExportJobRequest, ...grpc.CallOption) *datapb.GetExportJobResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.GetExportJobResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetExportJobRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoordClient_GetExportJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExportJob'
+type MockDataCoordClient_GetExportJob_Call struct {
+	*mock.Call
+}
+
+// GetExportJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *datapb.GetExportJobRequest
+//   - opts ...grpc.CallOption
+func (_e *MockDataCoordClient_Expecter) GetExportJob(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_GetExportJob_Call {
+	return &MockDataCoordClient_GetExportJob_Call{Call: _e.mock.On("GetExportJob",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockDataCoordClient_GetExportJob_Call) Run(run func(ctx context.Context, in *datapb.GetExportJobRequest, opts ...grpc.CallOption)) *MockDataCoordClient_GetExportJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*datapb.GetExportJobRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDataCoordClient_GetExportJob_Call) Return(_a0 *datapb.GetExportJobResponse, _a1 error) *MockDataCoordClient_GetExportJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoordClient_GetExportJob_Call) RunAndReturn(run func(context.Context, *datapb.GetExportJobRequest, ...grpc.CallOption) (*datapb.GetExportJobResponse, error)) *MockDataCoordClient_GetExportJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetExportSnapshotState provides a mock function with given fields: ctx, in, opts
 func (_m *MockDataCoordClient) GetExportSnapshotState(ctx context.Context, in *datapb.GetExportSnapshotStateRequest, opts ...grpc.CallOption) (*datapb.GetExportSnapshotStateResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -3624,6 +3920,80 @@ func (_c *MockDataCoordClient_ImportV2_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// ListExportJobs provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) ListExportJobs(ctx context.Context, in *datapb.ListExportJobsRequest, opts ...grpc.CallOption) (*datapb.ListExportJobsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListExportJobs")
+	}
+
+	var r0 *datapb.ListExportJobsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ListExportJobsRequest, ...grpc.CallOption) (*datapb.ListExportJobsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ListExportJobsRequest, ...grpc.CallOption) *datapb.ListExportJobsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.ListExportJobsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.ListExportJobsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoordClient_ListExportJobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListExportJobs'
+type MockDataCoordClient_ListExportJobs_Call struct {
+	*mock.Call
+}
+
+// ListExportJobs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *datapb.ListExportJobsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockDataCoordClient_Expecter) ListExportJobs(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_ListExportJobs_Call {
+	return &MockDataCoordClient_ListExportJobs_Call{Call: _e.mock.On("ListExportJobs",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockDataCoordClient_ListExportJobs_Call) Run(run func(ctx context.Context, in *datapb.ListExportJobsRequest, opts ...grpc.CallOption)) *MockDataCoordClient_ListExportJobs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*datapb.ListExportJobsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDataCoordClient_ListExportJobs_Call) Return(_a0 *datapb.ListExportJobsResponse, _a1 error) *MockDataCoordClient_ListExportJobs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoordClient_ListExportJobs_Call) RunAndReturn(run func(context.Context, *datapb.ListExportJobsRequest, ...grpc.CallOption) (*datapb.ListExportJobsResponse, error)) *MockDataCoordClient_ListExportJobs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListImports provides a mock function with given fields: ctx, in, opts
 func (_m *MockDataCoordClient) ListImports(ctx context.Context, in *internalpb.ListImportsRequestInternal, opts ...grpc.CallOption) (*internalpb.ListImportsResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -4364,6 +4734,80 @@ func (_c *MockDataCoordClient_ReportDataNodeTtMsgs_Call) RunAndReturn(run func(c
 	return _c
 }
 
+// ReportExportJob provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) ReportExportJob(ctx context.Context, in *datapb.ReportExportJobRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReportExportJob")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ReportExportJobRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ReportExportJobRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.ReportExportJobRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoordClient_ReportExportJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReportExportJob'
+type MockDataCoordClient_ReportExportJob_Call struct {
+	*mock.Call
+}
+
+// ReportExportJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *datapb.ReportExportJobRequest
+//   - opts ...grpc.CallOption
+func (_e *MockDataCoordClient_Expecter) ReportExportJob(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_ReportExportJob_Call {
+	return &MockDataCoordClient_ReportExportJob_Call{Call: _e.mock.On("ReportExportJob",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockDataCoordClient_ReportExportJob_Call) Run(run func(ctx context.Context, in *datapb.ReportExportJobRequest, opts ...grpc.CallOption)) *MockDataCoordClient_ReportExportJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*datapb.ReportExportJobRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDataCoordClient_ReportExportJob_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoordClient_ReportExportJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoordClient_ReportExportJob_Call) RunAndReturn(run func(context.Context, *datapb.ReportExportJobRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockDataCoordClient_ReportExportJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RestoreSnapshot provides a mock function with given fields: ctx, in, opts
 func (_m *MockDataCoordClient) RestoreSnapshot(ctx context.Context, in *datapb.RestoreSnapshotRequest, opts ...grpc.CallOption) (*datapb.RestoreSnapshotResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -93,6 +93,65 @@ func (_c *MixCoord_AbortImport_Call) RunAndReturn(run func(context.Context, *dat
 	return _c
 }
 
+// AcquireExportJob provides a mock function with given fields: _a0, _a1
+func (_m *MixCoord) AcquireExportJob(_a0 context.Context, _a1 *datapb.AcquireExportJobRequest) (*datapb.AcquireExportJobResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AcquireExportJob")
+	}
+
+	var r0 *datapb.AcquireExportJobResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.AcquireExportJobRequest) (*datapb.AcquireExportJobResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.AcquireExportJobRequest) *datapb.AcquireExportJobResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.AcquireExportJobResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.AcquireExportJobRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MixCoord_AcquireExportJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AcquireExportJob'
+type MixCoord_AcquireExportJob_Call struct {
+	*mock.Call
+}
+
+// AcquireExportJob is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.AcquireExportJobRequest
+func (_e *MixCoord_Expecter) AcquireExportJob(_a0 interface{}, _a1 interface{}) *MixCoord_AcquireExportJob_Call {
+	return &MixCoord_AcquireExportJob_Call{Call: _e.mock.On("AcquireExportJob", _a0, _a1)}
+}
+
+func (_c *MixCoord_AcquireExportJob_Call) Run(run func(_a0 context.Context, _a1 *datapb.AcquireExportJobRequest)) *MixCoord_AcquireExportJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.AcquireExportJobRequest))
+	})
+	return _c
+}
+
+func (_c *MixCoord_AcquireExportJob_Call) Return(_a0 *datapb.AcquireExportJobResponse, _a1 error) *MixCoord_AcquireExportJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MixCoord_AcquireExportJob_Call) RunAndReturn(run func(context.Context, *datapb.AcquireExportJobRequest) (*datapb.AcquireExportJobResponse, error)) *MixCoord_AcquireExportJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ActivateChecker provides a mock function with given fields: _a0, _a1
 func (_m *MixCoord) ActivateChecker(_a0 context.Context, _a1 *querypb.ActivateCheckerRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -1332,6 +1391,65 @@ func (_c *MixCoord_BroadcastAlteredCollection_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// CancelExportJob provides a mock function with given fields: _a0, _a1
+func (_m *MixCoord) CancelExportJob(_a0 context.Context, _a1 *datapb.CancelExportJobRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelExportJob")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CancelExportJobRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CancelExportJobRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.CancelExportJobRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MixCoord_CancelExportJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelExportJob'
+type MixCoord_CancelExportJob_Call struct {
+	*mock.Call
+}
+
+// CancelExportJob is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.CancelExportJobRequest
+func (_e *MixCoord_Expecter) CancelExportJob(_a0 interface{}, _a1 interface{}) *MixCoord_CancelExportJob_Call {
+	return &MixCoord_CancelExportJob_Call{Call: _e.mock.On("CancelExportJob", _a0, _a1)}
+}
+
+func (_c *MixCoord_CancelExportJob_Call) Run(run func(_a0 context.Context, _a1 *datapb.CancelExportJobRequest)) *MixCoord_CancelExportJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.CancelExportJobRequest))
+	})
+	return _c
+}
+
+func (_c *MixCoord_CancelExportJob_Call) Return(_a0 *commonpb.Status, _a1 error) *MixCoord_CancelExportJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MixCoord_CancelExportJob_Call) RunAndReturn(run func(context.Context, *datapb.CancelExportJobRequest) (*commonpb.Status, error)) *MixCoord_CancelExportJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CheckBalanceStatus provides a mock function with given fields: _a0, _a1
 func (_m *MixCoord) CheckBalanceStatus(_a0 context.Context, _a1 *querypb.CheckBalanceStatusRequest) (*querypb.CheckBalanceStatusResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -2040,6 +2158,65 @@ func (_c *MixCoord_CreateDatabase_Call) RunAndReturn(run func(context.Context, *
 	return _c
 }
 
+// CreateExportJob provides a mock function with given fields: _a0, _a1
+func (_m *MixCoord) CreateExportJob(_a0 context.Context, _a1 *datapb.CreateExportJobRequest) (*datapb.CreateExportJobResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateExportJob")
+	}
+
+	var r0 *datapb.CreateExportJobResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CreateExportJobRequest) (*datapb.CreateExportJobResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CreateExportJobRequest) *datapb.CreateExportJobResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.CreateExportJobResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.CreateExportJobRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MixCoord_CreateExportJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateExportJob'
+type MixCoord_CreateExportJob_Call struct {
+	*mock.Call
+}
+
+// CreateExportJob is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.CreateExportJobRequest
+func (_e *MixCoord_Expecter) CreateExportJob(_a0 interface{}, _a1 interface{}) *MixCoord_CreateExportJob_Call {
+	return &MixCoord_CreateExportJob_Call{Call: _e.mock.On("CreateExportJob", _a0, _a1)}
+}
+
+func (_c *MixCoord_CreateExportJob_Call) Run(run func(_a0 context.Context, _a1 *datapb.CreateExportJobRequest)) *MixCoord_CreateExportJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.CreateExportJobRequest))
+	})
+	return _c
+}
+
+func (_c *MixCoord_CreateExportJob_Call) Return(_a0 *datapb.CreateExportJobResponse, _a1 error) *MixCoord_CreateExportJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MixCoord_CreateExportJob_Call) RunAndReturn(run func(context.Context, *datapb.CreateExportJobRequest) (*datapb.CreateExportJobResponse, error)) *MixCoord_CreateExportJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateIndex provides a mock function with given fields: _a0, _a1
 func (_m *MixCoord) CreateIndex(_a0 context.Context, _a1 *indexpb.CreateIndexRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -4566,6 +4743,65 @@ func (_c *MixCoord_GetDcMetrics_Call) RunAndReturn(run func(context.Context, *mi
 	return _c
 }
 
+// GetExportJob provides a mock function with given fields: _a0, _a1
+func (_m *MixCoord) GetExportJob(_a0 context.Context, _a1 *datapb.GetExportJobRequest) (*datapb.GetExportJobResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetExportJob")
+	}
+
+	var r0 *datapb.GetExportJobResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetExportJobRequest) (*datapb.GetExportJobResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetExportJobRequest) *datapb.GetExportJobResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.GetExportJobResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetExportJobRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MixCoord_GetExportJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExportJob'
+type MixCoord_GetExportJob_Call struct {
+	*mock.Call
+}
+
+// GetExportJob is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.GetExportJobRequest
+func (_e *MixCoord_Expecter) GetExportJob(_a0 interface{}, _a1 interface{}) *MixCoord_GetExportJob_Call {
+	return &MixCoord_GetExportJob_Call{Call: _e.mock.On("GetExportJob", _a0, _a1)}
+}
+
+func (_c *MixCoord_GetExportJob_Call) Run(run func(_a0 context.Context, _a1 *datapb.GetExportJobRequest)) *MixCoord_GetExportJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.GetExportJobRequest))
+	})
+	return _c
+}
+
+func (_c *MixCoord_GetExportJob_Call) Return(_a0 *datapb.GetExportJobResponse, _a1 error) *MixCoord_GetExportJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MixCoord_GetExportJob_Call) RunAndReturn(run func(context.Context, *datapb.GetExportJobRequest) (*datapb.GetExportJobResponse, error)) *MixCoord_GetExportJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetExportSnapshotState provides a mock function with given fields: _a0, _a1
 func (_m *MixCoord) GetExportSnapshotState(_a0 context.Context, _a1 *datapb.GetExportSnapshotStateRequest) (*datapb.GetExportSnapshotStateResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -7194,6 +7430,65 @@ func (_c *MixCoord_ListDatabases_Call) RunAndReturn(run func(context.Context, *m
 	return _c
 }
 
+// ListExportJobs provides a mock function with given fields: _a0, _a1
+func (_m *MixCoord) ListExportJobs(_a0 context.Context, _a1 *datapb.ListExportJobsRequest) (*datapb.ListExportJobsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListExportJobs")
+	}
+
+	var r0 *datapb.ListExportJobsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ListExportJobsRequest) (*datapb.ListExportJobsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ListExportJobsRequest) *datapb.ListExportJobsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.ListExportJobsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.ListExportJobsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MixCoord_ListExportJobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListExportJobs'
+type MixCoord_ListExportJobs_Call struct {
+	*mock.Call
+}
+
+// ListExportJobs is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.ListExportJobsRequest
+func (_e *MixCoord_Expecter) ListExportJobs(_a0 interface{}, _a1 interface{}) *MixCoord_ListExportJobs_Call {
+	return &MixCoord_ListExportJobs_Call{Call: _e.mock.On("ListExportJobs", _a0, _a1)}
+}
+
+func (_c *MixCoord_ListExportJobs_Call) Run(run func(_a0 context.Context, _a1 *datapb.ListExportJobsRequest)) *MixCoord_ListExportJobs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.ListExportJobsRequest))
+	})
+	return _c
+}
+
+func (_c *MixCoord_ListExportJobs_Call) Return(_a0 *datapb.ListExportJobsResponse, _a1 error) *MixCoord_ListExportJobs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MixCoord_ListExportJobs_Call) RunAndReturn(run func(context.Context, *datapb.ListExportJobsRequest) (*datapb.ListExportJobsResponse, error)) *MixCoord_ListExportJobs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListFileResources provides a mock function with given fields: _a0, _a1
 func (_m *MixCoord) ListFileResources(_a0 context.Context, _a1 *milvuspb.ListFileResourcesRequest) (*milvuspb.ListFileResourcesResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -8960,6 +9255,65 @@ func (_c *MixCoord_ReportDataNodeTtMsgs_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// ReportExportJob provides a mock function with given fields: _a0, _a1
+func (_m *MixCoord) ReportExportJob(_a0 context.Context, _a1 *datapb.ReportExportJobRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReportExportJob")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ReportExportJobRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ReportExportJobRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.ReportExportJobRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MixCoord_ReportExportJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReportExportJob'
+type MixCoord_ReportExportJob_Call struct {
+	*mock.Call
+}
+
+// ReportExportJob is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.ReportExportJobRequest
+func (_e *MixCoord_Expecter) ReportExportJob(_a0 interface{}, _a1 interface{}) *MixCoord_ReportExportJob_Call {
+	return &MixCoord_ReportExportJob_Call{Call: _e.mock.On("ReportExportJob", _a0, _a1)}
+}
+
+func (_c *MixCoord_ReportExportJob_Call) Run(run func(_a0 context.Context, _a1 *datapb.ReportExportJobRequest)) *MixCoord_ReportExportJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.ReportExportJobRequest))
+	})
+	return _c
+}
+
+func (_c *MixCoord_ReportExportJob_Call) Return(_a0 *commonpb.Status, _a1 error) *MixCoord_ReportExportJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MixCoord_ReportExportJob_Call) RunAndReturn(run func(context.Context, *datapb.ReportExportJobRequest) (*commonpb.Status, error)) *MixCoord_ReportExportJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RestoreRBAC provides a mock function with given fields: _a0, _a1
 func (_m *MixCoord) RestoreRBAC(_a0 context.Context, _a1 *milvuspb.RestoreRBACMetaRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -104,6 +104,80 @@ func (_c *MockMixCoordClient_AbortImport_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// AcquireExportJob provides a mock function with given fields: ctx, in, opts
+func (_m *MockMixCoordClient) AcquireExportJob(ctx context.Context, in *datapb.AcquireExportJobRequest, opts ...grpc.CallOption) (*datapb.AcquireExportJobResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AcquireExportJob")
+	}
+
+	var r0 *datapb.AcquireExportJobResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.AcquireExportJobRequest, ...grpc.CallOption) (*datapb.AcquireExportJobResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.AcquireExportJobRequest, ...grpc.CallOption) *datapb.AcquireExportJobResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.AcquireExportJobResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.AcquireExportJobRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMixCoordClient_AcquireExportJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AcquireExportJob'
+type MockMixCoordClient_AcquireExportJob_Call struct {
+	*mock.Call
+}
+
+// AcquireExportJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *datapb.AcquireExportJobRequest
+//   - opts ...grpc.CallOption
+func (_e *MockMixCoordClient_Expecter) AcquireExportJob(ctx interface{}, in interface{}, opts ...interface{}) *MockMixCoordClient_AcquireExportJob_Call {
+	return &MockMixCoordClient_AcquireExportJob_Call{Call: _e.mock.On("AcquireExportJob",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockMixCoordClient_AcquireExportJob_Call) Run(run func(ctx context.Context, in *datapb.AcquireExportJobRequest, opts ...grpc.CallOption)) *MockMixCoordClient_AcquireExportJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*datapb.AcquireExportJobRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockMixCoordClient_AcquireExportJob_Call) Return(_a0 *datapb.AcquireExportJobResponse, _a1 error) *MockMixCoordClient_AcquireExportJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMixCoordClient_AcquireExportJob_Call) RunAndReturn(run func(context.Context, *datapb.AcquireExportJobRequest, ...grpc.CallOption) (*datapb.AcquireExportJobResponse, error)) *MockMixCoordClient_AcquireExportJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ActivateChecker provides a mock function with given fields: ctx, in, opts
 func (_m *MockMixCoordClient) ActivateChecker(ctx context.Context, in *querypb.ActivateCheckerRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
@@ -1658,6 +1732,80 @@ func (_c *MockMixCoordClient_BroadcastAlteredCollection_Call) RunAndReturn(run f
 	return _c
 }
 
+// CancelExportJob provides a mock function with given fields: ctx, in, opts
+func (_m *MockMixCoordClient) CancelExportJob(ctx context.Context, in *datapb.CancelExportJobRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelExportJob")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CancelExportJobRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CancelExportJobRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.CancelExportJobRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMixCoordClient_CancelExportJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelExportJob'
+type MockMixCoordClient_CancelExportJob_Call struct {
+	*mock.Call
+}
+
+// CancelExportJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *datapb.CancelExportJobRequest
+//   - opts ...grpc.CallOption
+func (_e *MockMixCoordClient_Expecter) CancelExportJob(ctx interface{}, in interface{}, opts ...interface{}) *MockMixCoordClient_CancelExportJob_Call {
+	return &MockMixCoordClient_CancelExportJob_Call{Call: _e.mock.On("CancelExportJob",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockMixCoordClient_CancelExportJob_Call) Run(run func(ctx context.Context, in *datapb.CancelExportJobRequest, opts ...grpc.CallOption)) *MockMixCoordClient_CancelExportJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*datapb.CancelExportJobRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockMixCoordClient_CancelExportJob_Call) Return(_a0 *commonpb.Status, _a1 error) *MockMixCoordClient_CancelExportJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMixCoordClient_CancelExportJob_Call) RunAndReturn(run func(context.Context, *datapb.CancelExportJobRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockMixCoordClient_CancelExportJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CheckBalanceStatus provides a mock function with given fields: ctx, in, opts
 func (_m *MockMixCoordClient) CheckBalanceStatus(ctx context.Context, in *querypb.CheckBalanceStatusRequest, opts ...grpc.CallOption) (*querypb.CheckBalanceStatusResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -2591,6 +2739,80 @@ func (_c *MockMixCoordClient_CreateDatabase_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// CreateExportJob provides a mock function with given fields: ctx, in, opts
+func (_m *MockMixCoordClient) CreateExportJob(ctx context.Context, in *datapb.CreateExportJobRequest, opts ...grpc.CallOption) (*datapb.CreateExportJobResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateExportJob")
+	}
+
+	var r0 *datapb.CreateExportJobResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CreateExportJobRequest, ...grpc.CallOption) (*datapb.CreateExportJobResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CreateExportJobRequest, ...grpc.CallOption) *datapb.CreateExportJobResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.CreateExportJobResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.CreateExportJobRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMixCoordClient_CreateExportJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateExportJob'
+type MockMixCoordClient_CreateExportJob_Call struct {
+	*mock.Call
+}
+
+// CreateExportJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *datapb.CreateExportJobRequest
+//   - opts ...grpc.CallOption
+func (_e *MockMixCoordClient_Expecter) CreateExportJob(ctx interface{}, in interface{}, opts ...interface{}) *MockMixCoordClient_CreateExportJob_Call {
+	return &MockMixCoordClient_CreateExportJob_Call{Call: _e.mock.On("CreateExportJob",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockMixCoordClient_CreateExportJob_Call) Run(run func(ctx context.Context, in *datapb.CreateExportJobRequest, opts ...grpc.CallOption)) *MockMixCoordClient_CreateExportJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*datapb.CreateExportJobRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockMixCoordClient_CreateExportJob_Call) Return(_a0 *datapb.CreateExportJobResponse, _a1 error) *MockMixCoordClient_CreateExportJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMixCoordClient_CreateExportJob_Call) RunAndReturn(run func(context.Context, *datapb.CreateExportJobRequest, ...grpc.CallOption) (*datapb.CreateExportJobResponse, error)) *MockMixCoordClient_CreateExportJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateIndex provides a mock function with given fields: ctx, in, opts
 func (_m *MockMixCoordClient) CreateIndex(ctx context.Context, in *indexpb.CreateIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
@@ -5551,6 +5773,80 @@ func (_c *MockMixCoordClient_GetCredential_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// GetExportJob provides a mock function with given fields: ctx, in, opts
+func (_m *MockMixCoordClient) GetExportJob(ctx context.Context, in *datapb.GetExportJobRequest, opts ...grpc.CallOption) (*datapb.GetExportJobResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetExportJob")
+	}
+
+	var r0 *datapb.GetExportJobResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetExportJobRequest, ...grpc.CallOption) (*datapb.GetExportJobResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetExportJobRequest, ...grpc.CallOption) *datapb.GetExportJobResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.GetExportJobResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetExportJobRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMixCoordClient_GetExportJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExportJob'
+type MockMixCoordClient_GetExportJob_Call struct {
+	*mock.Call
+}
+
+// GetExportJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *datapb.GetExportJobRequest
+//   - opts ...grpc.CallOption
+func (_e *MockMixCoordClient_Expecter) GetExportJob(ctx interface{}, in interface{}, opts ...interface{}) *MockMixCoordClient_GetExportJob_Call {
+	return &MockMixCoordClient_GetExportJob_Call{Call: _e.mock.On("GetExportJob",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockMixCoordClient_GetExportJob_Call) Run(run func(ctx context.Context, in *datapb.GetExportJobRequest, opts ...grpc.CallOption)) *MockMixCoordClient_GetExportJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*datapb.GetExportJobRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockMixCoordClient_GetExportJob_Call) Return(_a0 *datapb.GetExportJobResponse, _a1 error) *MockMixCoordClient_GetExportJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMixCoordClient_GetExportJob_Call) RunAndReturn(run func(context.Context, *datapb.GetExportJobRequest, ...grpc.CallOption) (*datapb.GetExportJobResponse, error)) *MockMixCoordClient_GetExportJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetExportSnapshotState provides a mock function with given fields: ctx, in, opts
 func (_m *MockMixCoordClient) GetExportSnapshotState(ctx context.Context, in *datapb.GetExportSnapshotStateRequest, opts ...grpc.CallOption) (*datapb.GetExportSnapshotStateResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -8511,6 +8807,80 @@ func (_c *MockMixCoordClient_ListDatabases_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// ListExportJobs provides a mock function with given fields: ctx, in, opts
+func (_m *MockMixCoordClient) ListExportJobs(ctx context.Context, in *datapb.ListExportJobsRequest, opts ...grpc.CallOption) (*datapb.ListExportJobsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListExportJobs")
+	}
+
+	var r0 *datapb.ListExportJobsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ListExportJobsRequest, ...grpc.CallOption) (*datapb.ListExportJobsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ListExportJobsRequest, ...grpc.CallOption) *datapb.ListExportJobsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.ListExportJobsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.ListExportJobsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMixCoordClient_ListExportJobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListExportJobs'
+type MockMixCoordClient_ListExportJobs_Call struct {
+	*mock.Call
+}
+
+// ListExportJobs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *datapb.ListExportJobsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockMixCoordClient_Expecter) ListExportJobs(ctx interface{}, in interface{}, opts ...interface{}) *MockMixCoordClient_ListExportJobs_Call {
+	return &MockMixCoordClient_ListExportJobs_Call{Call: _e.mock.On("ListExportJobs",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockMixCoordClient_ListExportJobs_Call) Run(run func(ctx context.Context, in *datapb.ListExportJobsRequest, opts ...grpc.CallOption)) *MockMixCoordClient_ListExportJobs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*datapb.ListExportJobsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockMixCoordClient_ListExportJobs_Call) Return(_a0 *datapb.ListExportJobsResponse, _a1 error) *MockMixCoordClient_ListExportJobs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMixCoordClient_ListExportJobs_Call) RunAndReturn(run func(context.Context, *datapb.ListExportJobsRequest, ...grpc.CallOption) (*datapb.ListExportJobsResponse, error)) *MockMixCoordClient_ListExportJobs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListFileResources provides a mock function with given fields: ctx, in, opts
 func (_m *MockMixCoordClient) ListFileResources(ctx context.Context, in *milvuspb.ListFileResourcesRequest, opts ...grpc.CallOption) (*milvuspb.ListFileResourcesResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -10509,6 +10879,80 @@ func (_c *MockMixCoordClient_ReportDataNodeTtMsgs_Call) RunAndReturn(run func(co
 	return _c
 }
 
+// ReportExportJob provides a mock function with given fields: ctx, in, opts
+func (_m *MockMixCoordClient) ReportExportJob(ctx context.Context, in *datapb.ReportExportJobRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReportExportJob")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ReportExportJobRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ReportExportJobRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.ReportExportJobRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMixCoordClient_ReportExportJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReportExportJob'
+type MockMixCoordClient_ReportExportJob_Call struct {
+	*mock.Call
+}
+
+// ReportExportJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *datapb.ReportExportJobRequest
+//   - opts ...grpc.CallOption
+func (_e *MockMixCoordClient_Expecter) ReportExportJob(ctx interface{}, in interface{}, opts ...interface{}) *MockMixCoordClient_ReportExportJob_Call {
+	return &MockMixCoordClient_ReportExportJob_Call{Call: _e.mock.On("ReportExportJob",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockMixCoordClient_ReportExportJob_Call) Run(run func(ctx context.Context, in *datapb.ReportExportJobRequest, opts ...grpc.CallOption)) *MockMixCoordClient_ReportExportJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*datapb.ReportExportJobRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockMixCoordClient_ReportExportJob_Call) Return(_a0 *commonpb.Status, _a1 error) *MockMixCoordClient_ReportExportJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMixCoordClient_ReportExportJob_Call) RunAndReturn(run func(context.Context, *datapb.ReportExportJobRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockMixCoordClient_ReportExportJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RestoreRBAC provides a mock function with given fields: ctx, in, opts
 func (_m *MockMixCoordClient) RestoreRBAC(ctx context.Context, in *milvuspb.RestoreRBACMetaRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
@@ -737,6 +737,80 @@ func (_c *MockQueryNodeClient_GetSegmentInfo_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// GetShardAppliedVersion provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryNodeClient) GetShardAppliedVersion(ctx context.Context, in *querypb.GetShardAppliedVersionRequest, opts ...grpc.CallOption) (*querypb.GetShardAppliedVersionResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetShardAppliedVersion")
+	}
+
+	var r0 *querypb.GetShardAppliedVersionResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetShardAppliedVersionRequest, ...grpc.CallOption) (*querypb.GetShardAppliedVersionResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetShardAppliedVersionRequest, ...grpc.CallOption) *querypb.GetShardAppliedVersionResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.GetShardAppliedVersionResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.GetShardAppliedVersionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryNodeClient_GetShardAppliedVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetShardAppliedVersion'
+type MockQueryNodeClient_GetShardAppliedVersion_Call struct {
+	*mock.Call
+}
+
+// GetShardAppliedVersion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *querypb.GetShardAppliedVersionRequest
+//   - opts ...grpc.CallOption
+func (_e *MockQueryNodeClient_Expecter) GetShardAppliedVersion(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryNodeClient_GetShardAppliedVersion_Call {
+	return &MockQueryNodeClient_GetShardAppliedVersion_Call{Call: _e.mock.On("GetShardAppliedVersion",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockQueryNodeClient_GetShardAppliedVersion_Call) Run(run func(ctx context.Context, in *querypb.GetShardAppliedVersionRequest, opts ...grpc.CallOption)) *MockQueryNodeClient_GetShardAppliedVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*querypb.GetShardAppliedVersionRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockQueryNodeClient_GetShardAppliedVersion_Call) Return(_a0 *querypb.GetShardAppliedVersionResponse, _a1 error) *MockQueryNodeClient_GetShardAppliedVersion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryNodeClient_GetShardAppliedVersion_Call) RunAndReturn(run func(context.Context, *querypb.GetShardAppliedVersionRequest, ...grpc.CallOption) (*querypb.GetShardAppliedVersionResponse, error)) *MockQueryNodeClient_GetShardAppliedVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetStatistics provides a mock function with given fields: ctx, in, opts
 func (_m *MockQueryNodeClient) GetStatistics(ctx context.Context, in *querypb.GetStatisticsRequest, opts ...grpc.CallOption) (*internalpb.GetStatisticsResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -0,0 +1,110 @@
+package planparserv2
+
+import (
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/schemapb"
+	"github.com/milvus-io/milvus/internal/parser/planparserv2/rewriter"
+	"github.com/milvus-io/milvus/pkg/v3/proto/planpb"
+	"github.com/milvus-io/milvus/pkg/v3/util/typeutil"
+)
+
+// ExprExplain describes how a filter expression is parsed and rewritten.
+type ExprExplain struct {
+	Expr string `json:"expr"`
+	// Parsed and Rewritten are the ShowExprVisitor renderings before and after the rewriter.
+	Parsed      interface{} `json:"parsed"`
+	Rewritten   interface{} `json:"rewritten"`
+	IsRewritten bool        `json:"is_rewritten"`
+	// Text is the text format of the rewritten expression, it covers the expressions
+	// ShowExprVisitor doesn't render.
+	Text string `json:"text"`
+}
+
+// ExplainExpr parses the expression and reports the effect of the rewriter on it.
+func ExplainExpr(schema *typeutil.SchemaHelper, exprStr string, exprTemplateValues map[string]*schemapb.TemplateValue, visitorArgs *ParserVisitorArgs) (*ExprExplain, error) {
+	parsed, err := parseExprWithoutRewrite(schema, exprStr, exprTemplateValues, visitorArgs)
+	if err != nil {
+		return nil, err
+	}
+	// the rewriter may modify the expression in place.
+	rewritten := rewriter.RewriteExpr(proto.Clone(parsed).(*planpb.Expr))
+
+	v := NewShowExprVisitor()
+	return &ExprExplain{
+		Expr:        exprStr,
+		Parsed:      v.VisitExpr(parsed),
+		Rewritten:   v.VisitExpr(rewritten),
+		IsRewritten: !proto.Equal(parsed, rewritten),
+		Text:        prototext.MarshalOptions{}.Format(rewritten),
+	}, nil
+}
+
+// ExplainPlan renders the plan node in the same json-friendly form as ShowExprVisitor.
+func ExplainPlan(plan *planpb.PlanNode) map[string]interface{} {
+	v := NewShowExprVisitor()
+	showPredicates := func(expr *planpb.Expr) interface{} {
+		if expr == nil {
+			return nil
+		}
+		return v.VisitExpr(expr)
+	}
+
+	js := make(map[string]interface{})
+	switch node := plan.GetNode().(type) {
+	case *planpb.PlanNode_VectorAnns:
+		queryInfo := node.VectorAnns.GetQueryInfo()
+		js["node"] = "vector_anns"
+		js["field_id"] = node.VectorAnns.GetFieldId()
+		js["vector_type"] = node.VectorAnns.GetVectorType().String()
+		js["topk"] = queryInfo.GetTopk()
+		js["metric_type"] = queryInfo.GetMetricType()
+		js["search_params"] = queryInfo.GetSearchParams()
+		js["round_decimal"] = queryInfo.GetRoundDecimal()
+		if groupByFieldIDs := queryInfo.GetGroupByFieldIds(); len(groupByFieldIDs) > 0 {
+			js["group_by_field_ids"] = groupByFieldIDs
+			js["group_size"] = queryInfo.GetGroupSize()
+		} else if queryInfo.GetGroupByFieldId() > 0 {
+			js["group_by_field_ids"] = []int64{queryInfo.GetGroupByFieldId()}
+			js["group_size"] = queryInfo.GetGroupSize()
+		}
+		js["predicates"] = showPredicates(node.VectorAnns.GetPredicates())
+	case *planpb.PlanNode_Query:
+		js["node"] = "query"
+		js["is_count"] = node.Query.GetIsCount()
+		js["limit"] = node.Query.GetLimit()
+		if groupByFieldIDs := node.Query.GetGroupByFieldIds(); len(groupByFieldIDs) > 0 {
+			js["group_by_field_ids"] = groupByFieldIDs
+		}
+		if aggregates := node.Query.GetAggregates(); len(aggregates) > 0 {
+			aggs := make([]interface{}, 0, len(aggregates))
+			for _, aggregate := range aggregates {
+				aggs = append(aggs, map[string]interface{}{
+					"op":       aggregate.GetOp().String(),
+					"field_id": aggregate.GetFieldId(),
+				})
+			}
+			js["aggregates"] = aggs
+		}
+		if orderByFields := node.Query.GetOrderByFields(); len(orderByFields) > 0 {
+			orderBy := make([]interface{}, 0, len(orderByFields))
+			for _, field := range orderByFields {
+				orderBy = append(orderBy, map[string]interface{}{
+					"field_id":  field.GetFieldId(),
+					"ascending": field.GetAscending(),
+				})
+			}
+			js["order_by"] = orderBy
+		}
+		js["predicates"] = showPredicates(node.Query.GetPredicates())
+	case *planpb.PlanNode_Predicates:
+		js["node"] = "predicates"
+		js["predicates"] = showPredicates(node.Predicates)
+	}
+	js["output_field_ids"] = plan.GetOutputFieldIds()
+	if len(plan.GetDynamicFields()) > 0 {
+		js["dynamic_fields"] = plan.GetDynamicFields()
+	}
+	return js
+}
@@ -0,0 +1,81 @@
+package planparserv2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/pkg/v3/proto/planpb"
+)
+
+func TestExplainExpr(t *testing.T) {
+	helper := newTestSchemaHelper(t)
+
+	t.Run("rewritten", func(t *testing.T) {
+		exprStr := `Int64Field == 1 or Int64Field == 2`
+		explain, err := ExplainExpr(helper, exprStr, nil, &ParserVisitorArgs{})
+		require.NoError(t, err)
+		assert.Equal(t, exprStr, explain.Expr)
+		assert.True(t, explain.IsRewritten)
+		assert.NotEqual(t, explain.Parsed, explain.Rewritten)
+		assert.NotEmpty(t, explain.Text)
+
+		// the rewritten rendering is the one of the expression used by the plan
+		expr, err := ParseExpr(helper, exprStr, nil)
+		require.NoError(t, err)
+		assert.Equal(t, NewShowExprVisitor().VisitExpr(expr), explain.Rewritten)
+	})
+
+	t.Run("not rewritten", func(t *testing.T) {
+		explain, err := ExplainExpr(helper, `Int64Field > 1`, nil, &ParserVisitorArgs{})
+		require.NoError(t, err)
+		assert.False(t, explain.IsRewritten)
+		assert.Equal(t, explain.Parsed, explain.Rewritten)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := ExplainExpr(helper, `NotExistField > 1`, nil, &ParserVisitorArgs{})
+		assert.Error(t, err)
+	})
+}
+
+func TestExplainPlan(t *testing.T) {
+	helper := newTestSchemaHelper(t)
+
+	t.Run("search", func(t *testing.T) {
+		plan, err := CreateSearchPlan(helper, `Int64Field > 1`, "FloatVectorField", &planpb.QueryInfo{
+			Topk:         10,
+			MetricType:   "L2",
+			SearchParams: `{"nprobe": 8}`,
+		}, nil, nil)
+		require.NoError(t, err)
+
+		js := ExplainPlan(plan)
+		assert.Equal(t, "vector_anns", js["node"])
+		assert.Equal(t, int64(10), js["topk"])
+		assert.Equal(t, "L2", js["metric_type"])
+		assert.Equal(t, `{"nprobe": 8}`, js["search_params"])
+		assert.Equal(t, NewShowExprVisitor().VisitExpr(plan.GetVectorAnns().GetPredicates()), js["predicates"])
+	})
+
+	t.Run("query", func(t *testing.T) {
+		plan, err := CreateRetrievePlan(helper, `Int64Field > 1`, nil)
+		require.NoError(t, err)
+		plan.GetQuery().Limit = 100
+
+		js := ExplainPlan(plan)
+		assert.Equal(t, "query", js["node"])
+		assert.Equal(t, int64(100), js["limit"])
+		assert.Equal(t, false, js["is_count"])
+		assert.NotNil(t, js["predicates"])
+	})
+
+	t.Run("no predicates", func(t *testing.T) {
+		js := ExplainPlan(&planpb.PlanNode{
+			Node: &planpb.PlanNode_Query{Query: &planpb.QueryPlanNode{IsCount: true}},
+		})
+		assert.Equal(t, true, js["is_count"])
+		assert.Nil(t, js["predicates"])
+	})
+}
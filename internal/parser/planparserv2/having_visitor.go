@@ -0,0 +1,247 @@
+package planparserv2
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+
+	parser "github.com/milvus-io/milvus/internal/parser/planparserv2/generated"
+	"github.com/milvus-io/milvus/pkg/v3/proto/planpb"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+)
+
+// HavingNodeKind is the kind of a node of the HAVING predicate.
+type HavingNodeKind int
+
+const (
+	HavingAnd HavingNodeKind = iota
+	HavingOr
+	HavingNot
+	HavingCompare
+)
+
+// HavingNode is a node of the HAVING predicate of an aggregation query, the predicate
+// is evaluated on the grouped rows after the aggregation, see agg.HavingExpr.
+type HavingNode struct {
+	Kind HavingNodeKind
+	// Children are the operands of HavingAnd, HavingOr and HavingNot.
+	Children []*HavingNode
+	// CompareOp, Left and Right are set for HavingCompare.
+	CompareOp   planpb.OpType
+	Left, Right *HavingOperand
+}
+
+// HavingOperand is a column or a literal compared in the HAVING predicate.
+type HavingOperand struct {
+	// Column is the group by column or the aggregate expression, e.g. count(*),
+	// empty for a literal.
+	Column string
+	// Value is the literal, which is an int64, float64, string or bool.
+	Value any
+}
+
+// ParseHavingExpr parses the HAVING predicate with the grammar of the filter expressions,
+// e.g. "count(*) > 10 and avg(price) >= 9.9". The operands are group by columns, aggregate
+// expressions and literals, compared by the relational and equality operators and combined
+// by the logical ones.
+func ParseHavingExpr(exprStr string) (node *HavingNode, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			node, err = nil, merr.WrapErrParameterInvalidMsg("unsupported HAVING expression: %s", exprStr)
+		}
+	}()
+
+	if strings.TrimSpace(exprStr) == "" {
+		return nil, merr.WrapErrParameterInvalidMsg("HAVING expression is empty")
+	}
+	listener := &errorListenerImpl{}
+	lexer := getLexer(antlr.NewInputStream(blankCountStar(convertHanToASCII(exprStr))), listener)
+	defer putLexer(lexer)
+	p := getParser(lexer, listener)
+	defer putParser(p)
+
+	ast := parseExpr(p, listener)
+	if err := listener.Error(); err != nil {
+		return nil, err
+	}
+	if p.GetCurrentToken().GetTokenType() != antlr.TokenEOF {
+		return nil, merr.WrapErrParameterInvalidMsg("invalid HAVING expression: %s", exprStr)
+	}
+	return (&havingVisitor{}).visitPredicate(ast)
+}
+
+// blankCountStar replaces the star of count(*) by a space, the star is not an expression
+// of the grammar, so count(*) is parsed as the call count() instead.
+func blankCountStar(exprStr string) string {
+	lexer := getLexer(antlr.NewInputStream(exprStr))
+	defer putLexer(lexer)
+	tokens := lexer.GetAllTokens()
+	runes := []rune(exprStr)
+	for i := 2; i+1 < len(tokens); i++ {
+		if tokens[i].GetTokenType() == parser.PlanLexerMUL && tokens[i-1].GetText() == "(" &&
+			tokens[i-2].GetTokenType() == parser.PlanLexerIdentifier && tokens[i+1].GetText() == ")" {
+			runes[tokens[i].GetStart()] = ' '
+		}
+	}
+	return string(runes)
+}
+
+// havingVisitor builds the HAVING predicate from the parse tree. The expressions not
+// supported by HAVING are visited into nil by parser.BasePlanVisitor.
+type havingVisitor struct {
+	parser.BasePlanVisitor
+}
+
+func (v *havingVisitor) visitPredicate(ctx parser.IExprContext) (*HavingNode, error) {
+	ret := ctx.Accept(v)
+	if err := getError(ret); err != nil {
+		return nil, err
+	}
+	node, ok := ret.(*HavingNode)
+	if !ok {
+		return nil, merr.WrapErrParameterInvalidMsg("'%s' is not a predicate supported by HAVING", ctx.GetText())
+	}
+	return node, nil
+}
+
+func (v *havingVisitor) visitOperand(ctx parser.IExprContext) (*HavingOperand, error) {
+	ret := ctx.Accept(v)
+	if err := getError(ret); err != nil {
+		return nil, err
+	}
+	operand, ok := ret.(*HavingOperand)
+	if !ok {
+		return nil, merr.WrapErrParameterInvalidMsg("'%s' is not a column or a literal supported by HAVING", ctx.GetText())
+	}
+	return operand, nil
+}
+
+func (v *havingVisitor) VisitParens(ctx *parser.ParensContext) interface{} {
+	return ctx.Expr().Accept(v)
+}
+
+func (v *havingVisitor) VisitInteger(ctx *parser.IntegerContext) interface{} {
+	return parseHavingInteger(ctx.GetText())
+}
+
+func (v *havingVisitor) VisitFloating(ctx *parser.FloatingContext) interface{} {
+	return parseHavingFloating(ctx.GetText())
+}
+
+func (v *havingVisitor) VisitBoolean(ctx *parser.BooleanContext) interface{} {
+	b, err := strconv.ParseBool(ctx.GetText())
+	if err != nil {
+		return err
+	}
+	return &HavingOperand{Value: b}
+}
+
+func (v *havingVisitor) VisitString(ctx *parser.StringContext) interface{} {
+	s, err := convertEscapeSingle(ctx.GetText())
+	if err != nil {
+		return err
+	}
+	return &HavingOperand{Value: s}
+}
+
+func (v *havingVisitor) VisitIdentifier(ctx *parser.IdentifierContext) interface{} {
+	if ctx.Identifier() == nil {
+		return merr.WrapErrParameterInvalidMsg("HAVING does not support the dynamic field %s", ctx.GetText())
+	}
+	return &HavingOperand{Column: decodeUnicode(ctx.GetText())}
+}
+
+// VisitCall visits the aggregate expressions, count(*) is count() here, see blankCountStar.
+func (v *havingVisitor) VisitCall(ctx *parser.CallContext) interface{} {
+	params := make([]string, 0, len(ctx.AllExpr()))
+	for _, param := range ctx.AllExpr() {
+		params = append(params, decodeUnicode(param.GetText()))
+	}
+	if len(params) == 0 {
+		params = append(params, "*")
+	}
+	return &HavingOperand{Column: ctx.Identifier().GetText() + "(" + strings.Join(params, ",") + ")"}
+}
+
+// VisitUnary visits NOT of the predicates and the signs of the numbers.
+func (v *havingVisitor) VisitUnary(ctx *parser.UnaryContext) interface{} {
+	switch ctx.GetOp().GetTokenType() {
+	case parser.PlanParserNOT:
+		child, err := v.visitPredicate(ctx.Expr())
+		if err != nil {
+			return err
+		}
+		return &HavingNode{Kind: HavingNot, Children: []*HavingNode{child}}
+	case parser.PlanParserADD, parser.PlanParserSUB:
+		// parse the sign together with the number, so that the min int64 is not overflowed
+		switch ctx.Expr().(type) {
+		case *parser.IntegerContext:
+			return parseHavingInteger(ctx.GetText())
+		case *parser.FloatingContext:
+			return parseHavingFloating(ctx.GetText())
+		}
+	}
+	return merr.WrapErrParameterInvalidMsg("HAVING does not support the unary expression %s", ctx.GetText())
+}
+
+func (v *havingVisitor) VisitRelational(ctx *parser.RelationalContext) interface{} {
+	return v.visitCompare(ctx.GetOp(), ctx.Expr(0), ctx.Expr(1))
+}
+
+func (v *havingVisitor) VisitEquality(ctx *parser.EqualityContext) interface{} {
+	return v.visitCompare(ctx.GetOp(), ctx.Expr(0), ctx.Expr(1))
+}
+
+func (v *havingVisitor) visitCompare(op antlr.Token, leftCtx, rightCtx parser.IExprContext) interface{} {
+	left, err := v.visitOperand(leftCtx)
+	if err != nil {
+		return err
+	}
+	right, err := v.visitOperand(rightCtx)
+	if err != nil {
+		return err
+	}
+	return &HavingNode{
+		Kind:      HavingCompare,
+		CompareOp: cmpOpMap[op.GetTokenType()],
+		Left:      left,
+		Right:     right,
+	}
+}
+
+func (v *havingVisitor) VisitLogicalAnd(ctx *parser.LogicalAndContext) interface{} {
+	return v.visitLogical(HavingAnd, ctx.Expr(0), ctx.Expr(1))
+}
+
+func (v *havingVisitor) VisitLogicalOr(ctx *parser.LogicalOrContext) interface{} {
+	return v.visitLogical(HavingOr, ctx.Expr(0), ctx.Expr(1))
+}
+
+func (v *havingVisitor) visitLogical(kind HavingNodeKind, leftCtx, rightCtx parser.IExprContext) interface{} {
+	left, err := v.visitPredicate(leftCtx)
+	if err != nil {
+		return err
+	}
+	right, err := v.visitPredicate(rightCtx)
+	if err != nil {
+		return err
+	}
+	return &HavingNode{Kind: kind, Children: []*HavingNode{left, right}}
+}
+
+func parseHavingInteger(literal string) interface{} {
+	i, err := strconv.ParseInt(literal, 0, 64)
+	if err != nil {
+		return merr.WrapErrParameterInvalidMsg("invalid integer %s in HAVING: %s", literal, err.Error())
+	}
+	return &HavingOperand{Value: i}
+}
+
+func parseHavingFloating(literal string) interface{} {
+	f, err := strconv.ParseFloat(literal, 64)
+	if err != nil {
+		return merr.WrapErrParameterInvalidMsg("invalid number %s in HAVING: %s", literal, err.Error())
+	}
+	return &HavingOperand{Value: f}
+}
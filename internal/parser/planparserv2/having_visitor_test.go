@@ -0,0 +1,94 @@
+package planparserv2
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/v3/proto/planpb"
+)
+
+func TestParseHavingExpr(t *testing.T) {
+	node, err := ParseHavingExpr("count( * ) > 10 and (avg(price) <= -1.5 or not (category == 'a'))")
+	assert.NoError(t, err)
+	assert.Equal(t, &HavingNode{
+		Kind: HavingAnd,
+		Children: []*HavingNode{
+			{
+				Kind:      HavingCompare,
+				CompareOp: planpb.OpType_GreaterThan,
+				Left:      &HavingOperand{Column: "count(*)"},
+				Right:     &HavingOperand{Value: int64(10)},
+			},
+			{
+				Kind: HavingOr,
+				Children: []*HavingNode{
+					{
+						Kind:      HavingCompare,
+						CompareOp: planpb.OpType_LessEqual,
+						Left:      &HavingOperand{Column: "avg(price)"},
+						Right:     &HavingOperand{Value: -1.5},
+					},
+					{
+						Kind: HavingNot,
+						Children: []*HavingNode{{
+							Kind:      HavingCompare,
+							CompareOp: planpb.OpType_Equal,
+							Left:      &HavingOperand{Column: "category"},
+							Right:     &HavingOperand{Value: "a"},
+						}},
+					},
+				},
+			},
+		},
+	}, node)
+}
+
+func TestParseHavingExprOperands(t *testing.T) {
+	tests := []struct {
+		expr     string
+		expected *HavingOperand
+	}{
+		{expr: "COUNT(*) != 1", expected: &HavingOperand{Column: "COUNT(*)"}},
+		{expr: "percentile(price, 0.9) != 1", expected: &HavingOperand{Column: "percentile(price,0.9)"}},
+		{expr: "-9223372036854775808 != 1", expected: &HavingOperand{Value: int64(math.MinInt64)}},
+		{expr: "0x10 != 1", expected: &HavingOperand{Value: int64(16)}},
+		{expr: "1e3 != 1", expected: &HavingOperand{Value: float64(1000)}},
+		{expr: "true != 1", expected: &HavingOperand{Value: true}},
+		{expr: `"it's" != 1`, expected: &HavingOperand{Value: "it's"}},
+	}
+	for _, test := range tests {
+		t.Run(test.expr, func(t *testing.T) {
+			node, err := ParseHavingExpr(test.expr)
+			assert.NoError(t, err)
+			assert.Equal(t, HavingCompare, node.Kind)
+			assert.Equal(t, test.expected, node.Left)
+		})
+	}
+}
+
+func TestParseHavingExprError(t *testing.T) {
+	for _, expr := range []string{
+		"",
+		"count(*)",
+		"count(*) > ",
+		"count(*) > 1 and",
+		"(count(*) > 1",
+		"count(*) > 1)",
+		"count(*) >> 1",
+		"count(*) + 1 > 2",
+		"count(* > 1",
+		"count(*) > 1 # 2",
+		"category == 'a",
+		"category like 'a%'",
+		"category in ['a', 'b']",
+		"$meta > 1",
+		"-category > 1",
+		"not count(*) > 1",
+		"1 < count(*) < 10",
+	} {
+		_, err := ParseHavingExpr(expr)
+		assert.Error(t, err, expr)
+	}
+}
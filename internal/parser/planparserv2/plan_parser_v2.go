@@ -177,6 +177,15 @@ func handleExpr(schema *typeutil.SchemaHelper, exprStr string) (result interface
 }
 
 func parseExprInner(schema *typeutil.SchemaHelper, exprStr string, exprTemplateValues map[string]*schemapb.TemplateValue, visitorArgs *ParserVisitorArgs) (*planpb.Expr, error) {
+	expr, err := parseExprWithoutRewrite(schema, exprStr, exprTemplateValues, visitorArgs)
+	if err != nil {
+		return nil, err
+	}
+	return rewriter.RewriteExpr(expr), nil
+}
+
+// parseExprWithoutRewrite parses the expression and fills the template values, the rewriter is not applied.
+func parseExprWithoutRewrite(schema *typeutil.SchemaHelper, exprStr string, exprTemplateValues map[string]*schemapb.TemplateValue, visitorArgs *ParserVisitorArgs) (*planpb.Expr, error) {
 	ret := handleExprInternal(schema, exprStr, visitorArgs)
 
 	if err := getError(ret); err != nil {
@@ -209,8 +218,6 @@ func parseExprInner(schema *typeutil.SchemaHelper, exprStr string, exprTemplateV
 	if err := FillExpressionValue(predicate.expr, valueMap); err != nil {
 		return nil, err
 	}
-
-	predicate.expr = rewriter.RewriteExpr(predicate.expr)
 	return predicate.expr, nil
 }
 
@@ -18,10 +18,12 @@ package accesslog
 
 import (
 	"context"
+	"encoding/json"
 	"net"
 	"strings"
 	"testing"
 
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/suite"
 	"go.opentelemetry.io/otel"
 	"google.golang.org/grpc"
@@ -190,6 +192,45 @@ func (s *LogFormatterSuite) TestFormatMethodResult() {
 	}
 }
 
+func (s *LogFormatterSuite) TestFormatRecord() {
+	fmt := "$method_name: $collection_name $error_code $time_cost $error_msg $collection_name"
+	formatter := NewFormatter(fmt)
+
+	for id, req := range s.reqs {
+		i := info.NewGrpcAccessInfo(s.ctx, s.serverinfo, req)
+		record := formatter.Record(i)
+		s.Equal([]string{"method_name", "collection_name", "error_code", "time_cost", "error_msg"},
+			lo.Map(record, func(field Field, _ int) string { return field.Key }))
+		// no result yet
+		s.Nil(record[3].Value)
+
+		i.SetResult(s.resps[id], s.errs[id])
+		record = formatter.Record(i)
+		s.Equal("test-collection", record[1].Value)
+		s.IsType(int64(0), record[2].Value)
+		s.IsType(float64(0), record[3].Value)
+
+		values := make(map[string]any)
+		s.NoError(json.Unmarshal(record.JSON(), &values))
+		s.Equal("test-collection", values["collection_name"])
+		s.Contains(values, "error_msg")
+	}
+}
+
+func (s *LogFormatterSuite) TestTypedValue() {
+	s.Nil(typedValue("$error_code", info.Unknown))
+	s.Nil(typedValue("$partition_name", info.NotAny))
+	s.Equal(int64(1100), typedValue("$error_code", "1100"))
+	s.Equal(int64(128), typedValue("$response_size", "128"))
+	s.Equal(1.5, typedValue("$time_cost", "1.5ms"))
+	s.Equal(int64(10), typedValue("$nq", "10"))
+	s.Equal(int64(3), typedValue("$nq", `["1","2"]`))
+	s.Equal(true, typedValue("$partial_update", "true"))
+	// keep the raw value if it can't be parsed
+	s.Equal("abc", typedValue("$error_code", "abc"))
+	s.Equal("test-db", typedValue("$database_name", "test-db"))
+}
+
 func (s *LogFormatterSuite) TestParseConfigKeyFailed() {
 	configKey := ".testf.invalidSub"
 	_, _, err := parseConfigKey(configKey)
@@ -17,8 +17,13 @@
 package accesslog
 
 import (
+	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
+
+	"github.com/samber/lo"
 
 	"github.com/milvus-io/milvus/internal/proxy/accesslog/info"
 	"github.com/milvus-io/milvus/pkg/v3/util/merr"
@@ -72,6 +77,8 @@ type Formatter struct {
 	base   string
 	fmt    string
 	fields []string
+	// distinct fields in the order they appear in base
+	keys []string
 }
 
 func NewFormatter(base string) *Formatter {
@@ -117,6 +124,7 @@ func (f *Formatter) build() {
 		}
 	}
 	f.fmt += "\n"
+	f.keys = lo.Uniq(f.fields)
 }
 
 func (f *Formatter) Format(i info.AccessInfo) string {
@@ -124,6 +132,91 @@ func (f *Formatter) Format(i info.AccessInfo) string {
 	return fmt.Sprintf(f.fmt, fieldValues...)
 }
 
+// Field is a $metric of the format without the "$" and its typed value.
+type Field struct {
+	Key   string
+	Value any
+}
+
+// Record is the structured form of an access log, used by the json output and the otlp sink.
+type Record []Field
+
+// Record returns the typed value of each distinct $metric of the format, in the order
+// they appear in the format.
+func (f *Formatter) Record(i info.AccessInfo) Record {
+	values := info.Get(i, f.keys...)
+	record := make(Record, 0, len(f.keys))
+	for id, key := range f.keys {
+		record = append(record, Field{
+			Key:   strings.TrimPrefix(key, "$"),
+			Value: typedValue(key, values[id].(string)),
+		})
+	}
+	return record
+}
+
+// JSON renders the record as a line of json object, the keys keep the order of the format.
+func (r Record) JSON() []byte {
+	buf := make([]byte, 0, 256)
+	buf = append(buf, '{')
+	for id, field := range r {
+		if id > 0 {
+			buf = append(buf, ',')
+		}
+		key, _ := json.Marshal(field.Key)
+		buf = append(buf, key...)
+		buf = append(buf, ':')
+		value, err := json.Marshal(field.Value)
+		if err != nil {
+			value, _ = json.Marshal(fmt.Sprint(field.Value))
+		}
+		buf = append(buf, value...)
+	}
+	buf = append(buf, '}', '\n')
+	return buf
+}
+
+// typedValue converts the string value of the metrics to their natural type, so that
+// they can be indexed without parsing: the unknown values are null, $time_cost is in
+// milliseconds, the codes and sizes are integers and the nq of a hybrid search is the
+// sum of its sub-requests.
+func typedValue(metric string, value string) any {
+	if value == info.Unknown || value == info.NotAny {
+		return nil
+	}
+	switch metric {
+	case "$time_cost":
+		if cost, err := time.ParseDuration(value); err == nil {
+			return float64(cost.Microseconds()) / 1000
+		}
+	case "$error_code", "$response_size":
+		if v, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return v
+		}
+	case "$nq":
+		if v, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return v
+		}
+		var nqs []string
+		if err := json.Unmarshal([]byte(value), &nqs); err == nil {
+			total := int64(0)
+			for _, nq := range nqs {
+				v, err := strconv.ParseInt(nq, 10, 64)
+				if err != nil {
+					return value
+				}
+				total += v
+			}
+			return total
+		}
+	case "$partial_update":
+		if v, err := strconv.ParseBool(value); err == nil {
+			return v
+		}
+	}
+	return value
+}
+
 func parseConfigKey(k string) (string, string, error) {
 	fields := strings.Split(k, ".")
 	if len(fields) != 2 || (fields[1] != fomaterkey && fields[1] != methodKey) {
@@ -28,6 +28,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proxy/accesslog/info"
 	configEvent "github.com/milvus-io/milvus/pkg/v3/config"
 	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
 	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
 )
 
@@ -36,9 +37,17 @@ var (
 	once     sync.Once
 )
 
+const (
+	textOutputFormat = "text"
+	jsonOutputFormat = "json"
+)
+
 type AccessLogger struct {
 	enable     atomic.Bool
 	writer     io.Writer
+	jsonFormat bool
+	// sink also ships the access logs to an otlp collector if not nil
+	sink       *OTLPSink
 	formatters *FormatterManger
 	mu         sync.RWMutex
 }
@@ -54,14 +63,38 @@ func (l *AccessLogger) init(params *paramtable.ComponentParam) error {
 	}
 	l.formatters = formatters
 
+	switch format := params.ProxyCfg.AccessLog.OutputFormat.GetValue(); format {
+	case "", textOutputFormat:
+		l.jsonFormat = false
+	case jsonOutputFormat:
+		l.jsonFormat = true
+	default:
+		return merr.WrapErrParameterInvalid("text or json", format, "invalid access log output format")
+	}
+
 	writer, err := initWriter(&params.ProxyCfg.AccessLog, &params.MinioCfg)
 	if err != nil {
 		return err
 	}
 	l.writer = writer
+
+	if params.ProxyCfg.AccessLog.OTLPEnable.GetAsBool() {
+		sink, err := NewOTLPSink(&params.ProxyCfg.AccessLog)
+		if err != nil {
+			return err
+		}
+		l.sink = sink
+	}
 	return nil
 }
 
+func (l *AccessLogger) closeSink() {
+	if l.sink != nil {
+		l.sink.Close()
+		l.sink = nil
+	}
+}
+
 func (l *AccessLogger) Init(params *paramtable.ComponentParam) error {
 	if params.ProxyCfg.AccessLog.Enable.GetAsBool() {
 		l.mu.Lock()
@@ -88,6 +121,7 @@ func (l *AccessLogger) Update(enable bool) error {
 				write.Close()
 				l.writer = nil
 			}
+			l.closeSink()
 			l.enable.Store(enable)
 		}
 		return nil
@@ -99,6 +133,7 @@ func (l *AccessLogger) Update(enable bool) error {
 			rw.Close()
 		}
 	}
+	l.closeSink()
 
 	// update access log params
 	mlog.Info(context.TODO(), "start update access log params")
@@ -126,7 +161,22 @@ func (l *AccessLogger) Write(info info.AccessInfo) bool {
 	if !ok {
 		return false
 	}
-	_, err := l.writer.Write([]byte(formatter.Format(info)))
+
+	var record Record
+	if l.jsonFormat || l.sink != nil {
+		record = formatter.Record(info)
+	}
+	if l.sink != nil {
+		l.sink.Emit(method, record)
+	}
+
+	var line []byte
+	if l.jsonFormat {
+		line = record.JSON()
+	} else {
+		line = []byte(formatter.Format(info))
+	}
+	_, err := l.writer.Write(line)
 	if err != nil {
 		mlog.Warn(context.TODO(), "write access log failed", mlog.Err(err))
 		return false
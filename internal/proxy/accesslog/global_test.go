@@ -18,8 +18,14 @@ package accesslog
 
 import (
 	"context"
+	"encoding/json"
+	"io"
 	"net"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -33,6 +39,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v3/milvuspb"
 	"github.com/milvus-io/milvus/internal/proxy/accesslog/info"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
 	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
 )
 
@@ -185,6 +192,73 @@ func TestAccessLogger_Basic(t *testing.T) {
 	assert.True(t, ok)
 }
 
+func TestAccessLogger_JSONFormat(t *testing.T) {
+	once = sync.Once{}
+	var Params paramtable.ComponentParam
+
+	exported := make(chan struct{}, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.Copy(io.Discard, r.Body)
+		select {
+		case exported <- struct{}{}:
+		default:
+		}
+	}))
+	defer server.Close()
+
+	Params.Init(paramtable.NewBaseTable(paramtable.SkipRemote(true)))
+	testPath := t.TempDir()
+	Params.Save(Params.ProxyCfg.AccessLog.Enable.Key, "true")
+	Params.Save(Params.ProxyCfg.AccessLog.Filename.Key, "test_access")
+	Params.Save(Params.ProxyCfg.AccessLog.LocalPath.Key, testPath)
+	Params.Save(Params.ProxyCfg.AccessLog.OutputFormat.Key, "json")
+	Params.Save(Params.ProxyCfg.AccessLog.OTLPEnable.Key, "true")
+	Params.Save(Params.ProxyCfg.AccessLog.OTLPMethod.Key, "http")
+	Params.Save(Params.ProxyCfg.AccessLog.OTLPSecure.Key, "false")
+	Params.Save(Params.ProxyCfg.AccessLog.OTLPEndpoint.Key, strings.TrimPrefix(server.URL, "http://"))
+	Params.SaveGroup(map[string]string{Params.ProxyCfg.AccessLog.Formatter.KeyPrefix + "base.format": "$method_name $database_name $collection_name $error_code"})
+
+	InitAccessLogger(&Params)
+	require.NotNil(t, _globalL.sink)
+
+	req := &milvuspb.QueryRequest{
+		DbName:         "test-db",
+		CollectionName: "test-collection",
+	}
+	rpcInfo := &grpc.UnaryServerInfo{Server: nil, FullMethod: "testMethod"}
+	accessInfo := info.NewGrpcAccessInfo(context.Background(), rpcInfo, req)
+	accessInfo.SetResult(merr.Status(nil), nil)
+	ok := _globalL.Write(accessInfo)
+	assert.True(t, ok)
+
+	// close the writer and flush the sink
+	_globalL.Update(false)
+	select {
+	case <-exported:
+	case <-time.After(10 * time.Second):
+		t.Fatal("access log is not exported to the otlp collector")
+	}
+
+	data, err := os.ReadFile(path.Join(testPath, "test_access"))
+	require.NoError(t, err)
+	values := make(map[string]any)
+	require.NoError(t, json.Unmarshal(data, &values))
+	assert.Equal(t, "testMethod", values["method_name"])
+	assert.Equal(t, "test-db", values["database_name"])
+	assert.Equal(t, "test-collection", values["collection_name"])
+	assert.Equal(t, float64(0), values["error_code"])
+}
+
+func TestAccessLogger_InvalidOutputFormat(t *testing.T) {
+	var Params paramtable.ComponentParam
+	Params.Init(paramtable.NewBaseTable(paramtable.SkipRemote(true)))
+	Params.Save(Params.ProxyCfg.AccessLog.Enable.Key, "true")
+	Params.Save(Params.ProxyCfg.AccessLog.OutputFormat.Key, "xml")
+
+	logger := &AccessLogger{}
+	assert.Error(t, logger.Init(&Params))
+}
+
 func TestAccessLogger_WriteFailed(t *testing.T) {
 	once = sync.Once{}
 	var Params paramtable.ComponentParam
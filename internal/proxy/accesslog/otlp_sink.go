@@ -0,0 +1,170 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package accesslog
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploggrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploghttp"
+	"go.opentelemetry.io/otel/log"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	"go.opentelemetry.io/otel/sdk/resource"
+
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/tracer"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v3/util/typeutil"
+)
+
+const (
+	otlpScopeName     = "milvus.accesslog"
+	otlpExportTimeout = 10 * time.Second
+)
+
+func newLogExporter(ctx context.Context, logCfg *paramtable.AccessLogConfig) (sdklog.Exporter, error) {
+	endpoint := logCfg.OTLPEndpoint.GetValue()
+	if endpoint == "" {
+		return nil, merr.WrapErrParameterMissingMsg("access log otlp endpoint is empty")
+	}
+	secure := logCfg.OTLPSecure.GetAsBool()
+	headers := tracer.ParseHeaders(logCfg.OTLPHeaders.GetValue())
+	switch logCfg.OTLPMethod.GetValue() {
+	case "", "grpc":
+		opts := []otlploggrpc.Option{
+			otlploggrpc.WithEndpoint(endpoint),
+		}
+		if !secure {
+			opts = append(opts, otlploggrpc.WithInsecure())
+		}
+		if headers != nil {
+			opts = append(opts, otlploggrpc.WithHeaders(headers))
+		}
+		return otlploggrpc.New(ctx, opts...)
+	case "http":
+		opts := []otlploghttp.Option{
+			otlploghttp.WithEndpoint(endpoint),
+		}
+		if !secure {
+			opts = append(opts, otlploghttp.WithInsecure())
+		}
+		if headers != nil {
+			opts = append(opts, otlploghttp.WithHeaders(headers))
+		}
+		return otlploghttp.New(ctx, opts...)
+	default:
+		return nil, merr.WrapErrParameterInvalidMsg("access log otlp method not supported: %s", logCfg.OTLPMethod.GetValue())
+	}
+}
+
+// OTLPSink ships the access logs as OpenTelemetry log records, each field of the record
+// is a typed attribute. The records are queued and exported in batches by the batch
+// processor of the otel log sdk, the oldest ones are dropped once the queue is full so
+// that a slow collector never blocks the requests.
+type OTLPSink struct {
+	provider  *sdklog.LoggerProvider
+	logger    log.Logger
+	closeOnce sync.Once
+}
+
+func NewOTLPSink(logCfg *paramtable.AccessLogConfig) (*OTLPSink, error) {
+	exporter, err := newLogExporter(context.Background(), logCfg)
+	if err != nil {
+		return nil, err
+	}
+	mlog.Info(context.TODO(), "Access log will be sent to otlp collector",
+		mlog.String("endpoint", logCfg.OTLPEndpoint.GetValue()),
+		mlog.String("method", logCfg.OTLPMethod.GetValue()))
+	return newOTLPSink(exporter,
+		logCfg.OTLPBatchSize.GetAsInt(),
+		logCfg.OTLPQueueSize.GetAsInt(),
+		logCfg.OTLPFlushInterval.GetAsDuration(time.Second)), nil
+}
+
+func newOTLPSink(exporter sdklog.Exporter, batchSize int, queueSize int, flushInterval time.Duration) *OTLPSink {
+	processor := sdklog.NewBatchProcessor(exporter,
+		sdklog.WithExportMaxBatchSize(batchSize),
+		sdklog.WithMaxQueueSize(queueSize),
+		sdklog.WithExportInterval(flushInterval),
+		sdklog.WithExportTimeout(otlpExportTimeout))
+	provider := sdklog.NewLoggerProvider(
+		sdklog.WithResource(resource.NewSchemaless(
+			attribute.String("service.name", "milvus"),
+			attribute.String("milvus.role", typeutil.ProxyRole),
+		)),
+		sdklog.WithProcessor(processor))
+	return &OTLPSink{
+		provider: provider,
+		logger:   provider.Logger(otlpScopeName),
+	}
+}
+
+// Emit queues the access log of method.
+func (s *OTLPSink) Emit(method string, record Record) {
+	s.logger.Emit(context.Background(), toLogRecord(method, record, time.Now()))
+}
+
+// Close exports the queued access logs and shuts the exporter down.
+func (s *OTLPSink) Close() {
+	s.closeOnce.Do(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), otlpExportTimeout)
+		defer cancel()
+		if err := s.provider.Shutdown(ctx); err != nil {
+			mlog.Warn(ctx, "close access log otlp exporter failed", mlog.Err(err))
+		}
+	})
+}
+
+// toLogRecord converts an access log to a log record, the body is the method name and
+// the fields are the attributes, a request failed with a non-zero error code is a warning.
+func toLogRecord(method string, record Record, now time.Time) log.Record {
+	var logRecord log.Record
+	logRecord.SetTimestamp(now)
+	logRecord.SetObservedTimestamp(now)
+	logRecord.SetSeverity(log.SeverityInfo)
+	logRecord.SetSeverityText("INFO")
+	logRecord.SetBody(log.StringValue(method))
+
+	attrs := make([]log.KeyValue, 0, len(record))
+	for _, field := range record {
+		switch v := field.Value.(type) {
+		case nil:
+			continue
+		case string:
+			attrs = append(attrs, log.String(field.Key, v))
+		case int64:
+			attrs = append(attrs, log.Int64(field.Key, v))
+			if field.Key == "error_code" && v != 0 {
+				logRecord.SetSeverity(log.SeverityWarn)
+				logRecord.SetSeverityText("WARN")
+			}
+		case float64:
+			attrs = append(attrs, log.Float64(field.Key, v))
+		case bool:
+			attrs = append(attrs, log.Bool(field.Key, v))
+		default:
+			attrs = append(attrs, log.String(field.Key, fmt.Sprint(v)))
+		}
+	}
+	logRecord.AddAttributes(attrs...)
+	return logRecord
+}
@@ -0,0 +1,226 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package accesslog
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploggrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploghttp"
+	"go.opentelemetry.io/otel/log"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+type mockLogExporter struct {
+	mu      sync.Mutex
+	records []sdklog.Record
+	block   chan struct{}
+	closed  bool
+}
+
+func (e *mockLogExporter) Export(ctx context.Context, records []sdklog.Record) error {
+	if e.block != nil {
+		<-e.block
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for _, record := range records {
+		e.records = append(e.records, record.Clone())
+	}
+	return nil
+}
+
+func (e *mockLogExporter) Shutdown(ctx context.Context) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.closed = true
+	return nil
+}
+
+func (e *mockLogExporter) ForceFlush(ctx context.Context) error {
+	return nil
+}
+
+func (e *mockLogExporter) numRecords() int {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return len(e.records)
+}
+
+func testRecord(errorCode int64) Record {
+	return Record{
+		{Key: "method_name", Value: "Search"},
+		{Key: "error_code", Value: errorCode},
+		{Key: "time_cost", Value: 1.5},
+		{Key: "partial_update", Value: false},
+		{Key: "error_msg", Value: nil},
+	}
+}
+
+func TestOTLPSink_Batch(t *testing.T) {
+	exporter := &mockLogExporter{}
+	sink := newOTLPSink(exporter, 2, 10, time.Hour)
+
+	for i := 0; i < 5; i++ {
+		sink.Emit("Search", testRecord(0))
+	}
+	// full batches are exported without waiting for the flush interval
+	assert.Eventually(t, func() bool { return exporter.numRecords() == 4 }, 5*time.Second, 10*time.Millisecond)
+
+	// the remaining one is exported on close
+	sink.Close()
+	assert.Equal(t, 5, exporter.numRecords())
+	assert.True(t, exporter.closed)
+
+	record := exporter.records[0]
+	assert.Equal(t, "Search", record.Body().AsString())
+	assert.Equal(t, otlpScopeName, record.InstrumentationScope().Name)
+	serviceName, ok := record.Resource().Set().Value("service.name")
+	assert.True(t, ok)
+	assert.Equal(t, "milvus", serviceName.AsString())
+
+	// close twice is fine
+	sink.Close()
+}
+
+func TestOTLPSink_FlushInterval(t *testing.T) {
+	exporter := &mockLogExporter{}
+	sink := newOTLPSink(exporter, 100, 100, 10*time.Millisecond)
+	defer sink.Close()
+
+	sink.Emit("Search", testRecord(0))
+	assert.Eventually(t, func() bool { return exporter.numRecords() == 1 }, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestOTLPSink_QueueFull(t *testing.T) {
+	exporter := &mockLogExporter{block: make(chan struct{})}
+	sink := newOTLPSink(exporter, 1, 1, time.Hour)
+
+	// a blocked collector never blocks the emitting requests
+	for i := 0; i < 10; i++ {
+		sink.Emit("Search", testRecord(0))
+	}
+
+	close(exporter.block)
+	sink.Close()
+	assert.Less(t, exporter.numRecords(), 10)
+}
+
+func TestToLogRecord(t *testing.T) {
+	now := time.Now()
+	logRecord := toLogRecord("Search", testRecord(0), now)
+	assert.Equal(t, "Search", logRecord.Body().AsString())
+	assert.True(t, now.Equal(logRecord.Timestamp()))
+	assert.Equal(t, log.SeverityInfo, logRecord.Severity())
+
+	// nil values are skipped
+	var attrs []log.KeyValue
+	logRecord.WalkAttributes(func(kv log.KeyValue) bool {
+		attrs = append(attrs, kv)
+		return true
+	})
+	require.Len(t, attrs, 4)
+	assert.Equal(t, "Search", attrs[0].Value.AsString())
+	assert.Equal(t, int64(0), attrs[1].Value.AsInt64())
+	assert.Equal(t, 1.5, attrs[2].Value.AsFloat64())
+	assert.False(t, attrs[3].Value.AsBool())
+
+	logRecord = toLogRecord("Search", testRecord(1100), now)
+	assert.Equal(t, log.SeverityWarn, logRecord.Severity())
+	assert.Equal(t, "WARN", logRecord.SeverityText())
+}
+
+func TestOTLPSink_HTTP(t *testing.T) {
+	var mu sync.Mutex
+	var received *collogspb.ExportLogsServiceRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/logs" || r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		req := &collogspb.ExportLogsServiceRequest{}
+		assert.NoError(t, proto.Unmarshal(body, req))
+		mu.Lock()
+		received = req
+		mu.Unlock()
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	var params paramtable.ComponentParam
+	params.Init(paramtable.NewBaseTable(paramtable.SkipRemote(true)))
+	logCfg := &params.ProxyCfg.AccessLog
+	params.Save(logCfg.OTLPEndpoint.Key, strings.TrimPrefix(server.URL, "http://"))
+	params.Save(logCfg.OTLPMethod.Key, "http")
+	params.Save(logCfg.OTLPSecure.Key, "false")
+	params.Save(logCfg.OTLPHeaders.Key, `{"Authorization": "Bearer token"}`)
+
+	sink, err := NewOTLPSink(logCfg)
+	require.NoError(t, err)
+	sink.Emit("Search", testRecord(0))
+	sink.Close()
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.NotNil(t, received)
+	scopeLogs := received.GetResourceLogs()[0].GetScopeLogs()[0]
+	assert.Equal(t, otlpScopeName, scopeLogs.GetScope().GetName())
+	assert.Equal(t, "Search", scopeLogs.GetLogRecords()[0].GetBody().GetStringValue())
+}
+
+func TestNewLogExporter(t *testing.T) {
+	var params paramtable.ComponentParam
+	params.Init(paramtable.NewBaseTable(paramtable.SkipRemote(true)))
+	logCfg := &params.ProxyCfg.AccessLog
+	ctx := context.Background()
+
+	_, err := newLogExporter(ctx, logCfg)
+	assert.Error(t, err)
+
+	params.Save(logCfg.OTLPEndpoint.Key, "localhost:4317")
+	params.Save(logCfg.OTLPMethod.Key, "udp")
+	_, err = newLogExporter(ctx, logCfg)
+	assert.Error(t, err)
+
+	params.Save(logCfg.OTLPMethod.Key, "http")
+	exporter, err := newLogExporter(ctx, logCfg)
+	assert.NoError(t, err)
+	assert.IsType(t, &otlploghttp.Exporter{}, exporter)
+	assert.NoError(t, exporter.Shutdown(ctx))
+
+	params.Save(logCfg.OTLPMethod.Key, "grpc")
+	params.Save(logCfg.OTLPSecure.Key, "false")
+	exporter, err = newLogExporter(ctx, logCfg)
+	assert.NoError(t, err)
+	assert.IsType(t, &otlploggrpc.Exporter{}, exporter)
+	assert.NoError(t, exporter.Shutdown(ctx))
+}
@@ -159,28 +159,55 @@ type RotateWriter struct {
 	closeOnce sync.Once
 }
 
+// RotateConfig is the config of a RotateWriter.
+type RotateConfig struct {
+	LocalPath   string
+	Filename    string
+	RotatedTime int64
+	MaxSize     int
+	MaxBackups  int
+	// MinioEnable backups the rotated files to RemotePath of minio.
+	MinioEnable   bool
+	RemotePath    string
+	RemoteMaxTime int
+}
+
 func NewRotateWriter(logCfg *paramtable.AccessLogConfig, minioCfg *paramtable.MinioConfig) (*RotateWriter, error) {
+	return NewRotateWriterWithConfig(&RotateConfig{
+		LocalPath:     logCfg.LocalPath.GetValue(),
+		Filename:      logCfg.Filename.GetValue(),
+		RotatedTime:   logCfg.RotatedTime.GetAsInt64(),
+		MaxSize:       logCfg.MaxSize.GetAsInt(),
+		MaxBackups:    logCfg.MaxBackups.GetAsInt(),
+		MinioEnable:   logCfg.MinioEnable.GetAsBool(),
+		RemotePath:    logCfg.RemotePath.GetValue(),
+		RemoteMaxTime: logCfg.RemoteMaxTime.GetAsInt(),
+	}, minioCfg)
+}
+
+// NewRotateWriterWithConfig creates a RotateWriter for the logs other than the access log.
+func NewRotateWriterWithConfig(cfg *RotateConfig, minioCfg *paramtable.MinioConfig) (*RotateWriter, error) {
 	logger := &RotateWriter{
-		localPath:   logCfg.LocalPath.GetValue(),
-		fileName:    logCfg.Filename.GetValue(),
-		rotatedTime: logCfg.RotatedTime.GetAsInt64(),
-		maxSize:     logCfg.MaxSize.GetAsInt(),
-		maxBackups:  logCfg.MaxBackups.GetAsInt(),
+		localPath:   cfg.LocalPath,
+		fileName:    cfg.Filename,
+		rotatedTime: cfg.RotatedTime,
+		maxSize:     cfg.MaxSize,
+		maxBackups:  cfg.MaxBackups,
 		closeCh:     make(chan struct{}),
 	}
-	mlog.Info(context.TODO(), "Access log save to "+logger.dir())
-	if logCfg.MinioEnable.GetAsBool() {
+	mlog.Info(context.TODO(), "Rotate log save to "+logger.filename())
+	if cfg.MinioEnable {
 		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 		defer cancel()
 
-		mlog.Info(context.TODO(), "Access log will backup files to minio", mlog.String("remote", logCfg.RemotePath.GetValue()), mlog.String("maxBackups", logCfg.MaxBackups.GetValue()))
-		handler, err := NewMinioHandler(ctx, minioCfg, logCfg.RemotePath.GetValue(), logCfg.MaxBackups.GetAsInt())
+		mlog.Info(context.TODO(), "Rotate log will backup files to minio", mlog.String("remote", cfg.RemotePath), mlog.Int("maxBackups", cfg.MaxBackups))
+		handler, err := NewMinioHandler(ctx, minioCfg, cfg.RemotePath, cfg.MaxBackups)
 		if err != nil {
 			return nil, err
 		}
 		prefix, ext := logger.prefixAndExt()
-		if logCfg.RemoteMaxTime.GetAsInt() > 0 {
-			handler.retentionPolicy = getTimeRetentionFunc(logCfg.RemoteMaxTime.GetAsInt(), prefix, ext)
+		if cfg.RemoteMaxTime > 0 {
+			handler.retentionPolicy = getTimeRetentionFunc(cfg.RemoteMaxTime, prefix, ext)
 		}
 
 		logger.handler = handler
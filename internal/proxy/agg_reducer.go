@@ -45,6 +45,14 @@ func (reducer *MilvusAggReducer) Reduce(results []*internalpb.RetrieveResults) (
 		} else if len(indices) == 1 {
 			// Single index: direct copy (non-avg aggregation or group-by field)
 			reOrganizedFieldDatas[i] = reducedFieldDatas[indices[0]]
+			if sketch := reducer.outputMap.SketchAt(i); sketch != nil {
+				// approximate aggregation: terminate the merged sketches
+				sketchFieldData, err := agg.ComputeSketchResult(sketch, reducedFieldDatas[indices[0]])
+				if err != nil {
+					return nil, merr.Wrapf(err, "failed to compute %s", reducer.outputMap.NameAt(i))
+				}
+				reOrganizedFieldDatas[i] = sketchFieldData
+			}
 			reOrganizedFieldDatas[i].FieldName = reducer.outputMap.NameAt(i)
 		} else if len(indices) == 2 {
 			// Two indices: avg aggregation (sum and count)
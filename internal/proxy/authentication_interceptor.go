@@ -12,6 +12,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/milvus-io/milvus-proto/go-api/v3/milvuspb"
+	"github.com/milvus-io/milvus/internal/proxy/jwtauth"
 	"github.com/milvus-io/milvus/internal/proxy/privilege"
 	"github.com/milvus-io/milvus/internal/util/hookutil"
 	"github.com/milvus-io/milvus/pkg/v3/metrics"
@@ -76,16 +77,32 @@ func AuthenticationInterceptorWithMetaCache(getMetaCache func() Cache) grpc_auth
 				return nil, status.Error(codes.Unauthenticated, "missing authorization in header")
 			}
 
-			// token format: base64<username:password>
-			// token := strings.TrimPrefix(authorization[0], "Bearer ")
+			// token format: base64<username:password>, base64<api key>, base64<jwt> or Bearer <jwt>
 			token := authStrArr[0]
-			rawToken, err := crypto.Base64Decode(token)
-			if err != nil {
-				mlog.Warn(ctx, "fail to decode the token", mlog.Err(err))
-				return nil, status.Error(codes.Unauthenticated, "invalid token format")
+			rawToken, isBearer := strings.CutPrefix(token, "Bearer ")
+			if !isBearer {
+				var err error
+				rawToken, err = crypto.Base64Decode(token)
+				if err != nil {
+					mlog.Warn(ctx, "fail to decode the token", mlog.Err(err))
+					return nil, status.Error(codes.Unauthenticated, "invalid token format")
+				}
 			}
 
-			if !strings.Contains(rawToken, util.CredentialSeparator) {
+			if identity, ok, err := VerifyJWT(ctx, rawToken); ok {
+				if err != nil {
+					return nil, status.Error(codes.Unauthenticated, "auth check failure, please check the jwt is valid")
+				}
+				metrics.UserRPCCounter.WithLabelValues(identity.Username).Inc()
+				userToken := fmt.Sprintf("%s%s%s", identity.Username, util.CredentialSeparator, util.PasswordHolder)
+				md[strings.ToLower(util.HeaderAuthorize)] = []string{crypto.Base64Encode(userToken)}
+				md[util.HeaderToken] = []string{rawToken}
+				ctx = metadata.NewIncomingContext(ctx, md)
+				ctx = jwtauth.WithIdentity(ctx, identity)
+			} else if isBearer {
+				mlog.Warn(ctx, "bearer token is not an accepted jwt")
+				return nil, status.Error(codes.Unauthenticated, "invalid token format")
+			} else if !strings.Contains(rawToken, util.CredentialSeparator) {
 				user, err := VerifyAPIKey(rawToken)
 				if err != nil {
 					mlog.Warn(ctx, "fail to verify apikey", mlog.Err(err))
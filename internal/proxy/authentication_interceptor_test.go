@@ -2,13 +2,23 @@ package proxy
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/cockroachdb/errors"
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/metadata"
 
+	"github.com/milvus-io/milvus/internal/proxy/jwtauth"
 	"github.com/milvus-io/milvus/internal/proxy/privilege"
 	"github.com/milvus-io/milvus/internal/util/hookutil"
 	"github.com/milvus-io/milvus/pkg/v3/util"
@@ -131,3 +141,72 @@ func TestAuthenticationInterceptor(t *testing.T) {
 	}
 	hookutil.SetTestHook(hookutil.DefaultHook{})
 }
+
+func TestAuthenticationInterceptorWithJWT(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+	keyFile := filepath.Join(t.TempDir(), "jwt.pem")
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0o600))
+
+	paramtable.Get().Save(Params.CommonCfg.AuthorizationEnabled.Key, "true")
+	paramtable.Get().Save(Params.CommonCfg.JWTEnabled.Key, "true")
+	paramtable.Get().Save(Params.CommonCfg.JWTKeyFile.Key, keyFile)
+	paramtable.Get().Save(Params.CommonCfg.JWTIssuer.Key, "https://idp.example.com")
+	paramtable.Get().Save(Params.CommonCfg.JWTAudience.Key, "milvus")
+	paramtable.Get().Save(Params.CommonCfg.JWTRoleMapping.Key, "sso-readers:reader")
+	jwtVerifier.Store(nil)
+	defer func() {
+		paramtable.Get().Reset(Params.CommonCfg.AuthorizationEnabled.Key)
+		paramtable.Get().Reset(Params.CommonCfg.JWTEnabled.Key)
+		paramtable.Get().Reset(Params.CommonCfg.JWTKeyFile.Key)
+		paramtable.Get().Reset(Params.CommonCfg.JWTIssuer.Key)
+		paramtable.Get().Reset(Params.CommonCfg.JWTAudience.Key)
+		paramtable.Get().Reset(Params.CommonCfg.JWTRoleMapping.Key)
+		jwtVerifier.Store(nil)
+	}()
+
+	sign := func(key *rsa.PrivateKey, sub string) string {
+		token, err := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+			"sub":    sub,
+			"iss":    "https://idp.example.com",
+			"aud":    "milvus",
+			"exp":    time.Now().Add(time.Hour).Unix(),
+			"groups": []string{"sso-readers", "sso-others"},
+		}).SignedString(key)
+		require.NoError(t, err)
+		return token
+	}
+
+	ctx := context.Background()
+	cache, err := initMetaCache(ctx, &MockMixCoordClientInterface{})
+	require.NoError(t, err)
+	authInterceptor := AuthenticationInterceptorWithMetaCache(func() Cache { return cache })
+
+	token := sign(key, "sso-user")
+	for _, header := range []string{"Bearer " + token, crypto.Base64Encode(token)} {
+		authCtx, err := authInterceptor(metadata.NewIncomingContext(ctx, metadata.Pairs(util.HeaderAuthorize, header)))
+		require.NoError(t, err)
+		user, err := GetCurUserFromContext(authCtx)
+		assert.NoError(t, err)
+		assert.Equal(t, "sso-user", user)
+		identity, ok := jwtauth.IdentityFromContext(authCtx)
+		assert.True(t, ok)
+		assert.Equal(t, []string{"reader"}, identity.Roles)
+	}
+
+	// signed by another key
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	_, err = authInterceptor(metadata.NewIncomingContext(ctx, metadata.Pairs(util.HeaderAuthorize, "Bearer "+sign(otherKey, "sso-user"))))
+	assert.Error(t, err)
+
+	// bearer token must be a jwt
+	_, err = authInterceptor(metadata.NewIncomingContext(ctx, metadata.Pairs(util.HeaderAuthorize, "Bearer mockapikey")))
+	assert.Error(t, err)
+
+	// username and password still work
+	_, err = authInterceptor(metadata.NewIncomingContext(ctx, metadata.Pairs(util.HeaderAuthorize, crypto.Base64Encode("mockUser:mockPass"))))
+	assert.NoError(t, err)
+}
@@ -0,0 +1,353 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"sort"
+
+	"go.opentelemetry.io/otel"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus/client/v3/proto/changestreampb"
+	"github.com/milvus-io/milvus/internal/distributed/streaming"
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message/adaptor"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/options"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+	"github.com/milvus-io/milvus/pkg/v3/util/typeutil"
+)
+
+// changeEventTypes maps the wal message types into the change event types,
+// the messages of other types are not delivered.
+var changeEventTypes = map[message.MessageType]changestreampb.ChangeEventType{
+	message.MessageTypeInsert:             changestreampb.ChangeEventType_Insert,
+	message.MessageTypeDelete:             changestreampb.ChangeEventType_Delete,
+	message.MessageTypeDropCollection:     changestreampb.ChangeEventType_DropCollection,
+	message.MessageTypeTruncateCollection: changestreampb.ChangeEventType_TruncateCollection,
+	message.MessageTypeCreatePartition:    changestreampb.ChangeEventType_CreatePartition,
+	message.MessageTypeDropPartition:      changestreampb.ChangeEventType_DropPartition,
+	message.MessageTypeSchemaChange:       changestreampb.ChangeEventType_SchemaChange,
+	message.MessageTypeAlterCollection:    changestreampb.ChangeEventType_AlterCollection,
+	message.MessageTypeImport:             changestreampb.ChangeEventType_Import,
+}
+
+// changeStreamHandler forwards the messages of all the scanners of a change stream into one channel.
+// Unlike adaptor.ChanMessageHandler, the channel is not closed by the scanners.
+type changeStreamHandler chan message.ImmutableMessage
+
+func (h changeStreamHandler) Handle(param message.HandleParam) message.HandleResult {
+	return adaptor.ChanMessageHandler(h).Handle(param)
+}
+
+func (h changeStreamHandler) Close() {}
+
+// changeGroup is the pending events of a vchannel sharing the same timetick.
+type changeGroup struct {
+	timetick uint64
+	events   []*changestreampb.ChangeEvent
+	lastID   message.MessageID
+}
+
+// changeStream reads the mutations of a collection from the wal of its vchannels.
+type changeStream struct {
+	collectionID int64
+	vchannels    []string
+	checkpoint   *changestreampb.Checkpoint
+	pending      map[string]*changeGroup
+}
+
+func newChangeStream(collectionID int64, vchannels []string, req *changestreampb.SubscribeRequest) (*changeStream, error) {
+	checkpoint := &changestreampb.Checkpoint{
+		CollectionId:   collectionID,
+		StartTimestamp: req.GetStartTimestamp(),
+	}
+	if req.GetCheckpoint() != nil {
+		checkpoint = proto.Clone(req.GetCheckpoint()).(*changestreampb.Checkpoint)
+		if checkpoint.GetCollectionId() != collectionID {
+			return nil, merr.WrapErrParameterInvalidMsg("checkpoint doesn't belong to collection %s, the collection may be recreated", req.GetCollectionName())
+		}
+		known := typeutil.NewSet(vchannels...)
+		for vchannel := range checkpoint.GetPositions() {
+			if !known.Contain(vchannel) {
+				return nil, merr.WrapErrParameterInvalidMsg("checkpoint contains unknown vchannel %s", vchannel)
+			}
+		}
+	}
+	if checkpoint.Positions == nil {
+		checkpoint.Positions = make(map[string]*commonpb.MessageID)
+	}
+	return &changeStream{
+		collectionID: collectionID,
+		vchannels:    vchannels,
+		checkpoint:   checkpoint,
+		pending:      make(map[string]*changeGroup),
+	}, nil
+}
+
+// readOption returns the read option of the vchannel according to the checkpoint.
+func (s *changeStream) readOption(vchannel string, handler message.Handler) (streaming.ReadOption, error) {
+	opt := streaming.ReadOption{
+		VChannel:       vchannel,
+		DeliverPolicy:  options.DeliverPolicyLatest(),
+		MessageHandler: handler,
+	}
+	if position, ok := s.checkpoint.GetPositions()[vchannel]; ok {
+		msgID, err := message.UnmarshalMessageID(position)
+		if err != nil {
+			return opt, merr.WrapErrParameterInvalidMsg("invalid checkpoint position of vchannel %s: %s", vchannel, err.Error())
+		}
+		opt.DeliverPolicy = options.DeliverPolicyStartAfter(msgID)
+	} else if s.checkpoint.GetStartTimestamp() > 0 {
+		opt.DeliverPolicy = options.DeliverPolicyAll()
+		opt.DeliverFilters = []options.DeliverFilter{options.DeliverFilterTimeTickGT(s.checkpoint.GetStartTimestamp())}
+	}
+	return opt, nil
+}
+
+// run streams the events to send until the context is done, the collection is dropped or any scanner fails.
+func (s *changeStream) run(ctx context.Context, send func(*changestreampb.ChangeEvent) error) error {
+	readOptions := make([]streaming.ReadOption, 0, len(s.vchannels))
+	msgCh := make(changeStreamHandler, 16)
+	for _, vchannel := range s.vchannels {
+		opt, err := s.readOption(vchannel, msgCh)
+		if err != nil {
+			return err
+		}
+		readOptions = append(readOptions, opt)
+	}
+
+	scannerDone := make(chan streaming.Scanner, len(readOptions))
+	for _, opt := range readOptions {
+		scanner := streaming.WAL().Read(ctx, opt)
+		defer scanner.Close()
+		go func() {
+			<-scanner.Done()
+			scannerDone <- scanner
+		}()
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case scanner := <-scannerDone:
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if err := scanner.Error(); err != nil {
+				return err
+			}
+			return merr.WrapErrServiceUnavailable("change stream scanner is closed")
+		case msg := <-msgCh:
+			dropped, err := s.handle(msg, send)
+			if err != nil {
+				return err
+			}
+			if dropped {
+				return nil
+			}
+		}
+	}
+}
+
+// handle converts the message into events and sends them, returns true if the collection is dropped.
+//
+// The inserts and deletes of a vchannel are held until a message with greater timetick comes,
+// so the deletes of an upsert can be delivered before its inserts.
+func (s *changeStream) handle(msg message.ImmutableMessage, send func(*changestreampb.ChangeEvent) error) (bool, error) {
+	vchannel := msg.VChannel()
+	if group, ok := s.pending[vchannel]; ok && group.timetick != msg.TimeTick() {
+		if err := s.flush(vchannel, send); err != nil {
+			return false, err
+		}
+	}
+
+	events, err := s.convert(msg)
+	if err != nil {
+		return false, err
+	}
+	group, ok := s.pending[vchannel]
+	if !ok {
+		group = &changeGroup{timetick: msg.TimeTick()}
+		s.pending[vchannel] = group
+	}
+	group.events = append(group.events, events...)
+	group.lastID = msg.MessageID()
+	if txnMsg, ok := msg.(message.ImmutableTxnMessage); ok {
+		group.lastID = txnMsg.Commit().MessageID()
+	}
+
+	// the transactions and ddl are complete, they can be delivered at once.
+	switch msg.MessageType() {
+	case message.MessageTypeInsert, message.MessageTypeDelete:
+		return false, nil
+	}
+	if err := s.flush(vchannel, send); err != nil {
+		return false, err
+	}
+	if msg.MessageType() != message.MessageTypeDropCollection {
+		return false, nil
+	}
+	// nothing comes after the collection is dropped, deliver the held events of other vchannels.
+	for _, vchannel := range s.vchannels {
+		if _, ok := s.pending[vchannel]; ok {
+			if err := s.flush(vchannel, send); err != nil {
+				return false, err
+			}
+		}
+	}
+	return true, nil
+}
+
+// flush sends the pending events of the vchannel, deletes go first.
+// Only the last event carries the checkpoint after the group, so resuming from
+// the other events replays the whole group.
+func (s *changeStream) flush(vchannel string, send func(*changestreampb.ChangeEvent) error) error {
+	group := s.pending[vchannel]
+	delete(s.pending, vchannel)
+
+	previous := proto.Clone(s.checkpoint).(*changestreampb.Checkpoint)
+	s.checkpoint.Positions[vchannel] = group.lastID.IntoProto()
+	current := proto.Clone(s.checkpoint).(*changestreampb.Checkpoint)
+
+	sort.SliceStable(group.events, func(i, j int) bool {
+		return group.events[i].GetType() == changestreampb.ChangeEventType_Delete &&
+			group.events[j].GetType() != changestreampb.ChangeEventType_Delete
+	})
+	for i, event := range group.events {
+		event.Checkpoint = previous
+		if i == len(group.events)-1 {
+			event.Checkpoint = current
+		}
+		if err := send(event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// convert converts the wal message into events, the transaction is expanded.
+func (s *changeStream) convert(msg message.ImmutableMessage) ([]*changestreampb.ChangeEvent, error) {
+	if txnMsg, ok := msg.(message.ImmutableTxnMessage); ok {
+		events := make([]*changestreampb.ChangeEvent, 0, txnMsg.Size())
+		err := txnMsg.RangeOver(func(im message.ImmutableMessage) error {
+			converted, err := s.convert(im)
+			if err != nil {
+				return err
+			}
+			// the messages of a transaction share the commit timetick.
+			for _, event := range converted {
+				event.Timestamp = txnMsg.TimeTick()
+			}
+			events = append(events, converted...)
+			return nil
+		})
+		return events, err
+	}
+
+	eventType, ok := changeEventTypes[msg.MessageType()]
+	if !ok {
+		return nil, nil
+	}
+	event := &changestreampb.ChangeEvent{
+		Type:      eventType,
+		Timestamp: msg.TimeTick(),
+		Vchannel:  msg.VChannel(),
+	}
+	switch msg.MessageType() {
+	case message.MessageTypeInsert:
+		insertMsg, err := message.AsImmutableInsertMessageV1(msg)
+		if err != nil {
+			return nil, err
+		}
+		body, err := insertMsg.Body()
+		if err != nil {
+			return nil, err
+		}
+		event.PartitionName = body.GetPartitionName()
+		event.NumRows = body.GetNumRows()
+		event.FieldsData = body.GetFieldsData()
+	case message.MessageTypeDelete:
+		deleteMsg, err := message.AsImmutableDeleteMessageV1(msg)
+		if err != nil {
+			return nil, err
+		}
+		body, err := deleteMsg.Body()
+		if err != nil {
+			return nil, err
+		}
+		event.PartitionName = body.GetPartitionName()
+		event.PrimaryKeys = body.GetPrimaryKeys()
+	case message.MessageTypeCreatePartition:
+		partitionMsg, err := message.AsImmutableCreatePartitionMessageV1(msg)
+		if err != nil {
+			return nil, err
+		}
+		body, err := partitionMsg.Body()
+		if err != nil {
+			return nil, err
+		}
+		event.PartitionName = body.GetPartitionName()
+	case message.MessageTypeDropPartition:
+		partitionMsg, err := message.AsImmutableDropPartitionMessageV1(msg)
+		if err != nil {
+			return nil, err
+		}
+		body, err := partitionMsg.Body()
+		if err != nil {
+			return nil, err
+		}
+		event.PartitionName = body.GetPartitionName()
+	}
+	return []*changestreampb.ChangeEvent{event}, nil
+}
+
+// SubscribeChanges streams the mutations of the collection to send, until the context is done,
+// the collection is dropped or send fails.
+func (node *Proxy) SubscribeChanges(ctx context.Context, req *changestreampb.SubscribeRequest, send func(*changestreampb.ChangeEvent) error) error {
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return err
+	}
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-SubscribeChanges")
+	defer sp.End()
+
+	if req.DbName == "" {
+		req.DbName = GetCurDBNameFromContextOrDefault(ctx)
+	}
+	logger := mlog.With(mlog.String("db", req.DbName), mlog.FieldCollectionName(req.CollectionName))
+
+	collectionID, err := node.getMetaCache().GetCollectionID(ctx, req.DbName, req.CollectionName)
+	if err != nil {
+		return err
+	}
+	vchannels, err := node.chMgr.GetVChannels(collectionID)
+	if err != nil {
+		return err
+	}
+	stream, err := newChangeStream(collectionID, vchannels, req)
+	if err != nil {
+		return err
+	}
+
+	logger.Info(ctx, "change stream subscribed", mlog.Strings("vchannels", vchannels), mlog.Bool("resumed", req.GetCheckpoint() != nil))
+	err = stream.run(ctx, send)
+	logger.Info(ctx, "change stream closed", mlog.Err(err))
+	return err
+}
@@ -0,0 +1,155 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v3/msgpb"
+	"github.com/milvus-io/milvus-proto/go-api/v3/schemapb"
+	"github.com/milvus-io/milvus/client/v3/proto/changestreampb"
+	"github.com/milvus-io/milvus/internal/distributed/streaming"
+	"github.com/milvus-io/milvus/internal/mocks/distributed/mock_streaming"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+)
+
+func TestChangeStreamCheckpoint(t *testing.T) {
+	vchannels := []string{"v0", "v1"}
+
+	checkpoint := &changestreampb.Checkpoint{
+		CollectionId:   1,
+		StartTimestamp: 100,
+		Positions:      map[string]*commonpb.MessageID{"v0": testPulsarMessageID(10).IntoProto()},
+	}
+	_, err := newChangeStream(2, vchannels, &changestreampb.SubscribeRequest{Checkpoint: checkpoint})
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	_, err = newChangeStream(1, []string{"v1"}, &changestreampb.SubscribeRequest{Checkpoint: checkpoint})
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+
+	// the vchannel with position resumes after it, the others start from the start timestamp.
+	s, err := newChangeStream(1, vchannels, &changestreampb.SubscribeRequest{Checkpoint: checkpoint})
+	require.NoError(t, err)
+	opt, err := s.readOption("v0", nil)
+	require.NoError(t, err)
+	_, ok := opt.DeliverPolicy.GetPolicy().(*streamingpb.DeliverPolicy_StartAfter)
+	assert.True(t, ok)
+	opt, err = s.readOption("v1", nil)
+	require.NoError(t, err)
+	_, ok = opt.DeliverPolicy.GetPolicy().(*streamingpb.DeliverPolicy_All)
+	assert.True(t, ok)
+	assert.Len(t, opt.DeliverFilters, 1)
+
+	s, err = newChangeStream(1, vchannels, &changestreampb.SubscribeRequest{})
+	require.NoError(t, err)
+	opt, err = s.readOption("v0", nil)
+	require.NoError(t, err)
+	_, ok = opt.DeliverPolicy.GetPolicy().(*streamingpb.DeliverPolicy_Latest)
+	assert.True(t, ok)
+	assert.Empty(t, opt.DeliverFilters)
+}
+
+func TestChangeStreamRun(t *testing.T) {
+	insertID, deleteID, otherID, dropID := testPulsarMessageID(11), testPulsarMessageID(12), testPulsarMessageID(13), testPulsarMessageID(20)
+	insert := message.NewInsertMessageBuilderV1().
+		WithHeader(&message.InsertMessageHeader{CollectionId: 1}).
+		WithBody(&msgpb.InsertRequest{PartitionName: "p1", NumRows: 1}).
+		WithVChannel("test-channel").
+		MustBuildMutable().
+		WithTimeTick(10).
+		WithLastConfirmed(insertID).
+		IntoImmutableMessage(insertID)
+	pks := &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1}}}}
+	del := message.NewDeleteMessageBuilderV1().
+		WithHeader(&message.DeleteMessageHeader{CollectionId: 1, Rows: 1}).
+		WithBody(&msgpb.DeleteRequest{PrimaryKeys: pks}).
+		WithVChannel("test-channel").
+		MustBuildMutable().
+		WithTimeTick(10).
+		WithLastConfirmed(insertID).
+		IntoImmutableMessage(deleteID)
+	drop := message.NewDropCollectionMessageBuilderV1().
+		WithHeader(&message.DropCollectionMessageHeader{CollectionId: 1}).
+		WithBody(&msgpb.DropCollectionRequest{}).
+		WithVChannel("test-channel").
+		MustBuildMutable().
+		WithTimeTick(40).
+		WithLastConfirmed(dropID).
+		IntoImmutableMessage(dropID)
+
+	scanner := mock_streaming.NewMockScanner(t)
+	scanner.EXPECT().Done().Return(make(chan struct{}))
+	scanner.EXPECT().Close().Return()
+	wal := mock_streaming.NewMockWALAccesser(t)
+	wal.EXPECT().Read(mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, opts streaming.ReadOption) streaming.Scanner {
+			assert.Equal(t, "test-channel", opts.VChannel)
+			ch, ok := opts.MessageHandler.(changeStreamHandler)
+			require.True(t, ok)
+			ch <- insert
+			ch <- del
+			// the messages not delivered still move the checkpoint forward.
+			ch <- buildTestImmutableMessageWithID(otherID, 20)
+			ch <- buildTxnImmutableMessage(t, 30)
+			ch <- drop
+			return scanner
+		})
+	streaming.SetWALForTest(wal)
+	defer streaming.SetWALForTest(nil)
+
+	s, err := newChangeStream(1, []string{"test-channel"}, &changestreampb.SubscribeRequest{})
+	require.NoError(t, err)
+	var events []*changestreampb.ChangeEvent
+	err = s.run(context.Background(), func(event *changestreampb.ChangeEvent) error {
+		events = append(events, event)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, events, 4)
+
+	// the deletes are delivered before the inserts with the same timestamp.
+	assert.Equal(t, changestreampb.ChangeEventType_Delete, events[0].Type)
+	assert.Equal(t, []int64{1}, events[0].PrimaryKeys.GetIntId().GetData())
+	assert.Equal(t, changestreampb.ChangeEventType_Insert, events[1].Type)
+	assert.Equal(t, "p1", events[1].PartitionName)
+	assert.EqualValues(t, 1, events[1].NumRows)
+	assert.EqualValues(t, 10, events[1].Timestamp)
+	assert.Equal(t, changestreampb.ChangeEventType_Insert, events[2].Type)
+	assert.EqualValues(t, 30, events[2].Timestamp)
+	assert.Equal(t, changestreampb.ChangeEventType_DropCollection, events[3].Type)
+
+	assertPosition := func(checkpoint *changestreampb.Checkpoint, expected message.MessageID) {
+		assert.EqualValues(t, 1, checkpoint.GetCollectionId())
+		if expected == nil {
+			assert.Empty(t, checkpoint.GetPositions())
+			return
+		}
+		assert.True(t, expected.EQ(message.MustUnmarshalMessageID(checkpoint.GetPositions()["test-channel"])))
+	}
+	// resuming from the first event of a group replays the whole group.
+	assertPosition(events[0].Checkpoint, nil)
+	assertPosition(events[1].Checkpoint, deleteID)
+	assertPosition(events[2].Checkpoint, testPulsarMessageID(4))
+	assertPosition(events[3].Checkpoint, dropID)
+}
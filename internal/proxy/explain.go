@@ -0,0 +1,305 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"sort"
+	"strconv"
+
+	"go.opentelemetry.io/otel"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v3/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v3/schemapb"
+	"github.com/milvus-io/milvus/client/v3/proto/explainpb"
+	"github.com/milvus-io/milvus/internal/json"
+	"github.com/milvus-io/milvus/internal/parser/planparserv2"
+	"github.com/milvus-io/milvus/pkg/v3/metrics"
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v3/util/commonpbutil"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v3/util/timerecord"
+	"github.com/milvus-io/milvus/pkg/v3/util/typeutil"
+)
+
+// noIndex is reported in the index usage for the segments without an index on the field.
+const noIndex = "NONE"
+
+// ExplainSearch executes the search as a normal one, but returns the plan and the execution
+// profile collected across the delegators instead of the search results.
+func (node *Proxy) ExplainSearch(ctx context.Context, request *milvuspb.SearchRequest) (*explainpb.ExplainResults, error) {
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return &explainpb.ExplainResults{Status: merr.Status(err)}, nil
+	}
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-ExplainSearch")
+	defer sp.End()
+
+	if _, err := node.handleIfSearchByPK(ctx, request); err != nil {
+		return &explainpb.ExplainResults{Status: merr.Status(err)}, nil
+	}
+	qt := &searchTask{
+		baseTask: baseTask{
+			metaCache: node.getMetaCache(),
+		},
+		ctx:       ctx,
+		Condition: NewTaskCondition(ctx),
+		SearchRequest: &internalpb.SearchRequest{
+			Base: commonpbutil.NewMsgBase(
+				commonpbutil.WithMsgType(commonpb.MsgType_Search),
+				commonpbutil.WithSourceID(paramtable.GetNodeID()),
+			),
+			ReqID:        paramtable.GetNodeID(),
+			IsTopkReduce: true,
+			Explain:      true,
+		},
+		request:                request,
+		tr:                     timerecord.NewTimeRecorder("explainSearch"),
+		mixCoord:               node.mixCoord,
+		node:                   node,
+		lb:                     node.lbPolicy,
+		shardClientMgr:         node.shardMgr,
+		enableMaterializedView: node.enableMaterializedView,
+		mustUsePartitionKey:    Params.ProxyCfg.MustUsePartitionKey.GetAsBool(),
+		chMgr:                  node.chMgr,
+		explain:                true,
+	}
+	if err := node.executeExplain(ctx, "ExplainSearch", qt); err != nil {
+		return &explainpb.ExplainResults{Status: merr.Status(err)}, nil
+	}
+	return qt.explainResult, nil
+}
+
+// ExplainQuery executes the query as a normal one, but returns the plan and the execution
+// profile collected across the delegators instead of the query results.
+func (node *Proxy) ExplainQuery(ctx context.Context, request *milvuspb.QueryRequest) (*explainpb.ExplainResults, error) {
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return &explainpb.ExplainResults{Status: merr.Status(err)}, nil
+	}
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-ExplainQuery")
+	defer sp.End()
+
+	qt := &queryTask{
+		baseTask: baseTask{
+			metaCache: node.getMetaCache(),
+		},
+		ctx:       ctx,
+		Condition: NewTaskCondition(ctx),
+		RetrieveRequest: &internalpb.RetrieveRequest{
+			Base: commonpbutil.NewMsgBase(
+				commonpbutil.WithMsgType(commonpb.MsgType_Retrieve),
+				commonpbutil.WithSourceID(paramtable.GetNodeID()),
+			),
+			ReqID:            paramtable.GetNodeID(),
+			ConsistencyLevel: request.ConsistencyLevel,
+			QueryLabel:       metrics.QueryLabel,
+			Explain:          true,
+		},
+		request:             request,
+		mixCoord:            node.mixCoord,
+		lb:                  node.lbPolicy,
+		shardclientMgr:      node.shardMgr,
+		mustUsePartitionKey: Params.ProxyCfg.MustUsePartitionKey.GetAsBool(),
+		chMgr:               node.chMgr,
+		explain:             true,
+	}
+	if err := node.executeExplain(ctx, "ExplainQuery", qt); err != nil {
+		return &explainpb.ExplainResults{Status: merr.Status(err)}, nil
+	}
+	return qt.explainResult, nil
+}
+
+// executeExplain runs the explain task in the dql queue and waits for it.
+func (node *Proxy) executeExplain(ctx context.Context, method string, t task) error {
+	mlog.Debug(ctx, rpcReceived(method))
+	if err := node.sched.dqQueue.Enqueue(t); err != nil {
+		mlog.Warn(ctx, rpcFailedToEnqueue(method), mlog.Err(err))
+		return err
+	}
+	if err := t.WaitToFinish(); err != nil {
+		mlog.Warn(ctx, rpcFailedToWaitToFinish(method), mlog.Err(err))
+		return err
+	}
+	mlog.Debug(ctx, rpcDone(method))
+	return nil
+}
+
+// explainResult is the search or retrieve result of a delegator.
+type explainResult interface {
+	GetExplainProfile() *internalpb.ExplainShardProfile
+}
+
+// newExplainResults merges the shard profiles returned by the delegators.
+// The index usage always covers the fields in reportFieldIDs, and the fields indexed in any segment.
+func newExplainResults[T explainResult](
+	schema *schemapb.CollectionSchema,
+	plan map[string]interface{},
+	expr *planparserv2.ExprExplain,
+	reportFieldIDs []int64,
+	results []T,
+) (*explainpb.ExplainResults, error) {
+	explainPlan, err := newExplainStruct(plan)
+	if err != nil {
+		return nil, err
+	}
+	explainExpr, err := newExprExplain(expr)
+	if err != nil {
+		return nil, err
+	}
+	output := &explainpb.ExplainResults{
+		Status: merr.Success(),
+		Plan:   explainPlan,
+		Expr:   explainExpr,
+		Shards: make([]*explainpb.ShardProfile, 0, len(results)),
+		Summary: &explainpb.Summary{
+			IndexUsage: make(map[string]*explainpb.IndexUsage),
+		},
+	}
+	for _, result := range results {
+		if profile := result.GetExplainProfile(); profile != nil {
+			output.Shards = append(output.Shards, newShardProfile(profile))
+		}
+	}
+	sort.Slice(output.Shards, func(i, j int) bool { return output.Shards[i].GetChannel() < output.Shards[j].GetChannel() })
+
+	fieldIDs := typeutil.NewSet[int64](reportFieldIDs...)
+	for _, shard := range output.Shards {
+		for _, segment := range shard.GetSegments() {
+			for fieldID := range segment.GetIndexes() {
+				fieldIDs.Insert(fieldID)
+			}
+		}
+	}
+	fieldName := func(fieldID int64) string {
+		if field := typeutil.GetField(schema, fieldID); field != nil {
+			return field.GetName()
+		}
+		return strconv.FormatInt(fieldID, 10)
+	}
+
+	summary := output.Summary
+	summary.Shards = int64(len(output.Shards))
+	for _, shard := range output.Shards {
+		for _, prune := range shard.GetPruning() {
+			summary.PrunedSegments += int64(len(prune.GetPrunedSegmentIds()))
+		}
+		for _, segment := range shard.GetSegments() {
+			summary.ExecutedSegments++
+			summary.TotalRows += segment.GetNumRows()
+			if segment.GetSurvivedRows() >= 0 {
+				summary.SurvivedRows += segment.GetSurvivedRows()
+			}
+			for _, fieldID := range fieldIDs.Collect() {
+				indexType, ok := segment.GetIndexes()[fieldID]
+				if !ok {
+					indexType = noIndex
+				}
+				name := fieldName(fieldID)
+				if summary.IndexUsage[name] == nil {
+					summary.IndexUsage[name] = &explainpb.IndexUsage{Segments: make(map[string]int64)}
+				}
+				summary.IndexUsage[name].Segments[indexType]++
+			}
+		}
+	}
+	return output, nil
+}
+
+func newShardProfile(profile *internalpb.ExplainShardProfile) *explainpb.ShardProfile {
+	shard := &explainpb.ShardProfile{
+		Channel:  profile.GetChannel(),
+		NodeId:   profile.GetNodeID(),
+		Pruning:  make([]*explainpb.PruneProfile, 0, len(profile.GetPruning())),
+		Segments: make([]*explainpb.SegmentProfile, 0, len(profile.GetSegments())),
+		CostUs:   profile.GetCostUs(),
+	}
+	for _, prune := range profile.GetPruning() {
+		shard.Pruning = append(shard.Pruning, &explainpb.PruneProfile{
+			Pruner:           prune.GetPruner(),
+			SegmentsBefore:   prune.GetSegmentsBefore(),
+			PrunedSegmentIds: prune.GetPrunedSegmentIDs(),
+			CostUs:           prune.GetCostUs(),
+		})
+	}
+	for _, segment := range profile.GetSegments() {
+		shard.Segments = append(shard.Segments, &explainpb.SegmentProfile{
+			SegmentId:    segment.GetSegmentID(),
+			NodeId:       segment.GetNodeID(),
+			Growing:      segment.GetGrowing(),
+			NumRows:      segment.GetNumRows(),
+			SurvivedRows: segment.GetSurvivedRows(),
+			Indexes:      segment.GetIndexes(),
+			CostUs:       segment.GetCostUs(),
+		})
+	}
+	return shard
+}
+
+func newExprExplain(expr *planparserv2.ExprExplain) (*explainpb.ExprExplain, error) {
+	if expr == nil {
+		return nil, nil
+	}
+	parsed, err := newExplainValue(expr.Parsed)
+	if err != nil {
+		return nil, err
+	}
+	rewritten, err := newExplainValue(expr.Rewritten)
+	if err != nil {
+		return nil, err
+	}
+	return &explainpb.ExprExplain{
+		Expr:        expr.Expr,
+		Parsed:      parsed,
+		Rewritten:   rewritten,
+		IsRewritten: expr.IsRewritten,
+		Text:        expr.Text,
+	}, nil
+}
+
+// newExplainStruct converts the rendering of a plan, the renderings hold the go types
+// structpb doesn't accept, like []int64, so they are converted through json.
+func newExplainStruct(rendering map[string]interface{}) (*structpb.Struct, error) {
+	if rendering == nil {
+		return nil, nil
+	}
+	bs, err := json.Marshal(rendering)
+	if err != nil {
+		return nil, merr.WrapErrServiceInternalMsg("failed to marshal explain plan: %s", err.Error())
+	}
+	value := &structpb.Struct{}
+	if err := protojson.Unmarshal(bs, value); err != nil {
+		return nil, merr.WrapErrServiceInternalMsg("failed to convert explain plan: %s", err.Error())
+	}
+	return value, nil
+}
+
+// newExplainValue converts the rendering of an expression like newExplainStruct.
+func newExplainValue(rendering interface{}) (*structpb.Value, error) {
+	bs, err := json.Marshal(rendering)
+	if err != nil {
+		return nil, merr.WrapErrServiceInternalMsg("failed to marshal explain expression: %s", err.Error())
+	}
+	value := &structpb.Value{}
+	if err := protojson.Unmarshal(bs, value); err != nil {
+		return nil, merr.WrapErrServiceInternalMsg("failed to convert explain expression: %s", err.Error())
+	}
+	return value, nil
+}
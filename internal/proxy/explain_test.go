@@ -0,0 +1,102 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/schemapb"
+	"github.com/milvus-io/milvus/internal/parser/planparserv2"
+	"github.com/milvus-io/milvus/internal/util/explainutil"
+	"github.com/milvus-io/milvus/pkg/v3/proto/internalpb"
+)
+
+func TestNewExplainResults(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "vec", DataType: schemapb.DataType_FloatVector},
+			{FieldID: 102, Name: "age", DataType: schemapb.DataType_Int64},
+		},
+	}
+	results := []*internalpb.SearchResults{
+		{ExplainProfile: &internalpb.ExplainShardProfile{
+			Channel: "ch-2",
+			NodeID:  2,
+			Pruning: []*internalpb.ExplainPruneProfile{
+				{Pruner: explainutil.PrunerClusteringKey, SegmentsBefore: 3, PrunedSegmentIDs: []int64{3}},
+			},
+			Segments: []*internalpb.ExplainSegmentProfile{
+				{SegmentID: 1, NumRows: 100, SurvivedRows: 10, Indexes: map[int64]string{101: "HNSW", 102: "INVERTED"}},
+				{SegmentID: 2, NumRows: 50, SurvivedRows: -1},
+			},
+		}},
+		{ExplainProfile: &internalpb.ExplainShardProfile{
+			Channel: "ch-1",
+			NodeID:  1,
+			Segments: []*internalpb.ExplainSegmentProfile{
+				{SegmentID: 4, NumRows: 20, SurvivedRows: 5, Growing: true},
+			},
+		}},
+		// results without profile are skipped
+		{},
+	}
+	plan := map[string]interface{}{"node": "vector_anns", "output_field_ids": []int64{100, 102}}
+	expr := &planparserv2.ExprExplain{
+		Expr:        "age > 10",
+		Parsed:      map[string]interface{}{"expr": "age > 10"},
+		Rewritten:   map[string]interface{}{"expr": "age > 10"},
+		IsRewritten: false,
+		Text:        "text",
+	}
+
+	output, err := newExplainResults(schema, plan, expr, []int64{101}, results)
+	require.NoError(t, err)
+	assert.Equal(t, "vector_anns", output.GetPlan().GetFields()["node"].GetStringValue())
+	assert.Len(t, output.GetPlan().GetFields()["output_field_ids"].GetListValue().GetValues(), 2)
+	assert.Equal(t, "age > 10", output.GetExpr().GetExpr())
+	assert.Equal(t, "age > 10", output.GetExpr().GetParsed().GetStructValue().GetFields()["expr"].GetStringValue())
+	assert.Equal(t, "text", output.GetExpr().GetText())
+
+	require.Len(t, output.GetShards(), 2)
+	assert.Equal(t, "ch-1", output.GetShards()[0].GetChannel())
+	assert.Equal(t, int64(1), output.GetShards()[0].GetNodeId())
+	assert.Equal(t, "ch-2", output.GetShards()[1].GetChannel())
+	assert.Equal(t, []int64{3}, output.GetShards()[1].GetPruning()[0].GetPrunedSegmentIds())
+	assert.Equal(t, "HNSW", output.GetShards()[1].GetSegments()[0].GetIndexes()[101])
+
+	summary := output.GetSummary()
+	assert.Equal(t, int64(2), summary.GetShards())
+	assert.Equal(t, int64(1), summary.GetPrunedSegments())
+	assert.Equal(t, int64(3), summary.GetExecutedSegments())
+	assert.Equal(t, int64(170), summary.GetTotalRows())
+	assert.Equal(t, int64(15), summary.GetSurvivedRows())
+	assert.Equal(t, map[string]int64{"HNSW": 1, noIndex: 2}, summary.GetIndexUsage()["vec"].GetSegments())
+	assert.Equal(t, map[string]int64{"INVERTED": 1, noIndex: 2}, summary.GetIndexUsage()["age"].GetSegments())
+
+	t.Run("without plan and expr", func(t *testing.T) {
+		output, err := newExplainResults(schema, nil, nil, nil, []*internalpb.RetrieveResults{})
+		require.NoError(t, err)
+		assert.Nil(t, output.GetPlan())
+		assert.Nil(t, output.GetExpr())
+		assert.Empty(t, output.GetShards())
+		assert.Equal(t, int64(0), output.GetSummary().GetShards())
+	})
+}
@@ -0,0 +1,513 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
+	"go.uber.org/atomic"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v3/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v3/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/exportutil"
+	"github.com/milvus-io/milvus/pkg/v3/common"
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+	"github.com/milvus-io/milvus/pkg/v3/util/typeutil"
+)
+
+// ExportJobState is the state of an export job.
+type ExportJobState string
+
+const (
+	ExportJobPending    ExportJobState = "Pending"
+	ExportJobInProgress ExportJobState = "InProgress"
+	ExportJobCompleted  ExportJobState = "Completed"
+	ExportJobFailed     ExportJobState = "Failed"
+	ExportJobCanceled   ExportJobState = "Canceled"
+)
+
+var exportJobStates = map[datapb.ExportJobState]ExportJobState{
+	datapb.ExportJobState_ExportJobPending:    ExportJobPending,
+	datapb.ExportJobState_ExportJobInProgress: ExportJobInProgress,
+	datapb.ExportJobState_ExportJobCompleted:  ExportJobCompleted,
+	datapb.ExportJobState_ExportJobFailed:     ExportJobFailed,
+	datapb.ExportJobState_ExportJobCanceled:   ExportJobCanceled,
+}
+
+// ExportRequest describes the rows to be exported and where to put them.
+type ExportRequest struct {
+	DbName         string
+	CollectionName string
+	PartitionNames []string
+	Filter         string
+	OutputFields   []string
+	Format         string
+	// Path is the directory of exported files relative to the export root path
+	// dataCoord.export.rootPath, defaults to the job id.
+	Path string
+}
+
+// ExportJob is the snapshot of an export job.
+type ExportJob struct {
+	JobID          string         `json:"jobId"`
+	DbName         string         `json:"dbName"`
+	CollectionName string         `json:"collectionName"`
+	Format         string         `json:"format"`
+	Path           string         `json:"path"`
+	State          ExportJobState `json:"state"`
+	ExportedRows   int64          `json:"exportedRows"`
+	Files          []string       `json:"files"`
+	Reason         string         `json:"reason,omitempty"`
+	CreateTime     string         `json:"createTime"`
+	CompleteTime   string         `json:"completeTime,omitempty"`
+}
+
+func newExportJobView(job *datapb.ExportJob) *ExportJob {
+	return &ExportJob{
+		JobID:          strconv.FormatInt(job.GetJobID(), 10),
+		DbName:         job.GetDbName(),
+		CollectionName: job.GetCollectionName(),
+		Format:         job.GetFormat(),
+		Path:           job.GetPath(),
+		State:          exportJobStates[job.GetState()],
+		ExportedRows:   job.GetExportedRows(),
+		Files:          lo.Ternary(job.GetFiles() == nil, []string{}, job.GetFiles()),
+		Reason:         job.GetReason(),
+		CreateTime:     job.GetCreateTime(),
+		CompleteTime:   job.GetCompleteTime(),
+	}
+}
+
+type (
+	exportQueryFunc      func(ctx context.Context, req *milvuspb.QueryRequest) (*milvuspb.QueryResults, error)
+	exportCollectionFunc func(ctx context.Context, dbName, collectionName string) (*collectionInfo, error)
+	exportChunkMgrFunc   func(ctx context.Context, bucket string) (storage.ChunkManager, error)
+)
+
+var (
+	// exportAcquireInterval is the interval of acquiring pending jobs from datacoord.
+	exportAcquireInterval = 2 * time.Second
+	// exportReportInterval is the interval of reporting the progress of running jobs,
+	// it must be much shorter than the lease dataCoord.export.leaseTimeout.
+	exportReportInterval = 5 * time.Second
+)
+
+// ExportManager serves the export requests and executes export jobs. The jobs are
+// owned and scheduled by datacoord, the manager acquires pending jobs from datacoord,
+// pages through the query results by primary key, writes the rows into object
+// storage and renews the lease of the job by reporting the progress periodically.
+type ExportManager struct {
+	ctx    context.Context
+	wg     sync.WaitGroup
+	nodeID int64
+
+	coord         types.MixCoordClient
+	query         exportQueryFunc
+	getCollection exportCollectionFunc
+	newCM         exportChunkMgrFunc
+
+	mu      sync.Mutex
+	cms     map[string]storage.ChunkManager
+	running map[int64]context.CancelFunc
+}
+
+func newExportManager(ctx context.Context, nodeID int64, coord types.MixCoordClient, query exportQueryFunc,
+	getCollection exportCollectionFunc, newCM exportChunkMgrFunc,
+) *ExportManager {
+	return &ExportManager{
+		ctx:           ctx,
+		nodeID:        nodeID,
+		coord:         coord,
+		query:         query,
+		getCollection: getCollection,
+		newCM:         newCM,
+		cms:           make(map[string]storage.ChunkManager),
+		running:       make(map[int64]context.CancelFunc),
+	}
+}
+
+// Create validates the request and submits an export job to datacoord.
+func (m *ExportManager) Create(ctx context.Context, req *ExportRequest) (string, error) {
+	if _, err := exportutil.ParseFormat(req.Format); err != nil {
+		return "", err
+	}
+	coll, err := m.getCollection(ctx, req.DbName, req.CollectionName)
+	if err != nil {
+		return "", err
+	}
+	if coll.Schema.PkField == nil {
+		return "", merr.WrapErrParameterInvalidMsg("primary key not found in collection %s", req.CollectionName)
+	}
+	outputFields := req.OutputFields
+	if len(outputFields) == 0 || lo.Contains(outputFields, "*") {
+		outputFields = lo.FilterMap(coll.Schema.GetFields(), func(field *schemapb.FieldSchema, _ int) (string, bool) {
+			return field.GetName(), !field.GetIsFunctionOutput() && field.GetFieldID() >= common.StartOfUserFieldID
+		})
+	}
+
+	resp, err := m.coord.CreateExportJob(ctx, &datapb.CreateExportJobRequest{
+		DbID:           coll.DBID,
+		DbName:         req.DbName,
+		CollectionID:   coll.CollID,
+		CollectionName: req.CollectionName,
+		PartitionNames: req.PartitionNames,
+		Filter:         req.Filter,
+		OutputFields:   outputFields,
+		Format:         req.Format,
+		Path:           req.Path,
+	})
+	if err = merr.CheckRPCCall(resp, err); err != nil {
+		return "", err
+	}
+	return strconv.FormatInt(resp.GetJobID(), 10), nil
+}
+
+// Get returns the snapshot of the job.
+func (m *ExportManager) Get(ctx context.Context, jobID string) (*ExportJob, error) {
+	id, err := parseExportJobID(jobID)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := m.coord.GetExportJob(ctx, &datapb.GetExportJobRequest{JobID: id})
+	if err = merr.CheckRPCCall(resp, err); err != nil {
+		return nil, err
+	}
+	return newExportJobView(resp.GetJob()), nil
+}
+
+// List returns the snapshots of jobs, filtered by database and collection if provided.
+func (m *ExportManager) List(ctx context.Context, dbName, collectionName string) ([]*ExportJob, error) {
+	req := &datapb.ListExportJobsRequest{}
+	if collectionName != "" {
+		coll, err := m.getCollection(ctx, dbName, collectionName)
+		if err != nil {
+			return nil, err
+		}
+		req.CollectionID = coll.CollID
+	}
+	resp, err := m.coord.ListExportJobs(ctx, req)
+	if err = merr.CheckRPCCall(resp, err); err != nil {
+		return nil, err
+	}
+	jobs := make([]*ExportJob, 0, len(resp.GetJobs()))
+	for _, job := range resp.GetJobs() {
+		if dbName != "" && job.GetDbName() != dbName {
+			continue
+		}
+		jobs = append(jobs, newExportJobView(job))
+	}
+	return jobs, nil
+}
+
+// Cancel cancels the unfinished job, the proxy executing the job stops it once
+// its progress report is rejected by datacoord.
+func (m *ExportManager) Cancel(ctx context.Context, jobID string) error {
+	id, err := parseExportJobID(jobID)
+	if err != nil {
+		return err
+	}
+	status, err := m.coord.CancelExportJob(ctx, &datapb.CancelExportJobRequest{JobID: id})
+	if err = merr.CheckRPCCall(status, err); err != nil {
+		return err
+	}
+	m.mu.Lock()
+	if cancel, ok := m.running[id]; ok {
+		cancel()
+	}
+	m.mu.Unlock()
+	return nil
+}
+
+// Start starts acquiring and executing the export jobs until the context is done.
+func (m *ExportManager) Start() {
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		ticker := time.NewTicker(exportAcquireInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-m.ctx.Done():
+				return
+			case <-ticker.C:
+				m.tryAcquire()
+			}
+		}
+	}()
+}
+
+// Wait waits for all the running jobs to quit.
+func (m *ExportManager) Wait() {
+	m.wg.Wait()
+}
+
+func (m *ExportManager) runningNum() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.running)
+}
+
+func (m *ExportManager) tryAcquire() {
+	if m.runningNum() >= Params.ProxyCfg.ExportMaxConcurrentJobs.GetAsInt() {
+		return
+	}
+	resp, err := m.coord.AcquireExportJob(m.ctx, &datapb.AcquireExportJobRequest{NodeID: m.nodeID})
+	if err = merr.CheckRPCCall(resp, err); err != nil {
+		mlog.Warn(m.ctx, "failed to acquire export job", mlog.Err(err))
+		return
+	}
+	job := resp.GetJob()
+	if job == nil {
+		return
+	}
+	ctx, cancel := context.WithCancel(m.ctx)
+	m.mu.Lock()
+	m.running[job.GetJobID()] = cancel
+	m.mu.Unlock()
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		defer func() {
+			m.mu.Lock()
+			delete(m.running, job.GetJobID())
+			m.mu.Unlock()
+			cancel()
+		}()
+		m.run(ctx, job)
+	}()
+}
+
+func (m *ExportManager) getChunkManager(ctx context.Context, bucket string) (storage.ChunkManager, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if cm, ok := m.cms[bucket]; ok {
+		return cm, nil
+	}
+	cm, err := m.newCM(ctx, bucket)
+	if err != nil {
+		return nil, err
+	}
+	m.cms[bucket] = cm
+	return cm, nil
+}
+
+func (m *ExportManager) report(ctx context.Context, job *datapb.ExportJob, state datapb.ExportJobState,
+	rows int64, files []string, reason string,
+) error {
+	status, err := m.coord.ReportExportJob(ctx, &datapb.ReportExportJobRequest{
+		JobID:        job.GetJobID(),
+		NodeID:       m.nodeID,
+		State:        state,
+		ExportedRows: rows,
+		Files:        files,
+		Reason:       reason,
+	})
+	return merr.CheckRPCCall(status, err)
+}
+
+// run executes the job. The job is stopped once datacoord rejects the progress
+// report, e.g. the job is canceled or rescheduled to another proxy after the lease
+// expired. If the proxy is stopping, the job is left to be rescheduled by datacoord.
+func (m *ExportManager) run(ctx context.Context, job *datapb.ExportJob) {
+	log := mlog.With(mlog.FieldJobID(job.GetJobID()),
+		mlog.String("dbName", job.GetDbName()), mlog.String("collection", job.GetCollectionName()))
+	log.Info(ctx, "start export job", mlog.String("path", job.GetPath()), mlog.Int64("retryTimes", job.GetRetryTimes()))
+
+	rows := atomic.NewInt64(0)
+	rejected := atomic.NewBool(false)
+	jobCtx, cancel := context.WithCancel(ctx)
+	heartbeatDone := make(chan struct{})
+	go func() {
+		defer close(heartbeatDone)
+		ticker := time.NewTicker(exportReportInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-jobCtx.Done():
+				return
+			case <-ticker.C:
+				err := m.report(jobCtx, job, datapb.ExportJobState_ExportJobInProgress, rows.Load(), nil, "")
+				if errors.Is(err, merr.ErrParameterInvalid) {
+					log.Warn(jobCtx, "export progress rejected, stop the job", mlog.Err(err))
+					rejected.Store(true)
+					cancel()
+					return
+				} else if err != nil {
+					log.Warn(jobCtx, "failed to report export progress, retry later", mlog.Err(err))
+				}
+			}
+		}
+	}()
+
+	files, err := m.execute(jobCtx, job, rows)
+	cancel()
+	<-heartbeatDone
+	if ctx.Err() != nil || rejected.Load() {
+		log.Info(m.ctx, "export job stopped", mlog.Bool("rejected", rejected.Load()), mlog.Bool("proxyStopping", m.ctx.Err() != nil))
+		return
+	}
+
+	state, reason := datapb.ExportJobState_ExportJobCompleted, ""
+	if err != nil {
+		state, reason = datapb.ExportJobState_ExportJobFailed, err.Error()
+	}
+	if err := m.report(ctx, job, state, rows.Load(), files, reason); err != nil {
+		log.Warn(ctx, "failed to report the export result", mlog.String("state", state.String()), mlog.Err(err))
+		return
+	}
+	if reason != "" {
+		log.Warn(ctx, "export job failed", mlog.String("reason", reason))
+		return
+	}
+	log.Info(ctx, "export job completed", mlog.Int64("rows", rows.Load()), mlog.Int("files", len(files)))
+}
+
+func (m *ExportManager) execute(ctx context.Context, job *datapb.ExportJob, rows *atomic.Int64) ([]string, error) {
+	format, err := exportutil.ParseFormat(job.GetFormat())
+	if err != nil {
+		return nil, err
+	}
+	coll, err := m.getCollection(ctx, job.GetDbName(), job.GetCollectionName())
+	if err != nil {
+		return nil, err
+	}
+	if coll.CollID != job.GetCollectionID() {
+		return nil, merr.WrapErrCollectionNotFoundWithDB(job.GetDbName(), job.GetCollectionName(),
+			fmt.Sprintf("collection %d is dropped", job.GetCollectionID()))
+	}
+	pkField := coll.Schema.PkField
+	if pkField == nil {
+		return nil, merr.WrapErrParameterInvalidMsg("primary key not found in collection %s", job.GetCollectionName())
+	}
+	cm, err := m.getChunkManager(ctx, job.GetBucket())
+	if err != nil {
+		return nil, err
+	}
+	// The job is rerun from scratch after it's rescheduled, remove the files written by the former
+	// attempts so that they are not mixed with the files of this one. The directory is dedicated to
+	// the job, see exportutil.Overlaps. The local storage fails to walk a missing directory.
+	err = cm.RemoveWithPrefix(ctx, strings.TrimSuffix(job.GetPath(), "/")+"/")
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, merr.Wrapf(err, "failed to clear export path %s", job.GetPath())
+	}
+	writer, err := exportutil.NewWriter(cm, coll.Schema.CollectionSchema, job.GetOutputFields(), format, job.GetPath(),
+		Params.ProxyCfg.ExportMaxFileSize.GetAsInt64())
+	if err != nil {
+		return nil, err
+	}
+
+	req := &milvuspb.QueryRequest{
+		DbName:                job.GetDbName(),
+		CollectionName:        job.GetCollectionName(),
+		PartitionNames:        job.GetPartitionNames(),
+		OutputFields:          lo.Uniq(append(job.GetOutputFields(), pkField.GetName())),
+		UseDefaultConsistency: true,
+	}
+	err = m.export(ctx, writer, req, job.GetFilter(), pkField, rows)
+	if err != nil {
+		return nil, err
+	}
+	files, err := writer.Close(ctx)
+	rows.Store(writer.RowCount())
+	return files, err
+}
+
+// export pages through the query results ordered by primary key, the guarantee
+// timestamp is fixed to the session ts of the first page so that all the pages
+// are read from the same snapshot.
+func (m *ExportManager) export(ctx context.Context, writer exportutil.Writer,
+	req *milvuspb.QueryRequest, filter string, pkField *schemapb.FieldSchema, rows *atomic.Int64,
+) error {
+	batchSize := Params.ProxyCfg.ExportBatchSize.GetAsInt()
+	var lastPK any
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		req.Expr = composeExportExpr(filter, pkField, lastPK)
+		req.QueryParams = []*commonpb.KeyValuePair{
+			{Key: IteratorField, Value: "true"},
+			{Key: ReduceStopForBestKey, Value: "true"},
+			{Key: LimitKey, Value: strconv.Itoa(batchSize)},
+		}
+		resp, err := m.query(ctx, req)
+		if err = merr.CheckRPCCall(resp, err); err != nil {
+			return err
+		}
+		if req.GetGuaranteeTimestamp() == 0 && resp.GetSessionTs() > 0 {
+			req.GuaranteeTimestamp = resp.GetSessionTs()
+		}
+
+		pkData, ok := lo.Find(resp.GetFieldsData(), func(fd *schemapb.FieldData) bool {
+			return fd.GetFieldId() == pkField.GetFieldID()
+		})
+		if !ok {
+			return merr.WrapErrServiceInternalMsg("primary key not found in query results")
+		}
+		numRows := typeutil.GetPKSize(pkData)
+		if numRows == 0 {
+			return nil
+		}
+		if err := writer.Write(ctx, resp.GetFieldsData()); err != nil {
+			return err
+		}
+		if pkField.GetDataType() == schemapb.DataType_VarChar {
+			lastPK = pkData.GetScalars().GetStringData().GetData()[numRows-1]
+		} else {
+			lastPK = pkData.GetScalars().GetLongData().GetData()[numRows-1]
+		}
+		rows.Store(writer.RowCount())
+	}
+}
+
+func parseExportJobID(jobID string) (int64, error) {
+	id, err := strconv.ParseInt(jobID, 10, 64)
+	if err != nil {
+		return 0, merr.WrapErrParameterInvalidMsg("invalid export job id '%s'", jobID)
+	}
+	return id, nil
+}
+
+func composeExportExpr(filter string, pkField *schemapb.FieldSchema, lastPK any) string {
+	filter = strings.TrimSpace(filter)
+	if lastPK == nil {
+		return filter
+	}
+	var pkFilter string
+	if pkField.GetDataType() == schemapb.DataType_VarChar {
+		pkFilter = fmt.Sprintf("%s > %s", pkField.GetName(), strconv.Quote(lastPK.(string)))
+	} else {
+		pkFilter = fmt.Sprintf("%s > %d", pkField.GetName(), lastPK)
+	}
+	if filter == "" {
+		return pkFilter
+	}
+	return fmt.Sprintf("(%s) and %s", filter, pkFilter)
+}
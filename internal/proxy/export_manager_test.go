@@ -0,0 +1,328 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"path"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v3/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v3/schemapb"
+	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/v3/objectstorage"
+	"github.com/milvus-io/milvus/pkg/v3/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+func newTestExportQuery(total int64, exprs *[]string, tss *[]uint64) exportQueryFunc {
+	return func(ctx context.Context, req *milvuspb.QueryRequest) (*milvuspb.QueryResults, error) {
+		*exprs = append(*exprs, req.GetExpr())
+		*tss = append(*tss, req.GetGuaranteeTimestamp())
+		start := int64(len(*exprs)-1) * 4
+		pks := make([]int64, 0)
+		values := make([]float64, 0)
+		for i := start; i < start+4 && i < total; i++ {
+			pks = append(pks, i)
+			values = append(values, float64(i)*0.5)
+		}
+		return &milvuspb.QueryResults{
+			Status: merr.Success(),
+			FieldsData: []*schemapb.FieldData{
+				{
+					FieldId: 100, FieldName: "pk", Type: schemapb.DataType_Int64,
+					Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: pks}},
+					}},
+				},
+				{
+					FieldId: 101, FieldName: "value", Type: schemapb.DataType_Double,
+					Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_DoubleData{DoubleData: &schemapb.DoubleArray{Data: values}},
+					}},
+				},
+			},
+			SessionTs: 1000,
+		}, nil
+	}
+}
+
+func newTestExportCollection(t *testing.T) exportCollectionFunc {
+	schema := &schemapb.CollectionSchema{
+		Name: "test_export",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "value", DataType: schemapb.DataType_Double},
+		},
+	}
+	info, err := newSchemaInfo(schema)
+	require.NoError(t, err)
+	return func(ctx context.Context, dbName, collectionName string) (*collectionInfo, error) {
+		if collectionName != "test_export" {
+			return nil, merr.WrapErrCollectionNotFound(collectionName)
+		}
+		return &collectionInfo{CollID: 1, DBID: 2, DBName: dbName, Schema: info}, nil
+	}
+}
+
+func TestExportManager_Run(t *testing.T) {
+	paramtable.Get().Save(Params.ProxyCfg.ExportBatchSize.Key, "4")
+	defer paramtable.Get().Reset(Params.ProxyCfg.ExportBatchSize.Key)
+
+	const total = 10
+	var (
+		exprs []string
+		tss   []uint64
+	)
+	cm := storage.NewLocalChunkManager(objectstorage.RootPath(t.TempDir()))
+	coord := mocks.NewMockMixCoordClient(t)
+	var reports []*datapb.ReportExportJobRequest
+	coord.EXPECT().ReportExportJob(mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, req *datapb.ReportExportJobRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+			reports = append(reports, req)
+			return merr.Success(), nil
+		})
+	mgr := newExportManager(context.Background(), 10, coord, newTestExportQuery(total, &exprs, &tss),
+		newTestExportCollection(t),
+		func(ctx context.Context, bucket string) (storage.ChunkManager, error) {
+			return cm, nil
+		},
+	)
+
+	// the export path doesn't exist before the first attempt
+	dir := path.Join(t.TempDir(), "export")
+	mgr.run(context.Background(), &datapb.ExportJob{
+		JobID:          1,
+		DbName:         "default",
+		CollectionID:   1,
+		CollectionName: "test_export",
+		Filter:         "value >= 0",
+		OutputFields:   []string{"pk", "value"},
+		Format:         "jsonl",
+		Path:           dir,
+	})
+	require.Len(t, reports, 1)
+	report := reports[0]
+	assert.Equal(t, datapb.ExportJobState_ExportJobCompleted, report.GetState(), report.GetReason())
+	assert.Equal(t, int64(10), report.GetNodeID())
+	assert.Equal(t, int64(total), report.GetExportedRows())
+	require.Len(t, report.GetFiles(), 1)
+	assert.Equal(t, []string{"value >= 0", "(value >= 0) and pk > 3", "(value >= 0) and pk > 7", "(value >= 0) and pk > 9"}, exprs)
+	assert.Equal(t, []uint64{0, 1000, 1000, 1000}, tss)
+
+	content, err := cm.Read(context.Background(), report.GetFiles()[0])
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	assert.Len(t, lines, total)
+	assert.JSONEq(t, `{"pk":9,"value":4.5}`, lines[total-1])
+
+	// the rerun after the job is rescheduled removes the files of the former attempt
+	reports, exprs, tss = nil, nil, nil
+	stale := path.Join(dir, "2.jsonl")
+	require.NoError(t, cm.Write(context.Background(), stale, []byte("stale")))
+	mgr.run(context.Background(), &datapb.ExportJob{
+		JobID:          1,
+		CollectionID:   1,
+		CollectionName: "test_export",
+		Format:         "jsonl",
+		Path:           dir,
+	})
+	require.Len(t, reports, 1)
+	assert.Equal(t, datapb.ExportJobState_ExportJobCompleted, reports[0].GetState(), reports[0].GetReason())
+	assert.Equal(t, []string{path.Join(dir, "1.jsonl")}, reports[0].GetFiles())
+	exist, err := cm.Exist(context.Background(), stale)
+	require.NoError(t, err)
+	assert.False(t, exist)
+
+	// the collection is dropped and recreated
+	reports = nil
+	mgr.run(context.Background(), &datapb.ExportJob{JobID: 2, CollectionID: 3, CollectionName: "test_export", Path: dir})
+	require.Len(t, reports, 1)
+	assert.Equal(t, datapb.ExportJobState_ExportJobFailed, reports[0].GetState())
+	assert.NotEmpty(t, reports[0].GetReason())
+
+	// query failure fails the job
+	reports = nil
+	failed := newExportManager(context.Background(), 10, coord,
+		func(ctx context.Context, req *milvuspb.QueryRequest) (*milvuspb.QueryResults, error) {
+			return &milvuspb.QueryResults{Status: merr.Status(merr.WrapErrCollectionNotLoaded("test_export"))}, nil
+		},
+		newTestExportCollection(t),
+		func(ctx context.Context, bucket string) (storage.ChunkManager, error) {
+			return cm, nil
+		},
+	)
+	failed.run(context.Background(), &datapb.ExportJob{JobID: 3, CollectionID: 1, CollectionName: "test_export", Path: dir})
+	require.Len(t, reports, 1)
+	assert.Equal(t, datapb.ExportJobState_ExportJobFailed, reports[0].GetState())
+
+	// the canceled job is not reported
+	reports = nil
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	mgr.run(ctx, &datapb.ExportJob{JobID: 4, CollectionID: 1, CollectionName: "test_export", Path: dir})
+	assert.Len(t, reports, 0)
+}
+
+func TestExportManager_Rejected(t *testing.T) {
+	origin := exportReportInterval
+	exportReportInterval = 10 * time.Millisecond
+	defer func() { exportReportInterval = origin }()
+
+	coord := mocks.NewMockMixCoordClient(t)
+	var finalReports atomic.Int32
+	coord.EXPECT().ReportExportJob(mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, req *datapb.ReportExportJobRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+			if req.GetState() != datapb.ExportJobState_ExportJobInProgress {
+				finalReports.Inc()
+			}
+			return merr.Status(merr.WrapErrParameterInvalidMsg("export job 1 is Canceled")), nil
+		})
+	// the query blocks until the job is stopped
+	query := func(ctx context.Context, req *milvuspb.QueryRequest) (*milvuspb.QueryResults, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	cm := storage.NewLocalChunkManager(objectstorage.RootPath(t.TempDir()))
+	mgr := newExportManager(context.Background(), 10, coord, query, newTestExportCollection(t),
+		func(ctx context.Context, bucket string) (storage.ChunkManager, error) {
+			return cm, nil
+		},
+	)
+	mgr.run(context.Background(), &datapb.ExportJob{JobID: 1, CollectionID: 1, CollectionName: "test_export", Path: t.TempDir()})
+	assert.Equal(t, int32(0), finalReports.Load())
+}
+
+func TestExportManager_Acquire(t *testing.T) {
+	paramtable.Get().Save(Params.ProxyCfg.ExportMaxConcurrentJobs.Key, "1")
+	defer paramtable.Get().Reset(Params.ProxyCfg.ExportMaxConcurrentJobs.Key)
+
+	coord := mocks.NewMockMixCoordClient(t)
+	coord.EXPECT().AcquireExportJob(mock.Anything, mock.Anything).Return(nil, errors.New("mock err")).Once()
+	coord.EXPECT().AcquireExportJob(mock.Anything, mock.Anything).Return(&datapb.AcquireExportJobResponse{Status: merr.Success()}, nil).Once()
+	coord.EXPECT().AcquireExportJob(mock.Anything, mock.Anything).Return(&datapb.AcquireExportJobResponse{
+		Status: merr.Success(),
+		Job:    &datapb.ExportJob{JobID: 1, CollectionID: 1, CollectionName: "test_export"},
+	}, nil).Once()
+	done := make(chan struct{})
+	coord.EXPECT().ReportExportJob(mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, req *datapb.ReportExportJobRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+			<-done
+			return merr.Success(), nil
+		})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	mgr := newExportManager(ctx, 10, coord, nil, newTestExportCollection(t),
+		func(ctx context.Context, bucket string) (storage.ChunkManager, error) {
+			return nil, errors.New("mock err")
+		},
+	)
+	mgr.tryAcquire()
+	mgr.tryAcquire()
+	mgr.tryAcquire()
+	assert.Equal(t, 1, mgr.runningNum())
+	// reach the max concurrent jobs, datacoord is not called
+	mgr.tryAcquire()
+	close(done)
+	assert.Eventually(t, func() bool { return mgr.runningNum() == 0 }, 5*time.Second, 10*time.Millisecond)
+	cancel()
+	mgr.Wait()
+}
+
+func TestExportManager_Requests(t *testing.T) {
+	ctx := context.Background()
+	coord := mocks.NewMockMixCoordClient(t)
+	mgr := newExportManager(ctx, 10, coord, nil, newTestExportCollection(t), nil)
+
+	// invalid format
+	_, err := mgr.Create(ctx, &ExportRequest{CollectionName: "test_export", Format: "csv"})
+	assert.Error(t, err)
+	_, err = mgr.Create(ctx, &ExportRequest{CollectionName: "not_exist"})
+	assert.Error(t, err)
+
+	coord.EXPECT().CreateExportJob(mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, req *datapb.CreateExportJobRequest, opts ...grpc.CallOption) (*datapb.CreateExportJobResponse, error) {
+			assert.Equal(t, int64(1), req.GetCollectionID())
+			assert.Equal(t, int64(2), req.GetDbID())
+			assert.Equal(t, []string{"pk", "value"}, req.GetOutputFields())
+			assert.Equal(t, "spark", req.GetPath())
+			return &datapb.CreateExportJobResponse{Status: merr.Success(), JobID: 100}, nil
+		}).Once()
+	jobID, err := mgr.Create(ctx, &ExportRequest{DbName: "default", CollectionName: "test_export", Path: "spark"})
+	assert.NoError(t, err)
+	assert.Equal(t, "100", jobID)
+
+	coord.EXPECT().CreateExportJob(mock.Anything, mock.Anything).Return(&datapb.CreateExportJobResponse{
+		Status: merr.Status(merr.WrapErrParameterInvalidMsg("export path overlaps")),
+	}, nil).Once()
+	_, err = mgr.Create(ctx, &ExportRequest{DbName: "default", CollectionName: "test_export", Path: "spark"})
+	assert.Error(t, err)
+
+	job := &datapb.ExportJob{
+		JobID: 100, DbName: "default", CollectionName: "test_export", Format: "parquet", Path: "export/spark",
+		State: datapb.ExportJobState_ExportJobCanceled, Reason: "canceled by user",
+	}
+	_, err = mgr.Get(ctx, "abc")
+	assert.Error(t, err)
+	coord.EXPECT().GetExportJob(mock.Anything, mock.Anything).Return(&datapb.GetExportJobResponse{Status: merr.Success(), Job: job}, nil).Once()
+	view, err := mgr.Get(ctx, "100")
+	assert.NoError(t, err)
+	assert.Equal(t, "100", view.JobID)
+	assert.Equal(t, ExportJobCanceled, view.State)
+	assert.Equal(t, []string{}, view.Files)
+
+	coord.EXPECT().ListExportJobs(mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, req *datapb.ListExportJobsRequest, opts ...grpc.CallOption) (*datapb.ListExportJobsResponse, error) {
+			return &datapb.ListExportJobsResponse{Status: merr.Success(), Jobs: []*datapb.ExportJob{job}}, nil
+		})
+	jobs, err := mgr.List(ctx, "default", "test_export")
+	assert.NoError(t, err)
+	assert.Len(t, jobs, 1)
+	jobs, err = mgr.List(ctx, "other", "")
+	assert.NoError(t, err)
+	assert.Len(t, jobs, 0)
+	_, err = mgr.List(ctx, "default", "not_exist")
+	assert.Error(t, err)
+
+	coord.EXPECT().CancelExportJob(mock.Anything, mock.Anything).Return(merr.Success(), nil).Once()
+	assert.NoError(t, mgr.Cancel(ctx, "100"))
+	coord.EXPECT().CancelExportJob(mock.Anything, mock.Anything).Return(nil, errors.New("mock err")).Once()
+	assert.Error(t, mgr.Cancel(ctx, "100"))
+	assert.Error(t, mgr.Cancel(ctx, ""))
+}
+
+func TestComposeExportExpr(t *testing.T) {
+	int64PK := &schemapb.FieldSchema{Name: "id", DataType: schemapb.DataType_Int64}
+	varcharPK := &schemapb.FieldSchema{Name: "id", DataType: schemapb.DataType_VarChar}
+
+	assert.Equal(t, "", composeExportExpr("", int64PK, nil))
+	assert.Equal(t, "id > 10", composeExportExpr(" ", int64PK, int64(10)))
+	assert.Equal(t, `(a > 1) and id > "x\"y"`, composeExportExpr("a > 1", varcharPK, `x"y`))
+}
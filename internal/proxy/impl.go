@@ -50,6 +50,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proxy/connection"
 	"github.com/milvus-io/milvus/internal/proxy/privilege"
 	"github.com/milvus-io/milvus/internal/proxy/replicate"
+	"github.com/milvus-io/milvus/internal/proxy/slowlog"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/fileresource"
 	"github.com/milvus-io/milvus/internal/util/hookutil"
@@ -159,6 +160,7 @@ func (node *Proxy) InvalidateCollectionMetaCache(ctx context.Context, request *p
 	collectionName := request.CollectionName
 	collectionID := request.CollectionID
 	msgType := request.GetBase().GetMsgType()
+	node.expireSearchResults(collectionID, request.GetBase().GetTimestamp())
 	var aliasName []string
 	// The shard leader cache is keyed by the cluster-unique collection id (issue #51533), so an
 	// alias/collection name is no longer a cache key. Evicting the affected collection id covers
@@ -2487,6 +2489,13 @@ func (node *Proxy) Insert(ctx context.Context, request *milvuspb.InsertRequest)
 		}, nil
 	}
 
+	ctx, err := node.bindTransaction(ctx, request.GetDbName(), request.GetCollectionName())
+	if err != nil {
+		return &milvuspb.MutationResult{
+			Status: merr.Status(err),
+		}, nil
+	}
+
 	method := "Insert"
 	tr := timerecord.NewTimeRecorder(method)
 	metrics.GetStats(ctx).
@@ -2543,6 +2552,8 @@ func (node *Proxy) Insert(ctx context.Context, request *milvuspb.InsertRequest)
 		mlog.Warn(context.TODO(), "Failed to enqueue insert task: "+err.Error())
 		return constructFailedResponse(err), nil
 	}
+	// the insert may be partially applied even if the task fails
+	defer func() { node.expireSearchResults(it.collectionID, it.result.GetTimestamp()) }()
 
 	mlog.Debug(context.TODO(), "Detail of insert request in Proxy")
 
@@ -2627,6 +2638,13 @@ func (node *Proxy) Delete(ctx context.Context, request *milvuspb.DeleteRequest)
 		}, nil
 	}
 
+	ctx, err := node.bindTransaction(ctx, request.GetDbName(), request.GetCollectionName())
+	if err != nil {
+		return &milvuspb.MutationResult{
+			Status: merr.Status(err),
+		}, nil
+	}
+
 	tr := timerecord.NewTimeRecorder(method)
 
 	var limiter types.Limiter
@@ -2655,6 +2673,7 @@ func (node *Proxy) Delete(ctx context.Context, request *milvuspb.DeleteRequest)
 	}
 
 	mlog.Debug(context.TODO(), "Run delete in Proxy")
+	defer func() { node.expireSearchResults(dr.collectionID, dr.result.GetTimestamp()) }()
 
 	if err := dr.Run(ctx); err != nil {
 		mlog.Error(context.TODO(), "Failed to run delete task: "+err.Error())
@@ -2724,6 +2743,18 @@ func (node *Proxy) Upsert(ctx context.Context, request *milvuspb.UpsertRequest)
 		}, nil
 	}
 
+	ctx, err := node.bindTransaction(ctx, request.GetDbName(), request.GetCollectionName())
+	if err != nil {
+		return &milvuspb.MutationResult{
+			Status: merr.Status(err),
+		}, nil
+	}
+	if request.GetPartialUpdate() && clientTxnFromContext(ctx) != nil {
+		return &milvuspb.MutationResult{
+			Status: merr.Status(merr.WrapErrParameterInvalidMsg("partial update is not supported in transaction")),
+		}, nil
+	}
+
 	method := "Upsert"
 	tr := timerecord.NewTimeRecorder(method)
 
@@ -2772,6 +2803,8 @@ func (node *Proxy) Upsert(ctx context.Context, request *milvuspb.UpsertRequest)
 			Status: merr.Status(err),
 		}, nil
 	}
+	// the upsert may be partially applied even if the task fails
+	defer func() { node.expireSearchResults(it.collectionID, it.result.GetTimestamp()) }()
 
 	mlog.Debug(context.TODO(), "Detail of upsert request in Proxy",
 		mlog.Uint64("BeginTS", it.BeginTs()),
@@ -2866,6 +2899,12 @@ func GetCollectionRateSubLabel(req any) string {
 
 // Search searches the most similar records of requests.
 func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, error) {
+	// the key is computed before the search, which may rewrite the request
+	cacheKey := node.searchResultCacheKey(ctx, request)
+	if cached, ok := node.searchResultCache.Get(cacheKey); ok {
+		return cached, nil
+	}
+
 	var err error
 	rsp := &milvuspb.SearchResults{
 		Status: merr.Success(),
@@ -2929,6 +2968,9 @@ func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest)
 		rsp.Status = merr.Status(err)
 	}
 	projectSearchResultValidDataForLegacy(rsp)
+	if merr.Ok(rsp.GetStatus()) {
+		node.searchResultCache.Put(cacheKey, rsp)
+	}
 	return rsp, nil
 }
 
@@ -3019,6 +3061,7 @@ func (node *Proxy) search(ctx context.Context, request *milvuspb.SearchRequest,
 		enableMaterializedView: node.enableMaterializedView,
 		mustUsePartitionKey:    Params.ProxyCfg.MustUsePartitionKey.GetAsBool(),
 		chMgr:                  node.chMgr,
+		slowTrace:              slowlog.NewTraceIfEnabled(),
 	}
 
 	succeeded := false
@@ -3027,6 +3070,7 @@ func (node *Proxy) search(ctx context.Context, request *milvuspb.SearchRequest,
 			return
 		}
 		span := tr.ElapseSpan()
+		node.recordSlowSearch(ctx, qt, request, span, getTraceID(sp))
 		spanPerNq := span
 		if qt.GetNq() > 0 {
 			spanPerNq = span / time.Duration(qt.GetNq())
@@ -3252,6 +3296,7 @@ func (node *Proxy) hybridSearch(ctx context.Context, request *milvuspb.HybridSea
 		shardClientMgr:      node.shardMgr,
 		mustUsePartitionKey: Params.ProxyCfg.MustUsePartitionKey.GetAsBool(),
 		chMgr:               node.chMgr,
+		slowTrace:           slowlog.NewTraceIfEnabled(),
 	}
 
 	succeeded := false
@@ -3260,6 +3305,7 @@ func (node *Proxy) hybridSearch(ctx context.Context, request *milvuspb.HybridSea
 			return
 		}
 		span := tr.ElapseSpan()
+		node.recordSlowSearch(ctx, qt, newSearchReq, span, getTraceID(sp))
 		spanPerNq := span
 		var totalNq int64
 		for _, subReq := range request.GetRequests() {
@@ -3739,6 +3785,7 @@ func (node *Proxy) query(ctx context.Context, qt *queryTask, sp trace.Span) (*mi
 			return
 		}
 		span := tr.ElapseSpan()
+		node.recordSlowQuery(ctx, qt, span, getTraceID(sp))
 		if span >= paramtable.Get().ProxyCfg.SlowQuerySpanInSeconds.GetAsDuration(time.Second) {
 			mlog.Info(ctx,
 				rpcSlow(method),
@@ -3838,6 +3885,7 @@ func (node *Proxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (*
 		shardclientMgr:      node.shardMgr,
 		mustUsePartitionKey: Params.ProxyCfg.MustUsePartitionKey.GetAsBool(),
 		chMgr:               node.chMgr,
+		slowTrace:           slowlog.NewTraceIfEnabled(),
 	}
 
 	subLabel := GetCollectionRateSubLabel(request)
@@ -5834,6 +5882,7 @@ func (node *Proxy) SetRates(ctx context.Context, request *proxypb.SetRatesReques
 		resp = merr.Status(err)
 		return resp, nil
 	}
+	node.simpleLimiter.SetPrincipalRates(request.GetPrincipalLimiters())
 
 	return resp, nil
 }
@@ -0,0 +1,269 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jwtauth
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/util/conc"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+)
+
+const (
+	jwksFetchTimeout = 10 * time.Second
+	// minimum interval to refetch the JWKS for an unknown key id, so that the tokens
+	// with random key ids can't flood the OIDC provider
+	jwksMinRefetchInterval = time.Minute
+	maxJWKSSize            = 1 << 20
+)
+
+type verificationKey struct {
+	id  string
+	alg string
+	key any
+}
+
+// keySource provides the keys to verify the token signatures.
+type keySource interface {
+	// Keys returns the keys of kid, or all the keys if kid is empty.
+	Keys(ctx context.Context, kid string) ([]verificationKey, error)
+}
+
+// staticKeys are loaded from a local file once.
+type staticKeys struct {
+	keys []verificationKey
+}
+
+func newStaticKeys(path string) (*staticKeys, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, merr.WrapErrIoFailed(path, err)
+	}
+	keys, err := parseKeys(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(keys) == 0 {
+		return nil, merr.WrapErrParameterInvalidMsg("no jwt verification key found in %s", path)
+	}
+	return &staticKeys{keys: keys}, nil
+}
+
+func (s *staticKeys) Keys(ctx context.Context, kid string) ([]verificationKey, error) {
+	return filterKeys(s.keys, kid), nil
+}
+
+// remoteKeys are fetched from the JWKS url of the OIDC provider, they are refetched
+// periodically or when a token is signed by an unknown key. The fetch never holds
+// the lock, the concurrent fetches are merged and the cached keys are served while
+// the periodical refresh is in flight.
+type remoteKeys struct {
+	url             string
+	client          *http.Client
+	refreshInterval time.Duration
+	sf              conc.Singleflight[[]verificationKey]
+
+	mu          sync.RWMutex
+	keys        []verificationKey
+	fetched     bool
+	refreshedAt time.Time
+	lastAttempt time.Time
+}
+
+func newRemoteKeys(url string, refreshInterval time.Duration) *remoteKeys {
+	return &remoteKeys{
+		url:             url,
+		client:          &http.Client{Timeout: jwksFetchTimeout},
+		refreshInterval: refreshInterval,
+	}
+}
+
+func (r *remoteKeys) Keys(ctx context.Context, kid string) ([]verificationKey, error) {
+	r.mu.RLock()
+	keys := filterKeys(r.keys, kid)
+	fetched := r.fetched
+	expired := time.Since(r.refreshedAt) >= r.refreshInterval
+	throttled := time.Since(r.lastAttempt) < jwksMinRefetchInterval
+	r.mu.RUnlock()
+
+	switch {
+	case !fetched:
+		if throttled {
+			return nil, merr.WrapErrServiceUnavailableMsg("jwks from %s is not available yet", r.url)
+		}
+		return r.refresh(ctx, kid)
+	case kid != "" && len(keys) == 0:
+		// the provider may have rotated its keys
+		if throttled {
+			return keys, nil
+		}
+		return r.refresh(ctx, kid)
+	case expired && !throttled:
+		// the cached keys are still valid until the provider rotates them
+		go func() {
+			if _, err, _ := r.sf.Do(r.url, r.fetch); err != nil {
+				mlog.Warn(context.Background(), "refresh jwks failed, keep using the stale keys", mlog.String("url", r.url), mlog.Err(err))
+			}
+		}()
+		return keys, nil
+	default:
+		return keys, nil
+	}
+}
+
+// refresh waits for the fetch, the stale keys are returned if the fetch failed.
+func (r *remoteKeys) refresh(ctx context.Context, kid string) ([]verificationKey, error) {
+	keys, err, _ := r.sf.Do(r.url, r.fetch)
+	if err == nil {
+		return filterKeys(keys, kid), nil
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if !r.fetched {
+		return nil, err
+	}
+	// keep using the stale keys if the provider is unavailable
+	mlog.Warn(ctx, "refresh jwks failed, use the stale keys", mlog.String("url", r.url), mlog.Err(err))
+	return filterKeys(r.keys, kid), nil
+}
+
+// fetch is shared by the concurrent callers, so it is not bound to the context of
+// any request. The attempt is recorded once it is done, the callers arriving in the
+// meantime join the in-flight fetch instead of being throttled.
+func (r *remoteKeys) fetch() ([]verificationKey, error) {
+	defer func() {
+		r.mu.Lock()
+		r.lastAttempt = time.Now()
+		r.mu.Unlock()
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), jwksFetchTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, r.url, nil)
+	if err != nil {
+		return nil, merr.WrapErrParameterInvalidMsg("invalid jwks url %s: %s", r.url, err.Error())
+	}
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, merr.WrapErrServiceUnavailableMsg("fetch jwks from %s failed: %s", r.url, err.Error())
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, merr.WrapErrServiceUnavailableMsg("fetch jwks from %s failed with status %s", r.url, resp.Status)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxJWKSSize))
+	if err != nil {
+		return nil, merr.WrapErrServiceUnavailableMsg("read jwks from %s failed: %s", r.url, err.Error())
+	}
+	keys, err := parseJWKS(data)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	r.keys = keys
+	r.fetched = true
+	r.refreshedAt = time.Now()
+	r.mu.Unlock()
+	mlog.Info(ctx, "jwks fetched", mlog.String("url", r.url), mlog.Int("keys", len(keys)))
+	return keys, nil
+}
+
+func filterKeys(keys []verificationKey, kid string) []verificationKey {
+	if kid == "" {
+		return keys
+	}
+	filtered := make([]verificationKey, 0, 1)
+	for _, key := range keys {
+		if key.id == kid {
+			filtered = append(filtered, key)
+		}
+	}
+	return filtered
+}
+
+// parseKeys parses a JWKS document or PEM encoded public keys and certificates.
+func parseKeys(data []byte) ([]verificationKey, error) {
+	if strings.HasPrefix(strings.TrimSpace(string(data)), "{") {
+		return parseJWKS(data)
+	}
+	return parsePEMKeys(data)
+}
+
+func parseJWKS(data []byte) ([]verificationKey, error) {
+	var jwks jose.JSONWebKeySet
+	if err := json.Unmarshal(data, &jwks); err != nil {
+		return nil, merr.WrapErrParameterInvalidMsg("invalid jwks: %s", err.Error())
+	}
+	keys := make([]verificationKey, 0, len(jwks.Keys))
+	for _, jwk := range jwks.Keys {
+		// skip the encryption keys and the symmetric keys
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		public := jwk.Public()
+		if !public.Valid() {
+			continue
+		}
+		keys = append(keys, verificationKey{id: jwk.KeyID, alg: jwk.Algorithm, key: public.Key})
+	}
+	return keys, nil
+}
+
+func parsePEMKeys(data []byte) ([]verificationKey, error) {
+	keys := make([]verificationKey, 0, 1)
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		var key any
+		var err error
+		switch block.Type {
+		case "PUBLIC KEY":
+			key, err = x509.ParsePKIXPublicKey(block.Bytes)
+		case "RSA PUBLIC KEY":
+			key, err = x509.ParsePKCS1PublicKey(block.Bytes)
+		case "CERTIFICATE":
+			var cert *x509.Certificate
+			cert, err = x509.ParseCertificate(block.Bytes)
+			if err == nil {
+				key = cert.PublicKey
+			}
+		default:
+			return nil, merr.WrapErrParameterInvalidMsg("unsupported pem block type %s, expect PUBLIC KEY, RSA PUBLIC KEY or CERTIFICATE", block.Type)
+		}
+		if err != nil {
+			return nil, merr.WrapErrParameterInvalidMsg("invalid pem %s: %s", block.Type, err.Error())
+		}
+		keys = append(keys, verificationKey{key: key})
+	}
+	return keys, nil
+}
@@ -0,0 +1,299 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jwtauth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/hashicorp/golang-lru/v2/expirable"
+	"github.com/samber/lo"
+
+	"github.com/milvus-io/milvus/pkg/v3/util"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+// the clock skew tolerated when validating exp, nbf and iat
+const leeway = time.Minute
+
+// only the asymmetric algorithms are accepted, a shared secret must never be
+// distributed to all the proxies
+var validMethods = []string{
+	"RS256", "RS384", "RS512",
+	"PS256", "PS384", "PS512",
+	"ES256", "ES384", "ES512",
+	"EdDSA",
+}
+
+// Identity is the Milvus user and roles of a verified token.
+type Identity struct {
+	Username  string
+	Roles     []string
+	ExpiresAt time.Time
+}
+
+type identityContextKey struct{}
+
+// GinContextKey is the key of the identity in the gin context of the RESTful
+// requests, some handlers use the gin context as the request context.
+const GinContextKey = "jwt_identity"
+
+// WithIdentity attaches the identity of the verified token to ctx, so that the
+// privilege check grants the roles of the token to the user.
+func WithIdentity(ctx context.Context, identity *Identity) context.Context {
+	return context.WithValue(ctx, identityContextKey{}, identity)
+}
+
+// IdentityFromContext returns the identity attached by WithIdentity.
+func IdentityFromContext(ctx context.Context) (*Identity, bool) {
+	identity, ok := ctx.Value(identityContextKey{}).(*Identity)
+	if !ok {
+		identity, ok = ctx.Value(GinContextKey).(*Identity)
+	}
+	return identity, ok && identity != nil
+}
+
+// Verifier verifies the JWT bearer tokens issued by an OIDC provider and maps their
+// claims to the Milvus user and roles. The verified tokens are cached until they
+// expire or the cache ttl is reached.
+type Verifier struct {
+	keys          keySource
+	parser        *jwt.Parser
+	usernameClaim string
+	rolesClaim    []string
+	roleMapping   map[string]string
+	// grant the unmapped groups as the roles of the same names
+	passThroughGroups bool
+	cache             *expirable.LRU[string, *Identity]
+}
+
+func NewVerifier(params *paramtable.ComponentParam) (*Verifier, error) {
+	cfg := &params.CommonCfg
+	var keys keySource
+	if url := cfg.JWTJWKSURL.GetValue(); url != "" {
+		refreshInterval := cfg.JWTJWKSRefreshInterval.GetAsDuration(time.Second)
+		if refreshInterval <= 0 {
+			return nil, merr.WrapErrParameterInvalidMsg("jwks refresh interval must be positive")
+		}
+		keys = newRemoteKeys(url, refreshInterval)
+	} else if path := cfg.JWTKeyFile.GetValue(); path != "" {
+		staticKeys, err := newStaticKeys(path)
+		if err != nil {
+			return nil, err
+		}
+		keys = staticKeys
+	} else {
+		return nil, merr.WrapErrParameterMissingMsg("either %s or %s must be set to enable the jwt authentication", cfg.JWTJWKSURL.Key, cfg.JWTKeyFile.Key)
+	}
+
+	roleMapping, err := parseRoleMapping(cfg.JWTRoleMapping.GetValue())
+	if err != nil {
+		return nil, err
+	}
+	usernameClaim := cfg.JWTUsernameClaim.GetValue()
+	if usernameClaim == "" {
+		return nil, merr.WrapErrParameterMissingMsg("jwt username claim is empty")
+	}
+
+	// a token issued to any other client of the provider must never be accepted
+	issuer := cfg.JWTIssuer.GetValue()
+	if issuer == "" {
+		return nil, merr.WrapErrParameterMissingMsg("%s must be set to enable the jwt authentication", cfg.JWTIssuer.Key)
+	}
+	audience := cfg.JWTAudience.GetValue()
+	if audience == "" {
+		return nil, merr.WrapErrParameterMissingMsg("%s must be set to enable the jwt authentication", cfg.JWTAudience.Key)
+	}
+	options := []jwt.ParserOption{
+		jwt.WithValidMethods(validMethods),
+		jwt.WithLeeway(leeway),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuer(issuer),
+		jwt.WithAudience(audience),
+	}
+
+	var rolesClaim []string
+	if claim := cfg.JWTRolesClaim.GetValue(); claim != "" {
+		rolesClaim = strings.Split(claim, ".")
+	}
+
+	v := &Verifier{
+		keys:              keys,
+		parser:            jwt.NewParser(options...),
+		usernameClaim:     usernameClaim,
+		rolesClaim:        rolesClaim,
+		roleMapping:       roleMapping,
+		passThroughGroups: cfg.JWTPassThroughGroups.GetAsBool(),
+	}
+	if capacity := cfg.JWTCacheCapacity.GetAsInt(); capacity > 0 {
+		v.cache = expirable.NewLRU[string, *Identity](capacity, nil, cfg.JWTCacheTTL.GetAsDuration(time.Second))
+	}
+	return v, nil
+}
+
+// IsJWT reports whether token looks like a compact serialized JWS, the signature
+// is not verified.
+func IsJWT(token string) bool {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return false
+	}
+	header, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return false
+	}
+	var fields struct {
+		Alg string `json:"alg"`
+	}
+	return json.Unmarshal(header, &fields) == nil && fields.Alg != ""
+}
+
+// Verify verifies the signature and the registered claims of token, returns the
+// Milvus identity mapped from its claims.
+func (v *Verifier) Verify(ctx context.Context, token string) (*Identity, error) {
+	cacheKey := tokenDigest(token)
+	if v.cache != nil {
+		if identity, ok := v.cache.Get(cacheKey); ok {
+			if time.Now().Before(identity.ExpiresAt.Add(leeway)) {
+				return identity, nil
+			}
+			v.cache.Remove(cacheKey)
+		}
+	}
+
+	claims := jwt.MapClaims{}
+	_, err := v.parser.ParseWithClaims(token, claims, func(t *jwt.Token) (any, error) {
+		kid, _ := t.Header["kid"].(string)
+		keys, err := v.keys.Keys(ctx, kid)
+		if err != nil {
+			return nil, err
+		}
+		alg := t.Method.Alg()
+		candidates := make([]jwt.VerificationKey, 0, len(keys))
+		for _, key := range keys {
+			if key.alg == "" || key.alg == alg {
+				candidates = append(candidates, key.key)
+			}
+		}
+		if len(candidates) == 0 {
+			return nil, fmt.Errorf("no verification key found for kid %q and alg %s", kid, alg)
+		}
+		return jwt.VerificationKeySet{Keys: candidates}, nil
+	})
+	if err != nil {
+		return nil, merr.WrapErrParameterInvalidMsg("invalid jwt: %s", err.Error())
+	}
+
+	identity, err := v.identity(claims)
+	if err != nil {
+		return nil, err
+	}
+	if v.cache != nil {
+		v.cache.Add(cacheKey, identity)
+	}
+	return identity, nil
+}
+
+func (v *Verifier) identity(claims jwt.MapClaims) (*Identity, error) {
+	username, ok := claims[v.usernameClaim].(string)
+	if !ok || username == "" {
+		return nil, merr.WrapErrParameterInvalidMsg("jwt lacks the username claim %s", v.usernameClaim)
+	}
+	// root bypasses the privilege check, it can only log in with its password
+	if username == util.UserRoot {
+		return nil, merr.WrapErrParameterInvalidMsg("jwt can't authenticate the %s user", util.UserRoot)
+	}
+	expiresAt, err := claims.GetExpirationTime()
+	if err != nil || expiresAt == nil {
+		return nil, merr.WrapErrParameterInvalidMsg("jwt lacks a valid exp claim")
+	}
+
+	// the groups are managed by the provider, a group is granted only if it is
+	// mapped explicitly, unless the pass through is enabled
+	roles := make([]string, 0)
+	for _, group := range v.groups(claims) {
+		if role, ok := v.roleMapping[group]; ok {
+			roles = append(roles, role)
+		} else if v.passThroughGroups {
+			roles = append(roles, group)
+		}
+	}
+	return &Identity{
+		Username:  username,
+		Roles:     lo.Uniq(roles),
+		ExpiresAt: expiresAt.Time,
+	}, nil
+}
+
+// groups returns the value of the roles claim, which is either a string of space
+// separated groups or a list of groups.
+func (v *Verifier) groups(claims jwt.MapClaims) []string {
+	if len(v.rolesClaim) == 0 {
+		return nil
+	}
+	var value any = map[string]any(claims)
+	for _, name := range v.rolesClaim {
+		object, ok := value.(map[string]any)
+		if !ok {
+			return nil
+		}
+		value = object[name]
+	}
+	switch value := value.(type) {
+	case string:
+		return strings.Fields(value)
+	case []any:
+		groups := make([]string, 0, len(value))
+		for _, group := range value {
+			if s, ok := group.(string); ok && s != "" {
+				groups = append(groups, s)
+			}
+		}
+		return groups
+	default:
+		return nil
+	}
+}
+
+func parseRoleMapping(value string) (map[string]string, error) {
+	if strings.TrimSpace(value) == "" {
+		return nil, nil
+	}
+	mapping := make(map[string]string)
+	for _, pair := range strings.Split(value, ",") {
+		group, role, ok := strings.Cut(strings.TrimSpace(pair), ":")
+		group, role = strings.TrimSpace(group), strings.TrimSpace(role)
+		if !ok || group == "" || role == "" {
+			return nil, merr.WrapErrParameterInvalidMsg("invalid jwt role mapping %q, expect group:role", pair)
+		}
+		mapping[group] = role
+	}
+	return mapping, nil
+}
+
+func tokenDigest(token string) string {
+	digest := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(digest[:])
+}
@@ -0,0 +1,343 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jwtauth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+type VerifierSuite struct {
+	suite.Suite
+
+	rsaKey *rsa.PrivateKey
+	ecKey  *ecdsa.PrivateKey
+	params *paramtable.ComponentParam
+}
+
+func (s *VerifierSuite) SetupSuite() {
+	var err error
+	s.rsaKey, err = rsa.GenerateKey(rand.Reader, 2048)
+	s.Require().NoError(err)
+	s.ecKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	s.Require().NoError(err)
+}
+
+func (s *VerifierSuite) SetupTest() {
+	s.params = &paramtable.ComponentParam{}
+	s.params.Init(paramtable.NewBaseTable(paramtable.SkipRemote(true)))
+	s.params.Save(s.params.CommonCfg.JWTEnabled.Key, "true")
+	s.params.Save(s.params.CommonCfg.JWTIssuer.Key, "https://idp.example.com")
+	s.params.Save(s.params.CommonCfg.JWTAudience.Key, "milvus")
+}
+
+func (s *VerifierSuite) writeKeyFile(keys ...any) string {
+	data := make([]byte, 0)
+	for _, key := range keys {
+		der, err := x509.MarshalPKIXPublicKey(key)
+		s.Require().NoError(err)
+		data = append(data, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})...)
+	}
+	path := filepath.Join(s.T().TempDir(), "keys.pem")
+	s.Require().NoError(os.WriteFile(path, data, 0o600))
+	return path
+}
+
+func (s *VerifierSuite) sign(method jwt.SigningMethod, key any, kid string, claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(method, claims)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	signed, err := token.SignedString(key)
+	s.Require().NoError(err)
+	return signed
+}
+
+func validClaims() jwt.MapClaims {
+	return jwt.MapClaims{
+		"sub":    "alice",
+		"iss":    "https://idp.example.com",
+		"aud":    "milvus",
+		"exp":    time.Now().Add(time.Hour).Unix(),
+		"groups": []string{"readers", "writers", "readers"},
+		"realm_access": map[string]any{
+			"roles": []string{"admin"},
+		},
+	}
+}
+
+func (s *VerifierSuite) TestKeyFile() {
+	s.params.Save(s.params.CommonCfg.JWTKeyFile.Key, s.writeKeyFile(&s.rsaKey.PublicKey, &s.ecKey.PublicKey))
+	s.params.Save(s.params.CommonCfg.JWTRoleMapping.Key, "readers:readers,writers:writers")
+	verifier, err := NewVerifier(s.params)
+	s.Require().NoError(err)
+	ctx := context.Background()
+
+	identity, err := verifier.Verify(ctx, s.sign(jwt.SigningMethodRS256, s.rsaKey, "", validClaims()))
+	s.NoError(err)
+	s.Equal("alice", identity.Username)
+	s.ElementsMatch([]string{"readers", "writers"}, identity.Roles)
+
+	identity, err = verifier.Verify(ctx, s.sign(jwt.SigningMethodES256, s.ecKey, "", validClaims()))
+	s.NoError(err)
+	s.Equal("alice", identity.Username)
+
+	// expired
+	claims := validClaims()
+	claims["exp"] = time.Now().Add(-time.Hour).Unix()
+	_, err = verifier.Verify(ctx, s.sign(jwt.SigningMethodRS256, s.rsaKey, "", claims))
+	s.Error(err)
+
+	// no exp
+	claims = validClaims()
+	delete(claims, "exp")
+	_, err = verifier.Verify(ctx, s.sign(jwt.SigningMethodRS256, s.rsaKey, "", claims))
+	s.Error(err)
+
+	// wrong issuer and audience
+	claims = validClaims()
+	claims["iss"] = "https://evil.example.com"
+	_, err = verifier.Verify(ctx, s.sign(jwt.SigningMethodRS256, s.rsaKey, "", claims))
+	s.Error(err)
+	claims = validClaims()
+	claims["aud"] = "other"
+	_, err = verifier.Verify(ctx, s.sign(jwt.SigningMethodRS256, s.rsaKey, "", claims))
+	s.Error(err)
+	claims = validClaims()
+	delete(claims, "aud")
+	_, err = verifier.Verify(ctx, s.sign(jwt.SigningMethodRS256, s.rsaKey, "", claims))
+	s.Error(err)
+
+	// signed by an unknown key
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	s.Require().NoError(err)
+	_, err = verifier.Verify(ctx, s.sign(jwt.SigningMethodRS256, otherKey, "", validClaims()))
+	s.Error(err)
+
+	// symmetric algorithms are rejected
+	_, err = verifier.Verify(ctx, s.sign(jwt.SigningMethodHS256, []byte("secret"), "", validClaims()))
+	s.Error(err)
+
+	// root can't log in with a jwt
+	claims = validClaims()
+	claims["sub"] = "root"
+	_, err = verifier.Verify(ctx, s.sign(jwt.SigningMethodRS256, s.rsaKey, "", claims))
+	s.Error(err)
+
+	// no username
+	claims = validClaims()
+	delete(claims, "sub")
+	_, err = verifier.Verify(ctx, s.sign(jwt.SigningMethodRS256, s.rsaKey, "", claims))
+	s.Error(err)
+}
+
+func (s *VerifierSuite) TestRoleMapping() {
+	s.params.Save(s.params.CommonCfg.JWTKeyFile.Key, s.writeKeyFile(&s.rsaKey.PublicKey))
+	s.params.Save(s.params.CommonCfg.JWTRoleMapping.Key, "readers:ro, admins:admin")
+	verifier, err := NewVerifier(s.params)
+	s.Require().NoError(err)
+
+	identity, err := verifier.Verify(context.Background(), s.sign(jwt.SigningMethodRS256, s.rsaKey, "", validClaims()))
+	s.NoError(err)
+	s.Equal([]string{"ro"}, identity.Roles)
+
+	// the unmapped groups are ignored by default
+	s.params.Save(s.params.CommonCfg.JWTRoleMapping.Key, "")
+	verifier, err = NewVerifier(s.params)
+	s.Require().NoError(err)
+	identity, err = verifier.Verify(context.Background(), s.sign(jwt.SigningMethodRS256, s.rsaKey, "", validClaims()))
+	s.NoError(err)
+	s.Empty(identity.Roles)
+
+	// pass through the unmapped groups
+	s.params.Save(s.params.CommonCfg.JWTRoleMapping.Key, "readers:ro")
+	s.params.Save(s.params.CommonCfg.JWTPassThroughGroups.Key, "true")
+	verifier, err = NewVerifier(s.params)
+	s.Require().NoError(err)
+	identity, err = verifier.Verify(context.Background(), s.sign(jwt.SigningMethodRS256, s.rsaKey, "", validClaims()))
+	s.NoError(err)
+	s.ElementsMatch([]string{"ro", "writers"}, identity.Roles)
+
+	// nested claim
+	s.params.Save(s.params.CommonCfg.JWTRolesClaim.Key, "realm_access.roles")
+	s.params.Save(s.params.CommonCfg.JWTRoleMapping.Key, "")
+	verifier, err = NewVerifier(s.params)
+	s.Require().NoError(err)
+	identity, err = verifier.Verify(context.Background(), s.sign(jwt.SigningMethodRS256, s.rsaKey, "", validClaims()))
+	s.NoError(err)
+	s.Equal([]string{"admin"}, identity.Roles)
+
+	// space separated groups
+	claims := validClaims()
+	claims["realm_access"] = map[string]any{"roles": "a b"}
+	identity, err = verifier.Verify(context.Background(), s.sign(jwt.SigningMethodRS256, s.rsaKey, "", claims))
+	s.NoError(err)
+	s.Equal([]string{"a", "b"}, identity.Roles)
+
+	s.params.Save(s.params.CommonCfg.JWTRoleMapping.Key, "readers")
+	_, err = NewVerifier(s.params)
+	s.Error(err)
+}
+
+func (s *VerifierSuite) TestJWKS() {
+	jwks := func(keys ...jose.JSONWebKey) []byte {
+		data, err := json.Marshal(jose.JSONWebKeySet{Keys: keys})
+		s.Require().NoError(err)
+		return data
+	}
+	var document atomic.Value
+	document.Store(jwks(jose.JSONWebKey{Key: &s.rsaKey.PublicKey, KeyID: "k1", Algorithm: "RS256", Use: "sig"}))
+	var fetches atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetches.Add(1)
+		w.Write(document.Load().([]byte))
+	}))
+	defer server.Close()
+
+	s.params.Save(s.params.CommonCfg.JWTJWKSURL.Key, server.URL)
+	verifier, err := NewVerifier(s.params)
+	s.Require().NoError(err)
+	ctx := context.Background()
+
+	token := s.sign(jwt.SigningMethodRS256, s.rsaKey, "k1", validClaims())
+	identity, err := verifier.Verify(ctx, token)
+	s.NoError(err)
+	s.Equal("alice", identity.Username)
+	s.EqualValues(1, fetches.Load())
+
+	// verified tokens are cached
+	_, err = verifier.Verify(ctx, token)
+	s.NoError(err)
+	s.EqualValues(1, fetches.Load())
+
+	// the provider rotates its keys, the unknown key id triggers a refetch
+	document.Store(jwks(jose.JSONWebKey{Key: &s.ecKey.PublicKey, KeyID: "k2", Algorithm: "ES256", Use: "sig"}))
+	verifier.keys.(*remoteKeys).lastAttempt = time.Time{}
+	_, err = verifier.Verify(ctx, s.sign(jwt.SigningMethodES256, s.ecKey, "k2", validClaims()))
+	s.NoError(err)
+	s.EqualValues(2, fetches.Load())
+
+	// the refetch is throttled
+	_, err = verifier.Verify(ctx, s.sign(jwt.SigningMethodES256, s.ecKey, "k3", validClaims()))
+	s.Error(err)
+	s.EqualValues(2, fetches.Load())
+
+	// the stale keys are served while they are refreshed in background
+	keys := verifier.keys.(*remoteKeys)
+	keys.mu.Lock()
+	keys.refreshedAt = time.Time{}
+	keys.lastAttempt = time.Time{}
+	keys.mu.Unlock()
+	claims := validClaims()
+	claims["jti"] = "stale"
+	_, err = verifier.Verify(ctx, s.sign(jwt.SigningMethodES256, s.ecKey, "k2", claims))
+	s.NoError(err)
+	s.Eventually(func() bool {
+		return fetches.Load() == 3
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func (s *VerifierSuite) TestJWKSUnavailable() {
+	var fetches atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetches.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	s.params.Save(s.params.CommonCfg.JWTJWKSURL.Key, server.URL)
+	verifier, err := NewVerifier(s.params)
+	s.Require().NoError(err)
+
+	_, err = verifier.Verify(context.Background(), s.sign(jwt.SigningMethodRS256, s.rsaKey, "k1", validClaims()))
+	s.Error(err)
+	s.EqualValues(1, fetches.Load())
+
+	// the failed fetch is throttled as well
+	_, err = verifier.Verify(context.Background(), s.sign(jwt.SigningMethodRS256, s.rsaKey, "k1", validClaims()))
+	s.Error(err)
+	s.EqualValues(1, fetches.Load())
+}
+
+func (s *VerifierSuite) TestNewVerifierFailed() {
+	// no key source
+	_, err := NewVerifier(s.params)
+	s.Error(err)
+
+	// no issuer or audience
+	s.params.Save(s.params.CommonCfg.JWTKeyFile.Key, s.writeKeyFile(&s.rsaKey.PublicKey))
+	s.params.Save(s.params.CommonCfg.JWTAudience.Key, "")
+	_, err = NewVerifier(s.params)
+	s.ErrorIs(err, merr.ErrParameterMissing)
+	s.params.Save(s.params.CommonCfg.JWTAudience.Key, "milvus")
+	s.params.Save(s.params.CommonCfg.JWTIssuer.Key, "")
+	_, err = NewVerifier(s.params)
+	s.ErrorIs(err, merr.ErrParameterMissing)
+	s.params.Save(s.params.CommonCfg.JWTIssuer.Key, "https://idp.example.com")
+
+	s.params.Save(s.params.CommonCfg.JWTKeyFile.Key, filepath.Join(s.T().TempDir(), "not_exist.pem"))
+	_, err = NewVerifier(s.params)
+	s.Error(err)
+
+	path := filepath.Join(s.T().TempDir(), "empty.pem")
+	s.Require().NoError(os.WriteFile(path, []byte("no keys"), 0o600))
+	s.params.Save(s.params.CommonCfg.JWTKeyFile.Key, path)
+	_, err = NewVerifier(s.params)
+	s.Error(err)
+}
+
+func (s *VerifierSuite) TestIsJWT() {
+	s.True(IsJWT(s.sign(jwt.SigningMethodRS256, s.rsaKey, "", validClaims())))
+	s.False(IsJWT(""))
+	s.False(IsJWT("user:password"))
+	s.False(IsJWT("a.b.c"))
+	s.False(IsJWT("api-key.with.dots"))
+}
+
+func (s *VerifierSuite) TestIdentityContext() {
+	_, ok := IdentityFromContext(context.Background())
+	s.False(ok)
+
+	identity := &Identity{Username: "alice", Roles: []string{"readers"}}
+	got, ok := IdentityFromContext(WithIdentity(context.Background(), identity))
+	s.True(ok)
+	s.Equal(identity, got)
+}
+
+func TestVerifier(t *testing.T) {
+	suite.Run(t, new(VerifierSuite))
+}
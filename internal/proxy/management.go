@@ -101,6 +101,10 @@ func RegisterMgrRoute(proxy *Proxy) {
 			Path:        management.RouteBackupEZ,
 			HandlerFunc: proxy.BackupEZ,
 		})
+		management.Register(&management.Handler{
+			Path:        management.RouteSlowLog,
+			HandlerFunc: proxy.ListSlowLog,
+		})
 	})
 }
 
@@ -6,6 +6,7 @@ import (
 	"reflect"
 	"sync"
 
+	"github.com/samber/lo"
 	"golang.org/x/time/rate"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -14,6 +15,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v3/hook"
 	"github.com/milvus-io/milvus-proto/go-api/v3/milvuspb"
+	"github.com/milvus-io/milvus/internal/proxy/jwtauth"
 	"github.com/milvus-io/milvus/internal/proxy/privilege"
 	"github.com/milvus-io/milvus/internal/util/hookutil"
 	"github.com/milvus-io/milvus/pkg/v3/mlog"
@@ -82,6 +84,10 @@ func PrivilegeInterceptorWithMetaCache(getMetaCache func() Cache) PrivilegeFunc
 			mlog.Warn(ctx, "GetRole fail", mlog.String("username", username), mlog.Err(err))
 			return ctx, err
 		}
+		// the roles granted by the jwt come along with the roles bound in milvus
+		if identity, ok := jwtauth.IdentityFromContext(ctx); ok && identity.Username == username {
+			roleNames = lo.Uniq(append(roleNames, identity.Roles...))
+		}
 		roleNames = append(roleNames, util.RolePublic)
 		ctx = SetRBACRolesToContext(ctx, roleNames)
 		objectType := privilegeExt.ObjectType.String()
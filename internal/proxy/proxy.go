@@ -31,7 +31,10 @@ import (
 	"github.com/milvus-io/milvus/internal/allocator"
 	"github.com/milvus-io/milvus/internal/proxy/channelmgr"
 	"github.com/milvus-io/milvus/internal/proxy/connection"
+	"github.com/milvus-io/milvus/internal/proxy/resultcache"
 	"github.com/milvus-io/milvus/internal/proxy/shardclient"
+	"github.com/milvus-io/milvus/internal/proxy/slowlog"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/dependency"
 	"github.com/milvus-io/milvus/internal/util/fileresource"
@@ -39,6 +42,7 @@ import (
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/pkg/v3/metrics"
 	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/objectstorage"
 	"github.com/milvus-io/milvus/pkg/v3/proto/internalpb"
 	"github.com/milvus-io/milvus/pkg/v3/util/expr"
 	"github.com/milvus-io/milvus/pkg/v3/util/merr"
@@ -119,6 +123,13 @@ type Proxy struct {
 	enableComplexDeleteLimit bool
 
 	slowQueries *expirable.LRU[Timestamp, *metricsinfo.SlowQuery]
+	// slowLog records the slow requests with their stages, nil until Init.
+	slowLog *slowlog.Logger
+	// searchResultCache caches the results of the Bounded and Eventually searches, nil until Init.
+	searchResultCache *resultcache.Cache
+
+	exportMgr *ExportManager
+	txnMgr    *TxnManager
 }
 
 // NewProxy returns a Proxy struct.
@@ -174,6 +185,16 @@ func (node *Proxy) GetMetaCache() Cache {
 	return node.getMetaCache()
 }
 
+// GetExportManager returns the manager of export jobs, nil before Proxy.Init().
+func (node *Proxy) GetExportManager() *ExportManager {
+	return node.exportMgr
+}
+
+// GetTxnManager returns the manager of client transactions, nil before Proxy.Init().
+func (node *Proxy) GetTxnManager() *TxnManager {
+	return node.txnMgr
+}
+
 // Register registers proxy at etcd
 func (node *Proxy) Register() error {
 	node.session.Register()
@@ -219,6 +240,13 @@ func (node *Proxy) Init() error {
 	}
 	mlog.Info(node.ctx, "init session for Proxy done")
 
+	// fail fast on an incomplete jwt config rather than rejecting every token later
+	if Params.CommonCfg.AuthorizationEnabled.GetAsBool() && Params.CommonCfg.JWTEnabled.GetAsBool() {
+		if _, err := getJWTVerifier(); err != nil {
+			return err
+		}
+	}
+
 	fileMode := fileresource.GetLocalMode()
 	if fileMode == fileresource.SyncMode {
 		if node.factory == nil {
@@ -298,6 +326,41 @@ func (node *Proxy) Init() error {
 
 	node.enableMaterializedView = Params.CommonCfg.EnableMaterializedView.GetAsBool()
 
+	node.slowLog, err = slowlog.NewLoggerWithParams(paramtable.Get())
+	if err != nil {
+		mlog.Warn(node.ctx, "failed to init slow log", mlog.String("role", typeutil.ProxyRole), mlog.Err(err))
+		return err
+	}
+	node.searchResultCache = resultcache.NewCacheWithParams(paramtable.Get())
+
+	node.exportMgr = newExportManager(node.ctx, paramtable.GetNodeID(), node.mixCoord, node.Query,
+		func(ctx context.Context, dbName, collectionName string) (*collectionInfo, error) {
+			return metaCache.GetCollectionInfo(ctx, dbName, collectionName, 0)
+		},
+		func(ctx context.Context, bucket string) (storage.ChunkManager, error) {
+			var opts []objectstorage.Option
+			if bucket != "" {
+				opts = append(opts, objectstorage.BucketName(bucket))
+			}
+			return storage.NewChunkManagerFactoryWithParam(paramtable.Get(), opts...).NewPersistentStorageChunkManager(ctx)
+		},
+	)
+
+	node.txnMgr = newTxnManager(node.ctx,
+		func(ctx context.Context, dbName, collectionName string) (int64, []string, error) {
+			collectionID, err := node.getMetaCache().GetCollectionID(ctx, dbName, collectionName)
+			if err != nil {
+				return 0, nil, err
+			}
+			vchannels, err := node.chMgr.GetVChannels(collectionID)
+			if err != nil {
+				return 0, nil, err
+			}
+			return collectionID, vchannels, nil
+		},
+		node.rowIDAllocator.AllocOne,
+	)
+
 	// Enable internal rand pool for UUIDv4 generation
 	// This is NOT thread-safe and should only be called before the service starts and
 	// there is no possibility that New or any other UUID V4 generation function will be called concurrently
@@ -328,6 +391,9 @@ func (node *Proxy) Start() error {
 	}
 	mlog.Debug(node.ctx, "start id allocator done", mlog.String("role", typeutil.ProxyRole))
 
+	node.txnMgr.Start()
+	node.exportMgr.Start()
+
 	// Start callbacks
 	for _, cb := range node.startCallbacks {
 		cb()
@@ -385,6 +451,15 @@ func (node *Proxy) Stop() error {
 
 	node.cancel()
 	node.wg.Wait()
+	if node.exportMgr != nil {
+		node.exportMgr.Wait()
+	}
+	if node.txnMgr != nil {
+		node.txnMgr.Wait()
+	}
+	if node.slowLog != nil {
+		node.slowLog.Close()
+	}
 
 	// https://github.com/milvus-io/milvus/issues/12282
 	node.UpdateStateCode(commonpb.StateCode_Abnormal)
@@ -18,6 +18,7 @@ package proxy
 
 import (
 	"context"
+	"strings"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/trace"
@@ -68,6 +69,10 @@ const (
 // GROUP BY + ORDER BY:
 //
 //	input -> [reduce_by_groups(raw)] -> [order] -> [slice] -> [agg_remap] -> output
+//
+// GROUP BY + HAVING / ORDER BY on aggregates:
+//
+//	input -> [reduce_by_groups] -> [having] -> [order] -> [slice] -> output
 type QueryPipeline struct {
 	pipeline       *queryutil.Pipeline
 	schema         *schemapb.CollectionSchema
@@ -111,6 +116,78 @@ func NewQueryPipeline(
 	}, nil
 }
 
+// NewFinalGroupQueryPipeline builds the pipeline for aggregation queries whose groups
+// are filtered by HAVING or ordered by aggregates. Both are evaluated on the user's
+// output layout, where avg is already computed from sum and count.
+func NewFinalGroupQueryPipeline(
+	schema *schemapb.CollectionSchema,
+	limit, offset int64,
+	having *agg.HavingExpr,
+	orderByFieldSpecs []string,
+	groupByFieldIDs []int64,
+	aggregates []*planpb.Aggregate,
+	outputMap *agg.AggregationFieldMap,
+) (*QueryPipeline, error) {
+	orderByFields, positions, err := parseGroupOrderByFields(orderByFieldSpecs, outputMap)
+	if err != nil {
+		return nil, err
+	}
+
+	b := queryutil.NewPipelineBuilder("proxy-query-groupby-having")
+	b.Add(queryutil.OpReduceByGroups, in(), ch(chanReduced), newReduceByGroupsOperator(schema, groupByFieldIDs, aggregates, outputMap))
+	sortInput := chanReduced
+	if having != nil {
+		b.Add("having", ch(chanReduced), ch("filtered"), newHavingOperator(having, outputMap.Names()))
+		sortInput = "filtered"
+	}
+	if len(orderByFields) > 0 {
+		b.Add(queryutil.OpOrderByLimit, ch(sortInput), ch(chanSorted), newOutputOrderByOperator(orderByFields, positions, offset+limit))
+		sortInput = chanSorted
+	}
+	b.Add(queryutil.OpSlice, ch(sortInput), out(), queryutil.NewSliceOperator(limit, offset))
+
+	return &QueryPipeline{
+		pipeline: b.Build(),
+		schema:   schema,
+	}, nil
+}
+
+// parseGroupOrderByFields resolves ORDER BY items against the user's output fields of
+// an aggregation query, both group by columns and aggregate expressions are allowed.
+// The data types are left unset since they are only known from the reduced results.
+func parseGroupOrderByFields(orderByFieldSpecs []string, outputMap *agg.AggregationFieldMap) ([]*orderby.OrderByField, []int, error) {
+	positions := make(map[string]int, outputMap.Count())
+	for i, name := range outputMap.Names() {
+		if _, ok := positions[agg.NormalizeOutputName(name)]; !ok {
+			positions[agg.NormalizeOutputName(name)] = i
+		}
+	}
+
+	fields := make([]*orderby.OrderByField, 0, len(orderByFieldSpecs))
+	fieldPositions := make([]int, 0, len(orderByFieldSpecs))
+	for _, spec := range orderByFieldSpecs {
+		if strings.TrimSpace(spec) == "" {
+			continue
+		}
+		name, ascending, nullsFirst, err := orderby.ParseOrderBySpec(spec)
+		if err != nil {
+			return nil, nil, err
+		}
+		pos, ok := positions[agg.NormalizeOutputName(name)]
+		if !ok {
+			return nil, nil, merr.WrapErrParameterInvalidMsg(
+				"ORDER BY field '%s' must be in output_fields when ordering by aggregates or using HAVING", name)
+		}
+		fields = append(fields, &orderby.OrderByField{
+			FieldName:  name,
+			Ascending:  ascending,
+			NullsFirst: nullsFirst,
+		})
+		fieldPositions = append(fieldPositions, pos)
+	}
+	return fields, fieldPositions, nil
+}
+
 // buildPlainQueryPipeline: sort_and_check_pk -> slice -> complement_fields -> output
 func buildPlainQueryPipeline(
 	schema *schemapb.CollectionSchema,
@@ -284,6 +361,13 @@ func newReduceByGroupsOperator(
 				return nil, merr.WrapErrParameterInvalidMsg("no indices found for output field '%s'", outputMap.NameAt(i))
 			} else if len(indices) == 1 {
 				reOrganizedFieldDatas[i] = reducedFieldDatas[indices[0]]
+				if sketch := outputMap.SketchAt(i); sketch != nil {
+					sketchFieldData, err := agg.ComputeSketchResult(sketch, reducedFieldDatas[indices[0]])
+					if err != nil {
+						return nil, err
+					}
+					reOrganizedFieldDatas[i] = sketchFieldData
+				}
 				reOrganizedFieldDatas[i].FieldName = outputMap.NameAt(i)
 			} else if len(indices) == 2 {
 				sumFieldData := reducedFieldDatas[indices[0]]
@@ -347,6 +431,13 @@ func newAggRemapOperator(outputMap *agg.AggregationFieldMap) queryutil.Operator
 				return nil, merr.WrapErrParameterInvalidMsg("no indices found for output field '%s'", outputMap.NameAt(i))
 			} else if len(indices) == 1 {
 				remapped[i] = rawFields[indices[0]]
+				if sketch := outputMap.SketchAt(i); sketch != nil {
+					sketchFieldData, err := agg.ComputeSketchResult(sketch, rawFields[indices[0]])
+					if err != nil {
+						return nil, err
+					}
+					remapped[i] = sketchFieldData
+				}
 				remapped[i].FieldName = outputMap.NameAt(i)
 			} else if len(indices) == 2 {
 				avgFieldData, err := agg.ComputeAvgFromSumAndCount(rawFields[indices[0]], rawFields[indices[1]])
@@ -363,3 +454,49 @@ func newAggRemapOperator(outputMap *agg.AggregationFieldMap) queryutil.Operator
 		}}, nil
 	})
 }
+
+// newHavingOperator keeps the groups satisfying the HAVING predicate, names are the
+// output names of the reorganized fields.
+func newHavingOperator(having *agg.HavingExpr, names []string) queryutil.Operator {
+	return queryutil.NewLambdaOperator("having", func(ctx context.Context, span trace.Span, inputs ...any) ([]any, error) {
+		result := inputs[0].(*internalpb.RetrieveResults)
+		if result == nil || len(result.GetFieldsData()) == 0 {
+			return []any{result}, nil
+		}
+
+		indices, err := having.Filter(names, result.GetFieldsData())
+		if err != nil {
+			return nil, err
+		}
+		filtered := typeutil.PrepareResultFieldData(result.GetFieldsData(), int64(len(indices)))
+		for _, idx := range indices {
+			typeutil.AppendFieldData(filtered, result.GetFieldsData(), int64(idx))
+		}
+		return []any{&internalpb.RetrieveResults{
+			FieldsData: filtered,
+		}}, nil
+	})
+}
+
+// newOutputOrderByOperator sorts the groups by the output fields at positions. The data
+// types of the ORDER BY fields are taken from the reduced results, for example, avg is
+// always Double while sum follows the type of its input.
+func newOutputOrderByOperator(orderByFields []*orderby.OrderByField, positions []int, topK int64) queryutil.Operator {
+	return queryutil.NewLambdaOperator(queryutil.OpOrderByLimit, func(ctx context.Context, span trace.Span, inputs ...any) ([]any, error) {
+		result := inputs[0].(*internalpb.RetrieveResults)
+		if result == nil || len(result.GetFieldsData()) == 0 {
+			return []any{result}, nil
+		}
+
+		typed := make([]*orderby.OrderByField, len(orderByFields))
+		for i, field := range orderByFields {
+			dataType := result.GetFieldsData()[positions[i]].GetType()
+			if !orderby.IsSortableType(dataType) {
+				return nil, merr.WrapErrParameterInvalidMsg("ORDER BY field '%s' has type %s which is not sortable", field.FieldName, dataType.String())
+			}
+			typed[i] = orderby.NewOrderByField(field.FieldID, field.FieldName, dataType,
+				orderby.WithAscending(field.Ascending), orderby.WithNullsFirst(field.NullsFirst))
+		}
+		return queryutil.NewOrderByLimitOperatorWithPositions(typed, positions, topK).Run(ctx, span, inputs...)
+	})
+}
@@ -18,6 +18,7 @@ package proxy
 
 import (
 	"context"
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -397,6 +398,194 @@ func TestNewQueryPipeline_GroupByOrderBy(t *testing.T) {
 	assert.Equal(t, 2, len(result.GetFieldsData()))
 }
 
+func TestNewFinalGroupQueryPipeline_HavingOrderByCount(t *testing.T) {
+	schema := testSchema()
+
+	countAggs, err := agg.NewAggregate("count", 500, "count(*)", 0)
+	require.NoError(t, err)
+	outputMap, err := agg.NewAggregationFieldMap(
+		[]string{"val", "count(*)"},
+		[]string{"val"},
+		countAggs,
+	)
+	require.NoError(t, err)
+	having, err := agg.ParseHavingExpr("COUNT(*) > 3")
+	require.NoError(t, err)
+
+	r1 := &internalpb.RetrieveResults{
+		FieldsData: []*schemapb.FieldData{
+			makeTestInt64Field(101, "val", []int64{10, 20}),
+			makeTestInt64Field(500, "count", []int64{3, 5}),
+		},
+	}
+	r2 := &internalpb.RetrieveResults{
+		FieldsData: []*schemapb.FieldData{
+			makeTestInt64Field(101, "val", []int64{10, 30, 40}),
+			makeTestInt64Field(500, "count", []int64{2, 4, 1}),
+		},
+	}
+
+	cases := []struct {
+		limit, offset int64
+		vals, counts  []int64
+	}{
+		{limit: 2, offset: 0, vals: []int64{10, 20}, counts: []int64{5, 5}},
+		{limit: 2, offset: 1, vals: []int64{20, 30}, counts: []int64{5, 4}},
+	}
+	for _, c := range cases {
+		pipeline, err := NewFinalGroupQueryPipeline(
+			schema, c.limit, c.offset, having,
+			[]string{"count(*):desc", "val:asc"},
+			[]int64{101},
+			[]*planpb.Aggregate{{Op: planpb.AggregateOp_count, FieldId: 500}},
+			outputMap,
+		)
+		require.NoError(t, err)
+
+		result, err := pipeline.Execute(context.Background(), []*internalpb.RetrieveResults{r1, r2})
+		require.NoError(t, err)
+		require.Len(t, result.GetFieldsData(), 2)
+		assert.Equal(t, c.vals, result.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+		assert.Equal(t, c.counts, result.GetFieldsData()[1].GetScalars().GetLongData().GetData())
+	}
+}
+
+func TestNewFinalGroupQueryPipeline_HavingOrderByAvg(t *testing.T) {
+	schema := testSchema()
+
+	avgAggs, err := agg.NewAggregate("avg", 100, "avg(pk)", schemapb.DataType_Int64)
+	require.NoError(t, err)
+	outputMap, err := agg.NewAggregationFieldMap(
+		[]string{"val", "avg(pk)"},
+		[]string{"val"},
+		avgAggs,
+	)
+	require.NoError(t, err)
+	having, err := agg.ParseHavingExpr("avg(pk) >= 2")
+	require.NoError(t, err)
+
+	pipeline, err := NewFinalGroupQueryPipeline(
+		schema, 10, 0, having,
+		[]string{"avg(pk):desc", "val:desc"},
+		[]int64{101},
+		agg.AggregatesToPB(avgAggs),
+		outputMap,
+	)
+	require.NoError(t, err)
+
+	// Raw reducer layout: [group_col(val), sum(pk), count(pk)]
+	r1 := &internalpb.RetrieveResults{
+		FieldsData: []*schemapb.FieldData{
+			makeTestInt64Field(101, "val", []int64{10, 20}),
+			makeTestInt64Field(100, "sum", []int64{6, 2}),
+			makeTestInt64Field(100, "count", []int64{2, 2}),
+		},
+	}
+	r2 := &internalpb.RetrieveResults{
+		FieldsData: []*schemapb.FieldData{
+			makeTestInt64Field(101, "val", []int64{10, 30}),
+			makeTestInt64Field(100, "sum", []int64{3, 9}),
+			makeTestInt64Field(100, "count", []int64{1, 3}),
+		},
+	}
+
+	result, err := pipeline.Execute(context.Background(), []*internalpb.RetrieveResults{r1, r2})
+	require.NoError(t, err)
+	require.Len(t, result.GetFieldsData(), 2)
+	assert.Equal(t, []int64{30, 10}, result.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+	assert.Equal(t, []float64{3, 3}, result.GetFieldsData()[1].GetScalars().GetDoubleData().GetData())
+}
+
+// encodeTestSketchState builds the partial state pushed down for the sketch aggregate
+// from the values, as segcore outputs it.
+func encodeTestSketchState(t *testing.T, aggregate agg.AggregateBase, values ...int64) string {
+	state := aggregate.NewState()
+	for _, v := range values {
+		require.NoError(t, aggregate.UpdateState(state, agg.NewFieldValue(v)))
+	}
+	partial, err := agg.FromPB(aggregate.ToPB())
+	require.NoError(t, err)
+	partialState := partial.NewState()
+	require.NoError(t, partial.UpdateState(partialState, state[0]))
+	encoded, err := partial.Terminate(partialState)
+	require.NoError(t, err)
+	return encoded.(string)
+}
+
+func makeTestVarCharField(fieldID int64, name string, vals []string) *schemapb.FieldData {
+	return &schemapb.FieldData{
+		Type:      schemapb.DataType_VarChar,
+		FieldName: name,
+		FieldId:   fieldID,
+		Field: &schemapb.FieldData_Scalars{
+			Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: vals}},
+			},
+		},
+	}
+}
+
+func TestNewFinalGroupQueryPipeline_SketchAggregates(t *testing.T) {
+	schema := testSchema()
+
+	var aggs []agg.AggregateBase
+	for _, expr := range []string{"count_distinct(pk)", "stddev(pk)"} {
+		_, name, _ := agg.MatchAggregationExpression(expr)
+		sketchAggs, err := agg.NewAggregate(name, 100, expr, schemapb.DataType_Int64)
+		require.NoError(t, err)
+		aggs = append(aggs, sketchAggs...)
+	}
+	distinct, stddev := aggs[0], aggs[1]
+	outputMap, err := agg.NewAggregationFieldMap([]string{"stddev(pk)", "val", "count_distinct(pk)"}, []string{"val"}, aggs)
+	require.NoError(t, err)
+	having, err := agg.ParseHavingExpr("count_distinct(pk) > 1")
+	require.NoError(t, err)
+
+	pipeline, err := NewFinalGroupQueryPipeline(schema, 10, 0, having, []string{"val:asc"}, []int64{101}, agg.AggregatesToPB(aggs), outputMap)
+	require.NoError(t, err)
+
+	// Raw reducer layout: [group_col(val), hyperloglog(pk), moments(pk)]
+	r1 := &internalpb.RetrieveResults{
+		FieldsData: []*schemapb.FieldData{
+			makeTestInt64Field(101, "val", []int64{10, 20}),
+			makeTestVarCharField(100, "hyperloglog", []string{encodeTestSketchState(t, distinct, 1, 2), encodeTestSketchState(t, distinct, 5, 5)}),
+			makeTestVarCharField(100, "moments", []string{encodeTestSketchState(t, stddev, 1, 2), encodeTestSketchState(t, stddev, 5, 5)}),
+		},
+	}
+	r2 := &internalpb.RetrieveResults{
+		FieldsData: []*schemapb.FieldData{
+			makeTestInt64Field(101, "val", []int64{10, 30}),
+			makeTestVarCharField(100, "hyperloglog", []string{encodeTestSketchState(t, distinct, 3), encodeTestSketchState(t, distinct, 1, 3)}),
+			makeTestVarCharField(100, "moments", []string{encodeTestSketchState(t, stddev, 3), encodeTestSketchState(t, stddev, 1, 3)}),
+		},
+	}
+
+	result, err := pipeline.Execute(context.Background(), []*internalpb.RetrieveResults{r1, r2})
+	require.NoError(t, err)
+	require.Len(t, result.GetFieldsData(), 3)
+	// val 20 has a single distinct pk and is filtered by HAVING
+	assert.Equal(t, []int64{10, 30}, result.GetFieldsData()[1].GetScalars().GetLongData().GetData())
+	assert.Equal(t, []int64{3, 2}, result.GetFieldsData()[2].GetScalars().GetLongData().GetData())
+	assert.InDeltaSlice(t, []float64{1, math.Sqrt(2)}, result.GetFieldsData()[0].GetScalars().GetDoubleData().GetData(), 1e-9)
+}
+
+func TestNewFinalGroupQueryPipeline_OrderByNotInOutput(t *testing.T) {
+	countAggs, err := agg.NewAggregate("count", 500, "count(*)", 0)
+	require.NoError(t, err)
+	outputMap, err := agg.NewAggregationFieldMap([]string{"val"}, []string{"val"}, countAggs)
+	require.NoError(t, err)
+
+	_, err = NewFinalGroupQueryPipeline(
+		testSchema(), 10, 0, nil,
+		[]string{"count(*):desc"},
+		[]int64{101},
+		[]*planpb.Aggregate{{Op: planpb.AggregateOp_count, FieldId: 500}},
+		outputMap,
+	)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "must be in output_fields")
+}
+
 // =========================================================================
 // Element-level (element_filter) pipeline
 // =========================================================================
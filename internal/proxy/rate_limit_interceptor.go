@@ -18,12 +18,15 @@ package proxy
 
 import (
 	"context"
+	"slices"
 	"strconv"
 
+	"github.com/samber/lo"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/proto"
 
 	"github.com/milvus-io/milvus-proto/go-api/v3/milvuspb"
+	"github.com/milvus-io/milvus/internal/proxy/jwtauth"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/importutilv2"
 	"github.com/milvus-io/milvus/pkg/v3/metrics"
@@ -61,7 +64,7 @@ func RateLimitInterceptorWithMetaCache(getMetaCache func() Cache, limiter types.
 				}
 			}
 		}
-		err = limiter.Check(dbID, collectionIDToPartIDs, rt, n)
+		err = CheckRateLimit(ctx, limiter, dbID, collectionIDToPartIDs, rt, n)
 		nodeID := strconv.FormatInt(paramtable.GetNodeID(), 10)
 		metrics.ProxyRateLimitReqCount.WithLabelValues(nodeID, rt.String(), metrics.TotalLabel).Inc()
 		if err != nil {
@@ -78,6 +81,41 @@ func RateLimitInterceptorWithMetaCache(getMetaCache func() Cache, limiter types.
 	}
 }
 
+// PrincipalLimiter is a limiter which also limits the requests by the user and roles issuing them.
+type PrincipalLimiter interface {
+	CheckWithPrincipal(username string, roles []string, dbID int64, collectionIDToPartIDs map[int64][]int64, rt internalpb.RateType, n int) error
+}
+
+// CheckRateLimit checks if the request would be limited or denied, the rate limits of the
+// user and its roles are also checked if the authorization is enabled.
+func CheckRateLimit(ctx context.Context, limiter types.Limiter, dbID int64, collectionIDToPartIDs map[int64][]int64, rt internalpb.RateType, n int) error {
+	principalLimiter, ok := limiter.(PrincipalLimiter)
+	if !ok || !Params.CommonCfg.AuthorizationEnabled.GetAsBool() {
+		return limiter.Check(dbID, collectionIDToPartIDs, rt, n)
+	}
+	username, err := GetCurUserFromContext(ctx)
+	if err != nil {
+		return limiter.Check(dbID, collectionIDToPartIDs, rt, n)
+	}
+	return principalLimiter.CheckWithPrincipal(username, getRequestRoles(ctx, username), dbID, collectionIDToPartIDs, rt, n)
+}
+
+// getRequestRoles returns the roles of the user, which are resolved by the privilege interceptor
+// if it has run.
+func getRequestRoles(ctx context.Context, username string) []string {
+	if roles, ok := ctx.Value(RBACRoleContextKey).([]string); ok {
+		return roles
+	}
+	roles, err := GetRole(username)
+	if err != nil {
+		return nil
+	}
+	if identity, ok := jwtauth.IdentityFromContext(ctx); ok && identity.Username == username {
+		roles = append(slices.Clone(roles), identity.Roles...)
+	}
+	return lo.Uniq(roles)
+}
+
 type reqPartName interface {
 	requestutil.DBNameGetter
 	requestutil.CollectionNameGetter
@@ -0,0 +1,282 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package resultcache caches the search results in the proxy.
+//
+// An entry is keyed by the hash of the user, the request, the versions the shards of the collection
+// have applied and the latest applied timestamp of the collection in this proxy. The shard versions
+// move on every write or segment change of the collection through any proxy, so the results of other
+// versions are never served. The local timestamp moves forward on every write or schema change of the
+// collection seen by this proxy, which drops the cached entries of the collection at once and rejects
+// the results of the searches started before.
+package resultcache
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/binary"
+	"sort"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/milvuspb"
+	"github.com/milvus-io/milvus/pkg/v3/metrics"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+// cacheName is the cache_name label of the hit and miss metrics.
+const cacheName = "SearchResultCache"
+
+// ShardVersion is what a shard of the collection has applied when the search starts.
+type ShardVersion struct {
+	Channel string
+	// AppliedTs is the timestamp of the latest insert or delete applied to the shard.
+	AppliedTs uint64
+	// TargetVersion is the version of the readable segments of the shard.
+	TargetVersion int64
+}
+
+// Key identifies a cached search result.
+type Key struct {
+	hash         [sha256.Size]byte
+	collectionID int64
+	// epoch is the latest applied timestamp of the collection when the key is created.
+	epoch uint64
+	// maxTTL bounds the ttl of the cached result if positive.
+	maxTTL time.Duration
+}
+
+// SetMaxTTL bounds the time the result of the key is cached, the ttl of the cache applies alone if
+// ttl isn't positive.
+func (k *Key) SetMaxTTL(ttl time.Duration) {
+	if k != nil {
+		k.maxTTL = ttl
+	}
+}
+
+type entry struct {
+	key      *Key
+	result   *milvuspb.SearchResults
+	size     int64
+	expireAt time.Time
+}
+
+// collection tracks the latest applied timestamp and the cached entries of a collection.
+type collection struct {
+	epoch   uint64
+	entries map[[sha256.Size]byte]*list.Element
+}
+
+// Cache is a lru cache of the search results bounded by memory, it's nil-safe and goroutine-safe.
+type Cache struct {
+	mu           sync.Mutex
+	capacity     int64
+	maxEntrySize int64
+	ttl          time.Duration
+	size         int64
+	lru          *list.List
+	collections  map[int64]*collection
+}
+
+// NewCache creates a cache using at most capacity bytes, the results larger than maxEntrySize bytes are not cached.
+func NewCache(capacity, maxEntrySize int64, ttl time.Duration) *Cache {
+	return &Cache{
+		capacity:     capacity,
+		maxEntrySize: maxEntrySize,
+		ttl:          ttl,
+		lru:          list.New(),
+		collections:  make(map[int64]*collection),
+	}
+}
+
+// NewCacheWithParams creates a cache with the proxy.searchResultCache configs.
+func NewCacheWithParams(params *paramtable.ComponentParam) *Cache {
+	cfg := &params.ProxyCfg.SearchResultCache
+	return NewCache(
+		cfg.Capacity.GetAsInt64()*1024*1024,
+		cfg.MaxResultSize.GetAsInt64()*1024*1024,
+		cfg.TTL.GetAsDuration(time.Second),
+	)
+}
+
+// NewKey hashes the search of the user on the collection with the shard versions, the Base of the request is ignored.
+func (c *Cache) NewKey(user, database string, collectionID int64, shards []ShardVersion, request *milvuspb.SearchRequest) (*Key, error) {
+	if c == nil {
+		return nil, nil
+	}
+	base := request.GetBase()
+	request.Base = nil
+	bs, err := proto.MarshalOptions{Deterministic: true}.Marshal(request)
+	request.Base = base
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	var epoch uint64
+	if coll, ok := c.collections[collectionID]; ok {
+		epoch = coll.epoch
+	}
+	c.mu.Unlock()
+
+	h := sha256.New()
+	h.Write([]byte(user))
+	h.Write([]byte{0})
+	h.Write([]byte(database))
+	h.Write([]byte{0})
+	h.Write(binary.LittleEndian.AppendUint64(nil, uint64(collectionID)))
+	h.Write(binary.LittleEndian.AppendUint64(nil, epoch))
+	shards = append([]ShardVersion(nil), shards...)
+	sort.Slice(shards, func(i, j int) bool { return shards[i].Channel < shards[j].Channel })
+	for _, shard := range shards {
+		h.Write([]byte(shard.Channel))
+		h.Write([]byte{0})
+		h.Write(binary.LittleEndian.AppendUint64(nil, shard.AppliedTs))
+		h.Write(binary.LittleEndian.AppendUint64(nil, uint64(shard.TargetVersion)))
+	}
+	h.Write(bs)
+	key := &Key{collectionID: collectionID, epoch: epoch}
+	h.Sum(key.hash[:0])
+	return key, nil
+}
+
+// Get returns a copy of the cached result of the key.
+func (c *Cache) Get(key *Key) (*milvuspb.SearchResults, bool) {
+	if c == nil || key == nil {
+		return nil, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	nodeID := paramtable.GetStringNodeID()
+	coll, ok := c.collections[key.collectionID]
+	if !ok {
+		metrics.ProxyCacheStatsCounter.WithLabelValues(nodeID, cacheName, metrics.CacheMissLabel).Inc()
+		return nil, false
+	}
+	elem, ok := coll.entries[key.hash]
+	if !ok {
+		metrics.ProxyCacheStatsCounter.WithLabelValues(nodeID, cacheName, metrics.CacheMissLabel).Inc()
+		return nil, false
+	}
+	e := elem.Value.(*entry)
+	if time.Now().After(e.expireAt) {
+		c.remove(elem)
+		c.updateMetrics()
+		metrics.ProxyCacheStatsCounter.WithLabelValues(nodeID, cacheName, metrics.CacheMissLabel).Inc()
+		return nil, false
+	}
+	c.lru.MoveToFront(elem)
+	metrics.ProxyCacheStatsCounter.WithLabelValues(nodeID, cacheName, metrics.CacheHitLabel).Inc()
+	return proto.Clone(e.result).(*milvuspb.SearchResults), true
+}
+
+// Put caches a copy of the result of the key. The result is dropped if it's too large, or the
+// collection has been written or altered since the key was created.
+func (c *Cache) Put(key *Key, result *milvuspb.SearchResults) {
+	if c == nil || key == nil {
+		return
+	}
+	size := int64(proto.Size(result))
+	if size > c.maxEntrySize || size > c.capacity {
+		return
+	}
+	result = proto.Clone(result).(*milvuspb.SearchResults)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	coll, ok := c.collections[key.collectionID]
+	if !ok {
+		coll = &collection{entries: make(map[[sha256.Size]byte]*list.Element)}
+		c.collections[key.collectionID] = coll
+	}
+	if coll.epoch != key.epoch {
+		return
+	}
+	if elem, ok := coll.entries[key.hash]; ok {
+		c.remove(elem)
+	}
+	for c.size+size > c.capacity && c.lru.Len() > 0 {
+		c.remove(c.lru.Back())
+	}
+	ttl := c.ttl
+	if key.maxTTL > 0 && key.maxTTL < ttl {
+		ttl = key.maxTTL
+	}
+	coll.entries[key.hash] = c.lru.PushFront(&entry{
+		key:      key,
+		result:   result,
+		size:     size,
+		expireAt: time.Now().Add(ttl),
+	})
+	c.size += size
+	c.updateMetrics()
+}
+
+// Invalidate drops the cached results of the collection, and moves the latest applied timestamp of
+// the collection to ts, or past the current one if ts isn't newer.
+func (c *Cache) Invalidate(collectionID int64, ts uint64) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	coll, ok := c.collections[collectionID]
+	if !ok {
+		coll = &collection{entries: make(map[[sha256.Size]byte]*list.Element)}
+		c.collections[collectionID] = coll
+	}
+	if ts <= coll.epoch {
+		ts = coll.epoch + 1
+	}
+	coll.epoch = ts
+	for _, elem := range coll.entries {
+		c.remove(elem)
+	}
+	c.updateMetrics()
+}
+
+// Size returns the memory used by the cached results, in bytes.
+func (c *Cache) Size() int64 {
+	if c == nil {
+		return 0
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.size
+}
+
+// Len returns the number of the cached results.
+func (c *Cache) Len() int {
+	if c == nil {
+		return 0
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lru.Len()
+}
+
+func (c *Cache) remove(elem *list.Element) {
+	e := c.lru.Remove(elem).(*entry)
+	delete(c.collections[e.key.collectionID].entries, e.key.hash)
+	c.size -= e.size
+}
+
+func (c *Cache) updateMetrics() {
+	metrics.ProxySearchResultCacheSize.WithLabelValues(paramtable.GetStringNodeID()).Set(float64(c.size))
+}
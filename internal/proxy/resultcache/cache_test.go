@@ -0,0 +1,252 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resultcache
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v3/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v3/schemapb"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+func TestMain(m *testing.M) {
+	paramtable.Init()
+	os.Exit(m.Run())
+}
+
+func newRequest(dsl string) *milvuspb.SearchRequest {
+	return &milvuspb.SearchRequest{
+		DbName:           "db",
+		CollectionName:   "c",
+		Dsl:              dsl,
+		PlaceholderGroup: []byte("vectors"),
+		SearchParams:     []*commonpb.KeyValuePair{{Key: "topk", Value: "10"}},
+		ConsistencyLevel: commonpb.ConsistencyLevel_Bounded,
+	}
+}
+
+func newResult(n int) *milvuspb.SearchResults {
+	ids := make([]int64, n)
+	for i := range ids {
+		ids[i] = int64(i)
+	}
+	return &milvuspb.SearchResults{
+		Status:         merr.Success(),
+		CollectionName: "c",
+		Results: &schemapb.SearchResultData{
+			NumQueries: 1,
+			TopK:       int64(n),
+			Ids:        &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: ids}}},
+		},
+	}
+}
+
+func TestKey(t *testing.T) {
+	c := NewCache(1024*1024, 1024*1024, time.Minute)
+	key, err := c.NewKey("root", "db", 1, nil, newRequest("id > 1"))
+	require.NoError(t, err)
+
+	// the base is ignored
+	req := newRequest("id > 1")
+	req.Base = &commonpb.MsgBase{MsgID: 100}
+	same, err := c.NewKey("root", "db", 1, nil, req)
+	require.NoError(t, err)
+	assert.Equal(t, key.hash, same.hash)
+	assert.NotNil(t, req.GetBase())
+
+	for _, other := range []func() (*Key, error){
+		func() (*Key, error) { return c.NewKey("alice", "db", 1, nil, newRequest("id > 1")) },
+		func() (*Key, error) { return c.NewKey("root", "db2", 1, nil, newRequest("id > 1")) },
+		func() (*Key, error) { return c.NewKey("root", "db", 2, nil, newRequest("id > 1")) },
+		func() (*Key, error) { return c.NewKey("root", "db", 1, nil, newRequest("id > 2")) },
+		func() (*Key, error) {
+			return c.NewKey("root", "db", 1, []ShardVersion{{Channel: "ch1", AppliedTs: 100, TargetVersion: 1}}, newRequest("id > 1"))
+		},
+	} {
+		k, err := other()
+		require.NoError(t, err)
+		assert.NotEqual(t, key.hash, k.hash)
+	}
+
+	// the shard versions are hashed in the order of channels
+	shards := []ShardVersion{{Channel: "ch1", AppliedTs: 100, TargetVersion: 1}, {Channel: "ch2", AppliedTs: 200, TargetVersion: 1}}
+	key1, err := c.NewKey("root", "db", 1, shards, newRequest("id > 1"))
+	require.NoError(t, err)
+	key2, err := c.NewKey("root", "db", 1, []ShardVersion{shards[1], shards[0]}, newRequest("id > 1"))
+	require.NoError(t, err)
+	assert.Equal(t, key1.hash, key2.hash)
+	for _, moved := range [][]ShardVersion{
+		{{Channel: "ch1", AppliedTs: 101, TargetVersion: 1}, shards[1]},
+		{{Channel: "ch1", AppliedTs: 100, TargetVersion: 2}, shards[1]},
+	} {
+		k, err := c.NewKey("root", "db", 1, moved, newRequest("id > 1"))
+		require.NoError(t, err)
+		assert.NotEqual(t, key1.hash, k.hash)
+	}
+
+	c.Invalidate(1, 100)
+	k, err := c.NewKey("root", "db", 1, nil, newRequest("id > 1"))
+	require.NoError(t, err)
+	assert.NotEqual(t, key.hash, k.hash)
+	assert.Equal(t, uint64(100), k.epoch)
+}
+
+func TestCache(t *testing.T) {
+	c := NewCache(1024*1024, 1024*1024, time.Minute)
+	key, err := c.NewKey("root", "db", 1, nil, newRequest("id > 1"))
+	require.NoError(t, err)
+
+	_, ok := c.Get(key)
+	assert.False(t, ok)
+
+	result := newResult(10)
+	c.Put(key, result)
+	assert.Equal(t, 1, c.Len())
+	assert.Equal(t, int64(proto.Size(result)), c.Size())
+
+	cached, ok := c.Get(key)
+	require.True(t, ok)
+	assert.True(t, proto.Equal(result, cached))
+	// the cached result is a copy
+	cached.CollectionName = "other"
+	cached, ok = c.Get(key)
+	require.True(t, ok)
+	assert.Equal(t, "c", cached.GetCollectionName())
+
+	// the other collections are not affected
+	other, err := c.NewKey("root", "db", 2, nil, newRequest("id > 1"))
+	require.NoError(t, err)
+	c.Put(other, result)
+
+	c.Invalidate(1, 100)
+	_, ok = c.Get(key)
+	assert.False(t, ok)
+	_, ok = c.Get(other)
+	assert.True(t, ok)
+	assert.Equal(t, 1, c.Len())
+
+	// the result of the search started before the invalidation is dropped
+	c.Put(key, result)
+	assert.Equal(t, 1, c.Len())
+
+	// an older timestamp still moves the collection forward
+	key, err = c.NewKey("root", "db", 1, nil, newRequest("id > 1"))
+	require.NoError(t, err)
+	c.Invalidate(1, 50)
+	c.Put(key, result)
+	assert.Equal(t, 1, c.Len())
+}
+
+func TestCacheLimits(t *testing.T) {
+	size := int64(proto.Size(newResult(10)))
+	c := NewCache(2*size, size, time.Minute)
+
+	keys := make([]*Key, 3)
+	for i := range keys {
+		var err error
+		keys[i], err = c.NewKey("root", "db", 1, nil, newRequest(string(rune('a'+i))))
+		require.NoError(t, err)
+	}
+
+	// too large
+	c.Put(keys[0], newResult(100))
+	assert.Equal(t, 0, c.Len())
+
+	c.Put(keys[0], newResult(10))
+	c.Put(keys[1], newResult(10))
+	// keys[0] is the most recently used
+	_, ok := c.Get(keys[0])
+	assert.True(t, ok)
+	c.Put(keys[2], newResult(10))
+	assert.Equal(t, 2, c.Len())
+	assert.Equal(t, 2*size, c.Size())
+	_, ok = c.Get(keys[1])
+	assert.False(t, ok)
+	_, ok = c.Get(keys[0])
+	assert.True(t, ok)
+
+	// putting the same key twice doesn't leak
+	c.Put(keys[2], newResult(10))
+	assert.Equal(t, 2*size, c.Size())
+}
+
+func TestCacheTTL(t *testing.T) {
+	c := NewCache(1024*1024, 1024*1024, time.Millisecond)
+	key, err := c.NewKey("root", "db", 1, nil, newRequest("id > 1"))
+	require.NoError(t, err)
+	c.Put(key, newResult(10))
+	time.Sleep(10 * time.Millisecond)
+	_, ok := c.Get(key)
+	assert.False(t, ok)
+	assert.Equal(t, 0, c.Len())
+	assert.Equal(t, int64(0), c.Size())
+}
+
+func TestCacheMaxTTL(t *testing.T) {
+	c := NewCache(1024*1024, 1024*1024, time.Hour)
+	key, err := c.NewKey("root", "db", 1, nil, newRequest("id > 1"))
+	require.NoError(t, err)
+	key.SetMaxTTL(time.Millisecond)
+	c.Put(key, newResult(10))
+	time.Sleep(10 * time.Millisecond)
+	_, ok := c.Get(key)
+	assert.False(t, ok)
+
+	// the larger max ttl doesn't extend the ttl of the cache
+	key.SetMaxTTL(2 * time.Hour)
+	c.Put(key, newResult(10))
+	c.mu.Lock()
+	expireAt := c.lru.Front().Value.(*entry).expireAt
+	c.mu.Unlock()
+	assert.True(t, expireAt.Before(time.Now().Add(time.Hour+time.Minute)))
+
+	var nilKey *Key
+	nilKey.SetMaxTTL(time.Second)
+}
+
+func TestNilCache(t *testing.T) {
+	var c *Cache
+	key, err := c.NewKey("root", "db", 1, nil, newRequest("id > 1"))
+	assert.NoError(t, err)
+	assert.Nil(t, key)
+	c.Put(key, newResult(10))
+	_, ok := c.Get(key)
+	assert.False(t, ok)
+	c.Invalidate(1, 1)
+	assert.Equal(t, 0, c.Len())
+	assert.Equal(t, int64(0), c.Size())
+}
+
+func TestNewCacheWithParams(t *testing.T) {
+	params := paramtable.Get()
+	params.Save(params.ProxyCfg.SearchResultCache.Capacity.Key, "1")
+	defer params.Reset(params.ProxyCfg.SearchResultCache.Capacity.Key)
+
+	c := NewCacheWithParams(params)
+	assert.Equal(t, int64(1024*1024), c.capacity)
+	assert.Equal(t, int64(4*1024*1024), c.maxEntrySize)
+	assert.Equal(t, time.Minute, c.ttl)
+}
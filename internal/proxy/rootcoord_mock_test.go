@@ -1455,6 +1455,38 @@ func (coord *MixCoordMock) ListImports(ctx context.Context, in *internalpb.ListI
 	}, nil
 }
 
+func (coord *MixCoordMock) CreateExportJob(ctx context.Context, in *datapb.CreateExportJobRequest, opts ...grpc.CallOption) (*datapb.CreateExportJobResponse, error) {
+	return &datapb.CreateExportJobResponse{
+		Status: merr.Success(),
+	}, nil
+}
+
+func (coord *MixCoordMock) GetExportJob(ctx context.Context, in *datapb.GetExportJobRequest, opts ...grpc.CallOption) (*datapb.GetExportJobResponse, error) {
+	return &datapb.GetExportJobResponse{
+		Status: merr.Success(),
+	}, nil
+}
+
+func (coord *MixCoordMock) ListExportJobs(ctx context.Context, in *datapb.ListExportJobsRequest, opts ...grpc.CallOption) (*datapb.ListExportJobsResponse, error) {
+	return &datapb.ListExportJobsResponse{
+		Status: merr.Success(),
+	}, nil
+}
+
+func (coord *MixCoordMock) CancelExportJob(ctx context.Context, in *datapb.CancelExportJobRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return merr.Success(), nil
+}
+
+func (coord *MixCoordMock) AcquireExportJob(ctx context.Context, in *datapb.AcquireExportJobRequest, opts ...grpc.CallOption) (*datapb.AcquireExportJobResponse, error) {
+	return &datapb.AcquireExportJobResponse{
+		Status: merr.Success(),
+	}, nil
+}
+
+func (coord *MixCoordMock) ReportExportJob(ctx context.Context, in *datapb.ReportExportJobRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return merr.Success(), nil
+}
+
 func (coord *MixCoordMock) CommitImport(ctx context.Context, in *datapb.CommitImportRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	return merr.Success(), nil
 }
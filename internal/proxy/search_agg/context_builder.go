@@ -112,6 +112,13 @@ func buildMetricPlan(alias string, spec MetricSpec) (metricPlan, error) {
 		return metricPlan{alias: alias, spec: spec, aggregate: agg.NewAvgAggregate(spec.FieldID, alias)}, nil
 	}
 
+	if spec.Op == "percentile" {
+		if err := agg.ValidateAggFieldType(spec.Op, spec.FieldType); err != nil {
+			return metricPlan{}, err
+		}
+		return metricPlan{alias: alias, spec: spec, aggregate: agg.NewPercentileAggregate(spec.FieldID, alias, spec.Quantile)}, nil
+	}
+
 	aggregates, err := agg.NewAggregate(spec.Op, spec.FieldID, alias, spec.FieldType)
 	if err != nil {
 		return metricPlan{}, err
@@ -377,8 +384,22 @@ func buildMetricSpec(metric *commonpb.MetricAggSpec, schema *schemapb.Collection
 	}
 
 	op := strings.ToLower(strings.TrimSpace(metric.GetOp()))
+	// percentile carries its quantile in the op, e.g. "percentile(0.99)"
+	quantile, hasQuantile := 0.0, false
+	if argument, ok := strings.CutPrefix(op, "percentile("); ok && strings.HasSuffix(argument, ")") {
+		q, err := agg.ParsePercentileQuantile(strings.TrimSuffix(argument, ")"))
+		if err != nil {
+			return MetricSpec{}, 0, err
+		}
+		op, quantile, hasQuantile = "percentile", q, true
+	}
 	switch op {
 	case "avg", "sum", "count", "min", "max":
+	case "count_distinct", "median", "stddev", "variance":
+	case "percentile":
+		if !hasQuantile {
+			return MetricSpec{}, 0, merr.WrapErrParameterInvalidMsg("percentile metric op requires a quantile, such as percentile(0.99)")
+		}
 	default:
 		return MetricSpec{}, 0, merr.WrapErrParameterInvalidMsg("unsupported metric op %q", metric.GetOp())
 	}
@@ -392,7 +413,7 @@ func buildMetricSpec(metric *commonpb.MetricAggSpec, schema *schemapb.Collection
 	case "_score":
 		// _score is a float32 produced by the engine; declare as Float so
 		// internal/agg's type check accepts it for sum/avg/min/max.
-		return MetricSpec{Op: op, FieldID: ScoreFieldID, FieldType: schemapb.DataType_Float}, 0, nil
+		return MetricSpec{Op: op, FieldID: ScoreFieldID, FieldType: schemapb.DataType_Float, Quantile: quantile}, 0, nil
 	case "*":
 		if op != "count" {
 			return MetricSpec{}, 0, merr.WrapErrParameterInvalidMsg("field_name '*' only supports count op")
@@ -413,7 +434,7 @@ func buildMetricSpec(metric *commonpb.MetricAggSpec, schema *schemapb.Collection
 		if field != nil {
 			fieldType = field.GetDataType()
 		}
-		return MetricSpec{Op: op, FieldID: fieldID, FieldType: fieldType}, fieldID, nil
+		return MetricSpec{Op: op, FieldID: fieldID, FieldType: fieldType, Quantile: quantile}, fieldID, nil
 	}
 }
 
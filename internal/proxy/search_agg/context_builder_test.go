@@ -54,6 +54,36 @@ func TestBuildSearchAggregationContext(t *testing.T) {
 	require.Equal(t, int64(2), ctx.DerivedGroupSize)
 }
 
+func TestBuildSearchAggregationContextApproximateMetrics(t *testing.T) {
+	spec := &commonpb.SearchAggregationSpec{
+		Fields: []string{"brand"},
+		Size:   5,
+		Metrics: map[string]*commonpb.MetricAggSpec{
+			"distinct_category": {Op: "count_distinct", FieldName: "category"},
+			"p99_price":         {Op: "Percentile(0.99)", FieldName: "price"},
+			"median_stock":      {Op: "median", FieldName: "stock"},
+			"stddev_price":      {Op: "stddev", FieldName: "price"},
+			"variance_score":    {Op: "variance", FieldName: "_score"},
+		},
+	}
+
+	ctx, err := BuildSearchAggregationContext(spec, testCollectionSchema(), 1)
+	require.NoError(t, err)
+	metrics := ctx.Levels[0].Metrics
+	require.Equal(t, "percentile", metrics["p99_price"].Op)
+	require.Equal(t, 0.99, metrics["p99_price"].Quantile)
+	require.Len(t, ctx.Levels[0].metricPlans, 5)
+
+	for _, op := range []string{"percentile", "percentile(1.5)", "percentile(abc)"} {
+		spec.Metrics = map[string]*commonpb.MetricAggSpec{"p": {Op: op, FieldName: "price"}}
+		_, err = BuildSearchAggregationContext(spec, testCollectionSchema(), 1)
+		require.Error(t, err, op)
+	}
+	spec.Metrics = map[string]*commonpb.MetricAggSpec{"m": {Op: "median", FieldName: "brand"}}
+	_, err = BuildSearchAggregationContext(spec, testCollectionSchema(), 1)
+	require.Error(t, err)
+}
+
 func TestBuildSearchAggregationContextCarriesTopHitsSortNullFirst(t *testing.T) {
 	schema := testCollectionSchema()
 	spec := &commonpb.SearchAggregationSpec{
@@ -82,6 +82,8 @@ type MetricSpec struct {
 	Op        string
 	FieldID   int64
 	FieldType schemapb.DataType
+	// Quantile is only used by the percentile op.
+	Quantile float64
 }
 
 // metricPlan is the executable form of a MetricSpec.
@@ -23,6 +23,7 @@ import (
 	"math"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/apache/arrow/go/v17/arrow/memory"
 	"github.com/samber/lo"
@@ -35,6 +36,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v3/schemapb"
 	"github.com/milvus-io/milvus/internal/parser/planparserv2"
 	"github.com/milvus-io/milvus/internal/proxy/search_agg"
+	"github.com/milvus-io/milvus/internal/proxy/slowlog"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/function/chain"
 	chaintypes "github.com/milvus-io/milvus/internal/util/function/chain/types"
@@ -3045,6 +3047,8 @@ type pipeline struct {
 	name         string
 	nodes        []*Node
 	traceEnabled bool
+	// slowTrace records the latency of each node for the slow log.
+	slowTrace *slowlog.Trace
 }
 
 func newPipeline(pipeDef *pipelineDef, t *searchTask) (*pipeline, error) {
@@ -3056,7 +3060,7 @@ func newPipeline(pipeDef *pipelineDef, t *searchTask) (*pipeline, error) {
 		}
 		nodes[i] = node
 	}
-	return &pipeline{name: pipeDef.name, nodes: nodes, traceEnabled: t.traceEnabled}, nil
+	return &pipeline{name: pipeDef.name, nodes: nodes, traceEnabled: t.traceEnabled, slowTrace: t.slowTrace}, nil
 }
 
 func (p *pipeline) AddNodes(t *searchTask, nodes ...*nodeDef) error {
@@ -3079,11 +3083,13 @@ func (p *pipeline) Run(ctx context.Context, span trace.Span, toReduceResults []*
 	for _, node := range p.nodes {
 		var err error
 		mlog.Debug(ctx, "SearchPipeline run node", mlog.String("node", node.name))
+		start := time.Now()
 		msg, err = node.Run(ctx, span, msg)
 		if err != nil {
 			mlog.Error(ctx, "Run node failed: ", mlog.String("err", err.Error()))
 			return nil, storageCost, err
 		}
+		p.slowTrace.RecordStage("pipeline."+node.name, time.Since(start))
 		pTrace.TraceMsg(node.opName, msg)
 	}
 	pTrace.LogIfEnabled(ctx, p.name)
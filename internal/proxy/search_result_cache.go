@@ -0,0 +1,131 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v3/milvuspb"
+	"github.com/milvus-io/milvus/internal/proxy/resultcache"
+	"github.com/milvus-io/milvus/internal/proxy/shardclient"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/v3/util/commonpbutil"
+	"github.com/milvus-io/milvus/pkg/v3/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v3/util/typeutil"
+)
+
+// searchResultCacheKey returns the key to cache the result of the search, nil if the search can't be cached.
+// Only the searches with the Bounded or Eventually consistency level are cached, the searches by primary
+// keys and the iterators are always executed. The key carries the versions the shards have applied, so the
+// writes through any proxy lead the later searches to other keys. A Bounded result is still cached no longer
+// than the graceful time, the staleness the Bounded consistency allows.
+func (node *Proxy) searchResultCacheKey(ctx context.Context, request *milvuspb.SearchRequest) *resultcache.Key {
+	if node.searchResultCache == nil || !paramtable.Get().ProxyCfg.SearchResultCache.Enable.GetAsBool() {
+		return nil
+	}
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return nil
+	}
+	if typeutil.GetSizeOfIDs(request.GetIds()) > 0 {
+		return nil
+	}
+	if _, err := funcutil.GetAttrByKeyFromRepeatedKV(IteratorField, request.GetSearchParams()); err == nil {
+		return nil
+	}
+
+	collectionInfo, err := node.getMetaCache().GetCollectionInfo(ctx, request.GetDbName(), request.GetCollectionName(), 0)
+	if err != nil {
+		return nil
+	}
+	consistencyLevel := request.GetConsistencyLevel()
+	if request.GetUseDefaultConsistency() {
+		consistencyLevel = collectionInfo.ConsistencyLevel
+	}
+	if consistencyLevel != commonpb.ConsistencyLevel_Bounded && consistencyLevel != commonpb.ConsistencyLevel_Eventually {
+		return nil
+	}
+
+	shards, err := node.getShardVersions(ctx, request.GetDbName(), request.GetCollectionName(), collectionInfo.CollID)
+	if err != nil {
+		mlog.RatedWarn(ctx, rate.Every(time.Minute), "failed to get the shard versions for the result cache", mlog.Err(err))
+		return nil
+	}
+	key, err := node.searchResultCache.NewKey(GetCurUserFromContextOrDefault(ctx), request.GetDbName(), collectionInfo.CollID, shards, request)
+	if err != nil {
+		mlog.RatedWarn(ctx, rate.Every(time.Minute), "failed to hash the search request for the result cache", mlog.Err(err))
+		return nil
+	}
+	if consistencyLevel == commonpb.ConsistencyLevel_Bounded {
+		key.SetMaxTTL(paramtable.Get().CommonCfg.GracefulTime.GetAsDuration(time.Millisecond))
+	}
+	return key
+}
+
+// getShardVersions returns what the delegators of the collection have applied.
+func (node *Proxy) getShardVersions(ctx context.Context, dbName, collectionName string, collectionID UniqueID) ([]resultcache.ShardVersion, error) {
+	var mu sync.Mutex
+	versions := make(map[string]resultcache.ShardVersion)
+	err := node.lbPolicy.Execute(ctx, shardclient.CollectionWorkLoad{
+		Db:             dbName,
+		CollectionName: collectionName,
+		CollectionID:   collectionID,
+		Nq:             1,
+		Exec: func(ctx context.Context, nodeID int64, qn types.QueryNodeClient, channel string) error {
+			resp, err := qn.GetShardAppliedVersion(ctx, &querypb.GetShardAppliedVersionRequest{
+				Base:    commonpbutil.NewMsgBase(commonpbutil.WithTargetID(nodeID)),
+				Channel: channel,
+			})
+			if err = merr.CheckRPCCall(resp, err); err != nil {
+				node.shardMgr.InvalidateShardLeaderCache([]int64{collectionID})
+				return err
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			versions[channel] = resultcache.ShardVersion{
+				Channel:       channel,
+				AppliedTs:     resp.GetAppliedTimestamp(),
+				TargetVersion: resp.GetTargetVersion(),
+			}
+			return nil
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	shards := make([]resultcache.ShardVersion, 0, len(versions))
+	for _, version := range versions {
+		shards = append(shards, version)
+	}
+	return shards, nil
+}
+
+// expireSearchResults drops the cached search results of the collection written or altered at ts.
+func (node *Proxy) expireSearchResults(collectionID UniqueID, ts Timestamp) {
+	if collectionID == 0 {
+		return
+	}
+	node.searchResultCache.Invalidate(collectionID, ts)
+}
@@ -0,0 +1,199 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v3/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v3/schemapb"
+	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/proxy/resultcache"
+	"github.com/milvus-io/milvus/internal/proxy/shardclient"
+	"github.com/milvus-io/milvus/pkg/v3/proto/proxypb"
+	"github.com/milvus-io/milvus/pkg/v3/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+func TestSearchResultCache(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get()
+	params.Save(params.ProxyCfg.SearchResultCache.Enable.Key, "true")
+	defer params.Reset(params.ProxyCfg.SearchResultCache.Enable.Key)
+
+	ctx := context.Background()
+	cache := NewMockCache(t)
+	cache.EXPECT().GetCollectionInfo(mock.Anything, "db", "c1", int64(0)).Return(&collectionInfo{
+		CollID:           1,
+		ConsistencyLevel: commonpb.ConsistencyLevel_Bounded,
+	}, nil).Maybe()
+	shard := shardclient.NewMockShardClientManager(t)
+	shard.EXPECT().InvalidateShardLeaderCache(mock.Anything).Return().Maybe()
+	appliedTs := atomic.NewUint64(100)
+	qn := mocks.NewMockQueryNodeClient(t)
+	qn.EXPECT().GetShardAppliedVersion(mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, req *querypb.GetShardAppliedVersionRequest, opts ...grpc.CallOption) (*querypb.GetShardAppliedVersionResponse, error) {
+			if req.GetChannel() == "unavailable" {
+				return &querypb.GetShardAppliedVersionResponse{Status: merr.Status(merr.WrapErrChannelNotAvailable(req.GetChannel()))}, nil
+			}
+			return &querypb.GetShardAppliedVersionResponse{Status: merr.Success(), AppliedTimestamp: appliedTs.Load(), TargetVersion: 1}, nil
+		}).Maybe()
+	channels := []string{"ch1", "ch2"}
+	lb := shardclient.NewMockLBPolicy(t)
+	lb.EXPECT().Execute(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, workload shardclient.CollectionWorkLoad) error {
+		for _, channel := range channels {
+			if err := workload.Exec(ctx, 1, qn, channel); err != nil {
+				return err
+			}
+		}
+		return nil
+	}).Maybe()
+	node := &Proxy{
+		metaCache:         cache,
+		shardMgr:          shard,
+		lbPolicy:          lb,
+		searchResultCache: resultcache.NewCache(1024*1024, 1024*1024, time.Minute),
+	}
+	node.UpdateStateCode(commonpb.StateCode_Healthy)
+
+	newRequest := func() *milvuspb.SearchRequest {
+		return &milvuspb.SearchRequest{
+			DbName:                "db",
+			CollectionName:        "c1",
+			Dsl:                   "id > 1",
+			PlaceholderGroup:      []byte("vectors"),
+			UseDefaultConsistency: true,
+		}
+	}
+
+	t.Run("not cacheable", func(t *testing.T) {
+		for name, modify := range map[string]func(req *milvuspb.SearchRequest){
+			"strong": func(req *milvuspb.SearchRequest) {
+				req.UseDefaultConsistency = false
+				req.ConsistencyLevel = commonpb.ConsistencyLevel_Strong
+			},
+			"session": func(req *milvuspb.SearchRequest) {
+				req.UseDefaultConsistency = false
+				req.ConsistencyLevel = commonpb.ConsistencyLevel_Session
+			},
+			"search by pk": func(req *milvuspb.SearchRequest) {
+				req.Ids = &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1}}}}
+			},
+			"iterator": func(req *milvuspb.SearchRequest) {
+				req.SearchParams = []*commonpb.KeyValuePair{{Key: IteratorField, Value: "true"}}
+			},
+		} {
+			req := newRequest()
+			modify(req)
+			assert.Nil(t, node.searchResultCacheKey(ctx, req), name)
+		}
+
+		params.Save(params.ProxyCfg.SearchResultCache.Enable.Key, "false")
+		assert.Nil(t, node.searchResultCacheKey(ctx, newRequest()))
+		params.Save(params.ProxyCfg.SearchResultCache.Enable.Key, "true")
+	})
+
+	t.Run("hit and invalidate", func(t *testing.T) {
+		key := node.searchResultCacheKey(ctx, newRequest())
+		require.NotNil(t, key)
+		result := &milvuspb.SearchResults{Status: merr.Success(), CollectionName: "c1"}
+		node.searchResultCache.Put(key, result)
+
+		// served from the cache without being executed
+		resp, err := node.Search(ctx, newRequest())
+		assert.NoError(t, err)
+		assert.Equal(t, "c1", resp.GetCollectionName())
+
+		// an explicit eventually search is another key
+		req := newRequest()
+		req.UseDefaultConsistency = false
+		req.ConsistencyLevel = commonpb.ConsistencyLevel_Eventually
+		other := node.searchResultCacheKey(ctx, req)
+		require.NotNil(t, other)
+		_, ok := node.searchResultCache.Get(other)
+		assert.False(t, ok)
+
+		cache.EXPECT().InvalidateCollectionMeta(mock.Anything, "db", "c1", int64(1), false).Return(nil)
+		status, err := node.InvalidateCollectionMetaCache(ctx, &proxypb.InvalidateCollMetaCacheRequest{
+			Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_AlterCollection, Timestamp: 100},
+			DbName:         "db",
+			CollectionName: "c1",
+			CollectionID:   1,
+		})
+		assert.NoError(t, err)
+		assert.True(t, merr.Ok(status))
+		_, ok = node.searchResultCache.Get(key)
+		assert.False(t, ok)
+
+		key = node.searchResultCacheKey(ctx, newRequest())
+		node.searchResultCache.Put(key, result)
+		node.expireSearchResults(1, 0)
+		_, ok = node.searchResultCache.Get(key)
+		assert.False(t, ok)
+	})
+
+	t.Run("writes through other proxies", func(t *testing.T) {
+		key := node.searchResultCacheKey(ctx, newRequest())
+		require.NotNil(t, key)
+		node.searchResultCache.Put(key, &milvuspb.SearchResults{Status: merr.Success()})
+		_, ok := node.searchResultCache.Get(node.searchResultCacheKey(ctx, newRequest()))
+		assert.True(t, ok)
+
+		// the shards applied a write this proxy didn't see
+		appliedTs.Store(200)
+		_, ok = node.searchResultCache.Get(node.searchResultCacheKey(ctx, newRequest()))
+		assert.False(t, ok)
+
+		// not cacheable without the versions of all the shards
+		channels = []string{"ch1", "unavailable"}
+		defer func() { channels = []string{"ch1", "ch2"} }()
+		assert.Nil(t, node.searchResultCacheKey(ctx, newRequest()))
+	})
+
+	t.Run("bounded results expire with the graceful time", func(t *testing.T) {
+		params.Save(params.CommonCfg.GracefulTime.Key, "1")
+		defer params.Reset(params.CommonCfg.GracefulTime.Key)
+
+		key := node.searchResultCacheKey(ctx, newRequest())
+		require.NotNil(t, key)
+		node.searchResultCache.Put(key, &milvuspb.SearchResults{Status: merr.Success()})
+		time.Sleep(10 * time.Millisecond)
+		_, ok := node.searchResultCache.Get(key)
+		assert.False(t, ok)
+
+		// the eventually results live for the ttl
+		req := newRequest()
+		req.UseDefaultConsistency = false
+		req.ConsistencyLevel = commonpb.ConsistencyLevel_Eventually
+		key = node.searchResultCacheKey(ctx, req)
+		require.NotNil(t, key)
+		node.searchResultCache.Put(key, &milvuspb.SearchResults{Status: merr.Success()})
+		time.Sleep(10 * time.Millisecond)
+		_, ok = node.searchResultCache.Get(key)
+		assert.True(t, ok)
+	})
+}
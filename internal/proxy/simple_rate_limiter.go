@@ -144,6 +144,65 @@ func (m *SimpleLimiter) Check(dbID int64, collectionIDToPartIDs map[int64][]int6
 	return ret
 }
 
+// CheckWithPrincipal checks the rate limits of the user and its roles, then the same as Check.
+func (m *SimpleLimiter) CheckWithPrincipal(username string, roles []string, dbID int64, collectionIDToPartIDs map[int64][]int64, rt internalpb.RateType, n int) error {
+	if !Params.QuotaConfig.QuotaAndLimitsEnabled.GetAsBool() {
+		return nil
+	}
+	if n <= 0 {
+		return nil
+	}
+
+	doneLimiters, err := m.checkPrincipals(username, roles, rt, n)
+	if err != nil {
+		return err
+	}
+	if err := m.Check(dbID, collectionIDToPartIDs, rt, n); err != nil {
+		for _, limiter := range doneLimiters {
+			limiter.Cancel(rt, n)
+		}
+		return err
+	}
+	return nil
+}
+
+// checkPrincipals checks the limiters of the user and its roles, returns the passed limiters.
+func (m *SimpleLimiter) checkPrincipals(username string, roles []string, rt internalpb.RateType, n int) ([]*rlinternal.RateLimiterNode, error) {
+	principals := make([]rlinternal.Principal, 0, len(roles)+1)
+	if username != "" {
+		principals = append(principals, rlinternal.Principal{Kind: rlinternal.PrincipalUser, Name: username})
+	}
+	for _, role := range roles {
+		principals = append(principals, rlinternal.Principal{Kind: rlinternal.PrincipalRole, Name: role})
+	}
+
+	m.quotaStatesMu.RLock()
+	defer m.quotaStatesMu.RUnlock()
+
+	doneLimiters := make([]*rlinternal.RateLimiterNode, 0)
+	for _, principal := range principals {
+		node := m.rateLimiter.GetPrincipalLimiters(principal)
+		if node == nil {
+			continue
+		}
+		var err error
+		limit, rate := node.Limit(rt, n)
+		if rate == 0 {
+			err = node.GetQuotaExceededError(rt)
+		} else if limit {
+			err = merr.WrapErrServiceRateLimit(rate, fmt.Sprintf("request is rejected by the rate limit of %s, please retry later", principal))
+		}
+		if err != nil {
+			for _, limiter := range doneLimiters {
+				limiter.Cancel(rt, n)
+			}
+			return nil, err
+		}
+		doneLimiters = append(doneLimiters, node)
+	}
+	return doneLimiters, nil
+}
+
 func isNotCollectionLevelLimitRequest(rt internalpb.RateType) bool {
 	// Most ddl is global level, only DDLFlush will be applied at collection
 	switch rt {
@@ -175,6 +234,16 @@ func (m *SimpleLimiter) GetQuotaStates() ([]milvuspb.QuotaState, []string) {
 			serviceStates[state].Insert(stateReasonKey{ErrorCode: errCode, Reason: reason})
 			return true
 		})
+	m.rateLimiter.GetPrincipals().Range(func(_ rlinternal.Principal, node *rlinternal.RateLimiterNode) bool {
+		node.GetQuotaStates().Range(func(state milvuspb.QuotaState, info *rlinternal.QuotaStateInfo) bool {
+			if serviceStates[state] == nil {
+				serviceStates[state] = typeutil.NewSet[stateReasonKey]()
+			}
+			serviceStates[state].Insert(stateReasonKey{ErrorCode: info.ErrorCode, Reason: info.Reason})
+			return true
+		})
+		return true
+	})
 
 	states := make([]milvuspb.QuotaState, 0)
 	reasons := make([]string, 0)
@@ -278,6 +347,37 @@ func newPartitionLimiters() *rlinternal.RateLimiterNode {
 	return partRateLimiters
 }
 
+// SetPrincipalRates updates the limiters of the users and roles, the rates are allocated
+// to each proxy by the quota center. The limiters not in the request are removed.
+func (m *SimpleLimiter) SetPrincipalRates(reqLimiters []*proxypb.PrincipalLimiter) {
+	m.quotaStatesMu.Lock()
+	defer m.quotaStatesMu.Unlock()
+
+	principals := make(map[rlinternal.Principal]*rlinternal.RateLimiterNode, len(reqLimiters))
+	for _, reqLimiter := range reqLimiters {
+		principal := rlinternal.Principal{Kind: rlinternal.PrincipalKind(reqLimiter.GetKind()), Name: reqLimiter.GetName()}
+		node := m.rateLimiter.GetPrincipalLimiters(principal)
+		if node == nil {
+			node = rlinternal.NewRateLimiterNode(internalpb.RateScope_Cluster)
+		}
+		// keep the limiters of the rates still configured, so their tokens are not reset
+		limiters := typeutil.NewConcurrentMap[internalpb.RateType, *ratelimitutil.Limiter]()
+		for _, rate := range reqLimiter.GetLimiter().GetRates() {
+			limiter, ok := node.GetLimiters().Get(rate.GetRt())
+			if ok {
+				limiter.SetLimit(ratelimitutil.Limit(rate.GetR()))
+			} else {
+				limiter = ratelimitutil.NewLimiter(ratelimitutil.Limit(rate.GetR()), rate.GetR())
+			}
+			limiters.Insert(rate.GetRt(), limiter)
+		}
+		node.SetLimiters(limiters)
+		node.SetQuotaStates(toQuotaStates(reqLimiter.GetLimiter()))
+		principals[principal] = node
+	}
+	m.rateLimiter.ResetPrincipalLimiters(principals)
+}
+
 func (m *SimpleLimiter) updateLimiterNode(req *proxypb.Limiter, node *rlinternal.RateLimiterNode, sourceID string) error {
 	curLimiters := node.GetLimiters()
 	for _, rate := range req.GetRates() {
@@ -288,6 +388,11 @@ func (m *SimpleLimiter) updateLimiterNode(req *proxypb.Limiter, node *rlinternal
 		limit.SetLimit(ratelimitutil.Limit(rate.GetR()))
 		setRateGaugeByRateType(rate.GetRt(), paramtable.GetNodeID(), sourceID, rate.GetR())
 	}
+	node.SetQuotaStates(toQuotaStates(req))
+	return nil
+}
+
+func toQuotaStates(req *proxypb.Limiter) *typeutil.ConcurrentMap[milvuspb.QuotaState, *rlinternal.QuotaStateInfo] {
 	quotaStates := typeutil.NewConcurrentMap[milvuspb.QuotaState, *rlinternal.QuotaStateInfo]()
 	states := req.GetStates()
 	codes := req.GetCodes()
@@ -302,8 +407,7 @@ func (m *SimpleLimiter) updateLimiterNode(req *proxypb.Limiter, node *rlinternal
 			Reason:    reason,
 		})
 	}
-	node.SetQuotaStates(quotaStates)
-	return nil
+	return quotaStates
 }
 
 func (m *SimpleLimiter) updateRateLimiter(reqRootLimiterNode *proxypb.LimiterNode) error {
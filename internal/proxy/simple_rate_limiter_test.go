@@ -231,6 +231,109 @@ func TestSimpleRateLimiter(t *testing.T) {
 	})
 }
 
+func TestSimpleRateLimiterPrincipal(t *testing.T) {
+	paramtable.Get().Save(Params.QuotaConfig.QuotaAndLimitsEnabled.Key, "true")
+	defer paramtable.Get().Reset(Params.QuotaConfig.QuotaAndLimitsEnabled.Key)
+
+	aliceLimiter := &proxypb.PrincipalLimiter{
+		Kind: string(rlinternal.PrincipalUser),
+		Name: "alice",
+		Limiter: &proxypb.Limiter{
+			Rates: []*internalpb.Rate{
+				{Rt: internalpb.RateType_DQLSearch, R: 10},
+				{Rt: internalpb.RateType_DMLInsert, R: 0},
+			},
+			States:  []milvuspb.QuotaState{milvuspb.QuotaState_DenyToWrite},
+			Codes:   []commonpb.ErrorCode{commonpb.ErrorCode_ForceDeny},
+			Reasons: []string{"denied by the rate limits of user alice"},
+		},
+	}
+	readerLimiter := &proxypb.PrincipalLimiter{
+		Kind: string(rlinternal.PrincipalRole),
+		Name: "reader",
+		Limiter: &proxypb.Limiter{
+			Rates: []*internalpb.Rate{{Rt: internalpb.RateType_DQLQuery, R: 5}},
+		},
+	}
+	simpleLimiter := NewSimpleLimiter(0, 0)
+	simpleLimiter.SetPrincipalRates([]*proxypb.PrincipalLimiter{aliceLimiter, readerLimiter})
+
+	t.Run("user rate limit", func(t *testing.T) {
+		err := simpleLimiter.CheckWithPrincipal("alice", nil, util.InvalidDBID, nil, internalpb.RateType_DQLSearch, 10)
+		assert.NoError(t, err)
+		err = simpleLimiter.CheckWithPrincipal("alice", nil, util.InvalidDBID, nil, internalpb.RateType_DQLSearch, 10)
+		assert.ErrorIs(t, err, merr.ErrServiceRateLimit)
+		assert.ErrorContains(t, err, "user alice")
+
+		// other users are not limited
+		err = simpleLimiter.CheckWithPrincipal("bob", nil, util.InvalidDBID, nil, internalpb.RateType_DQLSearch, 10)
+		assert.NoError(t, err)
+		err = simpleLimiter.CheckWithPrincipal("bob", nil, util.InvalidDBID, nil, internalpb.RateType_DQLSearch, 10)
+		assert.NoError(t, err)
+	})
+
+	t.Run("user deny", func(t *testing.T) {
+		err := simpleLimiter.CheckWithPrincipal("alice", nil, util.InvalidDBID, nil, internalpb.RateType_DMLInsert, 1)
+		assert.ErrorIs(t, err, merr.ErrServiceQuotaExceeded)
+		assert.ErrorContains(t, err, "user alice")
+	})
+
+	t.Run("role rate limit", func(t *testing.T) {
+		err := simpleLimiter.CheckWithPrincipal("bob", []string{"public", "reader"}, util.InvalidDBID, nil, internalpb.RateType_DQLQuery, 5)
+		assert.NoError(t, err)
+		err = simpleLimiter.CheckWithPrincipal("bob", []string{"public", "reader"}, util.InvalidDBID, nil, internalpb.RateType_DQLQuery, 5)
+		assert.ErrorIs(t, err, merr.ErrServiceRateLimit)
+		assert.ErrorContains(t, err, "role reader")
+	})
+
+	t.Run("cancel principal limiters", func(t *testing.T) {
+		limiter := NewSimpleLimiter(0, 0)
+		limiter.SetPrincipalRates([]*proxypb.PrincipalLimiter{aliceLimiter})
+		limiter.rateLimiter.GetRootLimiters().GetLimiters().Insert(internalpb.RateType_DQLSearch,
+			ratelimitutil.NewLimiter(ratelimitutil.Limit(0), 0))
+		err := limiter.CheckWithPrincipal("alice", nil, util.InvalidDBID, nil, internalpb.RateType_DQLSearch, 10)
+		assert.Error(t, err)
+
+		// the tokens of alice are returned as the request is rejected by the cluster limiter
+		limiter.rateLimiter.GetRootLimiters().GetLimiters().Remove(internalpb.RateType_DQLSearch)
+		err = limiter.CheckWithPrincipal("alice", nil, util.InvalidDBID, nil, internalpb.RateType_DQLSearch, 10)
+		assert.NoError(t, err)
+	})
+
+	t.Run("quota states", func(t *testing.T) {
+		states, reasons := simpleLimiter.GetQuotaStates()
+		assert.Contains(t, states, milvuspb.QuotaState_DenyToWrite)
+		assert.Len(t, reasons, len(states))
+	})
+
+	t.Run("update", func(t *testing.T) {
+		reader := rlinternal.Principal{Kind: rlinternal.PrincipalRole, Name: "reader"}
+		old, ok := simpleLimiter.rateLimiter.GetPrincipalLimiters(reader).GetLimiters().Get(internalpb.RateType_DQLQuery)
+		assert.True(t, ok)
+
+		readerLimiter := &proxypb.PrincipalLimiter{
+			Kind: string(rlinternal.PrincipalRole),
+			Name: "reader",
+			Limiter: &proxypb.Limiter{
+				Rates: []*internalpb.Rate{{Rt: internalpb.RateType_DQLQuery, R: 2}},
+			},
+		}
+		simpleLimiter.SetPrincipalRates([]*proxypb.PrincipalLimiter{readerLimiter})
+		assert.Nil(t, simpleLimiter.rateLimiter.GetPrincipalLimiters(rlinternal.Principal{Kind: rlinternal.PrincipalUser, Name: "alice"}))
+		err := simpleLimiter.CheckWithPrincipal("alice", nil, util.InvalidDBID, nil, internalpb.RateType_DMLInsert, 1)
+		assert.NoError(t, err)
+
+		// the limiter of the reader is kept with the new rate
+		limiter, ok := simpleLimiter.rateLimiter.GetPrincipalLimiters(reader).GetLimiters().Get(internalpb.RateType_DQLQuery)
+		assert.True(t, ok)
+		assert.Same(t, old, limiter)
+		assert.Equal(t, ratelimitutil.Limit(2), limiter.Limit())
+
+		simpleLimiter.SetPrincipalRates(nil)
+		assert.Equal(t, 0, simpleLimiter.rateLimiter.GetPrincipals().Len())
+	})
+}
+
 func getZeroRates() []*internalpb.Rate {
 	zeroRates := make([]*internalpb.Rate, 0, len(internalpb.RateType_value))
 	for _, rt := range internalpb.RateType_value {
@@ -0,0 +1,191 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/milvuspb"
+	"github.com/milvus-io/milvus/internal/json"
+	"github.com/milvus-io/milvus/internal/proxy/accesslog"
+	"github.com/milvus-io/milvus/internal/proxy/slowlog"
+	"github.com/milvus-io/milvus/pkg/v3/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+// slowLogTask is implemented by the tasks tracing their stages for the slow log.
+type slowLogTask interface {
+	getSlowTrace() *slowlog.Trace
+}
+
+// getTaskSlowTrace returns the slow log trace of the task, nil if the task isn't traced.
+func getTaskSlowTrace(t task) *slowlog.Trace {
+	if st, ok := t.(slowLogTask); ok {
+		return st.getSlowTrace()
+	}
+	return nil
+}
+
+func (t *searchTask) getSlowTrace() *slowlog.Trace {
+	return t.slowTrace
+}
+
+func (t *queryTask) getSlowTrace() *slowlog.Trace {
+	return t.slowTrace
+}
+
+// getTraceID returns the trace id of the span, empty if the span is nil.
+func getTraceID(sp trace.Span) string {
+	if sp == nil {
+		return ""
+	}
+	return sp.SpanContext().TraceID().String()
+}
+
+// traceSearchSegments records the sealed segments touched by the shards of a search.
+func traceSearchSegments(slowTrace *slowlog.Trace, results []*internalpb.SearchResults) {
+	for _, result := range results {
+		slowTrace.AddSegments(result.GetChannelIDsSearched(), result.GetSealedSegmentIDsSearched())
+	}
+}
+
+// traceQuerySegments records the sealed segments touched by the shards of a query.
+func traceQuerySegments(slowTrace *slowlog.Trace, results []*internalpb.RetrieveResults) {
+	for _, result := range results {
+		slowTrace.AddSegments(result.GetChannelIDsRetrieved(), result.GetSealedSegmentIDsRetrieved())
+	}
+}
+
+// recordSlowSearch adds the search or hybrid search to the slow log if it's slower than the threshold.
+func (node *Proxy) recordSlowSearch(ctx context.Context, qt *searchTask, request *milvuspb.SearchRequest, cost time.Duration, traceID string) {
+	requestType := slowlog.TypeSearch
+	if len(request.GetSubReqs()) > 0 {
+		requestType = slowlog.TypeHybridSearch
+	}
+	if node.slowLog == nil || !slowlog.IsSlow(requestType, cost) {
+		return
+	}
+
+	record := &slowlog.Record{
+		Type:               requestType,
+		TraceID:            traceID,
+		User:               GetCurUserFromContextOrDefault(ctx),
+		Database:           request.GetDbName(),
+		Collection:         request.GetCollectionName(),
+		Partitions:         request.GetPartitionNames(),
+		OutputFields:       request.GetOutputFields(),
+		NQ:                 qt.GetNq(),
+		TopK:               qt.GetTopk(),
+		ConsistencyLevel:   accesslog.NewConsistencyLevelHelper(ctx, request).String(),
+		GuaranteeTimestamp: qt.GetGuaranteeTimestamp(),
+		ThresholdInMs:      slowlog.Threshold(requestType).Milliseconds(),
+	}
+	if requestType == slowlog.TypeHybridSearch {
+		var nq int64
+		for _, subReq := range request.GetSubReqs() {
+			record.SubExprs = append(record.SubExprs, slowlog.NormalizeExpr(subReq.GetDsl()))
+			nq += subReq.GetNq()
+		}
+		record.NQ = nq
+		record.Fingerprint = slowlog.Fingerprint(requestType, record.Database, record.Collection, record.SubExprs...)
+	} else {
+		record.Expr = slowlog.NormalizeExpr(request.GetDsl())
+		record.Fingerprint = slowlog.Fingerprint(requestType, record.Database, record.Collection, record.Expr)
+	}
+	qt.slowTrace.Fill(record, paramtable.Get().ProxyCfg.SlowLog.MaxSegmentIDs.GetAsInt())
+	node.slowLog.Add(record, cost)
+}
+
+// recordSlowQuery adds the query to the slow log if it's slower than the threshold.
+func (node *Proxy) recordSlowQuery(ctx context.Context, qt *queryTask, cost time.Duration, traceID string) {
+	if node.slowLog == nil || qt.slowTrace == nil || !slowlog.IsSlow(slowlog.TypeQuery, cost) {
+		return
+	}
+
+	request := qt.request
+	record := &slowlog.Record{
+		Type:               slowlog.TypeQuery,
+		TraceID:            traceID,
+		User:               GetCurUserFromContextOrDefault(ctx),
+		Database:           request.GetDbName(),
+		Collection:         request.GetCollectionName(),
+		Expr:               slowlog.NormalizeExpr(request.GetExpr()),
+		Partitions:         request.GetPartitionNames(),
+		OutputFields:       request.GetOutputFields(),
+		TopK:               qt.GetLimit(),
+		ConsistencyLevel:   accesslog.NewConsistencyLevelHelper(ctx, request).String(),
+		GuaranteeTimestamp: qt.GetGuaranteeTimestamp(),
+		ThresholdInMs:      slowlog.Threshold(slowlog.TypeQuery).Milliseconds(),
+	}
+	record.Fingerprint = slowlog.Fingerprint(slowlog.TypeQuery, record.Database, record.Collection, record.Expr)
+	qt.slowTrace.Fill(record, paramtable.Get().ProxyCfg.SlowLog.MaxSegmentIDs.GetAsInt())
+	node.slowLog.Add(record, cost)
+}
+
+// ListSlowLog lists the recent slow requests, filtered by the query parameters
+// type, db_name, collection_name, fingerprint, min_cost_ms and limit.
+func (node *Proxy) ListSlowLog(w http.ResponseWriter, req *http.Request) {
+	if node.slowLog == nil {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		fmt.Fprintf(w, `{"msg": "slow log is not initialized"}`)
+		return
+	}
+
+	query := req.URL.Query()
+	filter := &slowlog.Filter{
+		Type:        query.Get("type"),
+		Database:    query.Get("db_name"),
+		Collection:  query.Get("collection_name"),
+		Fingerprint: query.Get("fingerprint"),
+	}
+	if value := query.Get("min_cost_ms"); value != "" {
+		minCost, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, `{"msg": "invalid min_cost_ms, %s"}`, err.Error())
+			return
+		}
+		filter.MinCost = time.Duration(minCost) * time.Millisecond
+	}
+	if value := query.Get("limit"); value != "" {
+		limit, err := strconv.Atoi(value)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, `{"msg": "invalid limit, %s"}`, err.Error())
+			return
+		}
+		filter.Limit = limit
+	}
+
+	bs, err := json.Marshal(map[string]interface{}{
+		"msg":     "OK",
+		"records": node.slowLog.List(filter),
+	})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"msg": "failed to marshal slow log, %s"}`, err.Error())
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(bs)
+}
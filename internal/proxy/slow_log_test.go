@@ -0,0 +1,126 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/milvuspb"
+	management "github.com/milvus-io/milvus/internal/http"
+	"github.com/milvus-io/milvus/internal/json"
+	"github.com/milvus-io/milvus/internal/proxy/slowlog"
+	"github.com/milvus-io/milvus/pkg/v3/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+func TestSlowLog(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get()
+	params.Save(params.ProxyCfg.SlowLog.SearchThreshold.Key, "10")
+	params.Save(params.ProxyCfg.SlowLog.QueryThreshold.Key, "10")
+	defer params.Reset(params.ProxyCfg.SlowLog.SearchThreshold.Key)
+	defer params.Reset(params.ProxyCfg.SlowLog.QueryThreshold.Key)
+
+	node := &Proxy{slowLog: slowlog.NewLogger(10)}
+	ctx := context.Background()
+
+	st := &searchTask{
+		SearchRequest: &internalpb.SearchRequest{Nq: 2, Topk: 10},
+		slowTrace:     slowlog.NewTrace(),
+	}
+	getTaskSlowTrace(st).RecordStage("execute", 20*time.Millisecond)
+	traceSearchSegments(st.slowTrace, []*internalpb.SearchResults{
+		{ChannelIDsSearched: []string{"ch-1"}, SealedSegmentIDsSearched: []int64{1, 2}},
+		{ChannelIDsSearched: []string{"ch-2"}, SealedSegmentIDsSearched: []int64{3}},
+	})
+	searchReq := &milvuspb.SearchRequest{DbName: "db", CollectionName: "c1", Dsl: "id in [1, 2, 3]"}
+	// faster than the threshold
+	node.recordSlowSearch(ctx, st, searchReq, time.Millisecond, "")
+	node.recordSlowSearch(ctx, st, searchReq, 30*time.Millisecond, "trace")
+
+	qt := &queryTask{
+		RetrieveRequest: &internalpb.RetrieveRequest{Limit: 5},
+		request:         &milvuspb.QueryRequest{DbName: "db", CollectionName: "c2", Expr: `name == "a"`},
+	}
+	// the task isn't traced, i.e. a requery
+	node.recordSlowQuery(ctx, qt, time.Second, "")
+	qt.slowTrace = slowlog.NewTrace()
+	node.recordSlowQuery(ctx, qt, time.Second, "")
+
+	list := func(query string) []*slowlog.Record {
+		req, err := http.NewRequest(http.MethodGet, management.RouteSlowLog+query, nil)
+		require.NoError(t, err)
+		recorder := httptest.NewRecorder()
+		node.ListSlowLog(recorder, req)
+		require.Equal(t, http.StatusOK, recorder.Code)
+		resp := struct {
+			Records []*slowlog.Record `json:"records"`
+		}{}
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+		return resp.Records
+	}
+
+	records := list("")
+	require.Len(t, records, 2)
+	assert.Equal(t, slowlog.TypeQuery, records[0].Type)
+	assert.Equal(t, "name == ?", records[0].Expr)
+	assert.Equal(t, int64(5), records[0].TopK)
+
+	search := records[1]
+	assert.Equal(t, slowlog.TypeSearch, search.Type)
+	assert.Equal(t, "id in [?]", search.Expr)
+	assert.Equal(t, "trace", search.TraceID)
+	assert.Equal(t, int64(2), search.NQ)
+	assert.Equal(t, int64(10), search.TopK)
+	assert.Equal(t, int64(10), search.ThresholdInMs)
+	assert.Equal(t, 3, search.NumSegments)
+	assert.Equal(t, []int64{1, 2, 3}, search.SegmentIDs)
+	assert.Equal(t, []string{"ch-1", "ch-2"}, search.Channels)
+	assert.Equal(t, []slowlog.Stage{{Name: "execute", CostInMs: 20}}, search.Stages)
+
+	records = list("?type=Search&collection_name=c1&fingerprint=" + search.Fingerprint)
+	assert.Len(t, records, 1)
+	records = list("?min_cost_ms=100")
+	assert.Len(t, records, 1)
+	records = list("?limit=1")
+	assert.Len(t, records, 1)
+
+	t.Run("invalid params", func(t *testing.T) {
+		for _, query := range []string{"?limit=a", "?min_cost_ms=b"} {
+			req, err := http.NewRequest(http.MethodGet, management.RouteSlowLog+query, nil)
+			require.NoError(t, err)
+			recorder := httptest.NewRecorder()
+			node.ListSlowLog(recorder, req)
+			assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		}
+	})
+
+	t.Run("not initialized", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodGet, management.RouteSlowLog, nil)
+		require.NoError(t, err)
+		recorder := httptest.NewRecorder()
+		(&Proxy{}).ListSlowLog(recorder, req)
+		assert.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+	})
+}
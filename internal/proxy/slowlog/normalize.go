@@ -0,0 +1,138 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slowlog
+
+import (
+	"fmt"
+	"hash/fnv"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// placeholder replaces the literals of a normalized expression.
+const placeholder = "?"
+
+var (
+	// listPattern matches a list of placeholders, which is collapsed into a single placeholder,
+	// so that `id in [1, 2]` and `id in [3, 4, 5]` share the fingerprint.
+	listPattern = regexp.MustCompile(`\[\s*\?(\s*,\s*\?)*\s*\]`)
+
+	// keywords are lower cased when normalizing, the operators of the grammar are case-insensitive.
+	keywords = map[string]struct{}{
+		"and": {}, "or": {}, "not": {}, "in": {}, "like": {}, "is": {}, "null": {}, "exists": {},
+	}
+	// literalKeywords are the keyword literals replaced by the placeholder.
+	literalKeywords = map[string]struct{}{
+		"true": {}, "false": {},
+	}
+)
+
+// NormalizeExpr replaces the literals of a filter expression by placeholders and
+// collapses the white spaces, the result identifies the shape of the expression.
+func NormalizeExpr(expr string) string {
+	runes := []rune(strings.TrimSpace(expr))
+	var sb strings.Builder
+	// last is the last non-space rune written, used to tell a sign from a minus.
+	var last rune
+	write := func(s string) {
+		sb.WriteString(s)
+		for _, r := range s {
+			if !unicode.IsSpace(r) {
+				last = r
+			}
+		}
+	}
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			for i < len(runes) && unicode.IsSpace(runes[i]) {
+				i++
+			}
+			if i < len(runes) {
+				sb.WriteByte(' ')
+			}
+		case r == '"' || r == '\'':
+			i++
+			for i < len(runes) && runes[i] != r {
+				if runes[i] == '\\' {
+					i++
+				}
+				i++
+			}
+			i++
+			write(placeholder)
+		case unicode.IsDigit(r) || (isSign(r) && i+1 < len(runes) && unicode.IsDigit(runes[i+1]) && expectsOperand(last)):
+			i++
+			for i < len(runes) && isNumberRune(runes[i], runes[i-1]) {
+				i++
+			}
+			write(placeholder)
+		case r == '_' || unicode.IsLetter(r):
+			start := i
+			for i < len(runes) && (runes[i] == '_' || unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i])) {
+				i++
+			}
+			word := string(runes[start:i])
+			lower := strings.ToLower(word)
+			if _, ok := literalKeywords[lower]; ok {
+				write(placeholder)
+			} else if _, ok := keywords[lower]; ok {
+				write(lower)
+			} else {
+				write(word)
+			}
+		default:
+			write(string(r))
+			i++
+		}
+	}
+	return listPattern.ReplaceAllString(sb.String(), "["+placeholder+"]")
+}
+
+// Fingerprint hashes the request type, the collection and the normalized expressions,
+// the requests of the same shape share the fingerprint.
+func Fingerprint(requestType string, database string, collection string, exprs ...string) string {
+	h := fnv.New64a()
+	for _, s := range append([]string{requestType, database, collection}, exprs...) {
+		h.Write([]byte(s))
+		h.Write([]byte{0})
+	}
+	return fmt.Sprintf("%016x", h.Sum64())
+}
+
+func isSign(r rune) bool {
+	return r == '-' || r == '+'
+}
+
+// expectsOperand returns whether an operand is expected after the rune, so a sign is a part of a number.
+func expectsOperand(last rune) bool {
+	return last == 0 || strings.ContainsRune("([,=<>!+-*/%", last)
+}
+
+func isNumberRune(r rune, prev rune) bool {
+	if unicode.IsDigit(r) || r == '.' || r == 'e' || r == 'E' || r == 'x' || r == 'X' {
+		return true
+	}
+	if isSign(r) && (prev == 'e' || prev == 'E') {
+		return true
+	}
+	// hex digits
+	return (r >= 'a' && r <= 'f') || (r >= 'A' && r <= 'F')
+}
@@ -0,0 +1,59 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slowlog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeExpr(t *testing.T) {
+	cases := []struct {
+		expr     string
+		expected string
+	}{
+		{"", ""},
+		{"id > 10", "id > ?"},
+		{"  id   >  10  AND  age <= 3.5 ", "id > ? and age <= ?"},
+		{`name == "alice"`, "name == ?"},
+		{`name == 'a\'b' or name like "c%"`, "name == ? or name like ?"},
+		{"id in [1, 2, 3]", "id in [?]"},
+		{`tag IN ["a","b"]`, "tag in [?]"},
+		{"id in [-1, +2]", "id in [?]"},
+		{"a - 1 > -2", "a - ? > ?"},
+		{"score > 1e-5", "score > ?"},
+		{"field1 == 0x1F", "field1 == ?"},
+		{"flag == true && other != False", "flag == ? && other != ?"},
+		{"json_contains(meta['tags'], 7)", "json_contains(meta[?], ?)"},
+		{"id > {min_id}", "id > {min_id}"},
+		{"not (x is null)", "not (x is null)"},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.expected, NormalizeExpr(c.expr), c.expr)
+	}
+}
+
+func TestFingerprint(t *testing.T) {
+	fp := Fingerprint(TypeSearch, "default", "c1", NormalizeExpr("id in [1, 2]"))
+	assert.Len(t, fp, 16)
+	assert.Equal(t, fp, Fingerprint(TypeSearch, "default", "c1", NormalizeExpr("id  IN [3,4,5]")))
+	assert.NotEqual(t, fp, Fingerprint(TypeQuery, "default", "c1", NormalizeExpr("id in [1, 2]")))
+	assert.NotEqual(t, fp, Fingerprint(TypeSearch, "default", "c2", NormalizeExpr("id in [1, 2]")))
+	// the separator keeps the fields apart
+	assert.NotEqual(t, Fingerprint(TypeSearch, "ab", "c"), Fingerprint(TypeSearch, "a", "bc"))
+}
@@ -0,0 +1,296 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package slowlog records the search and query requests slower than the threshold of their type.
+// The recent records are kept in memory for the management http api, and are written as json
+// lines through the rotate writer of the access log if a file name is configured.
+package slowlog
+
+import (
+	"context"
+	"io"
+	"sort"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/milvus-io/milvus/internal/json"
+	"github.com/milvus-io/milvus/internal/proxy/accesslog"
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+const (
+	TypeSearch       = "Search"
+	TypeHybridSearch = "HybridSearch"
+	TypeQuery        = "Query"
+)
+
+// Stage is the latency of a stage of a request.
+type Stage struct {
+	Name     string  `json:"name"`
+	CostInMs float64 `json:"cost_ms"`
+}
+
+// Record is a slow request.
+type Record struct {
+	Time        string `json:"time"`
+	Type        string `json:"type"`
+	Fingerprint string `json:"fingerprint"`
+	TraceID     string `json:"trace_id,omitempty"`
+	User        string `json:"user,omitempty"`
+	Database    string `json:"database,omitempty"`
+	Collection  string `json:"collection"`
+	// Expr is the normalized filter expression, SubExprs are the ones of the sub requests of a hybrid search.
+	Expr               string   `json:"expr,omitempty"`
+	SubExprs           []string `json:"sub_exprs,omitempty"`
+	Partitions         []string `json:"partitions,omitempty"`
+	OutputFields       []string `json:"output_fields,omitempty"`
+	NQ                 int64    `json:"nq,omitempty"`
+	TopK               int64    `json:"topk,omitempty"`
+	ConsistencyLevel   string   `json:"consistency_level,omitempty"`
+	GuaranteeTimestamp uint64   `json:"guarantee_timestamp,omitempty"`
+	CostInMs           float64  `json:"cost_ms"`
+	ThresholdInMs      int64    `json:"threshold_ms"`
+	Stages             []Stage  `json:"stages,omitempty"`
+	Channels           []string `json:"channels,omitempty"`
+	// NumSegments is the number of sealed segments touched on the query nodes,
+	// SegmentIDs is truncated to proxy.slowLog.maxSegmentIDs.
+	NumSegments int     `json:"num_segments"`
+	SegmentIDs  []int64 `json:"segment_ids,omitempty"`
+
+	cost time.Duration
+}
+
+// Trace collects the stages and the touched segments of a request, it's nil-safe and goroutine-safe.
+type Trace struct {
+	mu       sync.Mutex
+	stages   []Stage
+	channels []string
+	segments []int64
+}
+
+func NewTrace() *Trace {
+	return &Trace{}
+}
+
+// NewTraceIfEnabled returns a new trace if the slow log is enabled, otherwise nil.
+func NewTraceIfEnabled() *Trace {
+	if !Enabled() {
+		return nil
+	}
+	return NewTrace()
+}
+
+// RecordStage records the latency of a stage.
+func (t *Trace) RecordStage(name string, cost time.Duration) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.stages = append(t.stages, Stage{Name: name, CostInMs: float64(cost.Microseconds()) / 1000.0})
+}
+
+// AddSegments records the channel and the sealed segments touched on it.
+func (t *Trace) AddSegments(channels []string, segmentIDs []int64) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.channels = append(t.channels, channels...)
+	t.segments = append(t.segments, segmentIDs...)
+}
+
+// Fill puts the stages and the touched segments into the record, at most maxSegmentIDs segment ids are kept.
+func (t *Trace) Fill(record *Record, maxSegmentIDs int) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	record.Stages = append([]Stage{}, t.stages...)
+	record.Channels = append([]string{}, t.channels...)
+	sort.Strings(record.Channels)
+	record.NumSegments = len(t.segments)
+	segments := append([]int64{}, t.segments...)
+	sort.Slice(segments, func(i, j int) bool { return segments[i] < segments[j] })
+	if maxSegmentIDs >= 0 && len(segments) > maxSegmentIDs {
+		segments = segments[:maxSegmentIDs]
+	}
+	record.SegmentIDs = segments
+}
+
+// Enabled returns whether the slow log is enabled.
+func Enabled() bool {
+	return paramtable.Get().ProxyCfg.SlowLog.Enable.GetAsBool()
+}
+
+// Threshold returns the latency threshold of the request type, zero if the slow log is disabled.
+func Threshold(requestType string) time.Duration {
+	if !Enabled() {
+		return 0
+	}
+	params := &paramtable.Get().ProxyCfg.SlowLog
+	switch requestType {
+	case TypeSearch:
+		return params.SearchThreshold.GetAsDuration(time.Millisecond)
+	case TypeHybridSearch:
+		return params.HybridSearchThreshold.GetAsDuration(time.Millisecond)
+	case TypeQuery:
+		return params.QueryThreshold.GetAsDuration(time.Millisecond)
+	}
+	return 0
+}
+
+// IsSlow returns whether a request of the type costing cost should be recorded.
+func IsSlow(requestType string, cost time.Duration) bool {
+	threshold := Threshold(requestType)
+	return threshold > 0 && cost >= threshold
+}
+
+// Filter selects the records to list, the zero value of a field matches all.
+type Filter struct {
+	Type        string
+	Database    string
+	Collection  string
+	Fingerprint string
+	MinCost     time.Duration
+	// Limit is the max number of records returned, no limit if not positive.
+	Limit int
+}
+
+func (f *Filter) match(record *Record) bool {
+	return (f.Type == "" || f.Type == record.Type) &&
+		(f.Database == "" || f.Database == record.Database) &&
+		(f.Collection == "" || f.Collection == record.Collection) &&
+		(f.Fingerprint == "" || f.Fingerprint == record.Fingerprint) &&
+		record.cost >= f.MinCost
+}
+
+// Logger keeps the recent slow records in a ring buffer and writes them to the slow log file.
+type Logger struct {
+	mu       sync.RWMutex
+	records  []*Record
+	next     int
+	full     bool
+	writer   io.Writer
+	closer   func()
+	capacity int
+}
+
+// NewLogger creates a logger keeping at most capacity records in memory.
+func NewLogger(capacity int) *Logger {
+	if capacity <= 0 {
+		capacity = 1
+	}
+	return &Logger{
+		records:  make([]*Record, capacity),
+		capacity: capacity,
+	}
+}
+
+// NewLoggerWithParams creates a logger, and opens the slow log file if a file name is configured.
+func NewLoggerWithParams(params *paramtable.ComponentParam) (*Logger, error) {
+	cfg := &params.ProxyCfg.SlowLog
+	l := NewLogger(cfg.MaxEntries.GetAsInt())
+	if cfg.Filename.GetValue() == "" {
+		return l, nil
+	}
+
+	rw, err := accesslog.NewRotateWriterWithConfig(&accesslog.RotateConfig{
+		LocalPath:   cfg.LocalPath.GetValue(),
+		Filename:    cfg.Filename.GetValue(),
+		RotatedTime: cfg.RotatedTime.GetAsInt64(),
+		MaxSize:     cfg.MaxSize.GetAsInt(),
+		MaxBackups:  cfg.MaxBackups.GetAsInt(),
+	}, &params.MinioCfg)
+	if err != nil {
+		return nil, err
+	}
+	if cacheSize := cfg.CacheSize.GetAsInt(); cacheSize > 0 {
+		cw := accesslog.NewCacheWriterWithCloser(rw, rw, cacheSize, cfg.CacheFlushInterval.GetAsDuration(time.Second))
+		l.writer, l.closer = cw, cw.Close
+	} else {
+		l.writer, l.closer = rw, func() { rw.Close() }
+	}
+	return l, nil
+}
+
+// Add records a slow request costing cost.
+func (l *Logger) Add(record *Record, cost time.Duration) {
+	record.cost = cost
+	record.CostInMs = float64(cost.Microseconds()) / 1000.0
+	if record.Time == "" {
+		record.Time = time.Now().Format(time.RFC3339Nano)
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.records[l.next] = record
+	l.next = (l.next + 1) % l.capacity
+	if l.next == 0 {
+		l.full = true
+	}
+
+	if l.writer == nil {
+		return
+	}
+	line, err := json.Marshal(record)
+	if err != nil {
+		mlog.RatedWarn(context.TODO(), rate.Every(time.Minute), "failed to marshal slow log", mlog.Err(err))
+		return
+	}
+	if _, err := l.writer.Write(append(line, '\n')); err != nil {
+		mlog.RatedWarn(context.TODO(), rate.Every(time.Minute), "failed to write slow log", mlog.Err(err))
+	}
+}
+
+// List returns the records matching the filter, the most recent first.
+func (l *Logger) List(filter *Filter) []*Record {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	size := l.next
+	if l.full {
+		size = l.capacity
+	}
+	result := make([]*Record, 0)
+	for i := 1; i <= size; i++ {
+		record := l.records[(l.next-i+l.capacity)%l.capacity]
+		if !filter.match(record) {
+			continue
+		}
+		result = append(result, record)
+		if filter.Limit > 0 && len(result) >= filter.Limit {
+			break
+		}
+	}
+	return result
+}
+
+// Close closes the slow log file.
+func (l *Logger) Close() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.closer != nil {
+		l.closer()
+		l.closer = nil
+	}
+	l.writer = nil
+}
@@ -0,0 +1,142 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slowlog
+
+import (
+	"bufio"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/json"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+func TestMain(m *testing.M) {
+	paramtable.Init()
+	os.Exit(m.Run())
+}
+
+func TestTrace(t *testing.T) {
+	var nilTrace *Trace
+	nilTrace.RecordStage("queue", time.Millisecond)
+	nilTrace.AddSegments([]string{"ch"}, []int64{1})
+	record := &Record{}
+	nilTrace.Fill(record, 10)
+	assert.Empty(t, record.Stages)
+
+	trace := NewTrace()
+	trace.RecordStage("queue", 1500*time.Microsecond)
+	trace.RecordStage("execute", 2*time.Millisecond)
+	trace.AddSegments([]string{"ch-2"}, []int64{5, 3})
+	trace.AddSegments([]string{"ch-1"}, []int64{4, 1, 2})
+
+	record = &Record{}
+	trace.Fill(record, 3)
+	assert.Equal(t, []Stage{{Name: "queue", CostInMs: 1.5}, {Name: "execute", CostInMs: 2}}, record.Stages)
+	assert.Equal(t, []string{"ch-1", "ch-2"}, record.Channels)
+	assert.Equal(t, 5, record.NumSegments)
+	assert.Equal(t, []int64{1, 2, 3}, record.SegmentIDs)
+}
+
+func TestThreshold(t *testing.T) {
+	params := paramtable.Get()
+	params.Save(params.ProxyCfg.SlowLog.SearchThreshold.Key, "100")
+	defer params.Reset(params.ProxyCfg.SlowLog.SearchThreshold.Key)
+
+	assert.Equal(t, 100*time.Millisecond, Threshold(TypeSearch))
+	assert.True(t, IsSlow(TypeSearch, 100*time.Millisecond))
+	assert.False(t, IsSlow(TypeSearch, 99*time.Millisecond))
+	assert.Equal(t, time.Duration(0), Threshold("Unknown"))
+	assert.False(t, IsSlow("Unknown", time.Hour))
+
+	assert.True(t, Enabled())
+	assert.NotNil(t, NewTraceIfEnabled())
+
+	params.Save(params.ProxyCfg.SlowLog.Enable.Key, "false")
+	defer params.Reset(params.ProxyCfg.SlowLog.Enable.Key)
+	assert.False(t, IsSlow(TypeSearch, time.Hour))
+	assert.False(t, Enabled())
+	assert.Nil(t, NewTraceIfEnabled())
+}
+
+func TestLogger(t *testing.T) {
+	l := NewLogger(3)
+	assert.Empty(t, l.List(&Filter{}))
+
+	for i := 1; i <= 4; i++ {
+		typ := TypeSearch
+		if i%2 == 0 {
+			typ = TypeQuery
+		}
+		l.Add(&Record{Type: typ, Collection: "c", TraceID: string(rune('0' + i))}, time.Duration(i)*time.Second)
+	}
+
+	records := l.List(&Filter{})
+	require.Len(t, records, 3)
+	assert.Equal(t, []string{"4", "3", "2"}, []string{records[0].TraceID, records[1].TraceID, records[2].TraceID})
+	assert.Equal(t, float64(4000), records[0].CostInMs)
+	assert.NotEmpty(t, records[0].Time)
+
+	records = l.List(&Filter{Type: TypeQuery})
+	require.Len(t, records, 2)
+	assert.Equal(t, "4", records[0].TraceID)
+
+	records = l.List(&Filter{MinCost: 3 * time.Second})
+	assert.Len(t, records, 2)
+
+	records = l.List(&Filter{Limit: 1})
+	assert.Len(t, records, 1)
+
+	assert.Empty(t, l.List(&Filter{Collection: "other"}))
+	l.Close()
+}
+
+func TestLoggerWithFile(t *testing.T) {
+	dir := t.TempDir()
+	params := paramtable.Get()
+	params.Save(params.ProxyCfg.SlowLog.LocalPath.Key, dir)
+	params.Save(params.ProxyCfg.SlowLog.Filename.Key, "slow.log")
+	defer params.Reset(params.ProxyCfg.SlowLog.LocalPath.Key)
+	defer params.Reset(params.ProxyCfg.SlowLog.Filename.Key)
+
+	l, err := NewLoggerWithParams(params)
+	require.NoError(t, err)
+	l.Add(&Record{Type: TypeQuery, Collection: "c", Expr: "id > ?"}, time.Second)
+	l.Add(&Record{Type: TypeSearch, Collection: "c"}, 2*time.Second)
+	l.Close()
+	// closing twice is fine
+	l.Close()
+
+	f, err := os.Open(path.Join(dir, "slow.log"))
+	require.NoError(t, err)
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	var records []*Record
+	for scanner.Scan() {
+		record := &Record{}
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), record))
+		records = append(records, record)
+	}
+	require.Len(t, records, 2)
+	assert.Equal(t, "id > ?", records[0].Expr)
+	assert.Equal(t, float64(2000), records[1].CostInMs)
+}
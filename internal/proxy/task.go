@@ -88,6 +88,7 @@ const (
 	QueryIterLastOffsetKey = "query_iter_last_element_offset"
 	GroupByFieldsKey       = "group_by_fields"
 	OrderByFieldsKey       = "order_by_fields"
+	HavingKey              = "having"
 	PipelineTraceKey       = "pipeline_trace"
 
 	InsertTaskName                = "InsertTask"
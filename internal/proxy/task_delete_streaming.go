@@ -7,7 +7,6 @@ import (
 	"go.opentelemetry.io/otel"
 
 	"github.com/milvus-io/milvus-proto/go-api/v3/schemapb"
-	"github.com/milvus-io/milvus/internal/distributed/streaming"
 	"github.com/milvus-io/milvus/internal/util/hookutil"
 	"github.com/milvus-io/milvus/pkg/v3/mlog"
 	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
@@ -84,7 +83,7 @@ func (dt *deleteTask) Execute(ctx context.Context) (err error) {
 		mlog.Int64("taskID", dt.ID()),
 		mlog.Duration("prepare duration", dt.tr.RecordSpan()))
 
-	resp := streaming.WAL().AppendMessages(ctx, msgs...)
+	resp := appendMessages(ctx, msgs...)
 	if err := resp.UnwrapFirstError(); err != nil {
 		mlog.Warn(ctx, "append messages to wal failed", mlog.Err(err))
 		return err
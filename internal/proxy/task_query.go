@@ -13,11 +13,13 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v3/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/v3/schemapb"
+	"github.com/milvus-io/milvus/client/v3/proto/explainpb"
 	"github.com/milvus-io/milvus/internal/agg"
 	"github.com/milvus-io/milvus/internal/parser/planparserv2"
 	"github.com/milvus-io/milvus/internal/proxy/accesslog"
 	"github.com/milvus-io/milvus/internal/proxy/channelmgr"
 	"github.com/milvus-io/milvus/internal/proxy/shardclient"
+	"github.com/milvus-io/milvus/internal/proxy/slowlog"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/exprutil"
 	"github.com/milvus-io/milvus/internal/util/reduce"
@@ -89,7 +91,27 @@ type queryTask struct {
 	resolvedTimezoneStr  string
 	storageCost          segcore.StorageCost
 	aggregationFieldMap  *agg.AggregationFieldMap
-	chMgr                channelmgr.ChannelsMgr
+	// having filters the groups after the final merge at proxy
+	having *agg.HavingExpr
+	chMgr  channelmgr.ChannelsMgr
+
+	// explain returns the plan and the execution profile instead of the query result.
+	explain       bool
+	explainPlan   map[string]interface{}
+	explainExpr   *planparserv2.ExprExplain
+	explainResult *explainpb.ExplainResults
+
+	// slowTrace collects the stages and the touched segments for the slow log, nil if not traced.
+	slowTrace *slowlog.Trace
+}
+
+// needFinalGroupReduce returns whether the groups are filtered by HAVING or ordered
+// by aggregates, which need the final aggregated values of all the shards.
+func (t *queryTask) needFinalGroupReduce() bool {
+	if t.queryParams == nil || (len(t.queryParams.groupByFields) == 0 && len(t.userAggregates) == 0) {
+		return false
+	}
+	return t.having != nil || orderByReferencesAggregate(t.queryParams.orderByFields)
 }
 
 func (t *queryTask) getQueryLabel() string {
@@ -117,6 +139,7 @@ type queryParams struct {
 	collectionID        int64
 	groupByFields       []string
 	orderByFields       []string // NEW: ORDER BY field specifications (e.g., "price:desc")
+	having              string   // HAVING predicate over group by columns and aggregates
 	extractTimeFields   []string
 	queryIteratorCursor *planpb.QueryIteratorCursor
 }
@@ -173,9 +196,8 @@ func translateGroupByFieldIds(groupByFieldNames []string, schema *schemapb.Colle
 }
 
 // validateOrderByFieldsWithGroupBy validates that ORDER BY fields are compatible with GROUP BY.
-// When GROUP BY is used, ORDER BY can only reference columns in the GROUP BY clause.
-// ORDER BY on aggregate expressions (e.g., count(*)) is not yet supported and is
-// explicitly rejected. This restriction may be lifted in a future release.
+// When GROUP BY is used, ORDER BY can only reference columns in the GROUP BY clause or
+// the aggregate expressions of the query, e.g. count(*).
 func validateOrderByFieldsWithGroupBy(
 	orderByFieldSpecs []string,
 	groupByFields []string,
@@ -191,13 +213,16 @@ func validateOrderByFieldsWithGroupBy(
 		return nil
 	}
 
-	// Build set of valid ORDER BY targets (GROUP BY columns only)
+	// Build set of valid ORDER BY targets (GROUP BY columns and aggregate expressions)
 	validTargets := make(map[string]bool)
 
 	// Add GROUP BY fields as valid targets
 	for _, field := range groupByFields {
 		validTargets[strings.ToLower(strings.TrimSpace(field))] = true
 	}
+	for _, aggregate := range aggregates {
+		validTargets[strings.ToLower(agg.NormalizeOutputName(aggregate.OriginalName()))] = true
+	}
 
 	// Validate each ORDER BY field
 	for _, spec := range orderByFieldSpecs {
@@ -212,18 +237,15 @@ func validateOrderByFieldsWithGroupBy(
 		parts := strings.Split(spec, ":")
 		fieldName := strings.ToLower(strings.TrimSpace(parts[0]))
 
-		// Reject aggregate expressions — not yet supported
+		// Aggregate expressions must be one of the aggregates in output_fields
 		if isAgg, _, _ := agg.MatchAggregationExpression(fieldName); isAgg {
-			return merr.WrapErrParameterInvalidMsg(
-				"ORDER BY on aggregate expression '%s' is not yet supported",
-				fieldName,
-			)
+			fieldName = agg.NormalizeOutputName(fieldName)
 		}
 
 		if !validTargets[fieldName] {
 			return merr.WrapErrParameterInvalidMsg(
 				"ORDER BY field '%s' is not valid: when using GROUP BY or aggregates, "+
-					"ORDER BY can only reference GROUP BY columns. "+
+					"ORDER BY can only reference GROUP BY columns or aggregates in output_fields. "+
 					"Valid targets are: %v",
 				fieldName, getValidTargetList(groupByFields, aggregates),
 			)
@@ -234,15 +256,35 @@ func validateOrderByFieldsWithGroupBy(
 }
 
 // getValidTargetList returns a formatted list of valid ORDER BY targets for error messages.
-// The aggregates parameter is currently unused because ORDER BY on aggregate expressions
-// (e.g., count(*)) is not yet supported. When enabled in the future, aggregate original
-// names should be appended to the target list.
-func getValidTargetList(groupByFields []string, _ []agg.AggregateBase) []string {
-	targets := make([]string, 0, len(groupByFields))
+func getValidTargetList(groupByFields []string, aggregates []agg.AggregateBase) []string {
+	targets := make([]string, 0, len(groupByFields)+len(aggregates))
 	targets = append(targets, groupByFields...)
+	seen := make(map[string]struct{}, len(aggregates))
+	for _, aggregate := range aggregates {
+		// avg is split into sum and count sharing the same original name
+		if _, ok := seen[aggregate.OriginalName()]; ok {
+			continue
+		}
+		seen[aggregate.OriginalName()] = struct{}{}
+		targets = append(targets, aggregate.OriginalName())
+	}
 	return targets
 }
 
+// orderByReferencesAggregate returns whether any ORDER BY item is an aggregate expression.
+func orderByReferencesAggregate(orderByFieldSpecs []string) bool {
+	for _, spec := range orderByFieldSpecs {
+		name, _, _, err := orderby.ParseOrderBySpec(spec)
+		if err != nil {
+			continue
+		}
+		if isAgg, _, _ := agg.MatchAggregationExpression(name); isAgg {
+			return true
+		}
+	}
+	return false
+}
+
 // translateOrderByFields converts ORDER BY field specifications to planpb.OrderByField messages.
 // Delegates parsing to orderby.ParseOrderByFields to ensure consistent behavior
 // (direction validation, nullsFirst defaults) between C++ segcore and Go proxy pipeline.
@@ -445,6 +487,8 @@ func parseQueryParams(queryParamsPair []*commonpb.KeyValuePair, largeTopKEnabled
 		}
 	}
 
+	having, _ := funcutil.TryGetAttrByKeyFromRepeatedKV(HavingKey, queryParamsPair)
+
 	queryIteratorCursor, err := parseQueryIteratorCursor(queryParamsPair, isIterator, pkDataType)
 	if err != nil {
 		return nil, err
@@ -458,6 +502,7 @@ func parseQueryParams(queryParamsPair []*commonpb.KeyValuePair, largeTopKEnabled
 		collectionID:        collectionID,
 		groupByFields:       groupByFields,
 		orderByFields:       orderByFields,
+		having:              strings.TrimSpace(having),
 		queryIteratorCursor: queryIteratorCursor,
 		extractTimeFields:   extractTimeFields,
 	}, nil
@@ -585,17 +630,30 @@ func (t *queryTask) createPlanArgs(ctx context.Context, visitorArgs *planparserv
 		return err
 	}
 
-	// parse order by fields
-	orderByFields, err := translateOrderByFields(t.queryParams.orderByFields, t.schema.CollectionSchema)
-	if err != nil {
-		return err
+	hasAgg := len(t.GroupByFieldIds) > 0 || len(t.Aggregates) > 0
+	t.having = nil
+	if t.queryParams.having != "" {
+		if !hasAgg {
+			return merr.WrapErrParameterInvalidMsg("HAVING requires GROUP BY or aggregates")
+		}
+		if t.having, err = agg.ParseHavingExpr(t.queryParams.having); err != nil {
+			return err
+		}
+	}
+
+	// parse order by fields, ORDER BY on aggregates is evaluated at proxy only
+	var orderByFields []*planpb.OrderByField
+	if !t.needFinalGroupReduce() {
+		orderByFields, err = translateOrderByFields(t.queryParams.orderByFields, t.schema.CollectionSchema)
+		if err != nil {
+			return err
+		}
 	}
 	t.plan.GetQuery().OrderByFields = orderByFields
 	// Also populate on RetrieveRequest so QN/Delegator can read directly
 	// without re-parsing serialized_expr_plan.
 	t.OrderByFields = orderByFields
 
-	hasAgg := len(t.GroupByFieldIds) > 0 || len(t.Aggregates) > 0
 	// parse output field ids
 	if hasAgg {
 		emptyOutputFields := make([]UniqueID, 0)
@@ -606,6 +664,16 @@ func (t *queryTask) createPlanArgs(ctx context.Context, visitorArgs *planparserv
 			return merr.WrapErrParameterInvalidMsg(err.Error())
 		}
 		t.aggregationFieldMap = aggFieldMap
+		if t.needFinalGroupReduce() {
+			if t.having != nil {
+				if err := t.having.Validate(aggFieldMap.Names()); err != nil {
+					return err
+				}
+			}
+			if _, _, err := parseGroupOrderByFields(t.queryParams.orderByFields, aggFieldMap); err != nil {
+				return err
+			}
+		}
 	} else {
 		outputFieldIDs, err := translateToOutputFieldIDs(t.translatedOutputFields, schema.CollectionSchema)
 		if err != nil {
@@ -767,6 +835,9 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 	if len(queryParams.orderByFields) > 0 && queryParams.isIterator {
 		return merr.WrapErrParameterInvalidMsg("ORDER BY with iterator is not supported")
 	}
+	if queryParams.having != "" && queryParams.isIterator {
+		return merr.WrapErrParameterInvalidMsg("HAVING with iterator is not supported")
+	}
 
 	t.Limit = queryParams.limit + queryParams.offset
 
@@ -789,6 +860,11 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 	if err := t.createPlanArgs(ctx, &planparserv2.ParserVisitorArgs{Timezone: t.resolvedTimezoneStr}); err != nil {
 		return err
 	}
+	// The groups filtered by HAVING or ordered by aggregates are only final after
+	// proxy merges all the shards, so QN and delegator must not truncate them.
+	if t.needFinalGroupReduce() {
+		t.Limit = typeutil.Unlimited
+	}
 	t.plan.GetQuery().Limit = t.Limit
 	if t.queryParams.queryIteratorCursor != nil {
 		t.plan.GetQuery().QueryIteratorCursor = t.queryParams.queryIteratorCursor
@@ -839,6 +915,13 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 		return merr.WrapErrParameterInvalidMsg("count entities with pagination is not allowed")
 	}
 	t.plan.Namespace = namespaceForPlan(t.schema.CollectionSchema, t.request.Namespace)
+	// the query nodes only report the retrieved segments if the slow log traces them.
+	t.TraceSegments = t.slowTrace != nil
+	if t.explain {
+		if err := t.initExplain(); err != nil {
+			return err
+		}
+	}
 
 	t.SerializedExprPlan, _, err = marshalPlanWithMembershipFilterSizeLimit(t.plan, 0)
 	if err != nil {
@@ -921,6 +1004,37 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 	return nil
 }
 
+// initExplain renders the plan and the filter expression of an explain query.
+func (t *queryTask) initExplain() error {
+	if t.queryParams.isIterator {
+		return merr.WrapErrParameterInvalidMsg("explain is not supported for query iterator")
+	}
+	t.explainPlan = planparserv2.ExplainPlan(t.plan)
+	if expr := t.request.GetExpr(); expr != "" {
+		var err error
+		t.explainExpr, err = planparserv2.ExplainExpr(t.schema.SchemaHelper, expr, t.request.GetExprTemplateValues(), &planparserv2.ParserVisitorArgs{Timezone: t.resolvedTimezoneStr})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fillExplainResult merges the shard profiles into the explain result, the query result is left empty.
+func (t *queryTask) fillExplainResult(results []*internalpb.RetrieveResults) error {
+	var err error
+	t.explainResult, err = newExplainResults(t.schema.CollectionSchema, t.explainPlan, t.explainExpr, nil, results)
+	if err != nil {
+		return err
+	}
+	t.result = &milvuspb.QueryResults{
+		Status:         merr.Success(),
+		FieldsData:     []*schemapb.FieldData{},
+		CollectionName: t.collectionName,
+	}
+	return nil
+}
+
 func (t *queryTask) Execute(ctx context.Context) error {
 	tr := timerecord.NewTimeRecorder(fmt.Sprintf("proxy execute query %d", t.ID()))
 	defer tr.CtxElapse(ctx, "done")
@@ -1007,36 +1121,55 @@ func (t *queryTask) PostExecute(ctx context.Context) error {
 		})
 	}
 
+	if t.explain {
+		return t.fillExplainResult(toReduceResults)
+	}
+	traceQuerySegments(t.slowTrace, toReduceResults)
+
 	metrics.ProxyDecodeResultLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), t.getQueryLabel()).Observe(0.0)
 	tr.CtxRecord(ctx, "reduceResultStart")
 
-	// Parse ORDER BY fields if present
-	var orderByFields []*orderby.OrderByField
-	if len(t.queryParams.orderByFields) > 0 {
-		orderByFields, err = orderby.ParseOrderByFields(t.queryParams.orderByFields, t.schema.CollectionSchema)
-		if err != nil {
-			log.Warn(ctx, "fail to parse order by fields", mlog.Err(err))
-			return err
-		}
-	}
-
 	primaryFieldSchema, err := t.schema.GetPkField()
 	if err != nil {
 		log.Warn(ctx, "failed to get primary field schema", mlog.Err(err))
 		return err
 	}
 
-	pipeline, err := NewQueryPipeline(
-		t.schema.CollectionSchema,
-		t.queryParams.limit,
-		t.queryParams.offset,
-		t.queryParams.reduceType,
-		orderByFields,
-		t.GetGroupByFieldIds(),
-		t.GetAggregates(),
-		t.aggregationFieldMap,
-		filterSystemFields(t.GetOutputFieldsId()),
-	)
+	var pipeline *QueryPipeline
+	if t.needFinalGroupReduce() {
+		pipeline, err = NewFinalGroupQueryPipeline(
+			t.schema.CollectionSchema,
+			t.queryParams.limit,
+			t.queryParams.offset,
+			t.having,
+			t.queryParams.orderByFields,
+			t.GetGroupByFieldIds(),
+			t.GetAggregates(),
+			t.aggregationFieldMap,
+		)
+	} else {
+		// Parse ORDER BY fields if present
+		var orderByFields []*orderby.OrderByField
+		if len(t.queryParams.orderByFields) > 0 {
+			orderByFields, err = orderby.ParseOrderByFields(t.queryParams.orderByFields, t.schema.CollectionSchema)
+			if err != nil {
+				log.Warn(ctx, "fail to parse order by fields", mlog.Err(err))
+				return err
+			}
+		}
+
+		pipeline, err = NewQueryPipeline(
+			t.schema.CollectionSchema,
+			t.queryParams.limit,
+			t.queryParams.offset,
+			t.queryParams.reduceType,
+			orderByFields,
+			t.GetGroupByFieldIds(),
+			t.GetAggregates(),
+			t.aggregationFieldMap,
+			filterSystemFields(t.GetOutputFieldsId()),
+		)
+	}
 	if err != nil {
 		log.Warn(ctx, "fail to create query pipeline", mlog.Err(err))
 		return err
@@ -496,6 +496,24 @@ func TestQueryTask_all(t *testing.T) {
 			assert.Error(t, err, "non-aggregation with empty expr and no limit should fail")
 			assert.Contains(t, err.Error(), "empty expression should be used with limit")
 		}
+
+		// Case 6: count(*) + GROUP BY + HAVING → groups are bounded by common.groupBy.maxGroups
+		{
+			task := makeTask(
+				expr,
+				[]string{testInt64Field, "count(*)"},
+				10,
+				testInt64Field,
+			)
+			task.request.QueryParams = append(task.request.QueryParams, &commonpb.KeyValuePair{
+				Key:   HavingKey,
+				Value: "count(*) > 1",
+			})
+			err := task.PreExecute(ctx)
+			assert.NoError(t, err)
+			assert.Equal(t, paramtable.Get().CommonCfg.GroupByMaxGroups.GetAsInt64()+1, task.GetLimit())
+			assert.Equal(t, int64(10), task.queryParams.limit)
+		}
 	})
 
 	t.Run("test order by without limit", func(t *testing.T) {
@@ -572,8 +572,12 @@ func (sched *taskScheduler) processTask(t task, q taskQueue) {
 	metrics.ProxyReqInQueueLatency.
 		WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), t.Type().String()).
 		Observe(float64(waitDuration.Microseconds()) / 1000.0)
+	slowTrace := getTaskSlowTrace(t)
+	slowTrace.RecordStage("queue", waitDuration)
 
+	start := time.Now()
 	err := t.PreExecute(ctx)
+	slowTrace.RecordStage("pre_execute", time.Since(start))
 
 	defer func() {
 		t.Notify(err)
@@ -585,7 +589,9 @@ func (sched *taskScheduler) processTask(t task, q taskQueue) {
 	}
 
 	span.AddEvent("scheduler process Execute")
+	start = time.Now()
 	err = t.Execute(ctx)
+	slowTrace.RecordStage("execute", time.Since(start))
 	if err != nil {
 		span.RecordError(err)
 		mlog.Warn(ctx, "Failed to execute task: ", mlog.Err(err))
@@ -593,7 +599,9 @@ func (sched *taskScheduler) processTask(t task, q taskQueue) {
 	}
 
 	span.AddEvent("scheduler process PostExecute")
+	start = time.Now()
 	err = t.PostExecute(ctx)
+	slowTrace.RecordStage("post_execute", time.Since(start))
 	if err != nil {
 		span.RecordError(err)
 		mlog.Warn(ctx, "Failed to post-execute task: ", mlog.Err(err))
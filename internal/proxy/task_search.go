@@ -17,12 +17,14 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v3/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/v3/schemapb"
+	"github.com/milvus-io/milvus/client/v3/proto/explainpb"
 	"github.com/milvus-io/milvus/internal/agg"
 	"github.com/milvus-io/milvus/internal/parser/planparserv2"
 	"github.com/milvus-io/milvus/internal/proxy/accesslog"
 	"github.com/milvus-io/milvus/internal/proxy/channelmgr"
 	"github.com/milvus-io/milvus/internal/proxy/search_agg"
 	"github.com/milvus-io/milvus/internal/proxy/shardclient"
+	"github.com/milvus-io/milvus/internal/proxy/slowlog"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/exprutil"
 	"github.com/milvus-io/milvus/internal/util/function/embedding"
@@ -118,6 +120,15 @@ type searchTask struct {
 	storageCost  segcore.StorageCost
 	traceEnabled bool
 
+	// explain returns the plan and the execution profile instead of the search result.
+	explain       bool
+	explainPlan   map[string]interface{}
+	explainExpr   *planparserv2.ExprExplain
+	explainResult *explainpb.ExplainResults
+
+	// slowTrace collects the stages and the touched segments for the slow log, nil if not traced.
+	slowTrace *slowlog.Trace
+
 	aggCtx *search_agg.SearchAggregationContext
 
 	// Old SDK sent only singular group_by_field; output must downgrade plural→singular.
@@ -263,6 +274,11 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 	// searches with small result size could no longer need requery.
 	traceVal, _ := funcutil.GetAttrByKeyFromRepeatedKV(PipelineTraceKey, t.request.GetSearchParams())
 	t.traceEnabled = strings.EqualFold(traceVal, "true")
+	// the query nodes only report the searched segments if the slow log traces them.
+	t.TraceSegments = t.slowTrace != nil
+	if t.explain && t.GetIsAdvanced() {
+		return merr.WrapErrParameterInvalidMsg("explain is not supported for hybrid search")
+	}
 	timezone, err := resolveTimezone(ctx, t.request.GetSearchParams(), collectionInfo)
 	if err != nil {
 		return err
@@ -1037,6 +1053,12 @@ func (t *searchTask) initSearchRequest(ctx context.Context) error {
 		queryInfo.StrictGroupSize = true
 	}
 
+	if t.explain {
+		if err := t.initExplain(plan); err != nil {
+			return err
+		}
+	}
+
 	t.SerializedExprPlan, _, err = marshalPlanWithMembershipFilterSizeLimit(plan, 0)
 	if err != nil {
 		return err
@@ -1363,6 +1385,10 @@ func (t *searchTask) PostExecute(ctx context.Context) error {
 		log.Warn(ctx, "failed to collect search results", mlog.Err(err))
 		return err
 	}
+	if t.explain {
+		return t.fillExplainResult(toReduceResults)
+	}
+	traceSearchSegments(t.slowTrace, toReduceResults)
 
 	t.queryChannelsTs = make(map[string]uint64)
 	t.relatedDataSize = 0
@@ -1578,6 +1604,43 @@ func (t *searchTask) estimateResultSize(nq int64, topK int64) (int64, error) {
 	//return int64(sizePerRecord) * nq * topK, nil
 }
 
+// initExplain renders the plan and the filter expression of an explain search.
+func (t *searchTask) initExplain(plan *planpb.PlanNode) error {
+	if t.isIterator {
+		return merr.WrapErrParameterInvalidMsg("explain is not supported for search iterator")
+	}
+	t.explainPlan = planparserv2.ExplainPlan(plan)
+	if dsl := t.request.GetDsl(); dsl != "" {
+		var err error
+		t.explainExpr, err = planparserv2.ExplainExpr(t.schema.SchemaHelper, dsl, t.request.GetExprTemplateValues(), &planparserv2.ParserVisitorArgs{Timezone: t.resolvedTimezoneStr})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fillExplainResult merges the shard profiles into the explain result, the search result is left empty.
+func (t *searchTask) fillExplainResult(results []*internalpb.SearchResults) error {
+	var err error
+	t.explainResult, err = newExplainResults(t.schema.CollectionSchema, t.explainPlan, t.explainExpr, []int64{t.GetFieldId()}, results)
+	if err != nil {
+		return err
+	}
+	t.result = &milvuspb.SearchResults{
+		Status: merr.Success(),
+		Results: &schemapb.SearchResultData{
+			NumQueries: t.GetNq(),
+			TopK:       t.GetTopk(),
+			Scores:     []float32{},
+			Ids:        &schemapb.IDs{},
+			Topks:      make([]int64, t.GetNq()),
+		},
+		CollectionName: t.collectionName,
+	}
+	return nil
+}
+
 func (t *searchTask) collectSearchResults(ctx context.Context) ([]*internalpb.SearchResults, error) {
 	select {
 	case <-t.TraceCtx().Done():
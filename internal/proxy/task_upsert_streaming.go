@@ -161,7 +161,7 @@ func (ut *upsertTask) appendUpsertAttempt(ctx context.Context, ez *message.Ciphe
 			return err
 		}
 	}
-	resp := streaming.WAL().AppendMessages(ctx, messages...)
+	resp := appendMessages(ctx, messages...)
 	appendErr := resp.UnwrapFirstError()
 	if ut.req.GetPartialUpdate() {
 		appendErr = unwrapPartialUpdateAppendError(resp)
@@ -0,0 +1,436 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus/internal/distributed/streaming"
+	"github.com/milvus-io/milvus/internal/util/streamingutil/status"
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+)
+
+// TransactionIDHeader is the metadata key carrying the id of the transaction
+// that a DML request belongs to.
+const TransactionIDHeader = "transaction-id"
+
+var (
+	// txnJanitorInterval is the interval of cleaning up the expired transactions.
+	txnJanitorInterval = 5 * time.Second
+	// txnTombstoneLifetime is how long an expired transaction is remembered,
+	// so the client gets an expired error rather than a not found one.
+	txnTombstoneLifetime = 10 * time.Minute
+)
+
+// TxnInfo is the snapshot of a client transaction.
+type TxnInfo struct {
+	TxnID          string        `json:"transactionId"`
+	DbName         string        `json:"dbName"`
+	CollectionName string        `json:"collectionName"`
+	Keepalive      time.Duration `json:"-"`
+}
+
+type (
+	txnResolveFunc   func(ctx context.Context, dbName, collectionName string) (int64, []string, error)
+	txnAllocatorFunc func() (int64, error)
+	txnBeginFunc     func(ctx context.Context, opts streaming.TxnOption) (streaming.Txn, error)
+)
+
+type txnCtxKey struct{}
+
+// clientTxn is a transaction opened by client, it's bound to one vchannel of a collection.
+// The writes of a transaction are serialized, a failed write makes the transaction
+// unable to commit, it can only be rolled back.
+type clientTxn struct {
+	mu sync.Mutex
+
+	id             string
+	username       string
+	dbName         string
+	collectionName string
+	collectionID   int64
+	vchannel       string
+	keepalive      time.Duration
+	lastActive     time.Time
+
+	txn streaming.Txn
+	err error
+}
+
+func (t *clientTxn) expiredLocked(now time.Time) bool {
+	return now.Sub(t.lastActive) > t.keepalive
+}
+
+// append writes the messages into the transaction one by one.
+func (t *clientTxn) append(ctx context.Context, msgs ...message.MutableMessage) streaming.AppendResponses {
+	resp := types.NewAppendResponseN(len(msgs))
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if err := t.checkLocked(); err != nil {
+		resp.FillAllError(err)
+		return resp
+	}
+	for i, msg := range msgs {
+		if msg.VChannel() != t.vchannel {
+			t.err = merr.WrapErrParameterInvalidMsg("transaction %s is bound to vchannel %s, but got message of vchannel %s",
+				t.id, t.vchannel, msg.VChannel())
+			resp.FillAllError(t.err)
+			return resp
+		}
+		if err := t.txn.Append(ctx, msg); err != nil {
+			t.err = t.wrapError(err)
+			resp.FillAllError(t.err)
+			return resp
+		}
+		// the messages of a transaction get their timetick only when it's committed.
+		resp.FillResponseAtIdx(types.AppendResponse{
+			AppendResult: &types.AppendResult{},
+		}, i)
+	}
+	t.lastActive = time.Now()
+	return resp
+}
+
+func (t *clientTxn) checkLocked() error {
+	if t.expiredLocked(time.Now()) {
+		return merr.WrapErrTxnExpired(t.id, "keepalive "+t.keepalive.String()+" exceeded")
+	}
+	if t.txn == nil {
+		return merr.WrapErrTxnNotFound(t.id, "transaction is already finished")
+	}
+	if t.err != nil {
+		return merr.WrapErrTxnExpired(t.id, "transaction is aborted by a failed write: "+t.err.Error())
+	}
+	return nil
+}
+
+func (t *clientTxn) wrapError(err error) error {
+	if status.AsStreamingError(err).IsTxnExpired() {
+		return merr.WrapErrTxnExpired(t.id, err.Error())
+	}
+	return err
+}
+
+// withClientTxn binds the transaction to the context, the DML tasks executed
+// with the context write into the transaction instead of the wal directly.
+func withClientTxn(ctx context.Context, txn *clientTxn) context.Context {
+	return context.WithValue(ctx, txnCtxKey{}, txn)
+}
+
+func clientTxnFromContext(ctx context.Context) *clientTxn {
+	txn, _ := ctx.Value(txnCtxKey{}).(*clientTxn)
+	return txn
+}
+
+// appendMessages appends the messages into the transaction bound to the context,
+// or into the wal if the request doesn't belong to a transaction.
+func appendMessages(ctx context.Context, msgs ...message.MutableMessage) streaming.AppendResponses {
+	if txn := clientTxnFromContext(ctx); txn != nil {
+		return txn.append(ctx, msgs...)
+	}
+	return streaming.WAL().AppendMessages(ctx, msgs...)
+}
+
+// TxnManager manages the transactions opened by clients on proxy.
+// Each transaction maps onto a txn session of the wal, so all the writes
+// of a transaction must go to the proxy that begins it.
+type TxnManager struct {
+	ctx context.Context
+	wg  sync.WaitGroup
+
+	resolve txnResolveFunc
+	allocID txnAllocatorFunc
+	begin   txnBeginFunc
+
+	mu   sync.Mutex
+	txns map[string]*clientTxn
+}
+
+func newTxnManager(ctx context.Context, resolve txnResolveFunc, allocID txnAllocatorFunc) *TxnManager {
+	return &TxnManager{
+		ctx:     ctx,
+		resolve: resolve,
+		allocID: allocID,
+		begin: func(ctx context.Context, opts streaming.TxnOption) (streaming.Txn, error) {
+			return streaming.WAL().Txn(ctx, opts)
+		},
+		txns: make(map[string]*clientTxn),
+	}
+}
+
+// Start starts the background cleanup of expired transactions.
+func (m *TxnManager) Start() {
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		ticker := time.NewTicker(txnJanitorInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-m.ctx.Done():
+				return
+			case <-ticker.C:
+				m.cleanupExpired()
+			}
+		}
+	}()
+}
+
+// Wait waits for the background cleanup to quit.
+func (m *TxnManager) Wait() {
+	m.wg.Wait()
+}
+
+// Begin opens a transaction on the collection, only collections with one shard are supported.
+func (m *TxnManager) Begin(ctx context.Context, dbName, collectionName string, keepalive time.Duration) (*TxnInfo, error) {
+	if keepalive < 0 {
+		return nil, merr.WrapErrParameterInvalidMsg("keepalive must not be negative")
+	}
+	if keepalive == 0 {
+		keepalive = Params.ProxyCfg.TxnDefaultKeepalive.GetAsDurationByParse()
+	}
+	if maxKeepalive := Params.ProxyCfg.TxnMaxKeepalive.GetAsDurationByParse(); keepalive > maxKeepalive {
+		return nil, merr.WrapErrParameterInvalidRange(time.Millisecond, maxKeepalive, keepalive, "keepalive of transaction is too large")
+	}
+	m.mu.Lock()
+	num := len(m.txns)
+	m.mu.Unlock()
+	if maxNum := Params.ProxyCfg.TxnMaxNum.GetAsInt(); num >= maxNum {
+		return nil, merr.WrapErrServiceQuotaExceededMsg("too many open transactions, max: %d", maxNum)
+	}
+
+	if dbName == "" {
+		dbName = GetCurDBNameFromContextOrDefault(ctx)
+	}
+	collectionID, vchannels, err := m.resolve(ctx, dbName, collectionName)
+	if err != nil {
+		return nil, err
+	}
+	// a wal txn session is bound to one vchannel, committing across the vchannels atomically is not supported yet.
+	if len(vchannels) != 1 {
+		return nil, merr.WrapErrOperationNotSupportedMsg("transaction is only supported on collection with one shard, but collection %s has %d shards",
+			collectionName, len(vchannels))
+	}
+	id, err := m.allocID()
+	if err != nil {
+		return nil, err
+	}
+	txn, err := m.begin(ctx, streaming.TxnOption{
+		VChannel:  vchannels[0],
+		Keepalive: keepalive,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ct := &clientTxn{
+		id:             strconv.FormatInt(id, 10),
+		username:       GetCurUserFromContextOrDefault(ctx),
+		dbName:         dbName,
+		collectionName: collectionName,
+		collectionID:   collectionID,
+		vchannel:       vchannels[0],
+		keepalive:      keepalive,
+		lastActive:     time.Now(),
+		txn:            txn,
+	}
+	m.mu.Lock()
+	m.txns[ct.id] = ct
+	m.mu.Unlock()
+	mlog.Info(ctx, "client transaction begins",
+		mlog.String("txnID", ct.id),
+		mlog.Int64("collectionID", collectionID),
+		mlog.String("vchannel", ct.vchannel),
+		mlog.Duration("keepalive", keepalive))
+	return &TxnInfo{
+		TxnID:          ct.id,
+		DbName:         dbName,
+		CollectionName: collectionName,
+		Keepalive:      keepalive,
+	}, nil
+}
+
+// Commit commits the transaction and returns the commit timestamp,
+// which can be used as the guarantee timestamp of the following reads.
+func (m *TxnManager) Commit(ctx context.Context, txnID string) (uint64, error) {
+	ct, err := m.take(ctx, txnID)
+	if err != nil {
+		return 0, err
+	}
+	ct.mu.Lock()
+	defer ct.mu.Unlock()
+	txn := ct.txn
+	if err := ct.checkLocked(); err != nil {
+		ct.rollbackLocked(ctx)
+		return 0, err
+	}
+	ct.txn = nil
+	result, err := txn.Commit(ctx)
+	if err != nil {
+		return 0, ct.wrapError(err)
+	}
+	return result.TimeTick, nil
+}
+
+// Rollback discards all the writes of the transaction.
+func (m *TxnManager) Rollback(ctx context.Context, txnID string) error {
+	ct, err := m.take(ctx, txnID)
+	if err != nil {
+		return err
+	}
+	ct.mu.Lock()
+	defer ct.mu.Unlock()
+	if ct.expiredLocked(time.Now()) {
+		ct.rollbackLocked(ctx)
+		return merr.WrapErrTxnExpired(txnID, "keepalive "+ct.keepalive.String()+" exceeded")
+	}
+	if ct.txn == nil {
+		return merr.WrapErrTxnNotFound(txnID, "transaction is already finished")
+	}
+	txn := ct.txn
+	ct.txn = nil
+	return ct.wrapError(txn.Rollback(ctx))
+}
+
+// Describe returns the transaction owned by the user of context.
+func (m *TxnManager) Describe(ctx context.Context, txnID string) (*TxnInfo, error) {
+	ct, err := m.get(ctx, txnID)
+	if err != nil {
+		return nil, err
+	}
+	return &TxnInfo{
+		TxnID:          ct.id,
+		DbName:         ct.dbName,
+		CollectionName: ct.collectionName,
+		Keepalive:      ct.keepalive,
+	}, nil
+}
+
+// BindContext returns a context bound to the transaction of the request if there's one.
+// The transaction must be opened by the same user on the same collection.
+func (m *TxnManager) BindContext(ctx context.Context, dbName, collectionName string) (context.Context, error) {
+	txnID := getTransactionID(ctx)
+	if txnID == "" {
+		return ctx, nil
+	}
+	ct, err := m.get(ctx, txnID)
+	if err != nil {
+		return ctx, err
+	}
+	if dbName == "" {
+		dbName = GetCurDBNameFromContextOrDefault(ctx)
+	}
+	collectionID, _, err := m.resolve(ctx, dbName, collectionName)
+	if err != nil {
+		return ctx, err
+	}
+	if collectionID != ct.collectionID {
+		return ctx, merr.WrapErrParameterInvalidMsg("transaction %s is bound to collection %s, but the request writes collection %s",
+			txnID, ct.collectionName, collectionName)
+	}
+	return withClientTxn(ctx, ct), nil
+}
+
+// get returns the transaction owned by the user of context.
+func (m *TxnManager) get(ctx context.Context, txnID string) (*clientTxn, error) {
+	m.mu.Lock()
+	ct, ok := m.txns[txnID]
+	m.mu.Unlock()
+	if !ok || ct.username != GetCurUserFromContextOrDefault(ctx) {
+		return nil, merr.WrapErrTxnNotFound(txnID)
+	}
+	return ct, nil
+}
+
+// take removes the transaction owned by the user of context from manager.
+func (m *TxnManager) take(ctx context.Context, txnID string) (*clientTxn, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	ct, ok := m.txns[txnID]
+	if !ok || ct.username != GetCurUserFromContextOrDefault(ctx) {
+		return nil, merr.WrapErrTxnNotFound(txnID)
+	}
+	delete(m.txns, txnID)
+	return ct, nil
+}
+
+// rollbackLocked rolls back the wal txn in best effort,
+// the streaming node will expire the txn anyway if it fails.
+func (t *clientTxn) rollbackLocked(ctx context.Context) {
+	if t.txn == nil {
+		return
+	}
+	if err := t.txn.Rollback(ctx); err != nil {
+		mlog.Info(ctx, "rollback client transaction failed", mlog.String("txnID", t.id), mlog.Err(err))
+	}
+	t.txn = nil
+}
+
+func (m *TxnManager) cleanupExpired() {
+	now := time.Now()
+	expired := make([]*clientTxn, 0)
+	m.mu.Lock()
+	for id, ct := range m.txns {
+		if !ct.mu.TryLock() {
+			// the transaction is being written.
+			continue
+		}
+		if ct.expiredLocked(now) && ct.txn != nil {
+			expired = append(expired, ct)
+		}
+		if now.Sub(ct.lastActive) > ct.keepalive+txnTombstoneLifetime {
+			delete(m.txns, id)
+		}
+		ct.mu.Unlock()
+	}
+	m.mu.Unlock()
+
+	for _, ct := range expired {
+		ct.mu.Lock()
+		ct.rollbackLocked(m.ctx)
+		ct.mu.Unlock()
+		mlog.Info(m.ctx, "client transaction expired", mlog.String("txnID", ct.id))
+	}
+}
+
+func getTransactionID(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if ids := md.Get(TransactionIDHeader); len(ids) > 0 {
+		return ids[0]
+	}
+	return ""
+}
+
+// bindTransaction binds the transaction carried by the request metadata to the context.
+func (node *Proxy) bindTransaction(ctx context.Context, dbName, collectionName string) (context.Context, error) {
+	if node.txnMgr == nil {
+		return ctx, nil
+	}
+	return node.txnMgr.BindContext(ctx, dbName, collectionName)
+}
@@ -0,0 +1,180 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus/internal/distributed/streaming"
+	"github.com/milvus-io/milvus/internal/mocks/distributed/mock_streaming"
+	"github.com/milvus-io/milvus/internal/util/streamingutil/status"
+	"github.com/milvus-io/milvus/pkg/v3/mocks/streaming/util/mock_message"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+func TestTxnManager(t *testing.T) {
+	paramtable.Get().Save(Params.ProxyCfg.TxnDefaultKeepalive.Key, "5s")
+	defer paramtable.Get().Reset(Params.ProxyCfg.TxnDefaultKeepalive.Key)
+
+	ctx := context.Background()
+	resolve := func(ctx context.Context, dbName, collectionName string) (int64, []string, error) {
+		switch collectionName {
+		case "coll":
+			return 1, []string{"v1"}, nil
+		case "other":
+			return 2, []string{"v2"}, nil
+		case "multi":
+			return 3, []string{"v3_0", "v3_1"}, nil
+		default:
+			return 0, nil, merr.WrapErrCollectionNotFound(collectionName)
+		}
+	}
+	nextID := int64(0)
+	allocID := func() (int64, error) {
+		nextID++
+		return nextID, nil
+	}
+	m := newTxnManager(ctx, resolve, allocID)
+
+	wal := mock_streaming.NewMockWALAccesser(t)
+	streaming.SetWALForTest(wal)
+	defer streaming.SetWALForTest(nil)
+
+	walTxn := mock_streaming.NewMockTxn(t)
+	wal.EXPECT().Txn(mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, opts streaming.TxnOption) (streaming.Txn, error) {
+			assert.Equal(t, "v1", opts.VChannel)
+			return walTxn, nil
+		})
+
+	msg := mock_message.NewMockMutableMessage(t)
+	msg.EXPECT().VChannel().Return("v1").Maybe()
+
+	t.Run("begin", func(t *testing.T) {
+		_, err := m.Begin(ctx, "", "not_exist", 0)
+		assert.ErrorIs(t, err, merr.ErrCollectionNotFound)
+		_, err = m.Begin(ctx, "", "multi", 0)
+		assert.ErrorIs(t, err, merr.ErrOperationNotSupported)
+		_, err = m.Begin(ctx, "", "coll", time.Hour)
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+		_, err = m.Begin(ctx, "", "coll", -time.Second)
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+
+		paramtable.Get().Save(Params.ProxyCfg.TxnMaxNum.Key, "0")
+		_, err = m.Begin(ctx, "", "coll", 0)
+		assert.ErrorIs(t, err, merr.ErrServiceQuotaExceeded)
+		paramtable.Get().Reset(Params.ProxyCfg.TxnMaxNum.Key)
+	})
+
+	t.Run("commit", func(t *testing.T) {
+		info, err := m.Begin(ctx, "", "coll", 0)
+		require.NoError(t, err)
+		assert.Equal(t, 5*time.Second, info.Keepalive)
+		assert.Equal(t, "default", info.DbName)
+
+		described, err := m.Describe(ctx, info.TxnID)
+		require.NoError(t, err)
+		assert.Equal(t, "coll", described.CollectionName)
+		_, err = m.Describe(NewContextWithMetadata(ctx, "someone", ""), info.TxnID)
+		assert.ErrorIs(t, err, merr.ErrTxnNotFound)
+
+		// requests without transaction are not bound.
+		bound, err := m.BindContext(ctx, "", "coll")
+		assert.NoError(t, err)
+		assert.Nil(t, clientTxnFromContext(bound))
+
+		txnCtx := metadata.NewIncomingContext(ctx, metadata.Pairs(TransactionIDHeader, info.TxnID))
+		_, err = m.BindContext(txnCtx, "", "other")
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+		_, err = m.BindContext(NewContextWithMetadata(txnCtx, "someone", ""), "", "coll")
+		assert.ErrorIs(t, err, merr.ErrTxnNotFound)
+		bound, err = m.BindContext(txnCtx, "", "coll")
+		require.NoError(t, err)
+
+		walTxn.EXPECT().Append(mock.Anything, msg).Return(nil).Times(2)
+		resp := appendMessages(bound, msg, msg)
+		assert.NoError(t, resp.UnwrapFirstError())
+		assert.Zero(t, resp.MaxTimeTick())
+
+		walTxn.EXPECT().Commit(mock.Anything).Return(&types.AppendResult{TimeTick: 100}, nil).Once()
+		ts, err := m.Commit(txnCtx, info.TxnID)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(100), ts)
+
+		_, err = m.Commit(txnCtx, info.TxnID)
+		assert.ErrorIs(t, err, merr.ErrTxnNotFound)
+		_, err = m.BindContext(txnCtx, "", "coll")
+		assert.ErrorIs(t, err, merr.ErrTxnNotFound)
+	})
+
+	t.Run("failed write", func(t *testing.T) {
+		info, err := m.Begin(ctx, "", "coll", time.Second)
+		require.NoError(t, err)
+		bound := withClientTxn(ctx, m.txns[info.TxnID])
+
+		walTxn.EXPECT().Append(mock.Anything, msg).Return(status.NewTransactionExpired("expired")).Once()
+		resp := appendMessages(bound, msg)
+		assert.ErrorIs(t, resp.UnwrapFirstError(), merr.ErrTxnExpired)
+
+		// the transaction can't be committed after a failed write.
+		walTxn.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+		_, err = m.Commit(ctx, info.TxnID)
+		assert.ErrorIs(t, err, merr.ErrTxnExpired)
+	})
+
+	t.Run("rollback", func(t *testing.T) {
+		info, err := m.Begin(ctx, "", "coll", time.Second)
+		require.NoError(t, err)
+
+		walTxn.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+		assert.NoError(t, m.Rollback(ctx, info.TxnID))
+		assert.ErrorIs(t, m.Rollback(ctx, info.TxnID), merr.ErrTxnNotFound)
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		info, err := m.Begin(ctx, "", "coll", time.Second)
+		require.NoError(t, err)
+		ct := m.txns[info.TxnID]
+		ct.lastActive = time.Now().Add(-2 * time.Second)
+
+		walTxn.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+		m.cleanupExpired()
+		assert.Nil(t, ct.txn)
+
+		// the expired transaction is kept as tombstone to report a clear error.
+		resp := appendMessages(withClientTxn(ctx, ct), msg)
+		assert.ErrorIs(t, resp.UnwrapFirstError(), merr.ErrTxnExpired)
+		_, err = m.Commit(ctx, info.TxnID)
+		assert.ErrorIs(t, err, merr.ErrTxnExpired)
+
+		info, err = m.Begin(ctx, "", "coll", time.Second)
+		require.NoError(t, err)
+		m.txns[info.TxnID].lastActive = time.Now().Add(-time.Second - txnTombstoneLifetime - time.Minute)
+		walTxn.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+		m.cleanupExpired()
+		assert.NotContains(t, m.txns, info.TxnID)
+	})
+}
@@ -22,6 +22,8 @@ import (
 	"reflect"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 	"unicode/utf8"
 
@@ -39,6 +41,7 @@ import (
 	"github.com/milvus-io/milvus/internal/agg"
 	"github.com/milvus-io/milvus/internal/json"
 	"github.com/milvus-io/milvus/internal/parser/planparserv2"
+	"github.com/milvus-io/milvus/internal/proxy/jwtauth"
 	"github.com/milvus-io/milvus/internal/proxy/privilege"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/function/embedding"
@@ -1599,6 +1602,47 @@ func VerifyAPIKey(rawToken string) (string, error) {
 	return user, nil
 }
 
+var (
+	jwtVerifierMu sync.Mutex
+	jwtVerifier   atomic.Pointer[jwtauth.Verifier]
+)
+
+// VerifyJWT verifies rawToken as a JWT bearer token if the jwt authentication is enabled,
+// ok is false if the token is not a jwt and should be verified as a password or an api key.
+func VerifyJWT(ctx context.Context, rawToken string) (identity *jwtauth.Identity, ok bool, err error) {
+	if !Params.CommonCfg.JWTEnabled.GetAsBool() || !jwtauth.IsJWT(rawToken) {
+		return nil, false, nil
+	}
+	verifier, err := getJWTVerifier()
+	if err != nil {
+		return nil, true, err
+	}
+	identity, err = verifier.Verify(ctx, rawToken)
+	if err != nil {
+		mlog.Warn(ctx, "fail to verify jwt", mlog.Err(err))
+		return nil, true, err
+	}
+	return identity, true, nil
+}
+
+func getJWTVerifier() (*jwtauth.Verifier, error) {
+	if verifier := jwtVerifier.Load(); verifier != nil {
+		return verifier, nil
+	}
+	jwtVerifierMu.Lock()
+	defer jwtVerifierMu.Unlock()
+	if verifier := jwtVerifier.Load(); verifier != nil {
+		return verifier, nil
+	}
+	verifier, err := jwtauth.NewVerifier(Params)
+	if err != nil {
+		mlog.Warn(context.TODO(), "fail to init jwt verifier", mlog.Err(err))
+		return nil, err
+	}
+	jwtVerifier.Store(verifier)
+	return verifier, nil
+}
+
 // PasswordVerify verify password
 func passwordVerify(ctx context.Context, username, rawPwd string, privilegeCache privilege.PrivilegeCache) bool {
 	// it represents the cache miss if Sha256Password is empty within credInfo, which shall be updated first connection.
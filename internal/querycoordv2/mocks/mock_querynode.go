@@ -556,6 +556,65 @@ func (_c *MockQueryNodeServer_GetSegmentInfo_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// GetShardAppliedVersion provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryNodeServer) GetShardAppliedVersion(_a0 context.Context, _a1 *querypb.GetShardAppliedVersionRequest) (*querypb.GetShardAppliedVersionResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetShardAppliedVersion")
+	}
+
+	var r0 *querypb.GetShardAppliedVersionResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetShardAppliedVersionRequest) (*querypb.GetShardAppliedVersionResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetShardAppliedVersionRequest) *querypb.GetShardAppliedVersionResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.GetShardAppliedVersionResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.GetShardAppliedVersionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryNodeServer_GetShardAppliedVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetShardAppliedVersion'
+type MockQueryNodeServer_GetShardAppliedVersion_Call struct {
+	*mock.Call
+}
+
+// GetShardAppliedVersion is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *querypb.GetShardAppliedVersionRequest
+func (_e *MockQueryNodeServer_Expecter) GetShardAppliedVersion(_a0 interface{}, _a1 interface{}) *MockQueryNodeServer_GetShardAppliedVersion_Call {
+	return &MockQueryNodeServer_GetShardAppliedVersion_Call{Call: _e.mock.On("GetShardAppliedVersion", _a0, _a1)}
+}
+
+func (_c *MockQueryNodeServer_GetShardAppliedVersion_Call) Run(run func(_a0 context.Context, _a1 *querypb.GetShardAppliedVersionRequest)) *MockQueryNodeServer_GetShardAppliedVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.GetShardAppliedVersionRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryNodeServer_GetShardAppliedVersion_Call) Return(_a0 *querypb.GetShardAppliedVersionResponse, _a1 error) *MockQueryNodeServer_GetShardAppliedVersion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryNodeServer_GetShardAppliedVersion_Call) RunAndReturn(run func(context.Context, *querypb.GetShardAppliedVersionRequest) (*querypb.GetShardAppliedVersionResponse, error)) *MockQueryNodeServer_GetShardAppliedVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetStatistics provides a mock function with given fields: _a0, _a1
 func (_m *MockQueryNodeServer) GetStatistics(_a0 context.Context, _a1 *querypb.GetStatisticsRequest) (*internalpb.GetStatisticsResponse, error) {
 	ret := _m.Called(_a0, _a1)
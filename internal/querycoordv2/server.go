@@ -35,6 +35,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v3/milvuspb"
 	"github.com/milvus-io/milvus/internal/allocator"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	sqlkv "github.com/milvus-io/milvus/internal/kv/sql"
 	"github.com/milvus-io/milvus/internal/kv/tikv"
 	"github.com/milvus-io/milvus/internal/metastore"
 	"github.com/milvus-io/milvus/internal/metastore/kv/querycoord"
@@ -275,6 +276,15 @@ func (s *Server) initQueryCoord() error {
 		s.kv = tikv.NewTiKV(s.tikvCli, Params.TiKVCfg.MetaRootPath.GetValue(),
 			tikv.WithRequestTimeout(paramtable.Get().TiKVCfg.RequestTimeout.GetAsDuration(time.Millisecond)))
 		idAllocatorKV = tsoutil.NewTSOTiKVBase(s.tikvCli, Params.TiKVCfg.KvRootPath.GetValue(), "querycoord-id-allocator")
+	case util.MetaStoreTypeSQL:
+		sqlCli, err := sqlkv.GetSQLClient(&Params.SQLCfg)
+		if err != nil {
+			return err
+		}
+		s.kv = sqlkv.NewSQLKV(sqlCli, Params.SQLCfg.MetaRootPath.GetValue(),
+			sqlkv.WithRequestTimeout(paramtable.Get().SQLCfg.RequestTimeout.GetAsDuration(time.Millisecond)),
+			sqlkv.WithWatchPollInterval(paramtable.Get().SQLCfg.WatchPollInterval.GetAsDuration(time.Millisecond)))
+		idAllocatorKV = tsoutil.NewTSOSQLBase(sqlCli, Params.SQLCfg.KvRootPath.GetValue(), "querycoord-id-allocator")
 	case util.MetaStoreTypeEtcd:
 		s.kv = etcdkv.NewEtcdKV(s.etcdCli, Params.EtcdCfg.MetaRootPath.GetValue(),
 			etcdkv.WithRequestTimeout(paramtable.Get().EtcdCfg.RequestTimeout.GetAsDuration(time.Millisecond)))
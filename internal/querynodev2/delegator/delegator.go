@@ -42,6 +42,7 @@ import (
 	"github.com/milvus-io/milvus/internal/querynodev2/delegator/deletebuffer"
 	"github.com/milvus-io/milvus/internal/querynodev2/segments"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/explainutil"
 	"github.com/milvus-io/milvus/internal/util/function"
 	"github.com/milvus-io/milvus/internal/util/grpcclient"
 	"github.com/milvus-io/milvus/internal/util/reduce"
@@ -110,6 +111,9 @@ type ShardDelegator interface {
 	GetLatestRequiredMVCCTimeTick() uint64
 	UpdateTSafe(ts uint64)
 	GetTSafe() uint64
+	// GetAppliedVersion returns the timestamp of the latest applied insert or delete and the target version,
+	// the results of the shard don't change until either of them moves.
+	GetAppliedVersion() (appliedTs uint64, targetVersion int64)
 
 	// analyzer
 	RunAnalyzer(ctx context.Context, req *querypb.RunAnalyzerRequest) ([]*milvuspb.AnalyzerResult, error)
@@ -170,6 +174,8 @@ type shardDelegator struct {
 	loader      segments.Loader
 	tsCond      *syncutil.ContextCond
 	latestTsafe *atomic.Uint64
+	// latestAppliedTs is the timestamp of the latest insert or delete applied to the shard.
+	latestAppliedTs atomic.Uint64
 	// queryHook
 	queryHook      optimizers.QueryHook
 	partitionStats map[UniqueID]*storage.PartitionStatsSnapshot
@@ -437,6 +443,75 @@ func (sd *shardDelegator) modifyQueryRequest(req *querypb.QueryRequest, scope qu
 	}
 }
 
+// pruneSealedSegments removes the sealed segments which cannot hold any result of the
+// search or query request, by the clustering key stats and the pk filter.
+// The pruning decisions are returned when record is true.
+func (sd *shardDelegator) pruneSealedSegments(
+	ctx context.Context,
+	searchReq *internalpb.SearchRequest,
+	queryReq *internalpb.RetrieveRequest,
+	sealed []SnapshotItem,
+	record bool,
+) []*internalpb.ExplainPruneProfile {
+	var profiles []*internalpb.ExplainPruneProfile
+	runPruner := func(pruner string, prune func()) {
+		if !record {
+			prune()
+			return
+		}
+		before := lo.FlatMap(sealed, func(item SnapshotItem, _ int) []int64 {
+			return lo.Map(item.Segments, func(segment SegmentEntry, _ int) int64 { return segment.SegmentID })
+		})
+		tr := timerecord.NewTimeRecorder("pruneSealedSegments")
+		prune()
+		cost := tr.ElapseSpan()
+		after := typeutil.NewSet[int64]()
+		for _, item := range sealed {
+			for _, segment := range item.Segments {
+				after.Insert(segment.SegmentID)
+			}
+		}
+		profiles = append(profiles, &internalpb.ExplainPruneProfile{
+			Pruner:           pruner,
+			SegmentsBefore:   int64(len(before)),
+			PrunedSegmentIDs: lo.Filter(before, func(segmentID int64, _ int) bool { return !after.Contain(segmentID) }),
+			CostUs:           cost.Microseconds(),
+		})
+	}
+
+	if paramtable.Get().QueryNodeCfg.EnableSegmentPrune.GetAsBool() {
+		runPruner(explainutil.PrunerClusteringKey, func() {
+			sd.partitionStatsMut.RLock()
+			defer sd.partitionStatsMut.RUnlock()
+			PruneSegments(ctx, sd.partitionStats, searchReq, queryReq, sd.collection.Schema(), sealed,
+				PruneInfo{filterRatio: paramtable.Get().QueryNodeCfg.DefaultSegmentFilterRatio.GetAsFloat()})
+		})
+	}
+
+	if paramtable.Get().QueryNodeCfg.EnableSegmentFilter.GetAsBool() {
+		runPruner(explainutil.PrunerPKFilter, func() {
+			if searchReq != nil {
+				PruneSealedSegmentsByPKFilter(ctx,
+					searchReq.GetSerializedExprPlan(),
+					searchReq.GetPkFilter(),
+					sealed,
+					searchReq.GetCollectionID(),
+					metrics.SearchLabel,
+				)
+				return
+			}
+			PruneSealedSegmentsByPKFilter(ctx,
+				queryReq.GetSerializedExprPlan(),
+				queryReq.GetPkFilter(),
+				sealed,
+				queryReq.GetCollectionID(),
+				metrics.QueryLabel,
+			)
+		})
+	}
+	return profiles
+}
+
 // executeSearchSubTasks is a helper that encapsulates the common pattern of
 // organizeSubTask + executeSubTasks for search operations.
 // Used by both normal search and two-stage search to reduce code duplication.
@@ -472,30 +547,47 @@ func (sd *shardDelegator) executeSearchSubTasks(
 	return results, nil
 }
 
-// Search preforms search operation on shard.
-func (sd *shardDelegator) search(ctx context.Context, req *querypb.SearchRequest, sealed []SnapshotItem, growing []SegmentEntry, sealedRowCount map[int64]int64) ([]*internalpb.SearchResults, error) {
+// executeQuerySubTasks is the query counterpart of executeSearchSubTasks.
+func (sd *shardDelegator) executeQuerySubTasks(
+	ctx context.Context,
+	req *querypb.QueryRequest,
+	sealed []SnapshotItem,
+	growing []SegmentEntry,
+	sealedRowCount map[int64]int64,
+) ([]*internalpb.RetrieveResults, error) {
 	log := sd.getLogger(ctx)
-	if req.Req.IgnoreGrowing {
-		growing = []SegmentEntry{}
+	tasks, err := organizeSubTask(ctx, req, sealed, growing, sd, true, sd.modifyQueryRequest)
+	if err != nil {
+		mlog.Warn(ctx, "query organizeSubTask failed", mlog.Err(err))
+		return nil, err
 	}
 
-	if paramtable.Get().QueryNodeCfg.EnableSegmentPrune.GetAsBool() {
-		func() {
-			sd.partitionStatsMut.RLock()
-			defer sd.partitionStatsMut.RUnlock()
-			PruneSegments(ctx, sd.partitionStats, req.GetReq(), nil, sd.collection.Schema(), sealed,
-				PruneInfo{filterRatio: paramtable.Get().QueryNodeCfg.DefaultSegmentFilterRatio.GetAsFloat()})
-		}()
+	results, err := executeSubTasks(ctx, tasks, NewRowCountBasedEvaluator(sealedRowCount), func(ctx context.Context, req *querypb.QueryRequest, worker cluster.Worker) (*internalpb.RetrieveResults, error) {
+		ctx = retry.WithMaxAttemptsContext(ctx, 3)
+		resp, err := worker.QuerySegments(ctx, req)
+		if errors.Is(err, merr.ErrNodeNotFound) || grpcclient.IsServerIDMismatchErr(err) {
+			sd.markSegmentOffline(req.GetSegmentIDs()...)
+		}
+		return resp, err
+	}, "Query", log)
+	if err != nil {
+		mlog.Warn(ctx, "Delegator query failed", mlog.Err(err))
+		return nil, err
 	}
+	return results, nil
+}
 
-	if paramtable.Get().QueryNodeCfg.EnableSegmentFilter.GetAsBool() {
-		PruneSealedSegmentsByPKFilter(ctx,
-			req.GetReq().GetSerializedExprPlan(),
-			req.GetReq().GetPkFilter(),
-			sealed,
-			req.GetReq().GetCollectionID(),
-			metrics.SearchLabel,
-		)
+// Search preforms search operation on shard.
+func (sd *shardDelegator) search(ctx context.Context, req *querypb.SearchRequest, sealed []SnapshotItem, growing []SegmentEntry, sealedRowCount map[int64]int64) ([]*internalpb.SearchResults, error) {
+	sd.pruneSealedSegments(ctx, req.GetReq(), nil, sealed, false)
+	return sd.searchPruned(ctx, req, sealed, growing, sealedRowCount)
+}
+
+// searchPruned searches the sealed segments left by the pruners and the growing segments.
+func (sd *shardDelegator) searchPruned(ctx context.Context, req *querypb.SearchRequest, sealed []SnapshotItem, growing []SegmentEntry, sealedRowCount map[int64]int64) ([]*internalpb.SearchResults, error) {
+	log := sd.getLogger(ctx)
+	if req.Req.IgnoreGrowing {
+		growing = []SegmentEntry{}
 	}
 
 	avgdl, skipSearch, err := sd.prepareSearchFunction(ctx, req.GetReq())
@@ -621,6 +713,10 @@ func (sd *shardDelegator) Search(ctx context.Context, req *querypb.SearchRequest
 	}
 	defer sd.distribution.Unpin(version)
 
+	if req.GetReq().GetExplain() {
+		return sd.explainSearch(ctx, req, sealed, growing, sealedRowCount)
+	}
+
 	if req.GetReq().GetIsAdvanced() {
 		futures := make([]*conc.Future[*internalpb.SearchResults], len(req.GetReq().GetSubReqs()))
 		for index, subReq := range req.GetReq().GetSubReqs() {
@@ -800,7 +896,6 @@ func (sd *shardDelegator) QueryStream(ctx context.Context, req *querypb.QueryReq
 
 // Query performs query operation on shard.
 func (sd *shardDelegator) Query(ctx context.Context, req *querypb.QueryRequest) ([]*internalpb.RetrieveResults, error) {
-	log := sd.getLogger(ctx)
 	if err := sd.lifetime.Add(sd.IsWorking); err != nil {
 		return nil, err
 	}
@@ -851,23 +946,11 @@ func (sd *shardDelegator) Query(ctx context.Context, req *querypb.QueryRequest)
 		growing = []SegmentEntry{}
 	}
 
-	if paramtable.Get().QueryNodeCfg.EnableSegmentPrune.GetAsBool() {
-		func() {
-			sd.partitionStatsMut.RLock()
-			defer sd.partitionStatsMut.RUnlock()
-			PruneSegments(ctx, sd.partitionStats, nil, req.GetReq(), sd.collection.Schema(), sealed, PruneInfo{paramtable.Get().QueryNodeCfg.DefaultSegmentFilterRatio.GetAsFloat()})
-		}()
+	if req.GetReq().GetExplain() {
+		return sd.explainQuery(ctx, req, sealed, growing, sealedRowCount)
 	}
 
-	if paramtable.Get().QueryNodeCfg.EnableSegmentFilter.GetAsBool() {
-		PruneSealedSegmentsByPKFilter(ctx,
-			req.GetReq().GetSerializedExprPlan(),
-			req.GetReq().GetPkFilter(),
-			sealed,
-			req.GetReq().GetCollectionID(),
-			metrics.QueryLabel,
-		)
-	}
+	sd.pruneSealedSegments(ctx, nil, req.GetReq(), sealed, false)
 
 	sealedNum := lo.SumBy(sealed, func(item SnapshotItem) int { return len(item.Segments) })
 	mlog.Debug(ctx, "query segments...",
@@ -875,22 +958,8 @@ func (sd *shardDelegator) Query(ctx context.Context, req *querypb.QueryRequest)
 		mlog.Int("sealedNum", sealedNum),
 		mlog.Int("growingNum", len(growing)),
 	)
-	tasks, err := organizeSubTask(ctx, req, sealed, growing, sd, true, sd.modifyQueryRequest)
+	results, err := sd.executeQuerySubTasks(ctx, req, sealed, growing, sealedRowCount)
 	if err != nil {
-		mlog.Warn(ctx, "query organizeSubTask failed", mlog.Err(err))
-		return nil, err
-	}
-
-	results, err := executeSubTasks(ctx, tasks, NewRowCountBasedEvaluator(sealedRowCount), func(ctx context.Context, req *querypb.QueryRequest, worker cluster.Worker) (*internalpb.RetrieveResults, error) {
-		ctx = retry.WithMaxAttemptsContext(ctx, 3)
-		resp, err := worker.QuerySegments(ctx, req)
-		if errors.Is(err, merr.ErrNodeNotFound) || grpcclient.IsServerIDMismatchErr(err) {
-			sd.markSegmentOffline(req.GetSegmentIDs()...)
-		}
-		return resp, err
-	}, "Query", log)
-	if err != nil {
-		mlog.Warn(ctx, "Delegator query failed", mlog.Err(err))
 		return nil, err
 	}
 
@@ -1341,6 +1410,20 @@ func (sd *shardDelegator) GetTSafe() uint64 {
 	return sd.latestTsafe.Load()
 }
 
+func (sd *shardDelegator) GetAppliedVersion() (uint64, int64) {
+	return sd.latestAppliedTs.Load(), sd.distribution.getTargetVersion()
+}
+
+// updateAppliedTs moves the timestamp of the latest applied mutation forward.
+func (sd *shardDelegator) updateAppliedTs(ts uint64) {
+	for {
+		current := sd.latestAppliedTs.Load()
+		if ts <= current || sd.latestAppliedTs.CompareAndSwap(current, ts) {
+			return
+		}
+	}
+}
+
 // CatchingUpStreamingData returns true if delegator is still catching up with streaming data.
 func (sd *shardDelegator) CatchingUpStreamingData() bool {
 	return sd.catchingUpStreamingData.Load()
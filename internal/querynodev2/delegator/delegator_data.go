@@ -253,6 +253,7 @@ func (sd *shardDelegator) ProcessInsert(insertRecords map[int64]*InsertData) {
 			mlog.Int("rowCount", len(insertData.RowIDs)),
 			mlog.Uint64("maxTimestamp", insertData.Timestamps[len(insertData.Timestamps)-1]),
 		)
+		sd.updateAppliedTs(insertData.Timestamps[len(insertData.Timestamps)-1])
 	}
 	if growingSegmentAdded {
 		sd.notifyLeaderViewUpdated()
@@ -310,6 +311,7 @@ func (sd *shardDelegator) ProcessDeleteBatches(batches []DeleteBatch) {
 			Data: cacheItems,
 		})
 		allDeleteData = append(allDeleteData, batch.Data...)
+		sd.updateAppliedTs(batch.Ts)
 	}
 
 	if len(allDeleteData) > 0 {
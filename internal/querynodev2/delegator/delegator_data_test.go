@@ -356,6 +356,8 @@ func (s *DelegatorDataSuite) TestProcessInsert() {
 		})
 
 		s.NotNil(s.manager.Segment.GetGrowing(100))
+		appliedTs, _ := s.delegator.GetAppliedVersion()
+		s.EqualValues(10, appliedTs)
 	})
 
 	s.Run("notify_new_growing_segment_once", func() {
@@ -683,6 +685,8 @@ func (s *DelegatorDataSuite) TestProcessDeleteBatchesPreservesBatchTsInDeleteBuf
 	}
 
 	s.delegator.ProcessDeleteBatches(batches)
+	appliedTs, _ := s.delegator.GetAppliedVersion()
+	s.EqualValues(20, appliedTs)
 
 	after15 := s.delegator.deleteBuffer.ListAfter(15)
 	s.Require().Len(after15, 1)
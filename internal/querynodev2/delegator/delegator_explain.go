@@ -0,0 +1,127 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package delegator
+
+import (
+	"context"
+
+	"github.com/samber/lo"
+
+	"github.com/milvus-io/milvus/internal/querynodev2/segments"
+	"github.com/milvus-io/milvus/internal/util/explainutil"
+	"github.com/milvus-io/milvus/internal/util/reduce"
+	"github.com/milvus-io/milvus/internal/util/segmentutil"
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v3/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v3/util/timerecord"
+)
+
+// explainSearch executes an explain search request. The request is pruned, searched and reduced
+// as a normal search, so the profile times the real execution, the rows surviving the filter are
+// only known for the segments executing the filter stage of a two-stage search.
+// The only result returned is the reduced one, carrying the shard profile.
+func (sd *shardDelegator) explainSearch(
+	ctx context.Context,
+	req *querypb.SearchRequest,
+	sealed []SnapshotItem,
+	growing []SegmentEntry,
+	sealedRowCount map[int64]int64,
+) ([]*internalpb.SearchResults, error) {
+	tr := timerecord.NewTimeRecorder("explainSearch")
+	profile := &internalpb.ExplainShardProfile{
+		Channel: sd.vchannelName,
+		NodeID:  paramtable.GetNodeID(),
+		Pruning: sd.pruneSealedSegments(ctx, req.GetReq(), nil, sealed, true),
+	}
+
+	results, err := sd.searchPruned(ctx, req, sealed, growing, sealedRowCount)
+	if err != nil {
+		mlog.Warn(ctx, "delegator explain search failed", mlog.Err(err))
+		return nil, err
+	}
+	// the search is skipped if the bm25 stats are empty.
+	result := &internalpb.SearchResults{
+		Status:          merr.Success(),
+		MetricType:      req.GetReq().GetMetricType(),
+		NumQueries:      req.GetReq().GetNq(),
+		TopK:            req.GetReq().GetTopk(),
+		CostAggregation: &internalpb.CostAggregation{},
+	}
+	if len(results) > 0 {
+		result, err = segments.ReduceSearchOnQueryNode(ctx, results,
+			reduce.NewReduceSearchResultInfo(req.GetReq().GetNq(),
+				req.GetReq().GetTopk()).WithMetricType(req.GetReq().GetMetricType()).
+				WithGroupSize(req.GetReq().GetGroupSize()).
+				WithGroupByFieldIdsFromProto(req.GetReq().GetGroupByFieldId(), req.GetReq().GetGroupByFieldIds()))
+		if err != nil {
+			return nil, err
+		}
+	}
+	profile.Segments = collectSegmentProfiles(results)
+	profile.CostUs = tr.ElapseSpan().Microseconds()
+	result.ExplainProfile = profile
+	return []*internalpb.SearchResults{result}, nil
+}
+
+// explainQuery executes an explain query request. The request is retrieved and reduced as a
+// normal query, the rows surviving the filter are only known for the count queries.
+// The only result returned is the reduced one, carrying the shard profile.
+func (sd *shardDelegator) explainQuery(
+	ctx context.Context,
+	req *querypb.QueryRequest,
+	sealed []SnapshotItem,
+	growing []SegmentEntry,
+	sealedRowCount map[int64]int64,
+) ([]*internalpb.RetrieveResults, error) {
+	tr := timerecord.NewTimeRecorder("explainQuery")
+	profile := &internalpb.ExplainShardProfile{
+		Channel: sd.vchannelName,
+		NodeID:  paramtable.GetNodeID(),
+		Pruning: sd.pruneSealedSegments(ctx, nil, req.GetReq(), sealed, true),
+	}
+
+	results, err := sd.executeQuerySubTasks(ctx, req, sealed, growing, sealedRowCount)
+	if err != nil {
+		return nil, err
+	}
+	result, err := segments.RunDelegatorQueryPipeline(ctx, req, sd.collection.Schema(), results)
+	if err != nil {
+		return nil, err
+	}
+	result.CostAggregation = segmentutil.MergeRequestCost(lo.Map(results, func(result *internalpb.RetrieveResults, _ int) *internalpb.CostAggregation {
+		return result.GetCostAggregation()
+	}))
+	profile.Segments = collectSegmentProfiles(results)
+	profile.CostUs = tr.ElapseSpan().Microseconds()
+	result.ExplainProfile = profile
+	return []*internalpb.RetrieveResults{result}, nil
+}
+
+// explainResult is the search or retrieve result of a worker.
+type explainResult interface {
+	GetExplainProfile() *internalpb.ExplainShardProfile
+}
+
+// collectSegmentProfiles gathers the segment profiles returned by the workers.
+func collectSegmentProfiles[T explainResult](results []T) []*internalpb.ExplainSegmentProfile {
+	return explainutil.MergeSegments(lo.FlatMap(results, func(result T, _ int) []*internalpb.ExplainSegmentProfile {
+		return result.GetExplainProfile().GetSegments()
+	}))
+}
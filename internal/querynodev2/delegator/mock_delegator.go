@@ -181,6 +181,61 @@ func (_c *MockShardDelegator_Collection_Call) RunAndReturn(run func() int64) *Mo
 	return _c
 }
 
+// GetAppliedVersion provides a mock function with no fields
+func (_m *MockShardDelegator) GetAppliedVersion() (uint64, int64) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAppliedVersion")
+	}
+
+	var r0 uint64
+	var r1 int64
+	if rf, ok := ret.Get(0).(func() (uint64, int64)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() uint64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func() int64); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	return r0, r1
+}
+
+// MockShardDelegator_GetAppliedVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAppliedVersion'
+type MockShardDelegator_GetAppliedVersion_Call struct {
+	*mock.Call
+}
+
+// GetAppliedVersion is a helper method to define mock.On call
+func (_e *MockShardDelegator_Expecter) GetAppliedVersion() *MockShardDelegator_GetAppliedVersion_Call {
+	return &MockShardDelegator_GetAppliedVersion_Call{Call: _e.mock.On("GetAppliedVersion")}
+}
+
+func (_c *MockShardDelegator_GetAppliedVersion_Call) Run(run func()) *MockShardDelegator_GetAppliedVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockShardDelegator_GetAppliedVersion_Call) Return(appliedTs uint64, targetVersion int64) *MockShardDelegator_GetAppliedVersion_Call {
+	_c.Call.Return(appliedTs, targetVersion)
+	return _c
+}
+
+func (_c *MockShardDelegator_GetAppliedVersion_Call) RunAndReturn(run func() (uint64, int64)) *MockShardDelegator_GetAppliedVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetChannelQueryView provides a mock function with no fields
 func (_m *MockShardDelegator) GetChannelQueryView() *channelQueryView {
 	ret := _m.Called()
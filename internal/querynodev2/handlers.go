@@ -207,6 +207,10 @@ func (node *QueryNode) queryChannel(ctx context.Context, req *querypb.QueryReque
 		log.Warn(ctx, "failed to query on delegator", mlog.Err(err))
 		return nil, err
 	}
+	if req.GetReq().GetExplain() {
+		// the explain result is reduced by the delegator to time the reduce in the shard profile.
+		return results[0], nil
+	}
 
 	// reduce result
 	tr.CtxElapse(ctx, fmt.Sprintf("start reduce query result, traceID = %s, vChannel = %s, segmentIDs = %v",
@@ -253,6 +257,13 @@ func (node *QueryNode) queryChannel(ctx context.Context, req *querypb.QueryReque
 		return cost.GetTotalRelatedDataSize()
 	})
 	resp.CostAggregation.TotalRelatedDataSize = relatedDataSize
+	// report the sealed segments touched by the workers, the proxy records them in the slow log.
+	if req.GetReq().GetTraceSegments() {
+		resp.SealedSegmentIDsRetrieved = lo.FlatMap(results, func(result *internalpb.RetrieveResults, _ int) []int64 {
+			return result.GetSealedSegmentIDsRetrieved()
+		})
+		resp.ChannelIDsRetrieved = []string{channel}
+	}
 	tr.CtxElapse(ctx, fmt.Sprintf("do query with channel done , vChannel = %s, segmentIDs = %v",
 		channel,
 		req.GetSegmentIDs(),
@@ -405,6 +416,10 @@ func (node *QueryNode) searchChannel(ctx context.Context, req *querypb.SearchReq
 		log.Warn(ctx, "failed to search on delegator", mlog.Err(err))
 		return nil, err
 	}
+	if req.GetReq().GetExplain() {
+		// the explain result is reduced by the delegator to time the reduce in the shard profile.
+		return results[0], nil
+	}
 
 	tr.CtxElapse(ctx, "start reduce query result, ch="+channel)
 
@@ -423,6 +438,13 @@ func (node *QueryNode) searchChannel(ctx context.Context, req *querypb.SearchReq
 	if err != nil {
 		return nil, err
 	}
+	// report the sealed segments touched by the workers, the proxy records them in the slow log.
+	if req.GetReq().GetTraceSegments() {
+		resp.SealedSegmentIDsSearched = lo.FlatMap(results, func(result *internalpb.SearchResults, _ int) []int64 {
+			return result.GetSealedSegmentIDsSearched()
+		})
+		resp.ChannelIDsSearched = []string{channel}
+	}
 
 	tr.CtxElapse(ctx, "search with channel done, ch="+channel)
 
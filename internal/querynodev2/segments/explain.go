@@ -0,0 +1,50 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segments
+
+import (
+	"time"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus/pkg/v3/common"
+	"github.com/milvus-io/milvus/pkg/v3/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v3/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+// newSegmentProfile builds the explain profile of a segment after it's searched or retrieved.
+func newSegmentProfile(s Segment, survivedRows int64, cost time.Duration) *internalpb.ExplainSegmentProfile {
+	profile := &internalpb.ExplainSegmentProfile{
+		SegmentID:    s.ID(),
+		NodeID:       paramtable.GetNodeID(),
+		Growing:      s.Type() == commonpb.SegmentState_Growing,
+		NumRows:      s.RowNum(),
+		SurvivedRows: survivedRows,
+		CostUs:       cost.Microseconds(),
+	}
+	for _, index := range s.Indexes() {
+		if profile.Indexes == nil {
+			profile.Indexes = make(map[int64]string)
+		}
+		indexType, err := funcutil.GetAttrByKeyFromRepeatedKV(common.IndexTypeKey, index.IndexInfo.GetIndexParams())
+		if err != nil {
+			indexType = index.IndexInfo.GetIndexName()
+		}
+		profile.Indexes[index.IndexInfo.GetFieldID()] = indexType
+	}
+	return profile
+}
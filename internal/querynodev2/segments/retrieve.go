@@ -21,6 +21,7 @@ import (
 	"sync"
 
 	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus/internal/util/explainutil"
 	"github.com/milvus-io/milvus/internal/util/streamrpc"
 	"github.com/milvus-io/milvus/pkg/v3/metrics"
 	"github.com/milvus-io/milvus/pkg/v3/mlog"
@@ -77,6 +78,13 @@ func retrieveOnSegments(ctx context.Context, mgr *Manager, segments []Segment, s
 					mlog.Int64("countRet", countRet))
 			}
 		}
+		if collector := explainutil.FromContext(ctx); collector != nil {
+			survivedRows := int64(-1)
+			if req.GetReq().GetIsCount() {
+				survivedRows = result.GetFieldsData()[0].GetScalars().GetLongData().GetData()[0]
+			}
+			collector.Add(newSegmentProfile(s, survivedRows, tr.ElapseSpan()))
+		}
 		resultCh <- RetrieveSegmentResult{
 			result,
 			s,
@@ -23,6 +23,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
 	"github.com/milvus-io/milvus/internal/querynodev2/segments/metricsutil"
+	"github.com/milvus-io/milvus/internal/util/explainutil"
 	segcoreutil "github.com/milvus-io/milvus/internal/util/segcore"
 	"github.com/milvus-io/milvus/pkg/v3/metrics"
 	"github.com/milvus-io/milvus/pkg/v3/mlog"
@@ -90,6 +91,13 @@ func searchSegmentsAttempt(ctx context.Context, mgr *Manager, segments []Segment
 			return err
 		}
 		searchResults[idx] = searchResult
+		if collector := explainutil.FromContext(ctx); collector != nil {
+			survivedRows := int64(-1)
+			if searchReq.FilterOnly() {
+				survivedRows = searchResult.ValidCount()
+			}
+			collector.Add(newSegmentProfile(s, survivedRows, tr.ElapseSpan()))
+		}
 		elapsed := float64(tr.ElapseSpan().Microseconds()) / 1000.0
 		metrics.QueryNodeSQSegmentLatency.WithLabelValues(nodeIDStr,
 			metrics.SearchLabel, searchLabel).Observe(elapsed)
@@ -42,6 +42,7 @@ import (
 	"github.com/milvus-io/milvus/internal/streamingnode/client/handler"
 	"github.com/milvus-io/milvus/internal/streamingnode/client/handler/registry"
 	"github.com/milvus-io/milvus/internal/util/analyzer"
+	"github.com/milvus-io/milvus/internal/util/explainutil"
 	"github.com/milvus-io/milvus/internal/util/fileresource"
 	"github.com/milvus-io/milvus/internal/util/searchutil/scheduler"
 	streamingstatus "github.com/milvus-io/milvus/internal/util/streamingutil/status"
@@ -867,6 +868,10 @@ func (node *QueryNode) SearchSegments(ctx context.Context, req *querypb.SearchRe
 	)
 	searchCtx, cancel := context.WithCancel(ctx)
 	defer cancel()
+	var collector *explainutil.Collector
+	if req.GetReq().GetExplain() {
+		searchCtx, collector = explainutil.WithCollector(searchCtx)
+	}
 
 	tr := timerecord.NewTimeRecorder("searchSegments")
 	log.Debug(ctx, "search segments...")
@@ -910,6 +915,10 @@ func (node *QueryNode) SearchSegments(ctx context.Context, req *querypb.SearchRe
 		resp.IsTopkReduce = true
 	}
 	resp.IsRecallEvaluation = req.GetReq().GetIsRecallEvaluation()
+	if req.GetReq().GetTraceSegments() && req.GetScope() == querypb.DataScope_Historical && len(resp.GetSealedSegmentIDsSearched()) == 0 {
+		resp.SealedSegmentIDsSearched = req.GetSegmentIDs()
+	}
+	resp.ExplainProfile = collector.Profile()
 	return resp, nil
 }
 
@@ -1018,6 +1027,10 @@ func (node *QueryNode) QuerySegments(ctx context.Context, req *querypb.QueryRequ
 	// add cancel when error occurs
 	queryCtx, cancel := context.WithCancel(ctx)
 	defer cancel()
+	var collector *explainutil.Collector
+	if req.GetReq().GetExplain() {
+		queryCtx, collector = explainutil.WithCollector(queryCtx)
+	}
 
 	tr := timerecord.NewTimeRecorder("querySegments")
 	if !node.manager.Collection.Ref(req.Req.GetCollectionID(), 1) {
@@ -1057,6 +1070,10 @@ func (node *QueryNode) QuerySegments(ctx context.Context, req *querypb.QueryRequ
 	result := task.Result()
 	result.GetCostAggregation().ResponseTime = latency.Milliseconds()
 	result.GetCostAggregation().TotalNQ = node.scheduler.GetWaitingTaskTotalNQ()
+	if req.GetReq().GetTraceSegments() && req.GetScope() == querypb.DataScope_Historical {
+		result.SealedSegmentIDsRetrieved = req.GetSegmentIDs()
+	}
+	result.ExplainProfile = collector.Profile()
 	return result, nil
 }
 
@@ -1917,6 +1934,38 @@ func (node *QueryNode) GetHighlight(ctx context.Context, req *querypb.GetHighlig
 	}, nil
 }
 
+// GetShardAppliedVersion returns the latest mutation applied by the delegator of the channel
+// and the target version of its readable segments.
+func (node *QueryNode) GetShardAppliedVersion(ctx context.Context, req *querypb.GetShardAppliedVersionRequest) (*querypb.GetShardAppliedVersionResponse, error) {
+	if err := node.lifetime.Add(merr.IsHealthy); err != nil {
+		return &querypb.GetShardAppliedVersionResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	defer node.lifetime.Done()
+
+	sd, ok := node.delegators.Get(req.GetChannel())
+	if !ok {
+		err := merr.WrapErrChannelNotFound(req.GetChannel())
+		mlog.Warn(ctx, "GetShardAppliedVersion failed, failed to get shard delegator", mlog.Err(err))
+		return &querypb.GetShardAppliedVersionResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	if !sd.Serviceable() {
+		return &querypb.GetShardAppliedVersionResponse{
+			Status: merr.Status(merr.WrapErrChannelNotAvailable(req.GetChannel(), "channel is not serviceable")),
+		}, nil
+	}
+
+	appliedTs, targetVersion := sd.GetAppliedVersion()
+	return &querypb.GetShardAppliedVersionResponse{
+		Status:           merr.Success(),
+		AppliedTimestamp: appliedTs,
+		TargetVersion:    targetVersion,
+	}, nil
+}
+
 func (node *QueryNode) SyncFileResource(ctx context.Context, req *internalpb.SyncFileResourceRequest) (*commonpb.Status, error) {
 	log := mlog.With(mlog.Uint64("version", req.GetVersion()))
 	log.Info(ctx, "sync file resource")
@@ -3080,6 +3080,47 @@ func (suite *ServiceSuite) TestGetHighlight() {
 	})
 }
 
+func (suite *ServiceSuite) TestGetShardAppliedVersion() {
+	ctx := context.Background()
+
+	suite.Run("channel not found", func() {
+		resp, err := suite.node.GetShardAppliedVersion(ctx, &querypb.GetShardAppliedVersionRequest{
+			Channel: "unknown",
+		})
+		suite.NoError(err)
+		suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrChannelNotFound)
+	})
+
+	suite.Run("not serviceable", func() {
+		delegator := delegator.NewMockShardDelegator(suite.T())
+		suite.node.delegators.Insert(suite.vchannel, delegator)
+		defer suite.node.delegators.GetAndRemove(suite.vchannel)
+		delegator.EXPECT().Serviceable().Return(false)
+
+		resp, err := suite.node.GetShardAppliedVersion(ctx, &querypb.GetShardAppliedVersionRequest{
+			Channel: suite.vchannel,
+		})
+		suite.NoError(err)
+		suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrChannelNotAvailable)
+	})
+
+	suite.Run("normal case", func() {
+		delegator := delegator.NewMockShardDelegator(suite.T())
+		suite.node.delegators.Insert(suite.vchannel, delegator)
+		defer suite.node.delegators.GetAndRemove(suite.vchannel)
+		delegator.EXPECT().Serviceable().Return(true)
+		delegator.EXPECT().GetAppliedVersion().Return(100, 2)
+
+		resp, err := suite.node.GetShardAppliedVersion(ctx, &querypb.GetShardAppliedVersionRequest{
+			Channel: suite.vchannel,
+		})
+		suite.NoError(err)
+		suite.NoError(merr.Error(resp.GetStatus()))
+		suite.EqualValues(100, resp.GetAppliedTimestamp())
+		suite.EqualValues(2, resp.GetTargetVersion())
+	})
+}
+
 func TestQueryNodeService(t *testing.T) {
 	wal := mock_streaming.NewMockWALAccesser(t)
 	local := mock_streaming.NewMockLocal(t)
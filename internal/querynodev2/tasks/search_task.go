@@ -414,7 +414,9 @@ func (t *SearchTask) Merge(other *SearchTask) bool {
 	ratio := float64(after) / float64(pre)
 
 	// Check mergeable
-	if t.req.GetFilterOnly() != other.req.GetFilterOnly() ||
+	// explain requests collect the profile through the task context, never merge them.
+	if t.req.GetReq().GetExplain() || other.req.GetReq().GetExplain() ||
+		t.req.GetFilterOnly() != other.req.GetFilterOnly() ||
 		t.req.GetEnableExprCache() != other.req.GetEnableExprCache() ||
 		t.req.GetReq().GetDbID() != other.req.GetReq().GetDbID() ||
 		t.req.GetReq().GetCollectionID() != other.req.GetReq().GetCollectionID() ||
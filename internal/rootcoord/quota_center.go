@@ -1349,6 +1349,7 @@ func (q *QuotaCenter) calculateEzStates() error {
 func (q *QuotaCenter) resetAllCurrentRates() error {
 	clusterLimiter := newParamLimiterFunc(internalpb.RateScope_Cluster, allOps)()
 	q.rateLimiter = rlinternal.NewRateLimiterTree(clusterLimiter)
+	q.resetPrincipalRates()
 
 	enablePartitionRateLimit := false
 	for rt := range getRateTypes(internalpb.RateScope_Partition, allOps) {
@@ -1417,6 +1418,49 @@ func (q *QuotaCenter) resetAllCurrentRates() error {
 	return nil
 }
 
+// resetPrincipalRates builds the limiters of the users and roles from the configurations,
+// their rates are allocated to the proxies the same as the rates of the resources.
+func (q *QuotaCenter) resetPrincipalRates() {
+	principals := make(map[rlinternal.Principal]*rlinternal.RateLimiterNode)
+	for kind, param := range map[rlinternal.PrincipalKind]*paramtable.ParamItem{
+		rlinternal.PrincipalUser: &Params.QuotaConfig.UserRateLimits,
+		rlinternal.PrincipalRole: &Params.QuotaConfig.RoleRateLimits,
+	} {
+		limits, err := quota.ParsePrincipalRateLimits(param.GetValue())
+		if err != nil {
+			mlog.RatedWarn(q.ctx, rate.Every(time.Minute), "ignore invalid principal rate limits",
+				mlog.String("config item", param.Key), mlog.Err(err))
+			continue
+		}
+		for name, rates := range limits {
+			principal := rlinternal.Principal{Kind: kind, Name: name}
+			principals[principal] = newPrincipalLimiter(principal, rates)
+		}
+	}
+	q.rateLimiter.ResetPrincipalLimiters(principals)
+}
+
+func newPrincipalLimiter(principal rlinternal.Principal, rates map[internalpb.RateType]float64) *rlinternal.RateLimiterNode {
+	node := rlinternal.NewRateLimiterNode(internalpb.RateScope_Cluster)
+	for rt, r := range rates {
+		limiter := ratelimitutil.NewLimiter(Limit(r), 0)
+		limiter.SetHasUpdated(true)
+		node.GetLimiters().Insert(rt, limiter)
+		if r != 0 {
+			continue
+		}
+		state := milvuspb.QuotaState_DenyToRead
+		if rt == internalpb.RateType_DMLInsert || rt == internalpb.RateType_DMLDelete {
+			state = milvuspb.QuotaState_DenyToWrite
+		}
+		node.GetQuotaStates().Insert(state, &rlinternal.QuotaStateInfo{
+			ErrorCode: commonpb.ErrorCode_ForceDeny,
+			Reason:    fmt.Sprintf("denied by the rate limits of %s", principal),
+		})
+	}
+	return node
+}
+
 // getCollectionMaxLimit get limit value from collection's properties.
 func (q *QuotaCenter) getCollectionMaxLimit(rt internalpb.RateType, collectionID int64) (ratelimitutil.Limit, error) {
 	collectionProps := q.getCollectionLimitProperties(collectionID)
@@ -1669,14 +1713,27 @@ func (q *QuotaCenter) toRatesRequest() *proxypb.SetRatesRequest {
 		Children: dbLimiters,
 	}
 
+	principalLimiters := make([]*proxypb.PrincipalLimiter, 0, q.rateLimiter.GetPrincipals().Len())
+	q.rateLimiter.GetPrincipals().Range(func(principal rlinternal.Principal, node *rlinternal.RateLimiterNode) bool {
+		if limiter := q.toRequestLimiter(node); limiter != nil {
+			principalLimiters = append(principalLimiters, &proxypb.PrincipalLimiter{
+				Kind:    string(principal.Kind),
+				Name:    principal.Name,
+				Limiter: limiter,
+			})
+		}
+		return true
+	})
+
 	timestamp := tsoutil.ComposeTSByTime(time.Now())
 	return &proxypb.SetRatesRequest{
 		Base: commonpbutil.NewMsgBase(
 			commonpbutil.WithMsgID(int64(timestamp)),
 			commonpbutil.WithTimeStamp(timestamp),
 		),
-		Rates:       []*proxypb.CollectionRate{},
-		RootLimiter: clusterLimiter,
+		Rates:             []*proxypb.CollectionRate{},
+		RootLimiter:       clusterLimiter,
+		PrincipalLimiters: principalLimiters,
 	}
 }
 
@@ -1655,6 +1655,45 @@ func TestTORequestLimiter(t *testing.T) {
 	assert.Equal(t, commonpb.ErrorCode_ForceDeny, proxyLimit.Codes[0])
 }
 
+func TestPrincipalRatesRequest(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+	qc := mocks.NewMixCoord(t)
+	meta := mockrootcoord.NewIMetaTable(t)
+	pcm := proxyutil.NewMockProxyClientManager(t)
+	core, _ := NewCore(ctx, nil)
+	core.tsoAllocator = newMockTsoAllocator()
+
+	paramtable.Get().Save(Params.QuotaConfig.UserRateLimits.Key, `{"alice": {"searchRate": 10, "insertRate": 0}}`)
+	defer paramtable.Get().Reset(Params.QuotaConfig.UserRateLimits.Key)
+	paramtable.Get().Save(Params.QuotaConfig.RoleRateLimits.Key, `{"reader": {"unknownRate": 5}}`)
+	defer paramtable.Get().Reset(Params.QuotaConfig.RoleRateLimits.Key)
+
+	quotaCenter := NewQuotaCenter(pcm, qc, core.tsoAllocator, meta)
+	quotaCenter.rateAllocateStrategy = Average
+	pcm.EXPECT().GetProxyCount().Return(2)
+	quotaCenter.resetPrincipalRates()
+
+	// the invalid role rate limits are ignored
+	req := quotaCenter.toRatesRequest()
+	assert.Equal(t, 1, len(req.GetPrincipalLimiters()))
+	alice := req.GetPrincipalLimiters()[0]
+	assert.Equal(t, string(rlinternal.PrincipalUser), alice.GetKind())
+	assert.Equal(t, "alice", alice.GetName())
+
+	// the rates are shared by all the proxies
+	rates := make(map[internalpb.RateType]float64)
+	for _, rate := range alice.GetLimiter().GetRates() {
+		rates[rate.GetRt()] = rate.GetR()
+	}
+	assert.Equal(t, map[internalpb.RateType]float64{
+		internalpb.RateType_DQLSearch: 5,
+		internalpb.RateType_DMLInsert: 0,
+	}, rates)
+	assert.Equal(t, []milvuspb.QuotaState{milvuspb.QuotaState_DenyToWrite}, alice.GetLimiter().GetStates())
+	assert.Equal(t, []commonpb.ErrorCode{commonpb.ErrorCode_ForceDeny}, alice.GetLimiter().GetCodes())
+}
+
 func TestDatabaseForceDenyDDL(t *testing.T) {
 	getQuotaCenter := func() (*QuotaCenter, *mockrootcoord.IMetaTable) {
 		ctx := context.Background()
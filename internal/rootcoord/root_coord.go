@@ -38,6 +38,7 @@ import (
 	"github.com/milvus-io/milvus/internal/allocator"
 	"github.com/milvus-io/milvus/internal/coordinator/snmanager"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	sqlkv "github.com/milvus-io/milvus/internal/kv/sql"
 	"github.com/milvus-io/milvus/internal/kv/tikv"
 	"github.com/milvus-io/milvus/internal/metastore"
 	kvmetastore "github.com/milvus-io/milvus/internal/metastore/kv/rootcoord"
@@ -126,7 +127,7 @@ var Params *paramtable.ComponentParam = paramtable.Get()
 
 type Opt func(*Core)
 
-type metaKVCreator func() kv.MetaKv
+type metaKVCreator func() (kv.MetaKv, error)
 
 // Core root coordinator core
 type Core struct {
@@ -400,14 +401,18 @@ func (c *Core) SetSession(session sessionutil.SessionInterface) error {
 func (c *Core) initKVCreator() {
 	if c.metaKVCreator == nil {
 		if Params.MetaStoreCfg.MetaStoreType.GetValue() == util.MetaStoreTypeTiKV {
-			c.metaKVCreator = func() kv.MetaKv {
+			c.metaKVCreator = func() (kv.MetaKv, error) {
 				return tikv.NewTiKV(c.tikvCli, Params.TiKVCfg.MetaRootPath.GetValue(),
-					tikv.WithRequestTimeout(paramtable.Get().TiKVCfg.RequestTimeout.GetAsDuration(time.Millisecond)))
+					tikv.WithRequestTimeout(paramtable.Get().TiKVCfg.RequestTimeout.GetAsDuration(time.Millisecond))), nil
+			}
+		} else if Params.MetaStoreCfg.MetaStoreType.GetValue() == util.MetaStoreTypeSQL {
+			c.metaKVCreator = func() (kv.MetaKv, error) {
+				return sqlkv.NewMetaKvFactory(Params.SQLCfg.MetaRootPath.GetValue(), &Params.SQLCfg)
 			}
 		} else {
-			c.metaKVCreator = func() kv.MetaKv {
+			c.metaKVCreator = func() (kv.MetaKv, error) {
 				return etcdkv.NewEtcdKV(c.etcdCli, Params.EtcdCfg.MetaRootPath.GetValue(),
-					etcdkv.WithRequestTimeout(paramtable.Get().EtcdCfg.RequestTimeout.GetAsDuration(time.Millisecond)))
+					etcdkv.WithRequestTimeout(paramtable.Get().EtcdCfg.RequestTimeout.GetAsDuration(time.Millisecond))), nil
 			}
 		}
 	}
@@ -421,13 +426,28 @@ func (c *Core) initMetaTable(initCtx context.Context) error {
 		switch Params.MetaStoreCfg.MetaStoreType.GetValue() {
 		case util.MetaStoreTypeEtcd:
 			mlog.Info(initCtx, "Using etcd as meta storage.")
-			metaKV := c.metaKVCreator()
+			metaKV, err := c.metaKVCreator()
+			if err != nil {
+				return err
+			}
 			kvmetastore.StartLegacySnapshotGC(c.ctx, metaKV)
 			kvmetastore.StartLegacyTombstoneGC(c.ctx, metaKV)
 			catalog = kvmetastore.NewCatalog(metaKV)
 		case util.MetaStoreTypeTiKV:
 			mlog.Info(initCtx, "Using tikv as meta storage.")
-			metaKV := c.metaKVCreator()
+			metaKV, err := c.metaKVCreator()
+			if err != nil {
+				return err
+			}
+			kvmetastore.StartLegacySnapshotGC(c.ctx, metaKV)
+			kvmetastore.StartLegacyTombstoneGC(c.ctx, metaKV)
+			catalog = kvmetastore.NewCatalog(metaKV)
+		case util.MetaStoreTypeSQL:
+			mlog.Info(initCtx, "Using sql as meta storage.")
+			metaKV, err := c.metaKVCreator()
+			if err != nil {
+				return err
+			}
 			kvmetastore.StartLegacySnapshotGC(c.ctx, metaKV)
 			kvmetastore.StartLegacyTombstoneGC(c.ctx, metaKV)
 			catalog = kvmetastore.NewCatalog(metaKV)
@@ -451,6 +471,13 @@ func (c *Core) initIDAllocator(initCtx context.Context) error {
 	if Params.MetaStoreCfg.MetaStoreType.GetValue() == util.MetaStoreTypeTiKV {
 		kvPath = Params.TiKVCfg.KvRootPath.GetValue()
 		tsoKV = tsoutil2.NewTSOTiKVBase(c.tikvCli, kvPath, globalIDAllocatorSubPath)
+	} else if Params.MetaStoreCfg.MetaStoreType.GetValue() == util.MetaStoreTypeSQL {
+		sqlCli, err := sqlkv.GetSQLClient(&Params.SQLCfg)
+		if err != nil {
+			return err
+		}
+		kvPath = Params.SQLCfg.KvRootPath.GetValue()
+		tsoKV = tsoutil2.NewTSOSQLBase(sqlCli, kvPath, globalIDAllocatorSubPath)
 	} else {
 		kvPath = Params.EtcdCfg.KvRootPath.GetValue()
 		tsoKV = tsoutil2.NewTSOKVBase(c.etcdCli, kvPath, globalIDAllocatorSubPath)
@@ -475,6 +502,13 @@ func (c *Core) initTSOAllocator(initCtx context.Context) error {
 	if Params.MetaStoreCfg.MetaStoreType.GetValue() == util.MetaStoreTypeTiKV {
 		kvPath = Params.TiKVCfg.KvRootPath.GetValue()
 		tsoKV = tsoutil2.NewTSOTiKVBase(c.tikvCli, Params.TiKVCfg.KvRootPath.GetValue(), globalIDAllocatorSubPath)
+	} else if Params.MetaStoreCfg.MetaStoreType.GetValue() == util.MetaStoreTypeSQL {
+		sqlCli, err := sqlkv.GetSQLClient(&Params.SQLCfg)
+		if err != nil {
+			return err
+		}
+		kvPath = Params.SQLCfg.KvRootPath.GetValue()
+		tsoKV = tsoutil2.NewTSOSQLBase(sqlCli, kvPath, globalIDAllocatorSubPath)
 	} else {
 		kvPath = Params.EtcdCfg.KvRootPath.GetValue()
 		tsoKV = tsoutil2.NewTSOKVBase(c.etcdCli, Params.EtcdCfg.KvRootPath.GetValue(), globalIDAllocatorSubPath)
@@ -13,11 +13,13 @@ type ChunkManagerFactory struct {
 	config            *objectstorage.Config
 }
 
-func NewChunkManagerFactoryWithParam(params *paramtable.ComponentParam) *ChunkManagerFactory {
+// NewChunkManagerFactoryWithParam creates the factory of the storage configured by
+// params, opts are applied last so that callers could override the config, e.g. the bucket.
+func NewChunkManagerFactoryWithParam(params *paramtable.ComponentParam, opts ...objectstorage.Option) *ChunkManagerFactory {
 	if params.CommonCfg.StorageType.GetValue() == "local" {
-		return NewChunkManagerFactory("local", objectstorage.RootPath(params.LocalStorageCfg.Path.GetValue()))
+		return NewChunkManagerFactory("local", append([]objectstorage.Option{objectstorage.RootPath(params.LocalStorageCfg.Path.GetValue())}, opts...)...)
 	}
-	return NewChunkManagerFactory(params.CommonCfg.StorageType.GetValue(),
+	return NewChunkManagerFactory(params.CommonCfg.StorageType.GetValue(), append([]objectstorage.Option{
 		objectstorage.RootPath(params.MinioCfg.RootPath.GetValue()),
 		objectstorage.Address(params.MinioCfg.Address.GetValue()),
 		objectstorage.AccessKeyID(params.MinioCfg.AccessKeyID.GetValue()),
@@ -33,7 +35,8 @@ func NewChunkManagerFactoryWithParam(params *paramtable.ComponentParam) *ChunkMa
 		objectstorage.Region(params.MinioCfg.Region.GetValue()),
 		objectstorage.RequestTimeout(params.MinioCfg.RequestTimeoutMs.GetAsInt64()),
 		objectstorage.CreateBucket(true),
-		objectstorage.GcpCredentialJSON(params.MinioCfg.GcpCredentialJSON.GetValue()))
+		objectstorage.GcpCredentialJSON(params.MinioCfg.GcpCredentialJSON.GetValue()),
+	}, opts...)...)
 }
 
 func NewChunkManagerFactory(persistentStorage string, opts ...objectstorage.Option) *ChunkManagerFactory {
@@ -24,6 +24,7 @@ const (
 	mqTypeKafka      = "kafka"
 	mqTypePulsar     = "pulsar"
 	mqTypeWoodpecker = "woodpecker"
+	mqTypeFileWAL    = "filewal"
 )
 
 type mqEnable struct {
@@ -106,6 +107,9 @@ func (f *DefaultFactory) initMQ(standalone bool, params *paramtable.ComponentPar
 		f.msgStreamFactory = msgstream.NewKmsFactory(&params.ServiceParam)
 	case mqTypeWoodpecker:
 		f.msgStreamFactory = msgstream.NewWpmsFactory(&params.ServiceParam)
+	case mqTypeFileWAL:
+		// file wal is only served by the streaming service, the legacy msgstream is not supported.
+		f.msgStreamFactory = &unsupportedMsgStreamFactory{mqType: mqType}
 	}
 	if f.msgStreamFactory == nil {
 		return merr.WrapErrServiceInternalMsg("failed to create MQ: check the milvus log for initialization failures")
@@ -113,6 +117,29 @@ func (f *DefaultFactory) initMQ(standalone bool, params *paramtable.ComponentPar
 	return nil
 }
 
+// unsupportedMsgStreamFactory fails all the legacy msgstream creations of the mq types only served by the streaming service.
+type unsupportedMsgStreamFactory struct {
+	mqType string
+}
+
+func (f *unsupportedMsgStreamFactory) err() error {
+	return merr.WrapErrOperationNotSupportedMsg("msgstream is not supported with mq type %s", f.mqType)
+}
+
+func (f *unsupportedMsgStreamFactory) NewMsgStream(ctx context.Context) (msgstream.MsgStream, error) {
+	return nil, f.err()
+}
+
+func (f *unsupportedMsgStreamFactory) NewTtMsgStream(ctx context.Context) (msgstream.MsgStream, error) {
+	return nil, f.err()
+}
+
+func (f *unsupportedMsgStreamFactory) NewMsgStreamDisposer(ctx context.Context) func([]string, string) error {
+	return func([]string, string) error {
+		return f.err()
+	}
+}
+
 // Select valid mq if mq type is default.
 func mustSelectMQType(standalone bool, mqType string, enable mqEnable) string {
 	if mqType != mqTypeDefault {
@@ -142,10 +169,10 @@ func mustSelectMQType(standalone bool, mqType string, enable mqEnable) string {
 
 // Validate mq type.
 func validateMQType(standalone bool, mqType string) error {
-	if mqType != mqTypeRocksmq && mqType != mqTypeKafka && mqType != mqTypePulsar && mqType != mqTypeWoodpecker {
+	if mqType != mqTypeRocksmq && mqType != mqTypeKafka && mqType != mqTypePulsar && mqType != mqTypeWoodpecker && mqType != mqTypeFileWAL {
 		return merr.WrapErrParameterInvalidMsg("mq type %s is invalid", mqType)
 	}
-	if !standalone && mqType == mqTypeRocksmq {
+	if !standalone && (mqType == mqTypeRocksmq || mqType == mqTypeFileWAL) {
 		return merr.WrapErrParameterInvalidMsg("mq %s is only valid in standalone mode", mqType)
 	}
 	return nil
@@ -194,6 +221,9 @@ func HealthCheck(mqType string) *common.MQClusterStatus {
 	case mqTypeWoodpecker:
 		// TODO: implement health checker for woodpecker
 		clusterStatus.Health = true
+	case mqTypeFileWAL:
+		// file wal is embedded, it's healthy as long as the process is alive.
+		clusterStatus.Health = true
 	}
 	return clusterStatus
 }
@@ -1,10 +1,12 @@
 package dependency
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
 	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/v3/util/typeutil"
 )
@@ -13,10 +15,21 @@ func TestValidateMQType(t *testing.T) {
 	assert.Error(t, validateMQType(true, mqTypeDefault))
 	assert.Error(t, validateMQType(false, mqTypeDefault))
 	assert.Error(t, validateMQType(false, mqTypeRocksmq))
+	assert.Error(t, validateMQType(false, mqTypeFileWAL))
+	assert.NoError(t, validateMQType(true, mqTypeFileWAL))
 	assert.NoError(t, validateMQType(true, mqTypeWoodpecker))
 	assert.NoError(t, validateMQType(false, mqTypeWoodpecker))
 }
 
+func TestUnsupportedMsgStreamFactory(t *testing.T) {
+	f := &unsupportedMsgStreamFactory{mqType: mqTypeFileWAL}
+	_, err := f.NewMsgStream(context.Background())
+	assert.ErrorIs(t, err, merr.ErrOperationNotSupported)
+	_, err = f.NewTtMsgStream(context.Background())
+	assert.ErrorIs(t, err, merr.ErrOperationNotSupported)
+	assert.ErrorIs(t, f.NewMsgStreamDisposer(context.Background())([]string{"ch"}, "sub"), merr.ErrOperationNotSupported)
+}
+
 func TestSelectMQType(t *testing.T) {
 	assert.Equal(t, mustSelectMQType(true, mqTypeDefault, mqEnable{true, true, true, true}), mqTypeRocksmq)
 	assert.Equal(t, mustSelectMQType(true, mqTypeDefault, mqEnable{false, true, true, true}), mqTypePulsar)
@@ -76,6 +89,7 @@ func TestHealthCheck(t *testing.T) {
 		{mqTypePulsar, false},
 		{mqTypeKafka, false},
 		{mqTypeWoodpecker, true},
+		{mqTypeFileWAL, true},
 		{"invalidType", false},
 	}
 
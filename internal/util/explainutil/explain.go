@@ -0,0 +1,104 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package explainutil collects the execution profile of explain requests on the query nodes,
+// the profile is carried back in the explain_profile of the search and retrieve results.
+package explainutil
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/milvus-io/milvus/pkg/v3/proto/internalpb"
+)
+
+const (
+	PrunerClusteringKey = "clustering_key"
+	PrunerPKFilter      = "pk_filter"
+)
+
+// Collector gathers the segment profiles of an explain request on a worker.
+type Collector struct {
+	mu       sync.Mutex
+	segments []*internalpb.ExplainSegmentProfile
+}
+
+type collectorKey struct{}
+
+// WithCollector returns a context carrying a new collector.
+func WithCollector(ctx context.Context) (context.Context, *Collector) {
+	collector := &Collector{}
+	return context.WithValue(ctx, collectorKey{}, collector), collector
+}
+
+// FromContext returns the collector in the context, nil if the request is not explained.
+func FromContext(ctx context.Context) *Collector {
+	collector, _ := ctx.Value(collectorKey{}).(*Collector)
+	return collector
+}
+
+// Add records the profile of a segment, it's a no-op on a nil collector.
+func (c *Collector) Add(profile *internalpb.ExplainSegmentProfile) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.segments = append(c.segments, profile)
+}
+
+// Profile returns the collected profiles ordered by segment id, nil on a nil collector.
+func (c *Collector) Profile() *internalpb.ExplainShardProfile {
+	if c == nil {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return &internalpb.ExplainShardProfile{Segments: MergeSegments(c.segments)}
+}
+
+// MergeSegments merges the profiles of the same segment and orders them by segment id.
+// A segment is executed more than once by a two-stage search, the costs are summed
+// and the survived rows are kept from the execution counting them.
+func MergeSegments(profiles []*internalpb.ExplainSegmentProfile) []*internalpb.ExplainSegmentProfile {
+	merged := make(map[int64]*internalpb.ExplainSegmentProfile, len(profiles))
+	segments := make([]*internalpb.ExplainSegmentProfile, 0, len(profiles))
+	for _, profile := range profiles {
+		prev, ok := merged[profile.GetSegmentID()]
+		if !ok {
+			// the profiles may be shared with the results, merge into a copy.
+			profile = &internalpb.ExplainSegmentProfile{
+				SegmentID:    profile.GetSegmentID(),
+				NodeID:       profile.GetNodeID(),
+				Growing:      profile.GetGrowing(),
+				NumRows:      profile.GetNumRows(),
+				SurvivedRows: profile.GetSurvivedRows(),
+				Indexes:      profile.GetIndexes(),
+				CostUs:       profile.GetCostUs(),
+			}
+			merged[profile.GetSegmentID()] = profile
+			segments = append(segments, profile)
+			continue
+		}
+		prev.CostUs += profile.GetCostUs()
+		if prev.GetSurvivedRows() < 0 {
+			prev.SurvivedRows = profile.GetSurvivedRows()
+		}
+	}
+	sort.Slice(segments, func(i, j int) bool { return segments[i].GetSegmentID() < segments[j].GetSegmentID() })
+	return segments
+}
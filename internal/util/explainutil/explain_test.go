@@ -0,0 +1,70 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package explainutil
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/pkg/v3/proto/internalpb"
+)
+
+func TestCollector(t *testing.T) {
+	assert.Nil(t, FromContext(context.Background()))
+
+	var nilCollector *Collector
+	nilCollector.Add(&internalpb.ExplainSegmentProfile{SegmentID: 1})
+	assert.Nil(t, nilCollector.Profile())
+
+	ctx, collector := WithCollector(context.Background())
+	assert.Same(t, collector, FromContext(ctx))
+	collector.Add(&internalpb.ExplainSegmentProfile{SegmentID: 3})
+	collector.Add(&internalpb.ExplainSegmentProfile{SegmentID: 1})
+	collector.Add(&internalpb.ExplainSegmentProfile{SegmentID: 2})
+
+	segments := collector.Profile().GetSegments()
+	require.Len(t, segments, 3)
+	for i, segment := range segments {
+		assert.Equal(t, int64(i+1), segment.GetSegmentID())
+	}
+}
+
+func TestMergeSegments(t *testing.T) {
+	filter := &internalpb.ExplainSegmentProfile{SegmentID: 2, NumRows: 100, SurvivedRows: 10, CostUs: 5}
+	profiles := []*internalpb.ExplainSegmentProfile{
+		{SegmentID: 2, NumRows: 100, SurvivedRows: -1, CostUs: 20, Indexes: map[int64]string{101: "HNSW"}},
+		{SegmentID: 1, NumRows: 50, SurvivedRows: -1, CostUs: 7},
+		filter,
+	}
+
+	segments := MergeSegments(profiles)
+	require.Len(t, segments, 2)
+	assert.Equal(t, int64(1), segments[0].GetSegmentID())
+	assert.Equal(t, int64(-1), segments[0].GetSurvivedRows())
+	assert.Equal(t, int64(2), segments[1].GetSegmentID())
+	assert.Equal(t, int64(10), segments[1].GetSurvivedRows())
+	assert.Equal(t, int64(25), segments[1].GetCostUs())
+	assert.Equal(t, "HNSW", segments[1].GetIndexes()[101])
+	// the input profiles are not modified
+	assert.Equal(t, int64(-1), profiles[0].GetSurvivedRows())
+	assert.Equal(t, int64(20), profiles[0].GetCostUs())
+
+	assert.Empty(t, MergeSegments(nil))
+}
@@ -0,0 +1,188 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exportutil
+
+import (
+	"encoding/json"
+
+	"github.com/apache/arrow/go/v17/arrow"
+	"github.com/apache/arrow/go/v17/arrow/array"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+)
+
+// The binlog arrow layout (storage.ConvertToArrowSchema) stores dense vectors as
+// fixed size binary, which is not readable by common analytics engines.
+// Exported parquet files use plain arrow types instead:
+//   - float/float16/bfloat16 vectors are list<float32>
+//   - binary vectors are list<uint8>, int8 vectors are list<int8>
+//   - sparse vectors and JSON are JSON encoded strings
+//   - geometry is WKT string, timestamptz is timestamp[us, UTC]
+func exportArrowType(field *schemapb.FieldSchema) (arrow.DataType, error) {
+	switch field.GetDataType() {
+	case schemapb.DataType_Bool:
+		return arrow.FixedWidthTypes.Boolean, nil
+	case schemapb.DataType_Int8:
+		return arrow.PrimitiveTypes.Int8, nil
+	case schemapb.DataType_Int16:
+		return arrow.PrimitiveTypes.Int16, nil
+	case schemapb.DataType_Int32:
+		return arrow.PrimitiveTypes.Int32, nil
+	case schemapb.DataType_Int64:
+		return arrow.PrimitiveTypes.Int64, nil
+	case schemapb.DataType_Float:
+		return arrow.PrimitiveTypes.Float32, nil
+	case schemapb.DataType_Double:
+		return arrow.PrimitiveTypes.Float64, nil
+	case schemapb.DataType_String, schemapb.DataType_VarChar, schemapb.DataType_Text,
+		schemapb.DataType_JSON, schemapb.DataType_Geometry, schemapb.DataType_SparseFloatVector:
+		return arrow.BinaryTypes.String, nil
+	case schemapb.DataType_Timestamptz:
+		return &arrow.TimestampType{Unit: arrow.Microsecond, TimeZone: "UTC"}, nil
+	case schemapb.DataType_FloatVector, schemapb.DataType_Float16Vector, schemapb.DataType_BFloat16Vector:
+		return arrow.ListOf(arrow.PrimitiveTypes.Float32), nil
+	case schemapb.DataType_BinaryVector:
+		return arrow.ListOf(arrow.PrimitiveTypes.Uint8), nil
+	case schemapb.DataType_Int8Vector:
+		return arrow.ListOf(arrow.PrimitiveTypes.Int8), nil
+	case schemapb.DataType_Array:
+		switch field.GetElementType() {
+		case schemapb.DataType_Bool:
+			return arrow.ListOf(arrow.FixedWidthTypes.Boolean), nil
+		case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
+			return arrow.ListOf(arrow.PrimitiveTypes.Int32), nil
+		case schemapb.DataType_Int64:
+			return arrow.ListOf(arrow.PrimitiveTypes.Int64), nil
+		case schemapb.DataType_Float:
+			return arrow.ListOf(arrow.PrimitiveTypes.Float32), nil
+		case schemapb.DataType_Double:
+			return arrow.ListOf(arrow.PrimitiveTypes.Float64), nil
+		case schemapb.DataType_String, schemapb.DataType_VarChar:
+			return arrow.ListOf(arrow.BinaryTypes.String), nil
+		}
+	}
+	return nil, merr.WrapErrParameterInvalidMsg("export does not support field '%s' with data type %s",
+		field.GetName(), field.GetDataType().String())
+}
+
+func exportArrowSchema(fields []*schemapb.FieldSchema) (*arrow.Schema, error) {
+	arrowFields := make([]arrow.Field, 0, len(fields))
+	for _, field := range fields {
+		dataType, err := exportArrowType(field)
+		if err != nil {
+			return nil, err
+		}
+		arrowFields = append(arrowFields, arrow.Field{
+			Name:     field.GetName(),
+			Type:     dataType,
+			Nullable: true,
+		})
+	}
+	return arrow.NewSchema(arrowFields, nil), nil
+}
+
+// appendArrowRows appends all the rows of insertData into record builder.
+func appendArrowRows(builder *array.RecordBuilder, fields []*schemapb.FieldSchema, data *storage.InsertData) error {
+	rowNum := data.GetRowNum()
+	for i, field := range fields {
+		fieldData, ok := data.Data[field.GetFieldID()]
+		if !ok {
+			return merr.WrapErrFieldNotFound(field.GetName())
+		}
+		fb := builder.Field(i)
+		for row := 0; row < rowNum; row++ {
+			raw := fieldData.GetRow(row)
+			if tb, ok := fb.(*array.TimestampBuilder); ok && raw != nil {
+				tb.Append(arrow.Timestamp(raw.(int64)))
+				continue
+			}
+			value, err := toExportValue(field, raw)
+			if err != nil {
+				return err
+			}
+			if err := appendArrowValue(fb, field, value); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func appendArrowValue(fb array.Builder, field *schemapb.FieldSchema, value any) error {
+	if value == nil {
+		fb.AppendNull()
+		return nil
+	}
+	switch b := fb.(type) {
+	case *array.BooleanBuilder:
+		b.Append(value.(bool))
+	case *array.Int8Builder:
+		b.Append(value.(int8))
+	case *array.Int16Builder:
+		b.Append(value.(int16))
+	case *array.Int32Builder:
+		b.Append(value.(int32))
+	case *array.Int64Builder:
+		b.Append(value.(int64))
+	case *array.Float32Builder:
+		b.Append(value.(float32))
+	case *array.Float64Builder:
+		b.Append(value.(float64))
+	case *array.StringBuilder:
+		switch v := value.(type) {
+		case string:
+			b.Append(v)
+		case json.RawMessage:
+			b.Append(string(v))
+		default:
+			bs, err := json.Marshal(v)
+			if err != nil {
+				return err
+			}
+			b.Append(string(bs))
+		}
+	case *array.ListBuilder:
+		b.Append(true)
+		switch vb := b.ValueBuilder().(type) {
+		case *array.Float32Builder:
+			vb.AppendValues(value.([]float32), nil)
+		case *array.Float64Builder:
+			vb.AppendValues(value.([]float64), nil)
+		case *array.Int8Builder:
+			vb.AppendValues(value.([]int8), nil)
+		case *array.Int32Builder:
+			vb.AppendValues(value.([]int32), nil)
+		case *array.Int64Builder:
+			vb.AppendValues(value.([]int64), nil)
+		case *array.Uint8Builder:
+			for _, v := range value.([]int) {
+				vb.Append(uint8(v))
+			}
+		case *array.BooleanBuilder:
+			vb.AppendValues(value.([]bool), nil)
+		case *array.StringBuilder:
+			vb.AppendValues(value.([]string), nil)
+		default:
+			return merr.WrapErrServiceInternalMsg("unexpected list builder %T for field '%s'", vb, field.GetName())
+		}
+	default:
+		return merr.WrapErrServiceInternalMsg("unexpected arrow builder %T for field '%s'", fb, field.GetName())
+	}
+	return nil
+}
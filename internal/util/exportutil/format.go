@@ -0,0 +1,58 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exportutil
+
+import (
+	"strings"
+
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+)
+
+// Format is the file format of exported rows.
+type Format string
+
+const (
+	// Parquet writes one row group per exported batch.
+	Parquet Format = "parquet"
+	// JSONL writes one JSON object per line, the layout is the same as
+	// the JSONLines import format so that exported files could be re-imported.
+	JSONL Format = "jsonl"
+)
+
+// ParseFormat parses the user provided format name, case-insensitive.
+func ParseFormat(s string) (Format, error) {
+	switch Format(strings.ToLower(strings.TrimSpace(s))) {
+	case Parquet, "":
+		return Parquet, nil
+	case JSONL, "jsonlines":
+		return JSONL, nil
+	default:
+		return "", merr.WrapErrParameterInvalidMsg("unsupported export format '%s', expected '%s' or '%s'", s, Parquet, JSONL)
+	}
+}
+
+// Suffix returns the file extension of the format.
+func (f Format) Suffix() string {
+	switch f {
+	case Parquet:
+		return ".parquet"
+	case JSONL:
+		return ".jsonl"
+	default:
+		return ""
+	}
+}
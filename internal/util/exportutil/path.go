@@ -0,0 +1,82 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exportutil
+
+import (
+	"path"
+	"strings"
+
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+)
+
+// ValidateRootPath checks the dedicated root path of exported files. When the files
+// are written into the bucket of Milvus data, the root path must not overlap with
+// the root path of Milvus, otherwise export jobs could overwrite the binlogs.
+func ValidateRootPath(rootPath, milvusRootPath string, sameBucket bool) error {
+	if strings.TrimSpace(rootPath) == "" {
+		return merr.WrapErrOperationNotSupportedMsg("export is disabled, the export root path is not configured")
+	}
+	if hasParentRef(rootPath) {
+		return merr.WrapErrParameterInvalidMsg("export root path '%s' must not contain '..'", rootPath)
+	}
+	if !sameBucket {
+		return nil
+	}
+	if Overlaps(rootPath, milvusRootPath) {
+		return merr.WrapErrParameterInvalidMsg("export root path '%s' overlaps with the root path '%s' of Milvus, "+
+			"use a dedicated root path or bucket for export", rootPath, milvusRootPath)
+	}
+	return nil
+}
+
+// JoinPath returns the directory of exported files of a job, relPath is relative to
+// the export root path, absolute paths and parent references are rejected so that
+// a job cannot escape from the export root path.
+func JoinPath(rootPath, relPath string) (string, error) {
+	if path.IsAbs(relPath) || strings.HasPrefix(relPath, "\\") {
+		return "", merr.WrapErrParameterInvalidMsg("export path '%s' must be relative to the export root path", relPath)
+	}
+	if hasParentRef(relPath) {
+		return "", merr.WrapErrParameterInvalidMsg("export path '%s' must not contain '..'", relPath)
+	}
+	cleaned := path.Clean(relPath)
+	if cleaned == "." {
+		return "", merr.WrapErrParameterInvalidMsg("export path '%s' is empty", relPath)
+	}
+	return path.Join(rootPath, cleaned), nil
+}
+
+// Overlaps returns true if the two paths are the same or one is nested in the other,
+// an empty path is the root of the bucket so it overlaps with any path.
+func Overlaps(a, b string) bool {
+	a, b = normalizePath(a), normalizePath(b)
+	return a == "" || b == "" || a == b ||
+		strings.HasPrefix(a, b+"/") || strings.HasPrefix(b, a+"/")
+}
+
+func hasParentRef(p string) bool {
+	for _, elem := range strings.FieldsFunc(p, func(r rune) bool { return r == '/' || r == '\\' }) {
+		if elem == ".." {
+			return true
+		}
+	}
+	return false
+}
+
+func normalizePath(p string) string {
+	return strings.Trim(path.Clean("/"+strings.TrimSpace(p)), "/")
+}
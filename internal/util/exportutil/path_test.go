@@ -0,0 +1,75 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exportutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateRootPath(t *testing.T) {
+	cases := []struct {
+		root       string
+		milvusRoot string
+		sameBucket bool
+		ok         bool
+	}{
+		{"", "files", true, false},
+		{"  ", "files", false, false},
+		{"export", "files", true, true},
+		{"/export/", "files", true, true},
+		{"files-export", "files", true, true},
+		{"files", "files", true, false},
+		{"/files/", "files", true, false},
+		{"files/export", "files", true, false},
+		{"data", "data/files", true, false},
+		{"/", "files", true, false},
+		{"export", "", true, false},
+		{"export/../files", "files", true, false},
+		{"files", "files", false, true},
+		{"export/..", "files", false, false},
+	}
+	for _, c := range cases {
+		err := ValidateRootPath(c.root, c.milvusRoot, c.sameBucket)
+		assert.Equal(t, c.ok, err == nil, "root=%s milvusRoot=%s sameBucket=%v err=%v", c.root, c.milvusRoot, c.sameBucket, err)
+	}
+}
+
+func TestJoinPath(t *testing.T) {
+	dir, err := JoinPath("export", "spark/daily")
+	assert.NoError(t, err)
+	assert.Equal(t, "export/spark/daily", dir)
+
+	dir, err = JoinPath("export", "./spark//daily/")
+	assert.NoError(t, err)
+	assert.Equal(t, "export/spark/daily", dir)
+
+	for _, relPath := range []string{"", ".", "/files", "\\files", "..", "../files", "spark/../../files", "spark\\..\\files"} {
+		_, err = JoinPath("export", relPath)
+		assert.Error(t, err, relPath)
+	}
+}
+
+func TestOverlaps(t *testing.T) {
+	assert.True(t, Overlaps("export/a", "export/a/"))
+	assert.True(t, Overlaps("export/a", "export/a/b"))
+	assert.True(t, Overlaps("/export/a/b", "export/a"))
+	assert.True(t, Overlaps("", "export"))
+	assert.False(t, Overlaps("export/a", "export/ab"))
+	assert.False(t, Overlaps("export/a", "export/b"))
+}
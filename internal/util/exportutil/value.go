@@ -0,0 +1,121 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exportutil
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/schemapb"
+	"github.com/milvus-io/milvus/pkg/v3/common"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+	"github.com/milvus-io/milvus/pkg/v3/util/typeutil"
+)
+
+// toExportValue converts a row value of storage.FieldData into a value which could
+// be encoded by encoding/json, and is accepted by the JSON import reader.
+func toExportValue(field *schemapb.FieldSchema, value any) (any, error) {
+	if value == nil {
+		return nil, nil
+	}
+	switch field.GetDataType() {
+	case schemapb.DataType_Bool, schemapb.DataType_Int8, schemapb.DataType_Int16,
+		schemapb.DataType_Int32, schemapb.DataType_Int64, schemapb.DataType_Float,
+		schemapb.DataType_Double, schemapb.DataType_String, schemapb.DataType_VarChar,
+		schemapb.DataType_Text, schemapb.DataType_FloatVector, schemapb.DataType_Int8Vector:
+		return value, nil
+	case schemapb.DataType_JSON:
+		return json.RawMessage(value.([]byte)), nil
+	case schemapb.DataType_Geometry:
+		return common.ConvertWKBToWKT(value.([]byte))
+	case schemapb.DataType_Timestamptz:
+		return formatTimestamptz(value.(int64)), nil
+	case schemapb.DataType_BinaryVector:
+		bs := value.([]byte)
+		vec := make([]int, len(bs))
+		for i, b := range bs {
+			vec[i] = int(b)
+		}
+		return vec, nil
+	case schemapb.DataType_Float16Vector:
+		return typeutil.Float16BytesToFloat32Vector(value.([]byte)), nil
+	case schemapb.DataType_BFloat16Vector:
+		return typeutil.BFloat16BytesToFloat32Vector(value.([]byte)), nil
+	case schemapb.DataType_SparseFloatVector:
+		return sparseRowToMap(value.([]byte)), nil
+	case schemapb.DataType_Array:
+		return scalarFieldToSlice(field, value.(*schemapb.ScalarField))
+	default:
+		return nil, merr.WrapErrParameterInvalidMsg("export does not support field '%s' with data type %s",
+			field.GetName(), field.GetDataType().String())
+	}
+}
+
+func formatTimestamptz(us int64) string {
+	return time.UnixMicro(us).UTC().Format(time.RFC3339Nano)
+}
+
+// sparseRowToMap renders a sparse row as `{"index": value}`.
+func sparseRowToMap(row []byte) map[string]float32 {
+	n := typeutil.SparseFloatRowElementCount(row)
+	m := make(map[string]float32, n)
+	for i := 0; i < n; i++ {
+		m[strconv.FormatUint(uint64(typeutil.SparseFloatRowIndexAt(row, i)), 10)] = typeutil.SparseFloatRowValueAt(row, i)
+	}
+	return m
+}
+
+func scalarFieldToSlice(field *schemapb.FieldSchema, sf *schemapb.ScalarField) (any, error) {
+	switch field.GetElementType() {
+	case schemapb.DataType_Bool:
+		return sf.GetBoolData().GetData(), nil
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
+		return sf.GetIntData().GetData(), nil
+	case schemapb.DataType_Int64:
+		return sf.GetLongData().GetData(), nil
+	case schemapb.DataType_Float:
+		return sf.GetFloatData().GetData(), nil
+	case schemapb.DataType_Double:
+		return sf.GetDoubleData().GetData(), nil
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		return sf.GetStringData().GetData(), nil
+	default:
+		return nil, merr.WrapErrParameterInvalidMsg("export does not support array field '%s' with element type %s",
+			field.GetName(), field.GetElementType().String())
+	}
+}
+
+// checkExportable checks whether the field could be exported.
+func checkExportable(field *schemapb.FieldSchema) error {
+	switch field.GetDataType() {
+	case schemapb.DataType_Bool, schemapb.DataType_Int8, schemapb.DataType_Int16,
+		schemapb.DataType_Int32, schemapb.DataType_Int64, schemapb.DataType_Float,
+		schemapb.DataType_Double, schemapb.DataType_String, schemapb.DataType_VarChar,
+		schemapb.DataType_Text, schemapb.DataType_JSON, schemapb.DataType_Geometry,
+		schemapb.DataType_Timestamptz, schemapb.DataType_FloatVector, schemapb.DataType_BinaryVector,
+		schemapb.DataType_Float16Vector, schemapb.DataType_BFloat16Vector,
+		schemapb.DataType_SparseFloatVector, schemapb.DataType_Int8Vector:
+		return nil
+	case schemapb.DataType_Array:
+		_, err := scalarFieldToSlice(field, &schemapb.ScalarField{})
+		return err
+	default:
+		return merr.WrapErrParameterInvalidMsg("export does not support field '%s' with data type %s",
+			field.GetName(), field.GetDataType().String())
+	}
+}
@@ -0,0 +1,307 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exportutil
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+
+	"github.com/apache/arrow/go/v17/arrow"
+	"github.com/apache/arrow/go/v17/arrow/array"
+	"github.com/apache/arrow/go/v17/arrow/memory"
+	"github.com/apache/arrow/go/v17/parquet"
+	"github.com/apache/arrow/go/v17/parquet/compress"
+	"github.com/apache/arrow/go/v17/parquet/pqarrow"
+	"github.com/samber/lo"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v3/msgpb"
+	"github.com/milvus-io/milvus-proto/go-api/v3/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/v3/common"
+	"github.com/milvus-io/milvus/pkg/v3/mq/msgstream"
+	"github.com/milvus-io/milvus/pkg/v3/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+	"github.com/milvus-io/milvus/pkg/v3/util/typeutil"
+)
+
+// Writer writes column-based rows, such as query results, into files of object storage.
+type Writer interface {
+	// Write appends a batch of rows, each FieldData shall carry the field id.
+	Write(ctx context.Context, fieldsData []*schemapb.FieldData) error
+	// Close flushes the buffered rows and returns all the written file paths.
+	Close(ctx context.Context) ([]string, error)
+	// RowCount returns the number of rows written so far.
+	RowCount() int64
+}
+
+// encoder serializes rows of one file.
+type encoder interface {
+	encode(data *storage.InsertData) error
+	size() int64
+	finish() ([]byte, error)
+}
+
+type writer struct {
+	cm          storage.ChunkManager
+	schema      *schemapb.CollectionSchema
+	fields      []*schemapb.FieldSchema
+	format      Format
+	dir         string
+	maxFileSize int64
+
+	newEncoder func() (encoder, error)
+	current    encoder
+	rowCount   int64
+	files      []string
+}
+
+// NewWriter creates a Writer which writes the output fields of collection into dir,
+// a new file is started once the encoded size reaches maxFileSize.
+func NewWriter(cm storage.ChunkManager, schema *schemapb.CollectionSchema, outputFields []string,
+	format Format, dir string, maxFileSize int64,
+) (Writer, error) {
+	fields, err := resolveFields(schema, outputFields)
+	if err != nil {
+		return nil, err
+	}
+	if maxFileSize <= 0 {
+		return nil, merr.WrapErrParameterInvalidMsg("invalid export file size %d", maxFileSize)
+	}
+
+	w := &writer{
+		cm:          cm,
+		schema:      &schemapb.CollectionSchema{Name: schema.GetName(), Fields: fields},
+		fields:      fields,
+		format:      format,
+		dir:         dir,
+		maxFileSize: maxFileSize,
+	}
+	switch format {
+	case Parquet:
+		arrowSchema, err := exportArrowSchema(fields)
+		if err != nil {
+			return nil, err
+		}
+		w.newEncoder = func() (encoder, error) { return newParquetEncoder(arrowSchema, fields) }
+	case JSONL:
+		w.newEncoder = func() (encoder, error) { return &jsonlEncoder{fields: fields}, nil }
+	default:
+		return nil, merr.WrapErrParameterInvalidMsg("unsupported export format '%s'", format)
+	}
+	return w, nil
+}
+
+// resolveFields returns the exported fields in schema order, all the user fields
+// are exported if outputFields is empty.
+func resolveFields(schema *schemapb.CollectionSchema, outputFields []string) ([]*schemapb.FieldSchema, error) {
+	for _, structField := range schema.GetStructArrayFields() {
+		if lo.Contains(outputFields, structField.GetName()) {
+			return nil, merr.WrapErrParameterInvalidMsg("export does not support struct array field '%s'", structField.GetName())
+		}
+	}
+
+	name2Field := make(map[string]*schemapb.FieldSchema)
+	for _, field := range schema.GetFields() {
+		name2Field[field.GetName()] = field
+	}
+	for _, name := range outputFields {
+		if _, ok := name2Field[name]; !ok {
+			return nil, merr.WrapErrFieldNotFound(name, "output field not found in collection schema")
+		}
+	}
+
+	fields := make([]*schemapb.FieldSchema, 0, len(schema.GetFields()))
+	for _, field := range schema.GetFields() {
+		if common.IsSystemField(field.GetFieldID()) {
+			continue
+		}
+		if len(outputFields) > 0 && !lo.Contains(outputFields, field.GetName()) {
+			continue
+		}
+		if err := checkExportable(field); err != nil {
+			return nil, err
+		}
+		fields = append(fields, field)
+	}
+	if len(fields) == 0 {
+		return nil, merr.WrapErrParameterInvalidMsg("no field to export")
+	}
+	return fields, nil
+}
+
+func (w *writer) Write(ctx context.Context, fieldsData []*schemapb.FieldData) error {
+	data, err := w.toInsertData(fieldsData)
+	if err != nil {
+		return err
+	}
+	if data.GetRowNum() == 0 {
+		return nil
+	}
+	if w.current == nil {
+		if w.current, err = w.newEncoder(); err != nil {
+			return err
+		}
+	}
+	if err := w.current.encode(data); err != nil {
+		return err
+	}
+	w.rowCount += int64(data.GetRowNum())
+	if w.current.size() >= w.maxFileSize {
+		return w.flush(ctx)
+	}
+	return nil
+}
+
+func (w *writer) Close(ctx context.Context) ([]string, error) {
+	if err := w.flush(ctx); err != nil {
+		return nil, err
+	}
+	return w.files, nil
+}
+
+func (w *writer) RowCount() int64 {
+	return w.rowCount
+}
+
+func (w *writer) flush(ctx context.Context) error {
+	if w.current == nil {
+		return nil
+	}
+	content, err := w.current.finish()
+	if err != nil {
+		return err
+	}
+	filePath := path.Join(w.dir, fmt.Sprintf("%d%s", len(w.files)+1, w.format.Suffix()))
+	if err := w.cm.Write(ctx, filePath, content); err != nil {
+		return merr.Wrapf(err, "failed to write export file %s", filePath)
+	}
+	w.files = append(w.files, filePath)
+	w.current = nil
+	return nil
+}
+
+// toInsertData converts column-based rows into InsertData of exported fields.
+func (w *writer) toInsertData(fieldsData []*schemapb.FieldData) (*storage.InsertData, error) {
+	exported := make([]*schemapb.FieldData, 0, len(w.fields))
+	fieldIDs := typeutil.NewSet(lo.Map(w.fields, func(field *schemapb.FieldSchema, _ int) int64 {
+		return field.GetFieldID()
+	})...)
+	for _, fd := range fieldsData {
+		if fieldIDs.Contain(fd.GetFieldId()) {
+			exported = append(exported, fd)
+		}
+	}
+	if len(exported) != len(w.fields) {
+		return nil, merr.WrapErrServiceInternalMsg("export expects %d fields, got %d", len(w.fields), len(exported))
+	}
+	numRows, err := funcutil.GetNumRowOfFieldData(exported[0])
+	if err != nil {
+		return nil, err
+	}
+	msg := &msgstream.InsertMsg{
+		InsertRequest: &msgpb.InsertRequest{
+			Base:       &commonpb.MsgBase{MsgType: commonpb.MsgType_Insert},
+			FieldsData: exported,
+			NumRows:    numRows,
+			Version:    msgpb.InsertDataVersion_ColumnBased,
+		},
+	}
+	return storage.ColumnBasedInsertMsgToInsertData(msg, w.schema)
+}
+
+type jsonlEncoder struct {
+	fields []*schemapb.FieldSchema
+	buf    bytes.Buffer
+}
+
+func (e *jsonlEncoder) encode(data *storage.InsertData) error {
+	for i := 0; i < data.GetRowNum(); i++ {
+		row := make(map[string]any, len(e.fields))
+		for _, field := range e.fields {
+			value, err := toExportValue(field, data.Data[field.GetFieldID()].GetRow(i))
+			if err != nil {
+				return err
+			}
+			row[field.GetName()] = value
+		}
+		bs, err := json.Marshal(row)
+		if err != nil {
+			return err
+		}
+		e.buf.Write(bs)
+		e.buf.WriteByte('\n')
+	}
+	return nil
+}
+
+func (e *jsonlEncoder) size() int64 {
+	return int64(e.buf.Len())
+}
+
+func (e *jsonlEncoder) finish() ([]byte, error) {
+	return e.buf.Bytes(), nil
+}
+
+type parquetEncoder struct {
+	schema *arrow.Schema
+	fields []*schemapb.FieldSchema
+	buf    *bytes.Buffer
+	fw     *pqarrow.FileWriter
+}
+
+func newParquetEncoder(schema *arrow.Schema, fields []*schemapb.FieldSchema) (*parquetEncoder, error) {
+	buf := &bytes.Buffer{}
+	fw, err := pqarrow.NewFileWriter(schema, buf,
+		parquet.NewWriterProperties(parquet.WithCompression(compress.Codecs.Zstd)),
+		pqarrow.DefaultWriterProps())
+	if err != nil {
+		return nil, err
+	}
+	return &parquetEncoder{
+		schema: schema,
+		fields: fields,
+		buf:    buf,
+		fw:     fw,
+	}, nil
+}
+
+// encode writes one row group for each batch.
+func (e *parquetEncoder) encode(data *storage.InsertData) error {
+	builder := array.NewRecordBuilder(memory.DefaultAllocator, e.schema)
+	defer builder.Release()
+	if err := appendArrowRows(builder, e.fields, data); err != nil {
+		return err
+	}
+	rec := builder.NewRecord()
+	defer rec.Release()
+	return e.fw.Write(rec)
+}
+
+func (e *parquetEncoder) size() int64 {
+	return int64(e.buf.Len())
+}
+
+func (e *parquetEncoder) finish() ([]byte, error) {
+	if err := e.fw.Close(); err != nil {
+		return nil, err
+	}
+	return e.buf.Bytes(), nil
+}
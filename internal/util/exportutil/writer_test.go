@@ -0,0 +1,186 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exportutil
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/apache/arrow/go/v17/arrow/array"
+	"github.com/apache/arrow/go/v17/arrow/memory"
+	"github.com/apache/arrow/go/v17/parquet/file"
+	"github.com/apache/arrow/go/v17/parquet/pqarrow"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v3/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/v3/common"
+	"github.com/milvus-io/milvus/pkg/v3/objectstorage"
+)
+
+type WriterSuite struct {
+	suite.Suite
+
+	cm     storage.ChunkManager
+	schema *schemapb.CollectionSchema
+}
+
+func (s *WriterSuite) SetupTest() {
+	s.cm = storage.NewLocalChunkManager(objectstorage.RootPath(s.T().TempDir()))
+	s.schema = &schemapb.CollectionSchema{
+		Name: "test_export",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: common.RowIDField, Name: common.RowIDFieldName, DataType: schemapb.DataType_Int64},
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "name", DataType: schemapb.DataType_VarChar, TypeParams: []*commonpb.KeyValuePair{{Key: common.MaxLengthKey, Value: "64"}}},
+			{FieldID: 102, Name: "vec", DataType: schemapb.DataType_FloatVector, TypeParams: []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "2"}}},
+			{FieldID: 103, Name: "meta", DataType: schemapb.DataType_JSON},
+		},
+	}
+}
+
+func (s *WriterSuite) genFieldsData(start, num int) []*schemapb.FieldData {
+	pks := make([]int64, 0, num)
+	names := make([]string, 0, num)
+	vecs := make([]float32, 0, num*2)
+	metas := make([][]byte, 0, num)
+	for i := start; i < start+num; i++ {
+		pks = append(pks, int64(i))
+		names = append(names, fmt.Sprintf("name_%d", i))
+		vecs = append(vecs, float32(i), float32(i)+0.5)
+		metas = append(metas, []byte(fmt.Sprintf(`{"k":%d}`, i)))
+	}
+	return []*schemapb.FieldData{
+		{
+			FieldId: 100, FieldName: "pk", Type: schemapb.DataType_Int64,
+			Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: pks}},
+			}},
+		},
+		{
+			FieldId: 101, FieldName: "name", Type: schemapb.DataType_VarChar,
+			Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: names}},
+			}},
+		},
+		{
+			FieldId: 102, FieldName: "vec", Type: schemapb.DataType_FloatVector,
+			Field: &schemapb.FieldData_Vectors{Vectors: &schemapb.VectorField{
+				Dim:  2,
+				Data: &schemapb.VectorField_FloatVector{FloatVector: &schemapb.FloatArray{Data: vecs}},
+			}},
+		},
+		{
+			FieldId: 103, FieldName: "meta", Type: schemapb.DataType_JSON,
+			Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_JsonData{JsonData: &schemapb.JSONArray{Data: metas}},
+			}},
+		},
+	}
+}
+
+func (s *WriterSuite) TestJSONL() {
+	ctx := context.Background()
+	dir := s.T().TempDir()
+	w, err := NewWriter(s.cm, s.schema, []string{"pk", "name", "meta"}, JSONL, dir, 1024*1024)
+	s.NoError(err)
+	s.NoError(w.Write(ctx, s.genFieldsData(0, 10)))
+	s.NoError(w.Write(ctx, s.genFieldsData(10, 5)))
+	s.Equal(int64(15), w.RowCount())
+
+	files, err := w.Close(ctx)
+	s.NoError(err)
+	s.Len(files, 1)
+
+	content, err := s.cm.Read(ctx, files[0])
+	s.NoError(err)
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	count := 0
+	for scanner.Scan() {
+		row := make(map[string]any)
+		s.NoError(json.Unmarshal(scanner.Bytes(), &row))
+		s.Equal(float64(count), row["pk"])
+		s.Equal(fmt.Sprintf("name_%d", count), row["name"])
+		s.Equal(map[string]any{"k": float64(count)}, row["meta"])
+		s.NotContains(row, "vec")
+		count++
+	}
+	s.Equal(15, count)
+}
+
+func (s *WriterSuite) TestParquetRolling() {
+	ctx := context.Background()
+	dir := s.T().TempDir()
+	w, err := NewWriter(s.cm, s.schema, nil, Parquet, dir, 1)
+	s.NoError(err)
+	s.NoError(w.Write(ctx, s.genFieldsData(0, 10)))
+	s.NoError(w.Write(ctx, s.genFieldsData(10, 20)))
+
+	files, err := w.Close(ctx)
+	s.NoError(err)
+	s.Len(files, 2)
+
+	total := int64(0)
+	for _, filePath := range files {
+		content, err := s.cm.Read(ctx, filePath)
+		s.NoError(err)
+		reader, err := file.NewParquetReader(bytes.NewReader(content))
+		s.NoError(err)
+		fr, err := pqarrow.NewFileReader(reader, pqarrow.ArrowReadProperties{BatchSize: 1024}, memory.DefaultAllocator)
+		s.NoError(err)
+		table, err := fr.ReadTable(ctx)
+		s.NoError(err)
+		s.Equal(4, int(table.NumCols()))
+		s.Equal("vec", table.Schema().Field(2).Name)
+		vecs := table.Column(2).Data().Chunk(0).(*array.List)
+		values := vecs.ListValues().(*array.Float32)
+		s.Equal(float32(total), values.Value(0))
+		total += table.NumRows()
+		table.Release()
+	}
+	s.Equal(int64(30), total)
+}
+
+func (s *WriterSuite) TestInvalid() {
+	_, err := NewWriter(s.cm, s.schema, []string{"not_exist"}, JSONL, "dir", 1024)
+	s.Error(err)
+
+	_, err = NewWriter(s.cm, s.schema, nil, Format("csv"), "dir", 1024)
+	s.Error(err)
+
+	_, err = NewWriter(s.cm, s.schema, nil, JSONL, "dir", 0)
+	s.Error(err)
+
+	_, err = ParseFormat("csv")
+	s.Error(err)
+	format, err := ParseFormat("JSONLines")
+	s.NoError(err)
+	s.Equal(JSONL, format)
+
+	w, err := NewWriter(s.cm, s.schema, []string{"pk", "name"}, JSONL, "dir", 1024)
+	s.NoError(err)
+	s.Error(w.Write(context.Background(), s.genFieldsData(0, 1)[:1]))
+}
+
+func TestWriter(t *testing.T) {
+	suite.Run(t, new(WriterSuite))
+}
@@ -0,0 +1,212 @@
+/*
+ * # Licensed to the LF AI & Data foundation under one
+ * # or more contributor license agreements. See the NOTICE file
+ * # distributed with this work for additional information
+ * # regarding copyright ownership. The ASF licenses this file
+ * # to you under the Apache License, Version 2.0 (the
+ * # "License"); you may not use this file except in compliance
+ * # with the License. You may obtain a copy of the License at
+ * #
+ * #     http://www.apache.org/licenses/LICENSE-2.0
+ * #
+ * # Unless required by applicable law or agreed to in writing, software
+ * # distributed under the License is distributed on an "AS IS" BASIS,
+ * # WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * # See the License for the specific language governing permissions and
+ * # limitations under the License.
+ */
+
+package embedding
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/golang-lru/v2/expirable"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v3/schemapb"
+	"github.com/milvus-io/milvus/internal/util/function/models"
+	"github.com/milvus-io/milvus/pkg/v3/metrics"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v3/util/typeutil"
+)
+
+// cacheIgnoredParams never change the produced vectors, they are left out of the
+// cache key so that the functions only differing in them share the cached embeddings.
+var cacheIgnoredParams = typeutil.NewSet(
+	models.CredentialParamKey,
+	models.IntegrationIDKey,
+	models.TimeoutMsParamKey,
+	models.MaxClientBatchSizeParamKey,
+	models.EnableCacheParamKey,
+)
+
+type embeddingCacheKey struct {
+	provider string
+	// model is the digest of the function params which affect the produced vectors,
+	// e.g. the model name, prompts and endpoint
+	model    string
+	dataType schemapb.DataType
+	dim      int64
+	text     string
+}
+
+// embeddingCache is shared by all the text embedding functions of a node, the
+// values are []float32 or []int8 embeddings.
+type embeddingCache = expirable.LRU[embeddingCacheKey, any]
+
+var (
+	globalEmbeddingCache     *embeddingCache
+	globalEmbeddingCacheOnce sync.Once
+)
+
+// getEmbeddingCache returns the shared embedding cache, or nil if it is disabled.
+func getEmbeddingCache() *embeddingCache {
+	globalEmbeddingCacheOnce.Do(func() {
+		capacity := paramtable.Get().FunctionCfg.TextEmbeddingCacheCapacity.GetAsInt()
+		if capacity <= 0 {
+			return
+		}
+		ttl := paramtable.Get().FunctionCfg.TextEmbeddingCacheTTL.GetAsDurationByParse()
+		globalEmbeddingCache = expirable.NewLRU[embeddingCacheKey, any](capacity, nil, ttl)
+	})
+	return globalEmbeddingCache
+}
+
+func isEmbeddingCacheEnabled(functionSchema *schemapb.FunctionSchema) (bool, error) {
+	for _, param := range functionSchema.Params {
+		if strings.ToLower(param.Key) != models.EnableCacheParamKey {
+			continue
+		}
+		enable, err := strconv.ParseBool(param.Value)
+		if err != nil {
+			return false, merr.WrapErrParameterInvalidMsg("[%s param's value: %s] is invalid, only supports: [true/false]", models.EnableCacheParamKey, param.Value)
+		}
+		return enable, nil
+	}
+	return false, nil
+}
+
+func cacheModelKey(params []*commonpb.KeyValuePair) string {
+	kvs := make([]string, 0, len(params))
+	for _, param := range params {
+		key := strings.ToLower(param.Key)
+		if cacheIgnoredParams.Contain(key) {
+			continue
+		}
+		kvs = append(kvs, key+"="+param.Value)
+	}
+	sort.Strings(kvs)
+	sum := sha256.Sum256([]byte(strings.Join(kvs, "\n")))
+	return hex.EncodeToString(sum[:])
+}
+
+// normalizeCacheText makes the texts which only differ in unicode composition or
+// whitespaces share the same cache entry.
+func normalizeCacheText(text string) string {
+	return strings.Join(strings.Fields(norm.NFC.String(text)), " ")
+}
+
+// cachedEmbeddingProvider caches the embeddings of the search texts, which are often
+// repeated, the embeddings of the inserted data are rarely reused and not cached.
+type cachedEmbeddingProvider struct {
+	textEmbeddingProvider
+
+	cache    *embeddingCache
+	provider string
+	model    string
+	dataType schemapb.DataType
+
+	hitCounter  prometheus.Counter
+	missCounter prometheus.Counter
+}
+
+func newCachedEmbeddingProvider(embP textEmbeddingProvider, cache *embeddingCache, base *FunctionBase) *cachedEmbeddingProvider {
+	nodeID := strconv.FormatInt(paramtable.GetNodeID(), 10)
+	return &cachedEmbeddingProvider{
+		textEmbeddingProvider: embP,
+		cache:                 cache,
+		provider:              base.provider,
+		model:                 cacheModelKey(base.schema.GetParams()),
+		dataType:              base.outputFields[0].GetDataType(),
+		hitCounter:            metrics.ProxyFunctionEmbeddingCacheCount.WithLabelValues(nodeID, base.collectionName, base.provider, base.functionName, metrics.CacheHitLabel),
+		missCounter:           metrics.ProxyFunctionEmbeddingCacheCount.WithLabelValues(nodeID, base.collectionName, base.provider, base.functionName, metrics.CacheMissLabel),
+	}
+}
+
+func (p *cachedEmbeddingProvider) CallEmbedding(ctx context.Context, texts []string, mode models.TextEmbeddingMode) (any, error) {
+	if mode != models.SearchMode {
+		return p.textEmbeddingProvider.CallEmbedding(ctx, texts, mode)
+	}
+	if p.dataType == schemapb.DataType_Int8Vector {
+		return callEmbeddingWithCache[int8](ctx, p, texts)
+	}
+	return callEmbeddingWithCache[float32](ctx, p, texts)
+}
+
+func (p *cachedEmbeddingProvider) cacheKey(text string) embeddingCacheKey {
+	return embeddingCacheKey{
+		provider: p.provider,
+		model:    p.model,
+		dataType: p.dataType,
+		dim:      p.FieldDim(),
+		text:     normalizeCacheText(text),
+	}
+}
+
+// callEmbeddingWithCache only embeds the texts missing from the cache, each distinct
+// text once, and caches their embeddings.
+func callEmbeddingWithCache[T float32 | int8](ctx context.Context, p *cachedEmbeddingProvider, texts []string) ([][]T, error) {
+	embeddings := make([][]T, len(texts))
+	missKeys := make([]embeddingCacheKey, 0)
+	missTexts := make([]string, 0)
+	missRows := make(map[embeddingCacheKey][]int)
+	for i, text := range texts {
+		key := p.cacheKey(text)
+		if value, ok := p.cache.Get(key); ok {
+			if embedding, ok := value.([]T); ok {
+				embeddings[i] = embedding
+				continue
+			}
+		}
+		if _, ok := missRows[key]; !ok {
+			missKeys = append(missKeys, key)
+			missTexts = append(missTexts, text)
+		}
+		missRows[key] = append(missRows[key], i)
+	}
+	misses := 0
+	for _, rows := range missRows {
+		misses += len(rows)
+	}
+	p.hitCounter.Add(float64(len(texts) - misses))
+	p.missCounter.Add(float64(misses))
+	if len(missTexts) == 0 {
+		return embeddings, nil
+	}
+
+	output, err := p.textEmbeddingProvider.CallEmbedding(ctx, missTexts, models.SearchMode)
+	if err != nil {
+		return nil, err
+	}
+	computed, ok := output.([][]T)
+	if !ok || len(computed) != len(missTexts) {
+		return nil, merr.WrapErrFunctionFailedMsg("unexpected embeddings of %d search texts from provider [%s]", len(missTexts), p.provider)
+	}
+	for i, key := range missKeys {
+		p.cache.Add(key, computed[i])
+		for _, row := range missRows[key] {
+			embeddings[row] = computed[i]
+		}
+	}
+	return embeddings, nil
+}
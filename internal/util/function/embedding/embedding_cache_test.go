@@ -0,0 +1,220 @@
+/*
+ * # Licensed to the LF AI & Data foundation under one
+ * # or more contributor license agreements. See the NOTICE file
+ * # distributed with this work for additional information
+ * # regarding copyright ownership. The ASF licenses this file
+ * # to you under the Apache License, Version 2.0 (the
+ * # "License"); you may not use this file except in compliance
+ * # with the License. You may obtain a copy of the License at
+ * #
+ * #     http://www.apache.org/licenses/LICENSE-2.0
+ * #
+ * # Unless required by applicable law or agreed to in writing, software
+ * # distributed under the License is distributed on an "AS IS" BASIS,
+ * # WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * # See the License for the specific language governing permissions and
+ * # limitations under the License.
+ */
+
+package embedding
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/golang-lru/v2/expirable"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v3/schemapb"
+	"github.com/milvus-io/milvus/internal/util/function/models"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+func TestEmbeddingCache(t *testing.T) {
+	suite.Run(t, new(EmbeddingCacheSuite))
+}
+
+type EmbeddingCacheSuite struct {
+	suite.Suite
+	schema *schemapb.CollectionSchema
+}
+
+func (s *EmbeddingCacheSuite) SetupTest() {
+	paramtable.Init()
+	s.schema = &schemapb.CollectionSchema{
+		Name: "test",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "int64", DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "text", DataType: schemapb.DataType_VarChar},
+			{
+				FieldID: 102, Name: "vector", DataType: schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{
+					{Key: "dim", Value: "4"},
+				},
+			},
+			{
+				FieldID: 103, Name: "int8_vector", DataType: schemapb.DataType_Int8Vector,
+				TypeParams: []*commonpb.KeyValuePair{
+					{Key: "dim", Value: "4"},
+				},
+			},
+		},
+	}
+}
+
+// countingEmbeddingProvider embeds a text into [len(text), 0, 0, 0] and records the embedded texts
+type countingEmbeddingProvider struct {
+	int8     bool
+	embedded []string
+}
+
+func (p *countingEmbeddingProvider) MaxBatch() int {
+	return 32
+}
+
+func (p *countingEmbeddingProvider) FieldDim() int64 {
+	return 4
+}
+
+func (p *countingEmbeddingProvider) CallEmbedding(ctx context.Context, texts []string, mode models.TextEmbeddingMode) (any, error) {
+	p.embedded = append(p.embedded, texts...)
+	if p.int8 {
+		embeddings := make([][]int8, 0, len(texts))
+		for _, text := range texts {
+			embeddings = append(embeddings, []int8{int8(len(text)), 0, 0, 0})
+		}
+		return embeddings, nil
+	}
+	embeddings := make([][]float32, 0, len(texts))
+	for _, text := range texts {
+		embeddings = append(embeddings, []float32{float32(len(text)), 0, 0, 0})
+	}
+	return embeddings, nil
+}
+
+func (s *EmbeddingCacheSuite) newFunctionBase(outputField string, params ...*commonpb.KeyValuePair) *FunctionBase {
+	base, err := NewFunctionBase(s.schema, &schemapb.FunctionSchema{
+		Name:             "test",
+		Type:             schemapb.FunctionType_TextEmbedding,
+		InputFieldNames:  []string{"text"},
+		OutputFieldNames: []string{outputField},
+		Params:           append([]*commonpb.KeyValuePair{{Key: Provider, Value: openAIProvider}}, params...),
+	})
+	s.NoError(err)
+	return base
+}
+
+func (s *EmbeddingCacheSuite) TestCallEmbedding() {
+	cache := expirable.NewLRU[embeddingCacheKey, any](100, nil, time.Hour)
+	inner := &countingEmbeddingProvider{}
+	provider := newCachedEmbeddingProvider(inner, cache, s.newFunctionBase("vector", &commonpb.KeyValuePair{Key: models.ModelNameParamKey, Value: "m1"}))
+
+	r, err := provider.CallEmbedding(context.Background(), []string{"a", "bb", "a", " bb\t"}, models.SearchMode)
+	s.NoError(err)
+	s.Equal([][]float32{{1, 0, 0, 0}, {2, 0, 0, 0}, {1, 0, 0, 0}, {2, 0, 0, 0}}, r)
+	// the repeated and whitespace-only different texts are embedded once
+	s.Equal([]string{"a", "bb"}, inner.embedded)
+	s.Equal(2, cache.Len())
+
+	inner.embedded = nil
+	r, err = provider.CallEmbedding(context.Background(), []string{"bb", "ccc"}, models.SearchMode)
+	s.NoError(err)
+	s.Equal([][]float32{{2, 0, 0, 0}, {3, 0, 0, 0}}, r)
+	s.Equal([]string{"ccc"}, inner.embedded)
+
+	inner.embedded = nil
+	_, err = provider.CallEmbedding(context.Background(), []string{"a", "ccc"}, models.SearchMode)
+	s.NoError(err)
+	s.Empty(inner.embedded)
+
+	// the inserted data is not cached
+	_, err = provider.CallEmbedding(context.Background(), []string{"a", "dddd"}, models.InsertMode)
+	s.NoError(err)
+	s.Equal([]string{"a", "dddd"}, inner.embedded)
+	s.Equal(3, cache.Len())
+}
+
+func (s *EmbeddingCacheSuite) TestCacheKey() {
+	cache := expirable.NewLRU[embeddingCacheKey, any](100, nil, time.Hour)
+	newProvider := func(outputField string, params ...*commonpb.KeyValuePair) (*cachedEmbeddingProvider, *countingEmbeddingProvider) {
+		inner := &countingEmbeddingProvider{int8: outputField == "int8_vector"}
+		return newCachedEmbeddingProvider(inner, cache, s.newFunctionBase(outputField, params...)), inner
+	}
+
+	p1, _ := newProvider("vector", &commonpb.KeyValuePair{Key: models.ModelNameParamKey, Value: "m1"}, &commonpb.KeyValuePair{Key: models.CredentialParamKey, Value: "c1"})
+	_, err := p1.CallEmbedding(context.Background(), []string{"text"}, models.SearchMode)
+	s.NoError(err)
+
+	// only differ in the credential, share the entries
+	p2, inner2 := newProvider("vector", &commonpb.KeyValuePair{Key: models.CredentialParamKey, Value: "c2"}, &commonpb.KeyValuePair{Key: models.ModelNameParamKey, Value: "m1"})
+	_, err = p2.CallEmbedding(context.Background(), []string{"text"}, models.SearchMode)
+	s.NoError(err)
+	s.Empty(inner2.embedded)
+
+	// another model
+	p3, inner3 := newProvider("vector", &commonpb.KeyValuePair{Key: models.ModelNameParamKey, Value: "m2"})
+	_, err = p3.CallEmbedding(context.Background(), []string{"text"}, models.SearchMode)
+	s.NoError(err)
+	s.Equal([]string{"text"}, inner3.embedded)
+
+	// another vector type
+	p4, inner4 := newProvider("int8_vector", &commonpb.KeyValuePair{Key: models.ModelNameParamKey, Value: "m1"})
+	r, err := p4.CallEmbedding(context.Background(), []string{"text"}, models.SearchMode)
+	s.NoError(err)
+	s.Equal([][]int8{{4, 0, 0, 0}}, r)
+	s.Equal([]string{"text"}, inner4.embedded)
+}
+
+func (s *EmbeddingCacheSuite) TestCacheExpire() {
+	cache := expirable.NewLRU[embeddingCacheKey, any](100, nil, 10*time.Millisecond)
+	inner := &countingEmbeddingProvider{}
+	provider := newCachedEmbeddingProvider(inner, cache, s.newFunctionBase("vector"))
+
+	_, err := provider.CallEmbedding(context.Background(), []string{"text"}, models.SearchMode)
+	s.NoError(err)
+	s.Eventually(func() bool {
+		return cache.Len() == 0
+	}, time.Second, 10*time.Millisecond)
+	_, err = provider.CallEmbedding(context.Background(), []string{"text"}, models.SearchMode)
+	s.NoError(err)
+	s.Equal([]string{"text", "text"}, inner.embedded)
+}
+
+func (s *EmbeddingCacheSuite) TestEnableCache() {
+	functionSchema := &schemapb.FunctionSchema{
+		Name:             "test",
+		Type:             schemapb.FunctionType_TextEmbedding,
+		InputFieldNames:  []string{"text"},
+		OutputFieldNames: []string{"vector"},
+		InputFieldIds:    []int64{101},
+		OutputFieldIds:   []int64{102},
+		Params: []*commonpb.KeyValuePair{
+			{Key: Provider, Value: teiProvider},
+			{Key: models.EndpointParamKey, Value: "http://mymock.com"},
+		},
+	}
+	runner, err := NewTextEmbeddingFunction(s.schema, functionSchema, &models.ModelExtraInfo{})
+	s.NoError(err)
+	_, ok := runner.embProvider.(*cachedEmbeddingProvider)
+	s.False(ok)
+
+	functionSchema.Params = append(functionSchema.Params, &commonpb.KeyValuePair{Key: strings.ToUpper(models.EnableCacheParamKey), Value: "true"})
+	runner, err = NewTextEmbeddingFunction(s.schema, functionSchema, &models.ModelExtraInfo{})
+	s.NoError(err)
+	_, ok = runner.embProvider.(*cachedEmbeddingProvider)
+	s.True(ok)
+
+	functionSchema.Params[2].Value = "Invalid"
+	_, err = NewTextEmbeddingFunction(s.schema, functionSchema, &models.ModelExtraInfo{})
+	s.Error(err)
+}
+
+func (s *EmbeddingCacheSuite) TestNormalizeCacheText() {
+	s.Equal("hello world", normalizeCacheText("  hello \n\t world "))
+	// the decomposed "e\u0301" is composed
+	s.Equal("caf\u00e9", normalizeCacheText("cafe\u0301"))
+	s.Equal("Hello", normalizeCacheText("Hello"))
+}
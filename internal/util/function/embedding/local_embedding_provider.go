@@ -0,0 +1,162 @@
+/*
+ * # Licensed to the LF AI & Data foundation under one
+ * # or more contributor license agreements. See the NOTICE file
+ * # distributed with this work for additional information
+ * # regarding copyright ownership. The ASF licenses this file
+ * # to you under the Apache License, Version 2.0 (the
+ * # "License"); you may not use this file except in compliance
+ * # with the License. You may obtain a copy of the License at
+ * #
+ * #     http://www.apache.org/licenses/LICENSE-2.0
+ * #
+ * # Unless required by applicable law or agreed to in writing, software
+ * # distributed under the License is distributed on an "AS IS" BASIS,
+ * # WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * # See the License for the specific language governing permissions and
+ * # limitations under the License.
+ */
+
+package embedding
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/schemapb"
+	"github.com/milvus-io/milvus/internal/util/function/models"
+	"github.com/milvus-io/milvus/internal/util/function/models/local"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+	"github.com/milvus-io/milvus/pkg/v3/util/typeutil"
+)
+
+type localEmbedder interface {
+	Embed(texts []string) ([][]float32, error)
+}
+
+// LocalEmbeddingProvider embeds with a sentence-transformer model loaded from the file
+// resources and run in-process on CPU, so it needs no external endpoint.
+type LocalEmbeddingProvider struct {
+	fieldDim int64
+
+	embedder localEmbedder
+
+	ingestionPrompt string
+	searchPrompt    string
+
+	maxBatch  int
+	extraInfo *models.ModelExtraInfo
+}
+
+func NewLocalEmbeddingProvider(fieldSchema *schemapb.FieldSchema, functionSchema *schemapb.FunctionSchema, params map[string]string, extraInfo *models.ModelExtraInfo) (*LocalEmbeddingProvider, error) {
+	if fieldSchema.GetDataType() != schemapb.DataType_FloatVector {
+		return nil, merr.WrapErrParameterInvalidMsg("local text embedding only supports FloatVector output field, but got %s", fieldSchema.GetDataType().String())
+	}
+	fieldDim, err := typeutil.GetDim(fieldSchema)
+	if err != nil {
+		return nil, err
+	}
+	var modelResource, tokenizerResource, ingestionPrompt, searchPrompt string
+	maxLength := local.DefaultMaxLength
+	pooling := local.MeanPooling
+	normalize := true
+	maxBatch := 32
+
+	for _, param := range functionSchema.Params {
+		switch strings.ToLower(param.Key) {
+		case models.ModelResourceParamKey:
+			modelResource = param.Value
+		case models.TokenizerResourceParamKey:
+			tokenizerResource = param.Value
+		case models.MaxLengthParamKey:
+			if maxLength, err = strconv.Atoi(param.Value); err != nil {
+				return nil, merr.WrapErrParameterInvalidMsg("[%s param's value: %s] is not a valid number", models.MaxLengthParamKey, param.Value)
+			}
+		case models.PoolingParamKey:
+			pooling = param.Value
+		case models.NormalizeParamKey:
+			if normalize, err = strconv.ParseBool(param.Value); err != nil {
+				return nil, merr.WrapErrParameterInvalidMsg("[%s param's value: %s] is invalid, only supports: [true/false]", models.NormalizeParamKey, param.Value)
+			}
+		case models.IngestionPromptParamKey:
+			ingestionPrompt = param.Value
+		case models.SearchPromptParamKey:
+			searchPrompt = param.Value
+		case models.MaxClientBatchSizeParamKey:
+			if maxBatch, err = strconv.Atoi(param.Value); err != nil || maxBatch <= 0 {
+				return nil, merr.WrapErrParameterInvalidMsg("[%s param's value: %s] is not a valid positive number", models.MaxClientBatchSizeParamKey, param.Value)
+			}
+		default:
+		}
+	}
+
+	intraOpThreads := 0
+	if value := params[models.IntraOpThreadsConf]; value != "" {
+		if intraOpThreads, err = strconv.Atoi(value); err != nil || intraOpThreads < 0 {
+			return nil, merr.WrapErrParameterInvalidMsg("local text embedding config %s: %s is not a valid number", models.IntraOpThreadsConf, value)
+		}
+	}
+
+	embedder, err := local.NewEmbedder(modelResource, tokenizerResource, maxLength, pooling, normalize, intraOpThreads)
+	if err != nil {
+		return nil, err
+	}
+	provider := LocalEmbeddingProvider{
+		fieldDim:        fieldDim,
+		embedder:        embedder,
+		ingestionPrompt: ingestionPrompt,
+		searchPrompt:    searchPrompt,
+		maxBatch:        maxBatch,
+		extraInfo:       extraInfo,
+	}
+	return &provider, nil
+}
+
+func (provider *LocalEmbeddingProvider) MaxBatch() int {
+	return provider.extraInfo.BatchFactor * provider.maxBatch
+}
+
+func (provider *LocalEmbeddingProvider) FieldDim() int64 {
+	return provider.fieldDim
+}
+
+func (provider *LocalEmbeddingProvider) CallEmbedding(ctx context.Context, texts []string, mode models.TextEmbeddingMode) (any, error) {
+	numRows := len(texts)
+	data := make([][]float32, 0, numRows)
+	prompt := provider.ingestionPrompt
+	if mode == models.SearchMode {
+		prompt = provider.searchPrompt
+	}
+
+	for i := 0; i < numRows; i += provider.maxBatch {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		end := i + provider.maxBatch
+		if end > numRows {
+			end = numRows
+		}
+		inputs := texts[i:end]
+		if prompt != "" {
+			inputs = make([]string, 0, end-i)
+			for _, text := range texts[i:end] {
+				inputs = append(inputs, prompt+text)
+			}
+		}
+		embeddings, err := provider.embedder.Embed(inputs)
+		if err != nil {
+			return nil, err
+		}
+		if end-i != len(embeddings) {
+			return nil, merr.WrapErrFunctionFailedMsg("get embedding failed, the number of texts and embeddings does not match text:[%d], embedding:[%d]", end-i, len(embeddings))
+		}
+		for _, item := range embeddings {
+			if len(item) != int(provider.fieldDim) {
+				return nil, merr.WrapErrFunctionFailedMsg("the required embedding dim is [%d], but the embedding obtained from the model is [%d]",
+					provider.fieldDim, len(item))
+			}
+			data = append(data, item)
+		}
+	}
+	return data, nil
+}
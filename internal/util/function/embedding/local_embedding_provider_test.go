@@ -0,0 +1,182 @@
+/*
+ * # Licensed to the LF AI & Data foundation under one
+ * # or more contributor license agreements. See the NOTICE file
+ * # distributed with this work for additional information
+ * # regarding copyright ownership. The ASF licenses this file
+ * # to you under the Apache License, Version 2.0 (the
+ * # "License"); you may not use this file except in compliance
+ * # with the License. You may obtain a copy of the License at
+ * #
+ * #     http://www.apache.org/licenses/LICENSE-2.0
+ * #
+ * # Unless required by applicable law or agreed to in writing, software
+ * # distributed under the License is distributed on an "AS IS" BASIS,
+ * # WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * # See the License for the specific language governing permissions and
+ * # limitations under the License.
+ */
+
+package embedding
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v3/schemapb"
+	"github.com/milvus-io/milvus/internal/util/function/models"
+)
+
+func TestLocalTextEmbeddingProvider(t *testing.T) {
+	suite.Run(t, new(LocalTextEmbeddingProviderSuite))
+}
+
+type LocalTextEmbeddingProviderSuite struct {
+	suite.Suite
+	schema *schemapb.CollectionSchema
+}
+
+func (s *LocalTextEmbeddingProviderSuite) SetupTest() {
+	s.schema = &schemapb.CollectionSchema{
+		Name: "test",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "int64", DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "text", DataType: schemapb.DataType_VarChar},
+			{
+				FieldID: 102, Name: "vector", DataType: schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{
+					{Key: "dim", Value: "4"},
+				},
+			},
+			{
+				FieldID: 103, Name: "int8_vector", DataType: schemapb.DataType_Int8Vector,
+				TypeParams: []*commonpb.KeyValuePair{
+					{Key: "dim", Value: "4"},
+				},
+			},
+		},
+	}
+}
+
+func localFunctionSchema(params ...*commonpb.KeyValuePair) *schemapb.FunctionSchema {
+	return &schemapb.FunctionSchema{
+		Name:             "test",
+		Type:             schemapb.FunctionType_TextEmbedding,
+		InputFieldNames:  []string{"text"},
+		OutputFieldNames: []string{"vector"},
+		InputFieldIds:    []int64{101},
+		OutputFieldIds:   []int64{102},
+		Params: append([]*commonpb.KeyValuePair{
+			{Key: Provider, Value: localProvider},
+			{Key: models.ModelResourceParamKey, Value: "model"},
+			{Key: models.TokenizerResourceParamKey, Value: "vocab"},
+		}, params...),
+	}
+}
+
+// mockLocalEmbedder returns embeddings of dim, the first element is the length of the text
+type mockLocalEmbedder struct {
+	dim     int
+	calls   [][]string
+	dropOne bool
+}
+
+func (e *mockLocalEmbedder) Embed(texts []string) ([][]float32, error) {
+	e.calls = append(e.calls, texts)
+	embeddings := make([][]float32, 0, len(texts))
+	for _, text := range texts {
+		embedding := make([]float32, e.dim)
+		embedding[0] = float32(len(text))
+		embeddings = append(embeddings, embedding)
+	}
+	if e.dropOne {
+		embeddings = embeddings[1:]
+	}
+	return embeddings, nil
+}
+
+func (s *LocalTextEmbeddingProviderSuite) TestNewLocalEmbeddingProvider() {
+	batchFactor := 5
+	provider, err := NewLocalEmbeddingProvider(s.schema.Fields[2], localFunctionSchema(), map[string]string{}, &models.ModelExtraInfo{BatchFactor: batchFactor})
+	s.NoError(err)
+	s.Equal(int64(4), provider.FieldDim())
+	s.Equal(32*batchFactor, provider.MaxBatch())
+
+	provider, err = NewLocalEmbeddingProvider(s.schema.Fields[2], localFunctionSchema(
+		&commonpb.KeyValuePair{Key: models.MaxClientBatchSizeParamKey, Value: "8"},
+		&commonpb.KeyValuePair{Key: models.PoolingParamKey, Value: "CLS"},
+		&commonpb.KeyValuePair{Key: models.NormalizeParamKey, Value: "false"},
+		&commonpb.KeyValuePair{Key: models.MaxLengthParamKey, Value: "128"},
+	), map[string]string{models.IntraOpThreadsConf: "2"}, &models.ModelExtraInfo{BatchFactor: batchFactor})
+	s.NoError(err)
+	s.Equal(8*batchFactor, provider.MaxBatch())
+
+	// only FloatVector output
+	_, err = NewLocalEmbeddingProvider(s.schema.Fields[3], localFunctionSchema(), map[string]string{}, &models.ModelExtraInfo{})
+	s.Error(err)
+
+	for _, params := range [][]*commonpb.KeyValuePair{
+		{{Key: models.ModelResourceParamKey, Value: ""}},
+		{{Key: models.TokenizerResourceParamKey, Value: ""}},
+		{{Key: models.MaxLengthParamKey, Value: "Invalid"}},
+		{{Key: models.MaxLengthParamKey, Value: "-1"}},
+		{{Key: models.PoolingParamKey, Value: "max"}},
+		{{Key: models.NormalizeParamKey, Value: "Invalid"}},
+		{{Key: models.MaxClientBatchSizeParamKey, Value: "0"}},
+	} {
+		_, err := NewLocalEmbeddingProvider(s.schema.Fields[2], localFunctionSchema(params...), map[string]string{}, &models.ModelExtraInfo{})
+		s.Error(err, params[0].Key)
+	}
+
+	_, err = NewLocalEmbeddingProvider(s.schema.Fields[2], localFunctionSchema(), map[string]string{models.IntraOpThreadsConf: "Invalid"}, &models.ModelExtraInfo{})
+	s.Error(err)
+}
+
+func (s *LocalTextEmbeddingProviderSuite) TestEmbedding() {
+	provider, err := NewLocalEmbeddingProvider(s.schema.Fields[2], localFunctionSchema(
+		&commonpb.KeyValuePair{Key: models.MaxClientBatchSizeParamKey, Value: "2"},
+		&commonpb.KeyValuePair{Key: models.IngestionPromptParamKey, Value: "doc: "},
+		&commonpb.KeyValuePair{Key: models.SearchPromptParamKey, Value: "query: "},
+	), map[string]string{}, &models.ModelExtraInfo{BatchFactor: 1})
+	s.NoError(err)
+	embedder := &mockLocalEmbedder{dim: 4}
+	provider.embedder = embedder
+
+	texts := []string{"a", "bb", "ccc"}
+	r, err := provider.CallEmbedding(context.Background(), texts, models.InsertMode)
+	s.NoError(err)
+	ret := r.([][]float32)
+	s.Equal(3, len(ret))
+	s.Equal([][]string{{"doc: a", "doc: bb"}, {"doc: ccc"}}, embedder.calls)
+	for i, embedding := range ret {
+		s.Equal(float32(len(fmt.Sprintf("doc: %s", texts[i]))), embedding[0])
+	}
+
+	embedder.calls = nil
+	_, err = provider.CallEmbedding(context.Background(), texts[:1], models.SearchMode)
+	s.NoError(err)
+	s.Equal([][]string{{"query: a"}}, embedder.calls)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = provider.CallEmbedding(ctx, texts, models.InsertMode)
+	s.Error(err)
+}
+
+func (s *LocalTextEmbeddingProviderSuite) TestEmbeddingNotMatch() {
+	provider, err := NewLocalEmbeddingProvider(s.schema.Fields[2], localFunctionSchema(), map[string]string{}, &models.ModelExtraInfo{BatchFactor: 1})
+	s.NoError(err)
+
+	// embedding dim not match
+	provider.embedder = &mockLocalEmbedder{dim: 3}
+	_, err = provider.CallEmbedding(context.Background(), []string{"sentence"}, models.InsertMode)
+	s.Error(err)
+
+	// embedding number not match
+	provider.embedder = &mockLocalEmbedder{dim: 4, dropOne: true}
+	_, err = provider.CallEmbedding(context.Background(), []string{"sentence", "sentence2"}, models.InsertMode)
+	s.Error(err)
+}
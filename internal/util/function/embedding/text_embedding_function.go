@@ -50,6 +50,7 @@ const (
 	zillizProvider       string = "zilliz"
 	geminiProvider       string = "gemini"
 	huggingFaceProvider  string = "huggingface"
+	localProvider        string = "local"
 )
 
 func hasEmptyString(texts []string) bool {
@@ -146,13 +147,23 @@ func NewTextEmbeddingFunction(coll *schemapb.CollectionSchema, functionSchema *s
 		embP, newProviderErr = NewGeminiEmbeddingProvider(base.outputFields[0], functionSchema, conf, credentials, extraInfo)
 	case huggingFaceProvider:
 		embP, newProviderErr = NewHuggingFaceEmbeddingProvider(base.outputFields[0], functionSchema, conf, credentials, extraInfo)
+	case localProvider:
+		embP, newProviderErr = NewLocalEmbeddingProvider(base.outputFields[0], functionSchema, conf, extraInfo)
 	default:
-		return nil, merr.WrapErrParameterInvalidMsg("unsupported text embedding service provider: [%s] , list of supported [%s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s]", base.provider, openAIProvider, azureOpenAIProvider, aliDashScopeProvider, bedrockProvider, vertexAIProvider, voyageAIProvider, cohereProvider, siliconflowProvider, teiProvider, ycProvider, zillizProvider, geminiProvider, huggingFaceProvider)
+		return nil, merr.WrapErrParameterInvalidMsg("unsupported text embedding service provider: [%s] , list of supported [%s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s]", base.provider, openAIProvider, azureOpenAIProvider, aliDashScopeProvider, bedrockProvider, vertexAIProvider, voyageAIProvider, cohereProvider, siliconflowProvider, teiProvider, ycProvider, zillizProvider, geminiProvider, huggingFaceProvider, localProvider)
 	}
 
 	if newProviderErr != nil {
 		return nil, newProviderErr
 	}
+
+	enableCache, err := isEmbeddingCacheEnabled(functionSchema)
+	if err != nil {
+		return nil, err
+	}
+	if cache := getEmbeddingCache(); enableCache && cache != nil {
+		embP = newCachedEmbeddingProvider(embP, cache, base)
+	}
 	return &TextEmbeddingFunction{
 		FunctionBase: *base,
 		embProvider:  embP,
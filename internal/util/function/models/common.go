@@ -66,6 +66,7 @@ const (
 	MaxClientBatchSizeParamKey string = "max_client_batch_size"
 	IntegrationIDKey           string = "integration_id"
 	TimeoutMsParamKey          string = "timeout_ms"
+	EnableCacheParamKey        string = "enable_cache"
 )
 
 // ali text embedding
@@ -161,6 +162,16 @@ const (
 	TeiTruncateParamName string = "truncate"
 )
 
+// local models, run in-process from file resources
+const (
+	ModelResourceParamKey     string = "model_resource"
+	TokenizerResourceParamKey string = "tokenizer_resource"
+	MaxLengthParamKey         string = "max_length"
+	PoolingParamKey           string = "pooling"
+
+	IntraOpThreadsConf string = "intra_op_threads"
+)
+
 // zilliz
 
 const (
@@ -0,0 +1,89 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package local
+
+import (
+	"math"
+
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+)
+
+// CrossEncoder scores the relevance of documents to a query with a cross-encoder model
+// exported to ONNX, running in-process on CPU. The model and its tokenizer are file
+// resources, referenced by name.
+type CrossEncoder struct {
+	spec  modelSpec
+	cache *modelCache
+}
+
+func NewCrossEncoder(modelResource string, tokenizerResource string, maxLength int, intraOpThreads int) (*CrossEncoder, error) {
+	spec, err := newModelSpec(modelResource, tokenizerResource, maxLength, intraOpThreads)
+	if err != nil {
+		return nil, err
+	}
+	return &CrossEncoder{spec: spec, cache: globalModelCache}, nil
+}
+
+// Score returns the relevance score of each document in (0, 1).
+func (e *CrossEncoder) Score(query string, docs []string) ([]float32, error) {
+	if len(docs) == 0 {
+		return []float32{}, nil
+	}
+	tokenizer, model, err := e.cache.load(e.spec)
+	if err != nil {
+		return nil, err
+	}
+
+	queries := make([]string, len(docs))
+	for i := range queries {
+		queries[i] = query
+	}
+	batch, err := tokenizer.encodePairs(queries, docs, e.spec.maxLength)
+	if err != nil {
+		return nil, err
+	}
+	output, err := model.run(batch)
+	if err != nil {
+		return nil, err
+	}
+	return relevanceScores(output, len(docs))
+}
+
+// relevanceScores converts the logits to scores, a single logit per pair is mapped by
+// sigmoid, and two logits are treated as a binary classification of relevance.
+func relevanceScores(output *tensor, rows int) ([]float32, error) {
+	labels := 0
+	if rows > 0 {
+		labels = len(output.data) / rows
+	}
+	if len(output.shape) == 0 || output.shape[0] != int64(rows) || labels*rows != len(output.data) || labels < 1 || labels > 2 {
+		return nil, merr.WrapErrFunctionFailedMsg("unexpected cross-encoder output shape %v for %d documents", output.shape, rows)
+	}
+	scores := make([]float32, rows)
+	for i := range scores {
+		if labels == 1 {
+			scores[i] = sigmoid(output.data[i])
+		} else {
+			scores[i] = sigmoid(output.data[2*i+1] - output.data[2*i])
+		}
+	}
+	return scores, nil
+}
+
+func sigmoid(x float32) float32 {
+	return float32(1 / (1 + math.Exp(-float64(x))))
+}
@@ -0,0 +1,157 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package local
+
+import (
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/util/fileresource"
+)
+
+// tokenCountRunner outputs the number of tokens of each pair as its logit.
+type tokenCountRunner struct {
+	labels int
+	closed bool
+}
+
+func (r *tokenCountRunner) run(batch *encodedBatch) (*tensor, error) {
+	output := &tensor{shape: []int64{int64(batch.batchSize), int64(r.labels)}}
+	for i := 0; i < batch.batchSize; i++ {
+		count := float32(0)
+		for _, mask := range batch.attentionMask[i*batch.seqLen : (i+1)*batch.seqLen] {
+			count += float32(mask)
+		}
+		if r.labels == 2 {
+			output.data = append(output.data, 0)
+		}
+		output.data = append(output.data, count-6)
+	}
+	return output, nil
+}
+
+func (r *tokenCountRunner) close() error {
+	r.closed = true
+	return nil
+}
+
+func newTestModelCache(t *testing.T, runner modelRunner) *modelCache {
+	loads := 0
+	cache := newModelCache(func(path string, intraOpThreads int) (modelRunner, error) {
+		loads++
+		if loads > 1 {
+			return nil, errors.New("model loaded twice")
+		}
+		return runner, nil
+	})
+	require.NoError(t, cache.OnFileResourceSync(fileresource.SyncEvent{
+		Version: 1,
+		Resources: []*fileresource.ResolvedFileResource{
+			{ID: 1, Name: "model", Path: "models/model.onnx", LocalPath: "/tmp/model.onnx"},
+			{ID: 2, Name: "vocab", Path: "models/vocab.txt", LocalPath: writeTestVocab(t)},
+		},
+	}))
+	return cache
+}
+
+func newTestCrossEncoder(t *testing.T, runner *tokenCountRunner) (*CrossEncoder, *modelCache) {
+	cache := newTestModelCache(t, runner)
+	encoder, err := NewCrossEncoder("model", "vocab", 16, 1)
+	require.NoError(t, err)
+	encoder.cache = cache
+	return encoder, cache
+}
+
+func TestCrossEncoderScore(t *testing.T) {
+	for _, labels := range []int{1, 2} {
+		runner := &tokenCountRunner{labels: labels}
+		encoder, cache := newTestCrossEncoder(t, runner)
+
+		scores, err := encoder.Score("the fox", []string{"quick brown fox", "fox", "the quick brown fox"})
+		require.NoError(t, err)
+		require.Len(t, scores, 3)
+		// the longer pair gets the higher score
+		assert.Greater(t, scores[2], scores[0])
+		assert.Greater(t, scores[0], scores[1])
+		assert.InDelta(t, 0.5, scores[1], 1e-6)
+
+		// the model is cached
+		_, err = encoder.Score("the", []string{"fox"})
+		require.NoError(t, err)
+
+		// removing the file resource unloads the model
+		require.NoError(t, cache.OnFileResourceSync(fileresource.SyncEvent{Version: 2}))
+		assert.True(t, runner.closed)
+		_, err = encoder.Score("the", []string{"fox"})
+		assert.ErrorContains(t, err, "not found")
+	}
+}
+
+func TestModelCacheIntraOpThreads(t *testing.T) {
+	threads := make([]int, 0)
+	runners := make([]*tokenCountRunner, 0)
+	cache := newModelCache(func(path string, intraOpThreads int) (modelRunner, error) {
+		threads = append(threads, intraOpThreads)
+		runners = append(runners, &tokenCountRunner{labels: 1})
+		return runners[len(runners)-1], nil
+	})
+	require.NoError(t, cache.OnFileResourceSync(fileresource.SyncEvent{
+		Version: 1,
+		Resources: []*fileresource.ResolvedFileResource{
+			{ID: 1, Name: "model", Path: "models/model.onnx", LocalPath: "/tmp/model.onnx"},
+		},
+	}))
+
+	model1, err := cache.getModel("model", 1)
+	require.NoError(t, err)
+	model4, err := cache.getModel("model", 4)
+	require.NoError(t, err)
+	assert.NotSame(t, model1, model4)
+	cached, err := cache.getModel("model", 4)
+	require.NoError(t, err)
+	assert.Same(t, model4, cached)
+	assert.Equal(t, []int{1, 4}, threads)
+
+	// all the sessions of a model are released with its file resource
+	require.NoError(t, cache.OnFileResourceSync(fileresource.SyncEvent{Version: 2}))
+	assert.True(t, runners[0].closed)
+	assert.True(t, runners[1].closed)
+}
+
+func TestNewCrossEncoderError(t *testing.T) {
+	_, err := NewCrossEncoder("", "vocab", 16, 0)
+	assert.Error(t, err)
+	_, err = NewCrossEncoder("reranker", "", 16, 0)
+	assert.Error(t, err)
+	_, err = NewCrossEncoder("reranker", "vocab", 3, 0)
+	assert.Error(t, err)
+}
+
+func TestRelevanceScoresShape(t *testing.T) {
+	_, err := relevanceScores(&tensor{data: []float32{1, 2, 3}, shape: []int64{1, 3}}, 1)
+	assert.Error(t, err)
+	_, err = relevanceScores(&tensor{data: []float32{1}, shape: []int64{1}}, 2)
+	assert.Error(t, err)
+
+	scores, err := relevanceScores(&tensor{data: []float32{0, 100}, shape: []int64{2}}, 2)
+	require.NoError(t, err)
+	assert.InDelta(t, 0.5, scores[0], 1e-6)
+	assert.InDelta(t, 1, scores[1], 1e-6)
+}
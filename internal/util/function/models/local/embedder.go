@@ -0,0 +1,143 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package local
+
+import (
+	"math"
+	"strings"
+
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+)
+
+const (
+	MeanPooling = "mean"
+	CLSPooling  = "cls"
+)
+
+// Embedder embeds texts with a sentence-transformer model exported to ONNX, running
+// in-process on CPU. The model either outputs the token embeddings, which are pooled
+// into the sentence embedding, or the sentence embedding itself.
+type Embedder struct {
+	spec      modelSpec
+	pooling   string
+	normalize bool
+	cache     *modelCache
+}
+
+func NewEmbedder(modelResource string, tokenizerResource string, maxLength int, pooling string, normalize bool, intraOpThreads int) (*Embedder, error) {
+	spec, err := newModelSpec(modelResource, tokenizerResource, maxLength, intraOpThreads)
+	if err != nil {
+		return nil, err
+	}
+	pooling = strings.ToLower(pooling)
+	if pooling != MeanPooling && pooling != CLSPooling {
+		return nil, merr.WrapErrParameterInvalidMsg("unsupported pooling [%s], only supports [%s/%s]", pooling, MeanPooling, CLSPooling)
+	}
+	return &Embedder{spec: spec, pooling: pooling, normalize: normalize, cache: globalModelCache}, nil
+}
+
+// Embed returns the embedding of each text.
+func (e *Embedder) Embed(texts []string) ([][]float32, error) {
+	if len(texts) == 0 {
+		return [][]float32{}, nil
+	}
+	tokenizer, model, err := e.cache.load(e.spec)
+	if err != nil {
+		return nil, err
+	}
+	batch, err := tokenizer.encodePairs(texts, nil, e.spec.maxLength)
+	if err != nil {
+		return nil, err
+	}
+	output, err := model.run(batch)
+	if err != nil {
+		return nil, err
+	}
+	embeddings, err := e.pool(output, batch)
+	if err != nil {
+		return nil, err
+	}
+	if e.normalize {
+		for _, embedding := range embeddings {
+			l2Normalize(embedding)
+		}
+	}
+	return embeddings, nil
+}
+
+func (e *Embedder) pool(output *tensor, batch *encodedBatch) ([][]float32, error) {
+	rows := batch.batchSize
+	elements := 1
+	for _, dim := range output.shape {
+		elements *= int(dim)
+	}
+	if elements != len(output.data) {
+		return nil, merr.WrapErrFunctionFailedMsg("embedding model output shape %v mismatches its %d elements", output.shape, len(output.data))
+	}
+	embeddings := make([][]float32, rows)
+	switch {
+	// [batch, dim], the model does the pooling
+	case len(output.shape) == 2 && output.shape[0] == int64(rows):
+		dim := int(output.shape[1])
+		for i := range embeddings {
+			embeddings[i] = append([]float32{}, output.data[i*dim:(i+1)*dim]...)
+		}
+	// [batch, seq_len, dim], the token embeddings
+	case len(output.shape) == 3 && output.shape[0] == int64(rows) && output.shape[1] == int64(batch.seqLen):
+		dim := int(output.shape[2])
+		for i := range embeddings {
+			rowOffset := i * batch.seqLen
+			if e.pooling == CLSPooling {
+				embeddings[i] = append([]float32{}, output.data[rowOffset*dim:(rowOffset+1)*dim]...)
+				continue
+			}
+			embedding := make([]float32, dim)
+			tokens := 0
+			for j := 0; j < batch.seqLen; j++ {
+				if batch.attentionMask[rowOffset+j] == 0 {
+					continue
+				}
+				tokens++
+				token := output.data[(rowOffset+j)*dim : (rowOffset+j+1)*dim]
+				for k, v := range token {
+					embedding[k] += v
+				}
+			}
+			for k := range embedding {
+				embedding[k] /= float32(tokens)
+			}
+			embeddings[i] = embedding
+		}
+	default:
+		return nil, merr.WrapErrFunctionFailedMsg("unexpected embedding model output shape %v for %d texts", output.shape, rows)
+	}
+	return embeddings, nil
+}
+
+func l2Normalize(embedding []float32) {
+	norm := 0.0
+	for _, v := range embedding {
+		norm += float64(v) * float64(v)
+	}
+	if norm == 0 {
+		return
+	}
+	norm = math.Sqrt(norm)
+	for i := range embedding {
+		embedding[i] = float32(float64(embedding[i]) / norm)
+	}
+}
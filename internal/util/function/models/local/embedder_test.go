@@ -0,0 +1,104 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package local
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// tokenEmbeddingRunner outputs [token_id, 1] as the embedding of each token, or
+// [row, 0, 0] as the sentence embedding if pooled.
+type tokenEmbeddingRunner struct {
+	pooled bool
+}
+
+func (r *tokenEmbeddingRunner) run(batch *encodedBatch) (*tensor, error) {
+	if r.pooled {
+		output := &tensor{shape: []int64{int64(batch.batchSize), 3}}
+		for i := 0; i < batch.batchSize; i++ {
+			output.data = append(output.data, float32(i), 0, 0)
+		}
+		return output, nil
+	}
+	output := &tensor{shape: []int64{int64(batch.batchSize), int64(batch.seqLen), 2}}
+	for _, id := range batch.inputIDs {
+		output.data = append(output.data, float32(id), 1)
+	}
+	return output, nil
+}
+
+func (r *tokenEmbeddingRunner) close() error {
+	return nil
+}
+
+func TestEmbedderEmbed(t *testing.T) {
+	texts := []string{"the fox", "fox"}
+	tests := []struct {
+		name      string
+		pooled    bool
+		pooling   string
+		normalize bool
+		expected  [][]float32
+	}{
+		// [CLS] the fox [SEP] and [CLS] fox [SEP] [PAD], the padding is not pooled
+		{name: "mean", pooling: MeanPooling, expected: [][]float32{{4, 1}, {4, 1}}},
+		{name: "cls", pooling: CLSPooling, expected: [][]float32{{2, 1}, {2, 1}}},
+		{name: "normalize", pooling: MeanPooling, normalize: true, expected: [][]float32{
+			{4 / float32(math.Sqrt(17)), 1 / float32(math.Sqrt(17))},
+			{4 / float32(math.Sqrt(17)), 1 / float32(math.Sqrt(17))},
+		}},
+		{name: "pooled by model", pooled: true, pooling: MeanPooling, expected: [][]float32{{0, 0, 0}, {1, 0, 0}}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			embedder, err := NewEmbedder("model", "vocab", 16, test.pooling, test.normalize, 0)
+			require.NoError(t, err)
+			embedder.cache = newTestModelCache(t, &tokenEmbeddingRunner{pooled: test.pooled})
+
+			embeddings, err := embedder.Embed(texts)
+			require.NoError(t, err)
+			require.Len(t, embeddings, len(test.expected))
+			for i := range embeddings {
+				assert.InDeltaSlice(t, test.expected[i], embeddings[i], 1e-6)
+			}
+		})
+	}
+}
+
+func TestEmbedderError(t *testing.T) {
+	_, err := NewEmbedder("model", "vocab", 16, "max", true, 0)
+	assert.ErrorContains(t, err, "unsupported pooling")
+	_, err = NewEmbedder("", "vocab", 16, MeanPooling, true, 0)
+	assert.Error(t, err)
+
+	embedder, err := NewEmbedder("model", "vocab", 16, "CLS", true, 0)
+	require.NoError(t, err)
+	// the shape mismatches the number of elements
+	_, err = embedder.pool(&tensor{data: []float32{1}, shape: []int64{2, 1}}, &encodedBatch{batchSize: 2, seqLen: 1})
+	assert.Error(t, err)
+	// neither sentence embeddings nor token embeddings
+	_, err = embedder.pool(&tensor{data: []float32{1, 2}, shape: []int64{2}}, &encodedBatch{batchSize: 2, seqLen: 1})
+	assert.Error(t, err)
+
+	embeddings, err := embedder.Embed(nil)
+	require.NoError(t, err)
+	assert.Empty(t, embeddings)
+}
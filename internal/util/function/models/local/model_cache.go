@@ -0,0 +1,279 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package local
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/milvus-io/milvus/internal/util/fileresource"
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/util/conc"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+)
+
+// modelRunner runs a loaded model on a batch of tokenized texts.
+type modelRunner interface {
+	run(batch *encodedBatch) (*tensor, error)
+	close() error
+}
+
+// tensor is the first output of a model in row-major layout.
+type tensor struct {
+	data  []float32
+	shape []int64
+}
+
+type modelLoader func(path string, intraOpThreads int) (modelRunner, error)
+
+type cachedModel struct {
+	mu           sync.RWMutex
+	runner       modelRunner
+	resourceName string
+	resourceKey  string
+	closed       bool
+}
+
+func (m *cachedModel) run(batch *encodedBatch) (*tensor, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if m.closed {
+		return nil, merr.WrapErrServiceUnavailableMsg("model %q was unloaded since its file resource changed", m.resourceName)
+	}
+	return m.runner.run(batch)
+}
+
+// close waits for the running inferences before releasing the model.
+func (m *cachedModel) close() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.closed {
+		return
+	}
+	m.closed = true
+	if err := m.runner.close(); err != nil {
+		mlog.Warn(context.TODO(), "close local model failed", mlog.String("resource", m.resourceName), mlog.Err(err))
+	}
+}
+
+// DefaultMaxLength is the max number of tokens of a model input, the limit of most
+// BERT family models.
+const DefaultMaxLength = 512
+
+// modelSpec references a model and its tokenizer in the file resources.
+type modelSpec struct {
+	modelResource     string
+	tokenizerResource string
+	maxLength         int
+	intraOpThreads    int
+}
+
+func newModelSpec(modelResource string, tokenizerResource string, maxLength int, intraOpThreads int) (modelSpec, error) {
+	if modelResource == "" {
+		return modelSpec{}, merr.WrapErrParameterMissingMsg("local model resource is required")
+	}
+	if tokenizerResource == "" {
+		return modelSpec{}, merr.WrapErrParameterMissingMsg("local tokenizer resource is required")
+	}
+	if maxLength <= 3 || maxLength > DefaultMaxLength*16 {
+		return modelSpec{}, merr.WrapErrParameterInvalidMsg("max length %d is out of range (3, %d]", maxLength, DefaultMaxLength*16)
+	}
+	return modelSpec{
+		modelResource:     modelResource,
+		tokenizerResource: tokenizerResource,
+		maxLength:         maxLength,
+		intraOpThreads:    intraOpThreads,
+	}, nil
+}
+
+// modelCache keeps the models and tokenizers loaded from the file resources, they
+// are loaded on first use and released once their file resource is removed or replaced.
+type modelCache struct {
+	resources atomic.Value // map[string]*fileresource.ResolvedFileResource
+
+	mu         sync.RWMutex
+	models     map[string]*cachedModel
+	tokenizers map[string]*wordPieceTokenizer
+	modelSF    conc.Singleflight[*cachedModel]
+	tokenSF    conc.Singleflight[*wordPieceTokenizer]
+
+	loader modelLoader
+}
+
+var globalModelCache = newModelCache(loadOnnxModel)
+
+func init() {
+	fileresource.RegisterListener("local_model", globalModelCache)
+}
+
+func newModelCache(loader modelLoader) *modelCache {
+	cache := &modelCache{
+		models:     make(map[string]*cachedModel),
+		tokenizers: make(map[string]*wordPieceTokenizer),
+		loader:     loader,
+	}
+	cache.resources.Store(map[string]*fileresource.ResolvedFileResource{})
+	return cache
+}
+
+func resourceCacheKey(resource *fileresource.ResolvedFileResource) string {
+	return fmt.Sprintf("%d:%s", resource.ID, resource.Path)
+}
+
+// modelCacheKey tells apart the sessions of a model created with different
+// intra op threads, they are configured per function.
+func modelCacheKey(resourceKey string, intraOpThreads int) string {
+	return fmt.Sprintf("%s:%d", resourceKey, intraOpThreads)
+}
+
+func (c *modelCache) OnFileResourceSync(event fileresource.SyncEvent) error {
+	resources := make(map[string]*fileresource.ResolvedFileResource, len(event.Resources))
+	activeKeys := make(map[string]struct{}, len(event.Resources))
+	for _, resource := range event.Resources {
+		if resource == nil {
+			continue
+		}
+		resolved := *resource
+		resources[resource.Name] = &resolved
+		activeKeys[resourceCacheKey(resource)] = struct{}{}
+	}
+	c.resources.Store(resources)
+
+	c.mu.Lock()
+	evicted := make([]*cachedModel, 0)
+	for key, model := range c.models {
+		if _, ok := activeKeys[model.resourceKey]; !ok {
+			delete(c.models, key)
+			evicted = append(evicted, model)
+		}
+	}
+	for key := range c.tokenizers {
+		if _, ok := activeKeys[key]; !ok {
+			delete(c.tokenizers, key)
+		}
+	}
+	c.mu.Unlock()
+	for _, model := range evicted {
+		model.close()
+	}
+	return nil
+}
+
+func (c *modelCache) resolveResource(name string) (*fileresource.ResolvedFileResource, error) {
+	resources, _ := c.resources.Load().(map[string]*fileresource.ResolvedFileResource)
+	resource, ok := resources[name]
+	if !ok || resource == nil {
+		return nil, merr.WrapErrParameterInvalidMsg("file resource %q not found", name)
+	}
+	if resource.LocalPath == "" {
+		return nil, merr.WrapErrServiceInternalMsg("local path is empty for file resource %q", name)
+	}
+	return resource, nil
+}
+
+func (c *modelCache) isActive(key string) bool {
+	resources, _ := c.resources.Load().(map[string]*fileresource.ResolvedFileResource)
+	for _, resource := range resources {
+		if resourceCacheKey(resource) == key {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *modelCache) getModel(name string, intraOpThreads int) (*cachedModel, error) {
+	resource, err := c.resolveResource(name)
+	if err != nil {
+		return nil, err
+	}
+	resourceKey := resourceCacheKey(resource)
+	key := modelCacheKey(resourceKey, intraOpThreads)
+	c.mu.RLock()
+	model, ok := c.models[key]
+	c.mu.RUnlock()
+	if ok {
+		return model, nil
+	}
+
+	model, err, _ = c.modelSF.Do(key, func() (*cachedModel, error) {
+		c.mu.RLock()
+		model, ok := c.models[key]
+		c.mu.RUnlock()
+		if ok {
+			return model, nil
+		}
+		runner, err := c.loader(resource.LocalPath, intraOpThreads)
+		if err != nil {
+			return nil, err
+		}
+		model = &cachedModel{runner: runner, resourceName: resource.Name, resourceKey: resourceKey}
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		// the resource may be removed while loading
+		if !c.isActive(resourceKey) {
+			model.close()
+			return nil, merr.WrapErrParameterInvalidMsg("file resource %q was removed while loading", name)
+		}
+		c.models[key] = model
+		mlog.Info(context.TODO(), "local model loaded", mlog.String("resource", resource.Name), mlog.String("path", resource.LocalPath))
+		return model, nil
+	})
+	return model, err
+}
+
+func (c *modelCache) getTokenizer(name string) (*wordPieceTokenizer, error) {
+	resource, err := c.resolveResource(name)
+	if err != nil {
+		return nil, err
+	}
+	key := resourceCacheKey(resource)
+	c.mu.RLock()
+	tokenizer, ok := c.tokenizers[key]
+	c.mu.RUnlock()
+	if ok {
+		return tokenizer, nil
+	}
+
+	tokenizer, err, _ = c.tokenSF.Do(key, func() (*wordPieceTokenizer, error) {
+		tokenizer, err := loadTokenizer(resource.LocalPath)
+		if err != nil {
+			return nil, err
+		}
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		if c.isActive(key) {
+			c.tokenizers[key] = tokenizer
+		}
+		return tokenizer, nil
+	})
+	return tokenizer, err
+}
+
+// load returns the tokenizer and the model of spec, loading them on first use.
+func (c *modelCache) load(spec modelSpec) (*wordPieceTokenizer, *cachedModel, error) {
+	tokenizer, err := c.getTokenizer(spec.tokenizerResource)
+	if err != nil {
+		return nil, nil, err
+	}
+	model, err := c.getModel(spec.modelResource, spec.intraOpThreads)
+	if err != nil {
+		return nil, nil, err
+	}
+	return tokenizer, model, nil
+}
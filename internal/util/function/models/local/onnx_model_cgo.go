@@ -0,0 +1,117 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build cgo
+
+package local
+
+/*
+#cgo pkg-config: milvus_core
+
+#include <stdlib.h>
+#include "inference/onnx_model_c.h"
+*/
+import "C"
+
+import (
+	"runtime"
+	"unsafe"
+
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+)
+
+type onnxModel struct {
+	h unsafe.Pointer
+}
+
+func loadOnnxModel(path string, intraOpThreads int) (modelRunner, error) {
+	cPath := C.CString(path)
+	defer C.free(unsafe.Pointer(cPath))
+
+	result := C.LoadOnnxModel(cPath, C.int32_t(intraOpThreads))
+	if err := consumeOnnxCStatus(&result.status); err != nil {
+		if result.model != nil {
+			status := C.DeleteOnnxModel(result.model)
+			_ = consumeOnnxCStatus(&status)
+		}
+		return nil, err
+	}
+	if result.model == nil {
+		return nil, merr.WrapErrServiceInternalMsg("onnx: loader returned nil model for %s", path)
+	}
+	return &onnxModel{h: unsafe.Pointer(result.model)}, nil
+}
+
+func (m *onnxModel) run(batch *encodedBatch) (*tensor, error) {
+	if m.h == nil {
+		return nil, merr.WrapErrServiceInternalMsg("onnx: model handle is nil")
+	}
+	if batch.batchSize == 0 {
+		return &tensor{}, nil
+	}
+
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
+	pinner.Pin(&batch.inputIDs[0])
+	pinner.Pin(&batch.attentionMask[0])
+	pinner.Pin(&batch.tokenTypeIDs[0])
+
+	result := C.RunOnnxModel(C.COnnxRunRequest{
+		model:          C.COnnxModel(m.h),
+		input_ids:      (*C.int64_t)(unsafe.Pointer(&batch.inputIDs[0])),
+		attention_mask: (*C.int64_t)(unsafe.Pointer(&batch.attentionMask[0])),
+		token_type_ids: (*C.int64_t)(unsafe.Pointer(&batch.tokenTypeIDs[0])),
+		batch_size:     C.int64_t(batch.batchSize),
+		seq_len:        C.int64_t(batch.seqLen),
+	})
+	defer C.FreeOnnxRunResult(&result)
+	if err := consumeOnnxCStatus(&result.status); err != nil {
+		return nil, err
+	}
+
+	shape := make([]int64, int(result.num_dims))
+	elements := 1
+	if len(shape) > 0 {
+		copy(shape, unsafe.Slice((*int64)(unsafe.Pointer(result.shape)), len(shape)))
+		for _, dim := range shape {
+			elements *= int(dim)
+		}
+	}
+	data := make([]float32, elements)
+	if elements > 0 {
+		copy(data, unsafe.Slice((*float32)(unsafe.Pointer(result.data)), elements))
+	}
+	return &tensor{data: data, shape: shape}, nil
+}
+
+func (m *onnxModel) close() error {
+	if m.h == nil {
+		return nil
+	}
+	status := C.DeleteOnnxModel(C.COnnxModel(m.h))
+	m.h = nil
+	return consumeOnnxCStatus(&status)
+}
+
+func consumeOnnxCStatus(status *C.CStatus) error {
+	if status.error_code == 0 {
+		return nil
+	}
+	errorCode := int32(status.error_code)
+	errorMsg := C.GoString(status.error_msg)
+	C.free(unsafe.Pointer(status.error_msg))
+	return merr.SegcoreError(errorCode, errorMsg)
+}
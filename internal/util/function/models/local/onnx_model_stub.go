@@ -0,0 +1,29 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !cgo
+
+package local
+
+import (
+	"path/filepath"
+
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+)
+
+func loadOnnxModel(path string, _ int) (modelRunner, error) {
+	return nil, merr.WrapErrServiceInternalMsg("onnx: runtime is not enabled for model %q; rebuild with CGO_ENABLED=1", filepath.Base(path))
+}
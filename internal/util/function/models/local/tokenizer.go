@@ -0,0 +1,368 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package local
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+)
+
+const (
+	clsToken = "[CLS]"
+	sepToken = "[SEP]"
+	padToken = "[PAD]"
+	unkToken = "[UNK]"
+
+	defaultSubwordPrefix        = "##"
+	defaultMaxInputCharsPerWord = 100
+)
+
+// wordPieceTokenizer is the tokenizer of the BERT family models, it is loaded
+// from a HuggingFace tokenizer.json with a WordPiece model or from a vocab.txt.
+type wordPieceTokenizer struct {
+	vocab                map[string]int64
+	lowercase            bool
+	stripAccents         bool
+	subwordPrefix        string
+	maxInputCharsPerWord int
+
+	clsID int64
+	sepID int64
+	padID int64
+	unkID int64
+}
+
+type hfTokenizerFile struct {
+	Model struct {
+		Type                    string           `json:"type"`
+		Vocab                   map[string]int64 `json:"vocab"`
+		UnkToken                string           `json:"unk_token"`
+		ContinuingSubwordPrefix string           `json:"continuing_subword_prefix"`
+		MaxInputCharsPerWord    int              `json:"max_input_chars_per_word"`
+	} `json:"model"`
+	Normalizer *struct {
+		Type         string `json:"type"`
+		Lowercase    *bool  `json:"lowercase"`
+		StripAccents *bool  `json:"strip_accents"`
+	} `json:"normalizer"`
+}
+
+// loadTokenizer loads the tokenizer from path, a file with the .json extension is
+// parsed as a HuggingFace tokenizer.json, otherwise as a vocab.txt of an uncased model.
+func loadTokenizer(path string) (*wordPieceTokenizer, error) {
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		return loadHFTokenizer(path)
+	}
+	return loadVocabTokenizer(path)
+}
+
+func loadHFTokenizer(path string) (*wordPieceTokenizer, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, merr.WrapErrIoFailed(path, err)
+	}
+	var file hfTokenizerFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, merr.WrapErrParameterInvalidMsg("invalid tokenizer file %s: %s", filepath.Base(path), err.Error())
+	}
+	if file.Model.Type != "WordPiece" {
+		return nil, merr.WrapErrParameterInvalidMsg("unsupported tokenizer model type [%s], only WordPiece is supported", file.Model.Type)
+	}
+
+	lowercase := false
+	stripAccents := false
+	if file.Normalizer != nil && file.Normalizer.Type == "BertNormalizer" {
+		lowercase = file.Normalizer.Lowercase == nil || *file.Normalizer.Lowercase
+		// strip_accents follows lowercase if it is not set
+		stripAccents = lowercase
+		if file.Normalizer.StripAccents != nil {
+			stripAccents = *file.Normalizer.StripAccents
+		}
+	}
+	return newWordPieceTokenizer(file.Model.Vocab, lowercase, stripAccents,
+		file.Model.UnkToken, file.Model.ContinuingSubwordPrefix, file.Model.MaxInputCharsPerWord)
+}
+
+func loadVocabTokenizer(path string) (*wordPieceTokenizer, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, merr.WrapErrIoFailed(path, err)
+	}
+	defer f.Close()
+
+	vocab := make(map[string]int64)
+	scanner := bufio.NewScanner(f)
+	for id := int64(0); scanner.Scan(); id++ {
+		token := strings.TrimRight(scanner.Text(), "\r")
+		if _, ok := vocab[token]; !ok {
+			vocab[token] = id
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, merr.WrapErrIoFailed(path, err)
+	}
+	return newWordPieceTokenizer(vocab, true, true, unkToken, defaultSubwordPrefix, defaultMaxInputCharsPerWord)
+}
+
+func newWordPieceTokenizer(vocab map[string]int64, lowercase, stripAccents bool,
+	unk string, subwordPrefix string, maxInputCharsPerWord int,
+) (*wordPieceTokenizer, error) {
+	if unk == "" {
+		unk = unkToken
+	}
+	if subwordPrefix == "" {
+		subwordPrefix = defaultSubwordPrefix
+	}
+	if maxInputCharsPerWord <= 0 {
+		maxInputCharsPerWord = defaultMaxInputCharsPerWord
+	}
+	t := &wordPieceTokenizer{
+		vocab:                vocab,
+		lowercase:            lowercase,
+		stripAccents:         stripAccents,
+		subwordPrefix:        subwordPrefix,
+		maxInputCharsPerWord: maxInputCharsPerWord,
+	}
+	for _, special := range []struct {
+		token string
+		id    *int64
+	}{
+		{clsToken, &t.clsID},
+		{sepToken, &t.sepID},
+		{unk, &t.unkID},
+	} {
+		id, ok := vocab[special.token]
+		if !ok {
+			return nil, merr.WrapErrParameterInvalidMsg("tokenizer vocabulary lacks the special token %s", special.token)
+		}
+		*special.id = id
+	}
+	// models are usually padded with id 0 if there is no pad token
+	t.padID = vocab[padToken]
+	return t, nil
+}
+
+// normalize applies the BertNormalizer: removes the control characters, isolates the
+// CJK characters and optionally lowercases and strips the accents.
+func (t *wordPieceTokenizer) normalize(text string) string {
+	var sb strings.Builder
+	sb.Grow(len(text))
+	for _, r := range text {
+		switch {
+		case r == 0 || r == unicode.ReplacementChar:
+			continue
+		case r == '\t' || r == '\n' || r == '\r' || unicode.IsSpace(r):
+			sb.WriteRune(' ')
+		case unicode.IsControl(r) || unicode.Is(unicode.Cf, r):
+			continue
+		case isCJK(r):
+			sb.WriteRune(' ')
+			sb.WriteRune(r)
+			sb.WriteRune(' ')
+		default:
+			sb.WriteRune(r)
+		}
+	}
+	normalized := sb.String()
+	if t.stripAccents {
+		sb.Reset()
+		for _, r := range norm.NFD.String(normalized) {
+			if !unicode.Is(unicode.Mn, r) {
+				sb.WriteRune(r)
+			}
+		}
+		normalized = sb.String()
+	}
+	if t.lowercase {
+		normalized = strings.ToLower(normalized)
+	}
+	return normalized
+}
+
+func isCJK(r rune) bool {
+	return (r >= 0x4E00 && r <= 0x9FFF) ||
+		(r >= 0x3400 && r <= 0x4DBF) ||
+		(r >= 0x20000 && r <= 0x2A6DF) ||
+		(r >= 0x2A700 && r <= 0x2B73F) ||
+		(r >= 0x2B740 && r <= 0x2B81F) ||
+		(r >= 0x2B820 && r <= 0x2CEAF) ||
+		(r >= 0xF900 && r <= 0xFAFF) ||
+		(r >= 0x2F800 && r <= 0x2FA1F)
+}
+
+func isPunctuation(r rune) bool {
+	// all non-letter/number ASCII characters are punctuation, e.g. "$" and "^"
+	if (r >= 33 && r <= 47) || (r >= 58 && r <= 64) || (r >= 91 && r <= 96) || (r >= 123 && r <= 126) {
+		return true
+	}
+	return unicode.IsPunct(r)
+}
+
+// preTokenize splits the normalized text on whitespaces and punctuations.
+func preTokenize(text string) []string {
+	words := make([]string, 0)
+	var current []rune
+	flush := func() {
+		if len(current) > 0 {
+			words = append(words, string(current))
+			current = current[:0]
+		}
+	}
+	for _, r := range text {
+		switch {
+		case unicode.IsSpace(r):
+			flush()
+		case isPunctuation(r):
+			flush()
+			words = append(words, string(r))
+		default:
+			current = append(current, r)
+		}
+	}
+	flush()
+	return words
+}
+
+// tokenize returns the token ids of text without special tokens.
+func (t *wordPieceTokenizer) tokenize(text string) []int64 {
+	ids := make([]int64, 0)
+	for _, word := range preTokenize(t.normalize(text)) {
+		ids = append(ids, t.wordPiece(word)...)
+	}
+	return ids
+}
+
+// wordPiece splits a word into the longest sub-words of the vocabulary, the whole
+// word is unknown if any part of it can't be matched.
+func (t *wordPieceTokenizer) wordPiece(word string) []int64 {
+	runes := []rune(word)
+	if len(runes) > t.maxInputCharsPerWord {
+		return []int64{t.unkID}
+	}
+	ids := make([]int64, 0, 1)
+	for start := 0; start < len(runes); {
+		end := len(runes)
+		matched := false
+		for ; end > start; end-- {
+			piece := string(runes[start:end])
+			if start > 0 {
+				piece = t.subwordPrefix + piece
+			}
+			if id, ok := t.vocab[piece]; ok {
+				ids = append(ids, id)
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return []int64{t.unkID}
+		}
+		start = end
+	}
+	return ids
+}
+
+// encodedBatch is a batch of tokenized texts padded to the longest one, in the
+// row-major [batchSize, seqLen] layout expected by the model.
+type encodedBatch struct {
+	inputIDs      []int64
+	attentionMask []int64
+	tokenTypeIDs  []int64
+	batchSize     int
+	seqLen        int
+}
+
+// encodePairs encodes each (first[i], second[i]) pair as "[CLS] first [SEP] second [SEP]",
+// the longer sequence of a pair is truncated first to fit maxLength tokens. If second is
+// nil, each text is encoded alone as "[CLS] first [SEP]".
+func (t *wordPieceTokenizer) encodePairs(first []string, second []string, maxLength int) (*encodedBatch, error) {
+	if second != nil && len(first) != len(second) {
+		return nil, merr.WrapErrServiceInternalMsg("tokenizer got %d first texts and %d second texts", len(first), len(second))
+	}
+	specials := 2
+	if second != nil {
+		specials = 3
+	}
+	if maxLength <= specials {
+		return nil, merr.WrapErrParameterInvalidMsg("max length %d is too small", maxLength)
+	}
+
+	rows := make([][]int64, len(first))
+	types := make([][]int64, len(first))
+	seqLen := 0
+	for i := range first {
+		a := t.tokenize(first[i])
+		var b []int64
+		if second != nil {
+			b = t.tokenize(second[i])
+		}
+		for len(a)+len(b) > maxLength-specials {
+			if len(a) > len(b) {
+				a = a[:len(a)-1]
+			} else {
+				b = b[:len(b)-1]
+			}
+		}
+
+		row := make([]int64, 0, len(a)+len(b)+specials)
+		row = append(row, t.clsID)
+		row = append(row, a...)
+		row = append(row, t.sepID)
+		tokenTypes := make([]int64, len(row), cap(row))
+		if second != nil {
+			row = append(row, b...)
+			row = append(row, t.sepID)
+			for len(tokenTypes) < len(row) {
+				tokenTypes = append(tokenTypes, 1)
+			}
+		}
+		rows[i] = row
+		types[i] = tokenTypes
+		if len(row) > seqLen {
+			seqLen = len(row)
+		}
+	}
+
+	batch := &encodedBatch{
+		inputIDs:      make([]int64, len(rows)*seqLen),
+		attentionMask: make([]int64, len(rows)*seqLen),
+		tokenTypeIDs:  make([]int64, len(rows)*seqLen),
+		batchSize:     len(rows),
+		seqLen:        seqLen,
+	}
+	for i, row := range rows {
+		offset := i * seqLen
+		for j := 0; j < seqLen; j++ {
+			if j < len(row) {
+				batch.inputIDs[offset+j] = row[j]
+				batch.attentionMask[offset+j] = 1
+				batch.tokenTypeIDs[offset+j] = types[i][j]
+			} else {
+				batch.inputIDs[offset+j] = t.padID
+			}
+		}
+	}
+	return batch, nil
+}
@@ -0,0 +1,122 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package local
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testVocab = []string{
+	"[PAD]", "[UNK]", "[CLS]", "[SEP]", "the", "quick", "brown", "fox",
+	"un", "##aff", "##able", "!", "cafe", "中", "国",
+}
+
+func writeTestVocab(t *testing.T) string {
+	path := filepath.Join(t.TempDir(), "vocab.txt")
+	require.NoError(t, os.WriteFile(path, []byte(strings.Join(testVocab, "\n")), 0o600))
+	return path
+}
+
+func writeTestTokenizerJSON(t *testing.T, lowercase bool) string {
+	vocab := make(map[string]int64, len(testVocab))
+	for i, token := range testVocab {
+		vocab[token] = int64(i)
+	}
+	data, err := json.Marshal(map[string]any{
+		"model": map[string]any{
+			"type":                      "WordPiece",
+			"vocab":                     vocab,
+			"unk_token":                 "[UNK]",
+			"continuing_subword_prefix": "##",
+			"max_input_chars_per_word":  100,
+		},
+		"normalizer": map[string]any{
+			"type":      "BertNormalizer",
+			"lowercase": lowercase,
+		},
+	})
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "tokenizer.json")
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+	return path
+}
+
+func TestTokenizerTokenize(t *testing.T) {
+	for _, path := range []string{writeTestVocab(t), writeTestTokenizerJSON(t, true)} {
+		tokenizer, err := loadTokenizer(path)
+		require.NoError(t, err)
+
+		// "," is not in the vocabulary
+		assert.Equal(t, []int64{4, 5, 1, 6, 7, 11}, tokenizer.tokenize("The quick, brown\tfox!"))
+		assert.Equal(t, []int64{8, 9, 10}, tokenizer.tokenize("unaffable"))
+		// a word is unknown if any part of it can't be matched
+		assert.Equal(t, []int64{1}, tokenizer.tokenize("unaffablex"))
+		assert.Equal(t, []int64{12}, tokenizer.tokenize("Café"))
+		assert.Equal(t, []int64{13, 14}, tokenizer.tokenize("中国"))
+		assert.Empty(t, tokenizer.tokenize(" \u0000 "))
+	}
+
+	cased, err := loadTokenizer(writeTestTokenizerJSON(t, false))
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1, 5}, cased.tokenize("The quick"))
+}
+
+func TestTokenizerEncodePairs(t *testing.T) {
+	tokenizer, err := loadTokenizer(writeTestVocab(t))
+	require.NoError(t, err)
+
+	batch, err := tokenizer.encodePairs([]string{"the fox", "fox"}, []string{"quick brown fox", "the"}, 6)
+	require.NoError(t, err)
+	assert.Equal(t, 2, batch.batchSize)
+	assert.Equal(t, 6, batch.seqLen)
+	// the longer sequence is truncated first
+	assert.Equal(t, []int64{2, 4, 7, 3, 5, 3, 2, 7, 3, 4, 3, 0}, batch.inputIDs)
+	assert.Equal(t, []int64{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 0}, batch.attentionMask)
+	assert.Equal(t, []int64{0, 0, 0, 0, 1, 1, 0, 0, 0, 1, 1, 0}, batch.tokenTypeIDs)
+
+	batch, err = tokenizer.encodePairs([]string{"quick brown fox"}, nil, 4)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{2, 5, 6, 3}, batch.inputIDs)
+	assert.Equal(t, []int64{0, 0, 0, 0}, batch.tokenTypeIDs)
+
+	_, err = tokenizer.encodePairs([]string{"a"}, []string{"b", "c"}, 6)
+	assert.Error(t, err)
+	_, err = tokenizer.encodePairs([]string{"a"}, []string{"b"}, 3)
+	assert.Error(t, err)
+}
+
+func TestLoadTokenizerError(t *testing.T) {
+	_, err := loadTokenizer(filepath.Join(t.TempDir(), "missing.txt"))
+	assert.Error(t, err)
+
+	path := filepath.Join(t.TempDir(), "tokenizer.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"model": {"type": "Unigram"}}`), 0o600))
+	_, err = loadTokenizer(path)
+	assert.ErrorContains(t, err, "only WordPiece is supported")
+
+	path = filepath.Join(t.TempDir(), "vocab.txt")
+	require.NoError(t, os.WriteFile(path, []byte("[PAD]\n[UNK]\nthe"), 0o600))
+	_, err = loadTokenizer(path)
+	assert.ErrorContains(t, err, "[CLS]")
+}
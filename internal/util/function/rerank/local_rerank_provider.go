@@ -0,0 +1,82 @@
+/*
+ * # Licensed to the LF AI & Data foundation under one
+ * # or more contributor license agreements. See the NOTICE file
+ * # distributed with this work for additional information
+ * # regarding copyright ownership. The ASF licenses this file
+ * # to you under the Apache License, Version 2.0 (the
+ * # "License"); you may not use this file except in compliance
+ * # with the License. You may obtain a copy of the License at
+ * #
+ * #     http://www.apache.org/licenses/LICENSE-2.0
+ * #
+ * # Unless required by applicable law or agreed to in writing, software
+ * # distributed under the License is distributed on an "AS IS" BASIS,
+ * # WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * # See the License for the specific language governing permissions and
+ * # limitations under the License.
+ */
+
+package rerank
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus/internal/util/function/models"
+	"github.com/milvus-io/milvus/internal/util/function/models/local"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+)
+
+// localProvider reranks with a cross-encoder model loaded from the file resources
+// and run in-process, so it needs no external endpoint.
+type localProvider struct {
+	baseProvider
+	encoder *local.CrossEncoder
+}
+
+func newLocalProvider(params []*commonpb.KeyValuePair, conf map[string]string) (ModelProvider, error) {
+	var modelResource, tokenizerResource string
+	maxLength := local.DefaultMaxLength
+	maxBatch := 32
+	var err error
+	for _, param := range params {
+		switch strings.ToLower(param.Key) {
+		case models.ModelResourceParamKey:
+			modelResource = param.Value
+		case models.TokenizerResourceParamKey:
+			tokenizerResource = param.Value
+		case models.MaxLengthParamKey:
+			if maxLength, err = strconv.Atoi(param.Value); err != nil {
+				return nil, merr.WrapErrParameterInvalidMsg("[%s param's value: %s] is not a valid number", models.MaxLengthParamKey, param.Value)
+			}
+		case models.MaxClientBatchSizeParamKey:
+			if maxBatch, err = parseMaxBatch(param.Value); err != nil {
+				return nil, err
+			}
+		default:
+		}
+	}
+
+	intraOpThreads := 0
+	if value := conf[models.IntraOpThreadsConf]; value != "" {
+		if intraOpThreads, err = strconv.Atoi(value); err != nil || intraOpThreads < 0 {
+			return nil, merr.WrapErrParameterInvalidMsg("local rerank provider config %s: %s is not a valid number", models.IntraOpThreadsConf, value)
+		}
+	}
+
+	encoder, err := local.NewCrossEncoder(modelResource, tokenizerResource, maxLength, intraOpThreads)
+	if err != nil {
+		return nil, err
+	}
+	provider := localProvider{
+		baseProvider: baseProvider{batchSize: maxBatch},
+		encoder:      encoder,
+	}
+	return &provider, nil
+}
+
+func (provider *localProvider) Rerank(_ context.Context, query string, docs []string) ([]float32, error) {
+	return provider.encoder.Score(query, docs)
+}
@@ -40,6 +40,7 @@ const (
 	aliProviderName         string = "ali"
 	zillizProviderName      string = "zilliz"
 	huggingFaceProviderName string = "huggingface"
+	localProviderName       string = "local"
 )
 
 func parseMaxBatch(maxBatch string) (int, error) {
@@ -53,7 +54,7 @@ func parseMaxBatch(maxBatch string) (int, error) {
 	}
 }
 
-// ModelProvider is the interface for external rerank model services.
+// ModelProvider is the interface for rerank model services.
 type ModelProvider interface {
 	Rerank(context.Context, string, []string) ([]float32, error)
 	MaxBatch() int
@@ -95,6 +96,8 @@ func NewModelProvider(params []*commonpb.KeyValuePair, extraInfo *models.ModelEx
 				return newZillizProvider(params, conf, extraInfo)
 			case huggingFaceProviderName:
 				return newHuggingFaceProvider(params, conf, credentials, extraInfo)
+			case localProviderName:
+				return newLocalProvider(params, conf)
 			default:
 				return nil, merr.WrapErrParameterInvalidMsg("unknown rerank model provider:%s", param.Value)
 			}
@@ -286,6 +286,40 @@ func (s *RerankModelSuite) TestNewProvider() {
 		_, err := NewModelProvider(params, &models.ModelExtraInfo{ClusterID: "test-cluster", DBName: "test-db"})
 		s.NoError(err)
 	}
+	{
+		params := []*commonpb.KeyValuePair{
+			{Key: providerParamName, Value: "local"},
+			{Key: models.TokenizerResourceParamKey, Value: "tokenizer"},
+		}
+		_, err := NewModelProvider(params, &models.ModelExtraInfo{ClusterID: "test-cluster", DBName: "test-db"})
+		s.ErrorContains(err, "model resource is required")
+	}
+	{
+		params := []*commonpb.KeyValuePair{
+			{Key: providerParamName, Value: "local"},
+			{Key: models.ModelResourceParamKey, Value: "reranker"},
+			{Key: models.TokenizerResourceParamKey, Value: "tokenizer"},
+			{Key: models.MaxLengthParamKey, Value: "NotNum"},
+		}
+		_, err := NewModelProvider(params, &models.ModelExtraInfo{ClusterID: "test-cluster", DBName: "test-db"})
+		s.ErrorContains(err, "is not a valid number")
+	}
+	{
+		params := []*commonpb.KeyValuePair{
+			{Key: providerParamName, Value: "local"},
+			{Key: models.ModelResourceParamKey, Value: "reranker"},
+			{Key: models.TokenizerResourceParamKey, Value: "tokenizer"},
+			{Key: models.MaxLengthParamKey, Value: "256"},
+			{Key: models.MaxClientBatchSizeParamKey, Value: "16"},
+		}
+		provider, err := NewModelProvider(params, &models.ModelExtraInfo{ClusterID: "test-cluster", DBName: "test-db"})
+		s.NoError(err)
+		s.Equal(16, provider.MaxBatch())
+
+		// the file resources are resolved on use
+		_, err = provider.Rerank(context.Background(), "query", []string{"doc"})
+		s.ErrorContains(err, "not found")
+	}
 }
 
 func (s *RerankModelSuite) TestCallVllm() {
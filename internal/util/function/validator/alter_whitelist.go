@@ -49,6 +49,7 @@ import (
 // and use url only as a relocatable API host. model_deployment_id is likewise
 // alterable: it re-points the function to a model deployment on the cluster, and
 // keeping the new deployment model-compatible is the user's responsibility.
+// enable_cache only decides whether the search embeddings are cached.
 var alterableFunctionParams = map[schemapb.FunctionType]typeutil.Set[string]{
 	schemapb.FunctionType_TextEmbedding: typeutil.NewSet(
 		models.URLParamKey,
@@ -57,6 +58,7 @@ var alterableFunctionParams = map[schemapb.FunctionType]typeutil.Set[string]{
 		models.ModelDeploymentIDKey,
 		models.TimeoutMsParamKey,
 		models.MaxClientBatchSizeParamKey,
+		models.EnableCacheParamKey,
 		models.RegionParamKey,
 		models.LocationParamKey,
 		models.ProjectIDParamKey,
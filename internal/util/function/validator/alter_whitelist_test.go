@@ -62,6 +62,11 @@ func TestCheckFunctionAlterAllowed(t *testing.T) {
 		assert.NoError(t, CheckFunctionAlterAllowed(oldFn, newFn))
 	})
 
+	t.Run("toggle enable_cache -> ok", func(t *testing.T) {
+		newFn := embFn(kv("model_name", "m1"), kv("dim", "8"), kv("url", "http://a"), kv("enable_cache", "true"))
+		assert.NoError(t, CheckFunctionAlterAllowed(old, newFn))
+	})
+
 	t.Run("remove whitelisted param -> ok", func(t *testing.T) {
 		newFn := embFn(kv("model_name", "m1"), kv("dim", "8"))
 		assert.NoError(t, CheckFunctionAlterAllowed(old, newFn))
@@ -0,0 +1,575 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package avro
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/hamba/avro/v2"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/schemapb"
+	"github.com/milvus-io/milvus/internal/json"
+	"github.com/milvus-io/milvus/internal/util/importutilv2/common"
+	"github.com/milvus-io/milvus/internal/util/nullutil"
+	pkgcommon "github.com/milvus-io/milvus/pkg/v3/common"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+	"github.com/milvus-io/milvus/pkg/v3/util/parameterutil"
+	"github.com/milvus-io/milvus/pkg/v3/util/timestamptz"
+	"github.com/milvus-io/milvus/pkg/v3/util/typeutil"
+)
+
+// FieldReader converts the decoded values of one avro record field into the row values
+// of a collection field, the values are appended to storage.FieldData directly.
+type FieldReader struct {
+	name       string
+	avroSchema avro.Schema // the declared schema, unions are unwrapped by it
+	field      *schemapb.FieldSchema
+	timezone   string
+
+	dim         int
+	maxLength   int64
+	maxCapacity int64
+}
+
+func NewFieldReader(name string, avroSchema avro.Schema, field *schemapb.FieldSchema, timezone string) (*FieldReader, error) {
+	if !isAvroTypeConvertible(avroSchema, field) {
+		return nil, WrapTypeErr(field, avroSchema.String())
+	}
+	fr := &FieldReader{
+		name:       name,
+		avroSchema: avroSchema,
+		field:      field,
+		timezone:   timezone,
+	}
+
+	var err error
+	dataType := field.GetDataType()
+	switch {
+	case typeutil.IsVectorType(dataType) && !typeutil.IsSparseFloatVectorType(dataType):
+		dim, err := typeutil.GetDim(field)
+		if err != nil {
+			return nil, err
+		}
+		fr.dim = int(dim)
+	case dataType == schemapb.DataType_VarChar || dataType == schemapb.DataType_String:
+		if fr.maxLength, err = parameterutil.GetMaxLength(field); err != nil {
+			return nil, err
+		}
+	case dataType == schemapb.DataType_Array:
+		if fr.maxCapacity, err = parameterutil.GetMaxCapacity(field); err != nil {
+			return nil, err
+		}
+		if field.GetElementType() == schemapb.DataType_VarChar {
+			if fr.maxLength, err = parameterutil.GetMaxLength(field); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return fr, nil
+}
+
+// Convert returns the value of one row in the type accepted by storage.FieldData.AppendRow,
+// a null value is replaced by the default value of field if it has one.
+func (fr *FieldReader) Convert(value any) (any, error) {
+	value = unwrapUnion(fr.avroSchema, value)
+	if value == nil {
+		if fr.field.GetDefaultValue() != nil {
+			return nullutil.GetDefaultValue(fr.field)
+		}
+		if fr.field.GetNullable() {
+			return nil, nil
+		}
+		return nil, WrapNullRowErr(fr.field)
+	}
+
+	switch fr.field.GetDataType() {
+	case schemapb.DataType_Bool:
+		v, ok := value.(bool)
+		if !ok {
+			return nil, fr.wrapValueTypeErr(value)
+		}
+		return v, nil
+	case schemapb.DataType_Int8:
+		v, err := fr.toInt(value, math.MinInt8, math.MaxInt8)
+		return int8(v), err
+	case schemapb.DataType_Int16:
+		v, err := fr.toInt(value, math.MinInt16, math.MaxInt16)
+		return int16(v), err
+	case schemapb.DataType_Int32:
+		v, err := fr.toInt(value, math.MinInt32, math.MaxInt32)
+		return int32(v), err
+	case schemapb.DataType_Int64:
+		return fr.toInt(value, math.MinInt64, math.MaxInt64)
+	case schemapb.DataType_Float:
+		v, err := fr.toFloat(value)
+		if err != nil {
+			return nil, err
+		}
+		if v > math.MaxFloat32 || v < -math.MaxFloat32 {
+			return nil, merr.WrapErrImportFailedMsg("value %v overflows float for field '%s'", v, fr.field.GetName())
+		}
+		return float32(v), nil
+	case schemapb.DataType_Double:
+		return fr.toFloat(value)
+	case schemapb.DataType_VarChar, schemapb.DataType_String:
+		v, ok := value.(string)
+		if !ok {
+			return nil, fr.wrapValueTypeErr(value)
+		}
+		if err := common.CheckValidString(v, fr.maxLength, fr.field); err != nil {
+			return nil, err
+		}
+		return v, nil
+	case schemapb.DataType_Text:
+		v, ok := value.(string)
+		if !ok {
+			return nil, fr.wrapValueTypeErr(value)
+		}
+		if err := common.CheckValidUTF8(v, fr.field); err != nil {
+			return nil, err
+		}
+		return v, nil
+	case schemapb.DataType_JSON:
+		return fr.toJSON(value)
+	case schemapb.DataType_Geometry:
+		v, ok := value.(string)
+		if !ok {
+			return nil, fr.wrapValueTypeErr(value)
+		}
+		return pkgcommon.ConvertWKTToWKB(v)
+	case schemapb.DataType_Timestamptz:
+		return fr.toTimestamptz(value)
+	case schemapb.DataType_FloatVector:
+		return fr.toFloatVector(value)
+	case schemapb.DataType_Float16Vector, schemapb.DataType_BFloat16Vector:
+		return fr.toHalfVector(value)
+	case schemapb.DataType_BinaryVector:
+		return fr.toBinaryVector(value)
+	case schemapb.DataType_Int8Vector:
+		return fr.toInt8Vector(value)
+	case schemapb.DataType_SparseFloatVector:
+		return fr.toSparseFloatVector(value)
+	case schemapb.DataType_Array:
+		return fr.toArray(value)
+	default:
+		return nil, merr.WrapErrImportFailedMsg("unsupported data type '%s' for field '%s'",
+			fr.field.GetDataType().String(), fr.field.GetName())
+	}
+}
+
+func (fr *FieldReader) wrapValueTypeErr(value any) error {
+	return merr.WrapErrImportFailedMsg("unexpected value type %T of avro field '%s' for field '%s'", value, fr.name, fr.field.GetName())
+}
+
+// toInt converts the avro integer values, int is decoded as int and long as int64.
+func (fr *FieldReader) toInt(value any, minValue, maxValue int64) (int64, error) {
+	var v int64
+	switch n := value.(type) {
+	case int:
+		v = int64(n)
+	case int64:
+		v = n
+	default:
+		return 0, fr.wrapValueTypeErr(value)
+	}
+	if v < minValue || v > maxValue {
+		return 0, merr.WrapErrImportFailedMsg("value %d overflows %s for field '%s'", v, fr.field.GetDataType().String(), fr.field.GetName())
+	}
+	return v, nil
+}
+
+func (fr *FieldReader) toFloat(value any) (float64, error) {
+	var v float64
+	switch n := value.(type) {
+	case float32:
+		v = float64(n)
+	case float64:
+		v = n
+	case *big.Rat:
+		v, _ = n.Float64()
+	case int:
+		v = float64(n)
+	case int64:
+		v = float64(n)
+	default:
+		return 0, fr.wrapValueTypeErr(value)
+	}
+	if err := typeutil.VerifyFloat(v); err != nil {
+		return 0, merr.WrapErrImportFailedMsg("invalid value of field '%s', err=%v", fr.field.GetName(), err)
+	}
+	return v, nil
+}
+
+func (fr *FieldReader) toJSON(value any) ([]byte, error) {
+	v, ok := value.(string)
+	if !ok {
+		return nil, fr.wrapValueTypeErr(value)
+	}
+	var obj any
+	if err := json.Unmarshal([]byte(v), &obj); err != nil {
+		return nil, merr.WrapErrImportFailedMsg("invalid JSON value of field '%s', err=%v", fr.field.GetName(), err)
+	}
+	if _, ok = obj.(map[string]any); fr.field.GetIsDynamic() && !ok {
+		return nil, merr.WrapErrImportFailedMsg("the value of dynamic field '%s' must be a JSON object", fr.field.GetName())
+	}
+	return []byte(v), nil
+}
+
+// toTimestamptz accepts avro date and timestamp values, or strings in ISO 8601 format,
+// the schema timezone is used if a string has no explicit offset.
+func (fr *FieldReader) toTimestamptz(value any) (int64, error) {
+	switch v := value.(type) {
+	case time.Time:
+		return v.UnixMicro(), nil
+	case string:
+		return timestamptz.ValidateAndReturnUnixMicroTz(v, fr.timezone)
+	}
+	return 0, fr.wrapValueTypeErr(value)
+}
+
+// toFloats converts an array of avro float or double values.
+func (fr *FieldReader) toFloats(value any) ([]float32, error) {
+	list, ok := value.([]any)
+	if !ok {
+		return nil, fr.wrapValueTypeErr(value)
+	}
+	if len(list) != fr.dim {
+		return nil, merr.WrapErrImportFailedMsg("expect %d elements of vector field '%s', but got %d", fr.dim, fr.field.GetName(), len(list))
+	}
+	vector := make([]float32, 0, len(list))
+	for _, elem := range list {
+		switch f := elem.(type) {
+		case float32:
+			vector = append(vector, f)
+		case float64:
+			vector = append(vector, float32(f))
+		case nil:
+			return nil, WrapNullElementErr(fr.field)
+		default:
+			return nil, fr.wrapValueTypeErr(elem)
+		}
+	}
+	if err := typeutil.VerifyFloats32(vector); err != nil {
+		return nil, merr.WrapErrImportFailedMsg("invalid value of field '%s', err=%v", fr.field.GetName(), err)
+	}
+	return vector, nil
+}
+
+// toFloatVector accepts a float array, or little-endian float32 bytes.
+func (fr *FieldReader) toFloatVector(value any) ([]float32, error) {
+	bs, ok := toBytes(value)
+	if !ok {
+		return fr.toFloats(value)
+	}
+	if len(bs) != fr.dim*4 {
+		return nil, merr.WrapErrImportFailedMsg("expect %d bytes of vector field '%s', but got %d", fr.dim*4, fr.field.GetName(), len(bs))
+	}
+	vector := make([]float32, 0, fr.dim)
+	for i := 0; i < len(bs); i += 4 {
+		vector = append(vector, math.Float32frombits(binary.LittleEndian.Uint32(bs[i:i+4])))
+	}
+	if err := typeutil.VerifyFloats32(vector); err != nil {
+		return nil, merr.WrapErrImportFailedMsg("invalid value of field '%s', err=%v", fr.field.GetName(), err)
+	}
+	return vector, nil
+}
+
+// toHalfVector accepts a float array which is converted into float16/bfloat16 directly,
+// or the raw float16/bfloat16 bytes.
+func (fr *FieldReader) toHalfVector(value any) ([]byte, error) {
+	if bs, ok := toBytes(value); ok {
+		if len(bs) != fr.dim*2 {
+			return nil, merr.WrapErrImportFailedMsg("expect %d bytes of vector field '%s', but got %d", fr.dim*2, fr.field.GetName(), len(bs))
+		}
+		return bs, nil
+	}
+	vector, err := fr.toFloats(value)
+	if err != nil {
+		return nil, err
+	}
+	if fr.field.GetDataType() == schemapb.DataType_Float16Vector {
+		return typeutil.Float32ArrayToFloat16Bytes(vector), nil
+	}
+	return typeutil.Float32ArrayToBFloat16Bytes(vector), nil
+}
+
+// toBinaryVector accepts the raw bytes, or an array of uint8 values.
+func (fr *FieldReader) toBinaryVector(value any) ([]byte, error) {
+	if bs, ok := toBytes(value); ok {
+		if len(bs) != fr.dim/8 {
+			return nil, merr.WrapErrImportFailedMsg("expect %d bytes of vector field '%s', but got %d", fr.dim/8, fr.field.GetName(), len(bs))
+		}
+		return bs, nil
+	}
+	list, ok := value.([]any)
+	if !ok {
+		return nil, fr.wrapValueTypeErr(value)
+	}
+	if len(list) != fr.dim/8 {
+		return nil, merr.WrapErrImportFailedMsg("expect %d elements of vector field '%s', but got %d", fr.dim/8, fr.field.GetName(), len(list))
+	}
+	vector := make([]byte, 0, len(list))
+	for _, elem := range list {
+		if elem == nil {
+			return nil, WrapNullElementErr(fr.field)
+		}
+		v, err := fr.toInt(elem, 0, math.MaxUint8)
+		if err != nil {
+			return nil, err
+		}
+		vector = append(vector, byte(v))
+	}
+	return vector, nil
+}
+
+func (fr *FieldReader) toInt8Vector(value any) ([]int8, error) {
+	list, ok := value.([]any)
+	if !ok {
+		return nil, fr.wrapValueTypeErr(value)
+	}
+	if len(list) != fr.dim {
+		return nil, merr.WrapErrImportFailedMsg("expect %d elements of vector field '%s', but got %d", fr.dim, fr.field.GetName(), len(list))
+	}
+	vector := make([]int8, 0, len(list))
+	for _, elem := range list {
+		if elem == nil {
+			return nil, WrapNullElementErr(fr.field)
+		}
+		v, err := fr.toInt(elem, math.MinInt8, math.MaxInt8)
+		if err != nil {
+			return nil, err
+		}
+		vector = append(vector, int8(v))
+	}
+	return vector, nil
+}
+
+// toSparseFloatVector accepts a JSON string in the formats of JSON import, or a map from
+// the indices to the float values, the keys of avro maps are always strings.
+func (fr *FieldReader) toSparseFloatVector(value any) ([]byte, error) {
+	switch v := value.(type) {
+	case string:
+		row, err := typeutil.CreateSparseFloatRowFromJSON([]byte(v))
+		if err != nil {
+			return nil, merr.WrapErrImportFailedMsg("invalid JSON string for sparse vector field '%s', err=%v", fr.field.GetName(), err)
+		}
+		return row, nil
+	case map[string]any:
+		indices := make([]uint32, 0, len(v))
+		values := make([]float32, 0, len(v))
+		for key, elem := range v {
+			if elem == nil {
+				return nil, WrapNullElementErr(fr.field)
+			}
+			index, err := strconv.ParseUint(key, 10, 32)
+			if err != nil {
+				return nil, merr.WrapErrImportFailedMsg("invalid index '%s' of sparse vector field '%s'", key, fr.field.GetName())
+			}
+			f, err := fr.toFloat(elem)
+			if err != nil {
+				return nil, err
+			}
+			indices = append(indices, uint32(index))
+			values = append(values, float32(f))
+		}
+		indices, values = typeutil.SortSparseFloatRow(indices, values)
+		return typeutil.CreateSparseFloatRow(indices, values), nil
+	}
+	return nil, fr.wrapValueTypeErr(value)
+}
+
+func (fr *FieldReader) toArray(value any) (*schemapb.ScalarField, error) {
+	list, ok := value.([]any)
+	if !ok {
+		return nil, fr.wrapValueTypeErr(value)
+	}
+	if err := common.CheckArrayCapacity(len(list), fr.maxCapacity, fr.field); err != nil {
+		return nil, err
+	}
+	for _, elem := range list {
+		if elem == nil {
+			return nil, WrapNullElementErr(fr.field)
+		}
+	}
+
+	switch fr.field.GetElementType() {
+	case schemapb.DataType_Bool:
+		data := make([]bool, 0, len(list))
+		for _, elem := range list {
+			v, ok := elem.(bool)
+			if !ok {
+				return nil, fr.wrapValueTypeErr(elem)
+			}
+			data = append(data, v)
+		}
+		return &schemapb.ScalarField{Data: &schemapb.ScalarField_BoolData{BoolData: &schemapb.BoolArray{Data: data}}}, nil
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
+		minValue, maxValue := int64(math.MinInt32), int64(math.MaxInt32)
+		switch fr.field.GetElementType() {
+		case schemapb.DataType_Int8:
+			minValue, maxValue = math.MinInt8, math.MaxInt8
+		case schemapb.DataType_Int16:
+			minValue, maxValue = math.MinInt16, math.MaxInt16
+		}
+		data := make([]int32, 0, len(list))
+		for _, elem := range list {
+			v, err := fr.toInt(elem, minValue, maxValue)
+			if err != nil {
+				return nil, err
+			}
+			data = append(data, int32(v))
+		}
+		return &schemapb.ScalarField{Data: &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{Data: data}}}, nil
+	case schemapb.DataType_Int64:
+		data := make([]int64, 0, len(list))
+		for _, elem := range list {
+			v, err := fr.toInt(elem, math.MinInt64, math.MaxInt64)
+			if err != nil {
+				return nil, err
+			}
+			data = append(data, v)
+		}
+		return &schemapb.ScalarField{Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: data}}}, nil
+	case schemapb.DataType_Float:
+		data := make([]float32, 0, len(list))
+		for _, elem := range list {
+			v, err := fr.toFloat(elem)
+			if err != nil {
+				return nil, err
+			}
+			data = append(data, float32(v))
+		}
+		return &schemapb.ScalarField{Data: &schemapb.ScalarField_FloatData{FloatData: &schemapb.FloatArray{Data: data}}}, nil
+	case schemapb.DataType_Double:
+		data := make([]float64, 0, len(list))
+		for _, elem := range list {
+			v, err := fr.toFloat(elem)
+			if err != nil {
+				return nil, err
+			}
+			data = append(data, v)
+		}
+		return &schemapb.ScalarField{Data: &schemapb.ScalarField_DoubleData{DoubleData: &schemapb.DoubleArray{Data: data}}}, nil
+	case schemapb.DataType_VarChar, schemapb.DataType_String:
+		data := make([]string, 0, len(list))
+		for _, elem := range list {
+			v, ok := elem.(string)
+			if !ok {
+				return nil, fr.wrapValueTypeErr(elem)
+			}
+			if err := common.CheckValidString(v, fr.maxLength, fr.field); err != nil {
+				return nil, err
+			}
+			data = append(data, v)
+		}
+		return &schemapb.ScalarField{Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: data}}}, nil
+	}
+	return nil, merr.WrapErrImportFailedMsg("unsupported element type '%s' of array field '%s'",
+		fr.field.GetElementType().String(), fr.field.GetName())
+}
+
+// unwrapUnion removes the {"typeName": value} wrappers which the avro decoder produces
+// for union values whose type cannot be resolved to a go type, such as records.
+func unwrapUnion(schema avro.Schema, value any) any {
+	if value == nil {
+		return nil
+	}
+	switch s := schema.(type) {
+	case *avro.UnionSchema:
+		if wrapped, ok := value.(map[string]any); ok && len(wrapped) == 1 {
+			for _, member := range s.Types() {
+				if inner, ok := wrapped[unionTypeName(member)]; ok {
+					return unwrapUnion(member, inner)
+				}
+			}
+		}
+		for _, member := range s.Types() {
+			if member.Type() != avro.Null {
+				return unwrapUnion(member, value)
+			}
+		}
+	case *avro.ArraySchema:
+		if values, ok := value.([]any); ok {
+			for i := range values {
+				values[i] = unwrapUnion(s.Items(), values[i])
+			}
+		}
+	case *avro.MapSchema:
+		if values, ok := value.(map[string]any); ok {
+			for key := range values {
+				values[key] = unwrapUnion(s.Values(), values[key])
+			}
+		}
+	}
+	return value
+}
+
+// unionTypeName returns the key used by the avro decoder for a union member.
+func unionTypeName(schema avro.Schema) string {
+	if named, ok := schema.(avro.NamedSchema); ok {
+		return named.FullName()
+	}
+	name := string(schema.Type())
+	if logical, ok := schema.(avro.LogicalTypeSchema); ok && logical.Logical() != nil {
+		name += "." + string(logical.Logical().Type())
+	}
+	return name
+}
+
+// toBytes returns the content of avro bytes, or fixed which is decoded as a byte array.
+func toBytes(value any) ([]byte, bool) {
+	if bs, ok := value.([]byte); ok {
+		return bs, true
+	}
+	rv := reflect.ValueOf(value)
+	if rv.Kind() == reflect.Array && rv.Type().Elem().Kind() == reflect.Uint8 {
+		bs := make([]byte, rv.Len())
+		reflect.Copy(reflect.ValueOf(bs), rv)
+		return bs, true
+	}
+	return nil, false
+}
+
+func WrapTypeErr(expect *schemapb.FieldSchema, actual string) error {
+	nullable := ""
+	if expect.GetNullable() {
+		nullable = "nullable "
+	}
+	elementType := ""
+	if expect.GetDataType() == schemapb.DataType_Array {
+		elementType = expect.GetElementType().String() + " "
+	}
+	return merr.WrapErrImportFailed(
+		fmt.Sprintf("expect %s'%s%s' type for field '%s', but got '%s' type",
+			nullable, elementType, expect.GetDataType().String(), expect.GetName(), actual))
+}
+
+func WrapNullRowErr(field *schemapb.FieldSchema) error {
+	return merr.WrapErrImportFailed(
+		fmt.Sprintf("the field '%s' is not nullable but the file contains null value", field.GetName()))
+}
+
+func WrapNullElementErr(field *schemapb.FieldSchema) error {
+	return merr.WrapErrImportFailed(
+		fmt.Sprintf("array element is not allowed to be null value for field '%s'", field.GetName()))
+}
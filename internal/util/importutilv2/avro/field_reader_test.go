@@ -0,0 +1,210 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package avro
+
+import (
+	"encoding/binary"
+	"math"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/hamba/avro/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v3/schemapb"
+	"github.com/milvus-io/milvus/pkg/v3/common"
+	"github.com/milvus-io/milvus/pkg/v3/util/typeutil"
+)
+
+func newTestFieldReader(t *testing.T, avroType string, field *schemapb.FieldSchema) *FieldReader {
+	fr, err := NewFieldReader(field.GetName(), avro.MustParse(avroType), field, "UTC")
+	require.NoError(t, err)
+	return fr
+}
+
+func TestFieldReaderScalar(t *testing.T) {
+	// avro has no integer narrower than int, the values are checked by range
+	fr := newTestFieldReader(t, `"int"`, &schemapb.FieldSchema{Name: "i8", DataType: schemapb.DataType_Int8})
+	v, err := fr.Convert(-3)
+	assert.NoError(t, err)
+	assert.Equal(t, int8(-3), v)
+	_, err = fr.Convert(128)
+	assert.Error(t, err)
+
+	fr = newTestFieldReader(t, `"long"`, &schemapb.FieldSchema{Name: "i64", DataType: schemapb.DataType_Int64})
+	v, err = fr.Convert(int64(math.MaxInt64))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(math.MaxInt64), v)
+	_, err = fr.Convert("1")
+	assert.Error(t, err)
+
+	// long could only be converted into int64
+	_, err = NewFieldReader("i32", avro.MustParse(`"long"`), &schemapb.FieldSchema{Name: "i32", DataType: schemapb.DataType_Int32}, "UTC")
+	assert.Error(t, err)
+
+	fr = newTestFieldReader(t, `{"type": "bytes", "logicalType": "decimal", "precision": 10, "scale": 2}`,
+		&schemapb.FieldSchema{Name: "d", DataType: schemapb.DataType_Double})
+	v, err = fr.Convert(big.NewRat(1234, 100))
+	assert.NoError(t, err)
+	assert.Equal(t, 12.34, v)
+
+	fr = newTestFieldReader(t, `"double"`, &schemapb.FieldSchema{Name: "f", DataType: schemapb.DataType_Float})
+	_, err = fr.Convert(math.MaxFloat64)
+	assert.Error(t, err)
+	_, err = fr.Convert(math.NaN())
+	assert.Error(t, err)
+
+	fr = newTestFieldReader(t, `{"type": "enum", "name": "color", "symbols": ["red", "blue"]}`, &schemapb.FieldSchema{
+		Name: "s", DataType: schemapb.DataType_VarChar,
+		TypeParams: []*commonpb.KeyValuePair{{Key: common.MaxLengthKey, Value: "4"}},
+	})
+	v, err = fr.Convert("blue")
+	assert.NoError(t, err)
+	assert.Equal(t, "blue", v)
+	_, err = fr.Convert("yellow")
+	assert.Error(t, err)
+
+	fr = newTestFieldReader(t, `"string"`, &schemapb.FieldSchema{Name: "geo", DataType: schemapb.DataType_Geometry})
+	v, err = fr.Convert("POINT (1 2)")
+	assert.NoError(t, err)
+	assert.NotEmpty(t, v)
+	_, err = fr.Convert("not a wkt")
+	assert.Error(t, err)
+}
+
+func TestFieldReaderNullAndDefault(t *testing.T) {
+	fr := newTestFieldReader(t, `["null", "int"]`, &schemapb.FieldSchema{Name: "a", DataType: schemapb.DataType_Int32})
+	_, err := fr.Convert(nil)
+	assert.Error(t, err)
+
+	fr = newTestFieldReader(t, `["null", "int"]`, &schemapb.FieldSchema{Name: "a", DataType: schemapb.DataType_Int32, Nullable: true})
+	v, err := fr.Convert(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, v)
+	v, err = fr.Convert(5)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(5), v)
+
+	fr = newTestFieldReader(t, `["null", "string"]`, &schemapb.FieldSchema{
+		Name: "a", DataType: schemapb.DataType_VarChar, Nullable: true,
+		TypeParams:   []*commonpb.KeyValuePair{{Key: common.MaxLengthKey, Value: "8"}},
+		DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_StringData{StringData: "def"}},
+	})
+	v, err = fr.Convert(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "def", v)
+
+	_, err = NewFieldReader("a", avro.MustParse(`["int", "string"]`), &schemapb.FieldSchema{Name: "a", DataType: schemapb.DataType_Int32}, "UTC")
+	assert.Error(t, err)
+}
+
+func TestFieldReaderJSON(t *testing.T) {
+	fr := newTestFieldReader(t, `"string"`, &schemapb.FieldSchema{Name: "j", DataType: schemapb.DataType_JSON})
+	v, err := fr.Convert(`[1, 2]`)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte(`[1, 2]`), v)
+	_, err = fr.Convert(`{invalid`)
+	assert.Error(t, err)
+
+	fr = newTestFieldReader(t, `"string"`, &schemapb.FieldSchema{Name: "$meta", DataType: schemapb.DataType_JSON, IsDynamic: true})
+	v, err = fr.Convert(`{"a": 1}`)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte(`{"a": 1}`), v)
+	_, err = fr.Convert(`[1]`)
+	assert.Error(t, err)
+}
+
+func TestFieldReaderTimestamptz(t *testing.T) {
+	field := &schemapb.FieldSchema{Name: "ts", DataType: schemapb.DataType_Timestamptz}
+	ts := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	fr := newTestFieldReader(t, `{"type": "long", "logicalType": "timestamp-micros"}`, field)
+	v, err := fr.Convert(ts)
+	assert.NoError(t, err)
+	assert.Equal(t, ts.UnixMicro(), v)
+
+	fr = newTestFieldReader(t, `"string"`, field)
+	v, err = fr.Convert("2024-01-02T03:04:05Z")
+	assert.NoError(t, err)
+	assert.Equal(t, ts.UnixMicro(), v)
+
+	// a plain long has no time unit
+	_, err = NewFieldReader("ts", avro.MustParse(`"long"`), field, "UTC")
+	assert.Error(t, err)
+}
+
+func TestFieldReaderVector(t *testing.T) {
+	dim := []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "2"}}
+
+	floatField := &schemapb.FieldSchema{Name: "fv", DataType: schemapb.DataType_FloatVector, TypeParams: dim}
+	fr := newTestFieldReader(t, `{"type": "array", "items": "double"}`, floatField)
+	v, err := fr.Convert([]any{1.0, 2.5})
+	assert.NoError(t, err)
+	assert.Equal(t, []float32{1, 2.5}, v)
+	_, err = fr.Convert([]any{1.0})
+	assert.Error(t, err)
+
+	// the little-endian bytes of float32 values in fixed
+	fr = newTestFieldReader(t, `{"type": "fixed", "name": "fv", "size": 8}`, floatField)
+	var bs [8]byte
+	binary.LittleEndian.PutUint32(bs[0:], math.Float32bits(1))
+	binary.LittleEndian.PutUint32(bs[4:], math.Float32bits(2.5))
+	v, err = fr.Convert(bs)
+	assert.NoError(t, err)
+	assert.Equal(t, []float32{1, 2.5}, v)
+
+	fr = newTestFieldReader(t, `{"type": "array", "items": "float"}`,
+		&schemapb.FieldSchema{Name: "hv", DataType: schemapb.DataType_Float16Vector, TypeParams: dim})
+	v, err = fr.Convert([]any{float32(1), float32(2.5)})
+	assert.NoError(t, err)
+	assert.Equal(t, typeutil.Float32ArrayToFloat16Bytes([]float32{1, 2.5}), v)
+
+	fr = newTestFieldReader(t, `{"type": "array", "items": "int"}`,
+		&schemapb.FieldSchema{Name: "iv", DataType: schemapb.DataType_Int8Vector, TypeParams: dim})
+	v, err = fr.Convert([]any{-1, 127})
+	assert.NoError(t, err)
+	assert.Equal(t, []int8{-1, 127}, v)
+	_, err = fr.Convert([]any{-1, 128})
+	assert.Error(t, err)
+
+	fr = newTestFieldReader(t, `{"type": "map", "values": "float"}`,
+		&schemapb.FieldSchema{Name: "sv", DataType: schemapb.DataType_SparseFloatVector})
+	v, err = fr.Convert(map[string]any{"3": float32(0.5), "1": float32(0.25)})
+	assert.NoError(t, err)
+	assert.Equal(t, typeutil.CreateSparseFloatRow([]uint32{1, 3}, []float32{0.25, 0.5}), v)
+	_, err = fr.Convert(map[string]any{"x": float32(0.5)})
+	assert.Error(t, err)
+}
+
+func TestFieldReaderArray(t *testing.T) {
+	field := &schemapb.FieldSchema{
+		Name: "arr", DataType: schemapb.DataType_Array, ElementType: schemapb.DataType_Int64,
+		TypeParams: []*commonpb.KeyValuePair{{Key: common.MaxCapacityKey, Value: "2"}},
+	}
+	fr := newTestFieldReader(t, `{"type": "array", "items": "long"}`, field)
+	v, err := fr.Convert([]any{int64(1), int64(2)})
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{1, 2}, v.(*schemapb.ScalarField).GetLongData().GetData())
+	_, err = fr.Convert([]any{int64(1), int64(2), int64(3)})
+	assert.Error(t, err)
+
+	_, err = NewFieldReader("arr", avro.MustParse(`{"type": "array", "items": "string"}`), field, "UTC")
+	assert.Error(t, err)
+}
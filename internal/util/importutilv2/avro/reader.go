@@ -0,0 +1,149 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package avro
+
+import (
+	"context"
+	"io"
+
+	"github.com/hamba/avro/v2"
+	"github.com/hamba/avro/v2/ocf"
+	"go.uber.org/atomic"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/importutilv2/common"
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+)
+
+const schemaMetaKey = "avro.schema"
+
+type reader struct {
+	ctx    context.Context
+	cm     storage.ChunkManager
+	cmr    storage.FileReader
+	schema *schemapb.CollectionSchema
+
+	fileSize *atomic.Int64
+	filePath string
+	dec      *ocf.Decoder
+
+	bufferSize int
+	count      int64
+
+	frs []*FieldReader // in the order of the record fields
+}
+
+// NewReader creates a reader of Avro object container file, each avro record is a row,
+// record fields are mapped to collection fields by name and converted by the FieldReaders.
+func NewReader(ctx context.Context, cm storage.ChunkManager, schema *schemapb.CollectionSchema, path string, bufferSize int) (*reader, error) {
+	r, err := cm.Reader(ctx, path)
+	if err != nil {
+		return nil, merr.Wrapf(err, "read avro file failed, path=%s", path)
+	}
+	retryableReader := common.NewRetryableReaderWithReopen(ctx, path, r, common.NewChunkManagerReopenReaderFunc(cm), cm.Size)
+	dec, err := ocf.NewDecoder(retryableReader)
+	if err != nil {
+		retryableReader.Close()
+		return nil, merr.WrapErrImportFailedMsg("new avro decoder failed, err=%v", err)
+	}
+	avroSchema, err := avro.Parse(string(dec.Metadata()[schemaMetaKey]))
+	if err != nil {
+		retryableReader.Close()
+		return nil, merr.WrapErrImportFailedMsg("invalid avro schema, err=%v", err)
+	}
+	frs, err := CreateFieldReaders(ctx, avroSchema, schema)
+	if err != nil {
+		retryableReader.Close()
+		return nil, err
+	}
+	count, err := common.EstimateReadCountPerBatch(bufferSize, schema)
+	if err != nil {
+		retryableReader.Close()
+		return nil, err
+	}
+	mlog.Info(ctx, "avro file info", mlog.String("path", path), mlog.String("schema", avroSchema.String()))
+	return &reader{
+		ctx:        ctx,
+		cm:         cm,
+		cmr:        retryableReader,
+		schema:     schema,
+		fileSize:   atomic.NewInt64(0),
+		filePath:   path,
+		dec:        dec,
+		bufferSize: bufferSize,
+		count:      count,
+		frs:        frs,
+	}, nil
+}
+
+func (r *reader) Read() (*storage.InsertData, error) {
+	insertData, err := storage.NewInsertDataWithFunctionOutputField(r.schema)
+	if err != nil {
+		return nil, err
+	}
+	var cnt int64 = 0
+	for r.dec.HasNext() {
+		var record map[string]any
+		if err = r.dec.Decode(&record); err != nil {
+			return nil, merr.WrapErrImportFailedMsg("failed to decode avro record, error: %v", err)
+		}
+		for _, fr := range r.frs {
+			value, err := fr.Convert(record[fr.name])
+			if err != nil {
+				return nil, err
+			}
+			if err = insertData.Data[fr.field.GetFieldID()].AppendRow(value); err != nil {
+				return nil, merr.WrapErrImportFailedMsg("failed to append value of field '%s', err=%s", fr.field.GetName(), err.Error())
+			}
+		}
+		cnt++
+		if cnt >= r.count {
+			cnt = 0
+			if insertData.GetMemorySize() >= r.bufferSize {
+				break
+			}
+		}
+	}
+	if err = r.dec.Error(); err != nil {
+		return nil, merr.WrapErrImportFailedMsg("failed to read avro file, error: %v", err)
+	}
+	if insertData.GetRowNum() == 0 {
+		return nil, io.EOF
+	}
+	common.RemoveUnpopulatedFunctionOutputFields(r.schema, insertData)
+	return insertData, nil
+}
+
+func (r *reader) Size() (int64, error) {
+	if size := r.fileSize.Load(); size != 0 {
+		return size, nil
+	}
+	size, err := r.cm.Size(r.ctx, r.filePath)
+	if err != nil {
+		return 0, err
+	}
+	r.fileSize.Store(size)
+	return size, nil
+}
+
+func (r *reader) Close() {
+	if r.cmr != nil {
+		r.cmr.Close()
+	}
+}
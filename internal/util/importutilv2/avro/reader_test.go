@@ -0,0 +1,181 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package avro
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"testing"
+
+	"github.com/hamba/avro/v2"
+	"github.com/hamba/avro/v2/ocf"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v3/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/v3/common"
+	"github.com/milvus-io/milvus/pkg/v3/objectstorage"
+)
+
+const testAvroSchema = `{
+	"type": "record",
+	"name": "row",
+	"namespace": "test",
+	"fields": [
+		{"name": "pk", "type": "long"},
+		{"name": "name", "type": "string"},
+		{"name": "age", "type": ["null", "int"], "default": null},
+		{"name": "meta", "type": "string"},
+		{"name": "vec", "type": {"type": "array", "items": "float"}},
+		{"name": "bin", "type": "bytes"},
+		{"name": "extra", "type": ["null", {"type": "record", "name": "extra", "fields": [{"name": "v", "type": "double"}]}]},
+		{"name": "_meta", "type": ["null", "string"], "default": null}
+	]
+}`
+
+type ReaderSuite struct {
+	suite.Suite
+
+	dir    string
+	cm     storage.ChunkManager
+	schema *schemapb.CollectionSchema
+}
+
+func (s *ReaderSuite) SetupTest() {
+	s.dir = s.T().TempDir()
+	s.cm = storage.NewLocalChunkManager(objectstorage.RootPath(s.dir))
+	s.schema = &schemapb.CollectionSchema{
+		EnableDynamicField: true,
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "name", DataType: schemapb.DataType_VarChar, TypeParams: []*commonpb.KeyValuePair{{Key: common.MaxLengthKey, Value: "64"}}},
+			{FieldID: 102, Name: "age", DataType: schemapb.DataType_Int32, Nullable: true},
+			{FieldID: 103, Name: "meta", DataType: schemapb.DataType_JSON},
+			{FieldID: 104, Name: "vec", DataType: schemapb.DataType_FloatVector, TypeParams: []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "2"}}},
+			{FieldID: 105, Name: "bin", DataType: schemapb.DataType_BinaryVector, TypeParams: []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "16"}}},
+			{FieldID: 106, Name: "$meta", DataType: schemapb.DataType_JSON, IsDynamic: true},
+		},
+	}
+}
+
+func (s *ReaderSuite) writeAvro(name string, numRows int) string {
+	buf := &bytes.Buffer{}
+	enc, err := ocf.NewEncoder(testAvroSchema, buf)
+	s.NoError(err)
+	for i := 0; i < numRows; i++ {
+		var age any
+		if i%2 == 0 {
+			age = i
+		}
+		s.NoError(enc.Encode(map[string]any{
+			"pk":    int64(i),
+			"name":  fmt.Sprintf("name_%d", i),
+			"age":   age,
+			"meta":  fmt.Sprintf(`{"k": %d}`, i),
+			"vec":   []any{float32(i), float32(i) + 0.5},
+			"bin":   []byte{byte(i), 0xff},
+			"extra": map[string]any{"test.extra": map[string]any{"v": float64(i)}},
+			"_meta": map[string]any{"string": fmt.Sprintf(`{"d": %d}`, i)},
+		}))
+	}
+	s.NoError(enc.Close())
+	filePath := path.Join(s.dir, name)
+	s.NoError(s.cm.Write(context.Background(), filePath, buf.Bytes()))
+	return filePath
+}
+
+func (s *ReaderSuite) TestRead() {
+	const numRows = 10
+	filePath := s.writeAvro("test.avro", numRows)
+
+	r, err := NewReader(context.Background(), s.cm, s.schema, filePath, 64*1024*1024)
+	s.NoError(err)
+	defer r.Close()
+	size, err := r.Size()
+	s.NoError(err)
+	s.True(size > 0)
+
+	data, err := r.Read()
+	s.NoError(err)
+	s.Equal(numRows, data.GetRowNum())
+	for i := 0; i < numRows; i++ {
+		s.Equal(int64(i), data.Data[100].GetRow(i))
+		s.Equal(fmt.Sprintf("name_%d", i), data.Data[101].GetRow(i))
+		if i%2 == 0 {
+			s.Equal(int32(i), data.Data[102].GetRow(i))
+		} else {
+			s.Nil(data.Data[102].GetRow(i))
+		}
+		s.Equal([]float32{float32(i), float32(i) + 0.5}, data.Data[104].GetRow(i))
+		s.Equal([]byte{byte(i), 0xff}, data.Data[105].GetRow(i))
+		s.JSONEq(fmt.Sprintf(`{"k": %d}`, i), string(data.Data[103].GetRow(i).([]byte)))
+		// the record field "extra" is not in the collection and ignored, "_meta" is the dynamic field
+		s.JSONEq(fmt.Sprintf(`{"d": %d}`, i), string(data.Data[106].GetRow(i).([]byte)))
+	}
+
+	_, err = r.Read()
+	s.ErrorIs(err, io.EOF)
+}
+
+func (s *ReaderSuite) TestInvalid() {
+	// the root schema must be a record
+	_, err := CreateFieldReaders(context.Background(), avro.MustParse(`{"type": "array", "items": "int"}`), s.schema)
+	s.Error(err)
+
+	// type mismatch
+	_, err = CreateFieldReaders(context.Background(), avro.MustParse(`{"type": "record", "name": "r", "fields": [
+		{"name": "pk", "type": "long"}, {"name": "name", "type": "int"}]}`), s.schema)
+	s.Error(err)
+
+	// only the nullable unions are supported
+	_, err = CreateFieldReaders(context.Background(), avro.MustParse(`{"type": "record", "name": "r", "fields": [
+		{"name": "pk", "type": ["long", "string"]}]}`), s.schema)
+	s.Error(err)
+
+	// the required field "name" is not provided
+	_, err = CreateFieldReaders(context.Background(), avro.MustParse(`{"type": "record", "name": "r", "fields": [
+		{"name": "pk", "type": "long"}, {"name": "meta", "type": "string"},
+		{"name": "vec", "type": {"type": "array", "items": "float"}}, {"name": "bin", "type": "bytes"}]}`), s.schema)
+	s.Error(err)
+
+	// not an avro file
+	badPath := path.Join(s.dir, "bad.avro")
+	s.NoError(s.cm.Write(context.Background(), badPath, []byte("not avro")))
+	_, err = NewReader(context.Background(), s.cm, s.schema, badPath, 1024)
+	s.Error(err)
+
+	// dim mismatch
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 104, Name: "vec", DataType: schemapb.DataType_FloatVector, TypeParams: []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "4"}}},
+		},
+	}
+	r, err := NewReader(context.Background(), s.cm, schema, s.writeAvro("dim.avro", 1), 1024)
+	s.NoError(err)
+	defer r.Close()
+	_, err = r.Read()
+	s.Error(err)
+}
+
+func TestAvroReader(t *testing.T) {
+	suite.Run(t, new(ReaderSuite))
+}
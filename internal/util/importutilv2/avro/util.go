@@ -0,0 +1,242 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package avro
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hamba/avro/v2"
+	"github.com/samber/lo"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/schemapb"
+	common2 "github.com/milvus-io/milvus/internal/util/importutilv2/common"
+	"github.com/milvus-io/milvus/pkg/v3/common"
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+	"github.com/milvus-io/milvus/pkg/v3/util/typeutil"
+)
+
+// dynamicFieldName is the name of the record field which is read into the dynamic field,
+// "$meta" is not a valid avro name.
+const dynamicFieldName = "_meta"
+
+// CreateFieldReaders maps the fields of the avro record to collection fields by name with the
+// rules of parquet import. Record fields which are not in the collection are ignored, the
+// dynamic field is read from the "_meta" field unless the collection has a field of the name.
+func CreateFieldReaders(ctx context.Context, avroSchema avro.Schema, schema *schemapb.CollectionSchema) ([]*FieldReader, error) {
+	recordSchema, ok := avroSchema.(*avro.RecordSchema)
+	if !ok {
+		return nil, merr.WrapErrImportFailedMsg("the root schema of avro file must be a record, but got '%s'", avroSchema.Type())
+	}
+	nameToField := lo.KeyBy(schema.GetFields(), func(field *schemapb.FieldSchema) string {
+		return field.GetName()
+	})
+	structFields := lo.KeyBy(schema.GetStructArrayFields(), func(field *schemapb.StructArrayFieldSchema) string {
+		return field.GetName()
+	})
+	if _, ok := nameToField[dynamicFieldName]; !ok {
+		if dynamicField := typeutil.GetDynamicField(schema); dynamicField != nil {
+			nameToField[dynamicFieldName] = dynamicField
+		}
+	}
+	timezone := common2.GetSchemaTimezone(schema)
+
+	readFields := make(map[string]int64)
+	frs := make([]*FieldReader, 0, len(recordSchema.Fields()))
+	allowInsertAutoID, _ := common.IsAllowInsertAutoID(schema.GetProperties()...)
+	for _, avroField := range recordSchema.Fields() {
+		name := avroField.Name()
+		if _, ok := structFields[name]; ok {
+			return nil, merr.WrapErrImportFailedMsg("struct array field '%s' is not supported by avro import", name)
+		}
+		field, ok := nameToField[name]
+		if !ok {
+			// redundant fields, ignore. only accepts a special field "_meta" to store dynamic data
+			continue
+		}
+
+		// auto-id field must not be provided
+		if typeutil.IsAutoPKField(field) && !allowInsertAutoID {
+			return nil, merr.WrapErrImportFailed(
+				fmt.Sprintf("the primary key '%s' is auto-generated, no need to provide", field.GetName()))
+		}
+		// validate function output field
+		if field.GetIsFunctionOutput() {
+			if typeutil.IsBM25FunctionOutputField(field, schema) {
+				return nil, merr.WrapErrImportFailed(
+					fmt.Sprintf("not allowed to provide data for BM25 function output field '%s'", field.GetName()))
+			}
+			if !common.GetCollectionAllowInsertNonBM25FunctionOutputs(schema.GetProperties()) {
+				return nil, merr.WrapErrImportFailed(
+					fmt.Sprintf("not allowed to provide data for function output field '%s', "+
+						"set collection property '%s' to enable", field.GetName(), common.CollectionAllowInsertNonBM25FunctionOutputs))
+			}
+		}
+		if _, ok = readFields[field.GetName()]; ok {
+			return nil, merr.WrapErrImportFailed(
+				fmt.Sprintf("there is multi field with name: %s", field.GetName()))
+		}
+		fr, err := NewFieldReader(name, avroField.Type(), field, timezone)
+		if err != nil {
+			return nil, err
+		}
+		frs = append(frs, fr)
+		readFields[field.GetName()] = field.GetFieldID()
+	}
+
+	for _, structField := range schema.GetStructArrayFields() {
+		if !structField.GetNullable() {
+			return nil, merr.WrapErrImportFailedMsg("struct array field '%s' is not supported by avro import", structField.GetName())
+		}
+	}
+	// this loop is for "are there any fields not provided in the avro file?"
+	for _, field := range nameToField {
+		// auto-id field, function output field already checked
+		// dynamic field, nullable field, default value field, not provided or provided both ok
+		if typeutil.IsAutoPKField(field) || field.GetIsDynamic() || field.GetIsFunctionOutput() ||
+			field.GetNullable() || field.GetDefaultValue() != nil {
+			continue
+		}
+		// the other field must be provided
+		if _, ok := readFields[field.GetName()]; !ok {
+			return nil, merr.WrapErrImportFailed(
+				fmt.Sprintf("no avro field for milvus field '%s'", field.GetName()))
+		}
+	}
+
+	mlog.Info(ctx, "create avro field readers", mlog.Any("readFields", readFields))
+	return frs, nil
+}
+
+// resolveNullable returns the non-null member of a nullable union, the other unions
+// are not supported.
+func resolveNullable(schema avro.Schema) (avro.Schema, bool) {
+	union, ok := schema.(*avro.UnionSchema)
+	if !ok {
+		return schema, true
+	}
+	if !union.Nullable() {
+		return nil, false
+	}
+	for _, member := range union.Types() {
+		if member.Type() != avro.Null {
+			return member, true
+		}
+	}
+	return nil, false
+}
+
+// logicalType returns the logical type of schema, or an empty string if it has none.
+func logicalType(schema avro.Schema) avro.LogicalType {
+	if s, ok := schema.(avro.LogicalTypeSchema); ok && s.Logical() != nil {
+		return s.Logical().Type()
+	}
+	return ""
+}
+
+func isAvroIntegerType(schema avro.Schema) bool {
+	return (schema.Type() == avro.Int || schema.Type() == avro.Long) && logicalType(schema) == ""
+}
+
+func isAvroFloatingType(schema avro.Schema) bool {
+	switch schema.Type() {
+	case avro.Float, avro.Double:
+		return true
+	case avro.Bytes, avro.Fixed:
+		return logicalType(schema) == avro.Decimal
+	default:
+		return false
+	}
+}
+
+func isAvroStringType(schema avro.Schema) bool {
+	return schema.Type() == avro.String || schema.Type() == avro.Enum
+}
+
+func isAvroBytesType(schema avro.Schema) bool {
+	return (schema.Type() == avro.Bytes || schema.Type() == avro.Fixed) && logicalType(schema) == ""
+}
+
+// isAvroIntegerConvertible returns whether the avro integer type can be converted into the
+// integer data type. Avro has no integer types narrower than int, so int is accepted by
+// all the integer types and the values are checked by range, long is only accepted by Int64.
+func isAvroIntegerConvertible(schema avro.Schema, dataType schemapb.DataType) bool {
+	if !isAvroIntegerType(schema) {
+		return false
+	}
+	return schema.Type() == avro.Int || dataType == schemapb.DataType_Int64
+}
+
+func isAvroScalarConvertible(schema avro.Schema, dataType schemapb.DataType) bool {
+	switch dataType {
+	case schemapb.DataType_Bool:
+		return schema.Type() == avro.Boolean
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32, schemapb.DataType_Int64:
+		return isAvroIntegerConvertible(schema, dataType)
+	case schemapb.DataType_Float, schemapb.DataType_Double:
+		return isAvroIntegerType(schema) || isAvroFloatingType(schema)
+	case schemapb.DataType_VarChar, schemapb.DataType_String:
+		return isAvroStringType(schema)
+	default:
+		return false
+	}
+}
+
+// arrayItems returns the resolved item schema if schema is an array.
+func arrayItems(schema avro.Schema) (avro.Schema, bool) {
+	array, ok := schema.(*avro.ArraySchema)
+	if !ok {
+		return nil, false
+	}
+	return resolveNullable(array.Items())
+}
+
+func isAvroTypeConvertible(avroSchema avro.Schema, field *schemapb.FieldSchema) bool {
+	schema, ok := resolveNullable(avroSchema)
+	if !ok {
+		return false
+	}
+	items, isArray := arrayItems(schema)
+	switch field.GetDataType() {
+	case schemapb.DataType_Text, schemapb.DataType_JSON, schemapb.DataType_Geometry:
+		return isAvroStringType(schema)
+	case schemapb.DataType_Timestamptz:
+		switch logicalType(schema) {
+		case avro.Date, avro.TimestampMillis, avro.TimestampMicros:
+			return true
+		}
+		return isAvroStringType(schema)
+	case schemapb.DataType_FloatVector, schemapb.DataType_Float16Vector, schemapb.DataType_BFloat16Vector:
+		return isAvroBytesType(schema) ||
+			(isArray && (items.Type() == avro.Float || items.Type() == avro.Double))
+	case schemapb.DataType_BinaryVector:
+		return isAvroBytesType(schema) || (isArray && isAvroIntegerType(items))
+	case schemapb.DataType_Int8Vector:
+		return isArray && isAvroIntegerType(items)
+	case schemapb.DataType_SparseFloatVector:
+		if m, ok := schema.(*avro.MapSchema); ok {
+			values, ok := resolveNullable(m.Values())
+			return ok && (isAvroIntegerType(values) || isAvroFloatingType(values))
+		}
+		return isAvroStringType(schema)
+	case schemapb.DataType_Array:
+		return isArray && isAvroScalarConvertible(items, field.GetElementType())
+	default:
+		return isAvroScalarConvertible(schema, field.GetDataType())
+	}
+}
@@ -0,0 +1,159 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/binary"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+)
+
+type Compression int
+
+const (
+	CompressionNone Compression = 0
+	CompressionGzip Compression = 1
+	CompressionZstd Compression = 2
+
+	GzipFileExt     = ".gz"
+	ZstdFileExt     = ".zst"
+	ZstdLongFileExt = ".zstd"
+
+	// defaultCompressionRatio is used to estimate the decompressed size when
+	// the compressed file doesn't record it.
+	defaultCompressionRatio = 4
+	// zstdMaxFrameHeaderSize is the max size of magic number and zstd frame header.
+	zstdMaxFrameHeaderSize = 18
+)
+
+var (
+	gzipMagic = []byte{0x1f, 0x8b}
+	zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+)
+
+func (c Compression) String() string {
+	switch c {
+	case CompressionGzip:
+		return "gzip"
+	case CompressionZstd:
+		return "zstd"
+	}
+	return "none"
+}
+
+// GetCompression returns the compression indicated by the file extension.
+func GetCompression(path string) Compression {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case GzipFileExt:
+		return CompressionGzip
+	case ZstdFileExt, ZstdLongFileExt:
+		return CompressionZstd
+	}
+	return CompressionNone
+}
+
+// TrimCompressionExt removes the compression extension of path, for example,
+// "a.jsonl.gz" is trimmed to "a.jsonl".
+func TrimCompressionExt(path string) string {
+	if GetCompression(path) == CompressionNone {
+		return path
+	}
+	return strings.TrimSuffix(path, filepath.Ext(path))
+}
+
+// NewDecompressReader wraps r with a decompressor, the compression is decided by the file
+// extension and verified by the magic bytes. A file without compression extension is also
+// decompressed if it starts with gzip or zstd magic bytes.
+// Closing the returned reader releases the decompressor only, the caller still owns r.
+func NewDecompressReader(path string, r io.Reader) (io.ReadCloser, Compression, error) {
+	br := bufio.NewReader(r)
+	head, err := br.Peek(len(zstdMagic))
+	if err != nil && err != io.EOF {
+		return nil, CompressionNone, err
+	}
+	sniffed := CompressionNone
+	if bytes.HasPrefix(head, gzipMagic) {
+		sniffed = CompressionGzip
+	} else if bytes.HasPrefix(head, zstdMagic) {
+		sniffed = CompressionZstd
+	}
+	if expected := GetCompression(path); expected != CompressionNone && expected != sniffed {
+		return nil, CompressionNone, merr.WrapErrImportFailedMsg(
+			"the file '%s' is expected to be %s compressed, but the content is not", path, expected.String())
+	}
+
+	switch sniffed {
+	case CompressionGzip:
+		gr, err := gzip.NewReader(br)
+		if err != nil {
+			return nil, CompressionNone, merr.WrapErrImportFailedMsg("invalid gzip file '%s', error: %v", path, err)
+		}
+		return gr, sniffed, nil
+	case CompressionZstd:
+		zr, err := zstd.NewReader(br)
+		if err != nil {
+			return nil, CompressionNone, merr.WrapErrImportFailedMsg("invalid zstd file '%s', error: %v", path, err)
+		}
+		return zr.IOReadCloser(), sniffed, nil
+	}
+	return io.NopCloser(br), CompressionNone, nil
+}
+
+// EstimateDecompressedSize estimates the decompressed size of a compressed file so that
+// the file size check and the task slicing of preimport work on the real data volume.
+// The size is read from the gzip trailer or the zstd frame header if possible,
+// otherwise a default compression ratio is applied.
+func EstimateDecompressedSize(ctx context.Context, cm storage.ChunkManager, path string, size int64, compression Compression) (int64, error) {
+	estimated := size * defaultCompressionRatio
+	switch compression {
+	case CompressionNone:
+		return size, nil
+	case CompressionGzip:
+		// ISIZE, the last 4 bytes, is the decompressed size modulo 2^32 of the last member
+		if size < 4 {
+			return estimated, nil
+		}
+		trailer, err := cm.ReadAt(ctx, path, size-4, 4)
+		if err != nil {
+			return 0, err
+		}
+		if isize := int64(binary.LittleEndian.Uint32(trailer)); isize > size {
+			return isize, nil
+		}
+	case CompressionZstd:
+		length := min(size, zstdMaxFrameHeaderSize)
+		header, err := cm.ReadAt(ctx, path, 0, length)
+		if err != nil {
+			return 0, err
+		}
+		var h zstd.Header
+		if err := h.Decode(header); err == nil && h.HasFCS && int64(h.FrameContentSize) > size {
+			return int64(h.FrameContentSize), nil
+		}
+	}
+	return estimated, nil
+}
@@ -0,0 +1,107 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/v3/objectstorage"
+)
+
+func TestCompressionExt(t *testing.T) {
+	assert.Equal(t, CompressionGzip, GetCompression("a/b.jsonl.gz"))
+	assert.Equal(t, CompressionZstd, GetCompression("a/b.csv.ZST"))
+	assert.Equal(t, CompressionZstd, GetCompression("a/b.json.zstd"))
+	assert.Equal(t, CompressionNone, GetCompression("a/b.json"))
+
+	assert.Equal(t, "a/b.jsonl", TrimCompressionExt("a/b.jsonl.gz"))
+	assert.Equal(t, "a/b.csv", TrimCompressionExt("a/b.csv.zst"))
+	assert.Equal(t, "a/b.csv", TrimCompressionExt("a/b.csv"))
+}
+
+func TestDecompressReader(t *testing.T) {
+	content := strings.Repeat(`{"pk": 1, "vec": [0.1, 0.2]}`+"\n", 1000)
+
+	gzBuf := &bytes.Buffer{}
+	gw := gzip.NewWriter(gzBuf)
+	_, err := gw.Write([]byte(content))
+	require.NoError(t, err)
+	require.NoError(t, gw.Close())
+
+	zw, err := zstd.NewWriter(nil)
+	require.NoError(t, err)
+	zstdBytes := zw.EncodeAll([]byte(content), nil)
+
+	cases := []struct {
+		path        string
+		data        []byte
+		compression Compression
+	}{
+		{"a.jsonl", []byte(content), CompressionNone},
+		{"a.jsonl.gz", gzBuf.Bytes(), CompressionGzip},
+		{"a.jsonl.zst", zstdBytes, CompressionZstd},
+		// sniffed by magic bytes
+		{"a.jsonl", gzBuf.Bytes(), CompressionGzip},
+	}
+	for _, c := range cases {
+		r, compression, err := NewDecompressReader(c.path, bytes.NewReader(c.data))
+		require.NoError(t, err, c.path)
+		assert.Equal(t, c.compression, compression, c.path)
+		decompressed, err := io.ReadAll(r)
+		assert.NoError(t, err, c.path)
+		assert.Equal(t, content, string(decompressed), c.path)
+		assert.NoError(t, r.Close())
+	}
+
+	// extension mismatches the content
+	_, _, err = NewDecompressReader("a.jsonl.gz", bytes.NewReader([]byte(content)))
+	assert.Error(t, err)
+	_, _, err = NewDecompressReader("a.jsonl.zst", bytes.NewReader(gzBuf.Bytes()))
+	assert.Error(t, err)
+
+	// empty file
+	r, compression, err := NewDecompressReader("a.jsonl", bytes.NewReader(nil))
+	assert.NoError(t, err)
+	assert.Equal(t, CompressionNone, compression)
+	r.Close()
+
+	// size estimation
+	ctx := context.Background()
+	dir := t.TempDir()
+	cm := storage.NewLocalChunkManager(objectstorage.RootPath(dir))
+	for _, c := range cases[1:3] {
+		filePath := path.Join(dir, c.path)
+		require.NoError(t, cm.Write(ctx, filePath, c.data))
+		size, err := EstimateDecompressedSize(ctx, cm, filePath, int64(len(c.data)), c.compression)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(len(content)), size, c.path)
+	}
+	size, err := EstimateDecompressedSize(ctx, cm, "a.jsonl", 100, CompressionNone)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(100), size)
+}
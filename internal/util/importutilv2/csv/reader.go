@@ -38,8 +38,10 @@ type reader struct {
 	cmr    storage.FileReader
 	schema *schemapb.CollectionSchema
 
-	cr     *csv.Reader
-	parser RowParser
+	dr          io.ReadCloser
+	compression common.Compression
+	cr          *csv.Reader
+	parser      RowParser
 
 	fileSize   *atomic.Int64
 	bufferSize int
@@ -57,8 +59,13 @@ func NewReader(ctx context.Context, cm storage.ChunkManager, schema *schemapb.Co
 	if err != nil {
 		return nil, err
 	}
+	dr, compression, err := common.NewDecompressReader(path, retryableReader)
+	if err != nil {
+		retryableReader.Close()
+		return nil, err
+	}
 
-	csvReader := csv.NewReader(retryableReader)
+	csvReader := csv.NewReader(dr)
 	csvReader.Comma = sep
 
 	header, err := csvReader.Read()
@@ -72,16 +79,18 @@ func NewReader(ctx context.Context, cm storage.ChunkManager, schema *schemapb.Co
 		return nil, err
 	}
 	return &reader{
-		ctx:        ctx,
-		cm:         cm,
-		cmr:        retryableReader,
-		schema:     schema,
-		cr:         csvReader,
-		parser:     rowParser,
-		fileSize:   atomic.NewInt64(0),
-		filePath:   path,
-		bufferSize: bufferSize,
-		count:      count,
+		ctx:         ctx,
+		cm:          cm,
+		cmr:         retryableReader,
+		schema:      schema,
+		dr:          dr,
+		compression: compression,
+		cr:          csvReader,
+		parser:      rowParser,
+		fileSize:    atomic.NewInt64(0),
+		filePath:    path,
+		bufferSize:  bufferSize,
+		count:       count,
 	}, nil
 }
 
@@ -124,6 +133,9 @@ func (r *reader) Read() (*storage.InsertData, error) {
 }
 
 func (r *reader) Close() {
+	if r.dr != nil {
+		r.dr.Close()
+	}
 	if r.cmr != nil {
 		r.cmr.Close()
 	}
@@ -137,6 +149,10 @@ func (r *reader) Size() (int64, error) {
 	if err != nil {
 		return 0, err
 	}
+	size, err = common.EstimateDecompressedSize(r.ctx, r.cm, r.filePath, size, r.compression)
+	if err != nil {
+		return 0, err
+	}
 	r.fileSize.Store(size)
 	return size, nil
 }
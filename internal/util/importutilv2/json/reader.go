@@ -42,9 +42,11 @@ type reader struct {
 	cmr    storage.FileReader
 	schema *schemapb.CollectionSchema
 
-	fileSize *atomic.Int64
-	filePath string
-	dec      *json.Decoder
+	fileSize    *atomic.Int64
+	filePath    string
+	dr          io.ReadCloser
+	compression common.Compression
+	dec         *json.Decoder
 
 	bufferSize    int
 	count         int64
@@ -64,6 +66,11 @@ func newReader(ctx context.Context, cm storage.ChunkManager, schema *schemapb.Co
 	if err != nil {
 		return nil, err
 	}
+	dr, compression, err := common.NewDecompressReader(path, retryableReader)
+	if err != nil {
+		retryableReader.Close()
+		return nil, err
+	}
 	reader := &reader{
 		ctx:           ctx,
 		cm:            cm,
@@ -71,7 +78,9 @@ func newReader(ctx context.Context, cm storage.ChunkManager, schema *schemapb.Co
 		schema:        schema,
 		fileSize:      atomic.NewInt64(0),
 		filePath:      path,
-		dec:           json.NewDecoder(retryableReader),
+		dr:            dr,
+		compression:   compression,
+		dec:           json.NewDecoder(dr),
 		bufferSize:    bufferSize,
 		count:         count,
 		isLinesFormat: isLinesFormat,
@@ -272,11 +281,18 @@ func (j *reader) Size() (int64, error) {
 	if err != nil {
 		return 0, err
 	}
+	size, err = common.EstimateDecompressedSize(j.ctx, j.cm, j.filePath, size, j.compression)
+	if err != nil {
+		return 0, err
+	}
 	j.fileSize.Store(size)
 	return size, nil
 }
 
 func (j *reader) Close() {
+	if j.dr != nil {
+		j.dr.Close()
+	}
 	if j.cmr != nil {
 		j.cmr.Close()
 	}
@@ -0,0 +1,517 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package orc
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/scritchley/orc"
+	orcproto "github.com/scritchley/orc/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/schemapb"
+	"github.com/milvus-io/milvus/internal/json"
+	"github.com/milvus-io/milvus/internal/util/importutilv2/common"
+	"github.com/milvus-io/milvus/internal/util/nullutil"
+	pkgcommon "github.com/milvus-io/milvus/pkg/v3/common"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+	"github.com/milvus-io/milvus/pkg/v3/util/parameterutil"
+	"github.com/milvus-io/milvus/pkg/v3/util/timestamptz"
+	"github.com/milvus-io/milvus/pkg/v3/util/typeutil"
+)
+
+// FieldReader converts the values of one top-level ORC column into the row values
+// of a collection field, the values are appended to storage.FieldData directly.
+type FieldReader struct {
+	column   string
+	kind     orcproto.Type_Kind
+	elemKind orcproto.Type_Kind // kind of the list element, only valid for list column
+	field    *schemapb.FieldSchema
+	timezone string
+
+	dim         int
+	maxLength   int64
+	maxCapacity int64
+}
+
+func NewFieldReader(column string, columnType *orc.TypeDescription, field *schemapb.FieldSchema, timezone string) (*FieldReader, error) {
+	types := columnType.Types()
+	fr := &FieldReader{
+		column:   column,
+		kind:     types[0].GetKind(),
+		field:    field,
+		timezone: timezone,
+	}
+	if fr.kind == orcproto.Type_LIST {
+		fr.elemKind = types[1].GetKind()
+	}
+	if !isORCTypeConvertible(fr.kind, fr.elemKind, field) {
+		return nil, WrapTypeErr(field, columnType.String())
+	}
+
+	var err error
+	dataType := field.GetDataType()
+	switch {
+	case typeutil.IsVectorType(dataType) && !typeutil.IsSparseFloatVectorType(dataType):
+		dim, err := typeutil.GetDim(field)
+		if err != nil {
+			return nil, err
+		}
+		fr.dim = int(dim)
+	case dataType == schemapb.DataType_VarChar || dataType == schemapb.DataType_String:
+		if fr.maxLength, err = parameterutil.GetMaxLength(field); err != nil {
+			return nil, err
+		}
+	case dataType == schemapb.DataType_Array:
+		if fr.maxCapacity, err = parameterutil.GetMaxCapacity(field); err != nil {
+			return nil, err
+		}
+		if field.GetElementType() == schemapb.DataType_VarChar {
+			if fr.maxLength, err = parameterutil.GetMaxLength(field); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return fr, nil
+}
+
+// Convert returns the value of one row in the type accepted by storage.FieldData.AppendRow,
+// a null value is replaced by the default value of field if it has one.
+func (fr *FieldReader) Convert(value any) (any, error) {
+	if value == nil {
+		if fr.field.GetDefaultValue() != nil {
+			return nullutil.GetDefaultValue(fr.field)
+		}
+		if fr.field.GetNullable() {
+			return nil, nil
+		}
+		return nil, WrapNullRowErr(fr.field)
+	}
+
+	switch fr.field.GetDataType() {
+	case schemapb.DataType_Bool:
+		v, ok := value.(bool)
+		if !ok {
+			return nil, fr.wrapValueTypeErr(value)
+		}
+		return v, nil
+	case schemapb.DataType_Int8:
+		v, err := fr.toInt(value, math.MinInt8, math.MaxInt8)
+		return int8(v), err
+	case schemapb.DataType_Int16:
+		v, err := fr.toInt(value, math.MinInt16, math.MaxInt16)
+		return int16(v), err
+	case schemapb.DataType_Int32:
+		v, err := fr.toInt(value, math.MinInt32, math.MaxInt32)
+		return int32(v), err
+	case schemapb.DataType_Int64:
+		return fr.toInt(value, math.MinInt64, math.MaxInt64)
+	case schemapb.DataType_Float:
+		v, err := fr.toFloat(value)
+		if err != nil {
+			return nil, err
+		}
+		if v > math.MaxFloat32 || v < -math.MaxFloat32 {
+			return nil, merr.WrapErrImportFailedMsg("value %v overflows float for field '%s'", v, fr.field.GetName())
+		}
+		return float32(v), nil
+	case schemapb.DataType_Double:
+		return fr.toFloat(value)
+	case schemapb.DataType_VarChar, schemapb.DataType_String:
+		v, ok := value.(string)
+		if !ok {
+			return nil, fr.wrapValueTypeErr(value)
+		}
+		if err := common.CheckValidString(v, fr.maxLength, fr.field); err != nil {
+			return nil, err
+		}
+		return v, nil
+	case schemapb.DataType_Text:
+		v, ok := value.(string)
+		if !ok {
+			return nil, fr.wrapValueTypeErr(value)
+		}
+		if err := common.CheckValidUTF8(v, fr.field); err != nil {
+			return nil, err
+		}
+		return v, nil
+	case schemapb.DataType_JSON:
+		return fr.toJSON(value)
+	case schemapb.DataType_Geometry:
+		v, ok := value.(string)
+		if !ok {
+			return nil, fr.wrapValueTypeErr(value)
+		}
+		return pkgcommon.ConvertWKTToWKB(v)
+	case schemapb.DataType_Timestamptz:
+		return fr.toTimestamptz(value)
+	case schemapb.DataType_FloatVector:
+		return fr.toFloatVector(value)
+	case schemapb.DataType_Float16Vector, schemapb.DataType_BFloat16Vector:
+		return fr.toHalfVector(value)
+	case schemapb.DataType_BinaryVector:
+		return fr.toBinaryVector(value)
+	case schemapb.DataType_Int8Vector:
+		return fr.toInt8Vector(value)
+	case schemapb.DataType_SparseFloatVector:
+		return fr.toSparseFloatVector(value)
+	case schemapb.DataType_Array:
+		return fr.toArray(value)
+	default:
+		return nil, merr.WrapErrImportFailedMsg("unsupported data type '%s' for field '%s'",
+			fr.field.GetDataType().String(), fr.field.GetName())
+	}
+}
+
+func (fr *FieldReader) wrapValueTypeErr(value any) error {
+	return merr.WrapErrImportFailedMsg("unexpected value type %T of orc column '%s' for field '%s'", value, fr.column, fr.field.GetName())
+}
+
+// toInt converts the ORC integer values, tinyint is decoded as int8 and the others as int64.
+func (fr *FieldReader) toInt(value any, minValue, maxValue int64) (int64, error) {
+	var v int64
+	switch n := value.(type) {
+	case int8:
+		v = int64(n)
+	case int64:
+		v = n
+	default:
+		return 0, fr.wrapValueTypeErr(value)
+	}
+	if v < minValue || v > maxValue {
+		return 0, merr.WrapErrImportFailedMsg("value %d overflows %s for field '%s'", v, fr.field.GetDataType().String(), fr.field.GetName())
+	}
+	return v, nil
+}
+
+func (fr *FieldReader) toFloat(value any) (float64, error) {
+	var v float64
+	switch n := value.(type) {
+	case orc.Float:
+		v = float64(n)
+	case orc.Double:
+		v = float64(n)
+	case orc.Decimal:
+		v = n.Float64()
+	case int8:
+		v = float64(n)
+	case int64:
+		v = float64(n)
+	default:
+		return 0, fr.wrapValueTypeErr(value)
+	}
+	if err := typeutil.VerifyFloat(v); err != nil {
+		return 0, merr.WrapErrImportFailedMsg("invalid value of field '%s', err=%v", fr.field.GetName(), err)
+	}
+	return v, nil
+}
+
+func (fr *FieldReader) toJSON(value any) ([]byte, error) {
+	v, ok := value.(string)
+	if !ok {
+		return nil, fr.wrapValueTypeErr(value)
+	}
+	var obj any
+	if err := json.Unmarshal([]byte(v), &obj); err != nil {
+		return nil, merr.WrapErrImportFailedMsg("invalid JSON value of field '%s', err=%v", fr.field.GetName(), err)
+	}
+	if _, ok = obj.(map[string]any); fr.field.GetIsDynamic() && !ok {
+		return nil, merr.WrapErrImportFailedMsg("the value of dynamic field '%s' must be a JSON object", fr.field.GetName())
+	}
+	return []byte(v), nil
+}
+
+// toTimestamptz accepts ORC timestamp and date values, or strings in ISO 8601 format,
+// the schema timezone is used if a string has no explicit offset.
+func (fr *FieldReader) toTimestamptz(value any) (int64, error) {
+	switch v := value.(type) {
+	case time.Time:
+		return v.UnixMicro(), nil
+	case orc.Date:
+		return v.UnixMicro(), nil
+	case string:
+		return timestamptz.ValidateAndReturnUnixMicroTz(v, fr.timezone)
+	}
+	return 0, fr.wrapValueTypeErr(value)
+}
+
+// toFloats converts a list of ORC float or double values.
+func (fr *FieldReader) toFloats(value any) ([]float32, error) {
+	list, ok := value.([]any)
+	if !ok {
+		return nil, fr.wrapValueTypeErr(value)
+	}
+	if len(list) != fr.dim {
+		return nil, merr.WrapErrImportFailedMsg("expect %d elements of vector field '%s', but got %d", fr.dim, fr.field.GetName(), len(list))
+	}
+	vector := make([]float32, 0, len(list))
+	for _, elem := range list {
+		switch f := elem.(type) {
+		case orc.Float:
+			vector = append(vector, float32(f))
+		case orc.Double:
+			vector = append(vector, float32(f))
+		case nil:
+			return nil, WrapNullElementErr(fr.field)
+		default:
+			return nil, fr.wrapValueTypeErr(elem)
+		}
+	}
+	if err := typeutil.VerifyFloats32(vector); err != nil {
+		return nil, merr.WrapErrImportFailedMsg("invalid value of field '%s', err=%v", fr.field.GetName(), err)
+	}
+	return vector, nil
+}
+
+// toFloatVector accepts a float list, or little-endian float32 bytes.
+func (fr *FieldReader) toFloatVector(value any) ([]float32, error) {
+	bs, ok := value.([]byte)
+	if !ok {
+		return fr.toFloats(value)
+	}
+	if len(bs) != fr.dim*4 {
+		return nil, merr.WrapErrImportFailedMsg("expect %d bytes of vector field '%s', but got %d", fr.dim*4, fr.field.GetName(), len(bs))
+	}
+	vector := make([]float32, 0, fr.dim)
+	for i := 0; i < len(bs); i += 4 {
+		vector = append(vector, math.Float32frombits(binary.LittleEndian.Uint32(bs[i:i+4])))
+	}
+	if err := typeutil.VerifyFloats32(vector); err != nil {
+		return nil, merr.WrapErrImportFailedMsg("invalid value of field '%s', err=%v", fr.field.GetName(), err)
+	}
+	return vector, nil
+}
+
+// toHalfVector accepts a float list which is converted into float16/bfloat16 directly,
+// or the raw float16/bfloat16 bytes.
+func (fr *FieldReader) toHalfVector(value any) ([]byte, error) {
+	if bs, ok := value.([]byte); ok {
+		if len(bs) != fr.dim*2 {
+			return nil, merr.WrapErrImportFailedMsg("expect %d bytes of vector field '%s', but got %d", fr.dim*2, fr.field.GetName(), len(bs))
+		}
+		return bs, nil
+	}
+	vector, err := fr.toFloats(value)
+	if err != nil {
+		return nil, err
+	}
+	if fr.field.GetDataType() == schemapb.DataType_Float16Vector {
+		return typeutil.Float32ArrayToFloat16Bytes(vector), nil
+	}
+	return typeutil.Float32ArrayToBFloat16Bytes(vector), nil
+}
+
+// toBinaryVector accepts the raw bytes, or a list of uint8 values.
+func (fr *FieldReader) toBinaryVector(value any) ([]byte, error) {
+	if bs, ok := value.([]byte); ok {
+		if len(bs) != fr.dim/8 {
+			return nil, merr.WrapErrImportFailedMsg("expect %d bytes of vector field '%s', but got %d", fr.dim/8, fr.field.GetName(), len(bs))
+		}
+		return bs, nil
+	}
+	list, ok := value.([]any)
+	if !ok {
+		return nil, fr.wrapValueTypeErr(value)
+	}
+	if len(list) != fr.dim/8 {
+		return nil, merr.WrapErrImportFailedMsg("expect %d elements of vector field '%s', but got %d", fr.dim/8, fr.field.GetName(), len(list))
+	}
+	vector := make([]byte, 0, len(list))
+	for _, elem := range list {
+		if elem == nil {
+			return nil, WrapNullElementErr(fr.field)
+		}
+		v, err := fr.toInt(elem, 0, math.MaxUint8)
+		if err != nil {
+			return nil, err
+		}
+		vector = append(vector, byte(v))
+	}
+	return vector, nil
+}
+
+func (fr *FieldReader) toInt8Vector(value any) ([]int8, error) {
+	list, ok := value.([]any)
+	if !ok {
+		return nil, fr.wrapValueTypeErr(value)
+	}
+	if len(list) != fr.dim {
+		return nil, merr.WrapErrImportFailedMsg("expect %d elements of vector field '%s', but got %d", fr.dim, fr.field.GetName(), len(list))
+	}
+	vector := make([]int8, 0, len(list))
+	for _, elem := range list {
+		if elem == nil {
+			return nil, WrapNullElementErr(fr.field)
+		}
+		v, err := fr.toInt(elem, math.MinInt8, math.MaxInt8)
+		if err != nil {
+			return nil, err
+		}
+		vector = append(vector, int8(v))
+	}
+	return vector, nil
+}
+
+// toSparseFloatVector accepts a JSON string in the formats of JSON import, or a map from
+// the integer indices to the float values.
+func (fr *FieldReader) toSparseFloatVector(value any) ([]byte, error) {
+	switch v := value.(type) {
+	case string:
+		row, err := typeutil.CreateSparseFloatRowFromJSON([]byte(v))
+		if err != nil {
+			return nil, merr.WrapErrImportFailedMsg("invalid JSON string for sparse vector field '%s', err=%v", fr.field.GetName(), err)
+		}
+		return row, nil
+	case []orc.MapEntry:
+		indices := make([]uint32, 0, len(v))
+		values := make([]float32, 0, len(v))
+		for _, entry := range v {
+			if entry.Key == nil || entry.Value == nil {
+				return nil, WrapNullElementErr(fr.field)
+			}
+			index, err := fr.toInt(entry.Key, 0, math.MaxUint32)
+			if err != nil {
+				return nil, err
+			}
+			f, err := fr.toFloat(entry.Value)
+			if err != nil {
+				return nil, err
+			}
+			indices = append(indices, uint32(index))
+			values = append(values, float32(f))
+		}
+		indices, values = typeutil.SortSparseFloatRow(indices, values)
+		return typeutil.CreateSparseFloatRow(indices, values), nil
+	}
+	return nil, fr.wrapValueTypeErr(value)
+}
+
+func (fr *FieldReader) toArray(value any) (*schemapb.ScalarField, error) {
+	list, ok := value.([]any)
+	if !ok {
+		return nil, fr.wrapValueTypeErr(value)
+	}
+	if err := common.CheckArrayCapacity(len(list), fr.maxCapacity, fr.field); err != nil {
+		return nil, err
+	}
+	for _, elem := range list {
+		if elem == nil {
+			return nil, WrapNullElementErr(fr.field)
+		}
+	}
+
+	switch fr.field.GetElementType() {
+	case schemapb.DataType_Bool:
+		data := make([]bool, 0, len(list))
+		for _, elem := range list {
+			v, ok := elem.(bool)
+			if !ok {
+				return nil, fr.wrapValueTypeErr(elem)
+			}
+			data = append(data, v)
+		}
+		return &schemapb.ScalarField{Data: &schemapb.ScalarField_BoolData{BoolData: &schemapb.BoolArray{Data: data}}}, nil
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
+		minValue, maxValue := int64(math.MinInt32), int64(math.MaxInt32)
+		switch fr.field.GetElementType() {
+		case schemapb.DataType_Int8:
+			minValue, maxValue = math.MinInt8, math.MaxInt8
+		case schemapb.DataType_Int16:
+			minValue, maxValue = math.MinInt16, math.MaxInt16
+		}
+		data := make([]int32, 0, len(list))
+		for _, elem := range list {
+			v, err := fr.toInt(elem, minValue, maxValue)
+			if err != nil {
+				return nil, err
+			}
+			data = append(data, int32(v))
+		}
+		return &schemapb.ScalarField{Data: &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{Data: data}}}, nil
+	case schemapb.DataType_Int64:
+		data := make([]int64, 0, len(list))
+		for _, elem := range list {
+			v, err := fr.toInt(elem, math.MinInt64, math.MaxInt64)
+			if err != nil {
+				return nil, err
+			}
+			data = append(data, v)
+		}
+		return &schemapb.ScalarField{Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: data}}}, nil
+	case schemapb.DataType_Float:
+		data := make([]float32, 0, len(list))
+		for _, elem := range list {
+			v, err := fr.toFloat(elem)
+			if err != nil {
+				return nil, err
+			}
+			data = append(data, float32(v))
+		}
+		return &schemapb.ScalarField{Data: &schemapb.ScalarField_FloatData{FloatData: &schemapb.FloatArray{Data: data}}}, nil
+	case schemapb.DataType_Double:
+		data := make([]float64, 0, len(list))
+		for _, elem := range list {
+			v, err := fr.toFloat(elem)
+			if err != nil {
+				return nil, err
+			}
+			data = append(data, v)
+		}
+		return &schemapb.ScalarField{Data: &schemapb.ScalarField_DoubleData{DoubleData: &schemapb.DoubleArray{Data: data}}}, nil
+	case schemapb.DataType_VarChar, schemapb.DataType_String:
+		data := make([]string, 0, len(list))
+		for _, elem := range list {
+			v, ok := elem.(string)
+			if !ok {
+				return nil, fr.wrapValueTypeErr(elem)
+			}
+			if err := common.CheckValidString(v, fr.maxLength, fr.field); err != nil {
+				return nil, err
+			}
+			data = append(data, v)
+		}
+		return &schemapb.ScalarField{Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: data}}}, nil
+	}
+	return nil, merr.WrapErrImportFailedMsg("unsupported element type '%s' of array field '%s'",
+		fr.field.GetElementType().String(), fr.field.GetName())
+}
+
+func WrapTypeErr(expect *schemapb.FieldSchema, actual string) error {
+	nullable := ""
+	if expect.GetNullable() {
+		nullable = "nullable "
+	}
+	elementType := ""
+	if expect.GetDataType() == schemapb.DataType_Array {
+		elementType = expect.GetElementType().String() + " "
+	}
+	return merr.WrapErrImportFailed(
+		fmt.Sprintf("expect %s'%s%s' type for field '%s', but got '%s' type",
+			nullable, elementType, expect.GetDataType().String(), expect.GetName(), actual))
+}
+
+func WrapNullRowErr(field *schemapb.FieldSchema) error {
+	return merr.WrapErrImportFailed(
+		fmt.Sprintf("the field '%s' is not nullable but the file contains null value", field.GetName()))
+}
+
+func WrapNullElementErr(field *schemapb.FieldSchema) error {
+	return merr.WrapErrImportFailed(
+		fmt.Sprintf("array element is not allowed to be null value for field '%s'", field.GetName()))
+}
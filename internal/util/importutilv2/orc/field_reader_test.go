@@ -0,0 +1,242 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package orc
+
+import (
+	"encoding/binary"
+	"math"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/scritchley/orc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v3/schemapb"
+	"github.com/milvus-io/milvus/pkg/v3/common"
+	"github.com/milvus-io/milvus/pkg/v3/util/typeutil"
+)
+
+func newTestFieldReader(t *testing.T, orcType string, field *schemapb.FieldSchema) *FieldReader {
+	td, err := orc.ParseSchema(orcType)
+	require.NoError(t, err)
+	fr, err := NewFieldReader(field.GetName(), td, field, "UTC")
+	require.NoError(t, err)
+	return fr
+}
+
+func TestFieldReaderScalar(t *testing.T) {
+	int8Field := &schemapb.FieldSchema{Name: "i8", DataType: schemapb.DataType_Int8}
+	fr := newTestFieldReader(t, "tinyint", int8Field)
+	v, err := fr.Convert(int8(-3))
+	assert.NoError(t, err)
+	assert.Equal(t, int8(-3), v)
+
+	// tinyint could be converted into any integer
+	fr = newTestFieldReader(t, "tinyint", &schemapb.FieldSchema{Name: "i32", DataType: schemapb.DataType_Int32})
+	v, err = fr.Convert(int8(-3))
+	assert.NoError(t, err)
+	assert.Equal(t, int32(-3), v)
+
+	fr = newTestFieldReader(t, "bigint", &schemapb.FieldSchema{Name: "i64", DataType: schemapb.DataType_Int64})
+	v, err = fr.Convert(int64(math.MaxInt64))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(math.MaxInt64), v)
+	_, err = fr.Convert("1")
+	assert.Error(t, err)
+
+	fr = newTestFieldReader(t, "decimal(10,2)", &schemapb.FieldSchema{Name: "d", DataType: schemapb.DataType_Double})
+	v, err = fr.Convert(orc.Decimal{Int: big.NewInt(1234), Scale: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, 12.34, v)
+
+	fr = newTestFieldReader(t, "double", &schemapb.FieldSchema{Name: "f", DataType: schemapb.DataType_Float})
+	_, err = fr.Convert(orc.Double(math.MaxFloat64))
+	assert.Error(t, err)
+	_, err = fr.Convert(orc.Double(math.NaN()))
+	assert.Error(t, err)
+
+	fr = newTestFieldReader(t, "string", &schemapb.FieldSchema{Name: "text", DataType: schemapb.DataType_Text})
+	v, err = fr.Convert("a long text without the max length")
+	assert.NoError(t, err)
+	assert.Equal(t, "a long text without the max length", v)
+	_, err = fr.Convert(string([]byte{0xff, 0xfe}))
+	assert.Error(t, err)
+
+	fr = newTestFieldReader(t, "string", &schemapb.FieldSchema{Name: "geo", DataType: schemapb.DataType_Geometry})
+	v, err = fr.Convert("POINT (1 2)")
+	assert.NoError(t, err)
+	assert.NotEmpty(t, v)
+	_, err = fr.Convert("not a wkt")
+	assert.Error(t, err)
+}
+
+func TestFieldReaderNullAndDefault(t *testing.T) {
+	fr := newTestFieldReader(t, "int", &schemapb.FieldSchema{Name: "a", DataType: schemapb.DataType_Int32})
+	_, err := fr.Convert(nil)
+	assert.Error(t, err)
+
+	fr = newTestFieldReader(t, "int", &schemapb.FieldSchema{Name: "a", DataType: schemapb.DataType_Int32, Nullable: true})
+	v, err := fr.Convert(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, v)
+
+	fr = newTestFieldReader(t, "string", &schemapb.FieldSchema{
+		Name: "a", DataType: schemapb.DataType_VarChar, Nullable: true,
+		TypeParams:   []*commonpb.KeyValuePair{{Key: common.MaxLengthKey, Value: "8"}},
+		DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_StringData{StringData: "def"}},
+	})
+	v, err = fr.Convert(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "def", v)
+}
+
+func TestFieldReaderJSON(t *testing.T) {
+	fr := newTestFieldReader(t, "string", &schemapb.FieldSchema{Name: "j", DataType: schemapb.DataType_JSON})
+	v, err := fr.Convert(`[1, 2]`)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte(`[1, 2]`), v)
+	_, err = fr.Convert(`{invalid`)
+	assert.Error(t, err)
+
+	fr = newTestFieldReader(t, "string", &schemapb.FieldSchema{Name: "$meta", DataType: schemapb.DataType_JSON, IsDynamic: true})
+	v, err = fr.Convert(`{"a": 1}`)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte(`{"a": 1}`), v)
+	_, err = fr.Convert(`[1, 2]`)
+	assert.Error(t, err)
+}
+
+func TestFieldReaderTimestamptz(t *testing.T) {
+	fr := newTestFieldReader(t, "string", &schemapb.FieldSchema{Name: "ts", DataType: schemapb.DataType_Timestamptz})
+	v, err := fr.Convert("2025-01-02T03:04:05Z")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC).UnixMicro(), v)
+	_, err = fr.Convert("not a time")
+	assert.Error(t, err)
+
+	fr = newTestFieldReader(t, "date", &schemapb.FieldSchema{Name: "ts", DataType: schemapb.DataType_Timestamptz})
+	date := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	v, err = fr.Convert(orc.Date{Time: date})
+	assert.NoError(t, err)
+	assert.Equal(t, date.UnixMicro(), v)
+}
+
+func TestFieldReaderVector(t *testing.T) {
+	dim := []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "2"}}
+
+	fr := newTestFieldReader(t, "binary", &schemapb.FieldSchema{Name: "vec", DataType: schemapb.DataType_FloatVector, TypeParams: dim})
+	bs := make([]byte, 8)
+	binary.LittleEndian.PutUint32(bs, math.Float32bits(1.5))
+	binary.LittleEndian.PutUint32(bs[4:], math.Float32bits(-2))
+	v, err := fr.Convert(bs)
+	assert.NoError(t, err)
+	assert.Equal(t, []float32{1.5, -2}, v)
+	_, err = fr.Convert(bs[:4])
+	assert.Error(t, err)
+
+	fr = newTestFieldReader(t, "binary", &schemapb.FieldSchema{Name: "fp16", DataType: schemapb.DataType_Float16Vector, TypeParams: dim})
+	fp16 := typeutil.Float32ArrayToFloat16Bytes([]float32{1.5, -2})
+	v, err = fr.Convert(fp16)
+	assert.NoError(t, err)
+	assert.Equal(t, fp16, v)
+
+	binDim := []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "16"}}
+	fr = newTestFieldReader(t, "binary", &schemapb.FieldSchema{Name: "bin", DataType: schemapb.DataType_BinaryVector, TypeParams: binDim})
+	v, err = fr.Convert([]byte{1, 255})
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{1, 255}, v)
+	_, err = fr.Convert([]byte{1})
+	assert.Error(t, err)
+
+	fr = newTestFieldReader(t, "array<int>", &schemapb.FieldSchema{Name: "bin", DataType: schemapb.DataType_BinaryVector, TypeParams: binDim})
+	v, err = fr.Convert([]any{int64(1), int64(255)})
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{1, 255}, v)
+	_, err = fr.Convert([]any{int64(1), int64(256)})
+	assert.Error(t, err)
+
+	fr = newTestFieldReader(t, "array<tinyint>", &schemapb.FieldSchema{Name: "i8", DataType: schemapb.DataType_Int8Vector, TypeParams: dim})
+	v, err = fr.Convert([]any{int8(-128), int8(127)})
+	assert.NoError(t, err)
+	assert.Equal(t, []int8{-128, 127}, v)
+	_, err = fr.Convert([]any{int8(1)})
+	assert.Error(t, err)
+
+	fr = newTestFieldReader(t, "array<float>", &schemapb.FieldSchema{Name: "vec", DataType: schemapb.DataType_FloatVector, TypeParams: dim})
+	_, err = fr.Convert([]any{orc.Float(1), orc.Float(float32(math.Inf(1)))})
+	assert.Error(t, err)
+}
+
+func TestFieldReaderSparseVector(t *testing.T) {
+	field := &schemapb.FieldSchema{Name: "sparse", DataType: schemapb.DataType_SparseFloatVector}
+	expected := typeutil.CreateSparseFloatRow([]uint32{1, 5}, []float32{0.5, 0.25})
+
+	fr := newTestFieldReader(t, "string", field)
+	v, err := fr.Convert(`{"5": 0.25, "1": 0.5}`)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, v)
+	_, err = fr.Convert(`{"a": 1}`)
+	assert.Error(t, err)
+
+	fr = newTestFieldReader(t, "map<bigint,double>", field)
+	v, err = fr.Convert([]orc.MapEntry{{Key: int64(5), Value: orc.Double(0.25)}, {Key: int64(1), Value: orc.Double(0.5)}})
+	assert.NoError(t, err)
+	assert.Equal(t, expected, v)
+	_, err = fr.Convert([]orc.MapEntry{{Key: int64(-1), Value: orc.Double(0.5)}})
+	assert.Error(t, err)
+	_, err = fr.Convert([]orc.MapEntry{{Key: int64(1), Value: nil}})
+	assert.Error(t, err)
+}
+
+func TestFieldReaderArray(t *testing.T) {
+	capacity := []*commonpb.KeyValuePair{{Key: common.MaxCapacityKey, Value: "3"}}
+
+	fr := newTestFieldReader(t, "array<boolean>", &schemapb.FieldSchema{
+		Name: "a", DataType: schemapb.DataType_Array, ElementType: schemapb.DataType_Bool, TypeParams: capacity,
+	})
+	v, err := fr.Convert([]any{true, false})
+	assert.NoError(t, err)
+	assert.Equal(t, []bool{true, false}, v.(*schemapb.ScalarField).GetBoolData().GetData())
+
+	fr = newTestFieldReader(t, "array<smallint>", &schemapb.FieldSchema{
+		Name: "a", DataType: schemapb.DataType_Array, ElementType: schemapb.DataType_Int16, TypeParams: capacity,
+	})
+	v, err = fr.Convert([]any{int64(-2), int64(math.MaxInt16)})
+	assert.NoError(t, err)
+	assert.Equal(t, []int32{-2, math.MaxInt16}, v.(*schemapb.ScalarField).GetIntData().GetData())
+	_, err = fr.Convert([]any{int64(1), nil})
+	assert.Error(t, err)
+	_, err = fr.Convert([]any{int64(1), int64(2), int64(3), int64(4)})
+	assert.Error(t, err)
+
+	fr = newTestFieldReader(t, "array<double>", &schemapb.FieldSchema{
+		Name: "a", DataType: schemapb.DataType_Array, ElementType: schemapb.DataType_Double, TypeParams: capacity,
+	})
+	v, err = fr.Convert([]any{orc.Double(0.1), orc.Double(-3)})
+	assert.NoError(t, err)
+	assert.Equal(t, []float64{0.1, -3}, v.(*schemapb.ScalarField).GetDoubleData().GetData())
+
+	fr = newTestFieldReader(t, "array<float>", &schemapb.FieldSchema{
+		Name: "a", DataType: schemapb.DataType_Array, ElementType: schemapb.DataType_Float, TypeParams: capacity,
+	})
+	v, err = fr.Convert([]any{orc.Float(0.1)})
+	assert.NoError(t, err)
+	assert.Equal(t, []float32{0.1}, v.(*schemapb.ScalarField).GetFloatData().GetData())
+}
@@ -0,0 +1,173 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package orc
+
+import (
+	"context"
+	"io"
+
+	"github.com/scritchley/orc"
+	"go.uber.org/atomic"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/importutilv2/common"
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+)
+
+type reader struct {
+	ctx    context.Context
+	cm     storage.ChunkManager
+	cmr    storage.FileReader
+	schema *schemapb.CollectionSchema
+
+	fileSize *atomic.Int64
+	filePath string
+	r        *orc.Reader
+	cursor   *orc.Cursor
+	// hasStripe is true when the cursor is positioned in a stripe which may have more rows
+	hasStripe bool
+
+	bufferSize int
+	count      int64
+
+	frs []*FieldReader // in the order of selected columns
+}
+
+// NewReader creates a reader of ORC file, each row of the top-level struct is a row,
+// columns are mapped to collection fields by name and converted by the FieldReaders.
+func NewReader(ctx context.Context, cm storage.ChunkManager, schema *schemapb.CollectionSchema, path string, bufferSize int) (*reader, error) {
+	cmReader, err := cm.Reader(ctx, path)
+	if err != nil {
+		return nil, merr.Wrapf(err, "read orc file failed, path=%s", path)
+	}
+	retryableReader := common.NewRetryableReader(ctx, path, cmReader)
+	size, err := cm.Size(ctx, path)
+	if err != nil {
+		retryableReader.Close()
+		return nil, err
+	}
+	// the ORC footer is located at the end of file, random access is required
+	r, err := orc.NewReader(io.NewSectionReader(retryableReader, 0, size))
+	if err != nil {
+		retryableReader.Close()
+		return nil, merr.WrapErrImportFailedMsg("new orc reader failed, err=%v", err)
+	}
+	frs, err := CreateFieldReaders(ctx, r.Schema(), schema)
+	if err != nil {
+		r.Close()
+		retryableReader.Close()
+		return nil, err
+	}
+	count, err := common.EstimateReadCountPerBatch(bufferSize, schema)
+	if err != nil {
+		r.Close()
+		retryableReader.Close()
+		return nil, err
+	}
+	mlog.Info(ctx, "orc file info", mlog.String("path", path), mlog.Int("num rows", r.NumRows()))
+
+	columns := make([]string, 0, len(frs))
+	for _, fr := range frs {
+		columns = append(columns, fr.column)
+	}
+	fileSize := atomic.NewInt64(0)
+	fileSize.Store(size)
+	return &reader{
+		ctx:        ctx,
+		cm:         cm,
+		cmr:        retryableReader,
+		schema:     schema,
+		fileSize:   fileSize,
+		filePath:   path,
+		r:          r,
+		cursor:     r.Select(columns...),
+		bufferSize: bufferSize,
+		count:      count,
+		frs:        frs,
+	}, nil
+}
+
+func (r *reader) Read() (*storage.InsertData, error) {
+	insertData, err := storage.NewInsertDataWithFunctionOutputField(r.schema)
+	if err != nil {
+		return nil, err
+	}
+	var cnt int64 = 0
+OUTER:
+	for {
+		if !r.hasStripe {
+			if !r.cursor.Stripes() {
+				break
+			}
+			r.hasStripe = true
+		}
+		for r.cursor.Next() {
+			values := r.cursor.Row()
+			if len(values) != len(r.frs) {
+				return nil, merr.WrapErrImportFailedMsg("expect %d columns in orc row, but got %d", len(r.frs), len(values))
+			}
+			for i, fr := range r.frs {
+				value, err := fr.Convert(values[i])
+				if err != nil {
+					return nil, err
+				}
+				if err = insertData.Data[fr.field.GetFieldID()].AppendRow(value); err != nil {
+					return nil, merr.WrapErrImportFailedMsg("failed to append value of field '%s', err=%s", fr.field.GetName(), err.Error())
+				}
+			}
+			cnt++
+			if cnt >= r.count {
+				cnt = 0
+				if insertData.GetMemorySize() >= r.bufferSize {
+					break OUTER
+				}
+			}
+		}
+		r.hasStripe = false
+	}
+	if err = r.cursor.Err(); err != nil {
+		return nil, merr.WrapErrImportFailedMsg("failed to read orc file, error: %v", err)
+	}
+	if insertData.GetRowNum() == 0 {
+		return nil, io.EOF
+	}
+	common.RemoveUnpopulatedFunctionOutputFields(r.schema, insertData)
+	return insertData, nil
+}
+
+func (r *reader) Size() (int64, error) {
+	if size := r.fileSize.Load(); size != 0 {
+		return size, nil
+	}
+	size, err := r.cm.Size(r.ctx, r.filePath)
+	if err != nil {
+		return 0, err
+	}
+	r.fileSize.Store(size)
+	return size, nil
+}
+
+func (r *reader) Close() {
+	if r.r != nil {
+		r.r.Close()
+	}
+	if r.cmr != nil {
+		r.cmr.Close()
+	}
+}
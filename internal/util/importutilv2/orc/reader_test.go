@@ -0,0 +1,390 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package orc
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/scritchley/orc"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v3/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/v3/common"
+	"github.com/milvus-io/milvus/pkg/v3/objectstorage"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v3/util/typeutil"
+)
+
+const testORCSchema = "struct<pk:bigint,name:string,age:int,score:double,flag:boolean,meta:string," +
+	"vec:array<float>,fp16:array<float>,bf16:array<double>,sparse:map<int,float>," +
+	"tags:array<string>,nums:array<bigint>,ts:timestamp,f32:float,extra:string>"
+
+const (
+	pkFieldID int64 = 100 + iota
+	nameFieldID
+	ageFieldID
+	scoreFieldID
+	flagFieldID
+	metaFieldID
+	vecFieldID
+	fp16FieldID
+	bf16FieldID
+	sparseFieldID
+	tagsFieldID
+	numsFieldID
+	tsFieldID
+	f32FieldID
+	dynamicFieldID
+)
+
+type ReaderSuite struct {
+	suite.Suite
+
+	dir    string
+	cm     storage.ChunkManager
+	schema *schemapb.CollectionSchema
+}
+
+func (s *ReaderSuite) SetupSuite() {
+	paramtable.Init()
+}
+
+func (s *ReaderSuite) SetupTest() {
+	s.dir = s.T().TempDir()
+	s.cm = storage.NewLocalChunkManager(objectstorage.RootPath(s.dir))
+	dim := []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "4"}}
+	s.schema = &schemapb.CollectionSchema{
+		EnableDynamicField: true,
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: pkFieldID, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: nameFieldID, Name: "name", DataType: schemapb.DataType_VarChar, TypeParams: []*commonpb.KeyValuePair{{Key: common.MaxLengthKey, Value: "16"}}},
+			{FieldID: ageFieldID, Name: "age", DataType: schemapb.DataType_Int32, Nullable: true},
+			{
+				FieldID: scoreFieldID, Name: "score", DataType: schemapb.DataType_Double,
+				DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_DoubleData{DoubleData: 1.5}},
+			},
+			{FieldID: flagFieldID, Name: "flag", DataType: schemapb.DataType_Bool},
+			{FieldID: metaFieldID, Name: "meta", DataType: schemapb.DataType_JSON},
+			{FieldID: vecFieldID, Name: "vec", DataType: schemapb.DataType_FloatVector, TypeParams: dim},
+			{FieldID: fp16FieldID, Name: "fp16", DataType: schemapb.DataType_Float16Vector, TypeParams: dim},
+			{FieldID: bf16FieldID, Name: "bf16", DataType: schemapb.DataType_BFloat16Vector, TypeParams: dim},
+			{FieldID: sparseFieldID, Name: "sparse", DataType: schemapb.DataType_SparseFloatVector},
+			{
+				FieldID: tagsFieldID, Name: "tags", DataType: schemapb.DataType_Array, ElementType: schemapb.DataType_VarChar,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.MaxLengthKey, Value: "8"}, {Key: common.MaxCapacityKey, Value: "4"}},
+			},
+			{
+				FieldID: numsFieldID, Name: "nums", DataType: schemapb.DataType_Array, ElementType: schemapb.DataType_Int64,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.MaxCapacityKey, Value: "4"}},
+			},
+			{FieldID: tsFieldID, Name: "ts", DataType: schemapb.DataType_Timestamptz},
+			{FieldID: f32FieldID, Name: "f32", DataType: schemapb.DataType_Float},
+			{FieldID: dynamicFieldID, Name: "$meta", DataType: schemapb.DataType_JSON, IsDynamic: true},
+		},
+	}
+}
+
+func testPK(i int) int64 {
+	// not representable by float64, the value must not go through a float conversion
+	return 1<<62 + int64(i)
+}
+
+func testFloats(i int) []float32 {
+	return []float32{float32(i) + 0.1, float32(i) + 0.2, float32(i) + 0.3, float32(i) + 0.4}
+}
+
+func testTime(i int) time.Time {
+	return time.Unix(1700000000+int64(i), 1000).UTC()
+}
+
+func (s *ReaderSuite) writeORC(name string, schema string, numRows int, row func(i int) []any) string {
+	td, err := orc.ParseSchema(schema)
+	s.Require().NoError(err)
+	buf := &bytes.Buffer{}
+	w, err := orc.NewWriter(buf, orc.SetSchema(td))
+	s.Require().NoError(err)
+	for i := 0; i < numRows; i++ {
+		s.Require().NoError(w.Write(row(i)...))
+	}
+	s.Require().NoError(w.Close())
+	filePath := path.Join(s.dir, name)
+	s.Require().NoError(s.cm.Write(context.Background(), filePath, buf.Bytes()))
+	return filePath
+}
+
+func (s *ReaderSuite) testRow(i int) []any {
+	var age any
+	if i%2 == 0 {
+		age = int64(i)
+	}
+	var score any
+	if i%3 != 0 {
+		score = float64(i) * 2.5
+	}
+	doubles := make([]float64, 0, 4)
+	for _, f := range testFloats(i) {
+		doubles = append(doubles, float64(f))
+	}
+	return []any{
+		testPK(i),
+		fmt.Sprintf("name_%d", i),
+		age,
+		score,
+		i%2 == 0,
+		fmt.Sprintf(`{"k": %d}`, i),
+		testFloats(i),
+		testFloats(i),
+		doubles,
+		map[int64]float32{int64(i) + 10: 0.5, int64(i): 0.25},
+		[]string{"a", fmt.Sprintf("t_%d", i)},
+		[]int64{testPK(i), -1},
+		testTime(i),
+		float32(i) / 4,
+		"redundant",
+	}
+}
+
+func (s *ReaderSuite) TestRead() {
+	const numRows = 10
+	filePath := s.writeORC("test.orc", testORCSchema, numRows, s.testRow)
+
+	r, err := NewReader(context.Background(), s.cm, s.schema, filePath, 64*1024*1024)
+	s.Require().NoError(err)
+	defer r.Close()
+	size, err := r.Size()
+	s.NoError(err)
+	s.True(size > 0)
+
+	data, err := r.Read()
+	s.Require().NoError(err)
+	s.Equal(numRows, data.GetRowNum())
+	for i := 0; i < numRows; i++ {
+		s.Equal(testPK(i), data.Data[pkFieldID].GetRow(i))
+		s.Equal(fmt.Sprintf("name_%d", i), data.Data[nameFieldID].GetRow(i))
+		if i%2 == 0 {
+			s.Equal(int32(i), data.Data[ageFieldID].GetRow(i))
+		} else {
+			s.Nil(data.Data[ageFieldID].GetRow(i))
+		}
+		if i%3 != 0 {
+			s.Equal(float64(i)*2.5, data.Data[scoreFieldID].GetRow(i))
+		} else {
+			s.Equal(1.5, data.Data[scoreFieldID].GetRow(i))
+		}
+		s.Equal(i%2 == 0, data.Data[flagFieldID].GetRow(i))
+		s.JSONEq(fmt.Sprintf(`{"k": %d}`, i), string(data.Data[metaFieldID].GetRow(i).([]byte)))
+		s.Equal(testFloats(i), data.Data[vecFieldID].GetRow(i))
+		s.Equal(typeutil.Float32ArrayToFloat16Bytes(testFloats(i)), data.Data[fp16FieldID].GetRow(i))
+		s.Equal(typeutil.Float32ArrayToBFloat16Bytes(testFloats(i)), data.Data[bf16FieldID].GetRow(i))
+		s.Equal(typeutil.CreateSparseFloatRow([]uint32{uint32(i), uint32(i) + 10}, []float32{0.25, 0.5}), data.Data[sparseFieldID].GetRow(i))
+		s.Equal([]string{"a", fmt.Sprintf("t_%d", i)}, data.Data[tagsFieldID].GetRow(i).(*schemapb.ScalarField).GetStringData().GetData())
+		s.Equal([]int64{testPK(i), -1}, data.Data[numsFieldID].GetRow(i).(*schemapb.ScalarField).GetLongData().GetData())
+		s.Equal(testTime(i).UnixMicro(), data.Data[tsFieldID].GetRow(i))
+		s.Equal(float32(i)/4, data.Data[f32FieldID].GetRow(i))
+	}
+	// the dynamic field is filled by the import task if the file has no "$meta" column
+	s.Equal(0, data.Data[dynamicFieldID].RowNum())
+
+	_, err = r.Read()
+	s.ErrorIs(err, io.EOF)
+}
+
+func (s *ReaderSuite) TestReadBatches() {
+	const numRows = 100
+	filePath := s.writeORC("batch.orc", testORCSchema, numRows, s.testRow)
+
+	r, err := NewReader(context.Background(), s.cm, s.schema, filePath, 1)
+	s.Require().NoError(err)
+	defer r.Close()
+
+	total := 0
+	for {
+		data, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		s.Require().NoError(err)
+		s.True(data.GetRowNum() > 0)
+		for i := 0; i < data.GetRowNum(); i++ {
+			s.Equal(testPK(total+i), data.Data[pkFieldID].GetRow(i))
+		}
+		total += data.GetRowNum()
+	}
+	s.Equal(numRows, total)
+}
+
+func (s *ReaderSuite) TestPartialColumns() {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: pkFieldID, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true, AutoID: true},
+			{FieldID: ageFieldID, Name: "age", DataType: schemapb.DataType_Int64, Nullable: true},
+			{
+				FieldID: scoreFieldID, Name: "score", DataType: schemapb.DataType_Float,
+				DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_FloatData{FloatData: 0.5}},
+			},
+			{FieldID: f32FieldID, Name: "f32", DataType: schemapb.DataType_Double},
+		},
+	}
+	filePath := s.writeORC("partial.orc", "struct<f32:float,other:int>", 3, func(i int) []any {
+		return []any{float32(i) + 0.5, int64(i)}
+	})
+	r, err := NewReader(context.Background(), s.cm, schema, filePath, 1024)
+	s.Require().NoError(err)
+	defer r.Close()
+	data, err := r.Read()
+	s.Require().NoError(err)
+	s.Equal(3, data.GetRowNum())
+	s.Equal(0, data.Data[pkFieldID].RowNum())
+	s.Equal(0, data.Data[ageFieldID].RowNum())
+	s.Equal(0, data.Data[scoreFieldID].RowNum())
+	s.Equal(float64(1.5), data.Data[f32FieldID].GetRow(1))
+}
+
+func (s *ReaderSuite) TestCreateFieldReadersFailed() {
+	pk := &schemapb.FieldSchema{FieldID: pkFieldID, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true}
+	cases := []struct {
+		name      string
+		orcSchema string
+		fields    []*schemapb.FieldSchema
+	}{
+		{
+			name:      "missing field",
+			orcSchema: "struct<pk:bigint>",
+			fields:    []*schemapb.FieldSchema{pk, {FieldID: nameFieldID, Name: "name", DataType: schemapb.DataType_Bool}},
+		},
+		{
+			name:      "auto id",
+			orcSchema: "struct<pk:bigint>",
+			fields:    []*schemapb.FieldSchema{{FieldID: pkFieldID, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true, AutoID: true}},
+		},
+		{
+			name:      "narrowing integer",
+			orcSchema: "struct<pk:bigint,age:int>",
+			fields:    []*schemapb.FieldSchema{pk, {FieldID: ageFieldID, Name: "age", DataType: schemapb.DataType_Int16}},
+		},
+		{
+			name:      "string to integer",
+			orcSchema: "struct<pk:string>",
+			fields:    []*schemapb.FieldSchema{pk},
+		},
+		{
+			name:      "float to integer",
+			orcSchema: "struct<pk:double>",
+			fields:    []*schemapb.FieldSchema{pk},
+		},
+		{
+			name:      "integer list to float vector",
+			orcSchema: "struct<pk:bigint,vec:array<int>>",
+			fields: []*schemapb.FieldSchema{pk, {
+				FieldID: vecFieldID, Name: "vec", DataType: schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "4"}},
+			}},
+		},
+		{
+			name:      "array element",
+			orcSchema: "struct<pk:bigint,tags:array<int>>",
+			fields: []*schemapb.FieldSchema{pk, {
+				FieldID: tagsFieldID, Name: "tags", DataType: schemapb.DataType_Array, ElementType: schemapb.DataType_VarChar,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.MaxLengthKey, Value: "8"}, {Key: common.MaxCapacityKey, Value: "4"}},
+			}},
+		},
+		{
+			name:      "vector without dim",
+			orcSchema: "struct<pk:bigint,vec:array<float>>",
+			fields:    []*schemapb.FieldSchema{pk, {FieldID: vecFieldID, Name: "vec", DataType: schemapb.DataType_FloatVector}},
+		},
+	}
+	for _, c := range cases {
+		s.Run(c.name, func() {
+			td, err := orc.ParseSchema(c.orcSchema)
+			s.Require().NoError(err)
+			_, err = CreateFieldReaders(context.Background(), td, &schemapb.CollectionSchema{Fields: c.fields})
+			s.Error(err)
+		})
+	}
+
+	s.Run("struct array field", func() {
+		td, err := orc.ParseSchema("struct<pk:bigint>")
+		s.Require().NoError(err)
+		schema := &schemapb.CollectionSchema{
+			Fields:            []*schemapb.FieldSchema{pk},
+			StructArrayFields: []*schemapb.StructArrayFieldSchema{{FieldID: 200, Name: "st"}},
+		}
+		_, err = CreateFieldReaders(context.Background(), td, schema)
+		s.Error(err)
+		schema.StructArrayFields[0].Nullable = true
+		_, err = CreateFieldReaders(context.Background(), td, schema)
+		s.NoError(err)
+
+		td, err = orc.ParseSchema("struct<pk:bigint,st:array<struct<a:int>>>")
+		s.Require().NoError(err)
+		_, err = CreateFieldReaders(context.Background(), td, schema)
+		s.Error(err)
+	})
+}
+
+func (s *ReaderSuite) TestReadFailed() {
+	s.Run("not an orc file", func() {
+		badPath := path.Join(s.dir, "bad.orc")
+		s.NoError(s.cm.Write(context.Background(), badPath, []byte("not orc")))
+		_, err := NewReader(context.Background(), s.cm, s.schema, badPath, 1024)
+		s.Error(err)
+	})
+
+	s.Run("file not found", func() {
+		_, err := NewReader(context.Background(), s.cm, s.schema, path.Join(s.dir, "none.orc"), 1024)
+		s.Error(err)
+	})
+
+	cases := []struct {
+		name   string
+		values []any
+	}{
+		{name: "null of not nullable field", values: []any{nil, "name", []float32{1, 2, 3, 4}, []string{"a"}}},
+		{name: "varchar too long", values: []any{int64(1), "a name longer than 16", []float32{1, 2, 3, 4}, []string{"a"}}},
+		{name: "dim not matched", values: []any{int64(1), "name", []float32{1, 2, 3}, []string{"a"}}},
+		{name: "null element of vector", values: []any{int64(1), "name", []any{float32(1), nil, float32(3), float32(4)}, []string{"a"}}},
+		{name: "array capacity exceeded", values: []any{int64(1), "name", []float32{1, 2, 3, 4}, []string{"a", "b", "c", "d", "e"}}},
+		{name: "array element too long", values: []any{int64(1), "name", []float32{1, 2, 3, 4}, []string{"too long element"}}},
+	}
+	for i, c := range cases {
+		s.Run(c.name, func() {
+			filePath := s.writeORC(fmt.Sprintf("failed_%d.orc", i), "struct<pk:bigint,name:string,vec:array<float>,tags:array<string>>", 1,
+				func(int) []any { return c.values })
+			schema := &schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{
+				s.schema.GetFields()[0], s.schema.GetFields()[1], s.schema.GetFields()[6], s.schema.GetFields()[10],
+			}}
+			r, err := NewReader(context.Background(), s.cm, schema, filePath, 1024)
+			s.Require().NoError(err)
+			defer r.Close()
+			_, err = r.Read()
+			s.Error(err)
+		})
+	}
+}
+
+func TestORCReader(t *testing.T) {
+	suite.Run(t, new(ReaderSuite))
+}
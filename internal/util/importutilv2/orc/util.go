@@ -0,0 +1,197 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package orc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/samber/lo"
+	"github.com/scritchley/orc"
+	orcproto "github.com/scritchley/orc/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/schemapb"
+	common2 "github.com/milvus-io/milvus/internal/util/importutilv2/common"
+	"github.com/milvus-io/milvus/pkg/v3/common"
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+	"github.com/milvus-io/milvus/pkg/v3/util/typeutil"
+)
+
+// CreateFieldReaders maps the top-level ORC columns to collection fields by name with the
+// rules of parquet import, the readers are returned in the order of the selected columns.
+// Columns which are not in the collection are ignored, the dynamic field is read from the
+// "$meta" column.
+func CreateFieldReaders(ctx context.Context, orcSchema *orc.TypeDescription, schema *schemapb.CollectionSchema) ([]*FieldReader, error) {
+	nameToField := lo.KeyBy(schema.GetFields(), func(field *schemapb.FieldSchema) string {
+		return field.GetName()
+	})
+	structFields := lo.KeyBy(schema.GetStructArrayFields(), func(field *schemapb.StructArrayFieldSchema) string {
+		return field.GetName()
+	})
+	timezone := common2.GetSchemaTimezone(schema)
+
+	readFields := make(map[string]int64)
+	frs := make([]*FieldReader, 0, len(orcSchema.Columns()))
+	allowInsertAutoID, _ := common.IsAllowInsertAutoID(schema.GetProperties()...)
+	for _, column := range orcSchema.Columns() {
+		if _, ok := structFields[column]; ok {
+			return nil, merr.WrapErrImportFailedMsg("struct array field '%s' is not supported by orc import", column)
+		}
+		field, ok := nameToField[column]
+		if !ok {
+			// redundant fields, ignore. only accepts a special field "$meta" to store dynamic data
+			continue
+		}
+
+		// auto-id field must not be provided
+		if typeutil.IsAutoPKField(field) && !allowInsertAutoID {
+			return nil, merr.WrapErrImportFailed(
+				fmt.Sprintf("the primary key '%s' is auto-generated, no need to provide", field.GetName()))
+		}
+		// validate function output field
+		if field.GetIsFunctionOutput() {
+			if typeutil.IsBM25FunctionOutputField(field, schema) {
+				return nil, merr.WrapErrImportFailed(
+					fmt.Sprintf("not allowed to provide data for BM25 function output field '%s'", field.GetName()))
+			}
+			if !common.GetCollectionAllowInsertNonBM25FunctionOutputs(schema.GetProperties()) {
+				return nil, merr.WrapErrImportFailed(
+					fmt.Sprintf("not allowed to provide data for function output field '%s', "+
+						"set collection property '%s' to enable", field.GetName(), common.CollectionAllowInsertNonBM25FunctionOutputs))
+			}
+		}
+		if _, ok = readFields[field.GetName()]; ok {
+			return nil, merr.WrapErrImportFailed(
+				fmt.Sprintf("there is multi field with name: %s", field.GetName()))
+		}
+		columnType, err := orcSchema.GetField(column)
+		if err != nil {
+			return nil, merr.WrapErrImportFailedMsg("get orc column '%s' failed, err=%v", column, err)
+		}
+		fr, err := NewFieldReader(column, columnType, field, timezone)
+		if err != nil {
+			return nil, err
+		}
+		frs = append(frs, fr)
+		readFields[field.GetName()] = field.GetFieldID()
+	}
+
+	for _, structField := range schema.GetStructArrayFields() {
+		if !structField.GetNullable() {
+			return nil, merr.WrapErrImportFailedMsg("struct array field '%s' is not supported by orc import", structField.GetName())
+		}
+	}
+	// this loop is for "are there any fields not provided in the orc file?"
+	for _, field := range nameToField {
+		// auto-id field, function output field already checked
+		// dynamic field, nullable field, default value field, not provided or provided both ok
+		if typeutil.IsAutoPKField(field) || field.GetIsDynamic() || field.GetIsFunctionOutput() ||
+			field.GetNullable() || field.GetDefaultValue() != nil {
+			continue
+		}
+		// the other field must be provided
+		if _, ok := readFields[field.GetName()]; !ok {
+			return nil, merr.WrapErrImportFailed(
+				fmt.Sprintf("no orc column for milvus field '%s'", field.GetName()))
+		}
+	}
+
+	mlog.Info(ctx, "create orc column readers", mlog.Any("readFields", readFields))
+	return frs, nil
+}
+
+func isORCIntegerKind(kind orcproto.Type_Kind) bool {
+	switch kind {
+	case orcproto.Type_BYTE, orcproto.Type_SHORT, orcproto.Type_INT, orcproto.Type_LONG:
+		return true
+	default:
+		return false
+	}
+}
+
+func isORCFloatingKind(kind orcproto.Type_Kind) bool {
+	switch kind {
+	case orcproto.Type_FLOAT, orcproto.Type_DOUBLE, orcproto.Type_DECIMAL:
+		return true
+	default:
+		return false
+	}
+}
+
+func isORCStringKind(kind orcproto.Type_Kind) bool {
+	switch kind {
+	case orcproto.Type_STRING, orcproto.Type_VARCHAR, orcproto.Type_CHAR:
+		return true
+	default:
+		return false
+	}
+}
+
+// isORCIntegerConvertible returns whether the values of ORC integer kind are always
+// in the range of the integer data type, the same as the integer rules of parquet import.
+func isORCIntegerConvertible(kind orcproto.Type_Kind, dataType schemapb.DataType) bool {
+	switch kind {
+	case orcproto.Type_BYTE:
+		return true
+	case orcproto.Type_SHORT:
+		return dataType != schemapb.DataType_Int8
+	case orcproto.Type_INT:
+		return dataType == schemapb.DataType_Int32 || dataType == schemapb.DataType_Int64
+	case orcproto.Type_LONG:
+		return dataType == schemapb.DataType_Int64
+	default:
+		return false
+	}
+}
+
+func isORCScalarConvertible(kind orcproto.Type_Kind, dataType schemapb.DataType) bool {
+	switch dataType {
+	case schemapb.DataType_Bool:
+		return kind == orcproto.Type_BOOLEAN
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32, schemapb.DataType_Int64:
+		return isORCIntegerConvertible(kind, dataType)
+	case schemapb.DataType_Float, schemapb.DataType_Double:
+		return isORCIntegerKind(kind) || isORCFloatingKind(kind)
+	case schemapb.DataType_VarChar, schemapb.DataType_String:
+		return isORCStringKind(kind)
+	default:
+		return false
+	}
+}
+
+func isORCTypeConvertible(kind, elemKind orcproto.Type_Kind, field *schemapb.FieldSchema) bool {
+	switch field.GetDataType() {
+	case schemapb.DataType_Text, schemapb.DataType_JSON, schemapb.DataType_Geometry:
+		return isORCStringKind(kind)
+	case schemapb.DataType_Timestamptz:
+		return isORCStringKind(kind) || kind == orcproto.Type_TIMESTAMP || kind == orcproto.Type_DATE
+	case schemapb.DataType_FloatVector, schemapb.DataType_Float16Vector, schemapb.DataType_BFloat16Vector:
+		return kind == orcproto.Type_BINARY ||
+			(kind == orcproto.Type_LIST && (elemKind == orcproto.Type_FLOAT || elemKind == orcproto.Type_DOUBLE))
+	case schemapb.DataType_BinaryVector:
+		return kind == orcproto.Type_BINARY || (kind == orcproto.Type_LIST && isORCIntegerKind(elemKind))
+	case schemapb.DataType_Int8Vector:
+		return kind == orcproto.Type_LIST && isORCIntegerKind(elemKind)
+	case schemapb.DataType_SparseFloatVector:
+		return isORCStringKind(kind) || kind == orcproto.Type_MAP
+	case schemapb.DataType_Array:
+		return kind == orcproto.Type_LIST && isORCScalarConvertible(elemKind, field.GetElementType())
+	default:
+		return isORCScalarConvertible(kind, field.GetDataType())
+	}
+}
@@ -22,6 +22,7 @@ import (
 
 	"github.com/samber/lo"
 
+	"github.com/milvus-io/milvus/internal/util/importutilv2/common"
 	"github.com/milvus-io/milvus/pkg/v3/proto/internalpb"
 	"github.com/milvus-io/milvus/pkg/v3/util/merr"
 )
@@ -35,6 +36,8 @@ const (
 	Parquet   FileType = 3
 	CSV       FileType = 4
 	JSONLines FileType = 5
+	Avro      FileType = 6
+	ORC       FileType = 7
 
 	JSONFileExt    = ".json"
 	JSONLFileExt   = ".jsonl"
@@ -42,6 +45,8 @@ const (
 	NumpyFileExt   = ".npy"
 	ParquetFileExt = ".parquet"
 	CSVFileExt     = ".csv"
+	AvroFileExt    = ".avro"
+	ORCFileExt     = ".orc"
 )
 
 var FileTypeName = map[int]string{
@@ -51,6 +56,8 @@ var FileTypeName = map[int]string{
 	3: "Parquet",
 	4: "CSV",
 	5: "JSONLines",
+	6: "Avro",
+	7: "ORC",
 }
 
 func (f FileType) String() string {
@@ -65,8 +72,12 @@ func GetFileType(file *internalpb.ImportFile) (FileType, error) {
 	if len(file.GetPaths()) == 0 {
 		return Invalid, merr.WrapErrImportFailed("no file to import")
 	}
+	// compressed files, such as *.jsonl.gz and *.csv.zst, are identified by the inner extension
 	exts := lo.Map(file.GetPaths(), func(path string, _ int) string {
-		return filepath.Ext(path)
+		return filepath.Ext(common.TrimCompressionExt(path))
+	})
+	compressed := lo.ContainsBy(file.GetPaths(), func(path string) bool {
+		return common.GetCompression(path) != common.CompressionNone
 	})
 
 	ext := exts[0]
@@ -82,6 +93,10 @@ func GetFileType(file *internalpb.ImportFile) (FileType, error) {
 		}
 	}
 
+	if compressed && ext != JSONFileExt && !isJSONLinesType(ext) && ext != CSVFileExt {
+		return Invalid, merr.WrapErrImportFailedMsg("compressed file is only supported for JSON, JSONLines and CSV import, files=%v", file.GetPaths())
+	}
+
 	switch ext {
 	case JSONFileExt, JSONLFileExt, NDJSONFileExt:
 		if len(file.GetPaths()) != 1 {
@@ -104,6 +119,16 @@ func GetFileType(file *internalpb.ImportFile) (FileType, error) {
 			return Invalid, merr.WrapErrImportFailed("for CSV import, accepts only one file")
 		}
 		return CSV, nil
+	case AvroFileExt:
+		if len(file.GetPaths()) != 1 {
+			return Invalid, merr.WrapErrImportFailed("for Avro import, accepts only one file")
+		}
+		return Avro, nil
+	case ORCFileExt:
+		if len(file.GetPaths()) != 1 {
+			return Invalid, merr.WrapErrImportFailed("for ORC import, accepts only one file")
+		}
+		return ORC, nil
 	}
 	return Invalid, merr.WrapErrImportFailedMsg("unexpected file type, files=%v", file.GetPaths())
 }
@@ -0,0 +1,530 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package metacheck checks the consistency between the meta of the coordinators
+// and the object storage offline, and repairs the inconsistent meta.
+package metacheck
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/samber/lo"
+	"go.uber.org/atomic"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus/internal/metastore"
+	"github.com/milvus-io/milvus/internal/metastore/kv/binlog"
+	"github.com/milvus-io/milvus/internal/metastore/kv/datacoord"
+	"github.com/milvus-io/milvus/internal/metastore/kv/querycoord"
+	kvmetestore "github.com/milvus-io/milvus/internal/metastore/kv/rootcoord"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/segmentinspect"
+	"github.com/milvus-io/milvus/pkg/v3/common"
+	"github.com/milvus-io/milvus/pkg/v3/kv"
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/proto/datapb"
+	pb "github.com/milvus-io/milvus/pkg/v3/proto/etcdpb"
+	"github.com/milvus-io/milvus/pkg/v3/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/v3/util"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+	"github.com/milvus-io/milvus/pkg/v3/util/typeutil"
+)
+
+const (
+	walkPaginationSize = 1000
+	existConcurrency   = 16
+)
+
+// IssueType is the type of the inconsistency found by the Checker.
+type IssueType string
+
+const (
+	// IssueOrphanedSegment is a segment whose collection or partition is dropped.
+	IssueOrphanedSegment IssueType = "OrphanedSegment"
+	// IssueMissingBinlog is a segment whose binlogs are not found in the object storage.
+	IssueMissingBinlog IssueType = "MissingBinlog"
+	// IssueIndexOfDroppedField is an index whose field or collection is dropped.
+	IssueIndexOfDroppedField IssueType = "IndexOfDroppedField"
+	// IssueOrphanedPartition is a partition whose collection is dropped.
+	IssueOrphanedPartition IssueType = "OrphanedPartition"
+	// IssueDanglingAlias is an alias pointing to a dropped collection.
+	IssueDanglingAlias IssueType = "DanglingAlias"
+	// IssueOrphanedLoadInfo is the load info of a dropped collection or partition.
+	IssueOrphanedLoadInfo IssueType = "OrphanedLoadInfo"
+)
+
+// Issue is an inconsistency found by the Checker.
+type Issue struct {
+	Type         IssueType `json:"type"`
+	DBID         int64     `json:"dbID,omitempty"`
+	CollectionID int64     `json:"collectionID,omitempty"`
+	PartitionID  int64     `json:"partitionID,omitempty"`
+	SegmentID    int64     `json:"segmentID,omitempty"`
+	IndexID      int64     `json:"indexID,omitempty"`
+	Alias        string    `json:"alias,omitempty"`
+	Paths        []string  `json:"paths,omitempty"`
+	Detail       string    `json:"detail"`
+	// Repair describes the repair of the issue, empty if the issue can not be repaired.
+	Repair string `json:"repair,omitempty"`
+
+	repair func(ctx context.Context) error
+}
+
+// Repairable returns whether the issue can be repaired.
+func (issue *Issue) Repairable() bool {
+	return issue.repair != nil
+}
+
+// Report is the result of a check.
+type Report struct {
+	Databases    int      `json:"databases"`
+	Collections  int      `json:"collections"`
+	Segments     int      `json:"segments"`
+	Indexes      int      `json:"indexes"`
+	Aliases      int      `json:"aliases"`
+	CheckedFiles int64    `json:"checkedFiles"`
+	Issues       []*Issue `json:"issues"`
+}
+
+// Checker walks the catalogs of rootcoord, datacoord and querycoord and the object storage.
+//
+// The checks always read the meta through a read only kv. The repairs are applied through
+// the kv passed to NewChecker, so a Checker created over a read only kv never modifies the meta.
+// The repairs shall be applied while the coordinators are stopped.
+type Checker struct {
+	metaKV       kv.MetaKv
+	rootCatalog  metastore.RootCoordCatalog
+	dataCatalog  metastore.DataCoordCatalog
+	queryCatalog metastore.QueryCoordCatalog
+
+	writeKV           kv.MetaKv
+	writeRootCatalog  metastore.RootCoordCatalog
+	writeDataCatalog  metastore.DataCoordCatalog
+	writeQueryCatalog metastore.QueryCoordCatalog
+
+	// chunkManager is nil if the object storage is not checked.
+	chunkManager storage.ChunkManager
+}
+
+// NewChecker creates a Checker over the meta kv rooted at metaRootPath,
+// the object storage is not checked if chunkManager is nil.
+func NewChecker(metaKV kv.MetaKv, metaRootPath string, chunkManagerRootPath string, chunkManager storage.ChunkManager) *Checker {
+	readKV := segmentinspect.NewReadOnlyKV(metaKV)
+	return &Checker{
+		metaKV:            readKV,
+		rootCatalog:       kvmetestore.NewCatalog(readKV),
+		dataCatalog:       datacoord.NewCatalog(readKV, chunkManagerRootPath, metaRootPath),
+		queryCatalog:      querycoord.NewCatalog(readKV),
+		writeKV:           metaKV,
+		writeRootCatalog:  kvmetestore.NewCatalog(metaKV),
+		writeDataCatalog:  datacoord.NewCatalog(metaKV, chunkManagerRootPath, metaRootPath),
+		writeQueryCatalog: querycoord.NewCatalog(metaKV),
+		chunkManager:      chunkManager,
+	}
+}
+
+// Check reports the inconsistencies of the meta, the meta is not modified.
+func (c *Checker) Check(ctx context.Context) (*Report, error) {
+	report := &Report{}
+	dbIDs, collections, err := c.listCollections(ctx)
+	if err != nil {
+		return nil, err
+	}
+	report.Databases = len(dbIDs)
+	report.Collections = len(collections)
+
+	segmentCollectionIDs, err := c.listSegmentCollectionIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// a wrong meta root path shall not make all the segments orphaned
+	if len(collections) == 0 && len(segmentCollectionIDs) > 0 {
+		return nil, merr.WrapErrServiceInternalMsg("no collection found but segments of %d collections found, check the meta root path", len(segmentCollectionIDs))
+	}
+
+	if err := c.checkSegments(ctx, report, collections, segmentCollectionIDs); err != nil {
+		return nil, err
+	}
+	if err := c.checkIndexes(ctx, report, collections); err != nil {
+		return nil, err
+	}
+	if err := c.checkPartitions(ctx, report, collections); err != nil {
+		return nil, err
+	}
+	if err := c.checkAliases(ctx, report, collections, dbIDs); err != nil {
+		return nil, err
+	}
+	if err := c.checkLoadInfos(ctx, report, collections); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// Repair repairs the repairable issues in order, stops at the first failure.
+// Returns the count of the repaired issues.
+func (c *Checker) Repair(ctx context.Context, issues []*Issue) (int, error) {
+	repaired := 0
+	for _, issue := range issues {
+		if !issue.Repairable() {
+			continue
+		}
+		if err := issue.repair(ctx); err != nil {
+			return repaired, merr.Wrapf(err, "failed to repair %s: %s", issue.Type, issue.Detail)
+		}
+		mlog.Info(ctx, "meta issue repaired",
+			mlog.String("type", string(issue.Type)),
+			mlog.String("detail", issue.Detail),
+			mlog.String("repair", issue.Repair))
+		repaired++
+	}
+	return repaired, nil
+}
+
+// listCollections lists the collections of all the databases, including the legacy ones without database.
+func (c *Checker) listCollections(ctx context.Context) ([]int64, map[int64]*model.Collection, error) {
+	dbs, err := c.rootCatalog.ListDatabases(ctx, typeutil.MaxTimestamp)
+	if err != nil {
+		return nil, nil, err
+	}
+	dbIDs := lo.Map(dbs, func(db *model.Database, _ int) int64 { return db.ID })
+	if !lo.Contains(dbIDs, util.DefaultDBID) {
+		dbIDs = append(dbIDs, util.DefaultDBID)
+	}
+	collections := make(map[int64]*model.Collection)
+	for _, dbID := range append([]int64{util.NonDBID}, dbIDs...) {
+		colls, err := c.rootCatalog.ListCollections(ctx, dbID, typeutil.MaxTimestamp)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, coll := range colls {
+			collections[coll.CollectionID] = coll
+		}
+	}
+	return dbIDs, collections, nil
+}
+
+// listSegmentCollectionIDs lists the ids of the collections owning segments, including the dropped ones.
+func (c *Checker) listSegmentCollectionIDs(ctx context.Context) ([]int64, error) {
+	prefix := datacoord.SegmentPrefix + "/"
+	collectionIDs := typeutil.NewSet[int64]()
+	err := c.metaKV.WalkWithPrefix(ctx, prefix, walkPaginationSize, func(key []byte, _ []byte) error {
+		k := string(key)
+		idx := strings.Index(k, prefix)
+		if idx < 0 {
+			return nil
+		}
+		// {collectionID}/{partitionID}/{segmentID}
+		collectionID, err := strconv.ParseInt(strings.SplitN(k[idx+len(prefix):], "/", 2)[0], 10, 64)
+		if err != nil {
+			return merr.WrapErrDataIntegrity(err, "parse segment key failed, key:%s", k)
+		}
+		collectionIDs.Insert(collectionID)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	ids := collectionIDs.Collect()
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids, nil
+}
+
+func (c *Checker) checkSegments(ctx context.Context, report *Report, collections map[int64]*model.Collection, collectionIDs []int64) error {
+	for _, collectionID := range collectionIDs {
+		segments, err := c.dataCatalog.ListSegments(ctx, collectionID)
+		if err != nil {
+			return err
+		}
+		segments = lo.Filter(segments, func(segment *datapb.SegmentInfo, _ int) bool {
+			// the dropped segments are recycled by the garbage collector of datacoord
+			return segment.GetState() != commonpb.SegmentState_Dropped && segment.GetState() != commonpb.SegmentState_NotExist
+		})
+		sort.Slice(segments, func(i, j int) bool { return segments[i].GetID() < segments[j].GetID() })
+		report.Segments += len(segments)
+
+		coll, ok := collections[collectionID]
+		var partitionIDs typeutil.Set[int64]
+		if ok {
+			partitionIDs = typeutil.NewSet(lo.Map(coll.Partitions, func(p *model.Partition, _ int) int64 { return p.PartitionID })...)
+		}
+		var alive []*datapb.SegmentInfo
+		for _, segment := range segments {
+			switch {
+			case !ok:
+				report.Issues = append(report.Issues, c.orphanedSegment(segment, "collection dropped"))
+			case segment.GetPartitionID() != common.AllPartitionsID && !partitionIDs.Contain(segment.GetPartitionID()):
+				report.Issues = append(report.Issues, c.orphanedSegment(segment, "partition dropped"))
+			default:
+				alive = append(alive, segment)
+			}
+		}
+		if c.chunkManager != nil {
+			if err := c.checkBinlogs(ctx, report, alive); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (c *Checker) orphanedSegment(segment *datapb.SegmentInfo, reason string) *Issue {
+	return &Issue{
+		Type:         IssueOrphanedSegment,
+		CollectionID: segment.GetCollectionID(),
+		PartitionID:  segment.GetPartitionID(),
+		SegmentID:    segment.GetID(),
+		Detail:       fmt.Sprintf("segment %d in state %s, %s", segment.GetID(), segment.GetState(), reason),
+		Repair:       "mark the segment dropped, its files and meta are recycled by datacoord",
+		repair: func(ctx context.Context) error {
+			cloned := proto.Clone(segment).(*datapb.SegmentInfo)
+			cloned.State = commonpb.SegmentState_Dropped
+			cloned.DroppedAt = uint64(time.Now().UnixNano())
+			return c.writeDataCatalog.AlterSegments(ctx, []*datapb.SegmentInfo{cloned})
+		},
+	}
+}
+
+// checkBinlogs checks the existence of the binlogs of the segments,
+// the files of the segments in the manifest layout are listed in the manifest and skipped.
+func (c *Checker) checkBinlogs(ctx context.Context, report *Report, segments []*datapb.SegmentInfo) error {
+	segments = lo.Filter(segments, func(segment *datapb.SegmentInfo, _ int) bool {
+		return segment.GetManifestPath() == ""
+	})
+	issues := make([]*Issue, len(segments))
+	checked := atomic.NewInt64(0)
+	group, ctx := errgroup.WithContext(ctx)
+	group.SetLimit(existConcurrency)
+	for i, segment := range segments {
+		group.Go(func() error {
+			// decompress a copy, the segments listed are kept as stored
+			segment := proto.Clone(segment).(*datapb.SegmentInfo)
+			if err := binlog.DecompressBinLogs(segment); err != nil {
+				return err
+			}
+			var paths, missing []string
+			for _, fieldBinlogs := range [][]*datapb.FieldBinlog{segment.GetBinlogs(), segment.GetDeltalogs(), segment.GetStatslogs(), segment.GetBm25Statslogs()} {
+				for _, fieldBinlog := range fieldBinlogs {
+					for _, l := range fieldBinlog.GetBinlogs() {
+						paths = append(paths, l.GetLogPath())
+					}
+				}
+			}
+			for _, path := range paths {
+				exist, err := c.chunkManager.Exist(ctx, path)
+				if err != nil {
+					return err
+				}
+				if !exist {
+					missing = append(missing, path)
+				}
+			}
+			checked.Add(int64(len(paths)))
+			if len(missing) > 0 {
+				issues[i] = &Issue{
+					Type:         IssueMissingBinlog,
+					CollectionID: segment.GetCollectionID(),
+					PartitionID:  segment.GetPartitionID(),
+					SegmentID:    segment.GetID(),
+					Paths:        missing,
+					Detail:       fmt.Sprintf("%d of %d binlogs of segment %d not found", len(missing), len(paths), segment.GetID()),
+				}
+			}
+			return nil
+		})
+	}
+	if err := group.Wait(); err != nil {
+		return err
+	}
+	report.CheckedFiles += checked.Load()
+	report.Issues = append(report.Issues, lo.Compact(issues)...)
+	return nil
+}
+
+func (c *Checker) checkIndexes(ctx context.Context, report *Report, collections map[int64]*model.Collection) error {
+	indexes, err := c.dataCatalog.ListIndexes(ctx)
+	if err != nil {
+		return err
+	}
+	sort.Slice(indexes, func(i, j int) bool { return indexes[i].IndexID < indexes[j].IndexID })
+	for _, index := range indexes {
+		if index.IsDeleted {
+			continue
+		}
+		report.Indexes++
+		var reason string
+		if coll, ok := collections[index.CollectionID]; !ok {
+			reason = "collection dropped"
+		} else if typeutil.GetField(coll.ToCollectionSchemaPB(), index.FieldID) == nil {
+			reason = "field dropped"
+		} else {
+			continue
+		}
+		index := index
+		report.Issues = append(report.Issues, &Issue{
+			Type:         IssueIndexOfDroppedField,
+			CollectionID: index.CollectionID,
+			IndexID:      index.IndexID,
+			Detail:       fmt.Sprintf("index %s (%d) on field %d, %s", index.IndexName, index.IndexID, index.FieldID, reason),
+			Repair:       "mark the index deleted, its segment indexes and files are recycled by datacoord",
+			repair: func(ctx context.Context) error {
+				cloned := model.CloneIndex(index)
+				cloned.IsDeleted = true
+				return c.writeDataCatalog.AlterIndexes(ctx, []*model.Index{cloned})
+			},
+		})
+	}
+	return nil
+}
+
+// checkPartitions walks the partition keys directly, the catalog only lists the partitions of the existing collections.
+func (c *Checker) checkPartitions(ctx context.Context, report *Report, collections map[int64]*model.Collection) error {
+	_, values, err := c.metaKV.LoadWithPrefix(ctx, kvmetestore.PartitionMetaPrefix+"/")
+	if err != nil {
+		return err
+	}
+	for _, value := range values {
+		if kvmetestore.IsTombstone(value) {
+			continue
+		}
+		partition := &pb.PartitionInfo{}
+		if err := proto.Unmarshal([]byte(value), partition); err != nil {
+			return err
+		}
+		if _, ok := collections[partition.GetCollectionId()]; ok {
+			continue
+		}
+		key := kvmetestore.BuildPartitionKey(partition.GetCollectionId(), partition.GetPartitionID())
+		report.Issues = append(report.Issues, &Issue{
+			Type:         IssueOrphanedPartition,
+			CollectionID: partition.GetCollectionId(),
+			PartitionID:  partition.GetPartitionID(),
+			Detail:       fmt.Sprintf("partition %s (%d), collection dropped", partition.GetPartitionName(), partition.GetPartitionID()),
+			Repair:       "remove the partition meta",
+			repair: func(ctx context.Context) error {
+				return c.writeKV.MultiSaveAndRemove(ctx, nil, []string{key})
+			},
+		})
+	}
+	return nil
+}
+
+func (c *Checker) checkAliases(ctx context.Context, report *Report, collections map[int64]*model.Collection, dbIDs []int64) error {
+	type aliasKey struct {
+		dbID int64
+		name string
+	}
+	visited := typeutil.NewSet[aliasKey]()
+	for _, dbID := range dbIDs {
+		aliases, err := c.rootCatalog.ListAliases(ctx, dbID, typeutil.MaxTimestamp)
+		if err != nil {
+			return err
+		}
+		sort.Slice(aliases, func(i, j int) bool { return aliases[i].Name < aliases[j].Name })
+		for _, alias := range aliases {
+			// the aliases of the default database are listed with the legacy ones, use the listed database
+			key := aliasKey{dbID: dbID, name: alias.Name}
+			if visited.Contain(key) {
+				continue
+			}
+			visited.Insert(key)
+			report.Aliases++
+			if _, ok := collections[alias.CollectionID]; ok {
+				continue
+			}
+			dbID, name := dbID, alias.Name
+			report.Issues = append(report.Issues, &Issue{
+				Type:         IssueDanglingAlias,
+				DBID:         dbID,
+				CollectionID: alias.CollectionID,
+				Alias:        name,
+				Detail:       fmt.Sprintf("alias %s in database %d, collection %d dropped", name, dbID, alias.CollectionID),
+				Repair:       "drop the alias",
+				repair: func(ctx context.Context) error {
+					return c.writeRootCatalog.DropAlias(ctx, dbID, name, typeutil.MaxTimestamp)
+				},
+			})
+		}
+	}
+	return nil
+}
+
+func (c *Checker) checkLoadInfos(ctx context.Context, report *Report, collections map[int64]*model.Collection) error {
+	loadInfos, err := c.queryCatalog.GetCollections(ctx)
+	if err != nil {
+		return err
+	}
+	replicas, err := c.queryCatalog.GetReplicas(ctx)
+	if err != nil {
+		return err
+	}
+	loadedIDs := typeutil.NewSet(lo.Map(loadInfos, func(info *querypb.CollectionLoadInfo, _ int) int64 { return info.GetCollectionID() })...)
+	loadedIDs.Insert(lo.Map(replicas, func(replica *querypb.Replica, _ int) int64 { return replica.GetCollectionID() })...)
+	collectionIDs := loadedIDs.Collect()
+	sort.Slice(collectionIDs, func(i, j int) bool { return collectionIDs[i] < collectionIDs[j] })
+
+	partitions, err := c.queryCatalog.GetPartitions(ctx, collectionIDs)
+	if err != nil {
+		return err
+	}
+	for _, collectionID := range collectionIDs {
+		coll, ok := collections[collectionID]
+		if !ok {
+			collectionID := collectionID
+			report.Issues = append(report.Issues, &Issue{
+				Type:         IssueOrphanedLoadInfo,
+				CollectionID: collectionID,
+				Detail:       fmt.Sprintf("collection %d loaded, collection dropped", collectionID),
+				Repair:       "release the collection, its replicas and target",
+				repair: func(ctx context.Context) error {
+					if err := c.writeQueryCatalog.ReleaseCollection(ctx, collectionID); err != nil {
+						return err
+					}
+					if err := c.writeQueryCatalog.ReleaseReplicas(ctx, collectionID); err != nil {
+						return err
+					}
+					return c.writeQueryCatalog.RemoveCollectionTarget(ctx, collectionID)
+				},
+			})
+			continue
+		}
+		partitionIDs := typeutil.NewSet(lo.Map(coll.Partitions, func(p *model.Partition, _ int) int64 { return p.PartitionID })...)
+		for _, partition := range partitions[collectionID] {
+			if partitionIDs.Contain(partition.GetPartitionID()) {
+				continue
+			}
+			partitionID := partition.GetPartitionID()
+			report.Issues = append(report.Issues, &Issue{
+				Type:         IssueOrphanedLoadInfo,
+				CollectionID: collectionID,
+				PartitionID:  partitionID,
+				Detail:       fmt.Sprintf("partition %d loaded, partition dropped", partitionID),
+				Repair:       "release the partition",
+				repair: func(ctx context.Context) error {
+					return c.writeQueryCatalog.ReleasePartition(ctx, collectionID, partitionID)
+				},
+			})
+		}
+	}
+	return nil
+}
@@ -0,0 +1,205 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metacheck
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	kvmocks "github.com/milvus-io/milvus/internal/kv/mocks"
+	"github.com/milvus-io/milvus/internal/metastore"
+	"github.com/milvus-io/milvus/internal/metastore/kv/datacoord"
+	kvmetestore "github.com/milvus-io/milvus/internal/metastore/kv/rootcoord"
+	"github.com/milvus-io/milvus/internal/metastore/mocks"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	storagemocks "github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/pkg/v3/proto/datapb"
+	pb "github.com/milvus-io/milvus/pkg/v3/proto/etcdpb"
+	"github.com/milvus-io/milvus/pkg/v3/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/v3/util"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+type CheckerSuite struct {
+	suite.Suite
+
+	metaKV       *kvmocks.MetaKv
+	rootCatalog  *mocks.RootCoordCatalog
+	dataCatalog  *mocks.DataCoordCatalog
+	queryCatalog *mocks.QueryCoordCatalog
+	chunkManager *storagemocks.ChunkManager
+	checker      *Checker
+}
+
+func (s *CheckerSuite) SetupSuite() {
+	paramtable.Init()
+}
+
+func (s *CheckerSuite) SetupTest() {
+	s.metaKV = kvmocks.NewMetaKv(s.T())
+	s.rootCatalog = mocks.NewRootCoordCatalog(s.T())
+	s.dataCatalog = mocks.NewDataCoordCatalog(s.T())
+	s.queryCatalog = mocks.NewQueryCoordCatalog(s.T())
+	s.chunkManager = storagemocks.NewChunkManager(s.T())
+	s.checker = &Checker{
+		metaKV:            s.metaKV,
+		rootCatalog:       s.rootCatalog,
+		dataCatalog:       s.dataCatalog,
+		queryCatalog:      s.queryCatalog,
+		writeKV:           s.metaKV,
+		writeRootCatalog:  s.rootCatalog,
+		writeDataCatalog:  s.dataCatalog,
+		writeQueryCatalog: s.queryCatalog,
+		chunkManager:      s.chunkManager,
+	}
+}
+
+func (s *CheckerSuite) mockSegmentKeys(keys ...string) {
+	s.metaKV.EXPECT().WalkWithPrefix(mock.Anything, datacoord.SegmentPrefix+"/", mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, prefix string, size int, fn func([]byte, []byte) error) error {
+			for _, key := range keys {
+				if err := fn([]byte("by-dev/meta/"+prefix+key), nil); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+}
+
+func (s *CheckerSuite) TestCheckAndRepair() {
+	ctx := context.Background()
+	coll := &model.Collection{
+		CollectionID: 1,
+		DBID:         util.DefaultDBID,
+		Fields:       []*model.Field{{FieldID: 100, Name: "pk"}, {FieldID: 101, Name: "vec"}},
+		Partitions:   []*model.Partition{{PartitionID: 10, CollectionID: 1}},
+	}
+	s.rootCatalog.EXPECT().ListDatabases(mock.Anything, mock.Anything).Return([]*model.Database{{ID: util.DefaultDBID}}, nil)
+	s.rootCatalog.EXPECT().ListCollections(mock.Anything, util.NonDBID, mock.Anything).Return(nil, nil)
+	s.rootCatalog.EXPECT().ListCollections(mock.Anything, util.DefaultDBID, mock.Anything).Return([]*model.Collection{coll}, nil)
+
+	s.mockSegmentKeys("1/10/1000", "1/11/1001", "1/10/1002", "2/20/2000")
+	s.dataCatalog.EXPECT().ListSegments(mock.Anything, int64(1)).Return([]*datapb.SegmentInfo{
+		{
+			ID: 1000, CollectionID: 1, PartitionID: 10, State: commonpb.SegmentState_Flushed,
+			Binlogs: []*datapb.FieldBinlog{{FieldID: 100, Binlogs: []*datapb.Binlog{{LogID: 1}, {LogID: 2}}}},
+		},
+		{ID: 1001, CollectionID: 1, PartitionID: 11, State: commonpb.SegmentState_Flushed},
+		{ID: 1002, CollectionID: 1, PartitionID: 10, State: commonpb.SegmentState_Dropped},
+	}, nil)
+	s.dataCatalog.EXPECT().ListSegments(mock.Anything, int64(2)).Return([]*datapb.SegmentInfo{
+		{ID: 2000, CollectionID: 2, PartitionID: 20, State: commonpb.SegmentState_Growing},
+	}, nil)
+	s.chunkManager.EXPECT().Exist(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, path string) (bool, error) {
+		return !strings.HasSuffix(path, "/2"), nil
+	})
+
+	s.dataCatalog.EXPECT().ListIndexes(mock.Anything).Return([]*model.Index{
+		{CollectionID: 1, IndexID: 1, FieldID: 101},
+		{CollectionID: 1, IndexID: 2, FieldID: 102},
+		{CollectionID: 2, IndexID: 3, FieldID: 101},
+		{CollectionID: 1, IndexID: 4, FieldID: 102, IsDeleted: true},
+	}, nil)
+
+	partitions := lo.Map([]*pb.PartitionInfo{
+		{PartitionID: 10, CollectionId: 1},
+		{PartitionID: 20, CollectionId: 2},
+	}, func(p *pb.PartitionInfo, _ int) string {
+		bs, err := proto.Marshal(p)
+		s.Require().NoError(err)
+		return string(bs)
+	})
+	s.metaKV.EXPECT().LoadWithPrefix(mock.Anything, kvmetestore.PartitionMetaPrefix+"/").Return(nil, partitions, nil)
+
+	s.rootCatalog.EXPECT().ListAliases(mock.Anything, util.DefaultDBID, mock.Anything).Return([]*model.Alias{
+		{Name: "a1", CollectionID: 1, DbID: util.DefaultDBID},
+		{Name: "a2", CollectionID: 2, DbID: util.DefaultDBID},
+	}, nil)
+
+	s.queryCatalog.EXPECT().GetCollections(mock.Anything).Return([]*querypb.CollectionLoadInfo{{CollectionID: 1}}, nil)
+	s.queryCatalog.EXPECT().GetReplicas(mock.Anything).Return([]*querypb.Replica{{ID: 1, CollectionID: 2}}, nil)
+	s.queryCatalog.EXPECT().GetPartitions(mock.Anything, []int64{1, 2}).Return(map[int64][]*querypb.PartitionLoadInfo{
+		1: {{CollectionID: 1, PartitionID: 10}, {CollectionID: 1, PartitionID: 11}},
+	}, nil)
+
+	report, err := s.checker.Check(ctx)
+	s.Require().NoError(err)
+	s.Equal(1, report.Databases)
+	s.Equal(1, report.Collections)
+	s.Equal(3, report.Segments)
+	s.Equal(3, report.Indexes)
+	s.Equal(2, report.Aliases)
+	s.EqualValues(2, report.CheckedFiles)
+
+	types := lo.Map(report.Issues, func(issue *Issue, _ int) IssueType { return issue.Type })
+	s.Equal([]IssueType{
+		IssueOrphanedSegment, IssueMissingBinlog, IssueOrphanedSegment,
+		IssueIndexOfDroppedField, IssueIndexOfDroppedField,
+		IssueOrphanedPartition,
+		IssueDanglingAlias,
+		IssueOrphanedLoadInfo, IssueOrphanedLoadInfo,
+	}, types)
+	s.EqualValues(1001, report.Issues[0].SegmentID)
+	s.EqualValues(1000, report.Issues[1].SegmentID)
+	s.Len(report.Issues[1].Paths, 1)
+	s.False(report.Issues[1].Repairable())
+	s.EqualValues(2000, report.Issues[2].SegmentID)
+	s.EqualValues(20, report.Issues[5].PartitionID)
+	s.Equal("a2", report.Issues[6].Alias)
+
+	s.dataCatalog.EXPECT().AlterSegments(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, segments []*datapb.SegmentInfo, _ ...metastore.BinlogsIncrement) error {
+		s.Len(segments, 1)
+		s.Equal(commonpb.SegmentState_Dropped, segments[0].GetState())
+		s.NotZero(segments[0].GetDroppedAt())
+		return nil
+	}).Twice()
+	s.dataCatalog.EXPECT().AlterIndexes(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, indexes []*model.Index) error {
+		s.Len(indexes, 1)
+		s.True(indexes[0].IsDeleted)
+		return nil
+	}).Twice()
+	s.metaKV.EXPECT().MultiSaveAndRemove(mock.Anything, mock.Anything, []string{kvmetestore.BuildPartitionKey(2, 20)}).Return(nil).Once()
+	s.rootCatalog.EXPECT().DropAlias(mock.Anything, util.DefaultDBID, "a2", mock.Anything).Return(nil).Once()
+	s.queryCatalog.EXPECT().ReleasePartition(mock.Anything, int64(1), int64(11)).Return(nil).Once()
+	s.queryCatalog.EXPECT().ReleaseCollection(mock.Anything, int64(2)).Return(nil).Once()
+	s.queryCatalog.EXPECT().ReleaseReplicas(mock.Anything, int64(2)).Return(nil).Once()
+	s.queryCatalog.EXPECT().RemoveCollectionTarget(mock.Anything, int64(2)).Return(nil).Once()
+
+	repaired, err := s.checker.Repair(ctx, report.Issues)
+	s.NoError(err)
+	s.Equal(8, repaired)
+}
+
+func (s *CheckerSuite) TestNoCollection() {
+	s.rootCatalog.EXPECT().ListDatabases(mock.Anything, mock.Anything).Return(nil, nil)
+	s.rootCatalog.EXPECT().ListCollections(mock.Anything, mock.Anything, mock.Anything).Return(nil, nil)
+	s.mockSegmentKeys("1/10/1000")
+
+	_, err := s.checker.Check(context.Background())
+	s.Error(err)
+}
+
+func TestChecker(t *testing.T) {
+	suite.Run(t, new(CheckerSuite))
+}
@@ -150,6 +150,10 @@ func (m *GrpcQueryNodeClient) ComputePhraseMatchSlop(ctx context.Context, in *qu
 	return &querypb.ComputePhraseMatchSlopResponse{}, m.Err
 }
 
+func (m *GrpcQueryNodeClient) GetShardAppliedVersion(ctx context.Context, in *querypb.GetShardAppliedVersionRequest, opts ...grpc.CallOption) (*querypb.GetShardAppliedVersionResponse, error) {
+	return &querypb.GetShardAppliedVersionResponse{}, m.Err
+}
+
 func (m *GrpcQueryNodeClient) ValidateAnalyzer(ctx context.Context, in *querypb.ValidateAnalyzerRequest, opts ...grpc.CallOption) (*querypb.ValidateAnalyzerResponse, error) {
 	return &querypb.ValidateAnalyzerResponse{}, m.Err
 }
@@ -0,0 +1,65 @@
+/*
+ * Licensed to the LF AI & Data foundation under one
+ * or more contributor license agreements. See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership. The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License. You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package quota
+
+import (
+	"github.com/milvus-io/milvus/internal/json"
+	"github.com/milvus-io/milvus/pkg/v3/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+// principalRateTypes maps the keys of the principal rate limit configurations to the rate types.
+var principalRateTypes = map[string]internalpb.RateType{
+	"insertRate": internalpb.RateType_DMLInsert,
+	"deleteRate": internalpb.RateType_DMLDelete,
+	"searchRate": internalpb.RateType_DQLSearch,
+	"queryRate":  internalpb.RateType_DQLQuery,
+}
+
+// ParsePrincipalRateLimits parses the rate limits of the principals, in the format of
+// {"name": {"searchRate": 100, "insertRate": 5}}, the dml rates are converted from MB/s to bytes/s.
+func ParsePrincipalRateLimits(value string) (map[string]map[internalpb.RateType]float64, error) {
+	if value == "" {
+		return nil, nil
+	}
+	configs := make(map[string]map[string]float64)
+	if err := json.Unmarshal([]byte(value), &configs); err != nil {
+		return nil, merr.WrapErrParameterInvalidMsg("invalid principal rate limits: %s", err.Error())
+	}
+	limits := make(map[string]map[internalpb.RateType]float64, len(configs))
+	for name, config := range configs {
+		rates := make(map[internalpb.RateType]float64, len(config))
+		for key, rate := range config {
+			rt, ok := principalRateTypes[key]
+			if !ok {
+				return nil, merr.WrapErrParameterInvalidMsg("unknown rate %s of %s, only supports insertRate, deleteRate, searchRate and queryRate", key, name)
+			}
+			if rate < 0 {
+				return nil, merr.WrapErrParameterInvalidMsg("negative rate %s of %s", key, name)
+			}
+			if rt == internalpb.RateType_DMLInsert || rt == internalpb.RateType_DMLDelete {
+				rate *= paramtable.MBSize
+			}
+			rates[rt] = rate
+		}
+		limits[name] = rates
+	}
+	return limits, nil
+}
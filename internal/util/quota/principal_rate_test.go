@@ -0,0 +1,48 @@
+/*
+ * Licensed to the LF AI & Data foundation under one
+ * or more contributor license agreements. See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership. The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License. You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package quota
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/v3/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+func TestParsePrincipalRateLimits(t *testing.T) {
+	limits, err := ParsePrincipalRateLimits("")
+	assert.NoError(t, err)
+	assert.Empty(t, limits)
+
+	limits, err = ParsePrincipalRateLimits(`{"alice": {"insertRate": 2, "deleteRate": 1, "searchRate": 100, "queryRate": 50}}`)
+	assert.NoError(t, err)
+	assert.Equal(t, 2*paramtable.MBSize, limits["alice"][internalpb.RateType_DMLInsert])
+	assert.Equal(t, paramtable.MBSize, limits["alice"][internalpb.RateType_DMLDelete])
+	assert.Equal(t, float64(100), limits["alice"][internalpb.RateType_DQLSearch])
+	assert.Equal(t, float64(50), limits["alice"][internalpb.RateType_DQLQuery])
+
+	_, err = ParsePrincipalRateLimits(`{"alice": 1}`)
+	assert.Error(t, err)
+	_, err = ParsePrincipalRateLimits(`{"alice": {"searchRate": -1}}`)
+	assert.Error(t, err)
+	_, err = ParsePrincipalRateLimits(`{"alice": {"flushRate": 1}}`)
+	assert.Error(t, err)
+}
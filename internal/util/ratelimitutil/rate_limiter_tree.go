@@ -168,6 +168,23 @@ func (rln *RateLimiterNode) GetID() int64 {
 
 const clearInvalidNodeInterval = 1 * time.Minute
 
+type PrincipalKind string
+
+const (
+	PrincipalUser PrincipalKind = "user"
+	PrincipalRole PrincipalKind = "role"
+)
+
+// Principal is the user or role which a request is issued by.
+type Principal struct {
+	Kind PrincipalKind
+	Name string
+}
+
+func (p Principal) String() string {
+	return fmt.Sprintf("%s %s", p.Kind, p.Name)
+}
+
 // RateLimiterTree is implemented based on RateLimiterNode to operate multilevel rate limiters
 //
 // it contains the following four levels generally:
@@ -176,16 +193,51 @@ const clearInvalidNodeInterval = 1 * time.Minute
 //		-> database level
 //			-> collection level
 //				-> partition levelearl
+//
+// besides, it contains the limiters of the principals, a request issued by a user is
+// also limited by the limiters of the user and its roles.
 type RateLimiterTree struct {
 	root *RateLimiterNode
 	mu   sync.RWMutex
 
+	principals *typeutil.ConcurrentMap[Principal, *RateLimiterNode]
+
 	lastClearTime time.Time
 }
 
 // NewRateLimiterTree returns a new RateLimiterTree.
 func NewRateLimiterTree(root *RateLimiterNode) *RateLimiterTree {
-	return &RateLimiterTree{root: root, lastClearTime: time.Now()}
+	return &RateLimiterTree{
+		root:          root,
+		principals:    typeutil.NewConcurrentMap[Principal, *RateLimiterNode](),
+		lastClearTime: time.Now(),
+	}
+}
+
+// GetPrincipalLimiters returns the limiters of the principal, nil if it's not limited.
+func (m *RateLimiterTree) GetPrincipalLimiters(principal Principal) *RateLimiterNode {
+	node, _ := m.principals.Get(principal)
+	return node
+}
+
+// GetPrincipals returns the limiters of all the principals.
+func (m *RateLimiterTree) GetPrincipals() *typeutil.ConcurrentMap[Principal, *RateLimiterNode] {
+	return m.principals
+}
+
+// ResetPrincipalLimiters replaces the limiters of all the principals.
+func (m *RateLimiterTree) ResetPrincipalLimiters(principals map[Principal]*RateLimiterNode) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.principals.Range(func(principal Principal, _ *RateLimiterNode) bool {
+		if _, ok := principals[principal]; !ok {
+			m.principals.Remove(principal)
+		}
+		return true
+	})
+	for principal, node := range principals {
+		m.principals.Insert(principal, node)
+	}
 }
 
 // GetRootLimiters get root limiters
@@ -214,3 +214,28 @@ func TestRateLimiterTreeClearInvalidLimiterNode(t *testing.T) {
 	assert.Equal(t, 1, root.GetChild(1).GetChildren().Len())
 	assert.Equal(t, 1, root.GetChild(1).GetChild(10).GetChildren().Len())
 }
+
+func TestRateLimiterTreePrincipalLimiters(t *testing.T) {
+	tree := NewRateLimiterTree(NewRateLimiterNode(internalpb.RateScope_Cluster))
+	alice := Principal{Kind: PrincipalUser, Name: "alice"}
+	admin := Principal{Kind: PrincipalRole, Name: "admin"}
+	assert.Nil(t, tree.GetPrincipalLimiters(alice))
+	assert.Equal(t, "user alice", alice.String())
+
+	tree.ResetPrincipalLimiters(map[Principal]*RateLimiterNode{
+		alice: NewRateLimiterNode(internalpb.RateScope_Cluster),
+		admin: NewRateLimiterNode(internalpb.RateScope_Cluster),
+	})
+	assert.NotNil(t, tree.GetPrincipalLimiters(alice))
+	assert.NotNil(t, tree.GetPrincipalLimiters(admin))
+	assert.Equal(t, 2, tree.GetPrincipals().Len())
+
+	tree.ResetPrincipalLimiters(map[Principal]*RateLimiterNode{
+		admin: NewRateLimiterNode(internalpb.RateScope_Cluster),
+	})
+	assert.Nil(t, tree.GetPrincipalLimiters(alice))
+	assert.NotNil(t, tree.GetPrincipalLimiters(admin))
+	assert.Equal(t, 1, tree.GetPrincipals().Len())
+	// the principal limiters are not in the resource tree
+	assert.Equal(t, 0, tree.GetRootLimiters().GetChildren().Len())
+}
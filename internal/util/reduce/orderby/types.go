@@ -122,37 +122,9 @@ func ParseOrderByFields(orderByStrs []string, schema *schemapb.CollectionSchema)
 			continue
 		}
 
-		// Parse "field:direction:nulls_option" format
-		parts := strings.Split(orderByStr, ":")
-		fieldName := strings.TrimSpace(parts[0])
-
-		ascending := true
-		if len(parts) > 1 {
-			dir := strings.ToLower(strings.TrimSpace(parts[1]))
-			switch dir {
-			case "desc", "descending":
-				ascending = false
-			case "asc", "ascending", "":
-				ascending = true
-			default:
-				return nil, merr.WrapErrParameterInvalidMsg("invalid order direction '%s' for field '%s', must be 'asc' or 'desc'", dir, fieldName)
-			}
-		}
-
-		// Default null handling follows PostgreSQL convention
-		nullsFirst := !ascending // ASC -> NULLS LAST (false), DESC -> NULLS FIRST (true)
-
-		// Parse explicit null ordering if provided
-		if len(parts) > 2 {
-			nullOpt := strings.ToLower(strings.TrimSpace(parts[2]))
-			switch nullOpt {
-			case "nulls_first":
-				nullsFirst = true
-			case "nulls_last":
-				nullsFirst = false
-			default:
-				return nil, merr.WrapErrParameterInvalidMsg("invalid null ordering '%s', must be 'nulls_first' or 'nulls_last'", nullOpt)
-			}
+		fieldName, ascending, nullsFirst, err := ParseOrderBySpec(orderByStr)
+		if err != nil {
+			return nil, err
 		}
 
 		// Validate field exists in schema.
@@ -181,6 +153,44 @@ func ParseOrderByFields(orderByStrs []string, schema *schemapb.CollectionSchema)
 	return result, nil
 }
 
+// ParseOrderBySpec parses a single "name:direction:nulls_option" ORDER BY item, the
+// name is returned without validation so that callers could resolve it against
+// the schema or the output fields of an aggregation.
+func ParseOrderBySpec(orderByStr string) (name string, ascending bool, nullsFirst bool, err error) {
+	parts := strings.Split(strings.TrimSpace(orderByStr), ":")
+	name = strings.TrimSpace(parts[0])
+
+	ascending = true
+	if len(parts) > 1 {
+		dir := strings.ToLower(strings.TrimSpace(parts[1]))
+		switch dir {
+		case "desc", "descending":
+			ascending = false
+		case "asc", "ascending", "":
+			ascending = true
+		default:
+			return "", false, false, merr.WrapErrParameterInvalidMsg("invalid order direction '%s' for field '%s', must be 'asc' or 'desc'", dir, name)
+		}
+	}
+
+	// Default null handling follows PostgreSQL convention
+	nullsFirst = !ascending // ASC -> NULLS LAST (false), DESC -> NULLS FIRST (true)
+
+	// Parse explicit null ordering if provided
+	if len(parts) > 2 {
+		nullOpt := strings.ToLower(strings.TrimSpace(parts[2]))
+		switch nullOpt {
+		case "nulls_first":
+			nullsFirst = true
+		case "nulls_last":
+			nullsFirst = false
+		default:
+			return "", false, false, merr.WrapErrParameterInvalidMsg("invalid null ordering '%s', must be 'nulls_first' or 'nulls_last'", nullOpt)
+		}
+	}
+	return name, ascending, nullsFirst, nil
+}
+
 // ConvertFromPlanOrderByFields converts planpb.OrderByField to orderby.OrderByField
 // using schema to look up FieldName and DataType (which planpb doesn't carry).
 func ConvertFromPlanOrderByFields(planFields []*planpb.OrderByField, schema *schemapb.CollectionSchema) ([]*OrderByField, error) {
@@ -0,0 +1,343 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package segmentinspect resolves the segments of a collection to their files on the
+// object storage and reads the segment data offline, without any running component.
+package segmentinspect
+
+import (
+	"context"
+	"io"
+	"sort"
+
+	"github.com/samber/lo"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v3/schemapb"
+	"github.com/milvus-io/milvus/internal/compaction"
+	"github.com/milvus-io/milvus/internal/json"
+	"github.com/milvus-io/milvus/internal/metastore"
+	"github.com/milvus-io/milvus/internal/metastore/kv/binlog"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/segmentutil"
+	"github.com/milvus-io/milvus/pkg/v3/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v3/proto/indexpb"
+	"github.com/milvus-io/milvus/pkg/v3/util"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+	"github.com/milvus-io/milvus/pkg/v3/util/metautil"
+	"github.com/milvus-io/milvus/pkg/v3/util/typeutil"
+)
+
+// IndexFiles is the files of an index built on the segment.
+type IndexFiles struct {
+	IndexID      int64    `json:"indexID"`
+	IndexName    string   `json:"indexName"`
+	FieldID      int64    `json:"fieldID"`
+	BuildID      int64    `json:"buildID"`
+	IndexVersion int64    `json:"indexVersion"`
+	State        string   `json:"state"`
+	Files        []string `json:"files,omitempty"`
+}
+
+// SegmentReport is the summary of the meta, the files and the data of a segment.
+type SegmentReport struct {
+	CollectionID   int64  `json:"collectionID"`
+	PartitionID    int64  `json:"partitionID"`
+	SegmentID      int64  `json:"segmentID"`
+	Channel        string `json:"channel"`
+	State          string `json:"state"`
+	Level          string `json:"level"`
+	StorageVersion int64  `json:"storageVersion"`
+	// ManifestPath is set for the segments of the manifest layout, whose files are listed in the manifest.
+	ManifestPath string `json:"manifestPath,omitempty"`
+
+	NumRows                 int64 `json:"numRows"`
+	BinlogRows              int64 `json:"binlogRows"`
+	DeletedRows             int64 `json:"deletedRows"`
+	SchemaVersion           int32 `json:"schemaVersion"`
+	CollectionSchemaVersion int32 `json:"collectionSchemaVersion"`
+	MinPK                   any   `json:"minPK,omitempty"`
+	MaxPK                   any   `json:"maxPK,omitempty"`
+	// PKRangeError is the reason why the primary key range is not available.
+	PKRangeError string `json:"pkRangeError,omitempty"`
+
+	InsertLogs map[int64][]string `json:"insertLogs,omitempty"`
+	DeltaLogs  []string           `json:"deltaLogs,omitempty"`
+	StatsLogs  map[int64][]string `json:"statsLogs,omitempty"`
+	BM25Logs   map[int64][]string `json:"bm25Logs,omitempty"`
+	Indexes    []*IndexFiles      `json:"indexes,omitempty"`
+
+	segment *datapb.SegmentInfo
+}
+
+// Inspector reads the meta from the catalogs and the data from the object storage.
+type Inspector struct {
+	rootCatalog   metastore.RootCoordCatalog
+	dataCatalog   metastore.DataCoordCatalog
+	chunkManager  storage.ChunkManager
+	storageConfig *indexpb.StorageConfig
+}
+
+// NewInspector creates an Inspector, the catalogs shall be created over a read only kv.
+func NewInspector(rootCatalog metastore.RootCoordCatalog, dataCatalog metastore.DataCoordCatalog,
+	chunkManager storage.ChunkManager, storageConfig *indexpb.StorageConfig,
+) *Inspector {
+	return &Inspector{
+		rootCatalog:   rootCatalog,
+		dataCatalog:   dataCatalog,
+		chunkManager:  chunkManager,
+		storageConfig: storageConfig,
+	}
+}
+
+// GetCollection finds the collection by id in all the databases.
+func (i *Inspector) GetCollection(ctx context.Context, collectionID int64) (*model.Collection, error) {
+	dbs, err := i.rootCatalog.ListDatabases(ctx, typeutil.MaxTimestamp)
+	if err != nil {
+		return nil, err
+	}
+	dbIDs := append([]int64{util.NonDBID}, lo.Map(dbs, func(db *model.Database, _ int) int64 { return db.ID })...)
+	for _, dbID := range dbIDs {
+		coll, err := i.rootCatalog.GetCollectionByID(ctx, dbID, typeutil.MaxTimestamp, collectionID)
+		if err == nil {
+			return coll, nil
+		}
+	}
+	return nil, merr.WrapErrCollectionNotFound(collectionID)
+}
+
+// Inspect reports the segments of the collection, all the segments are reported if segmentIDs is empty.
+func (i *Inspector) Inspect(ctx context.Context, collectionID int64, segmentIDs ...int64) (*model.Collection, []*SegmentReport, error) {
+	coll, err := i.GetCollection(ctx, collectionID)
+	if err != nil {
+		return nil, nil, err
+	}
+	segments, err := i.dataCatalog.ListSegments(ctx, collectionID)
+	if err != nil {
+		return nil, nil, err
+	}
+	indexes, err := i.dataCatalog.ListIndexes(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	segmentIndexes, err := i.dataCatalog.ListSegmentIndexes(ctx, collectionID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(segmentIDs) > 0 {
+		segmentMap := lo.SliceToMap(segments, func(segment *datapb.SegmentInfo) (int64, *datapb.SegmentInfo) {
+			return segment.GetID(), segment
+		})
+		segments = make([]*datapb.SegmentInfo, 0, len(segmentIDs))
+		for _, segmentID := range segmentIDs {
+			segment, ok := segmentMap[segmentID]
+			if !ok {
+				return nil, nil, merr.WrapErrSegmentNotFound(segmentID)
+			}
+			segments = append(segments, segment)
+		}
+	}
+	sort.Slice(segments, func(a, b int) bool { return segments[a].GetID() < segments[b].GetID() })
+
+	indexMap := lo.SliceToMap(lo.Filter(indexes, func(index *model.Index, _ int) bool {
+		return index.CollectionID == collectionID
+	}), func(index *model.Index) (int64, *model.Index) {
+		return index.IndexID, index
+	})
+	segmentIndexMap := lo.GroupBy(segmentIndexes, func(segIdx *model.SegmentIndex) int64 { return segIdx.SegmentID })
+
+	pkField, err := typeutil.GetPrimaryFieldSchema(coll.ToCollectionSchemaPB())
+	if err != nil {
+		return nil, nil, err
+	}
+	reports := make([]*SegmentReport, 0, len(segments))
+	for _, segment := range segments {
+		report, err := newSegmentReport(i.chunkManager.RootPath(), coll, segment, indexMap, segmentIndexMap[segment.GetID()])
+		if err != nil {
+			return nil, nil, err
+		}
+		i.loadPKRange(ctx, report, pkField.GetFieldID())
+		reports = append(reports, report)
+	}
+	return coll, reports, nil
+}
+
+func newSegmentReport(rootPath string, coll *model.Collection, segment *datapb.SegmentInfo,
+	indexes map[int64]*model.Index, segmentIndexes []*model.SegmentIndex,
+) (*SegmentReport, error) {
+	if err := binlog.DecompressBinLogs(segment); err != nil {
+		return nil, err
+	}
+	report := &SegmentReport{
+		CollectionID:            segment.GetCollectionID(),
+		PartitionID:             segment.GetPartitionID(),
+		SegmentID:               segment.GetID(),
+		Channel:                 segment.GetInsertChannel(),
+		State:                   segment.GetState().String(),
+		Level:                   segment.GetLevel().String(),
+		StorageVersion:          segment.GetStorageVersion(),
+		ManifestPath:            segment.GetManifestPath(),
+		NumRows:                 segment.GetNumOfRows(),
+		BinlogRows:              segmentutil.CalcRowCountFromBinLog(segment),
+		SchemaVersion:           segment.GetSchemaVersion(),
+		CollectionSchemaVersion: coll.SchemaVersion,
+		InsertLogs:              logPaths(segment.GetBinlogs()),
+		StatsLogs:               logPaths(segment.GetStatslogs()),
+		BM25Logs:                logPaths(segment.GetBm25Statslogs()),
+		segment:                 segment,
+	}
+	for _, fieldBinlog := range segment.GetDeltalogs() {
+		for _, l := range fieldBinlog.GetBinlogs() {
+			report.DeletedRows += l.GetEntriesNum()
+			if l.GetLogPath() != "" {
+				report.DeltaLogs = append(report.DeltaLogs, l.GetLogPath())
+			}
+		}
+	}
+
+	for _, segIdx := range segmentIndexes {
+		if segIdx.IsDeleted {
+			continue
+		}
+		files := &IndexFiles{
+			IndexID:      segIdx.IndexID,
+			BuildID:      segIdx.BuildID,
+			IndexVersion: segIdx.IndexVersion,
+			State:        segIdx.IndexState.String(),
+		}
+		if index, ok := indexes[segIdx.IndexID]; ok {
+			files.IndexName = index.IndexName
+			files.FieldID = index.FieldID
+		}
+		// the path version reflects the layout only after the index is finished
+		if segIdx.IndexState == commonpb.IndexState_Finished {
+			files.Files = metautil.NewIndexPathBuilder(rootPath, segIdx.IndexStorePathVersion,
+				segIdx.CollectionID, segIdx.PartitionID, segIdx.SegmentID,
+				segIdx.BuildID, segIdx.IndexVersion).BuildFilePaths(segIdx.IndexFileKeys)
+		}
+		report.Indexes = append(report.Indexes, files)
+	}
+	sort.Slice(report.Indexes, func(a, b int) bool { return report.Indexes[a].IndexID < report.Indexes[b].IndexID })
+	return report, nil
+}
+
+func logPaths(fieldBinlogs []*datapb.FieldBinlog) map[int64][]string {
+	if len(fieldBinlogs) == 0 {
+		return nil
+	}
+	paths := make(map[int64][]string, len(fieldBinlogs))
+	for _, fieldBinlog := range fieldBinlogs {
+		for _, l := range fieldBinlog.GetBinlogs() {
+			if l.GetLogPath() != "" {
+				paths[fieldBinlog.GetFieldID()] = append(paths[fieldBinlog.GetFieldID()], l.GetLogPath())
+			}
+		}
+	}
+	return paths
+}
+
+// loadPKRange reads the primary key range from the stats logs, the failure is recorded into the report.
+func (i *Inspector) loadPKRange(ctx context.Context, report *SegmentReport, pkFieldID int64) {
+	paths := report.StatsLogs[pkFieldID]
+	if len(paths) == 0 {
+		report.PKRangeError = "no primary key stats log"
+		return
+	}
+	stats, err := compaction.LoadStatsFromPaths(ctx, i.chunkManager, report.SegmentID, paths)
+	if err != nil {
+		report.PKRangeError = err.Error()
+		return
+	}
+	var minPK, maxPK storage.PrimaryKey
+	for _, stat := range stats {
+		if stat.MinPK != nil && (minPK == nil || stat.MinPK.LT(minPK)) {
+			minPK = stat.MinPK
+		}
+		if stat.MaxPK != nil && (maxPK == nil || stat.MaxPK.GT(maxPK)) {
+			maxPK = stat.MaxPK
+		}
+	}
+	if minPK == nil || maxPK == nil {
+		report.PKRangeError = "empty primary key stats"
+		return
+	}
+	report.MinPK, report.MaxPK = minPK.GetValue(), maxPK.GetValue()
+}
+
+// DumpRows writes the rows stored in the segment to w as JSON lines keyed by the field names,
+// returns the count of the written rows. The deletions are not applied.
+func (i *Inspector) DumpRows(ctx context.Context, w io.Writer, schema *schemapb.CollectionSchema, report *SegmentReport) (int64, error) {
+	segment := report.segment
+	options := []storage.RwOption{
+		storage.WithVersion(segment.GetStorageVersion()),
+		storage.WithCollectionID(segment.GetCollectionID()),
+		storage.WithDownloader(i.chunkManager.MultiRead),
+		storage.WithStorageConfig(i.storageConfig),
+	}
+	var (
+		rr  storage.RecordReader
+		err error
+	)
+	if segment.GetManifestPath() != "" {
+		rr, err = storage.NewManifestRecordReader(ctx, segment.GetManifestPath(), schema, options...)
+	} else {
+		rr, err = storage.NewBinlogRecordReader(ctx, segment.GetBinlogs(), schema, options...)
+	}
+	if err == io.EOF {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	reader := storage.NewDeserializeReader(rr, func(record storage.Record, v []*storage.Value) error {
+		return storage.ValueDeserializerWithSchema(record, v, schema, true)
+	})
+	defer reader.Close()
+
+	fields := typeutil.GetAllFieldSchemas(schema)
+	encoder := json.NewEncoder(w)
+	var count int64
+	for {
+		v, err := reader.NextValue()
+		if err == io.EOF {
+			return count, nil
+		}
+		if err != nil {
+			return count, err
+		}
+		if err := encoder.Encode(rowToJSON(fields, (*v).Value.(map[storage.FieldID]any))); err != nil {
+			return count, err
+		}
+		count++
+	}
+}
+
+func rowToJSON(fields []*schemapb.FieldSchema, values map[storage.FieldID]any) map[string]any {
+	row := make(map[string]any, len(fields))
+	for _, field := range fields {
+		value, ok := values[field.GetFieldID()]
+		if !ok {
+			continue
+		}
+		if bytes, ok := value.([]byte); ok && field.GetDataType() == schemapb.DataType_JSON {
+			value = json.RawMessage(bytes)
+		}
+		row[field.GetName()] = value
+	}
+	return row
+}
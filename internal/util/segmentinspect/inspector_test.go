@@ -0,0 +1,139 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segmentinspect
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v3/schemapb"
+	"github.com/milvus-io/milvus/internal/json"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/v3/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+func TestNewSegmentReport(t *testing.T) {
+	paramtable.Init()
+
+	coll := &model.Collection{CollectionID: 1, SchemaVersion: 3}
+	segment := &datapb.SegmentInfo{
+		ID:            100,
+		CollectionID:  1,
+		PartitionID:   10,
+		InsertChannel: "ch",
+		State:         commonpb.SegmentState_Flushed,
+		NumOfRows:     20,
+		SchemaVersion: 2,
+		Binlogs: []*datapb.FieldBinlog{
+			{FieldID: 100, Binlogs: []*datapb.Binlog{{LogID: 1, EntriesNum: 12}, {LogID: 2, EntriesNum: 8}}},
+			{FieldID: 101, Binlogs: []*datapb.Binlog{{LogID: 3, EntriesNum: 12}, {LogID: 4, EntriesNum: 8}}},
+		},
+		Deltalogs: []*datapb.FieldBinlog{
+			{Binlogs: []*datapb.Binlog{{LogID: 5, EntriesNum: 3}}},
+		},
+		Statslogs: []*datapb.FieldBinlog{
+			{FieldID: 100, Binlogs: []*datapb.Binlog{{LogID: 6}}},
+		},
+	}
+	indexes := map[int64]*model.Index{
+		1000: {IndexID: 1000, IndexName: "vec_index", FieldID: 101},
+	}
+	segmentIndexes := []*model.SegmentIndex{
+		{
+			SegmentID: 100, CollectionID: 1, PartitionID: 10, IndexID: 1000, BuildID: 2000, IndexVersion: 1,
+			IndexState: commonpb.IndexState_Finished, IndexFileKeys: []string{"a", "b"},
+		},
+		{SegmentID: 100, IndexID: 1001, BuildID: 2001, IndexState: commonpb.IndexState_InProgress},
+		{SegmentID: 100, IndexID: 1002, BuildID: 2002, IndexState: commonpb.IndexState_Finished, IsDeleted: true},
+	}
+
+	report, err := newSegmentReport("files", coll, segment, indexes, segmentIndexes)
+	require.NoError(t, err)
+	assert.EqualValues(t, 100, report.SegmentID)
+	assert.Equal(t, "Flushed", report.State)
+	assert.EqualValues(t, 20, report.NumRows)
+	assert.EqualValues(t, 20, report.BinlogRows)
+	assert.EqualValues(t, 3, report.DeletedRows)
+	assert.EqualValues(t, 2, report.SchemaVersion)
+	assert.EqualValues(t, 3, report.CollectionSchemaVersion)
+	assert.Len(t, report.InsertLogs[100], 2)
+	assert.Len(t, report.InsertLogs[101], 2)
+	assert.Len(t, report.DeltaLogs, 1)
+	assert.Len(t, report.StatsLogs[100], 1)
+	assert.True(t, strings.HasSuffix(report.InsertLogs[100][0], "1/10/100/100/1"))
+
+	require.Len(t, report.Indexes, 2)
+	assert.Equal(t, "vec_index", report.Indexes[0].IndexName)
+	assert.EqualValues(t, 101, report.Indexes[0].FieldID)
+	assert.Len(t, report.Indexes[0].Files, 2)
+	assert.EqualValues(t, 1001, report.Indexes[1].IndexID)
+	assert.Empty(t, report.Indexes[1].Files)
+
+	t.Run("manifest", func(t *testing.T) {
+		segment := &datapb.SegmentInfo{
+			ID:           101,
+			ManifestPath: "manifest",
+			Deltalogs: []*datapb.FieldBinlog{
+				{Binlogs: []*datapb.Binlog{{EntriesNum: 5}}},
+			},
+		}
+		report, err := newSegmentReport("files", coll, segment, nil, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "manifest", report.ManifestPath)
+		assert.EqualValues(t, 5, report.DeletedRows)
+		assert.Empty(t, report.DeltaLogs)
+	})
+}
+
+func TestRowToJSON(t *testing.T) {
+	fields := []*schemapb.FieldSchema{
+		{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64},
+		{FieldID: 101, Name: "meta", DataType: schemapb.DataType_JSON},
+		{FieldID: 102, Name: "vec", DataType: schemapb.DataType_FloatVector},
+		{FieldID: 103, Name: "missing", DataType: schemapb.DataType_VarChar},
+	}
+	row := rowToJSON(fields, map[storage.FieldID]any{
+		100: int64(1),
+		101: []byte(`{"a":1}`),
+		102: []float32{1, 2},
+	})
+	bs, err := json.Marshal(row)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"pk":1,"meta":{"a":1},"vec":[1,2]}`, string(bs))
+}
+
+func TestReadOnlyKV(t *testing.T) {
+	metaKV := NewReadOnlyKV(nil)
+	ctx := context.Background()
+	assert.ErrorIs(t, metaKV.Save(ctx, "k", "v"), merr.ErrServiceUnavailable)
+	assert.ErrorIs(t, metaKV.MultiSave(ctx, map[string]string{"k": "v"}), merr.ErrServiceUnavailable)
+	assert.ErrorIs(t, metaKV.Remove(ctx, "k"), merr.ErrServiceUnavailable)
+	assert.ErrorIs(t, metaKV.MultiRemove(ctx, []string{"k"}), merr.ErrServiceUnavailable)
+	assert.ErrorIs(t, metaKV.RemoveWithPrefix(ctx, "k"), merr.ErrServiceUnavailable)
+	assert.ErrorIs(t, metaKV.MultiSaveAndRemove(ctx, nil, []string{"k"}), merr.ErrServiceUnavailable)
+	assert.ErrorIs(t, metaKV.MultiSaveAndRemoveWithPrefix(ctx, nil, []string{"k"}), merr.ErrServiceUnavailable)
+	_, err := metaKV.CompareVersionAndSwap(ctx, "k", 0, "v")
+	assert.ErrorIs(t, err, merr.ErrServiceUnavailable)
+}
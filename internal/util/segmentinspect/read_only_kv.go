@@ -0,0 +1,72 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segmentinspect
+
+import (
+	"context"
+
+	"github.com/milvus-io/milvus/pkg/v3/kv"
+	"github.com/milvus-io/milvus/pkg/v3/kv/predicates"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+)
+
+// readOnlyKV rejects all the writes, so the catalogs used offline never modify the meta,
+// e.g. the rootcoord catalog fixes the database id of the legacy collections when loading them.
+type readOnlyKV struct {
+	kv.MetaKv
+}
+
+// NewReadOnlyKV wraps the meta kv to reject all the writes.
+func NewReadOnlyKV(metaKV kv.MetaKv) kv.MetaKv {
+	return &readOnlyKV{MetaKv: metaKV}
+}
+
+func errReadOnly() error {
+	return merr.WrapErrServiceUnavailable("meta kv is read only")
+}
+
+func (kv *readOnlyKV) Save(ctx context.Context, key, value string) error {
+	return errReadOnly()
+}
+
+func (kv *readOnlyKV) MultiSave(ctx context.Context, kvs map[string]string) error {
+	return errReadOnly()
+}
+
+func (kv *readOnlyKV) Remove(ctx context.Context, key string) error {
+	return errReadOnly()
+}
+
+func (kv *readOnlyKV) MultiRemove(ctx context.Context, keys []string) error {
+	return errReadOnly()
+}
+
+func (kv *readOnlyKV) RemoveWithPrefix(ctx context.Context, key string) error {
+	return errReadOnly()
+}
+
+func (kv *readOnlyKV) MultiSaveAndRemove(ctx context.Context, saves map[string]string, removals []string, preds ...predicates.Predicate) error {
+	return errReadOnly()
+}
+
+func (kv *readOnlyKV) MultiSaveAndRemoveWithPrefix(ctx context.Context, saves map[string]string, removals []string, preds ...predicates.Predicate) error {
+	return errReadOnly()
+}
+
+func (kv *readOnlyKV) CompareVersionAndSwap(ctx context.Context, key string, version int64, target string) (bool, error) {
+	return false, errReadOnly()
+}
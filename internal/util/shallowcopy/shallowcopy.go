@@ -46,6 +46,8 @@ func ShallowCopySearchRequest(src *internalpb.SearchRequest, targetID int64) *in
 		PkFilter:                src.PkFilter,
 		SearchType:              src.SearchType,
 		GroupByFieldIds:         src.GroupByFieldIds,
+		Explain:                 src.Explain,
+		TraceSegments:           src.TraceSegments,
 	}
 }
 
@@ -82,5 +84,7 @@ func ShallowCopyRetrieveRequest(src *internalpb.RetrieveRequest, targetID int64)
 		OrderByFields:                src.OrderByFields,
 		QueryLabel:                   src.QueryLabel,
 		PkFilter:                     src.PkFilter,
+		Explain:                      src.Explain,
+		TraceSegments:                src.TraceSegments,
 	}
 }
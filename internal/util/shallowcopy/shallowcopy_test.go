@@ -43,6 +43,8 @@ func TestShallowCopySearchRequest(t *testing.T) {
 			AnalyzerName:            "analyzer",
 			CollectionTtlTimestamps: 999,
 			EntityTtlPhysicalTime:   888,
+			Explain:                 true,
+			TraceSegments:           true,
 		}
 
 		dst := ShallowCopySearchRequest(src, 42)
@@ -60,6 +62,8 @@ func TestShallowCopySearchRequest(t *testing.T) {
 		assert.Equal(t, src.IsIterator, dst.IsIterator)
 		assert.Equal(t, src.AnalyzerName, dst.AnalyzerName)
 		assert.Equal(t, src.EntityTtlPhysicalTime, dst.EntityTtlPhysicalTime)
+		assert.Equal(t, src.Explain, dst.Explain)
+		assert.Equal(t, src.TraceSegments, dst.TraceSegments)
 
 		// Slices share underlying array (shallow copy)
 		assert.Equal(t, src.PartitionIDs, dst.PartitionIDs)
@@ -91,6 +95,8 @@ func TestShallowCopyRetrieveRequest(t *testing.T) {
 			CollectionTtlTimestamps: 999,
 			EntityTtlPhysicalTime:   888,
 			QueryLabel:              "query",
+			Explain:                 true,
+			TraceSegments:           true,
 		}
 
 		dst := ShallowCopyRetrieveRequest(src, 42)
@@ -103,6 +109,8 @@ func TestShallowCopyRetrieveRequest(t *testing.T) {
 		assert.Equal(t, src.IsIterator, dst.IsIterator)
 		assert.Equal(t, src.EntityTtlPhysicalTime, dst.EntityTtlPhysicalTime)
 		assert.Equal(t, src.QueryLabel, dst.QueryLabel)
+		assert.Equal(t, src.Explain, dst.Explain)
+		assert.Equal(t, src.TraceSegments, dst.TraceSegments)
 		assert.Equal(t, src.PartitionIDs, dst.PartitionIDs)
 	})
 }
@@ -89,7 +89,7 @@ func validateWALName(standalone bool, mqType string) (message.WALName, error) {
 	// we may register more mq type by plugin.
 	// so we should not check all mq type here.
 	// only check standalone type.
-	if !standalone && mqName == message.WALNameRocksmq {
+	if !standalone && (mqName == message.WALNameRocksmq || mqName == message.WALNameFile) {
 		return mqName, errors.Wrapf(errInvalidWALConfig, "mq %s is only valid in standalone mode", mqType)
 	}
 	// woodpecker with local storage cannot work in cluster mode,
@@ -23,6 +23,7 @@ import (
 	clientv3 "go.etcd.io/etcd/client/v3"
 
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	sqlkv "github.com/milvus-io/milvus/internal/kv/sql"
 	"github.com/milvus-io/milvus/internal/kv/tikv"
 	"github.com/milvus-io/milvus/pkg/v3/kv"
 )
@@ -36,3 +37,8 @@ func NewTSOKVBase(client *clientv3.Client, tsoRoot, subPath string) kv.TxnKV {
 func NewTSOTiKVBase(client *txnkv.Client, tsoRoot, subPath string) kv.TxnKV {
 	return tikv.NewTiKV(client, path.Join(tsoRoot, subPath))
 }
+
+// NewTSOSQLBase returns a kv.TxnKV object
+func NewTSOSQLBase(client *sqlkv.Client, tsoRoot, subPath string) kv.TxnKV {
+	return sqlkv.NewSQLKV(client, path.Join(tsoRoot, subPath))
+}
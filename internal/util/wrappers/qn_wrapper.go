@@ -184,6 +184,10 @@ func (qn *qnServerWrapper) ComputePhraseMatchSlop(ctx context.Context, in *query
 	return qn.QueryNode.ComputePhraseMatchSlop(ctx, in)
 }
 
+func (qn *qnServerWrapper) GetShardAppliedVersion(ctx context.Context, in *querypb.GetShardAppliedVersionRequest, _ ...grpc.CallOption) (*querypb.GetShardAppliedVersionResponse, error) {
+	return qn.QueryNode.GetShardAppliedVersion(ctx, in)
+}
+
 func WrapQueryNodeServerAsClient(qn types.QueryNode) types.QueryNodeClient {
 	return &qnServerWrapper{
 		QueryNode: qn,
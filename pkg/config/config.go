@@ -75,6 +75,13 @@ func Init(opts ...Option) (*Manager, error) {
 		}
 		sourceManager.AddSource(s)
 	}
+	if o.SQLInfo != nil {
+		s, err := NewSQLSource(o.SQLInfo)
+		if err != nil {
+			return nil, err
+		}
+		sourceManager.AddSource(s)
+	}
 	return sourceManager, nil
 }
 
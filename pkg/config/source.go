@@ -61,6 +61,17 @@ type EtcdInfo struct {
 	RefreshInterval time.Duration
 }
 
+// SQLInfo has attribute for sql config source initialization
+type SQLInfo struct {
+	Dialect     string
+	DSN         string
+	TablePrefix string
+	KeyPrefix   string
+
+	// Pull Configuration interval, unit is second
+	RefreshInterval time.Duration
+}
+
 // FileInfo has attribute for file source
 type FileInfo struct {
 	Files           []string
@@ -71,6 +82,7 @@ type FileInfo struct {
 type Options struct {
 	FileInfo        *FileInfo
 	EtcdInfo        *EtcdInfo
+	SQLInfo         *SQLInfo
 	EnvKeyFormatter func(string) string
 }
 
@@ -91,6 +103,13 @@ func WithEtcdSource(ri *EtcdInfo) Option {
 	}
 }
 
+// WithSQLSource accept the information for initiating a sql source
+func WithSQLSource(si *SQLInfo) Option {
+	return func(options *Options) {
+		options.SQLInfo = si
+	}
+}
+
 // WithEnvSource enable env source
 // archaius will read ENV as key value
 func WithEnvSource(keyFormatter func(string) string) Option {
@@ -0,0 +1,201 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"path"
+	"strings"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
+	"golang.org/x/time/rate"
+
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/util/sqlutil"
+)
+
+// SQLSource reads the configs under <KeyPrefix>/config of the sql metastore,
+// the same layout as the EtcdSource.
+type SQLSource struct {
+	sync.RWMutex
+	db             *sql.DB
+	dialect        sqlutil.Dialect
+	tables         sqlutil.Tables
+	ctx            context.Context
+	currentConfigs map[string]string
+	keyPrefix      string
+
+	updateMu        sync.Mutex
+	configRefresher *refresher
+	manager         ConfigManager
+}
+
+func NewSQLSource(sqlInfo *SQLInfo) (*SQLSource, error) {
+	mlog.Debug(context.TODO(), "init sql source", mlog.String("dialect", sqlInfo.Dialect), mlog.String("tablePrefix", sqlInfo.TablePrefix))
+	dialect, err := sqlutil.ParseDialect(sqlInfo.Dialect)
+	if err != nil {
+		return nil, err
+	}
+	tables, err := sqlutil.NewTables(sqlInfo.TablePrefix)
+	if err != nil {
+		return nil, err
+	}
+	db, err := sqlutil.CreateSQLClient(dialect, sqlInfo.DSN)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), ReadConfigTimeout)
+	defer cancel()
+	// the source may start before the metastore, create the tables to read from.
+	if err := sqlutil.InitSchema(ctx, db, dialect, tables); err != nil {
+		db.Close()
+		return nil, err
+	}
+	ss := &SQLSource{
+		db:             db,
+		dialect:        dialect,
+		tables:         tables,
+		ctx:            context.Background(),
+		currentConfigs: make(map[string]string),
+		keyPrefix:      sqlInfo.KeyPrefix,
+	}
+	ss.configRefresher = newRefresher(sqlInfo.RefreshInterval, ss.refreshConfigurations)
+	ss.configRefresher.start(ss.GetSourceName())
+	return ss, nil
+}
+
+// GetConfigurationByKey implements ConfigSource
+func (ss *SQLSource) GetConfigurationByKey(key string) (string, error) {
+	ss.RLock()
+	v, ok := ss.currentConfigs[key]
+	ss.RUnlock()
+	if !ok {
+		return "", errors.Wrap(ErrKeyNotFound, key)
+	}
+	return v, nil
+}
+
+// GetConfigurations implements ConfigSource
+func (ss *SQLSource) GetConfigurations() (map[string]string, error) {
+	if err := ss.refreshConfigurations(); err != nil {
+		return nil, err
+	}
+	ss.RLock()
+	defer ss.RUnlock()
+	configMap := make(map[string]string, len(ss.currentConfigs))
+	for key, value := range ss.currentConfigs {
+		configMap[key] = value
+	}
+	return configMap, nil
+}
+
+// GetPriority implements ConfigSource
+func (ss *SQLSource) GetPriority() int {
+	return HighPriority
+}
+
+// GetSourceName implements ConfigSource
+func (ss *SQLSource) GetSourceName() string {
+	return "SQLSource"
+}
+
+func (ss *SQLSource) Close() {
+	ss.configRefresher.stop()
+	ss.db.Close()
+}
+
+func (ss *SQLSource) SetManager(m ConfigManager) {
+	ss.Lock()
+	defer ss.Unlock()
+	ss.manager = m
+}
+
+func (ss *SQLSource) SetEventHandler(eh EventHandler) {
+	ss.configRefresher.SetEventHandler(eh)
+}
+
+func (ss *SQLSource) UpdateOptions(opts Options) {
+	if opts.SQLInfo == nil {
+		return
+	}
+	ss.Lock()
+	defer ss.Unlock()
+	ss.keyPrefix = opts.SQLInfo.KeyPrefix
+	if ss.configRefresher.refreshInterval != opts.SQLInfo.RefreshInterval {
+		ss.configRefresher.stop()
+		eh := ss.configRefresher.GetEventHandler()
+		ss.configRefresher = newRefresher(opts.SQLInfo.RefreshInterval, ss.refreshConfigurations)
+		ss.configRefresher.SetEventHandler(eh)
+		ss.configRefresher.start(ss.GetSourceName())
+	}
+}
+
+func (ss *SQLSource) refreshConfigurations() error {
+	ss.RLock()
+	prefix := path.Join(ss.keyPrefix, "config")
+	ss.RUnlock()
+
+	ctx, cancel := context.WithTimeout(ss.ctx, ReadConfigTimeout)
+	defer cancel()
+	mlog.RatedDebug(ss.ctx, rate.Limit(10), "sql refreshConfigurations", mlog.String("prefix", prefix))
+	cond, args := sqlutil.PrefixCondition(prefix + "/")
+	rows, err := ss.db.QueryContext(ctx, ss.dialect.Rebind(fmt.Sprintf(`SELECT k, v FROM %s WHERE %s`, ss.tables.KV, cond)), args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	newConfig := make(map[string]string)
+	for rows.Next() {
+		var k, v []byte
+		if err := rows.Scan(&k, &v); err != nil {
+			return err
+		}
+		key := strings.TrimPrefix(string(k), prefix+"/")
+		newConfig[key] = string(v)
+		newConfig[formatKey(key)] = string(v)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	return ss.update(newConfig)
+}
+
+func (ss *SQLSource) update(configs map[string]string) error {
+	// make sure config not change when fire event
+	ss.updateMu.Lock()
+	defer ss.updateMu.Unlock()
+
+	ss.Lock()
+	events, err := PopulateEvents(ss.GetSourceName(), ss.currentConfigs, configs)
+	if err != nil {
+		ss.Unlock()
+		mlog.Warn(ss.ctx, "generating event error", mlog.Err(err))
+		return err
+	}
+	ss.currentConfigs = configs
+	ss.Unlock()
+	if ss.manager != nil {
+		ss.manager.EvictCacheValueByFormat(lo.Map(events, func(event *Event, _ int) string { return event.Key })...)
+	}
+
+	ss.configRefresher.fireEvents(events...)
+	return nil
+}
@@ -0,0 +1,113 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/atomic"
+	_ "modernc.org/sqlite"
+)
+
+type SQLSourceSuite struct {
+	suite.Suite
+
+	dsn string
+}
+
+func (s *SQLSourceSuite) SetupTest() {
+	s.dsn = filepath.Join(s.T().TempDir(), "config.db")
+}
+
+func (s *SQLSourceSuite) newSource(keyPrefix string, refreshInterval time.Duration) *SQLSource {
+	source, err := NewSQLSource(&SQLInfo{
+		Dialect:         "sqlite",
+		DSN:             s.dsn,
+		TablePrefix:     "milvus",
+		KeyPrefix:       keyPrefix,
+		RefreshInterval: refreshInterval,
+	})
+	s.Require().NoError(err)
+	s.Require().NotNil(source)
+	return source
+}
+
+func (s *SQLSourceSuite) put(source *SQLSource, key, value string) {
+	_, err := source.db.ExecContext(context.Background(), source.dialect.Rebind(fmt.Sprintf(
+		`INSERT INTO %s (k, v, create_revision, mod_revision, version) VALUES (?, ?, 1, 1, 1)`, source.tables.KV)),
+		[]byte(key), []byte(value))
+	s.Require().NoError(err)
+}
+
+func (s *SQLSourceSuite) TestNewSource() {
+	source := s.newSource("by-dev", time.Second)
+	defer source.Close()
+
+	s.put(source, "by-dev/config/dataCoord/segment/maxSize", "1024")
+	s.put(source, "by-dev/configs/ignored", "1")
+	s.put(source, "other/config/ignored", "1")
+
+	configs, err := source.GetConfigurations()
+	s.NoError(err)
+	s.Len(configs, 2)
+	s.Equal("1024", configs["dataCoord/segment/maxSize"])
+	s.Equal("1024", configs[formatKey("dataCoord/segment/maxSize")])
+
+	value, err := source.GetConfigurationByKey("dataCoord/segment/maxSize")
+	s.NoError(err)
+	s.Equal("1024", value)
+	_, err = source.GetConfigurationByKey("ignored")
+	s.ErrorIs(err, ErrKeyNotFound)
+
+	_, err = NewSQLSource(&SQLInfo{Dialect: "mysql", DSN: s.dsn, TablePrefix: "milvus"})
+	s.Error(err)
+	_, err = NewSQLSource(&SQLInfo{Dialect: "sqlite", DSN: s.dsn, TablePrefix: "milvus;"})
+	s.Error(err)
+}
+
+func (s *SQLSourceSuite) TestUpdateOptions() {
+	source := s.newSource("test_update_options_1", time.Second)
+	defer source.Close()
+
+	called := atomic.NewBool(false)
+	handler := NewHandler("test_update_options", func(evt *Event) {
+		called.Store(true)
+	})
+	source.SetEventHandler(handler)
+
+	source.UpdateOptions(Options{
+		SQLInfo: &SQLInfo{
+			KeyPrefix:       "test_update_options_2",
+			RefreshInterval: time.Millisecond * 100,
+		},
+	})
+
+	s.put(source, "test_update_options_2/config/abc", "def")
+
+	s.Eventually(func() bool {
+		return called.Load()
+	}, time.Second*2, time.Millisecond*100)
+}
+
+func TestSQLSource(t *testing.T) {
+	suite.Run(t, new(SQLSourceSuite))
+}
@@ -384,6 +384,16 @@ var (
 			Help:      "count of slow query executed",
 		}, []string{nodeIDLabelName, msgTypeLabelName})
 
+	// ProxySearchResultCacheSize records the memory used by the cached search results, the hits and
+	// misses are counted by ProxyCacheStatsCounter.
+	ProxySearchResultCacheSize = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "search_result_cache_size",
+			Help:      "the memory used by the cached search results, in bytes",
+		}, []string{nodeIDLabelName})
+
 	// ProxyReqInQueueLatency records the latency that requests wait in the queue, like "CreateCollection".
 	ProxyReqInQueueLatency = prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
@@ -467,6 +477,15 @@ var (
 			Buckets:   buckets,
 		}, []string{nodeIDLabelName, collectionName, functionTypeName, functionProvider, functionName})
 
+	// ProxyFunctionEmbeddingCacheCount records the number of search texts whose embeddings hit or miss the embedding cache.
+	ProxyFunctionEmbeddingCacheCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "function_embedding_cache_count",
+			Help:      "count of search text embeddings hit or miss the embedding cache",
+		}, []string{nodeIDLabelName, collectionName, functionProvider, functionName, cacheStateLabelName})
+
 	ProxyScannedRemoteMB = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
 			Namespace: milvusNamespace,
@@ -536,6 +555,7 @@ func RegisterProxy(registry *prometheus.Registry) {
 	registry.MustRegister(ProxyRateLimitReqCount)
 
 	registry.MustRegister(ProxySlowQueryCount)
+	registry.MustRegister(ProxySearchResultCacheSize)
 	registry.MustRegister(ProxyReportValue)
 	registry.MustRegister(ProxyReqInQueueLatency)
 
@@ -550,6 +570,7 @@ func RegisterProxy(registry *prometheus.Registry) {
 	registry.MustRegister(ProxyParseExpressionLatency)
 
 	registry.MustRegister(ProxyFunctionlatency)
+	registry.MustRegister(ProxyFunctionEmbeddingCacheCount)
 
 	registry.MustRegister(ProxyScannedRemoteMB)
 	registry.MustRegister(ProxyScannedTotalMB)
@@ -783,4 +804,8 @@ func CleanupProxyCollectionMetrics(nodeID int64, dbName string, collection strin
 		nodeIDLabelName: strconv.FormatInt(nodeID, 10),
 		collectionName:  collection,
 	})
+	ProxyFunctionEmbeddingCacheCount.DeletePartialMatch(prometheus.Labels{
+		nodeIDLabelName: strconv.FormatInt(nodeID, 10),
+		collectionName:  collection,
+	})
 }
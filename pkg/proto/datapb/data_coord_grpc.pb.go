@@ -70,6 +70,12 @@ const (
 	DataCoord_ImportV2_FullMethodName                             = "/milvus.proto.data.DataCoord/ImportV2"
 	DataCoord_GetImportProgress_FullMethodName                    = "/milvus.proto.data.DataCoord/GetImportProgress"
 	DataCoord_ListImports_FullMethodName                          = "/milvus.proto.data.DataCoord/ListImports"
+	DataCoord_CreateExportJob_FullMethodName                      = "/milvus.proto.data.DataCoord/CreateExportJob"
+	DataCoord_GetExportJob_FullMethodName                         = "/milvus.proto.data.DataCoord/GetExportJob"
+	DataCoord_ListExportJobs_FullMethodName                       = "/milvus.proto.data.DataCoord/ListExportJobs"
+	DataCoord_CancelExportJob_FullMethodName                      = "/milvus.proto.data.DataCoord/CancelExportJob"
+	DataCoord_AcquireExportJob_FullMethodName                     = "/milvus.proto.data.DataCoord/AcquireExportJob"
+	DataCoord_ReportExportJob_FullMethodName                      = "/milvus.proto.data.DataCoord/ReportExportJob"
 	DataCoord_CreateSnapshot_FullMethodName                       = "/milvus.proto.data.DataCoord/CreateSnapshot"
 	DataCoord_DropSnapshot_FullMethodName                         = "/milvus.proto.data.DataCoord/DropSnapshot"
 	DataCoord_ListSnapshots_FullMethodName                        = "/milvus.proto.data.DataCoord/ListSnapshots"
@@ -151,6 +157,14 @@ type DataCoordClient interface {
 	ImportV2(ctx context.Context, in *internalpb.ImportRequestInternal, opts ...grpc.CallOption) (*internalpb.ImportResponse, error)
 	GetImportProgress(ctx context.Context, in *internalpb.GetImportProgressRequest, opts ...grpc.CallOption) (*internalpb.GetImportProgressResponse, error)
 	ListImports(ctx context.Context, in *internalpb.ListImportsRequestInternal, opts ...grpc.CallOption) (*internalpb.ListImportsResponse, error)
+	// export
+	CreateExportJob(ctx context.Context, in *CreateExportJobRequest, opts ...grpc.CallOption) (*CreateExportJobResponse, error)
+	GetExportJob(ctx context.Context, in *GetExportJobRequest, opts ...grpc.CallOption) (*GetExportJobResponse, error)
+	ListExportJobs(ctx context.Context, in *ListExportJobsRequest, opts ...grpc.CallOption) (*ListExportJobsResponse, error)
+	CancelExportJob(ctx context.Context, in *CancelExportJobRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	// called by the proxies which execute export jobs
+	AcquireExportJob(ctx context.Context, in *AcquireExportJobRequest, opts ...grpc.CallOption) (*AcquireExportJobResponse, error)
+	ReportExportJob(ctx context.Context, in *ReportExportJobRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	// snapshot
 	CreateSnapshot(ctx context.Context, in *CreateSnapshotRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	DropSnapshot(ctx context.Context, in *DropSnapshotRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
@@ -611,6 +625,60 @@ func (c *dataCoordClient) ListImports(ctx context.Context, in *internalpb.ListIm
 	return out, nil
 }
 
+func (c *dataCoordClient) CreateExportJob(ctx context.Context, in *CreateExportJobRequest, opts ...grpc.CallOption) (*CreateExportJobResponse, error) {
+	out := new(CreateExportJobResponse)
+	err := c.cc.Invoke(ctx, DataCoord_CreateExportJob_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetExportJob(ctx context.Context, in *GetExportJobRequest, opts ...grpc.CallOption) (*GetExportJobResponse, error) {
+	out := new(GetExportJobResponse)
+	err := c.cc.Invoke(ctx, DataCoord_GetExportJob_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) ListExportJobs(ctx context.Context, in *ListExportJobsRequest, opts ...grpc.CallOption) (*ListExportJobsResponse, error) {
+	out := new(ListExportJobsResponse)
+	err := c.cc.Invoke(ctx, DataCoord_ListExportJobs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) CancelExportJob(ctx context.Context, in *CancelExportJobRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, DataCoord_CancelExportJob_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) AcquireExportJob(ctx context.Context, in *AcquireExportJobRequest, opts ...grpc.CallOption) (*AcquireExportJobResponse, error) {
+	out := new(AcquireExportJobResponse)
+	err := c.cc.Invoke(ctx, DataCoord_AcquireExportJob_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) ReportExportJob(ctx context.Context, in *ReportExportJobRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, DataCoord_ReportExportJob_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dataCoordClient) CreateSnapshot(ctx context.Context, in *CreateSnapshotRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	out := new(commonpb.Status)
 	err := c.cc.Invoke(ctx, DataCoord_CreateSnapshot_FullMethodName, in, out, opts...)
@@ -842,6 +910,14 @@ type DataCoordServer interface {
 	ImportV2(context.Context, *internalpb.ImportRequestInternal) (*internalpb.ImportResponse, error)
 	GetImportProgress(context.Context, *internalpb.GetImportProgressRequest) (*internalpb.GetImportProgressResponse, error)
 	ListImports(context.Context, *internalpb.ListImportsRequestInternal) (*internalpb.ListImportsResponse, error)
+	// export
+	CreateExportJob(context.Context, *CreateExportJobRequest) (*CreateExportJobResponse, error)
+	GetExportJob(context.Context, *GetExportJobRequest) (*GetExportJobResponse, error)
+	ListExportJobs(context.Context, *ListExportJobsRequest) (*ListExportJobsResponse, error)
+	CancelExportJob(context.Context, *CancelExportJobRequest) (*commonpb.Status, error)
+	// called by the proxies which execute export jobs
+	AcquireExportJob(context.Context, *AcquireExportJobRequest) (*AcquireExportJobResponse, error)
+	ReportExportJob(context.Context, *ReportExportJobRequest) (*commonpb.Status, error)
 	// snapshot
 	CreateSnapshot(context.Context, *CreateSnapshotRequest) (*commonpb.Status, error)
 	DropSnapshot(context.Context, *DropSnapshotRequest) (*commonpb.Status, error)
@@ -1014,6 +1090,24 @@ func (UnimplementedDataCoordServer) GetImportProgress(context.Context, *internal
 func (UnimplementedDataCoordServer) ListImports(context.Context, *internalpb.ListImportsRequestInternal) (*internalpb.ListImportsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListImports not implemented")
 }
+func (UnimplementedDataCoordServer) CreateExportJob(context.Context, *CreateExportJobRequest) (*CreateExportJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateExportJob not implemented")
+}
+func (UnimplementedDataCoordServer) GetExportJob(context.Context, *GetExportJobRequest) (*GetExportJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExportJob not implemented")
+}
+func (UnimplementedDataCoordServer) ListExportJobs(context.Context, *ListExportJobsRequest) (*ListExportJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExportJobs not implemented")
+}
+func (UnimplementedDataCoordServer) CancelExportJob(context.Context, *CancelExportJobRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelExportJob not implemented")
+}
+func (UnimplementedDataCoordServer) AcquireExportJob(context.Context, *AcquireExportJobRequest) (*AcquireExportJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcquireExportJob not implemented")
+}
+func (UnimplementedDataCoordServer) ReportExportJob(context.Context, *ReportExportJobRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportExportJob not implemented")
+}
 func (UnimplementedDataCoordServer) CreateSnapshot(context.Context, *CreateSnapshotRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateSnapshot not implemented")
 }
@@ -1929,6 +2023,114 @@ func _DataCoord_ListImports_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataCoord_CreateExportJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateExportJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).CreateExportJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataCoord_CreateExportJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).CreateExportJob(ctx, req.(*CreateExportJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_GetExportJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExportJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).GetExportJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataCoord_GetExportJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).GetExportJob(ctx, req.(*GetExportJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_ListExportJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExportJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).ListExportJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataCoord_ListExportJobs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).ListExportJobs(ctx, req.(*ListExportJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_CancelExportJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelExportJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).CancelExportJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataCoord_CancelExportJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).CancelExportJob(ctx, req.(*CancelExportJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_AcquireExportJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcquireExportJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).AcquireExportJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataCoord_AcquireExportJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).AcquireExportJob(ctx, req.(*AcquireExportJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_ReportExportJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportExportJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).ReportExportJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataCoord_ReportExportJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).ReportExportJob(ctx, req.(*ReportExportJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DataCoord_CreateSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateSnapshotRequest)
 	if err := dec(in); err != nil {
@@ -2466,6 +2668,30 @@ var DataCoord_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListImports",
 			Handler:    _DataCoord_ListImports_Handler,
 		},
+		{
+			MethodName: "CreateExportJob",
+			Handler:    _DataCoord_CreateExportJob_Handler,
+		},
+		{
+			MethodName: "GetExportJob",
+			Handler:    _DataCoord_GetExportJob_Handler,
+		},
+		{
+			MethodName: "ListExportJobs",
+			Handler:    _DataCoord_ListExportJobs_Handler,
+		},
+		{
+			MethodName: "CancelExportJob",
+			Handler:    _DataCoord_CancelExportJob_Handler,
+		},
+		{
+			MethodName: "AcquireExportJob",
+			Handler:    _DataCoord_AcquireExportJob_Handler,
+		},
+		{
+			MethodName: "ReportExportJob",
+			Handler:    _DataCoord_ReportExportJob_Handler,
+		},
 		{
 			MethodName: "CreateSnapshot",
 			Handler:    _DataCoord_CreateSnapshot_Handler,
@@ -1632,6 +1632,7 @@ const (
 	QueryNode_SyncFileResource_FullMethodName       = "/milvus.proto.query.QueryNode/SyncFileResource"
 	QueryNode_ClearReadTaskQueue_FullMethodName     = "/milvus.proto.query.QueryNode/ClearReadTaskQueue"
 	QueryNode_ComputePhraseMatchSlop_FullMethodName = "/milvus.proto.query.QueryNode/ComputePhraseMatchSlop"
+	QueryNode_GetShardAppliedVersion_FullMethodName = "/milvus.proto.query.QueryNode/GetShardAppliedVersion"
 )
 
 // QueryNodeClient is the client API for QueryNode service.
@@ -1675,6 +1676,9 @@ type QueryNodeClient interface {
 	SyncFileResource(ctx context.Context, in *internalpb.SyncFileResourceRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	ClearReadTaskQueue(ctx context.Context, in *internalpb.ClearReadTaskQueueRequest, opts ...grpc.CallOption) (*internalpb.ClearReadTaskQueueResponse, error)
 	ComputePhraseMatchSlop(ctx context.Context, in *ComputePhraseMatchSlopRequest, opts ...grpc.CallOption) (*ComputePhraseMatchSlopResponse, error)
+	// GetShardAppliedVersion returns what the delegator of the channel has applied,
+	// the search results of the shard don't change until it moves.
+	GetShardAppliedVersion(ctx context.Context, in *GetShardAppliedVersionRequest, opts ...grpc.CallOption) (*GetShardAppliedVersionResponse, error)
 }
 
 type queryNodeClient struct {
@@ -2028,6 +2032,15 @@ func (c *queryNodeClient) ComputePhraseMatchSlop(ctx context.Context, in *Comput
 	return out, nil
 }
 
+func (c *queryNodeClient) GetShardAppliedVersion(ctx context.Context, in *GetShardAppliedVersionRequest, opts ...grpc.CallOption) (*GetShardAppliedVersionResponse, error) {
+	out := new(GetShardAppliedVersionResponse)
+	err := c.cc.Invoke(ctx, QueryNode_GetShardAppliedVersion_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryNodeServer is the server API for QueryNode service.
 // All implementations should embed UnimplementedQueryNodeServer
 // for forward compatibility
@@ -2069,6 +2082,9 @@ type QueryNodeServer interface {
 	SyncFileResource(context.Context, *internalpb.SyncFileResourceRequest) (*commonpb.Status, error)
 	ClearReadTaskQueue(context.Context, *internalpb.ClearReadTaskQueueRequest) (*internalpb.ClearReadTaskQueueResponse, error)
 	ComputePhraseMatchSlop(context.Context, *ComputePhraseMatchSlopRequest) (*ComputePhraseMatchSlopResponse, error)
+	// GetShardAppliedVersion returns what the delegator of the channel has applied,
+	// the search results of the shard don't change until it moves.
+	GetShardAppliedVersion(context.Context, *GetShardAppliedVersionRequest) (*GetShardAppliedVersionResponse, error)
 }
 
 // UnimplementedQueryNodeServer should be embedded to have forward compatible implementations.
@@ -2174,6 +2190,9 @@ func (UnimplementedQueryNodeServer) ClearReadTaskQueue(context.Context, *interna
 func (UnimplementedQueryNodeServer) ComputePhraseMatchSlop(context.Context, *ComputePhraseMatchSlopRequest) (*ComputePhraseMatchSlopResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ComputePhraseMatchSlop not implemented")
 }
+func (UnimplementedQueryNodeServer) GetShardAppliedVersion(context.Context, *GetShardAppliedVersionRequest) (*GetShardAppliedVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShardAppliedVersion not implemented")
+}
 
 // UnsafeQueryNodeServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to QueryNodeServer will
@@ -2786,6 +2805,24 @@ func _QueryNode_ComputePhraseMatchSlop_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QueryNode_GetShardAppliedVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetShardAppliedVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryNodeServer).GetShardAppliedVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryNode_GetShardAppliedVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryNodeServer).GetShardAppliedVersion(ctx, req.(*GetShardAppliedVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // QueryNode_ServiceDesc is the grpc.ServiceDesc for QueryNode service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -2917,6 +2954,10 @@ var QueryNode_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ComputePhraseMatchSlop",
 			Handler:    _QueryNode_ComputePhraseMatchSlop_Handler,
 		},
+		{
+			MethodName: "GetShardAppliedVersion",
+			Handler:    _QueryNode_GetShardAppliedVersion_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -15,6 +15,7 @@ import (
 	mqpulsar "github.com/milvus-io/milvus/pkg/v3/mq/msgstream/mqwrapper/pulsar"
 	mqwoodpecker "github.com/milvus-io/milvus/pkg/v3/mq/msgstream/mqwrapper/wp"
 	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/walimpls/impls/filewal"
 	msgkafka "github.com/milvus-io/milvus/pkg/v3/streaming/walimpls/impls/kafka"
 	msgpulsar "github.com/milvus-io/milvus/pkg/v3/streaming/walimpls/impls/pulsar"
 	"github.com/milvus-io/milvus/pkg/v3/streaming/walimpls/impls/rmq"
@@ -34,6 +35,9 @@ func MustGetMQWrapperIDFromMessage(messageID message.MessageID) common.MessageID
 		return mqkafka.NewKafkaID(int64(id.KafkaID()))
 	} else if id, ok := messageID.(interface{ WoodpeckerID() *rawWP.LogMessageId }); ok {
 		return mqwoodpecker.NewWoodpeckerID(id.WoodpeckerID())
+	} else if id, ok := messageID.(interface{ FileOffset() int64 }); ok {
+		// the offset of file wal is serialized as the same as rmq.
+		return &server.RmqID{MessageID: id.FileOffset()}
 	}
 	panic("unsupported now")
 }
@@ -47,6 +51,8 @@ func MustGetMQWrapperIDAndWALNameFromMessage(messageID message.MessageID) (commo
 		return mqkafka.NewKafkaID(int64(id.KafkaID())), commonpb.WALName_Kafka
 	} else if id, ok := messageID.(interface{ WoodpeckerID() *rawWP.LogMessageId }); ok {
 		return mqwoodpecker.NewWoodpeckerID(id.WoodpeckerID()), commonpb.WALName_WoodPecker
+	} else if id, ok := messageID.(interface{ FileOffset() int64 }); ok {
+		return &server.RmqID{MessageID: id.FileOffset()}, commonpb.WALName(message.WALNameFile)
 	}
 	panic("unsupported now")
 }
@@ -118,6 +124,9 @@ func MustGetMessageIDFromMQWrapperIDBytesWithWALName(walName message.WALName, ms
 			panic(err)
 		}
 		commonMsgID = mqwoodpecker.NewWoodpeckerID(msgID)
+	case message.WALNameFile:
+		// the file wal shares the serialized form with rmq, but has no mq wrapper id of its own.
+		return filewal.NewFileID(server.DeserializeRmqID(msgIDBytes))
 	default:
 		panic("unsupported now")
 	}
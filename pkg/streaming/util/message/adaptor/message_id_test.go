@@ -7,6 +7,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	wp "github.com/zilliztech/woodpecker/woodpecker/log"
 
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/walimpls/impls/filewal"
 	msgkafka "github.com/milvus-io/milvus/pkg/v3/streaming/walimpls/impls/kafka"
 	msgpulsar "github.com/milvus-io/milvus/pkg/v3/streaming/walimpls/impls/pulsar"
 	"github.com/milvus-io/milvus/pkg/v3/streaming/walimpls/impls/rmq"
@@ -27,4 +29,11 @@ func TestIDConvension(t *testing.T) {
 	logMsgId := wp.EarliestLogMessageID()
 	wpID := MustGetMessageIDFromMQWrapperID(MustGetMQWrapperIDFromMessage(msgwoodpecker.NewWpID(&logMsgId)))
 	assert.True(t, wpID.EQ(msgwoodpecker.NewWpID(&logMsgId)))
+
+	// the file wal id is serialized as the rmq id.
+	fileID := filewal.NewFileID(1)
+	wrapperID, walName := MustGetMQWrapperIDAndWALNameFromMessage(fileID)
+	assert.Equal(t, message.WALNameFile, message.WALName(walName))
+	id = MustGetMessageIDFromMQWrapperIDBytesWithWALName(message.WALNameFile, wrapperID.Serialize())
+	assert.True(t, id.EQ(fileID))
 }
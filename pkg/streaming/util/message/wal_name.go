@@ -15,6 +15,9 @@ const (
 	WALNamePulsar     WALName = WALName(commonpb.WALName_Pulsar)
 	WALNameWoodpecker WALName = WALName(commonpb.WALName_WoodPecker)
 	WALNameTest       WALName = WALName(commonpb.WALName_Test)
+	// WALNameFile is the pure-go local file wal for embedded deployment.
+	// TODO: move into commonpb.WALName of milvus-proto.
+	WALNameFile WALName = 100
 )
 
 var defaultWALName = atomic.NewPointer[WALName](nil)
@@ -54,6 +57,7 @@ var walNameMap = map[WALName]string{
 	WALNamePulsar:     "pulsar",
 	WALNameWoodpecker: "woodpecker",
 	WALNameTest:       "walimplstest",
+	WALNameFile:       "filewal",
 }
 
 // String returns the string representation of the WALName.
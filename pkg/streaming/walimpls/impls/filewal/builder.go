@@ -0,0 +1,63 @@
+package filewal
+
+import (
+	"path/filepath"
+
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/walimpls"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/walimpls/registry"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+func init() {
+	// register the builder to the registry.
+	registry.RegisterBuilder(&builderImpl{})
+	// register the unmarshaler to the message registry.
+	message.RegisterMessageIDUnmsarshaler(message.WALNameFile, UnmarshalMessageID)
+}
+
+// builderImpl is the builder for file wal opener.
+type builderImpl struct{}
+
+// Name of the wal builder, should be a lowercase string.
+func (b *builderImpl) Name() message.WALName {
+	return message.WALNameFile
+}
+
+// Build build a wal instance.
+func (b *builderImpl) Build() (walimpls.OpenerImpls, error) {
+	cfg, err := b.getConfig()
+	if err != nil {
+		return nil, err
+	}
+	return newOpener(cfg), nil
+}
+
+// getConfig gets the file wal config from paramtable.
+func (b *builderImpl) getConfig() (config, error) {
+	params := paramtable.Get()
+	root := params.FileWALCfg.Path.GetValue()
+	if root == "" {
+		root = filepath.Join(params.LocalStorageCfg.Path.GetValue(), "filewal")
+	}
+	cfg := config{
+		root:         root,
+		segmentSize:  params.FileWALCfg.SegmentSize.GetAsInt64(),
+		syncPolicy:   params.FileWALCfg.SyncPolicy.GetValue(),
+		syncInterval: params.FileWALCfg.SyncInterval.GetAsDurationByParse(),
+	}
+	switch cfg.syncPolicy {
+	case SyncPolicyAlways, SyncPolicyNone:
+	case SyncPolicyInterval:
+		if cfg.syncInterval <= 0 {
+			return config{}, merr.WrapErrParameterInvalidMsg("filewal.syncInterval should be positive, got %s", cfg.syncInterval)
+		}
+	default:
+		return config{}, merr.WrapErrParameterInvalidMsg("unsupported filewal.syncPolicy %s", cfg.syncPolicy)
+	}
+	if cfg.segmentSize <= 0 {
+		return config{}, merr.WrapErrParameterInvalidMsg("filewal.segmentSize should be positive, got %d", cfg.segmentSize)
+	}
+	return cfg, nil
+}
@@ -0,0 +1,218 @@
+package filewal
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/options"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/walimpls"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/walimpls/registry"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+func TestMain(m *testing.M) {
+	paramtable.Init()
+	m.Run()
+}
+
+func TestRegistry(t *testing.T) {
+	registeredB := registry.MustGetBuilder(message.WALNameFile)
+	assert.NotNil(t, registeredB)
+	assert.Equal(t, message.WALNameFile, registeredB.Name())
+
+	id, err := message.UnmarshalMessageID(&commonpb.MessageID{
+		WALName: commonpb.WALName(message.WALNameFile),
+		Id:      fileID(1).Marshal(),
+	})
+	assert.NoError(t, err)
+	assert.True(t, id.EQ(fileID(1)))
+}
+
+func TestWAL(t *testing.T) {
+	for _, policy := range []string{SyncPolicyAlways, SyncPolicyInterval, SyncPolicyNone} {
+		t.Run(policy, func(t *testing.T) {
+			params := paramtable.Get()
+			params.Save(params.FileWALCfg.Path.Key, t.TempDir())
+			params.Save(params.FileWALCfg.SyncPolicy.Key, policy)
+			// use small segments to cover the segment rotation.
+			params.Save(params.FileWALCfg.SegmentSize.Key, "4096")
+			defer params.Reset(params.FileWALCfg.Path.Key)
+			defer params.Reset(params.FileWALCfg.SyncPolicy.Key)
+			defer params.Reset(params.FileWALCfg.SegmentSize.Key)
+
+			walimpls.NewWALImplsTestFramework(t, 1000, &builderImpl{}).Run()
+		})
+	}
+}
+
+func TestInvalidConfig(t *testing.T) {
+	params := paramtable.Get()
+	params.Save(params.FileWALCfg.SyncPolicy.Key, "sometimes")
+	_, err := (&builderImpl{}).Build()
+	assert.Error(t, err)
+	params.Reset(params.FileWALCfg.SyncPolicy.Key)
+
+	params.Save(params.FileWALCfg.SegmentSize.Key, "0")
+	_, err = (&builderImpl{}).Build()
+	assert.Error(t, err)
+	params.Reset(params.FileWALCfg.SegmentSize.Key)
+}
+
+func newTestConfig(t *testing.T) config {
+	return config{
+		root:         t.TempDir(),
+		segmentSize:  1024,
+		syncPolicy:   SyncPolicyAlways,
+		syncInterval: 100 * time.Millisecond,
+	}
+}
+
+func openTestWAL(t *testing.T, o walimpls.OpenerImpls, term int64) (walimpls.WALImpls, error) {
+	return o.Open(context.Background(), &walimpls.OpenOption{
+		Channel: types.PChannelInfo{Name: "test-channel", Term: term, AccessMode: types.AccessModeRW},
+	})
+}
+
+func appendTestMessages(t *testing.T, w walimpls.WALImpls, n int) []message.MessageID {
+	ids := make([]message.MessageID, 0, n)
+	for i := 0; i < n; i++ {
+		id, err := w.Append(context.Background(), message.CreateTestEmptyInsertMesage(int64(i), map[string]string{}))
+		require.NoError(t, err)
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+func readTestMessages(t *testing.T, w walimpls.WALImpls, policy options.DeliverPolicy, n int) []message.ImmutableMessage {
+	s, err := w.Read(context.Background(), walimpls.ReadOption{Name: "test", DeliverPolicy: policy})
+	require.NoError(t, err)
+	defer s.Close()
+	msgs := make([]message.ImmutableMessage, 0, n)
+	for i := 0; i < n; i++ {
+		select {
+		case msg, ok := <-s.Chan():
+			require.True(t, ok)
+			msgs = append(msgs, msg)
+		case <-time.After(5 * time.Second):
+			t.Fatal("read message timeout")
+		}
+	}
+	return msgs
+}
+
+func TestFence(t *testing.T) {
+	o := newOpener(newTestConfig(t))
+	defer o.Close()
+
+	w1, err := openTestWAL(t, o, 1)
+	require.NoError(t, err)
+	appendTestMessages(t, w1, 1)
+
+	// the wal of higher term fences the lower one.
+	w2, err := openTestWAL(t, o, 2)
+	require.NoError(t, err)
+	_, err = w1.Append(context.Background(), message.CreateTestEmptyInsertMesage(1, map[string]string{}))
+	assert.True(t, errors.Is(err, walimpls.ErrFenced))
+	appendTestMessages(t, w2, 1)
+
+	_, err = openTestWAL(t, o, 1)
+	assert.True(t, errors.Is(err, walimpls.ErrFenced))
+
+	// the term is persisted.
+	o.Close()
+	o = newOpener(o.cfg)
+	defer o.Close()
+	_, err = openTestWAL(t, o, 1)
+	assert.True(t, errors.Is(err, walimpls.ErrFenced))
+	w3, err := openTestWAL(t, o, 3)
+	require.NoError(t, err)
+	msgs := readTestMessages(t, w3, options.DeliverPolicyAll(), 2)
+	assert.True(t, msgs[1].MessageID().EQ(fileID(1)))
+}
+
+func TestRecoverAndTruncate(t *testing.T) {
+	cfg := newTestConfig(t)
+	o := newOpener(cfg)
+	w, err := openTestWAL(t, o, 1)
+	require.NoError(t, err)
+	ids := appendTestMessages(t, w, 50)
+	l := o.logs["test-channel"]
+	segments := len(l.segments)
+	assert.Greater(t, segments, 2)
+	o.Close()
+
+	// append a torn entry at the tail of the active segment.
+	entries, err := os.ReadDir(filepath.Join(cfg.root, "test-channel"))
+	require.NoError(t, err)
+	var active string
+	for _, entry := range entries {
+		if filepath.Ext(entry.Name()) == segmentFileSuffix {
+			active = filepath.Join(cfg.root, "test-channel", entry.Name())
+		}
+	}
+	f, err := os.OpenFile(active, os.O_WRONLY|os.O_APPEND, 0o644)
+	require.NoError(t, err)
+	_, err = f.Write([]byte{0xff, 0x00, 0x00, 0x00, 0x01})
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	// the torn tail is dropped and the log continues after the last complete message.
+	o = newOpener(cfg)
+	defer o.Close()
+	w, err = openTestWAL(t, o, 2)
+	require.NoError(t, err)
+	newIDs := appendTestMessages(t, w, 1)
+	assert.True(t, newIDs[0].EQ(fileID(50)))
+	msgs := readTestMessages(t, w, options.DeliverPolicyStartAfter(ids[48]), 2)
+	assert.True(t, msgs[0].MessageID().EQ(ids[49]))
+	assert.True(t, msgs[1].MessageID().EQ(newIDs[0]))
+
+	// the segments before the truncated position are removed.
+	require.NoError(t, w.Truncate(context.Background(), ids[30]))
+	l = o.logs["test-channel"]
+	assert.Less(t, len(l.segments), segments)
+	assert.LessOrEqual(t, l.segments[0].firstOffset, int64(31))
+	msgs = readTestMessages(t, w, options.DeliverPolicyAll(), 1)
+	assert.True(t, msgs[0].MessageID().EQ(fileID(l.segments[0].firstOffset)))
+
+	// the truncated message can not be read anymore.
+	s, err := w.Read(context.Background(), walimpls.ReadOption{Name: "test", DeliverPolicy: options.DeliverPolicyStartFrom(ids[0])})
+	require.NoError(t, err)
+	defer s.Close()
+	_, ok := <-s.Chan()
+	assert.False(t, ok)
+	assert.ErrorIs(t, s.Error(), errTruncated)
+}
+
+func TestBrokenLog(t *testing.T) {
+	cfg := newTestConfig(t)
+	o := newOpener(cfg)
+	defer o.Close()
+	w, err := openTestWAL(t, o, 1)
+	require.NoError(t, err)
+	appendTestMessages(t, w, 1)
+
+	// the entry failed to write can't be truncated from a closed file, the log is broken.
+	l := o.logs["test-channel"]
+	active := l.active
+	require.NoError(t, active.Close())
+	_, err = w.Append(context.Background(), message.CreateTestEmptyInsertMesage(1, map[string]string{}))
+	assert.Error(t, err)
+	assert.Error(t, l.broken)
+
+	// no more appends even if the active segment is writable again.
+	l.active, err = os.OpenFile(active.Name(), os.O_WRONLY|os.O_APPEND, 0o644)
+	require.NoError(t, err)
+	_, err = w.Append(context.Background(), message.CreateTestEmptyInsertMesage(1, map[string]string{}))
+	assert.ErrorIs(t, err, l.broken)
+}
@@ -0,0 +1,539 @@
+package filewal
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/proto/messagespb"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/walimpls"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+	"github.com/milvus-io/milvus/pkg/v3/util/syncutil"
+)
+
+const (
+	segmentFileSuffix = ".log"
+	termFileName      = "term"
+
+	// entryHeaderSize is the size of the entry header, 4 bytes length and 4 bytes crc of the entry data.
+	entryHeaderSize = 8
+
+	SyncPolicyAlways   = "always"
+	SyncPolicyInterval = "interval"
+	SyncPolicyNone     = "none"
+)
+
+var (
+	crcTable = crc32.MakeTable(crc32.Castagnoli)
+
+	errLogClosed = errors.New("file wal log closed")
+	errTruncated = errors.New("message is truncated")
+)
+
+// config is the configuration of the channel logs.
+type config struct {
+	root         string
+	segmentSize  int64
+	syncPolicy   string
+	syncInterval time.Duration
+}
+
+// segment is a file holding a contiguous range of the entries of the channel log.
+// The file is named by the offset of its first entry.
+type segment struct {
+	firstOffset int64
+	path        string
+	positions   []int64 // the file position of each entry.
+	size        int64
+}
+
+// lastOffset returns the offset of the last entry in the segment, firstOffset-1 if the segment is empty.
+func (s *segment) lastOffset() int64 {
+	return s.firstOffset + int64(len(s.positions)) - 1
+}
+
+// channelLog is the log of a pchannel, it's a sequence of segment files under the channel directory.
+// The offset of the entry in the log is used as the message id.
+// All wal instances of the same channel in the process share one channelLog,
+// the wal instances with a lower term are fenced by the higher term one.
+type channelLog struct {
+	cfg    config
+	dir    string
+	logger *mlog.Logger
+
+	cond       *syncutil.ContextCond
+	term       int64
+	segments   []*segment // never empty, the last one is the active segment.
+	active     *os.File
+	nextOffset int64
+	dirty      bool // there're appended entries not synced yet.
+	closed     bool
+	// broken is set if an entry failed to append can't be dropped from the active segment,
+	// the following entries would be located at the wrong positions, so no more appends.
+	broken error
+
+	closeCh chan struct{}
+	wg      sync.WaitGroup
+}
+
+// openChannelLog opens the log of the channel, the torn tail of the active segment is truncated.
+func openChannelLog(cfg config, channel string) (*channelLog, error) {
+	dir := filepath.Join(cfg.root, channel)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	l := &channelLog{
+		cfg:     cfg,
+		dir:     dir,
+		logger:  mlog.With(mlog.String("channel", channel)),
+		cond:    syncutil.NewContextCond(&sync.Mutex{}),
+		closeCh: make(chan struct{}),
+	}
+	if err := l.recoverTerm(); err != nil {
+		return nil, err
+	}
+	if err := l.recoverSegments(); err != nil {
+		return nil, err
+	}
+	if cfg.syncPolicy == SyncPolicyInterval {
+		l.wg.Add(1)
+		go l.syncLoop()
+	}
+	l.logger.Info(context.TODO(), "file wal log opened",
+		mlog.Int64("term", l.term),
+		mlog.Int("segments", len(l.segments)),
+		mlog.Int64("firstOffset", l.segments[0].firstOffset),
+		mlog.Int64("nextOffset", l.nextOffset))
+	return l, nil
+}
+
+func (l *channelLog) recoverTerm() error {
+	data, err := os.ReadFile(filepath.Join(l.dir, termFileName))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	term, err := strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64)
+	if err != nil {
+		return errors.Wrapf(err, "invalid term file of file wal %s", l.dir)
+	}
+	l.term = term
+	return nil
+}
+
+func (l *channelLog) recoverSegments() error {
+	entries, err := os.ReadDir(l.dir)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, segmentFileSuffix) {
+			continue
+		}
+		firstOffset, err := strconv.ParseInt(strings.TrimSuffix(name, segmentFileSuffix), 10, 64)
+		if err != nil {
+			return errors.Wrapf(err, "invalid segment file %s of file wal", name)
+		}
+		l.segments = append(l.segments, &segment{firstOffset: firstOffset, path: filepath.Join(l.dir, name)})
+	}
+	sort.Slice(l.segments, func(i, j int) bool {
+		return l.segments[i].firstOffset < l.segments[j].firstOffset
+	})
+
+	if len(l.segments) == 0 {
+		return l.rotate(0)
+	}
+	for i, seg := range l.segments {
+		isActive := i == len(l.segments)-1
+		if err := l.recoverSegment(seg, isActive); err != nil {
+			return err
+		}
+		if !isActive && seg.lastOffset()+1 != l.segments[i+1].firstOffset {
+			return errors.Errorf("file wal segments are not contiguous, %s ends at %d, %s starts at %d",
+				seg.path, seg.lastOffset(), l.segments[i+1].path, l.segments[i+1].firstOffset)
+		}
+	}
+	active := l.segments[len(l.segments)-1]
+	f, err := os.OpenFile(active.path, os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return err
+	}
+	l.active = f
+	l.nextOffset = active.lastOffset() + 1
+	return nil
+}
+
+// recoverSegment rebuilds the entry positions of the segment.
+// The incomplete or broken entries at the tail of the active segment are truncated,
+// they're never acknowledged to the caller.
+func (l *channelLog) recoverSegment(seg *segment, isActive bool) error {
+	f, err := os.Open(seg.path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	stat, err := f.Stat()
+	if err != nil {
+		return err
+	}
+
+	reader := bufio.NewReader(f)
+	header := make([]byte, entryHeaderSize)
+	var pos int64
+	for {
+		if _, err := io.ReadFull(reader, header); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return l.handleTornTail(seg, pos, isActive, err)
+		}
+		length := binary.LittleEndian.Uint32(header[0:4])
+		if pos+entryHeaderSize+int64(length) > stat.Size() {
+			return l.handleTornTail(seg, pos, isActive, io.ErrUnexpectedEOF)
+		}
+		data := make([]byte, length)
+		if _, err := io.ReadFull(reader, data); err != nil {
+			return l.handleTornTail(seg, pos, isActive, err)
+		}
+		if crc32.Checksum(data, crcTable) != binary.LittleEndian.Uint32(header[4:8]) {
+			return l.handleTornTail(seg, pos, isActive, errors.New("crc mismatch"))
+		}
+		seg.positions = append(seg.positions, pos)
+		pos += entryHeaderSize + int64(length)
+	}
+	seg.size = pos
+	return nil
+}
+
+func (l *channelLog) handleTornTail(seg *segment, pos int64, isActive bool, cause error) error {
+	if !isActive {
+		return errors.Wrapf(cause, "file wal segment %s is corrupted at position %d", seg.path, pos)
+	}
+	l.logger.Warn(context.TODO(), "truncate the torn tail of file wal segment",
+		mlog.String("segment", seg.path), mlog.Int64("position", pos), mlog.Err(cause))
+	if err := os.Truncate(seg.path, pos); err != nil {
+		return err
+	}
+	seg.size = pos
+	return nil
+}
+
+// fence updates the term of the log, the wal instances with a lower term can not append anymore.
+func (l *channelLog) fence(term int64) error {
+	l.cond.L.Lock()
+	defer l.cond.L.Unlock()
+
+	if l.closed {
+		return errLogClosed
+	}
+	if term < l.term {
+		return errors.Mark(errors.Errorf("term %d is fenced by term %d", term, l.term), walimpls.ErrFenced)
+	}
+	if term == l.term {
+		return nil
+	}
+	// write the new term into a temporary file and rename it to keep the term file complete.
+	tmp := filepath.Join(l.dir, termFileName+".tmp")
+	if err := writeFileAndSync(tmp, []byte(strconv.FormatInt(term, 10))); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, filepath.Join(l.dir, termFileName)); err != nil {
+		return err
+	}
+	if err := syncDir(l.dir); err != nil {
+		return err
+	}
+	l.term = term
+	return nil
+}
+
+// append appends the message into the log with the term of the writer, the offset of the message is returned.
+func (l *channelLog) append(term int64, msg message.MutableMessage) (int64, error) {
+	data, err := proto.Marshal(msg.IntoMessageProto())
+	if err != nil {
+		return 0, err
+	}
+	buf := make([]byte, entryHeaderSize+len(data))
+	binary.LittleEndian.PutUint32(buf[0:4], uint32(len(data)))
+	binary.LittleEndian.PutUint32(buf[4:8], crc32.Checksum(data, crcTable))
+	copy(buf[entryHeaderSize:], data)
+
+	l.cond.L.Lock()
+	defer l.cond.L.Unlock()
+
+	if l.closed {
+		return 0, errLogClosed
+	}
+	if l.broken != nil {
+		return 0, l.broken
+	}
+	if term < l.term {
+		return 0, errors.Mark(errors.Errorf("term %d is fenced by term %d", term, l.term), walimpls.ErrFenced)
+	}
+	active := l.segments[len(l.segments)-1]
+	if len(active.positions) > 0 && active.size+int64(len(buf)) > l.cfg.segmentSize {
+		if err := l.rotate(l.nextOffset); err != nil {
+			return 0, err
+		}
+		active = l.segments[len(l.segments)-1]
+	}
+	if _, err := l.active.Write(buf); err != nil {
+		// drop the partial written entry, so the following entries can be appended.
+		l.dropUnappended(active)
+		return 0, err
+	}
+	if l.cfg.syncPolicy == SyncPolicyAlways {
+		if err := l.active.Sync(); err != nil {
+			// the entry is not acknowledged, drop it so that it's not seen after recovery.
+			l.dropUnappended(active)
+			return 0, err
+		}
+	} else {
+		l.dirty = true
+	}
+
+	offset := l.nextOffset
+	active.positions = append(active.positions, active.size)
+	active.size += int64(len(buf))
+	l.nextOffset++
+	l.cond.UnsafeBroadcast()
+	return offset, nil
+}
+
+// dropUnappended truncates the bytes written after the last appended entry of the active segment,
+// the log is marked broken if it fails. Must be called with the lock held.
+func (l *channelLog) dropUnappended(active *segment) {
+	if err := l.active.Truncate(active.size); err != nil {
+		l.logger.Warn(context.TODO(), "truncate the unappended entry failed, file wal is broken", mlog.Err(err))
+		l.broken = errors.Wrapf(err, "file wal %s is broken", l.dir)
+	}
+}
+
+// rotate seals the active segment and creates a new segment starting at the offset.
+// Must be called with the lock held.
+func (l *channelLog) rotate(firstOffset int64) error {
+	seg := &segment{
+		firstOffset: firstOffset,
+		path:        filepath.Join(l.dir, fmt.Sprintf("%020d%s", firstOffset, segmentFileSuffix)),
+	}
+	f, err := os.OpenFile(seg.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return err
+	}
+	if err := syncDir(l.dir); err != nil {
+		f.Close()
+		return err
+	}
+	if l.active != nil {
+		// the sealed segment should be persisted before appending into the new one.
+		if l.cfg.syncPolicy != SyncPolicyNone {
+			if err := l.active.Sync(); err != nil {
+				f.Close()
+				return err
+			}
+		}
+		l.active.Close()
+	}
+	l.active = f
+	l.segments = append(l.segments, seg)
+	return nil
+}
+
+// truncate removes the segments whose entries are all before or equal to the offset.
+// The active segment is never removed.
+func (l *channelLog) truncate(offset int64) error {
+	l.cond.L.Lock()
+	defer l.cond.L.Unlock()
+
+	if l.closed {
+		return errLogClosed
+	}
+	removed := 0
+	for removed < len(l.segments)-1 && l.segments[removed].lastOffset() <= offset {
+		if err := os.Remove(l.segments[removed].path); err != nil && !os.IsNotExist(err) {
+			l.logger.Warn(context.TODO(), "remove file wal segment failed",
+				mlog.String("segment", l.segments[removed].path), mlog.Err(err))
+			break
+		}
+		removed++
+	}
+	if removed > 0 {
+		l.logger.Info(context.TODO(), "file wal segments truncated",
+			mlog.Int64("offset", offset), mlog.Int("removed", removed))
+		l.segments = l.segments[removed:]
+	}
+	return nil
+}
+
+// firstOffset returns the offset of the first entry which is not truncated.
+func (l *channelLog) firstOffset() int64 {
+	l.cond.L.Lock()
+	defer l.cond.L.Unlock()
+	return l.segments[0].firstOffset
+}
+
+// latestOffset returns the offset of the next appended entry.
+func (l *channelLog) latestOffset() int64 {
+	l.cond.L.Lock()
+	defer l.cond.L.Unlock()
+	return l.nextOffset
+}
+
+// locate blocks until the entry at offset is appended and returns the location of it.
+func (l *channelLog) locate(ctx context.Context, offset int64) (path string, pos int64, length int64, err error) {
+	l.cond.L.Lock()
+	for offset >= l.nextOffset && !l.closed {
+		if err := l.cond.Wait(ctx); err != nil {
+			return "", 0, 0, err
+		}
+	}
+	defer l.cond.L.Unlock()
+
+	if l.closed {
+		return "", 0, 0, errLogClosed
+	}
+	if offset < l.segments[0].firstOffset {
+		return "", 0, 0, errors.Wrapf(errTruncated, "offset %d, first offset %d", offset, l.segments[0].firstOffset)
+	}
+	idx := sort.Search(len(l.segments), func(i int) bool {
+		return l.segments[i].firstOffset > offset
+	}) - 1
+	seg := l.segments[idx]
+	i := offset - seg.firstOffset
+	end := seg.size
+	if i+1 < int64(len(seg.positions)) {
+		end = seg.positions[i+1]
+	}
+	return seg.path, seg.positions[i], end - seg.positions[i], nil
+}
+
+// syncLoop syncs the active segment periodically when the sync policy is interval.
+func (l *channelLog) syncLoop() {
+	defer l.wg.Done()
+	ticker := time.NewTicker(l.cfg.syncInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-l.closeCh:
+			return
+		case <-ticker.C:
+			l.sync()
+		}
+	}
+}
+
+func (l *channelLog) sync() {
+	l.cond.L.Lock()
+	defer l.cond.L.Unlock()
+	if !l.dirty || l.closed {
+		return
+	}
+	if err := l.active.Sync(); err != nil {
+		l.logger.Warn(context.TODO(), "sync file wal failed", mlog.Err(err))
+		return
+	}
+	l.dirty = false
+}
+
+// close closes the log, the blocked readers are woken up with an error.
+func (l *channelLog) close() {
+	l.cond.LockAndBroadcast()
+	if l.closed {
+		l.cond.L.Unlock()
+		return
+	}
+	if l.cfg.syncPolicy != SyncPolicyNone && l.dirty {
+		if err := l.active.Sync(); err != nil {
+			l.logger.Warn(context.TODO(), "sync file wal failed when closing", mlog.Err(err))
+		}
+	}
+	l.active.Close()
+	l.closed = true
+	close(l.closeCh)
+	l.cond.L.Unlock()
+	l.wg.Wait()
+}
+
+// entryReader reads the entries from the segment files, the file of the last read segment is kept open.
+type entryReader struct {
+	path string
+	f    *os.File
+}
+
+func (r *entryReader) read(offset int64, path string, pos int64, length int64) (message.ImmutableMessage, error) {
+	if r.path != path {
+		r.close()
+		f, err := os.Open(path)
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil, errors.Wrapf(errTruncated, "segment %s is removed", path)
+			}
+			return nil, err
+		}
+		r.path, r.f = path, f
+	}
+	buf := make([]byte, length)
+	if _, err := r.f.ReadAt(buf, pos); err != nil {
+		return nil, err
+	}
+	data := buf[entryHeaderSize:]
+	if crc32.Checksum(data, crcTable) != binary.LittleEndian.Uint32(buf[4:8]) {
+		return nil, merr.WrapErrServiceInternalMsg("file wal entry %d of %s is corrupted", offset, path)
+	}
+	pb := &messagespb.Message{}
+	if err := proto.Unmarshal(data, pb); err != nil {
+		return nil, err
+	}
+	return message.NewImmutableMesasge(fileID(offset), pb.GetPayload(), pb.GetProperties()), nil
+}
+
+func (r *entryReader) close() {
+	if r.f != nil {
+		r.f.Close()
+		r.path, r.f = "", nil
+	}
+}
+
+func writeFileAndSync(path string, data []byte) error {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o644)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// syncDir syncs the directory to persist the creation, removal and renaming of the files in it.
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	return d.Sync()
+}
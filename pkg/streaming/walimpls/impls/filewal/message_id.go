@@ -0,0 +1,82 @@
+package filewal
+
+import (
+	"strconv"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
+)
+
+var _ message.MessageID = fileID(0)
+
+// NewFileID creates a new fileID.
+func NewFileID(offset int64) message.MessageID {
+	return fileID(offset)
+}
+
+// UnmarshalMessageID unmarshal the message id.
+func UnmarshalMessageID(data string) (message.MessageID, error) {
+	id, err := unmarshalMessageID(data)
+	if err != nil {
+		return nil, err
+	}
+	return id, nil
+}
+
+// unmarshalMessageID unmarshal the message id.
+func unmarshalMessageID(data string) (fileID, error) {
+	v, err := message.DecodeInt64(data)
+	if err != nil {
+		return 0, errors.Wrapf(message.ErrInvalidMessageID, "decode fileID fail with err: %s, id: %s", err.Error(), data)
+	}
+	return fileID(v), nil
+}
+
+// fileID is the message id for file wal, it's the offset of the message in the channel log.
+type fileID int64
+
+// FileOffset returns the offset of the message for conversion.
+// Don't delete this function until conversion logic removed.
+// TODO: remove in future.
+func (id fileID) FileOffset() int64 {
+	return int64(id)
+}
+
+// WALName returns the name of message id related wal.
+func (id fileID) WALName() message.WALName {
+	return message.WALNameFile
+}
+
+// LT less than.
+func (id fileID) LT(other message.MessageID) bool {
+	return id < other.(fileID)
+}
+
+// LTE less than or equal to.
+func (id fileID) LTE(other message.MessageID) bool {
+	return id <= other.(fileID)
+}
+
+// EQ Equal to.
+func (id fileID) EQ(other message.MessageID) bool {
+	return id == other.(fileID)
+}
+
+// Marshal marshal the message id.
+func (id fileID) Marshal() string {
+	return message.EncodeInt64(int64(id))
+}
+
+// IntoProto marshal the message id to proto.
+func (id fileID) IntoProto() *commonpb.MessageID {
+	return &commonpb.MessageID{
+		Id:      message.EncodeInt64(int64(id)),
+		WALName: commonpb.WALName(id.WALName()),
+	}
+}
+
+func (id fileID) String() string {
+	return strconv.FormatInt(int64(id), 10)
+}
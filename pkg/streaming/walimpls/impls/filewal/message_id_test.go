@@ -0,0 +1,31 @@
+package filewal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
+)
+
+func TestMessageID(t *testing.T) {
+	assert.Equal(t, int64(1), message.MessageID(fileID(1)).(interface{ FileOffset() int64 }).FileOffset())
+	assert.Equal(t, message.WALNameFile, fileID(1).WALName())
+
+	assert.True(t, fileID(1).LT(fileID(2)))
+	assert.True(t, fileID(1).EQ(fileID(1)))
+	assert.True(t, fileID(1).LTE(fileID(1)))
+	assert.True(t, fileID(1).LTE(fileID(2)))
+	assert.False(t, fileID(2).LT(fileID(1)))
+	assert.False(t, fileID(2).EQ(fileID(1)))
+	assert.False(t, fileID(2).LTE(fileID(1)))
+	assert.True(t, fileID(2).LTE(fileID(2)))
+
+	msgID, err := UnmarshalMessageID(fileID(1).Marshal())
+	assert.NoError(t, err)
+	assert.Equal(t, fileID(1), msgID)
+	assert.Equal(t, "1", msgID.String())
+
+	_, err = UnmarshalMessageID(string([]byte{0x01, 0x02, 0x03, 0x04}))
+	assert.Error(t, err)
+}
@@ -0,0 +1,75 @@
+package filewal
+
+import (
+	"context"
+	"sync"
+
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/walimpls"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/walimpls/helper"
+)
+
+var _ walimpls.OpenerImpls = (*openerImpl)(nil)
+
+func newOpener(cfg config) *openerImpl {
+	return &openerImpl{
+		cfg:  cfg,
+		logs: make(map[string]*channelLog),
+	}
+}
+
+// openerImpl is the implementation of walimpls.Opener interface.
+// The channel logs are kept open until the opener is closed,
+// so only one opener should be built on the same directory.
+type openerImpl struct {
+	cfg  config
+	mu   sync.Mutex
+	logs map[string]*channelLog
+}
+
+// Open opens a new wal.
+func (o *openerImpl) Open(ctx context.Context, opt *walimpls.OpenOption) (walimpls.WALImpls, error) {
+	if err := opt.Validate(); err != nil {
+		return nil, err
+	}
+	l, err := o.getOrOpenLog(opt.Channel.Name)
+	if err != nil {
+		return nil, err
+	}
+	if opt.Channel.AccessMode == types.AccessModeRW {
+		// fence the wal instances with lower term.
+		if err := l.fence(opt.Channel.Term); err != nil {
+			return nil, err
+		}
+	}
+	return &walImpl{
+		WALHelper: helper.NewWALHelper(opt),
+		log:       l,
+	}, nil
+}
+
+func (o *openerImpl) getOrOpenLog(channel string) (*channelLog, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if l, ok := o.logs[channel]; ok {
+		return l, nil
+	}
+	l, err := openChannelLog(o.cfg, channel)
+	if err != nil {
+		return nil, err
+	}
+	o.logs[channel] = l
+	return l, nil
+}
+
+// Close closes the opener resources.
+func (o *openerImpl) Close() {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	for _, l := range o.logs {
+		l.close()
+	}
+	o.logs = make(map[string]*channelLog)
+}
@@ -0,0 +1,104 @@
+package filewal
+
+import (
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/walimpls"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/walimpls/helper"
+)
+
+var _ walimpls.ScannerImpls = (*scannerImpl)(nil)
+
+// newScanner creates a new scanner reading the log from the offset.
+func newScanner(scannerName string, readAheadBufferSize int, offset int64, log *channelLog) *scannerImpl {
+	s := &scannerImpl{
+		ScannerHelper: helper.NewScannerHelper(scannerName),
+		log:           log,
+		offset:        offset,
+		readAhead:     make(chan message.ImmutableMessage, readAheadBufferSize),
+		msgChannel:    make(chan message.ImmutableMessage),
+	}
+	go s.executeRead()
+	go s.executeConsume()
+	return s
+}
+
+// scannerImpl is the implementation of ScannerImpls for file wal.
+type scannerImpl struct {
+	*helper.ScannerHelper
+	log        *channelLog
+	offset     int64
+	reader     entryReader
+	readAhead  chan message.ImmutableMessage
+	readErr    error
+	msgChannel chan message.ImmutableMessage
+}
+
+// Chan returns the channel of message.
+func (s *scannerImpl) Chan() <-chan message.ImmutableMessage {
+	return s.msgChannel
+}
+
+// Close the scanner, release the underlying resources.
+// Return the error same with `Error`
+func (s *scannerImpl) Close() error {
+	return s.ScannerHelper.Close()
+}
+
+// executeRead reads the messages from the log into the read ahead buffer.
+func (s *scannerImpl) executeRead() {
+	defer func() {
+		s.reader.close()
+		close(s.readAhead)
+	}()
+
+	for {
+		path, pos, length, err := s.log.locate(s.Context(), s.offset)
+		if err != nil {
+			if s.Context().Err() == nil {
+				s.readErr = err
+			}
+			return
+		}
+		msg, err := s.reader.read(s.offset, path, pos, length)
+		if err != nil {
+			s.readErr = err
+			return
+		}
+		select {
+		case <-s.Context().Done():
+			return
+		case s.readAhead <- msg:
+		}
+		s.offset++
+	}
+}
+
+// executeConsume delivers the read ahead messages to the consumer.
+func (s *scannerImpl) executeConsume() {
+	var err error
+	defer func() {
+		// wait for the read goroutine to release the segment file.
+		for range s.readAhead {
+		}
+		s.Finish(err)
+		close(s.msgChannel)
+	}()
+
+	for {
+		select {
+		case <-s.Context().Done():
+			return
+		case msg, ok := <-s.readAhead:
+			if !ok {
+				// readErr is visible after the read ahead channel is closed.
+				err = s.readErr
+				return
+			}
+			select {
+			case <-s.Context().Done():
+				return
+			case s.msgChannel <- msg:
+			}
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package filewal
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	"golang.org/x/time/rate"
+
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/walimpls"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/walimpls/helper"
+)
+
+const defaultReadAheadBufferSize = 1024
+
+var _ walimpls.WALImpls = (*walImpl)(nil)
+
+// walImpl is the implementation of walimpls.WAL interface.
+type walImpl struct {
+	*helper.WALHelper
+	log *channelLog
+}
+
+func (w *walImpl) WALName() message.WALName {
+	return message.WALNameFile
+}
+
+// Append appends a message to the wal.
+func (w *walImpl) Append(ctx context.Context, msg message.MutableMessage) (message.MessageID, error) {
+	if w.Channel().AccessMode != types.AccessModeRW {
+		panic("write on a wal that is not in read-write mode")
+	}
+	offset, err := w.log.append(w.Channel().Term, msg)
+	if err != nil {
+		if !errors.Is(err, walimpls.ErrFenced) {
+			w.Log().RatedWarn(ctx, rate.Limit(1), "append message to file wal failed", mlog.Err(err))
+		}
+		return nil, err
+	}
+	return fileID(offset), nil
+}
+
+// Read create a scanner to read the wal.
+func (w *walImpl) Read(ctx context.Context, opt walimpls.ReadOption) (walimpls.ScannerImpls, error) {
+	if opt.ReadAheadBufferSize == 0 {
+		opt.ReadAheadBufferSize = defaultReadAheadBufferSize
+	}
+	var offset int64
+	switch t := opt.DeliverPolicy.GetPolicy().(type) {
+	case *streamingpb.DeliverPolicy_All:
+		offset = w.log.firstOffset()
+	case *streamingpb.DeliverPolicy_Latest:
+		offset = w.log.latestOffset()
+	case *streamingpb.DeliverPolicy_StartFrom:
+		id, err := unmarshalMessageID(t.StartFrom.GetId())
+		if err != nil {
+			return nil, err
+		}
+		offset = int64(id)
+	case *streamingpb.DeliverPolicy_StartAfter:
+		id, err := unmarshalMessageID(t.StartAfter.GetId())
+		if err != nil {
+			return nil, err
+		}
+		offset = int64(id) + 1
+	}
+	return newScanner(opt.Name, opt.ReadAheadBufferSize, offset, w.log), nil
+}
+
+// Truncate removes the segment files whose messages are all before or equal to the id.
+func (w *walImpl) Truncate(ctx context.Context, id message.MessageID) error {
+	if w.Channel().AccessMode != types.AccessModeRW {
+		panic("truncate on a wal that is not in read-write mode")
+	}
+	return w.log.truncate(int64(id.(fileID)))
+}
+
+// Close closes the wal, the underlying channel log is kept open for the other wal instances.
+func (w *walImpl) Close() {
+}
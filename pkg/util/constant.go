@@ -30,6 +30,7 @@ import (
 const (
 	MetaStoreTypeEtcd = "etcd"
 	MetaStoreTypeTiKV = "tikv"
+	MetaStoreTypeSQL  = "sql"
 
 	SegmentMetaPrefix    = "queryCoord-segmentMeta"
 	ChangeInfoMetaPrefix = "queryCoord-sealedSegmentChangeInfo"
@@ -313,6 +313,10 @@ var (
 	ErrSnapshotNotFound = newMilvusError("snapshot not found", 2600, false)
 	ErrSnapshotPinned   = newMilvusError("snapshot is pinned", 2601, false)
 
+	// Transaction related
+	ErrTxnNotFound = newMilvusError("transaction not found", 2700, false)
+	ErrTxnExpired  = newMilvusError("transaction expired", 2701, false)
+
 	// General
 	ErrOperationNotSupported = newMilvusError("unsupported operation", 3000, false)
 
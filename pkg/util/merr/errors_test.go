@@ -176,6 +176,10 @@ func (s *ErrSuite) TestWrap() {
 
 	// Search/Query related
 	s.ErrorIs(WrapErrInconsistentRequery("unknown"), ErrInconsistentRequery)
+
+	// Transaction related
+	s.ErrorIs(WrapErrTxnNotFound("txn", "failed to commit"), ErrTxnNotFound)
+	s.ErrorIs(WrapErrTxnExpired("txn", "failed to commit"), ErrTxnExpired)
 }
 
 func (s *ErrSuite) TestOldCode() {
@@ -1695,6 +1695,22 @@ func WrapErrSnapshotPinned(name any, msg ...string) error {
 	return err
 }
 
+func WrapErrTxnNotFound(id any, msg ...string) error {
+	err := wrapFields(ErrTxnNotFound, value("transaction", id))
+	if len(msg) > 0 {
+		err = errors.Wrap(err, strings.Join(msg, "->"))
+	}
+	return err
+}
+
+func WrapErrTxnExpired(id any, msg ...string) error {
+	err := wrapFields(ErrTxnExpired, value("transaction", id))
+	if len(msg) > 0 {
+		err = errors.Wrap(err, strings.Join(msg, "->"))
+	}
+	return err
+}
+
 // WrapErrOperationNotSupportedMsg creates a new ErrOperationNotSupported with a detail message (Code 3000).
 // This is the primary replacement for fmt.Errorf/errors.New for operations that are
 // currently not supported by the system.
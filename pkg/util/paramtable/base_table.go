@@ -27,6 +27,7 @@ import (
 
 	"github.com/milvus-io/milvus/pkg/v3/config"
 	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/util"
 	"github.com/milvus-io/milvus/pkg/v3/util/etcd"
 	"github.com/milvus-io/milvus/pkg/v3/util/typeutil"
 )
@@ -192,6 +193,9 @@ func (bt *BaseTable) initConfigsFromLocal() {
 
 func (bt *BaseTable) initConfigsFromRemote() {
 	refreshInterval := bt.config.refreshInterval
+	if bt.GetWithDefault("metastore.type", util.MetaStoreTypeEtcd) == util.MetaStoreTypeSQL {
+		bt.initConfigsFromSQL()
+	}
 	etcdConfig := EtcdConfig{}
 	etcdConfig.Init(bt)
 	etcdConfig.Endpoints.PanicIfEmpty = false
@@ -227,6 +231,29 @@ func (bt *BaseTable) initConfigsFromRemote() {
 	s.SetEventHandler(bt.mgr)
 }
 
+// initConfigsFromSQL reads the configs from the sql metastore, the same configs etcd holds for etcd metastore.
+func (bt *BaseTable) initConfigsFromSQL() {
+	sqlConfig := SQLConfig{}
+	sqlConfig.Init(bt)
+	if sqlConfig.DSN.GetValue() == "" {
+		return
+	}
+	info := &config.SQLInfo{
+		Dialect:         sqlConfig.Dialect.GetValue(),
+		DSN:             sqlConfig.DSN.GetValue(),
+		TablePrefix:     sqlConfig.TablePrefix.GetValue(),
+		KeyPrefix:       sqlConfig.RootPath.GetValue(),
+		RefreshInterval: bt.config.refreshInterval,
+	}
+	s, err := config.NewSQLSource(info)
+	if err != nil {
+		mlog.Info(context.TODO(), "init with sql failed", mlog.Err(err))
+		return
+	}
+	bt.mgr.AddSource(s)
+	s.SetEventHandler(bt.mgr)
+}
+
 // GetConfigDir returns the config directory
 func (bt *BaseTable) GetConfigDir() string {
 	return bt.config.configDir
@@ -289,6 +289,19 @@ type commonConfig struct {
 	ExprEnabled           ParamItem `refreshable:"false"`
 	ExprAuthMode          ParamItem `refreshable:"false"`
 
+	JWTEnabled             ParamItem `refreshable:"false"`
+	JWTJWKSURL             ParamItem `refreshable:"false"`
+	JWTKeyFile             ParamItem `refreshable:"false"`
+	JWTIssuer              ParamItem `refreshable:"false"`
+	JWTAudience            ParamItem `refreshable:"false"`
+	JWTUsernameClaim       ParamItem `refreshable:"false"`
+	JWTRolesClaim          ParamItem `refreshable:"false"`
+	JWTRoleMapping         ParamItem `refreshable:"false"`
+	JWTPassThroughGroups   ParamItem `refreshable:"false"`
+	JWTJWKSRefreshInterval ParamItem `refreshable:"false"`
+	JWTCacheTTL            ParamItem `refreshable:"false"`
+	JWTCacheCapacity       ParamItem `refreshable:"false"`
+
 	ClusterName ParamItem `refreshable:"false"`
 
 	SessionTTL        ParamItem `refreshable:"false"`
@@ -1032,6 +1045,117 @@ Large numeric passwords require double quotes to avoid yaml parsing precision is
 	}
 	p.ExprAuthMode.Init(base.mgr)
 
+	p.JWTEnabled = ParamItem{
+		Key:          "common.security.jwt.enabled",
+		Version:      "3.0.1",
+		DefaultValue: "false",
+		Doc: "Whether to accept the JWT bearer tokens issued by an OIDC provider when the authorization is enabled. " +
+			"The token is verified against jwksURL or keyFile, its claims are mapped to a Milvus user and roles.",
+		Export: true,
+	}
+	p.JWTEnabled.Init(base.mgr)
+
+	p.JWTJWKSURL = ParamItem{
+		Key:          "common.security.jwt.jwksURL",
+		Version:      "3.0.1",
+		DefaultValue: "",
+		Doc:          "The url of the JWKS document of the OIDC provider, e.g. https://idp.example.com/.well-known/jwks.json",
+		Export:       true,
+	}
+	p.JWTJWKSURL.Init(base.mgr)
+
+	p.JWTKeyFile = ParamItem{
+		Key:          "common.security.jwt.keyFile",
+		Version:      "3.0.1",
+		DefaultValue: "",
+		Doc:          "The local file of the token verification keys, either PEM encoded public keys/certificates or a JWKS document. Used if jwksURL is empty.",
+		Export:       true,
+	}
+	p.JWTKeyFile.Init(base.mgr)
+
+	p.JWTIssuer = ParamItem{
+		Key:          "common.security.jwt.issuer",
+		Version:      "3.0.1",
+		DefaultValue: "",
+		Doc:          "The expected iss claim of the tokens, the issuer url of the OIDC provider. Required if the jwt authentication is enabled.",
+		Export:       true,
+	}
+	p.JWTIssuer.Init(base.mgr)
+
+	p.JWTAudience = ParamItem{
+		Key:          "common.security.jwt.audience",
+		Version:      "3.0.1",
+		DefaultValue: "",
+		Doc:          "The expected aud claim of the tokens, usually the client id of Milvus in the OIDC provider. Required if the jwt authentication is enabled.",
+		Export:       true,
+	}
+	p.JWTAudience.Init(base.mgr)
+
+	p.JWTUsernameClaim = ParamItem{
+		Key:          "common.security.jwt.usernameClaim",
+		Version:      "3.0.1",
+		DefaultValue: "sub",
+		Doc:          "The claim used as the Milvus username.",
+		Export:       true,
+	}
+	p.JWTUsernameClaim.Init(base.mgr)
+
+	p.JWTRolesClaim = ParamItem{
+		Key:          "common.security.jwt.rolesClaim",
+		Version:      "3.0.1",
+		DefaultValue: "groups",
+		Doc:          "The claim of the groups granted to the user, a dotted path like realm_access.roles is supported.",
+		Export:       true,
+	}
+	p.JWTRolesClaim.Init(base.mgr)
+
+	p.JWTRoleMapping = ParamItem{
+		Key:          "common.security.jwt.roleMapping",
+		Version:      "3.0.1",
+		DefaultValue: "",
+		Doc: "Maps the groups to Milvus roles, in the format of group1:role1,group2:role2. " +
+			"The unmapped groups are ignored unless passThroughUnmappedGroups is true.",
+		Export: true,
+	}
+	p.JWTRoleMapping.Init(base.mgr)
+
+	p.JWTPassThroughGroups = ParamItem{
+		Key:          "common.security.jwt.passThroughUnmappedGroups",
+		Version:      "3.0.1",
+		DefaultValue: "false",
+		Doc: "Whether to grant the groups without a role mapping as the Milvus roles of the same names. " +
+			"Keep it false if the groups can be created by anyone not trusted to manage the Milvus roles.",
+		Export: true,
+	}
+	p.JWTPassThroughGroups.Init(base.mgr)
+
+	p.JWTJWKSRefreshInterval = ParamItem{
+		Key:          "common.security.jwt.jwksRefreshInterval",
+		Version:      "3.0.1",
+		DefaultValue: "3600",
+		Doc:          "The interval in seconds to refetch the JWKS document, it's also refetched when a token is signed by an unknown key.",
+		Export:       true,
+	}
+	p.JWTJWKSRefreshInterval.Init(base.mgr)
+
+	p.JWTCacheTTL = ParamItem{
+		Key:          "common.security.jwt.cache.ttl",
+		Version:      "3.0.1",
+		DefaultValue: "300",
+		Doc:          "The seconds to cache a verified token, bounded by the expiration of the token.",
+		Export:       true,
+	}
+	p.JWTCacheTTL.Init(base.mgr)
+
+	p.JWTCacheCapacity = ParamItem{
+		Key:          "common.security.jwt.cache.capacity",
+		Version:      "3.0.1",
+		DefaultValue: "10000",
+		Doc:          "The max number of the cached verified tokens.",
+		Export:       true,
+	}
+	p.JWTCacheCapacity.Init(base.mgr)
+
 	p.ClusterName = ParamItem{
 		Key:          "common.cluster.name",
 		Version:      "2.0.0",
@@ -2332,6 +2456,40 @@ type AccessLogConfig struct {
 
 	CacheSize          ParamItem `refreshable:"false"`
 	CacheFlushInterval ParamItem `refreshable:"false"`
+
+	OutputFormat      ParamItem `refreshable:"false"`
+	OTLPEnable        ParamItem `refreshable:"false"`
+	OTLPEndpoint      ParamItem `refreshable:"false"`
+	OTLPMethod        ParamItem `refreshable:"false"`
+	OTLPSecure        ParamItem `refreshable:"false"`
+	OTLPHeaders       ParamItem `refreshable:"false"`
+	OTLPBatchSize     ParamItem `refreshable:"false"`
+	OTLPQueueSize     ParamItem `refreshable:"false"`
+	OTLPFlushInterval ParamItem `refreshable:"false"`
+}
+
+type SlowLogConfig struct {
+	Enable                ParamItem `refreshable:"true"`
+	SearchThreshold       ParamItem `refreshable:"true"`
+	HybridSearchThreshold ParamItem `refreshable:"true"`
+	QueryThreshold        ParamItem `refreshable:"true"`
+	MaxEntries            ParamItem `refreshable:"false"`
+	MaxSegmentIDs         ParamItem `refreshable:"true"`
+
+	LocalPath          ParamItem `refreshable:"false"`
+	Filename           ParamItem `refreshable:"false"`
+	MaxSize            ParamItem `refreshable:"false"`
+	RotatedTime        ParamItem `refreshable:"false"`
+	MaxBackups         ParamItem `refreshable:"false"`
+	CacheSize          ParamItem `refreshable:"false"`
+	CacheFlushInterval ParamItem `refreshable:"false"`
+}
+
+type SearchResultCacheConfig struct {
+	Enable        ParamItem `refreshable:"true"`
+	Capacity      ParamItem `refreshable:"false"`
+	MaxResultSize ParamItem `refreshable:"false"`
+	TTL           ParamItem `refreshable:"false"`
 }
 
 type proxyConfig struct {
@@ -2392,6 +2550,9 @@ type proxyConfig struct {
 	MaxSearchAggregationResultEntries ParamItem `refreshable:"true"`
 
 	AccessLog AccessLogConfig
+	SlowLog   SlowLogConfig
+
+	SearchResultCache SearchResultCacheConfig
 
 	// connection manager
 	ConnectionCheckIntervalSeconds ParamItem `refreshable:"true"`
@@ -2404,6 +2565,14 @@ type proxyConfig struct {
 	QueryNodePoolingSize   ParamItem `refreshable:"false"`
 
 	HybridSearchRequeryPolicy ParamItem `refreshable:"true"`
+
+	ExportBatchSize         ParamItem `refreshable:"true"`
+	ExportMaxFileSize       ParamItem `refreshable:"true"`
+	ExportMaxConcurrentJobs ParamItem `refreshable:"true"`
+
+	TxnDefaultKeepalive ParamItem `refreshable:"true"`
+	TxnMaxKeepalive     ParamItem `refreshable:"true"`
+	TxnMaxNum           ParamItem `refreshable:"true"`
 }
 
 func (p *proxyConfig) init(base *BaseTable) {
@@ -2750,6 +2919,86 @@ please adjust in embedded Milvus: false`,
 	}
 	p.AccessLog.CacheFlushInterval.Init(base.mgr)
 
+	p.AccessLog.OutputFormat = ParamItem{
+		Key:          "proxy.accessLog.outputFormat",
+		Version:      "3.0.1",
+		DefaultValue: "text",
+		Doc:          "The format of the access logs, acceptable values: [\"text\", \"json\"]. text renders the format of the formatter into a line, json writes each $field of the format as a typed key of a JSON object per line, $time_cost in milliseconds.",
+		Export:       true,
+	}
+	p.AccessLog.OutputFormat.Init(base.mgr)
+
+	p.AccessLog.OTLPEnable = ParamItem{
+		Key:          "proxy.accessLog.otlp.enable",
+		Version:      "3.0.1",
+		DefaultValue: "false",
+		Doc:          "Whether to also send the access logs to an OpenTelemetry collector through the OTLP logs protocol, each $field of the format is a typed attribute of the log record.",
+		Export:       true,
+	}
+	p.AccessLog.OTLPEnable.Init(base.mgr)
+
+	p.AccessLog.OTLPEndpoint = ParamItem{
+		Key:     "proxy.accessLog.otlp.endpoint",
+		Version: "3.0.1",
+		Doc:     `example: "127.0.0.1:4317" for grpc, "127.0.0.1:4318" for http`,
+		Export:  true,
+	}
+	p.AccessLog.OTLPEndpoint.Init(base.mgr)
+
+	p.AccessLog.OTLPMethod = ParamItem{
+		Key:          "proxy.accessLog.otlp.method",
+		Version:      "3.0.1",
+		DefaultValue: "grpc",
+		Doc:          `otlp export method, acceptable values: ["grpc", "http"]`,
+		Export:       true,
+	}
+	p.AccessLog.OTLPMethod.Init(base.mgr)
+
+	p.AccessLog.OTLPSecure = ParamItem{
+		Key:          "proxy.accessLog.otlp.secure",
+		Version:      "3.0.1",
+		DefaultValue: "true",
+		Doc:          "Whether to connect the otlp endpoint with TLS",
+		Export:       true,
+	}
+	p.AccessLog.OTLPSecure.Init(base.mgr)
+
+	p.AccessLog.OTLPHeaders = ParamItem{
+		Key:          "proxy.accessLog.otlp.headers",
+		Version:      "3.0.1",
+		DefaultValue: "",
+		Doc:          "otlp header that encoded in base64",
+		Export:       true,
+	}
+	p.AccessLog.OTLPHeaders.Init(base.mgr)
+
+	p.AccessLog.OTLPBatchSize = ParamItem{
+		Key:          "proxy.accessLog.otlp.batchSize",
+		Version:      "3.0.1",
+		DefaultValue: "512",
+		Doc:          "The max number of access logs sent in one otlp export request",
+		Export:       true,
+	}
+	p.AccessLog.OTLPBatchSize.Init(base.mgr)
+
+	p.AccessLog.OTLPQueueSize = ParamItem{
+		Key:          "proxy.accessLog.otlp.queueSize",
+		Version:      "3.0.1",
+		DefaultValue: "4096",
+		Doc:          "The max number of access logs waiting to be sent, the oldest access logs are dropped once the queue is full",
+		Export:       true,
+	}
+	p.AccessLog.OTLPQueueSize.Init(base.mgr)
+
+	p.AccessLog.OTLPFlushInterval = ParamItem{
+		Key:          "proxy.accessLog.otlp.flushInterval",
+		Version:      "3.0.1",
+		DefaultValue: "5",
+		Doc:          "The max time interval to send the queued access logs, in seconds",
+		Export:       true,
+	}
+	p.AccessLog.OTLPFlushInterval.Init(base.mgr)
+
 	p.AccessLog.MaxBackups = ParamItem{
 		Key:          "proxy.accessLog.maxBackups",
 		Version:      "2.2.0",
@@ -2793,6 +3042,162 @@ please adjust in embedded Milvus: false`,
 	}
 	p.AccessLog.Formatter.Init(base.mgr)
 
+	p.SlowLog.Enable = ParamItem{
+		Key:          "proxy.slowLog.enable",
+		Version:      "3.0.1",
+		DefaultValue: "true",
+		Doc:          "Whether to record the slow search and query requests. The recent records can be listed by the management http api.",
+		Export:       true,
+	}
+	p.SlowLog.Enable.Init(base.mgr)
+
+	p.SlowLog.SearchThreshold = ParamItem{
+		Key:          "proxy.slowLog.threshold.search",
+		Version:      "3.0.1",
+		DefaultValue: "1000",
+		Doc:          "The latency of a search request to be recorded in the slow log, in milliseconds.",
+		Export:       true,
+	}
+	p.SlowLog.SearchThreshold.Init(base.mgr)
+
+	p.SlowLog.HybridSearchThreshold = ParamItem{
+		Key:          "proxy.slowLog.threshold.hybridSearch",
+		Version:      "3.0.1",
+		DefaultValue: "2000",
+		Doc:          "The latency of a hybrid search request to be recorded in the slow log, in milliseconds.",
+		Export:       true,
+	}
+	p.SlowLog.HybridSearchThreshold.Init(base.mgr)
+
+	p.SlowLog.QueryThreshold = ParamItem{
+		Key:          "proxy.slowLog.threshold.query",
+		Version:      "3.0.1",
+		DefaultValue: "1000",
+		Doc:          "The latency of a query request to be recorded in the slow log, in milliseconds.",
+		Export:       true,
+	}
+	p.SlowLog.QueryThreshold.Init(base.mgr)
+
+	p.SlowLog.MaxEntries = ParamItem{
+		Key:          "proxy.slowLog.maxEntries",
+		Version:      "3.0.1",
+		DefaultValue: "1000",
+		Doc:          "The number of the recent slow log records kept in memory for the management http api.",
+		Export:       true,
+	}
+	p.SlowLog.MaxEntries.Init(base.mgr)
+
+	p.SlowLog.MaxSegmentIDs = ParamItem{
+		Key:          "proxy.slowLog.maxSegmentIDs",
+		Version:      "3.0.1",
+		DefaultValue: "100",
+		Doc:          "The max number of the touched segment ids kept in a slow log record, the number of segments is always recorded.",
+		Export:       true,
+	}
+	p.SlowLog.MaxSegmentIDs.Init(base.mgr)
+
+	p.SlowLog.LocalPath = ParamItem{
+		Key:          "proxy.slowLog.localPath",
+		Version:      "3.0.1",
+		DefaultValue: "/tmp/milvus_slowlog",
+		Doc:          "The local folder path where the slow log file is stored.",
+		Export:       true,
+	}
+	p.SlowLog.LocalPath.Init(base.mgr)
+
+	p.SlowLog.Filename = ParamItem{
+		Key:          "proxy.slowLog.filename",
+		Version:      "3.0.1",
+		DefaultValue: "",
+		Doc:          "The name of the slow log file. If you leave this parameter empty, the slow log is only kept in memory.",
+		Export:       true,
+	}
+	p.SlowLog.Filename.Init(base.mgr)
+
+	p.SlowLog.MaxSize = ParamItem{
+		Key:          "proxy.slowLog.maxSize",
+		Version:      "3.0.1",
+		DefaultValue: "64",
+		Doc:          "The maximum size allowed for a single slow log file, in MB.",
+		Export:       true,
+	}
+	p.SlowLog.MaxSize.Init(base.mgr)
+
+	p.SlowLog.RotatedTime = ParamItem{
+		Key:          "proxy.slowLog.rotatedTime",
+		Version:      "3.0.1",
+		DefaultValue: "0",
+		Doc:          "The maximum time interval allowed for rotating a single slow log file, in seconds. (Close time rotating if it was 0)",
+		Export:       true,
+	}
+	p.SlowLog.RotatedTime.Init(base.mgr)
+
+	p.SlowLog.MaxBackups = ParamItem{
+		Key:          "proxy.slowLog.maxBackups",
+		Version:      "3.0.1",
+		DefaultValue: "8",
+		Doc:          "The maximum number of sealed slow log files that can be retained.",
+		Export:       true,
+	}
+	p.SlowLog.MaxBackups.Init(base.mgr)
+
+	p.SlowLog.CacheSize = ParamItem{
+		Key:          "proxy.slowLog.cacheSize",
+		Version:      "3.0.1",
+		DefaultValue: "0",
+		Doc:          "Size of slow log of write cache, in byte. (Close write cache if size was 0)",
+		Export:       true,
+	}
+	p.SlowLog.CacheSize.Init(base.mgr)
+
+	p.SlowLog.CacheFlushInterval = ParamItem{
+		Key:          "proxy.slowLog.cacheFlushInterval",
+		Version:      "3.0.1",
+		DefaultValue: "3",
+		Doc:          "time interval of auto flush slow log write cache, in seconds. (Close auto flush if interval was 0)",
+		Export:       true,
+	}
+	p.SlowLog.CacheFlushInterval.Init(base.mgr)
+
+	p.SearchResultCache.Enable = ParamItem{
+		Key:          "proxy.searchResultCache.enable",
+		Version:      "3.0.1",
+		DefaultValue: "false",
+		Doc: `Whether to cache the results of the searches with the Bounded or Eventually consistency level.
+An identical search of the same user is served from the cache until the collection is written or altered, or the entry expires.`,
+		Export: true,
+	}
+	p.SearchResultCache.Enable.Init(base.mgr)
+
+	p.SearchResultCache.Capacity = ParamItem{
+		Key:          "proxy.searchResultCache.capacity",
+		Version:      "3.0.1",
+		DefaultValue: "256",
+		Doc:          "The max memory used by the cached search results, in MB.",
+		Export:       true,
+	}
+	p.SearchResultCache.Capacity.Init(base.mgr)
+
+	p.SearchResultCache.MaxResultSize = ParamItem{
+		Key:          "proxy.searchResultCache.maxResultSize",
+		Version:      "3.0.1",
+		DefaultValue: "4",
+		Doc:          "The search results larger than this size are not cached, in MB.",
+		Export:       true,
+	}
+	p.SearchResultCache.MaxResultSize.Init(base.mgr)
+
+	p.SearchResultCache.TTL = ParamItem{
+		Key:          "proxy.searchResultCache.ttl",
+		Version:      "3.0.1",
+		DefaultValue: "60",
+		Doc: `The time a cached search result lives, in seconds.
+The entries are keyed by the versions the shards have applied, so the writes through any proxy miss the cached results.
+The results of the Bounded searches live no longer than common.gracefulTime.`,
+		Export: true,
+	}
+	p.SearchResultCache.TTL.Init(base.mgr)
+
 	p.ShardLeaderCacheInterval = ParamItem{
 		Key:          "proxy.shardLeaderCacheInterval",
 		Version:      "2.2.4",
@@ -3041,6 +3446,64 @@ Disabled if the value is less or equal to 0.`,
 		Export:       true,
 	}
 	p.QueryNodePoolingSize.Init(base.mgr)
+
+	p.ExportBatchSize = ParamItem{
+		Key:          "proxy.export.batchSize",
+		Version:      "3.0.1",
+		Doc:          "the number of rows fetched by each query of an export job, each batch is written as one row group of parquet",
+		DefaultValue: "1000",
+		Export:       false,
+	}
+	p.ExportBatchSize.Init(base.mgr)
+
+	p.ExportMaxFileSize = ParamItem{
+		Key:          "proxy.export.maxFileSizeInMB",
+		Version:      "3.0.1",
+		Doc:          "the max size of each exported file, a new file is started once the size is reached",
+		DefaultValue: "256",
+		Formatter: func(v string) string {
+			fileSize := getAsFloat(v)
+			return fmt.Sprintf("%d", int(megaBytes2Bytes(fileSize)))
+		},
+		Export: false,
+	}
+	p.ExportMaxFileSize.Init(base.mgr)
+
+	p.ExportMaxConcurrentJobs = ParamItem{
+		Key:          "proxy.export.maxConcurrentJobs",
+		Version:      "3.0.1",
+		Doc:          "the max number of export jobs executed by each proxy at the same time, the jobs are scheduled by datacoord",
+		DefaultValue: "2",
+		Export:       false,
+	}
+	p.ExportMaxConcurrentJobs.Init(base.mgr)
+
+	p.TxnDefaultKeepalive = ParamItem{
+		Key:          "proxy.transaction.defaultKeepalive",
+		Version:      "3.0.1",
+		Doc:          "the default keepalive of client transactions, a transaction without any write or commit within the keepalive is expired",
+		DefaultValue: "30s",
+		Export:       false,
+	}
+	p.TxnDefaultKeepalive.Init(base.mgr)
+
+	p.TxnMaxKeepalive = ParamItem{
+		Key:          "proxy.transaction.maxKeepalive",
+		Version:      "3.0.1",
+		Doc:          "the max keepalive that can be requested by a client transaction",
+		DefaultValue: "10m",
+		Export:       false,
+	}
+	p.TxnMaxKeepalive.Init(base.mgr)
+
+	p.TxnMaxNum = ParamItem{
+		Key:          "proxy.transaction.maxNum",
+		Version:      "3.0.1",
+		Doc:          "the max number of open client transactions on each proxy",
+		DefaultValue: "1024",
+		Export:       false,
+	}
+	p.TxnMaxNum.Init(base.mgr)
 }
 
 // /////////////////////////////////////////////////////////////////////////////
@@ -5755,6 +6218,15 @@ type dataCoordConfig struct {
 	CopySegmentTaskRetention        ParamItem `refreshable:"true"`
 	CopySegmentJobTimeout           ParamItem `refreshable:"true"`
 
+	// export
+	ExportRootPath         ParamItem `refreshable:"false"`
+	ExportBucketName       ParamItem `refreshable:"false"`
+	ExportScheduleInterval ParamItem `refreshable:"true"`
+	ExportMaxRunningJobs   ParamItem `refreshable:"true"`
+	ExportLeaseTimeout     ParamItem `refreshable:"true"`
+	ExportMaxRetryTimes    ParamItem `refreshable:"true"`
+	ExportJobRetention     ParamItem `refreshable:"true"`
+
 	ExternalCollectionCheckInterval    ParamItem `refreshable:"true"`
 	ExternalCollectionJobTimeout       ParamItem `refreshable:"true"`
 	ExternalCollectionJobRetention     ParamItem `refreshable:"true"`
@@ -7075,6 +7547,71 @@ if param targetScalarIndexVersion is not set, the default value is -1, which mea
 	}
 	p.MaxImportJobNum.Init(base.mgr)
 
+	p.ExportRootPath = ParamItem{
+		Key:     "dataCoord.export.rootPath",
+		Version: "3.0.1",
+		Doc: `The dedicated root path of exported files, the path of each export job is relative to it.
+Export is disabled if it is empty, it must not overlap with the root path of Milvus data in the same bucket.`,
+		DefaultValue: "",
+		Export:       false,
+	}
+	p.ExportRootPath.Init(base.mgr)
+
+	p.ExportBucketName = ParamItem{
+		Key:          "dataCoord.export.bucketName",
+		Version:      "3.0.1",
+		Doc:          "The bucket of exported files, the bucket of Milvus data is used if it is empty.",
+		DefaultValue: "",
+		Export:       false,
+	}
+	p.ExportBucketName.Init(base.mgr)
+
+	p.ExportScheduleInterval = ParamItem{
+		Key:          "dataCoord.export.scheduleInterval",
+		Version:      "3.0.1",
+		Doc:          "The interval for inspecting and checking export jobs, measured in seconds.",
+		DefaultValue: "2",
+		Export:       false,
+	}
+	p.ExportScheduleInterval.Init(base.mgr)
+
+	p.ExportMaxRunningJobs = ParamItem{
+		Key:          "dataCoord.export.maxRunningJobs",
+		Version:      "3.0.1",
+		Doc:          "The max number of export jobs executed by proxies at the same time.",
+		DefaultValue: "4",
+		Export:       false,
+	}
+	p.ExportMaxRunningJobs.Init(base.mgr)
+
+	p.ExportLeaseTimeout = ParamItem{
+		Key:     "dataCoord.export.leaseTimeout",
+		Version: "3.0.1",
+		Doc: `The lease of a running export job, measured in seconds. If the executing proxy does not report
+within the lease, the job is rescheduled to another proxy.`,
+		DefaultValue: "60",
+		Export:       false,
+	}
+	p.ExportLeaseTimeout.Init(base.mgr)
+
+	p.ExportMaxRetryTimes = ParamItem{
+		Key:          "dataCoord.export.maxRetryTimes",
+		Version:      "3.0.1",
+		Doc:          "The max times of rescheduling an export job whose executing proxy is lost, the job fails after that.",
+		DefaultValue: "3",
+		Export:       false,
+	}
+	p.ExportMaxRetryTimes.Init(base.mgr)
+
+	p.ExportJobRetention = ParamItem{
+		Key:          "dataCoord.export.jobRetention",
+		Version:      "3.0.1",
+		Doc:          "The retention period in seconds for finished export jobs.",
+		DefaultValue: "10800",
+		Export:       false,
+	}
+	p.ExportJobRetention.Init(base.mgr)
+
 	p.WaitForIndex = ParamItem{
 		Key:          "dataCoord.import.waitForIndex",
 		Version:      "2.4.0",
@@ -8236,6 +8773,13 @@ type streamingConfig struct {
 	// Replication pending message queue configuration
 	ReplicationPendingMessagesQueueLength  ParamItem `refreshable:"true"`
 	ReplicationPendingMessagesQueueMaxSize ParamItem `refreshable:"true"`
+
+	// Replication sink configuration
+	ReplicationSinkType               ParamItem `refreshable:"false"`
+	ReplicationSinkFormat             ParamItem `refreshable:"false"`
+	ReplicationSinkTopicPrefix        ParamItem `refreshable:"false"`
+	ReplicationSinkKafkaBrokerList    ParamItem `refreshable:"false"`
+	ReplicationSinkCheckpointInterval ParamItem `refreshable:"true"`
 }
 
 func (p *streamingConfig) init(base *BaseTable) {
@@ -8712,6 +9256,52 @@ so we set 1 second here as a threshold.`,
 	}
 	p.ReplicationPendingMessagesQueueMaxSize.Init(base.mgr)
 
+	p.ReplicationSinkType = ParamItem{
+		Key:     "streaming.replication.sink.type",
+		Version: "3.0.1",
+		Doc: `The type of the sink that the change events of all collections are emitted to, in addition to the milvus replication.
+Only "kafka" is supported for now, the sink is disabled if empty.`,
+		DefaultValue: "",
+		Export:       false,
+	}
+	p.ReplicationSinkType.Init(base.mgr)
+
+	p.ReplicationSinkFormat = ParamItem{
+		Key:          "streaming.replication.sink.format",
+		Version:      "3.0.1",
+		Doc:          `The encoding format of the change events emitted to the sink, "json" or "avro".`,
+		DefaultValue: "json",
+		Export:       false,
+	}
+	p.ReplicationSinkFormat.Init(base.mgr)
+
+	p.ReplicationSinkTopicPrefix = ParamItem{
+		Key:          "streaming.replication.sink.topicPrefix",
+		Version:      "3.0.1",
+		Doc:          "The prefix of the sink topics, the events of a collection are emitted to the topic {topicPrefix}-{collectionID}.",
+		DefaultValue: "milvus-cdc",
+		Export:       false,
+	}
+	p.ReplicationSinkTopicPrefix.Init(base.mgr)
+
+	p.ReplicationSinkKafkaBrokerList = ParamItem{
+		Key:          "streaming.replication.sink.kafka.brokerList",
+		Version:      "3.0.1",
+		Doc:          "The broker list of the kafka sink, the kafka configuration of milvus is used if empty.",
+		DefaultValue: "",
+		Export:       false,
+	}
+	p.ReplicationSinkKafkaBrokerList.Init(base.mgr)
+
+	p.ReplicationSinkCheckpointInterval = ParamItem{
+		Key:          "streaming.replication.sink.checkpointInterval",
+		Version:      "3.0.1",
+		Doc:          "The interval of persisting the sink checkpoint of each pchannel, the events after the checkpoint are emitted again after restart.",
+		DefaultValue: "5s",
+		Export:       false,
+	}
+	p.ReplicationSinkCheckpointInterval.Init(base.mgr)
+
 	p.WALRateLimitDefaultBurst = ParamItem{
 		Key:          "streaming.walRateLimit.defaultBurst",
 		Version:      "2.6.9",
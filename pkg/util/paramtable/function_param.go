@@ -24,6 +24,8 @@ type functionConfig struct {
 	BatchFactor                   ParamItem  `refreshable:"true"`
 	ModelRequestTimeout           ParamItem  `refreshable:"true"`
 	TextEmbeddingProviders        ParamGroup `refreshable:"true"`
+	TextEmbeddingCacheCapacity    ParamItem  `refreshable:"false"`
+	TextEmbeddingCacheTTL         ParamItem  `refreshable:"false"`
 	RerankModelProviders          ParamGroup `refreshable:"true"`
 	LocalResourcePath             ParamItem  `refreshable:"true"`
 	LinderaDownloadUrls           ParamGroup `refreshable:"true"`
@@ -125,6 +127,10 @@ func (p *functionConfig) init(base *BaseTable) {
 				return "Your Hugging Face Inference Providers router URL, default is https://router.huggingface.co"
 			case "huggingface.enable":
 				return "Whether to enable Hugging Face text embedding service"
+			case "local.enable":
+				return "Whether to enable local text embedding models, loaded from file resources and run in-process on CPU"
+			case "local.intra_op_threads":
+				return "The number of threads used by each inference of a local text embedding model, 0 means the runtime default"
 			default:
 				return ""
 			}
@@ -132,6 +138,24 @@ func (p *functionConfig) init(base *BaseTable) {
 	}
 	p.TextEmbeddingProviders.Init(base.mgr)
 
+	p.TextEmbeddingCacheCapacity = ParamItem{
+		Key:          "function.textEmbedding.cache.capacity",
+		Version:      "3.0.1",
+		DefaultValue: "10000",
+		Export:       true,
+		Doc:          "The max number of search text embeddings cached on each proxy, shared by the text embedding functions with the enable_cache param set, 0 disables the cache",
+	}
+	p.TextEmbeddingCacheCapacity.Init(base.mgr)
+
+	p.TextEmbeddingCacheTTL = ParamItem{
+		Key:          "function.textEmbedding.cache.ttl",
+		Version:      "3.0.1",
+		DefaultValue: "1h",
+		Export:       true,
+		Doc:          "How long a cached text embedding stays valid, e.g. 1h, 0 means it only leaves the cache when evicted",
+	}
+	p.TextEmbeddingCacheTTL.Init(base.mgr)
+
 	p.RerankModelProviders = ParamGroup{
 		KeyPrefix: "function.rerank.model.providers.",
 		Version:   "2.6.0",
@@ -170,6 +194,10 @@ func (p *functionConfig) init(base *BaseTable) {
 				return "Your Hugging Face Inference Providers router URL, default is https://router.huggingface.co"
 			case "huggingface.enable":
 				return "Whether to enable Hugging Face rerank service"
+			case "local.enable":
+				return "Whether to enable local rerank models, loaded from file resources and run in-process on CPU"
+			case "local.intra_op_threads":
+				return "The number of threads used by each inference of a local rerank model, 0 means the runtime default"
 			default:
 				return ""
 			}
@@ -103,4 +103,6 @@ func TestFunctionConfig(t *testing.T) {
 	assert.Equal(t, 1, cfg.GetAnalyzerRunnerConcurrency())
 
 	assert.Equal(t, 30*time.Second, cfg.ModelRequestTimeout.GetAsDurationByParse())
+	assert.Equal(t, 10000, cfg.TextEmbeddingCacheCapacity.GetAsInt())
+	assert.Equal(t, time.Hour, cfg.TextEmbeddingCacheTTL.GetAsDurationByParse())
 }
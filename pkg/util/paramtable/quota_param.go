@@ -49,6 +49,10 @@ type quotaConfig struct {
 	AllocWaitInterval          ParamItem `refreshable:"false"`
 	ComplexDeleteLimitEnable   ParamItem `refreshable:"false"`
 
+	// principal
+	UserRateLimits ParamItem `refreshable:"true"`
+	RoleRateLimits ParamItem `refreshable:"true"`
+
 	// ddl
 	DDLLimitEnabled   ParamItem `refreshable:"true"`
 	DDLCollectionRate ParamItem `refreshable:"true"`
@@ -1962,6 +1966,27 @@ specific conditions, such as collection has been dropped), ` + "true" + ` means
 		Export:       true,
 	}
 	p.ComplexDeleteLimitEnable.Init(base.mgr)
+
+	p.UserRateLimits = ParamItem{
+		Key:          "quotaAndLimits.principal.users",
+		Version:      "3.0.1",
+		DefaultValue: "",
+		Doc: `Rate limits of each user in the whole cluster, in the json format of {"user": {"searchRate": 100, "insertRate": 5}}.
+The supported limits are insertRate (upsert included) and deleteRate in MB/s, searchRate in vectors/s and queryRate in qps, 0 denies the requests.
+To use this setting, set quotaAndLimits.enabled and common.security.authorizationEnabled to true at the same time.`,
+		Export: true,
+	}
+	p.UserRateLimits.Init(base.mgr)
+
+	p.RoleRateLimits = ParamItem{
+		Key:          "quotaAndLimits.principal.roles",
+		Version:      "3.0.1",
+		DefaultValue: "",
+		Doc: `Rate limits of each role in the whole cluster, in the same format as quotaAndLimits.principal.users.
+The limits are allocated to the proxies by rootcoord, the limits of a role are shared by all the users granted the role.`,
+		Export: true,
+	}
+	p.RoleRateLimits.Init(base.mgr)
 }
 
 func megaBytes2Bytes(f float64) float64 {
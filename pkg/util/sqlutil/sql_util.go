@@ -0,0 +1,206 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sqlutil holds the dialect handling and the key-value schema shared by
+// the sql metastore and the sql config source.
+//
+// The database drivers are not imported here, the binary that uses the sql
+// metastore must register them (see internal/kv/sql).
+package sqlutil
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+)
+
+// Dialect is the sql dialect of the backend database.
+type Dialect string
+
+const (
+	DialectPostgres Dialect = "postgres"
+	DialectSQLite   Dialect = "sqlite"
+)
+
+const (
+	// driver names registered by github.com/jackc/pgx/v5/stdlib and modernc.org/sqlite.
+	driverPostgres = "pgx"
+	driverSQLite   = "sqlite"
+)
+
+// MetaRowID is the id of the single row of the meta table.
+const MetaRowID = 1
+
+var tablePrefixPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// ParseDialect parses the dialect from config.
+func ParseDialect(dialect string) (Dialect, error) {
+	switch d := Dialect(strings.ToLower(dialect)); d {
+	case DialectPostgres, DialectSQLite:
+		return d, nil
+	default:
+		return "", merr.WrapErrParameterInvalidMsg("unsupported sql dialect %s, valid values: [postgres, sqlite]", dialect)
+	}
+}
+
+// DriverName returns the database/sql driver name of the dialect.
+func (d Dialect) DriverName() string {
+	if d == DialectSQLite {
+		return driverSQLite
+	}
+	return driverPostgres
+}
+
+// Rebind rewrites the `?` placeholders of query into the placeholder style of the dialect.
+func (d Dialect) Rebind(query string) string {
+	if d != DialectPostgres {
+		return query
+	}
+	var b strings.Builder
+	b.Grow(len(query) + 8)
+	n := 0
+	for i := 0; i < len(query); i++ {
+		if query[i] == '?' {
+			n++
+			b.WriteByte('$')
+			b.WriteString(strconv.Itoa(n))
+			continue
+		}
+		b.WriteByte(query[i])
+	}
+	return b.String()
+}
+
+func (d Dialect) blobType() string {
+	if d == DialectSQLite {
+		return "BLOB"
+	}
+	return "BYTEA"
+}
+
+// CreateSQLClient opens the database of the dialect.
+// A sqlite database only allows one writer at a time, so the pool is limited to a single connection,
+// which also keeps an in-memory database shared by all the queries.
+func CreateSQLClient(dialect Dialect, dsn string) (*sql.DB, error) {
+	if dsn == "" {
+		return nil, merr.WrapErrParameterInvalidMsg("sql dsn is empty")
+	}
+	db, err := sql.Open(dialect.DriverName(), dsn)
+	if err != nil {
+		return nil, err
+	}
+	if dialect == DialectSQLite {
+		db.SetMaxOpenConns(1)
+	}
+	return db, nil
+}
+
+// Tables is the names of the tables backing the key-value store.
+//
+//	<prefix>_kv: the latest value of each key with etcd-like revisions.
+//	<prefix>_kv_event: the change history used to serve the watches, compacted by revision.
+//	<prefix>_kv_meta: a single row holding the current and the compacted revision.
+type Tables struct {
+	KV    string
+	Event string
+	Meta  string
+}
+
+// NewTables returns the table names with the given prefix.
+func NewTables(prefix string) (Tables, error) {
+	if !tablePrefixPattern.MatchString(prefix) {
+		return Tables{}, merr.WrapErrParameterInvalidMsg("invalid sql table prefix %s", prefix)
+	}
+	return Tables{
+		KV:    prefix + "_kv",
+		Event: prefix + "_kv_event",
+		Meta:  prefix + "_kv_meta",
+	}, nil
+}
+
+// InitSchema creates the tables if not exist.
+// Keys and values are stored as binary so that the keys are ordered byte-wise like etcd
+// whatever the collation of the database is, and the values may hold any marshaled proto.
+func InitSchema(ctx context.Context, db *sql.DB, dialect Dialect, tables Tables) error {
+	blob := dialect.blobType()
+	stmts := []string{
+		fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+	k %s PRIMARY KEY,
+	v %s,
+	create_revision BIGINT NOT NULL,
+	mod_revision BIGINT NOT NULL,
+	version BIGINT NOT NULL
+)`, tables.KV, blob, blob),
+		fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+	revision BIGINT NOT NULL,
+	k %s NOT NULL,
+	v %s,
+	event_type INTEGER NOT NULL,
+	create_revision BIGINT NOT NULL,
+	version BIGINT NOT NULL,
+	prev_v %s,
+	prev_create_revision BIGINT,
+	prev_mod_revision BIGINT,
+	prev_version BIGINT,
+	PRIMARY KEY (revision, k)
+)`, tables.Event, blob, blob, blob),
+		fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+	id INTEGER PRIMARY KEY,
+	revision BIGINT NOT NULL,
+	compact_revision BIGINT NOT NULL
+)`, tables.Meta),
+	}
+	for _, stmt := range stmts {
+		if _, err := db.ExecContext(ctx, stmt); err != nil {
+			return merr.WrapErrIoFailedReason("failed to init sql schema", err.Error())
+		}
+	}
+	// etcd starts from revision 1, keep the same to make the revisions comparable.
+	initMeta := dialect.Rebind(fmt.Sprintf(`INSERT INTO %s (id, revision, compact_revision) VALUES (?, 1, 0) ON CONFLICT (id) DO NOTHING`, tables.Meta))
+	if _, err := db.ExecContext(ctx, initMeta, MetaRowID); err != nil {
+		return merr.WrapErrIoFailedReason("failed to init sql schema", err.Error())
+	}
+	return nil
+}
+
+// PrefixCondition returns the condition on the column k selecting the keys with the given prefix,
+// the keys are compared byte-wise as etcd does.
+func PrefixCondition(prefix string) (string, []any) {
+	end := prefixRangeEnd([]byte(prefix))
+	if end == nil {
+		return "k >= ?", []any{[]byte(prefix)}
+	}
+	return "k >= ? AND k < ?", []any{[]byte(prefix), end}
+}
+
+// prefixRangeEnd returns the smallest key greater than all the keys with the prefix,
+// nil if there is no upper bound.
+func prefixRangeEnd(prefix []byte) []byte {
+	end := make([]byte, len(prefix))
+	copy(end, prefix)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xff {
+			end[i]++
+			return end[:i+1]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDialect(t *testing.T) {
+	d, err := ParseDialect("Postgres")
+	assert.NoError(t, err)
+	assert.Equal(t, DialectPostgres, d)
+	assert.Equal(t, "pgx", d.DriverName())
+
+	d, err = ParseDialect("sqlite")
+	assert.NoError(t, err)
+	assert.Equal(t, DialectSQLite, d)
+	assert.Equal(t, "sqlite", d.DriverName())
+
+	_, err = ParseDialect("mysql")
+	assert.Error(t, err)
+}
+
+func TestRebind(t *testing.T) {
+	query := "SELECT v FROM kv WHERE k >= ? AND k < ?"
+	assert.Equal(t, "SELECT v FROM kv WHERE k >= $1 AND k < $2", DialectPostgres.Rebind(query))
+	assert.Equal(t, query, DialectSQLite.Rebind(query))
+}
+
+func TestNewTables(t *testing.T) {
+	tables, err := NewTables("milvus")
+	assert.NoError(t, err)
+	assert.Equal(t, Tables{KV: "milvus_kv", Event: "milvus_kv_event", Meta: "milvus_kv_meta"}, tables)
+
+	for _, prefix := range []string{"", "1milvus", "milvus-dev", "milvus; DROP TABLE t"} {
+		_, err := NewTables(prefix)
+		assert.Error(t, err, prefix)
+	}
+}
+
+func TestPrefixCondition(t *testing.T) {
+	cond, args := PrefixCondition("by-dev/meta/")
+	assert.Equal(t, "k >= ? AND k < ?", cond)
+	assert.Equal(t, []any{[]byte("by-dev/meta/"), []byte("by-dev/meta0")}, args)
+
+	cond, args = PrefixCondition("a\xff\xff")
+	assert.Equal(t, "k >= ? AND k < ?", cond)
+	assert.Equal(t, []any{[]byte("a\xff\xff"), []byte("b")}, args)
+
+	cond, args = PrefixCondition("")
+	assert.Equal(t, "k >= ?", cond)
+	assert.Equal(t, []any{[]byte("")}, args)
+}
+
+func TestCreateSQLClient(t *testing.T) {
+	_, err := CreateSQLClient(DialectSQLite, "")
+	assert.Error(t, err)
+}
@@ -107,6 +107,7 @@ if [[ "$(uname)" == "Darwin" ]]; then
   ENABLE_AZURE_FS="OFF"
 fi
 : "${ENABLE_GCP_NATIVE:="OFF"}"
+: "${WITH_ONNXRUNTIME:="OFF"}"
 # Build acceleration options (override via env vars)
 : "${USE_PCH:="ON"}"
 : "${USE_UNITY_BUILD:="OFF"}"
@@ -254,6 +255,7 @@ ${CMAKE_EXTRA_ARGS} \
 -DTANTIVY_FEATURES_LIST=${TANTIVY_FEATURES} \
 -DENABLE_GCP_NATIVE=${ENABLE_GCP_NATIVE} \
 -DENABLE_AZURE_FS=${ENABLE_AZURE_FS} \
+-DMILVUS_WITH_ONNXRUNTIME=${WITH_ONNXRUNTIME} \
 -DMILVUS_USE_PCH=${USE_PCH} \
 -DMILVUS_UNITY_BUILD=${USE_UNITY_BUILD} "
 # Azure build variables removed as we now use Arrow with Azure support directly
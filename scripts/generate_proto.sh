@@ -64,6 +64,7 @@ backup_generated_files() {
     done < <(find \
         "${ROOT_DIR}/pkg/proto" \
         "${ROOT_DIR}/pkg/eventlog" \
+        "${ROOT_DIR}/client/proto" \
         "${ROOT_DIR}/cmd/tools/migration/backend" \
         "${ROOT_DIR}/cmd/tools/migration/legacy/legacypb" \
         "${CPP_SRC_DIR}/src/pb" \
@@ -105,6 +106,9 @@ mkdir -p ./messagespb
 mkdir -p ./streamingpb
 mkdir -p ./viewpb
 mkdir -p $ROOT_DIR/cmd/tools/migration/legacy/legacypb
+mkdir -p $ROOT_DIR/client/proto/txnpb
+mkdir -p $ROOT_DIR/client/proto/changestreampb
+mkdir -p $ROOT_DIR/client/proto/explainpb
 
 protoc_opt="${PROTOC_BIN} --proto_path=${API_PROTO_DIR} --proto_path=."
 
@@ -129,6 +133,10 @@ ${protoc_opt} --go_out=paths=source_relative:./viewpb --go-grpc_out=require_unim
 ${protoc_opt} --go_out=paths=source_relative:./workerpb --go-grpc_out=require_unimplemented_servers=false,paths=source_relative:./workerpb worker.proto|| { echo 'generate worker.proto failed'; exit 1; }
 
 ${protoc_opt} --proto_path=$ROOT_DIR/pkg/eventlog/ --go_out=paths=source_relative:../../pkg/eventlog/ --go-grpc_out=require_unimplemented_servers=false,paths=source_relative:../../pkg/eventlog/ event_log.proto || { echo 'generate event_log.proto failed'; exit 1; }
+# the services shared by the client sdk and proxy, the client module doesn't depend on pkg.
+${protoc_opt} --proto_path=$ROOT_DIR/client/proto/ --go_out=paths=source_relative:../../client/proto/txnpb --go-grpc_out=require_unimplemented_servers=false,paths=source_relative:../../client/proto/txnpb transaction.proto || { echo 'generate transaction.proto failed'; exit 1; }
+${protoc_opt} --proto_path=$ROOT_DIR/client/proto/ --go_out=paths=source_relative:../../client/proto/changestreampb --go-grpc_out=require_unimplemented_servers=false,paths=source_relative:../../client/proto/changestreampb change_stream.proto || { echo 'generate change_stream.proto failed'; exit 1; }
+${protoc_opt} --proto_path=$ROOT_DIR/client/proto/ --go_out=paths=source_relative:../../client/proto/explainpb --go-grpc_out=require_unimplemented_servers=false,paths=source_relative:../../client/proto/explainpb explain.proto || { echo 'generate explain.proto failed'; exit 1; }
 ${protoc_opt} --proto_path=$ROOT_DIR/cmd/tools/migration/backend --go_out=paths=source_relative:../../cmd/tools/migration/backend/ --go-grpc_out=require_unimplemented_servers=false,paths=source_relative:../../cmd/tools/migration/backend backup_header.proto || { echo 'generate backup_header.proto failed'; exit 1; }
 
 ${protoc_opt} --proto_path=$ROOT_DIR/cmd/tools/migration/legacy/ \